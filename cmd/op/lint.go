@@ -3,3 +3,74 @@
 // that can be found in the LICENSE file.
 
 package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/devkit"
+)
+
+const lintDesc = `
+This command takes paths to apps or archived apps and runs a series of tests
+to verify that the apps are well-formed.
+
+If the linter encounters things that will cause the app to fail installation,
+it will emit [ERROR] messages. If it encounters issues that break with convention
+or recommendation, it will emit [WARNING] messages.
+
+It exits with non-zero code when any app has errors, or warnings with --strict.
+`
+
+type lintCmd struct {
+	strict bool
+	paths  []string
+
+	out io.Writer
+}
+
+func newLintCmd(out io.Writer) *cobra.Command {
+	l := &lintCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "lint [flags] PATH [...]",
+		Short: "examines an app for possible issues",
+		Long:  lintDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("need at least one argument, the path to the app")
+			}
+			l.paths = args
+			return l.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.BoolVar(&l.strict, "strict", false, "fail on lint warnings")
+
+	return cmd
+}
+
+func (l *lintCmd) run() error {
+	var failures int
+	for _, path := range l.paths {
+		fmt.Fprintf(l.out, "==> Linting %s\n", path)
+
+		result := devkit.Lint(path)
+		for _, m := range result.Messages {
+			fmt.Fprintln(l.out, m)
+		}
+		if result.HasError() || (l.strict && len(result.Messages) > 0) {
+			failures++
+		}
+		fmt.Fprintln(l.out)
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("%d app(s) linted, %d app(s) failed", len(l.paths), failures)
+	}
+	fmt.Fprintf(l.out, "%d app(s) linted, no failures\n", len(l.paths))
+	return nil
+}
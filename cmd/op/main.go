@@ -25,7 +25,8 @@ func newRootCmd(c string, args []string) *cobra.Command {
 	cmd.AddCommand(
 		// app commands
 		newCreateCmd(out),
-		//newLintCmd(out),
+		newLintCmd(out),
+		newRenderCmd(out),
 		newPackageCmd(out),
 		newIndexCmd(out),
 		newServeCmd(out),
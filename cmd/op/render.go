@@ -0,0 +1,143 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/config"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+const renderDesc = `
+This command renders the cluster.json.tmpl of an app locally, and shows the
+task plan of creating the cluster, no runtime is involved.
+
+The values file is a json object in the same structure as the default config
+generated by config.json, the values in it override the default ones:
+
+	$ op render nginx --values values.json
+`
+
+type renderCmd struct {
+	path   string
+	values string
+
+	out io.Writer
+}
+
+func newRenderCmd(out io.Writer) *cobra.Command {
+	r := &renderCmd{out: out}
+
+	cmd := &cobra.Command{
+		Use:     "render [flags] PATH",
+		Aliases: []string{"template"},
+		Short:   "render the cluster conf and the task plan of an app",
+		Long:    renderDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgsLength(len(args), "the path to the app"); err != nil {
+				return err
+			}
+			r.path = args[0]
+			return r.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&r.values, "values", "f", "", "specify values in a json file")
+
+	return cmd
+}
+
+func (r *renderCmd) run() error {
+	app, err := devkit.Load(r.path)
+	if err != nil {
+		return err
+	}
+
+	var values jsonutil.Json
+	if len(r.values) > 0 {
+		content, err := ioutil.ReadFile(r.values)
+		if err != nil {
+			return err
+		}
+		values, err = jsonutil.NewJson(content)
+		if err != nil {
+			return fmt.Errorf("failed to parse values file [%s]: %+v", r.values, err)
+		}
+	}
+
+	clusterConf, err := app.RenderClusterConf(values)
+	if err != nil {
+		return err
+	}
+	var conf bytes.Buffer
+	if err = json.Indent(&conf, []byte(clusterConf.RenderJson), "", "  "); err != nil {
+		return err
+	}
+	fmt.Fprintln(r.out, "==> Cluster conf")
+	fmt.Fprintln(r.out, strings.TrimSpace(conf.String()))
+	fmt.Fprintln(r.out)
+
+	ctx := context.Background()
+	clusterWrapper := new(models.ClusterWrapper)
+	parser := vmbased.Parser{Ctx: ctx}
+	err = parser.Parse(clusterConf, clusterWrapper, "")
+	if err != nil {
+		return err
+	}
+	frame := &vmbased.Frame{
+		Ctx: ctx,
+		Job: &models.Job{
+			JobAction: constants.ActionCreateCluster,
+			Directive: jsonutil.ToString(clusterWrapper),
+		},
+		ClusterWrapper:        clusterWrapper,
+		Runtime:               new(models.RuntimeDetails),
+		RuntimeProviderConfig: new(config.RuntimeProviderConfig),
+		DryRun:                true,
+	}
+
+	fmt.Fprintf(r.out, "==> Task plan of [%s]\n", constants.ActionCreateCluster)
+	step := 0
+	for taskLayer := frame.CreateClusterLayer(); taskLayer != nil; taskLayer = taskLayer.Child {
+		step++
+		fmt.Fprintf(r.out, "%2d. %s\n", step, describeTaskLayer(taskLayer))
+	}
+	return nil
+}
+
+// describeTaskLayer returns the actions of the layer and the nodes they run on
+func describeTaskLayer(taskLayer *models.TaskLayer) string {
+	var actions []string
+	nodes := make(map[string][]string)
+	for _, task := range taskLayer.Tasks {
+		if _, ok := nodes[task.TaskAction]; !ok {
+			actions = append(actions, task.TaskAction)
+		}
+		nodes[task.TaskAction] = append(nodes[task.TaskAction], task.NodeId)
+	}
+
+	var descs []string
+	for _, action := range actions {
+		nodeIds := nodes[action]
+		sort.Strings(nodeIds)
+		descs = append(descs, fmt.Sprintf("%s x%d %v", action, len(nodeIds), nodeIds))
+	}
+	return strings.Join(descs, ", ")
+}
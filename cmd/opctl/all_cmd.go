@@ -66,14 +66,17 @@ var AllCmd = []Cmd{
 	NewCancelAppVersionCmd(),
 	NewCreateAppCmd(),
 	NewCreateAppVersionCmd(),
+	NewCreateReviewPolicyCmd(),
 	NewDeleteAppVersionCmd(),
 	NewDeleteAppsCmd(),
+	NewDeleteReviewPoliciesCmd(),
 	NewDescribeActiveAppVersionsCmd(),
 	NewDescribeActiveAppsCmd(),
 	NewDescribeAppVersionAuditsCmd(),
 	NewDescribeAppVersionReviewsCmd(),
 	NewDescribeAppVersionsCmd(),
 	NewDescribeAppsCmd(),
+	NewDescribeReviewPoliciesCmd(),
 	NewGetAppStatisticsCmd(),
 	NewGetAppVersionPackageCmd(),
 	NewGetAppVersionPackageFilesCmd(),
@@ -82,8 +85,12 @@ var AllCmd = []Cmd{
 	NewIsvReviewAppVersionCmd(),
 	NewModifyAppCmd(),
 	NewModifyAppVersionCmd(),
+	NewModifyReviewPolicyCmd(),
+	NewPassAppVersionCmd(),
 	NewRecoverAppVersionCmd(),
+	NewRejectAppVersionCmd(),
 	NewReleaseAppVersionCmd(),
+	NewReviewAppVersionCmd(),
 	NewSubmitAppVersionCmd(),
 	NewSuspendAppVersionCmd(),
 	NewTechnicalPassAppVersionCmd(),
@@ -100,25 +107,37 @@ var AllCmd = []Cmd{
 	NewAttachKeyPairsCmd(),
 	NewCeaseClustersCmd(),
 	NewCreateClusterCmd(),
+	NewCreateClusterSnapshotCmd(),
 	NewCreateDebugClusterCmd(),
 	NewCreateKeyPairCmd(),
+	NewCreateQuotaCmd(),
 	NewDeleteClusterNodesCmd(),
+	NewDeleteClusterSnapshotsCmd(),
 	NewDeleteClustersCmd(),
 	NewDeleteKeyPairsCmd(),
+	NewDeleteQuotasCmd(),
 	NewDescribeAppClustersCmd(),
+	NewDescribeClusterMonitorCmd(),
 	NewDescribeClusterNodesCmd(),
+	NewDescribeClusterServiceAuditsCmd(),
+	NewDescribeClusterSnapshotsCmd(),
 	NewDescribeClustersCmd(),
 	NewDescribeDebugAppClustersCmd(),
 	NewDescribeDebugClustersCmd(),
 	NewDescribeKeyPairsCmd(),
+	NewDescribeQuotaUsageCmd(),
+	NewDescribeQuotasCmd(),
 	NewDescribeSubnetsCmd(),
 	NewDetachKeyPairsCmd(),
 	NewGetClusterStatisticsCmd(),
 	NewModifyClusterAttributesCmd(),
 	NewModifyClusterNodeAttributesCmd(),
+	NewModifyQuotaCmd(),
 	NewRecoverClustersCmd(),
 	NewResizeClusterCmd(),
+	NewRestoreClusterFromSnapshotCmd(),
 	NewRollbackClusterCmd(),
+	NewRunClusterServiceCmd(),
 	NewStartClustersCmd(),
 	NewStopClustersCmd(),
 	NewUpdateClusterEnvCmd(),
@@ -129,6 +148,7 @@ var AllCmd = []Cmd{
 	NewPassVendorVerifyInfoCmd(),
 	NewRejectVendorVerifyInfoCmd(),
 	NewSubmitVendorVerifyInfoCmd(),
+	NewCancelJobCmd(),
 	NewDescribeJobsCmd(),
 	NewCreateMarketCmd(),
 	NewDeleteMarketsCmd(),
@@ -139,6 +159,8 @@ var AllCmd = []Cmd{
 	NewUserLeaveMarketCmd(),
 	NewCreateReleaseCmd(),
 	NewDeleteReleaseCmd(),
+	NewDescribeReleaseHistoryCmd(),
+	NewDiffReleaseRevisionsCmd(),
 	NewListReleasesCmd(),
 	NewRollbackReleaseCmd(),
 	NewUpgradeReleaseCmd(),
@@ -163,6 +185,7 @@ var AllCmd = []Cmd{
 	NewGetRuntimeStatisticsCmd(),
 	NewModifyRuntimeCmd(),
 	NewModifyRuntimeCredentialCmd(),
+	NewRotateCredentialKeyCmd(),
 	NewValidateRuntimeCredentialCmd(),
 	NewGetServiceConfigCmd(),
 	NewSetServiceConfigCmd(),
@@ -170,6 +193,10 @@ var AllCmd = []Cmd{
 	NewDescribeTasksCmd(),
 	NewRetryTasksCmd(),
 	NewCreateClientCmd(),
+	NewDescribeSessionsCmd(),
+	NewLogoutCmd(),
+	NewRevokeTokenCmd(),
+	NewRevokeUserTokensCmd(),
 	NewTokenCmd(),
 }
 
@@ -1238,6 +1265,7 @@ func (*AdminPassAppVersionCmd) GetActionName() string {
 }
 
 func (c *AdminPassAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to pass, required by PassAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to pass")
 }
 
@@ -1274,6 +1302,7 @@ func (*AdminRejectAppVersionCmd) GetActionName() string {
 
 func (c *AdminRejectAppVersionCmd) ParseFlag(f Flag) {
 	f.StringVarP(&c.Message, "message", "", "", "reject message")
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to reject, required by RejectAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to reject")
 }
 
@@ -1309,6 +1338,7 @@ func (*BusinessPassAppVersionCmd) GetActionName() string {
 }
 
 func (c *BusinessPassAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to pass, required by PassAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to pass")
 }
 
@@ -1345,6 +1375,7 @@ func (*BusinessRejectAppVersionCmd) GetActionName() string {
 
 func (c *BusinessRejectAppVersionCmd) ParseFlag(f Flag) {
 	f.StringVarP(&c.Message, "message", "", "", "reject message")
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to reject, required by RejectAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to reject")
 }
 
@@ -1380,6 +1411,7 @@ func (*BusinessReviewAppVersionCmd) GetActionName() string {
 }
 
 func (c *BusinessReviewAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to start, required by ReviewAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to review")
 }
 
@@ -1539,6 +1571,44 @@ func (c *CreateAppVersionCmd) Run(out Out) error {
 	return nil
 }
 
+type CreateReviewPolicyCmd struct {
+	*models.OpenpitrixCreateReviewPolicyRequest
+}
+
+func NewCreateReviewPolicyCmd() Cmd {
+	cmd := &CreateReviewPolicyCmd{}
+	cmd.OpenpitrixCreateReviewPolicyRequest = &models.OpenpitrixCreateReviewPolicyRequest{}
+	return cmd
+}
+
+func (*CreateReviewPolicyCmd) GetActionName() string {
+	return "CreateReviewPolicy"
+}
+
+func (c *CreateReviewPolicyCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Description, "description", "", "", "review policy description")
+	f.StringVarP(&c.Name, "name", "", "", "review policy name")
+	f.StringVarP(&c.ScopeID, "scope_id", "", "", "required, id of category or market which the review policy applies to")
+	f.StringVarP(&c.ScopeType, "scope_type", "", "", "required, scope type of review policy eg.[category|market]")
+}
+
+func (c *CreateReviewPolicyCmd) Run(out Out) error {
+	params := app_manager.NewCreateReviewPolicyParams()
+	params.WithBody(c.OpenpitrixCreateReviewPolicyRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.CreateReviewPolicy(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DeleteAppVersionCmd struct {
 	*models.OpenpitrixDeleteAppVersionRequest
 }
@@ -1609,6 +1679,41 @@ func (c *DeleteAppsCmd) Run(out Out) error {
 	return nil
 }
 
+type DeleteReviewPoliciesCmd struct {
+	*models.OpenpitrixDeleteReviewPoliciesRequest
+}
+
+func NewDeleteReviewPoliciesCmd() Cmd {
+	cmd := &DeleteReviewPoliciesCmd{}
+	cmd.OpenpitrixDeleteReviewPoliciesRequest = &models.OpenpitrixDeleteReviewPoliciesRequest{}
+	return cmd
+}
+
+func (*DeleteReviewPoliciesCmd) GetActionName() string {
+	return "DeleteReviewPolicies"
+}
+
+func (c *DeleteReviewPoliciesCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.PolicyID, "policy_id", "", []string{}, "required, ids of review policy to delete")
+}
+
+func (c *DeleteReviewPoliciesCmd) Run(out Out) error {
+	params := app_manager.NewDeleteReviewPoliciesParams()
+	params.WithBody(c.OpenpitrixDeleteReviewPoliciesRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DeleteReviewPolicies(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DescribeActiveAppVersionsCmd struct {
 	*app_manager.DescribeActiveAppVersionsParams
 }
@@ -1687,6 +1792,8 @@ func (c *DescribeActiveAppsCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default is 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "app owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	f.StringSliceVarP(&c.RepoID, "repo_id", "", []string{}, "app repository ids.")
 	c.Reverse = new(bool)
 	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
@@ -1889,6 +1996,8 @@ func (c *DescribeAppsCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default is 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "app owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	f.StringSliceVarP(&c.RepoID, "repo_id", "", []string{}, "app repository ids.")
 	c.Reverse = new(bool)
 	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
@@ -1915,6 +2024,51 @@ func (c *DescribeAppsCmd) Run(out Out) error {
 	return nil
 }
 
+type DescribeReviewPoliciesCmd struct {
+	*app_manager.DescribeReviewPoliciesParams
+}
+
+func NewDescribeReviewPoliciesCmd() Cmd {
+	return &DescribeReviewPoliciesCmd{
+		DescribeReviewPoliciesParams: app_manager.NewDescribeReviewPoliciesParams(),
+	}
+}
+
+func (*DescribeReviewPoliciesCmd) GetActionName() string {
+	return "DescribeReviewPolicies"
+}
+
+func (c *DescribeReviewPoliciesCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.DisplayColumns, "display_columns", "", []string{}, "select columns to display.")
+	c.Limit = new(int64)
+	f.Int64VarP(c.Limit, "limit", "", 20, "data limit per page, default is 20, max value is 200.")
+	c.Offset = new(int64)
+	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default is 0.")
+	f.StringSliceVarP(&c.PolicyID, "policy_id", "", []string{}, "review policy ids.")
+	c.Reverse = new(bool)
+	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
+	f.StringSliceVarP(&c.ScopeID, "scope_id", "", []string{}, "ids of category or market which the review policy applies to.")
+	f.StringSliceVarP(&c.ScopeType, "scope_type", "", []string{}, "scope types of review policy eg.[category|market].")
+	c.SortKey = new(string)
+	f.StringVarP(c.SortKey, "sort_key", "", "", "sort key, order by sort_key, default create_time.")
+}
+
+func (c *DescribeReviewPoliciesCmd) Run(out Out) error {
+	params := c.DescribeReviewPoliciesParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.DescribeReviewPolicies(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type GetAppStatisticsCmd struct {
 	*app_manager.GetAppStatisticsParams
 }
@@ -2034,6 +2188,7 @@ func (*IsvPassAppVersionCmd) GetActionName() string {
 }
 
 func (c *IsvPassAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to pass, required by PassAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to pass")
 }
 
@@ -2070,6 +2225,7 @@ func (*IsvRejectAppVersionCmd) GetActionName() string {
 
 func (c *IsvRejectAppVersionCmd) ParseFlag(f Flag) {
 	f.StringVarP(&c.Message, "message", "", "", "reject message")
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to reject, required by RejectAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to reject")
 }
 
@@ -2105,6 +2261,7 @@ func (*IsvReviewAppVersionCmd) GetActionName() string {
 }
 
 func (c *IsvReviewAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to start, required by ReviewAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to review")
 }
 
@@ -2216,6 +2373,79 @@ func (c *ModifyAppVersionCmd) Run(out Out) error {
 	return nil
 }
 
+type ModifyReviewPolicyCmd struct {
+	*models.OpenpitrixModifyReviewPolicyRequest
+}
+
+func NewModifyReviewPolicyCmd() Cmd {
+	cmd := &ModifyReviewPolicyCmd{}
+	cmd.OpenpitrixModifyReviewPolicyRequest = &models.OpenpitrixModifyReviewPolicyRequest{}
+	return cmd
+}
+
+func (*ModifyReviewPolicyCmd) GetActionName() string {
+	return "ModifyReviewPolicy"
+}
+
+func (c *ModifyReviewPolicyCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Description, "description", "", "", "review policy description")
+	f.StringVarP(&c.Name, "name", "", "", "review policy name")
+	f.StringVarP(&c.PolicyID, "policy_id", "", "", "required, id of review policy to modify")
+}
+
+func (c *ModifyReviewPolicyCmd) Run(out Out) error {
+	params := app_manager.NewModifyReviewPolicyParams()
+	params.WithBody(c.OpenpitrixModifyReviewPolicyRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.ModifyReviewPolicy(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type PassAppVersionCmd struct {
+	*models.OpenpitrixPassAppVersionRequest
+}
+
+func NewPassAppVersionCmd() Cmd {
+	cmd := &PassAppVersionCmd{}
+	cmd.OpenpitrixPassAppVersionRequest = &models.OpenpitrixPassAppVersionRequest{}
+	return cmd
+}
+
+func (*PassAppVersionCmd) GetActionName() string {
+	return "PassAppVersion"
+}
+
+func (c *PassAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to pass, required by PassAppVersion")
+	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to pass")
+}
+
+func (c *PassAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewPassAppVersionParams()
+	params.WithBody(c.OpenpitrixPassAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.PassAppVersion(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type RecoverAppVersionCmd struct {
 	*models.OpenpitrixRecoverAppVersionRequest
 }
@@ -2251,6 +2481,43 @@ func (c *RecoverAppVersionCmd) Run(out Out) error {
 	return nil
 }
 
+type RejectAppVersionCmd struct {
+	*models.OpenpitrixRejectAppVersionRequest
+}
+
+func NewRejectAppVersionCmd() Cmd {
+	cmd := &RejectAppVersionCmd{}
+	cmd.OpenpitrixRejectAppVersionRequest = &models.OpenpitrixRejectAppVersionRequest{}
+	return cmd
+}
+
+func (*RejectAppVersionCmd) GetActionName() string {
+	return "RejectAppVersion"
+}
+
+func (c *RejectAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Message, "message", "", "", "reject message")
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to reject, required by RejectAppVersion")
+	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to reject")
+}
+
+func (c *RejectAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewRejectAppVersionParams()
+	params.WithBody(c.OpenpitrixRejectAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.RejectAppVersion(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type ReleaseAppVersionCmd struct {
 	*models.OpenpitrixReleaseAppVersionRequest
 }
@@ -2286,6 +2553,42 @@ func (c *ReleaseAppVersionCmd) Run(out Out) error {
 	return nil
 }
 
+type ReviewAppVersionCmd struct {
+	*models.OpenpitrixReviewAppVersionRequest
+}
+
+func NewReviewAppVersionCmd() Cmd {
+	cmd := &ReviewAppVersionCmd{}
+	cmd.OpenpitrixReviewAppVersionRequest = &models.OpenpitrixReviewAppVersionRequest{}
+	return cmd
+}
+
+func (*ReviewAppVersionCmd) GetActionName() string {
+	return "ReviewAppVersion"
+}
+
+func (c *ReviewAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to start, required by ReviewAppVersion")
+	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to review")
+}
+
+func (c *ReviewAppVersionCmd) Run(out Out) error {
+	params := app_manager.NewReviewAppVersionParams()
+	params.WithBody(c.OpenpitrixReviewAppVersionRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.AppManager.ReviewAppVersion(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type SubmitAppVersionCmd struct {
 	*models.OpenpitrixSubmitAppVersionRequest
 }
@@ -2371,6 +2674,7 @@ func (*TechnicalPassAppVersionCmd) GetActionName() string {
 }
 
 func (c *TechnicalPassAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to pass, required by PassAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to pass")
 }
 
@@ -2407,6 +2711,7 @@ func (*TechnicalRejectAppVersionCmd) GetActionName() string {
 
 func (c *TechnicalRejectAppVersionCmd) ParseFlag(f Flag) {
 	f.StringVarP(&c.Message, "message", "", "", "reject message")
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to reject, required by RejectAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to reject")
 }
 
@@ -2442,6 +2747,7 @@ func (*TechnicalReviewAppVersionCmd) GetActionName() string {
 }
 
 func (c *TechnicalReviewAppVersionCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Stage, "stage", "", "", "stage of the review to start, required by ReviewAppVersion")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of version to review")
 }
 
@@ -2780,6 +3086,7 @@ func (*AddClusterNodesCmd) GetActionName() string {
 func (c *AddClusterNodesCmd) ParseFlag(f Flag) {
 	f.StringSliceVarP(&c.AdvancedParam, "advanced_param", "", []string{}, "advanced param")
 	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to add node")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
 	f.StringVarP(&c.Role, "role", "", "", "required, role eg:[mysql|wordpress|...]")
 }
 
@@ -2913,6 +3220,46 @@ func (c *CreateClusterCmd) Run(out Out) error {
 	return nil
 }
 
+type CreateClusterSnapshotCmd struct {
+	*models.OpenpitrixCreateClusterSnapshotRequest
+}
+
+func NewCreateClusterSnapshotCmd() Cmd {
+	cmd := &CreateClusterSnapshotCmd{}
+	cmd.OpenpitrixCreateClusterSnapshotRequest = &models.OpenpitrixCreateClusterSnapshotRequest{}
+	return cmd
+}
+
+func (*CreateClusterSnapshotCmd) GetActionName() string {
+	return "CreateClusterSnapshot"
+}
+
+func (c *CreateClusterSnapshotCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to back up")
+	f.StringVarP(&c.Description, "description", "", "", "snapshot description")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
+	f.BoolVarP(&c.Incremental, "incremental", "", false, "back up incrementally based on the last full snapshot if app supports incremental backup")
+	f.StringVarP(&c.Name, "name", "", "", "snapshot name")
+	f.StringSliceVarP(&c.Role, "role", "", []string{}, "roles to back up, default back up all the roles with backup service")
+}
+
+func (c *CreateClusterSnapshotCmd) Run(out Out) error {
+	params := cluster_manager.NewCreateClusterSnapshotParams()
+	params.WithBody(c.OpenpitrixCreateClusterSnapshotRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.CreateClusterSnapshot(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type CreateDebugClusterCmd struct {
 	*models.OpenpitrixCreateClusterRequest
 }
@@ -2990,6 +3337,44 @@ func (c *CreateKeyPairCmd) Run(out Out) error {
 	return nil
 }
 
+type CreateQuotaCmd struct {
+	*models.OpenpitrixCreateQuotaRequest
+}
+
+func NewCreateQuotaCmd() Cmd {
+	cmd := &CreateQuotaCmd{}
+	cmd.OpenpitrixCreateQuotaRequest = &models.OpenpitrixCreateQuotaRequest{}
+	return cmd
+}
+
+func (*CreateQuotaCmd) GetActionName() string {
+	return "CreateQuota"
+}
+
+func (c *CreateQuotaCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Description, "description", "", "", "quota description")
+	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "runtime id limited by quota, empty for all runtimes")
+	f.StringVarP(&c.Subject, "subject", "", "", "required, user id, group path, or prefix of owner path limited by quota")
+	f.StringVarP(&c.SubjectType, "subject_type", "", "", "required, subject type of quota eg.[user|group|owner_path]")
+}
+
+func (c *CreateQuotaCmd) Run(out Out) error {
+	params := cluster_manager.NewCreateQuotaParams()
+	params.WithBody(c.OpenpitrixCreateQuotaRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.CreateQuota(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DeleteClusterNodesCmd struct {
 	*models.OpenpitrixDeleteClusterNodesRequest
 }
@@ -3007,6 +3392,7 @@ func (*DeleteClusterNodesCmd) GetActionName() string {
 func (c *DeleteClusterNodesCmd) ParseFlag(f Flag) {
 	f.StringSliceVarP(&c.AdvancedParam, "advanced_param", "", []string{}, "advanced param")
 	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to delete node")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
 	f.StringSliceVarP(&c.NodeID, "node_id", "", []string{}, "required, node ids")
 }
 
@@ -3027,6 +3413,41 @@ func (c *DeleteClusterNodesCmd) Run(out Out) error {
 	return nil
 }
 
+type DeleteClusterSnapshotsCmd struct {
+	*models.OpenpitrixDeleteClusterSnapshotsRequest
+}
+
+func NewDeleteClusterSnapshotsCmd() Cmd {
+	cmd := &DeleteClusterSnapshotsCmd{}
+	cmd.OpenpitrixDeleteClusterSnapshotsRequest = &models.OpenpitrixDeleteClusterSnapshotsRequest{}
+	return cmd
+}
+
+func (*DeleteClusterSnapshotsCmd) GetActionName() string {
+	return "DeleteClusterSnapshots"
+}
+
+func (c *DeleteClusterSnapshotsCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.SnapshotID, "snapshot_id", "", []string{}, "required, ids of snapshots to delete, the incremental snapshots based on the full snapshots are deleted too")
+}
+
+func (c *DeleteClusterSnapshotsCmd) Run(out Out) error {
+	params := cluster_manager.NewDeleteClusterSnapshotsParams()
+	params.WithBody(c.OpenpitrixDeleteClusterSnapshotsRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DeleteClusterSnapshots(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DeleteClustersCmd struct {
 	*models.OpenpitrixDeleteClustersRequest
 }
@@ -3099,6 +3520,41 @@ func (c *DeleteKeyPairsCmd) Run(out Out) error {
 	return nil
 }
 
+type DeleteQuotasCmd struct {
+	*models.OpenpitrixDeleteQuotasRequest
+}
+
+func NewDeleteQuotasCmd() Cmd {
+	cmd := &DeleteQuotasCmd{}
+	cmd.OpenpitrixDeleteQuotasRequest = &models.OpenpitrixDeleteQuotasRequest{}
+	return cmd
+}
+
+func (*DeleteQuotasCmd) GetActionName() string {
+	return "DeleteQuotas"
+}
+
+func (c *DeleteQuotasCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.QuotaID, "quota_id", "", []string{}, "required, ids of quotas to delete")
+}
+
+func (c *DeleteQuotasCmd) Run(out Out) error {
+	params := cluster_manager.NewDeleteQuotasParams()
+	params.WithBody(c.OpenpitrixDeleteQuotasRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DeleteQuotas(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DescribeAppClustersCmd struct {
 	*cluster_manager.DescribeAppClustersParams
 }
@@ -3150,6 +3606,49 @@ func (c *DescribeAppClustersCmd) Run(out Out) error {
 	return nil
 }
 
+type DescribeClusterMonitorCmd struct {
+	*cluster_manager.DescribeClusterMonitorParams
+}
+
+func NewDescribeClusterMonitorCmd() Cmd {
+	return &DescribeClusterMonitorCmd{
+		DescribeClusterMonitorParams: cluster_manager.NewDescribeClusterMonitorParams(),
+	}
+}
+
+func (*DescribeClusterMonitorCmd) GetActionName() string {
+	return "DescribeClusterMonitor"
+}
+
+func (c *DescribeClusterMonitorCmd) ParseFlag(f Flag) {
+	c.ClusterID = new(string)
+	f.StringVarP(c.ClusterID, "cluster_id", "", "", "required, id of cluster to monitor.")
+	c.EndTime = new(string)
+	f.StringVarP(c.EndTime, "end_time", "", "", "end time of time series, default now.")
+	f.StringSliceVarP(&c.NodeID, "node_id", "", []string{}, "ids of nodes to monitor, default all nodes.")
+	f.StringSliceVarP(&c.Role, "role", "", []string{}, "roles of nodes to monitor, default all roles.")
+	c.StartTime = new(string)
+	f.StringVarP(c.StartTime, "start_time", "", "", "start time of time series, default one hour before end time.")
+	c.Step = new(int64)
+	f.Int64VarP(c.Step, "step", "", 0, "seconds between the points of time series, the values in a step are averaged, default no average.")
+}
+
+func (c *DescribeClusterMonitorCmd) Run(out Out) error {
+	params := c.DescribeClusterMonitorParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusterMonitor(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DescribeClusterNodesCmd struct {
 	*cluster_manager.DescribeClusterNodesParams
 }
@@ -3168,12 +3667,15 @@ func (c *DescribeClusterNodesCmd) ParseFlag(f Flag) {
 	c.ClusterID = new(string)
 	f.StringVarP(c.ClusterID, "cluster_id", "", "", "cluster id.")
 	f.StringSliceVarP(&c.DisplayColumns, "display_columns", "", []string{}, "select columns to display.")
+	f.StringSliceVarP(&c.HealthStatus, "health_status", "", []string{}, "health status eg.[healthy|unhealthy].")
 	c.Limit = new(int64)
 	f.Int64VarP(c.Limit, "limit", "", 20, "data limit per page, default value 20, max value 200.")
 	f.StringSliceVarP(&c.NodeID, "node_id", "", []string{}, "node ids.")
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	c.Reverse = new(bool)
 	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
 	c.SearchWord = new(string)
@@ -3199,6 +3701,101 @@ func (c *DescribeClusterNodesCmd) Run(out Out) error {
 	return nil
 }
 
+type DescribeClusterServiceAuditsCmd struct {
+	*cluster_manager.DescribeClusterServiceAuditsParams
+}
+
+func NewDescribeClusterServiceAuditsCmd() Cmd {
+	return &DescribeClusterServiceAuditsCmd{
+		DescribeClusterServiceAuditsParams: cluster_manager.NewDescribeClusterServiceAuditsParams(),
+	}
+}
+
+func (*DescribeClusterServiceAuditsCmd) GetActionName() string {
+	return "DescribeClusterServiceAudits"
+}
+
+func (c *DescribeClusterServiceAuditsCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.ClusterID, "cluster_id", "", []string{}, "ids of clusters service run on.")
+	f.StringSliceVarP(&c.ClusterServiceAuditID, "cluster_service_audit_id", "", []string{}, "cluster service audit ids.")
+	f.StringSliceVarP(&c.DisplayColumns, "display_columns", "", []string{}, "select columns to display.")
+	c.Limit = new(int64)
+	f.Int64VarP(c.Limit, "limit", "", 20, "data limit per page, default value 20, max value 200.")
+	c.Offset = new(int64)
+	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
+	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owners.")
+	c.Reverse = new(bool)
+	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
+	f.StringSliceVarP(&c.ServiceName, "service_name", "", []string{}, "names of service.")
+	c.SortKey = new(string)
+	f.StringVarP(c.SortKey, "sort_key", "", "", "sort key, order by sort_key, default create_time.")
+	f.StringSliceVarP(&c.Status, "status", "", []string{}, "status eg.[pending|successful|failed].")
+}
+
+func (c *DescribeClusterServiceAuditsCmd) Run(out Out) error {
+	params := c.DescribeClusterServiceAuditsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusterServiceAudits(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeClusterSnapshotsCmd struct {
+	*cluster_manager.DescribeClusterSnapshotsParams
+}
+
+func NewDescribeClusterSnapshotsCmd() Cmd {
+	return &DescribeClusterSnapshotsCmd{
+		DescribeClusterSnapshotsParams: cluster_manager.NewDescribeClusterSnapshotsParams(),
+	}
+}
+
+func (*DescribeClusterSnapshotsCmd) GetActionName() string {
+	return "DescribeClusterSnapshots"
+}
+
+func (c *DescribeClusterSnapshotsCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.ClusterID, "cluster_id", "", []string{}, "ids of clusters backed up.")
+	f.StringSliceVarP(&c.DisplayColumns, "display_columns", "", []string{}, "select columns to display.")
+	c.Limit = new(int64)
+	f.Int64VarP(c.Limit, "limit", "", 20, "data limit per page, default value 20, max value 200.")
+	c.Offset = new(int64)
+	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
+	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owners.")
+	f.StringSliceVarP(&c.ParentSnapshotID, "parent_snapshot_id", "", []string{}, "ids of the full snapshots which the incremental snapshots are based on.")
+	c.Reverse = new(bool)
+	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
+	f.StringSliceVarP(&c.SnapshotID, "snapshot_id", "", []string{}, "snapshot ids.")
+	f.StringSliceVarP(&c.SnapshotType, "snapshot_type", "", []string{}, "snapshot types eg.[full|incremental].")
+	c.SortKey = new(string)
+	f.StringVarP(c.SortKey, "sort_key", "", "", "sort key, order by sort_key, default create_time.")
+	f.StringSliceVarP(&c.Status, "status", "", []string{}, "status eg.[pending|active|failed|deleted].")
+}
+
+func (c *DescribeClusterSnapshotsCmd) Run(out Out) error {
+	params := c.DescribeClusterSnapshotsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeClusterSnapshots(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DescribeClustersCmd struct {
 	*cluster_manager.DescribeClustersParams
 	MinCreateTimeString *string
@@ -3232,6 +3829,8 @@ func (c *DescribeClustersCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	c.Reverse = new(bool)
 	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
 	f.StringSliceVarP(&c.RuntimeID, "runtime_id", "", []string{}, "runtime ids.")
@@ -3353,6 +3952,8 @@ func (c *DescribeDebugClustersCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	c.Reverse = new(bool)
 	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
 	f.StringSliceVarP(&c.RuntimeID, "runtime_id", "", []string{}, "runtime ids.")
@@ -3416,20 +4017,99 @@ func (c *DescribeKeyPairsCmd) ParseFlag(f Flag) {
 	f.StringVarP(c.Name, "name", "", "", "key pair name.")
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
-	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
-	c.PubKey = new(string)
-	f.StringVarP(c.PubKey, "pub_key", "", "", "public key.")
-	c.SearchWord = new(string)
-	f.StringVarP(c.SearchWord, "search_word", "", "", "query key, can filter with these fields(key_pair_id, name, owner).")
+	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PubKey = new(string)
+	f.StringVarP(c.PubKey, "pub_key", "", "", "public key.")
+	c.SearchWord = new(string)
+	f.StringVarP(c.SearchWord, "search_word", "", "", "query key, can filter with these fields(key_pair_id, name, owner).")
+}
+
+func (c *DescribeKeyPairsCmd) Run(out Out) error {
+	params := c.DescribeKeyPairsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeKeyPairs(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeQuotaUsageCmd struct {
+	*cluster_manager.DescribeQuotaUsageParams
+}
+
+func NewDescribeQuotaUsageCmd() Cmd {
+	return &DescribeQuotaUsageCmd{
+		DescribeQuotaUsageParams: cluster_manager.NewDescribeQuotaUsageParams(),
+	}
+}
+
+func (*DescribeQuotaUsageCmd) GetActionName() string {
+	return "DescribeQuotaUsage"
+}
+
+func (c *DescribeQuotaUsageCmd) ParseFlag(f Flag) {
+	c.OwnerPath = new(string)
+	f.StringVarP(c.OwnerPath, "owner_path", "", "", "owner path of clusters, default is the owner path of sender.")
+	c.RuntimeID = new(string)
+	f.StringVarP(c.RuntimeID, "runtime_id", "", "", "runtime id, default returns the usage of quotas in all runtimes.")
+}
+
+func (c *DescribeQuotaUsageCmd) Run(out Out) error {
+	params := c.DescribeQuotaUsageParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.DescribeQuotaUsage(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DescribeQuotasCmd struct {
+	*cluster_manager.DescribeQuotasParams
+}
+
+func NewDescribeQuotasCmd() Cmd {
+	return &DescribeQuotasCmd{
+		DescribeQuotasParams: cluster_manager.NewDescribeQuotasParams(),
+	}
+}
+
+func (*DescribeQuotasCmd) GetActionName() string {
+	return "DescribeQuotas"
+}
+
+func (c *DescribeQuotasCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.DisplayColumns, "display_columns", "", []string{}, "select columns to display.")
+	c.Limit = new(int64)
+	f.Int64VarP(c.Limit, "limit", "", 20, "data limit per page, default value 20, max value 200.")
+	c.Offset = new(int64)
+	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
+	f.StringSliceVarP(&c.QuotaID, "quota_id", "", []string{}, "quota ids.")
+	f.StringSliceVarP(&c.RuntimeID, "runtime_id", "", []string{}, "runtime ids of quota.")
+	f.StringSliceVarP(&c.Subject, "subject", "", []string{}, "subjects of quota.")
+	f.StringSliceVarP(&c.SubjectType, "subject_type", "", []string{}, "subject types of quota eg.[user|group|owner_path].")
 }
 
-func (c *DescribeKeyPairsCmd) Run(out Out) error {
-	params := c.DescribeKeyPairsParams
+func (c *DescribeQuotasCmd) Run(out Out) error {
+	params := c.DescribeQuotasParams
 
 	out.WriteRequest(params)
 
 	client := getClient()
-	res, err := client.ClusterManager.DescribeKeyPairs(params, nil)
+	res, err := client.ClusterManager.DescribeQuotas(params, nil)
 	if err != nil {
 		return err
 	}
@@ -3625,6 +4305,42 @@ func (c *ModifyClusterNodeAttributesCmd) Run(out Out) error {
 	return nil
 }
 
+type ModifyQuotaCmd struct {
+	*models.OpenpitrixModifyQuotaRequest
+}
+
+func NewModifyQuotaCmd() Cmd {
+	cmd := &ModifyQuotaCmd{}
+	cmd.OpenpitrixModifyQuotaRequest = &models.OpenpitrixModifyQuotaRequest{}
+	return cmd
+}
+
+func (*ModifyQuotaCmd) GetActionName() string {
+	return "ModifyQuota"
+}
+
+func (c *ModifyQuotaCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.Description, "description", "", "", "quota description")
+	f.StringVarP(&c.QuotaID, "quota_id", "", "", "required, id of quota to modify")
+}
+
+func (c *ModifyQuotaCmd) Run(out Out) error {
+	params := cluster_manager.NewModifyQuotaParams()
+	params.WithBody(c.OpenpitrixModifyQuotaRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.ModifyQuota(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type RecoverClustersCmd struct {
 	*models.OpenpitrixRecoverClustersRequest
 }
@@ -3678,6 +4394,7 @@ func (*ResizeClusterCmd) GetActionName() string {
 func (c *ResizeClusterCmd) ParseFlag(f Flag) {
 	f.StringSliceVarP(&c.AdvancedParam, "advanced_param", "", []string{}, "advanced param")
 	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to resize")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
 }
 
 func (c *ResizeClusterCmd) Run(out Out) error {
@@ -3697,6 +4414,42 @@ func (c *ResizeClusterCmd) Run(out Out) error {
 	return nil
 }
 
+type RestoreClusterFromSnapshotCmd struct {
+	*models.OpenpitrixRestoreClusterFromSnapshotRequest
+}
+
+func NewRestoreClusterFromSnapshotCmd() Cmd {
+	cmd := &RestoreClusterFromSnapshotCmd{}
+	cmd.OpenpitrixRestoreClusterFromSnapshotRequest = &models.OpenpitrixRestoreClusterFromSnapshotRequest{}
+	return cmd
+}
+
+func (*RestoreClusterFromSnapshotCmd) GetActionName() string {
+	return "RestoreClusterFromSnapshot"
+}
+
+func (c *RestoreClusterFromSnapshotCmd) ParseFlag(f Flag) {
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
+	f.StringVarP(&c.SnapshotID, "snapshot_id", "", "", "required, id of snapshot to restore cluster from")
+}
+
+func (c *RestoreClusterFromSnapshotCmd) Run(out Out) error {
+	params := cluster_manager.NewRestoreClusterFromSnapshotParams()
+	params.WithBody(c.OpenpitrixRestoreClusterFromSnapshotRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.RestoreClusterFromSnapshot(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type RollbackClusterCmd struct {
 	*models.OpenpitrixRollbackClusterRequest
 }
@@ -3714,6 +4467,7 @@ func (*RollbackClusterCmd) GetActionName() string {
 func (c *RollbackClusterCmd) ParseFlag(f Flag) {
 	f.StringSliceVarP(&c.AdvancedParam, "advanced_param", "", []string{}, "advanced param")
 	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to rollback")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
 }
 
 func (c *RollbackClusterCmd) Run(out Out) error {
@@ -3733,6 +4487,45 @@ func (c *RollbackClusterCmd) Run(out Out) error {
 	return nil
 }
 
+type RunClusterServiceCmd struct {
+	*models.OpenpitrixRunClusterServiceRequest
+}
+
+func NewRunClusterServiceCmd() Cmd {
+	cmd := &RunClusterServiceCmd{}
+	cmd.OpenpitrixRunClusterServiceRequest = &models.OpenpitrixRunClusterServiceRequest{}
+	return cmd
+}
+
+func (*RunClusterServiceCmd) GetActionName() string {
+	return "RunClusterService"
+}
+
+func (c *RunClusterServiceCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to run service on")
+	f.StringSliceVarP(&c.NodeID, "node_id", "", []string{}, "ids of nodes to run service on, the roles are ignored if nodes are given")
+	f.StringSliceVarP(&c.Role, "role", "", []string{}, "roles to run service on, default run on all the roles with the service")
+	f.StringVarP(&c.ServiceName, "service_name", "", "", "required, name of service to run, restart or the custom service defined by app")
+	f.StringVarP(&c.ServiceParams, "service_params", "", "", "params of service in json format, validated against the service params declared by app")
+}
+
+func (c *RunClusterServiceCmd) Run(out Out) error {
+	params := cluster_manager.NewRunClusterServiceParams()
+	params.WithBody(c.OpenpitrixRunClusterServiceRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ClusterManager.RunClusterService(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type StartClustersCmd struct {
 	*models.OpenpitrixStartClustersRequest
 }
@@ -3822,6 +4615,7 @@ func (*UpdateClusterEnvCmd) GetActionName() string {
 func (c *UpdateClusterEnvCmd) ParseFlag(f Flag) {
 	f.StringSliceVarP(&c.AdvancedParam, "advanced_param", "", []string{}, "advanced param")
 	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "id of cluster to update env")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
 	f.StringVarP(&c.Env, "env", "", "", "env")
 }
 
@@ -3859,6 +4653,7 @@ func (*UpgradeClusterCmd) GetActionName() string {
 func (c *UpgradeClusterCmd) ParseFlag(f Flag) {
 	f.StringSliceVarP(&c.AdvancedParam, "advanced_param", "", []string{}, "advanced param")
 	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "required, id of cluster to upgrade")
+	f.BoolVarP(&c.DryRun, "dry_run", "", false, "dry run, return the task layers the job would be split into without executing it")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "app version id")
 }
 
@@ -4123,6 +4918,41 @@ func (c *SubmitVendorVerifyInfoCmd) Run(out Out) error {
 	return nil
 }
 
+type CancelJobCmd struct {
+	*models.OpenpitrixCancelJobRequest
+}
+
+func NewCancelJobCmd() Cmd {
+	cmd := &CancelJobCmd{}
+	cmd.OpenpitrixCancelJobRequest = &models.OpenpitrixCancelJobRequest{}
+	return cmd
+}
+
+func (*CancelJobCmd) GetActionName() string {
+	return "CancelJob"
+}
+
+func (c *CancelJobCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.JobID, "job_id", "", "", "required, id of job to cancel")
+}
+
+func (c *CancelJobCmd) Run(out Out) error {
+	params := job_manager.NewCancelJobParams()
+	params.WithBody(c.OpenpitrixCancelJobRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.JobManager.CancelJob(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type DescribeJobsCmd struct {
 	*job_manager.DescribeJobsParams
 }
@@ -4151,6 +4981,8 @@ func (c *DescribeJobsCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	c.Provider = new(string)
 	f.StringVarP(c.Provider, "provider", "", "", "runtime provider eg.[qingcloud|aliyun|aws|kubernetes].")
 	c.Reverse = new(bool)
@@ -4161,7 +4993,7 @@ func (c *DescribeJobsCmd) ParseFlag(f Flag) {
 	f.StringVarP(c.SearchWord, "search_word", "", "", "query key, support these fields(job_id, cluster_id, app_id, version_id, executor, provider, status, owner).")
 	c.SortKey = new(string)
 	f.StringVarP(c.SortKey, "sort_key", "", "", "sort key, order by sort_key, default create_time.")
-	f.StringSliceVarP(&c.Status, "status", "", []string{}, "status eg.[successful|failed|running|pending].")
+	f.StringSliceVarP(&c.Status, "status", "", []string{}, "status eg.[successful|failed|running|pending|scheduled|cancelled].")
 	c.VersionID = new(string)
 	f.StringVarP(c.VersionID, "version_id", "", "", "specific app version id to filter result.")
 }
@@ -4476,8 +5308,8 @@ func (*CreateReleaseCmd) GetActionName() string {
 
 func (c *CreateReleaseCmd) ParseFlag(f Flag) {
 	f.StringVarP(&c.AppID, "app_id", "", "", "required, id of app to run in cluster")
-	f.StringVarP(&c.Namespace, "namespace", "", "", "namespace")
-	f.StringVarP(&c.ReleaseName, "release_name", "", "", "release name")
+	f.StringVarP(&c.Namespace, "namespace", "", "", "namespace, default to the zone of runtime")
+	f.StringVarP(&c.ReleaseName, "release_name", "", "", "required, release name, which is the name of cluster as well")
 	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "required, id of runtime")
 	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of app version")
 }
@@ -4514,9 +5346,10 @@ func (*DeleteReleaseCmd) GetActionName() string {
 }
 
 func (c *DeleteReleaseCmd) ParseFlag(f Flag) {
-	f.BoolVarP(&c.Purge, "purge", "", false, "")
+	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "id of kubernetes cluster")
+	f.BoolVarP(&c.Purge, "purge", "", false, "whether to delete the revision history and the cluster as well")
 	f.StringVarP(&c.ReleaseName, "release_name", "", "", "")
-	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "")
+	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "id of runtime, required with release_name if cluster_id is not set")
 }
 
 func (c *DeleteReleaseCmd) Run(out Out) error {
@@ -4536,6 +5369,80 @@ func (c *DeleteReleaseCmd) Run(out Out) error {
 	return nil
 }
 
+type DescribeReleaseHistoryCmd struct {
+	*release_manager.DescribeReleaseHistoryParams
+}
+
+func NewDescribeReleaseHistoryCmd() Cmd {
+	return &DescribeReleaseHistoryCmd{
+		DescribeReleaseHistoryParams: release_manager.NewDescribeReleaseHistoryParams(),
+	}
+}
+
+func (*DescribeReleaseHistoryCmd) GetActionName() string {
+	return "DescribeReleaseHistory"
+}
+
+func (c *DescribeReleaseHistoryCmd) ParseFlag(f Flag) {
+	c.ClusterID = new(string)
+	f.StringVarP(c.ClusterID, "cluster_id", "", "", "required, id of kubernetes cluster.")
+}
+
+func (c *DescribeReleaseHistoryCmd) Run(out Out) error {
+	params := c.DescribeReleaseHistoryParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ReleaseManager.DescribeReleaseHistory(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type DiffReleaseRevisionsCmd struct {
+	*release_manager.DiffReleaseRevisionsParams
+}
+
+func NewDiffReleaseRevisionsCmd() Cmd {
+	return &DiffReleaseRevisionsCmd{
+		DiffReleaseRevisionsParams: release_manager.NewDiffReleaseRevisionsParams(),
+	}
+}
+
+func (*DiffReleaseRevisionsCmd) GetActionName() string {
+	return "DiffReleaseRevisions"
+}
+
+func (c *DiffReleaseRevisionsCmd) ParseFlag(f Flag) {
+	c.ClusterID = new(string)
+	f.StringVarP(c.ClusterID, "cluster_id", "", "", "required, id of kubernetes cluster.")
+	c.FromVersion = new(int32)
+	f.Int32VarP(c.FromVersion, "from_version", "", 0, "required, version of revision to diff from.")
+	c.ToVersion = new(int32)
+	f.Int32VarP(c.ToVersion, "to_version", "", 0, "version of revision to diff to, default to the current revision.")
+}
+
+func (c *DiffReleaseRevisionsCmd) Run(out Out) error {
+	params := c.DiffReleaseRevisionsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.ReleaseManager.DiffReleaseRevisions(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type ListReleasesCmd struct {
 	*release_manager.ListReleasesParams
 }
@@ -4552,13 +5459,13 @@ func (*ListReleasesCmd) GetActionName() string {
 
 func (c *ListReleasesCmd) ParseFlag(f Flag) {
 	c.Namespace = new(string)
-	f.StringVarP(c.Namespace, "namespace", "", "", "")
+	f.StringVarP(c.Namespace, "namespace", "", "", "namespace, default to all namespaces.")
 	c.ReleaseName = new(string)
 	f.StringVarP(c.ReleaseName, "release_name", "", "", "")
 	c.RuntimeID = new(string)
-	f.StringVarP(c.RuntimeID, "runtime_id", "", "", "")
+	f.StringVarP(c.RuntimeID, "runtime_id", "", "", "required, id of runtime.")
 	c.Status = new(string)
-	f.StringVarP(c.Status, "status", "", "", "")
+	f.StringVarP(c.Status, "status", "", "", "release status eg.[deployed|failed|uninstalled|all], default to deployed and failed.")
 }
 
 func (c *ListReleasesCmd) Run(out Out) error {
@@ -4592,8 +5499,9 @@ func (*RollbackReleaseCmd) GetActionName() string {
 }
 
 func (c *RollbackReleaseCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "id of kubernetes cluster")
 	f.StringVarP(&c.ReleaseName, "release_name", "", "", "")
-	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "")
+	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "id of runtime, required with release_name if cluster_id is not set")
 }
 
 func (c *RollbackReleaseCmd) Run(out Out) error {
@@ -4628,9 +5536,10 @@ func (*UpgradeReleaseCmd) GetActionName() string {
 }
 
 func (c *UpgradeReleaseCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.ClusterID, "cluster_id", "", "", "id of kubernetes cluster")
 	f.StringVarP(&c.ReleaseName, "release_name", "", "", "")
-	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "")
-	f.StringVarP(&c.VersionID, "version_id", "", "", "")
+	f.StringVarP(&c.RuntimeID, "runtime_id", "", "", "id of runtime, required with release_name if cluster_id is not set")
+	f.StringVarP(&c.VersionID, "version_id", "", "", "required, id of app version to upgrade to")
 }
 
 func (c *UpgradeReleaseCmd) Run(out Out) error {
@@ -4670,6 +5579,8 @@ func (c *DescribeRepoEventsCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	f.StringSliceVarP(&c.RepoEventID, "repo_event_id", "", []string{}, "repository event ids.")
 	f.StringSliceVarP(&c.RepoID, "repo_id", "", []string{}, "repository ids.")
 	f.StringSliceVarP(&c.Status, "status", "", []string{}, "repository event status eg.[failed|successful|working|pending].")
@@ -5520,6 +6431,41 @@ func (c *ModifyRuntimeCredentialCmd) Run(out Out) error {
 	return nil
 }
 
+type RotateCredentialKeyCmd struct {
+	*models.OpenpitrixRotateCredentialKeyRequest
+}
+
+func NewRotateCredentialKeyCmd() Cmd {
+	cmd := &RotateCredentialKeyCmd{}
+	cmd.OpenpitrixRotateCredentialKeyRequest = &models.OpenpitrixRotateCredentialKeyRequest{}
+	return cmd
+}
+
+func (*RotateCredentialKeyCmd) GetActionName() string {
+	return "RotateCredentialKey"
+}
+
+func (c *RotateCredentialKeyCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.RuntimeCredentialID, "runtime_credential_id", "", []string{}, "ids of runtime credential to re-encrypt, default re-encrypt all runtime credentials")
+}
+
+func (c *RotateCredentialKeyCmd) Run(out Out) error {
+	params := runtime_manager.NewRotateCredentialKeyParams()
+	params.WithBody(c.OpenpitrixRotateCredentialKeyRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.RuntimeManager.RotateCredentialKey(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type ValidateRuntimeCredentialCmd struct {
 	*models.OpenpitrixValidateRuntimeCredentialRequest
 }
@@ -5684,6 +6630,8 @@ func (c *DescribeTasksCmd) ParseFlag(f Flag) {
 	c.Offset = new(int64)
 	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
 	f.StringSliceVarP(&c.Owner, "owner", "", []string{}, "owner.")
+	c.PageToken = new(string)
+	f.StringVarP(c.PageToken, "page_token", "", "", "page token of the next page returned by previous page, the offset is ignored if it is set.")
 	c.Reverse = new(bool)
 	f.BoolVarP(c.Reverse, "reverse", "", false, "value = 0 sort ASC, value = 1 sort DESC.")
 	c.SearchWord = new(string)
@@ -5782,6 +6730,149 @@ func (c *CreateClientCmd) Run(out Out) error {
 	return nil
 }
 
+type DescribeSessionsCmd struct {
+	*token_manager.DescribeSessionsParams
+}
+
+func NewDescribeSessionsCmd() Cmd {
+	return &DescribeSessionsCmd{
+		DescribeSessionsParams: token_manager.NewDescribeSessionsParams(),
+	}
+}
+
+func (*DescribeSessionsCmd) GetActionName() string {
+	return "DescribeSessions"
+}
+
+func (c *DescribeSessionsCmd) ParseFlag(f Flag) {
+	c.Limit = new(int64)
+	f.Int64VarP(c.Limit, "limit", "", 20, "data limit, default 20, max 200.")
+	c.Offset = new(int64)
+	f.Int64VarP(c.Offset, "offset", "", 0, "data offset, default 0.")
+	f.StringSliceVarP(&c.UserID, "user_id", "", []string{}, "user ids, default the sender.")
+}
+
+func (c *DescribeSessionsCmd) Run(out Out) error {
+	params := c.DescribeSessionsParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.TokenManager.DescribeSessions(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type LogoutCmd struct {
+	*token_manager.LogoutParams
+}
+
+func NewLogoutCmd() Cmd {
+	return &LogoutCmd{
+		LogoutParams: token_manager.NewLogoutParams(),
+	}
+}
+
+func (*LogoutCmd) GetActionName() string {
+	return "Logout"
+}
+
+func (c *LogoutCmd) ParseFlag(f Flag) {
+}
+
+func (c *LogoutCmd) Run(out Out) error {
+	params := c.LogoutParams
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.TokenManager.Logout(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type RevokeTokenCmd struct {
+	*models.OpenpitrixRevokeTokenRequest
+}
+
+func NewRevokeTokenCmd() Cmd {
+	cmd := &RevokeTokenCmd{}
+	cmd.OpenpitrixRevokeTokenRequest = &models.OpenpitrixRevokeTokenRequest{}
+	return cmd
+}
+
+func (*RevokeTokenCmd) GetActionName() string {
+	return "RevokeToken"
+}
+
+func (c *RevokeTokenCmd) ParseFlag(f Flag) {
+	f.StringVarP(&c.SessionID, "session_id", "", "", "id of session to revoke, required if token is empty")
+	f.StringVarP(&c.Token, "token", "", "", "access token or refresh token to revoke, required if session_id is empty")
+	f.StringVarP(&c.TokenTypeHint, "token_type_hint", "", "", "hint of token type to revoke.eg.[access_token or refresh_token]")
+}
+
+func (c *RevokeTokenCmd) Run(out Out) error {
+	params := token_manager.NewRevokeTokenParams()
+	params.WithBody(c.OpenpitrixRevokeTokenRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.TokenManager.RevokeToken(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
+type RevokeUserTokensCmd struct {
+	*models.OpenpitrixRevokeUserTokensRequest
+}
+
+func NewRevokeUserTokensCmd() Cmd {
+	cmd := &RevokeUserTokensCmd{}
+	cmd.OpenpitrixRevokeUserTokensRequest = &models.OpenpitrixRevokeUserTokensRequest{}
+	return cmd
+}
+
+func (*RevokeUserTokensCmd) GetActionName() string {
+	return "RevokeUserTokens"
+}
+
+func (c *RevokeUserTokensCmd) ParseFlag(f Flag) {
+	f.StringSliceVarP(&c.UserID, "user_id", "", []string{}, "required, ids of users to revoke all tokens")
+}
+
+func (c *RevokeUserTokensCmd) Run(out Out) error {
+	params := token_manager.NewRevokeUserTokensParams()
+	params.WithBody(c.OpenpitrixRevokeUserTokensRequest)
+
+	out.WriteRequest(params)
+
+	client := getClient()
+	res, err := client.TokenManager.RevokeUserTokens(params, nil)
+	if err != nil {
+		return err
+	}
+
+	out.WriteResponse(res.Payload)
+
+	return nil
+}
+
 type TokenCmd struct {
 	*models.OpenpitrixTokenRequest
 }
@@ -0,0 +1,144 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	"openpitrix.io/openpitrix/test/client/app_manager"
+	"openpitrix.io/openpitrix/test/client/cluster_manager"
+	"openpitrix.io/openpitrix/test/client/job_manager"
+	"openpitrix.io/openpitrix/test/client/repo_indexer"
+	"openpitrix.io/openpitrix/test/client/task_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+// Exporter is implemented by describe commands supporting --export,
+// which print all the pages of resources from the streaming export api
+type Exporter interface {
+	Export(out Out) error
+}
+
+// exportStream requests the streaming export api with the query of describe params,
+// and calls cb with every page of response
+func exportStream(out Out, path string, params httpRequest, cb func(decoder *json.Decoder) error) error {
+	out.WriteRequest(params)
+
+	r := &clientRequest{}
+	err := params.WriteToRequest(r, strfmt.NewFormats())
+	if err != nil {
+		return err
+	}
+	return watchStream(path, r.GetQueryParams(), cb)
+}
+
+func streamError(e *models.RuntimeStreamError) error {
+	return fmt.Errorf("[%d] %s", e.GrpcCode, e.Message)
+}
+
+func (c *DescribeJobsCmd) Export(out Out) error {
+	return exportStream(out, "/v1/jobs/export", c.DescribeJobsParams, func(decoder *json.Decoder) error {
+		var chunk job_manager.ExportJobsOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return streamError(chunk.Error)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		return out.WriteResponse(chunk.Result)
+	})
+}
+
+func (c *DescribeTasksCmd) Export(out Out) error {
+	return exportStream(out, "/v1/tasks/export", c.DescribeTasksParams, func(decoder *json.Decoder) error {
+		var chunk task_manager.ExportTasksOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return streamError(chunk.Error)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		return out.WriteResponse(chunk.Result)
+	})
+}
+
+func (c *DescribeClustersCmd) Export(out Out) error {
+	return exportStream(out, "/v1/clusters/export", c.DescribeClustersParams, func(decoder *json.Decoder) error {
+		var chunk cluster_manager.ExportClustersOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return streamError(chunk.Error)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		return out.WriteResponse(chunk.Result)
+	})
+}
+
+func (c *DescribeClusterNodesCmd) Export(out Out) error {
+	return exportStream(out, "/v1/clusters/nodes/export", c.DescribeClusterNodesParams, func(decoder *json.Decoder) error {
+		var chunk cluster_manager.ExportClusterNodesOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return streamError(chunk.Error)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		return out.WriteResponse(chunk.Result)
+	})
+}
+
+func (c *DescribeAppsCmd) Export(out Out) error {
+	return exportStream(out, "/v1/apps/export", c.DescribeAppsParams, func(decoder *json.Decoder) error {
+		var chunk app_manager.ExportAppsOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return streamError(chunk.Error)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		return out.WriteResponse(chunk.Result)
+	})
+}
+
+func (c *DescribeRepoEventsCmd) Export(out Out) error {
+	return exportStream(out, "/v1/repo_events/export", c.DescribeRepoEventsParams, func(decoder *json.Decoder) error {
+		var chunk repo_indexer.ExportRepoEventsOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return streamError(chunk.Error)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		return out.WriteResponse(chunk.Result)
+	})
+}
@@ -48,6 +48,7 @@ func newRootCmd(c string, args []string) *cobra.Command {
 	cmd.AddCommand(getValidateCmd())
 	cmd.AddCommand(getCompletionCmd())
 	cmd.AddCommand(getJwtCmd())
+	cmd.AddCommand(getRunClusterNodeCommandCmd())
 	flags.Parse(args)
 	return cmd
 }
@@ -69,6 +70,28 @@ func getCobraCmds(cmds []Cmd) (cobraCmds []*cobra.Command) {
 		f := c.Flags()
 		config.AddFlag(f, &clientConfig.ConfigPath)
 		cmd.ParseFlag(Flag{f})
+		if watcher, ok := cmd.(Watcher); ok {
+			var watch bool
+			f.BoolVarP(&watch, "watch", "w", false, "watch the changes until finished")
+			describe := run
+			run = func(out Out) error {
+				if watch {
+					return watcher.Watch(out)
+				}
+				return describe(out)
+			}
+		}
+		if exporter, ok := cmd.(Exporter); ok {
+			var export bool
+			f.BoolVarP(&export, "export", "", false, "export all the pages from the page token, limit is ignored")
+			describe := run
+			run = func(out Out) error {
+				if export {
+					return exporter.Export(out)
+				}
+				return describe(out)
+			}
+		}
 
 		cobraCmds = append(cobraCmds, c)
 	}
@@ -33,6 +33,19 @@ func getName(str string) string {
 	return strings.Replace(str, ".", "_", -1)
 }
 
+// isStreaming returns true if the operation responds a stream, which is watched
+// by the --watch flag of describe commands instead of a generated command
+func isStreaming(op *spec.Operation) bool {
+	if op.Responses == nil {
+		return false
+	}
+	resp, ok := op.Responses.StatusCodeResponses[200]
+	if !ok || resp.Schema == nil {
+		return false
+	}
+	return strings.HasPrefix(resp.Schema.Title, "Stream result of")
+}
+
 func (g *Gen) GetCmdFromOperation(op *spec.Operation) Cmd {
 	var c = Cmd{}
 	c.Action = op.ID
@@ -138,7 +151,7 @@ func (g *Gen) Parse(content []byte) {
 			path.Patch,
 		}
 		for _, op := range ops {
-			if op != nil {
+			if op != nil && !isStreaming(op) {
 				cmd := g.GetCmdFromOperation(op)
 				cmds = append(cmds, cmd)
 			}
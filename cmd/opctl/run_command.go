@@ -0,0 +1,68 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"openpitrix.io/openpitrix/pkg/client/config"
+	"openpitrix.io/openpitrix/test/client/cluster_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+func getRunClusterNodeCommandCmd() *cobra.Command {
+	body := &models.OpenpitrixRunClusterNodeCommandRequest{}
+	cmd := &cobra.Command{
+		Use:   "run_cluster_node_command [flags] -- COMMAND",
+		Short: "Run command on cluster node",
+		Long:  "Run command on cluster node by /bin/sh -c, and print the output until the command exited",
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(body.NodeID) == 0 {
+				return fmt.Errorf("[node_id] should specify")
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("command should specify")
+			}
+			body.Command = strings.Join(args, " ")
+
+			var exitCode int32
+			err := requestStream(http.MethodPost, "/v1/clusters/nodes/run_command", nil, body, func(decoder *json.Decoder) error {
+				var chunk cluster_manager.RunClusterNodeCommandOKBody
+				err := decoder.Decode(&chunk)
+				if err != nil {
+					return err
+				}
+				if chunk.Error != nil {
+					return fmt.Errorf("[%d] %s", chunk.Error.GrpcCode, chunk.Error.Message)
+				}
+				if chunk.Result == nil {
+					return nil
+				}
+				c.OutOrStdout().Write([]byte(chunk.Result.Stdout))
+				c.OutOrStderr().Write([]byte(chunk.Result.Stderr))
+				exitCode = chunk.Result.ExitCode
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if exitCode != 0 {
+				return fmt.Errorf("command exited with code [%d]", exitCode)
+			}
+			return nil
+		},
+	}
+	f := cmd.Flags()
+	config.AddFlag(f, &clientConfig.ConfigPath)
+	f.StringVarP(&body.NodeID, "node_id", "n", "", "required, id of cluster node to run command on")
+	f.Int64Var(&body.TimeoutSeconds, "timeout_seconds", 0, "seconds to kill the command, no timeout if not set")
+
+	return cmd
+}
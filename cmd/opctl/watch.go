@@ -0,0 +1,175 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"openpitrix.io/openpitrix/pkg/client/config"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/test/client/job_manager"
+	"openpitrix.io/openpitrix/test/models"
+)
+
+// Watcher is implemented by commands supporting --watch,
+// which keep printing the changes of resource until it finished
+type Watcher interface {
+	Watch(out Out) error
+}
+
+// watchStream requests the streaming api and calls cb with every chunk of response
+func watchStream(path string, query url.Values, cb func(decoder *json.Decoder) error) error {
+	return requestStream(http.MethodGet, path, query, nil, cb)
+}
+
+// requestStream is watchStream with method and json body of request
+func requestStream(method, path string, query url.Values, body httpBody, cb func(decoder *json.Decoder) error) error {
+	endpoint := clientConfig.GetEndpoint()
+	client, err := config.GetClient(context.Background(), clientConfig.ConfigPath)
+	if err != nil {
+		return err
+	}
+	u := url.URL{
+		Scheme:   endpoint.Scheme,
+		Host:     endpoint.Host,
+		Path:     path,
+		RawQuery: query.Encode(),
+	}
+	var reqBody io.Reader
+	if body != nil {
+		b, err := body.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("[%s] %s", resp.Status, string(body))
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		err = cb(decoder)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *DescribeJobsCmd) Watch(out Out) error {
+	if len(c.JobID) != 1 {
+		return fmt.Errorf("[job_id] should specify one job to watch")
+	}
+	params := job_manager.NewWatchJobParams()
+	params.WithJobID(&c.JobID[0])
+	out.WriteRequest(params)
+
+	tree := &jobTree{}
+	return watchStream("/v1/jobs/watch", url.Values{"job_id": c.JobID}, func(decoder *json.Decoder) error {
+		var chunk job_manager.WatchJobOKBody
+		err := decoder.Decode(&chunk)
+		if err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			return fmt.Errorf("[%d] %s", chunk.Error.GrpcCode, chunk.Error.Message)
+		}
+		if chunk.Result == nil {
+			return nil
+		}
+		tree.update(chunk.Result)
+		out.out.Write([]byte(tree.render(chunk.Result)))
+		return nil
+	})
+}
+
+// jobTree is the live task tree of watched job
+type jobTree struct {
+	job          *models.OpenpitrixJob
+	layers       [][]*models.OpenpitrixTask
+	currentLayer int
+}
+
+func (t *jobTree) update(event *models.OpenpitrixWatchJobResponse) {
+	switch event.EventType {
+	case constants.JobEventSnapshot:
+		t.job = event.Job
+		t.layers = nil
+		for layer := event.TaskLayer; layer != nil; layer = layer.Child {
+			t.layers = append(t.layers, layer.Tasks)
+		}
+	case constants.JobEventJobStatus:
+		t.job = event.Job
+	case constants.JobEventTaskLayer:
+		t.currentLayer = int(event.LayerIndex)
+	case constants.JobEventTaskStatus:
+		if event.Task != nil {
+			t.updateTask(event.Task)
+		}
+	}
+}
+
+func (t *jobTree) updateTask(task *models.OpenpitrixTask) {
+	for _, tasks := range t.layers {
+		for i, current := range tasks {
+			// tasks split from job are not sent when job started to watch
+			if current.TaskID == task.TaskID ||
+				(current.TaskID == "" && current.TaskAction == task.TaskAction && current.NodeID == task.NodeID) {
+				tasks[i] = task
+				return
+			}
+		}
+	}
+	for len(t.layers) <= t.currentLayer {
+		t.layers = append(t.layers, nil)
+	}
+	t.layers[t.currentLayer] = append(t.layers[t.currentLayer], task)
+}
+
+func (t *jobTree) render(event *models.OpenpitrixWatchJobResponse) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "------ %s [%s] ------\n", event.EventTime, event.EventType)
+	if event.ErrorMessage != "" {
+		fmt.Fprintf(&b, "error: %s\n", event.ErrorMessage)
+	}
+	if t.job != nil {
+		fmt.Fprintf(&b, "[%s] %s %s\n", t.job.Status, t.job.JobID, t.job.JobAction)
+	}
+	for i, tasks := range t.layers {
+		fmt.Fprintf(&b, "  layer %d\n", i)
+		for _, task := range tasks {
+			status := task.Status
+			if status == "" {
+				status = constants.StatusPending
+			}
+			fmt.Fprintf(&b, "    [%s] %s %s %s\n", status, task.TaskID, task.TaskAction, task.NodeID)
+		}
+	}
+	return b.String()
+}
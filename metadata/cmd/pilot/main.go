@@ -12,15 +12,20 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"regexp"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/urfave/cli"
 
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
 	"openpitrix.io/openpitrix/pkg/logger"
+	pbpilot "openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
 	"openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/service/metadata/pilot"
 	"openpitrix.io/openpitrix/pkg/service/metadata/pilot/pilotutil"
 	"openpitrix.io/openpitrix/pkg/util/pathutil"
@@ -299,6 +304,11 @@ EXAMPLE:
 				cli.IntFlag{
 					Name:  "timeout",
 					Value: 3,
+					Usage: "set timeout seconds, no timeout if 0 with --stream",
+				},
+				cli.BoolFlag{
+					Name:  "stream",
+					Usage: "print the output while the command is running, exit with the exit code of command",
 				},
 			},
 
@@ -315,6 +325,36 @@ EXAMPLE:
 				}
 				defer conn.Close()
 
+				if c.Bool("stream") {
+					req := &pbtypes.RunCommandStreamRequest{
+						Command:        strings.Join(c.Args(), " "),
+						TimeoutSeconds: int32(c.Int("timeout")),
+					}
+					switch s := c.String("endpoint-type"); s {
+					case "frontgate":
+						req.FrontgateEndpoint = &pbtypes.FrontgateEndpoint{
+							FrontgateId:     c.String("frontgate-id"),
+							FrontgateNodeId: c.String("frontgate-node-id"),
+						}
+					case "drone":
+						req.DroneEndpoint = &pbtypes.DroneEndpoint{
+							FrontgateId: c.String("frontgate-id"),
+							DroneIp:     c.String("drone-host"),
+							DronePort:   int32(c.Int("drone-port")),
+						}
+					default:
+						logger.Critical(nil, "unknown endpoint type: %s\n", s)
+						os.Exit(1)
+					}
+
+					exitCode, err := runCommandStream(client, req)
+					if err != nil {
+						logger.Critical(nil, "%+v", err)
+						os.Exit(1)
+					}
+					os.Exit(exitCode)
+				}
+
 				switch s := c.String("endpoint-type"); s {
 				case "frontgate":
 					_, err = client.RunCommandOnFrontgateNode(context.Background(), &pbtypes.RunCommandOnFrontgateRequest{
@@ -639,6 +679,38 @@ EXAMPLE:
 		{
 			Name:  "serve",
 			Usage: "run as pilot service",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:   "etcd-endpoints",
+					Value:  "",
+					Usage:  "etcd endpoints to share sub task status and frontgates between pilot replicas, e.g. openpitrix-etcd:2379",
+					EnvVar: "OPENPITRIX_ETCD_ENDPOINTS",
+				},
+				cli.StringFlag{
+					Name:   "advertise-host",
+					Value:  "",
+					Usage:  "host of this pilot replica reachable by other replicas, default is the host in config",
+					EnvVar: "OPENPITRIX_PILOT_ADVERTISE_HOST",
+				},
+				cli.DurationFlag{
+					Name:   "task-status-ttl",
+					Value:  pilot.DefaultTaskStatusTTL,
+					Usage:  "how long the sub task status is kept",
+					EnvVar: "OPENPITRIX_PILOT_TASK_STATUS_TTL",
+				},
+				cli.StringFlag{
+					Name:   "metrics-store",
+					Value:  pilot.LocalMetricsStore,
+					Usage:  "store of the monitor metrics reported by drones",
+					EnvVar: "OPENPITRIX_PILOT_METRICS_STORE",
+				},
+				cli.DurationFlag{
+					Name:   "metrics-retention",
+					Value:  pilot.DefaultMetricsRetention,
+					Usage:  "how long the monitor metrics are kept",
+					EnvVar: "OPENPITRIX_PILOT_METRICS_RETENTION",
+				},
+			},
 
 			Action: func(c *cli.Context) {
 				cfgpath := pathutil.MakeAbsPath(c.GlobalString("config"))
@@ -657,7 +729,28 @@ EXAMPLE:
 					os.Exit(1)
 				}
 
-				pilot.Serve(cfg, pbPilotTLSConfig)
+				if host := c.String("advertise-host"); host != "" {
+					cfg.Host = host
+				}
+
+				metricsStore, err := pilot.NewMetricsStore(c.String("metrics-store"), c.Duration("metrics-retention"))
+				if err != nil {
+					logger.Critical(nil, "%+v", err)
+					os.Exit(1)
+				}
+
+				endpoints := c.String("etcd-endpoints")
+				if endpoints == "" {
+					pilot.Serve(cfg, pbPilotTLSConfig, metricsStore)
+					return
+				}
+
+				e, err := etcd.Connect(strings.Split(endpoints, ","), pi.EtcdPrefix)
+				if err != nil {
+					logger.Critical(nil, "%+v", err)
+					os.Exit(1)
+				}
+				pilot.ServeWithEtcd(cfg, pbPilotTLSConfig, e, c.Duration("task-status-ttl"), metricsStore)
 				return
 			},
 		},
@@ -742,3 +835,36 @@ pilot serve
 GOOS=windows pilot list
 LIBCONFD_GOOS=windows pilot list
 `
+
+// runCommandStream prints the output of command until it exited,
+// the command is canceled when interrupted
+func runCommandStream(client pbpilot.PilotServiceClient, req *pbtypes.RunCommandStreamRequest) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	stream, err := client.RunCommandStream(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		out, err := stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		os.Stdout.Write(out.GetStdout())
+		os.Stderr.Write(out.GetStderr())
+		if out.GetExited() {
+			if out.GetError() != "" {
+				return 0, fmt.Errorf("%s", out.GetError())
+			}
+			return int(out.GetExitCode()), nil
+		}
+	}
+}
@@ -4,9 +4,31 @@
 COMMAND="/usr/local/bin/drone"
 ARGV="-config=/opt/openpitrix/conf/drone.conf"
 
+VERSION_FILE="/opt/openpitrix/conf/pilot-version"
+PREVIOUS_VERSION_FILE="/opt/openpitrix/conf/pilot-version.previous"
+PENDING_VERSION_FILE="/opt/openpitrix/conf/pilot-version.pending"
+MAX_PENDING_STARTS=3
 
-if [ -f "/opt/openpitrix/conf/pilot-version" ]; then
-	PILOT_VERSION=$(cat /opt/openpitrix/conf/pilot-version)
+
+# rollback if the updated drone fails to start several times
+if [ -f "${PENDING_VERSION_FILE}" ]; then
+	STARTS=$(cat ${PENDING_VERSION_FILE})
+	STARTS=$((${STARTS:-0}+1))
+
+	if [ ${STARTS} -gt ${MAX_PENDING_STARTS} ]; then
+		if [ -s "${PREVIOUS_VERSION_FILE}" ]; then
+			cp ${PREVIOUS_VERSION_FILE} ${VERSION_FILE}
+		else
+			rm -f ${VERSION_FILE}
+		fi
+		rm -f ${PENDING_VERSION_FILE}
+	else
+		echo ${STARTS} > ${PENDING_VERSION_FILE}
+	fi
+fi
+
+if [ -f "${VERSION_FILE}" ]; then
+	PILOT_VERSION=$(cat ${VERSION_FILE})
 
 	if [ -f "/opt/openpitrix/bin/${PILOT_VERSION}/drone" ]; then
 		COMMAND="/opt/openpitrix/bin/${PILOT_VERSION}/drone"
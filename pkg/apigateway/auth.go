@@ -5,6 +5,7 @@
 package apigateway
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -14,6 +15,8 @@ import (
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/revocation"
 	"openpitrix.io/openpitrix/pkg/sender"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
 	"openpitrix.io/openpitrix/pkg/util/jwtutil"
@@ -27,7 +30,33 @@ var (
 	accessClient, _ = access.NewClient()
 )
 
-func httpAuth(mux *runtime.ServeMux, key string) http.Handler {
+// canDo checks the permission by account service if the decision is not cached,
+// cache is nil if caching is disabled.
+func canDo(ctx context.Context, cache *canDoCache, key canDoKey) (*pb.CanDoResponse, error) {
+	if cache == nil {
+		return accessClient.CanDo(ctx, &pb.CanDoRequest{
+			UserId:    key.UserId,
+			Url:       key.Url,
+			UrlMethod: key.UrlMethod,
+		})
+	}
+	if v, ok := cache.Get(key); ok {
+		return v, nil
+	}
+	generation := cache.Generation()
+	v, err := accessClient.CanDo(ctx, &pb.CanDoRequest{
+		UserId:    key.UserId,
+		Url:       key.Url,
+		UrlMethod: key.UrlMethod,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cache.Add(key, v, generation)
+	return v, nil
+}
+
+func httpAuth(mux *runtime.ServeMux, key string, cache *canDoCache) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 
 		if req.URL.Path == "/v1/oauth2/token" {
@@ -46,7 +75,7 @@ func httpAuth(mux *runtime.ServeMux, key string) http.Handler {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		s, err := jwtutil.Validate(key, auth[1])
+		claims, err := jwtutil.ValidateClaims(key, auth[1])
 		if err != nil {
 			if err == jwtutil.ErrExpired {
 				err = gerr.New(ctx, gerr.Unauthenticated, gerr.ErrorAccessTokenExpired)
@@ -56,8 +85,20 @@ func httpAuth(mux *runtime.ServeMux, key string) http.Handler {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		revoked, err := revocation.IsRevoked(ctx, pi.Global().Etcd(ctx), claims)
+		if err != nil {
+			err = gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		if revoked {
+			err = gerr.New(ctx, gerr.Unauthenticated, gerr.ErrorAccessTokenRevoked)
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		s := &sender.Sender{UserId: claims.UserId, SessionId: claims.SessionId}
 
-		v, err := accessClient.CanDo(ctx, &pb.CanDoRequest{
+		v, err := canDo(ctx, cache, canDoKey{
 			UserId:    s.UserId,
 			Url:       req.URL.Path,
 			UrlMethod: req.Method,
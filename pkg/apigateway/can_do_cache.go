@@ -0,0 +1,123 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package apigateway
+
+import (
+	"context"
+	"expvar"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/topic"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// canDoCacheMetrics is exposed in /debug/vars of the debug port
+var canDoCacheMetrics = expvar.NewMap("can_do_cache")
+
+type canDoKey struct {
+	UserId    string
+	Url       string
+	UrlMethod string
+}
+
+type canDoEntry struct {
+	response   *pb.CanDoResponse
+	expireTime time.Time
+}
+
+// canDoCache caches the allowed decisions of CanDo, a denied request fails with error
+// and is never cached, so it is checked again. Decisions expire after ttl and are
+// invalidated by the access events pushed when the permissions of users changed.
+type canDoCache struct {
+	cache *lru.Cache
+	ttl   time.Duration
+	// generation is increased when decisions are invalidated, so that the decisions
+	// requested before the invalidation will not be cached
+	generation uint64
+}
+
+func newCanDoCache(size int, ttl time.Duration) (*canDoCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	c := &canDoCache{
+		cache: cache,
+		ttl:   ttl,
+	}
+	canDoCacheMetrics.Set("size", expvar.Func(func() interface{} {
+		return c.cache.Len()
+	}))
+	return c, nil
+}
+
+func (c *canDoCache) Generation() uint64 {
+	return atomic.LoadUint64(&c.generation)
+}
+
+func (c *canDoCache) Get(key canDoKey) (*pb.CanDoResponse, bool) {
+	value, ok := c.cache.Get(key)
+	if ok {
+		entry := value.(*canDoEntry)
+		if time.Now().Before(entry.expireTime) {
+			canDoCacheMetrics.Add("hits", 1)
+			return entry.response, true
+		}
+		c.cache.Remove(key)
+	}
+	canDoCacheMetrics.Add("misses", 1)
+	return nil, false
+}
+
+// Add caches the decision requested at the generation, it is dropped if invalidated since then
+func (c *canDoCache) Add(key canDoKey, response *pb.CanDoResponse, generation uint64) {
+	if c.Generation() != generation {
+		return
+	}
+	evicted := c.cache.Add(key, &canDoEntry{
+		response:   response,
+		expireTime: time.Now().Add(c.ttl),
+	})
+	if evicted {
+		canDoCacheMetrics.Add("evictions", 1)
+	}
+}
+
+// Invalidate removes the decisions of users, or all decisions if userIds is empty
+func (c *canDoCache) Invalidate(userIds []string) {
+	atomic.AddUint64(&c.generation, 1)
+	canDoCacheMetrics.Add("invalidations", 1)
+	if len(userIds) == 0 {
+		c.cache.Purge()
+		return
+	}
+	for _, key := range c.cache.Keys() {
+		if stringutil.StringIn(key.(canDoKey).UserId, userIds) {
+			c.cache.Remove(key)
+		}
+	}
+}
+
+// Watch invalidates decisions by access events until ctx is done
+func (c *canDoCache) Watch(ctx context.Context, e *etcd.Etcd) {
+	for {
+		for event := range topic.WatchAccessEvents(ctx, e) {
+			logger.Debug(ctx, "Invalidate CanDo decisions of users [%v]", event.UserIds)
+			c.Invalidate(event.UserIds)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		// events may be lost when the watch failed
+		c.Invalidate(nil)
+		time.Sleep(time.Second)
+	}
+}
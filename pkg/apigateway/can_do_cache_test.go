@@ -0,0 +1,62 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package apigateway
+
+import (
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/pb"
+)
+
+func TestCanDoCache(t *testing.T) {
+	cache, err := newCanDoCache(2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key1 := canDoKey{UserId: "usr-1", Url: "/v1/apps", UrlMethod: "GET"}
+	key2 := canDoKey{UserId: "usr-2", Url: "/v1/apps", UrlMethod: "GET"}
+
+	if _, ok := cache.Get(key1); ok {
+		t.Errorf("Decision should not be cached")
+	}
+	cache.Add(key1, &pb.CanDoResponse{UserId: "usr-1"}, cache.Generation())
+	cache.Add(key2, &pb.CanDoResponse{UserId: "usr-2"}, cache.Generation())
+	v, ok := cache.Get(key1)
+	if !ok || v.UserId != "usr-1" {
+		t.Errorf("Wrong decision [%+v] cached", v)
+	}
+
+	cache.Invalidate([]string{"usr-1"})
+	if _, ok := cache.Get(key1); ok {
+		t.Errorf("Decision of invalidated user should be removed")
+	}
+	if _, ok := cache.Get(key2); !ok {
+		t.Errorf("Decision of other users should be kept")
+	}
+
+	// decision requested before invalidation is not cached
+	generation := cache.Generation()
+	cache.Invalidate(nil)
+	cache.Add(key1, &pb.CanDoResponse{UserId: "usr-1"}, generation)
+	if _, ok := cache.Get(key1); ok {
+		t.Errorf("Decision requested before invalidation should not be cached")
+	}
+	if _, ok := cache.Get(key2); ok {
+		t.Errorf("All decisions should be removed")
+	}
+}
+
+func TestCanDoCacheExpired(t *testing.T) {
+	cache, err := newCanDoCache(2, -time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := canDoKey{UserId: "usr-1", Url: "/v1/apps", UrlMethod: "GET"}
+	cache.Add(key, &pb.CanDoResponse{UserId: "usr-1"}, cache.Generation())
+	if _, ok := cache.Get(key); ok {
+		t.Errorf("Expired decision should not be returned")
+	}
+}
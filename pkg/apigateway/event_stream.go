@@ -0,0 +1,40 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package apigateway
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+const EventStreamMIME = "text/event-stream"
+
+// eventStreamMarshaler writes each message of streaming api as a Server-Sent Event,
+// it is used when request accepts "text/event-stream", otherwise the messages
+// are written as chunked json separated by newline.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+func newEventStreamMarshaler() *eventStreamMarshaler {
+	return &eventStreamMarshaler{
+		JSONPb: runtime.JSONPb{OrigName: true},
+	}
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), data...), nil
+}
+
+func (m *eventStreamMarshaler) ContentType() string {
+	return EventStreamMIME
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
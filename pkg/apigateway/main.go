@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -161,9 +162,23 @@ func (s *Server) run() error {
 	r.Any("/api/*filepath", mainHandler)
 	r.Any("/attachments/*filepath", gin.WrapH(ServeAttachments("/attachments/")))
 
+	// internal variables are not exposed on the public port
+	go s.runDebug()
+
 	return r.Run(fmt.Sprintf(":%d", constants.ApiGatewayPort))
 }
 
+func (s *Server) runDebug() {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	addr := fmt.Sprintf("127.0.0.1:%d", constants.ApiGatewayDebugPort)
+	logger.Info(nil, "Api gateway debug service http://%s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		logger.Error(nil, "Api gateway debug service exited: %+v", err)
+	}
+}
+
 func (s *Server) mainHandler() http.Handler {
 	var gwmux = runtime.NewServeMux(
 		runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
@@ -172,6 +187,7 @@ func (s *Server) mainHandler() http.Handler {
 				RequestIdKey, req.Header.Get(RequestIdKey),
 			)
 		}),
+		runtime.WithMarshalerOption(EventStreamMIME, newEventStreamMarshaler()),
 	)
 	var opts = manager.ClientOptions
 	var err error
@@ -218,6 +234,9 @@ func (s *Server) mainHandler() http.Handler {
 	}, {
 		pb.RegisterIsvManagerHandlerFromEndpoint,
 		fmt.Sprintf("%s:%d", constants.IsvManagerHost, constants.IsvManagerPort),
+	}, {
+		pb.RegisterReleaseManagerHandlerFromEndpoint,
+		fmt.Sprintf("%s:%d", constants.ReleaseManagerHost, constants.ReleaseManagerPort),
 	}, {
 		pb.RegisterServiceConfigHandlerFromEndpoint,
 		fmt.Sprintf("localhost:%d", constants.ServiceConfigPort),
@@ -233,7 +252,17 @@ func (s *Server) mainHandler() http.Handler {
 	tm := topic.NewTopicManager(pi.Global().Etcd(nil))
 	go tm.Run()
 
-	mux.Handle("/", httpAuth(gwmux, s.IAMConfig.SecretKey))
+	var cache *canDoCache
+	if s.IAMConfig.CanDoCacheSize > 0 {
+		cache, err = newCanDoCache(s.IAMConfig.CanDoCacheSize, s.IAMConfig.CanDoCacheTTL)
+		if err != nil {
+			logger.Critical(nil, "Create CanDo cache failed: %+v", err)
+			panic(err)
+		}
+		go cache.Watch(context.Background(), pi.Global().Etcd(nil))
+	}
+
+	mux.Handle("/", httpAuth(gwmux, s.IAMConfig.SecretKey, cache))
 	mux.HandleFunc("/v1/io", tm.HandleEvent(s.IAMConfig.SecretKey))
 
 	return formWrapper(mux)
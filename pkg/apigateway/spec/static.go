@@ -484,6 +484,134 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/oauth2/logout": {
+      "post": {
+        "summary": "Logout",
+        "operationId": "Logout",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixLogoutResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixLogoutRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenManager"
+        ]
+      }
+    },
+    "/v1/oauth2/revoke": {
+      "post": {
+        "summary": "Revoke token",
+        "description": "Ref: https://tools.ietf.org/html/rfc7009\n\nRevoke token",
+        "operationId": "RevokeToken",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixRevokeTokenResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixRevokeTokenRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenManager"
+        ]
+      }
+    },
+    "/v1/oauth2/revoke_user_tokens": {
+      "post": {
+        "summary": "Revoke all tokens of users",
+        "operationId": "RevokeUserTokens",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixRevokeUserTokensResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixRevokeUserTokensRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenManager"
+        ]
+      }
+    },
+    "/v1/oauth2/sessions": {
+      "get": {
+        "summary": "Get sessions",
+        "operationId": "DescribeSessions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeSessionsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "user_id",
+            "description": "user ids, default the sender.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "description": "data limit, default 20, max 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "TokenManager"
+        ]
+      }
+    },
     "/v1/oauth2/token": {
       "post": {
         "summary": "Get users, include user info of role and group, filter with fields(user_id, email, phone_number, status), default return all users",
@@ -1603,6 +1731,13 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -1691,6 +1826,33 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/app_version/action/pass": {
+      "post": {
+        "summary": "Reviewer of the stage pass version of the app",
+        "operationId": "PassAppVersion",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixPassAppVersionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixPassAppVersionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
     "/v1/app_version/action/pass/admin": {
       "post": {
         "summary": "Operator of admin pass version of the app",
@@ -1826,6 +1988,33 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/app_version/action/reject": {
+      "post": {
+        "summary": "Reviewer of the stage reject version of the app",
+        "operationId": "RejectAppVersion",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixRejectAppVersionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixRejectAppVersionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
     "/v1/app_version/action/reject/admin": {
       "post": {
         "summary": "Operator of Admin reject version of the app",
@@ -1961,6 +2150,33 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/app_version/action/review": {
+      "post": {
+        "summary": "Reviewer of the stage review version of the app",
+        "operationId": "ReviewAppVersion",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixReviewAppVersionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixReviewAppVersionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
     "/v1/app_version/action/review/business": {
       "post": {
         "summary": "Operator of business review version of the app",
@@ -2760,6 +2976,13 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -2842,103 +3065,235 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/apps/statistics": {
-      "get": {
-        "summary": "Get statistics info of apps",
-        "operationId": "GetAppStatistics",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/openpitrixGetAppStatisticsResponse"
-            }
-          }
-        },
-        "tags": [
-          "AppManager"
-        ]
-      }
-    },
-    "/v1/apps/validate_package": {
-      "post": {
-        "summary": "Validate format of package(pack by op tool)",
-        "operationId": "ValidatePackage",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/openpitrixValidatePackageResponse"
-            }
-          }
-        },
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixValidatePackageRequest"
-            }
-          }
-        ],
-        "tags": [
-          "AppManager"
-        ]
-      }
-    },
-    "/v1/attachments": {
+    "/v1/apps/export": {
       "get": {
-        "summary": "Get attachment, use attachment id to get attachment",
-        "operationId": "GetAttachment",
+        "summary": "Export apps, stream all the apps page by page for bulk reporting",
+        "operationId": "ExportApps",
         "responses": {
           "200": {
-            "description": "A successful response.",
+            "description": "A successful response.(streaming responses)",
             "schema": {
-              "$ref": "#/definitions/openpitrixGetAttachmentResponse"
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixDescribeAppsResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixDescribeAppsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "attachment_id",
-            "description": "required, use attachment id to get attachment.",
+            "name": "search_word",
+            "description": "query key, support these fields(app_id, name, repo_id, description, status, home, icon, screenshots, maintainers, sources, readme, owner, chart_name).",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "filename",
-            "description": "filename, attachment contain one more file.",
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
             "in": "query",
             "required": false,
             "type": "string"
-          }
-        ],
-        "tags": [
-          "AttachmentService"
-        ]
-      }
-    },
-    "/v1/categories": {
-      "get": {
-        "summary": "Get categories, support filter with these fields(category_id, status, locale, owner, name), default return all categories",
-        "operationId": "DescribeCategories",
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default is 20, max value is 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default is 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "app_id",
+            "description": "app ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "name",
+            "description": "app name.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "repo_id",
+            "description": "app repository ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "description": "app status eg.[modify|submit|review|cancel|release|delete|pass|reject|suspend|recover].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "description": "app owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "chart_name",
+            "description": "app chart name.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "category_id",
+            "description": "app category ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "display_columns",
+            "description": "select column to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "isv",
+            "description": "isv.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/v1/apps/statistics": {
+      "get": {
+        "summary": "Get statistics info of apps",
+        "operationId": "GetAppStatistics",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeCategoriesResponse"
+              "$ref": "#/definitions/openpitrixGetAppStatisticsResponse"
+            }
+          }
+        },
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/v1/apps/validate_package": {
+      "post": {
+        "summary": "Validate format of package(pack by op tool)",
+        "operationId": "ValidatePackage",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixValidatePackageResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "search_word",
-            "description": "query key, can fields with these fields(category_id, status, locale, owner, name), default return all categories.",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixValidatePackageRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AppManager"
+        ]
+      }
+    },
+    "/v1/review_policies": {
+      "get": {
+        "summary": "Get review policies, can filter with these fields(policy_id, scope_type, scope_id)",
+        "operationId": "DescribeReviewPolicies",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeReviewPoliciesResponse"
+            }
+          }
+        },
+        "parameters": [
           {
             "name": "sort_key",
             "description": "sort key, order by sort_key, default create_time.",
@@ -2956,7 +3311,7 @@ var Files = map[string]string{
           },
           {
             "name": "limit",
-            "description": "data limit per page, default value 20, max value 200.",
+            "description": "data limit per page, default is 20, max value is 200.",
             "in": "query",
             "required": false,
             "type": "integer",
@@ -2964,15 +3319,15 @@ var Files = map[string]string{
           },
           {
             "name": "offset",
-            "description": "data offset, default 0.",
+            "description": "data offset, default is 0.",
             "in": "query",
             "required": false,
             "type": "integer",
             "format": "int64"
           },
           {
-            "name": "display_columns",
-            "description": "select column to display.",
+            "name": "policy_id",
+            "description": "review policy ids.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -2982,8 +3337,8 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "category_id",
-            "description": "category ids.",
+            "name": "scope_type",
+            "description": "scope types of review policy eg.[category|market].",
             "in": "query",
             "required": false,
             "type": "array",
@@ -2993,8 +3348,8 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "name",
-            "description": "category name.",
+            "name": "scope_id",
+            "description": "ids of category or market which the review policy applies to.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3004,8 +3359,8 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "owner",
-            "description": "owner.",
+            "name": "display_columns",
+            "description": "select columns to display.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3016,17 +3371,17 @@ var Files = map[string]string{
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       },
       "delete": {
-        "summary": "Batch delete categories",
-        "operationId": "DeleteCategories",
+        "summary": "Batch delete review policies",
+        "operationId": "DeleteReviewPolicies",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteCategoriesResponse"
+              "$ref": "#/definitions/openpitrixDeleteReviewPoliciesResponse"
             }
           }
         },
@@ -3036,22 +3391,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteCategoriesRequest"
+              "$ref": "#/definitions/openpitrixDeleteReviewPoliciesRequest"
             }
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       },
       "post": {
-        "summary": "Create category",
-        "operationId": "CreateCategory",
+        "summary": "Create review policy, which replaces the default review stages of the apps in category or market",
+        "operationId": "CreateReviewPolicy",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateCategoryResponse"
+              "$ref": "#/definitions/openpitrixCreateReviewPolicyResponse"
             }
           }
         },
@@ -3061,22 +3416,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateCategoryRequest"
+              "$ref": "#/definitions/openpitrixCreateReviewPolicyRequest"
             }
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       },
       "patch": {
-        "summary": "Modify category",
-        "operationId": "ModifyCategory",
+        "summary": "Modify review policy",
+        "operationId": "ModifyReviewPolicy",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyCategoryResponse"
+              "$ref": "#/definitions/openpitrixModifyReviewPolicyResponse"
             }
           }
         },
@@ -3086,57 +3441,266 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyCategoryRequest"
+              "$ref": "#/definitions/openpitrixModifyReviewPolicyRequest"
             }
           }
         ],
         "tags": [
-          "CategoryManager"
+          "AppManager"
         ]
       }
     },
-    "/v1/clusters": {
+    "/v1/attachments": {
       "get": {
-        "summary": "Get clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all clusters",
-        "operationId": "DescribeClusters",
+        "summary": "Get attachment, use attachment id to get attachment",
+        "operationId": "GetAttachment",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeClustersResponse"
+              "$ref": "#/definitions/openpitrixGetAttachmentResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "cluster_id",
-            "description": "cluster ids.",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "app_id",
-            "description": "app ids.",
+            "name": "attachment_id",
+            "description": "required, use attachment id to get attachment.",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "string"
           },
           {
-            "name": "version_id",
-            "description": "version ids.",
+            "name": "filename",
+            "description": "filename, attachment contain one more file.",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "AttachmentService"
+        ]
+      }
+    },
+    "/v1/categories": {
+      "get": {
+        "summary": "Get categories, support filter with these fields(category_id, status, locale, owner, name), default return all categories",
+        "operationId": "DescribeCategories",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeCategoriesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "description": "query key, can fields with these fields(category_id, status, locale, owner, name), default return all categories.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select column to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "category_id",
+            "description": "category ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "name",
+            "description": "category name.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          }
+        ],
+        "tags": [
+          "CategoryManager"
+        ]
+      },
+      "delete": {
+        "summary": "Batch delete categories",
+        "operationId": "DeleteCategories",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteCategoriesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteCategoriesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "CategoryManager"
+        ]
+      },
+      "post": {
+        "summary": "Create category",
+        "operationId": "CreateCategory",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateCategoryResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateCategoryRequest"
+            }
+          }
+        ],
+        "tags": [
+          "CategoryManager"
+        ]
+      },
+      "patch": {
+        "summary": "Modify category",
+        "operationId": "ModifyCategory",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyCategoryResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyCategoryRequest"
+            }
+          }
+        ],
+        "tags": [
+          "CategoryManager"
+        ]
+      }
+    },
+    "/v1/clusters": {
+      "get": {
+        "summary": "Get clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all clusters",
+        "operationId": "DescribeClusters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeClustersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "cluster_id",
+            "description": "cluster ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "app_id",
+            "description": "app ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "version_id",
+            "description": "version ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
               "type": "string"
             },
             "collectionFormat": "multi"
@@ -3146,15 +3710,961 @@ var Files = map[string]string{
             "description": "cluster status eg.[active|used|enabled|disabled|deleted|stopped|ceased].",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "runtime_id",
+            "description": "runtime ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "frontgate_id",
+            "description": "frontgate ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "external_cluster_id",
+            "description": "external cluster id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "search_word",
+            "description": "query key, support these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "cluster_type",
+            "description": "cluster type, frontgate or normal cluster.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "with_detail",
+            "description": "get cluster detail info or not.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "created_date",
+            "description": "cluster created duration eg.[1 day].",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select column to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "zone",
+            "description": "namespace.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "min_create_time",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/add_nodes": {
+      "post": {
+        "summary": "Batch add nodes to cluster",
+        "operationId": "AddClusterNodes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixAddClusterNodesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixAddClusterNodesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/apps": {
+      "get": {
+        "summary": "Get app clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all app clusters",
+        "operationId": "DescribeAppClusters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeAppClustersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "app_id",
+            "description": "app ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "description": "status eg.[active|used|enabled|disabled|deleted|stopped|ceased].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "search_word",
+            "description": "query key, support these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "with_detail",
+            "description": "get cluster with detail.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "created_date",
+            "description": "cluster created duration eg.[1 day].",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select columns to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/cease": {
+      "post": {
+        "summary": "Batch cease clusters",
+        "operationId": "CeaseClusters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCeaseClustersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCeaseClustersRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/create": {
+      "post": {
+        "summary": "Create cluster",
+        "operationId": "CreateCluster",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateClusterResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateClusterRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/delete": {
+      "post": {
+        "summary": "Batch delete clusters",
+        "operationId": "DeleteClusters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteClustersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteClustersRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/delete_nodes": {
+      "post": {
+        "summary": "Batch delete nodes from cluster",
+        "operationId": "DeleteClusterNodes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteClusterNodesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteClusterNodesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/export": {
+      "get": {
+        "summary": "Export clusters, stream all the clusters page by page for bulk reporting",
+        "operationId": "ExportClusters",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixDescribeClustersResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixDescribeClustersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "cluster_id",
+            "description": "cluster ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "app_id",
+            "description": "app ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "version_id",
+            "description": "version ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "description": "cluster status eg.[active|used|enabled|disabled|deleted|stopped|ceased].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "runtime_id",
+            "description": "runtime ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "frontgate_id",
+            "description": "frontgate ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "external_cluster_id",
+            "description": "external cluster id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "search_word",
+            "description": "query key, support these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "cluster_type",
+            "description": "cluster type, frontgate or normal cluster.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "with_detail",
+            "description": "get cluster detail info or not.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "created_date",
+            "description": "cluster created duration eg.[1 day].",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select column to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "zone",
+            "description": "namespace.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "min_create_time",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/key_pair/attach": {
+      "post": {
+        "summary": "Batch attach key pairs to node",
+        "operationId": "AttachKeyPairs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixAttachKeyPairsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixAttachKeyPairsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/key_pair/detach": {
+      "post": {
+        "summary": "Batch detach key pairs from node",
+        "operationId": "DetachKeyPairs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDetachKeyPairsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDetachKeyPairsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/key_pairs": {
+      "get": {
+        "summary": "Get key pairs, support filter with these fields(key_pair_id, name, owner), default return all key pairs",
+        "operationId": "DescribeKeyPairs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeKeyPairsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "key_pair_id",
+            "description": "key pair id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "description": "key pair name.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "description",
+            "description": "key pair description.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "pub_key",
+            "description": "public key.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "search_word",
+            "description": "query key, can filter with these fields(key_pair_id, name, owner).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select columns to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      },
+      "delete": {
+        "summary": "Batch delete key pairs",
+        "operationId": "DeleteKeyPairs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteKeyPairsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixDeleteKeyPairsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      },
+      "post": {
+        "summary": "Create key pair",
+        "operationId": "CreateKeyPair",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateKeyPairResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCreateKeyPairRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/modify": {
+      "post": {
+        "summary": "Modify cluster attributes",
+        "operationId": "ModifyClusterAttributes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyClusterAttributesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyClusterAttributesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/modify_nodes": {
+      "post": {
+        "summary": "Modify node attributes in the cluster",
+        "operationId": "ModifyClusterNodeAttributes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/monitor": {
+      "get": {
+        "summary": "Get time series of the monitor items defined by app on nodes of cluster",
+        "operationId": "DescribeClusterMonitor",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeClusterMonitorResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "cluster_id",
+            "description": "required, id of cluster to monitor.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "role",
+            "description": "roles of nodes to monitor, default all roles.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "node_id",
+            "description": "ids of nodes to monitor, default all nodes.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "start_time",
+            "description": "start time of time series, default one hour before end time.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "end_time",
+            "description": "end time of time series, default now.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "step",
+            "description": "seconds between the points of time series, the values in a step are averaged, default no average.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/nodes": {
+      "get": {
+        "summary": "Get nodes in cluster, can filter with these fields(cluster_id, node_id, status, health_status, owner)",
+        "operationId": "DescribeClusterNodes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeClusterNodesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "cluster_id",
+            "description": "cluster id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
           },
           {
-            "name": "runtime_id",
-            "description": "runtime ids.",
+            "name": "node_id",
+            "description": "node ids.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3164,8 +4674,8 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "frontgate_id",
-            "description": "frontgate ids.",
+            "name": "status",
+            "description": "status eg.[active|used|enabled|disabled|deleted|stopped|ceased].",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3174,13 +4684,6 @@ var Files = map[string]string{
             },
             "collectionFormat": "multi"
           },
-          {
-            "name": "external_cluster_id",
-            "description": "external cluster id.",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
           {
             "name": "limit",
             "description": "data limit per page, default value 20, max value 200.",
@@ -3199,7 +4702,7 @@ var Files = map[string]string{
           },
           {
             "name": "search_word",
-            "description": "query key, support these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type).",
+            "description": "query key, support these fields(cluster_id, node_id, status, owner).",
             "in": "query",
             "required": false,
             "type": "string"
@@ -3230,32 +4733,9 @@ var Files = map[string]string{
             },
             "collectionFormat": "multi"
           },
-          {
-            "name": "cluster_type",
-            "description": "cluster type, frontgate or normal cluster.",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "with_detail",
-            "description": "get cluster detail info or not.",
-            "in": "query",
-            "required": false,
-            "type": "boolean",
-            "format": "boolean"
-          },
-          {
-            "name": "created_date",
-            "description": "cluster created duration eg.[1 day].",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
           {
             "name": "display_columns",
-            "description": "select column to display.",
+            "description": "select columns to display.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3265,8 +4745,15 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "zone",
-            "description": "namespace.",
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "health_status",
+            "description": "health status eg.[healthy|unhealthy].",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3274,40 +4761,6 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multi"
-          },
-          {
-            "name": "min_create_time",
-            "in": "query",
-            "required": false,
-            "type": "string",
-            "format": "date-time"
-          }
-        ],
-        "tags": [
-          "ClusterManager"
-        ]
-      }
-    },
-    "/v1/clusters/add_nodes": {
-      "post": {
-        "summary": "Batch add nodes to cluster",
-        "operationId": "AddClusterNodes",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/openpitrixAddClusterNodesResponse"
-            }
-          }
-        },
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixAddClusterNodesRequest"
-            }
           }
         ],
         "tags": [
@@ -3315,22 +4768,38 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/apps": {
+    "/v1/clusters/nodes/export": {
       "get": {
-        "summary": "Get app clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all app clusters",
-        "operationId": "DescribeAppClusters",
+        "summary": "Export cluster nodes, stream all the cluster nodes page by page for bulk reporting",
+        "operationId": "ExportClusterNodes",
         "responses": {
           "200": {
-            "description": "A successful response.",
+            "description": "A successful response.(streaming responses)",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeAppClustersResponse"
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixDescribeClusterNodesResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixDescribeClusterNodesResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "app_id",
-            "description": "app ids.",
+            "name": "cluster_id",
+            "description": "cluster id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "node_id",
+            "description": "node ids.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3368,7 +4837,7 @@ var Files = map[string]string{
           },
           {
             "name": "search_word",
-            "description": "query key, support these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type).",
+            "description": "query key, support these fields(cluster_id, node_id, status, owner).",
             "in": "query",
             "required": false,
             "type": "string"
@@ -3400,24 +4869,26 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "with_detail",
-            "description": "get cluster with detail.",
+            "name": "display_columns",
+            "description": "select columns to display.",
             "in": "query",
             "required": false,
-            "type": "boolean",
-            "format": "boolean"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "created_date",
-            "description": "cluster created duration eg.[1 day].",
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
             "in": "query",
             "required": false,
-            "type": "integer",
-            "format": "int64"
+            "type": "string"
           },
           {
-            "name": "display_columns",
-            "description": "select columns to display.",
+            "name": "health_status",
+            "description": "health status eg.[healthy|unhealthy].",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3432,15 +4903,24 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/cease": {
+    "/v1/clusters/nodes/run_command": {
       "post": {
-        "summary": "Batch cease clusters",
-        "operationId": "CeaseClusters",
+        "summary": "Run command on cluster node, stream output of command until exited",
+        "operationId": "RunClusterNodeCommand",
         "responses": {
           "200": {
-            "description": "A successful response.",
+            "description": "A successful response.(streaming responses)",
             "schema": {
-              "$ref": "#/definitions/openpitrixCeaseClustersResponse"
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixRunClusterNodeCommandResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixRunClusterNodeCommandResponse"
             }
           }
         },
@@ -3450,7 +4930,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCeaseClustersRequest"
+              "$ref": "#/definitions/openpitrixRunClusterNodeCommandRequest"
             }
           }
         ],
@@ -3459,69 +4939,103 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/create": {
-      "post": {
-        "summary": "Create cluster",
-        "operationId": "CreateCluster",
+    "/v1/clusters/quotas": {
+      "get": {
+        "summary": "Get quotas, can filter with these fields(quota_id, subject_type, subject, runtime_id), default return all quotas",
+        "operationId": "DescribeQuotas",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateClusterResponse"
+              "$ref": "#/definitions/openpitrixDescribeQuotasResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixCreateClusterRequest"
-            }
-          }
-        ],
-        "tags": [
-          "ClusterManager"
-        ]
-      }
-    },
-    "/v1/clusters/delete": {
-      "post": {
-        "summary": "Batch delete clusters",
-        "operationId": "DeleteClusters",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClustersResponse"
-            }
-          }
-        },
-        "parameters": [
+            "name": "quota_id",
+            "description": "quota ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClustersRequest"
-            }
+            "name": "subject_type",
+            "description": "subject types of quota eg.[user|group|owner_path].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "subject",
+            "description": "subjects of quota.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "runtime_id",
+            "description": "runtime ids of quota.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select columns to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/delete_nodes": {
-      "post": {
-        "summary": "Batch delete nodes from cluster",
-        "operationId": "DeleteClusterNodes",
+      },
+      "delete": {
+        "summary": "Batch delete quotas",
+        "operationId": "DeleteQuotas",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClusterNodesResponse"
+              "$ref": "#/definitions/openpitrixDeleteQuotasResponse"
             }
           }
         },
@@ -3531,24 +5045,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteClusterNodesRequest"
+              "$ref": "#/definitions/openpitrixDeleteQuotasRequest"
             }
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/key_pair/attach": {
+      },
       "post": {
-        "summary": "Batch attach key pairs to node",
-        "operationId": "AttachKeyPairs",
+        "summary": "Create quota of user, group or owner path",
+        "operationId": "CreateQuota",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixAttachKeyPairsResponse"
+              "$ref": "#/definitions/openpitrixCreateQuotaResponse"
             }
           }
         },
@@ -3558,24 +5070,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixAttachKeyPairsRequest"
+              "$ref": "#/definitions/openpitrixCreateQuotaRequest"
             }
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/key_pair/detach": {
-      "post": {
-        "summary": "Batch detach key pairs from node",
-        "operationId": "DetachKeyPairs",
+      },
+      "patch": {
+        "summary": "Modify quota",
+        "operationId": "ModifyQuota",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDetachKeyPairsResponse"
+              "$ref": "#/definitions/openpitrixModifyQuotaResponse"
             }
           }
         },
@@ -3585,7 +5095,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDetachKeyPairsRequest"
+              "$ref": "#/definitions/openpitrixModifyQuotaRequest"
             }
           }
         ],
@@ -3594,84 +5104,36 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/key_pairs": {
+    "/v1/clusters/quotas/usage": {
       "get": {
-        "summary": "Get key pairs, support filter with these fields(key_pair_id, name, owner), default return all key pairs",
-        "operationId": "DescribeKeyPairs",
+        "summary": "Get usage of quotas limiting the clusters of owner path",
+        "operationId": "DescribeQuotaUsage",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeKeyPairsResponse"
+              "$ref": "#/definitions/openpitrixDescribeQuotaUsageResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "key_pair_id",
-            "description": "key pair id.",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "name",
-            "description": "key pair name.",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "description",
-            "description": "key pair description.",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "pub_key",
-            "description": "public key.",
+            "name": "owner_path",
+            "description": "owner path of clusters, default is the owner path of sender.",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "owner",
-            "description": "owner.",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
-          {
-            "name": "search_word",
-            "description": "query key, can filter with these fields(key_pair_id, name, owner).",
+            "name": "runtime_id",
+            "description": "runtime id, default returns the usage of quotas in all runtimes.",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "limit",
-            "description": "data limit per page, default value 20, max value 200.",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "offset",
-            "description": "data offset, default 0.",
-            "in": "query",
-            "required": false,
-            "type": "integer",
-            "format": "int64"
-          },
-          {
-            "name": "display_columns",
-            "description": "select columns to display.",
+            "name": "health_status",
+            "description": "health status eg.[healthy|unhealthy].",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3684,15 +5146,17 @@ var Files = map[string]string{
         "tags": [
           "ClusterManager"
         ]
-      },
-      "delete": {
-        "summary": "Batch delete key pairs",
-        "operationId": "DeleteKeyPairs",
+      }
+    },
+    "/v1/clusters/recover": {
+      "post": {
+        "summary": "Batch recover clusters",
+        "operationId": "RecoverClusters",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteKeyPairsResponse"
+              "$ref": "#/definitions/openpitrixRecoverClustersResponse"
             }
           }
         },
@@ -3702,22 +5166,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixDeleteKeyPairsRequest"
+              "$ref": "#/definitions/openpitrixRecoverClustersRequest"
             }
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      },
+      }
+    },
+    "/v1/clusters/resize": {
       "post": {
-        "summary": "Create key pair",
-        "operationId": "CreateKeyPair",
+        "summary": "Resize cluster",
+        "operationId": "ResizeCluster",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateKeyPairResponse"
+              "$ref": "#/definitions/openpitrixResizeClusterResponse"
             }
           }
         },
@@ -3727,7 +5193,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixCreateKeyPairRequest"
+              "$ref": "#/definitions/openpitrixResizeClusterRequest"
             }
           }
         ],
@@ -3736,15 +5202,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/modify": {
+    "/v1/clusters/rollback": {
       "post": {
-        "summary": "Modify cluster attributes",
-        "operationId": "ModifyClusterAttributes",
+        "summary": "Rollback cluster",
+        "operationId": "RollbackCluster",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterAttributesResponse"
+              "$ref": "#/definitions/openpitrixRollbackClusterResponse"
             }
           }
         },
@@ -3754,7 +5220,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterAttributesRequest"
+              "$ref": "#/definitions/openpitrixRollbackClusterRequest"
             }
           }
         ],
@@ -3763,15 +5229,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/modify_nodes": {
+    "/v1/clusters/run_service": {
       "post": {
-        "summary": "Modify node attributes in the cluster",
-        "operationId": "ModifyClusterNodeAttributes",
+        "summary": "Run restart service or the custom service defined by app on cluster",
+        "operationId": "RunClusterService",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesResponse"
+              "$ref": "#/definitions/openpitrixRunClusterServiceResponse"
             }
           }
         },
@@ -3781,7 +5247,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixModifyClusterNodeAttributesRequest"
+              "$ref": "#/definitions/openpitrixRunClusterServiceRequest"
             }
           }
         ],
@@ -3790,29 +5256,44 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/nodes": {
+    "/v1/clusters/service_audits": {
       "get": {
-        "summary": "Get nodes in cluster, can filter with these fields(cluster_id, node_id, status, owner)",
-        "operationId": "DescribeClusterNodes",
+        "summary": "Get history of services run on clusters, can filter with these fields(cluster_service_audit_id, cluster_id, service_name, status, owner), default return all cluster service audits",
+        "operationId": "DescribeClusterServiceAudits",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeClusterNodesResponse"
+              "$ref": "#/definitions/openpitrixDescribeClusterServiceAuditsResponse"
             }
           }
         },
         "parameters": [
+          {
+            "name": "cluster_service_audit_id",
+            "description": "cluster service audit ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
           {
             "name": "cluster_id",
-            "description": "cluster id.",
+            "description": "ids of clusters service run on.",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
-            "name": "node_id",
-            "description": "node ids.",
+            "name": "service_name",
+            "description": "names of service.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3823,7 +5304,18 @@ var Files = map[string]string{
           },
           {
             "name": "status",
-            "description": "status eg.[active|used|enabled|disabled|deleted|stopped|ceased].",
+            "description": "status eg.[pending|successful|failed].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "description": "owners.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3849,11 +5341,15 @@ var Files = map[string]string{
             "format": "int64"
           },
           {
-            "name": "search_word",
-            "description": "query key, support these fields(cluster_id, node_id, status, owner).",
+            "name": "display_columns",
+            "description": "select columns to display.",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
             "name": "sort_key",
@@ -3869,10 +5365,84 @@ var Files = map[string]string{
             "required": false,
             "type": "boolean",
             "format": "boolean"
+          }
+        ],
+        "tags": [
+          "ClusterManager"
+        ]
+      }
+    },
+    "/v1/clusters/snapshots": {
+      "get": {
+        "summary": "Get snapshots of clusters, can filter with these fields(snapshot_id, cluster_id, snapshot_type, parent_snapshot_id, status, owner), default return all snapshots",
+        "operationId": "DescribeClusterSnapshots",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeClusterSnapshotsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "snapshot_id",
+            "description": "snapshot ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "cluster_id",
+            "description": "ids of clusters backed up.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "snapshot_type",
+            "description": "snapshot types eg.[full|incremental].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "parent_snapshot_id",
+            "description": "ids of the full snapshots which the incremental snapshots are based on.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "description": "status eg.[pending|active|failed|deleted].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           },
           {
             "name": "owner",
-            "description": "owner.",
+            "description": "owners.",
             "in": "query",
             "required": false,
             "type": "array",
@@ -3881,6 +5451,22 @@ var Files = map[string]string{
             },
             "collectionFormat": "multi"
           },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
           {
             "name": "display_columns",
             "description": "select columns to display.",
@@ -3891,22 +5477,35 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multi"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/recover": {
-      "post": {
-        "summary": "Batch recover clusters",
-        "operationId": "RecoverClusters",
+      },
+      "delete": {
+        "summary": "Batch delete snapshots of clusters",
+        "operationId": "DeleteClusterSnapshots",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixRecoverClustersResponse"
+              "$ref": "#/definitions/openpitrixDeleteClusterSnapshotsResponse"
             }
           }
         },
@@ -3916,24 +5515,22 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixRecoverClustersRequest"
+              "$ref": "#/definitions/openpitrixDeleteClusterSnapshotsRequest"
             }
           }
         ],
         "tags": [
           "ClusterManager"
         ]
-      }
-    },
-    "/v1/clusters/resize": {
-      "post": {
-        "summary": "Resize cluster",
-        "operationId": "ResizeCluster",
+      },
+      "post": {
+        "summary": "Back up cluster by the backup service of app",
+        "operationId": "CreateClusterSnapshot",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixResizeClusterResponse"
+              "$ref": "#/definitions/openpitrixCreateClusterSnapshotResponse"
             }
           }
         },
@@ -3943,7 +5540,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixResizeClusterRequest"
+              "$ref": "#/definitions/openpitrixCreateClusterSnapshotRequest"
             }
           }
         ],
@@ -3952,15 +5549,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/clusters/rollback": {
+    "/v1/clusters/snapshots/restore": {
       "post": {
-        "summary": "Rollback cluster",
-        "operationId": "RollbackCluster",
+        "summary": "Restore cluster from snapshot by the restore service of app",
+        "operationId": "RestoreClusterFromSnapshot",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/openpitrixRollbackClusterResponse"
+              "$ref": "#/definitions/openpitrixRestoreClusterFromSnapshotResponse"
             }
           }
         },
@@ -3970,7 +5567,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/openpitrixRollbackClusterRequest"
+              "$ref": "#/definitions/openpitrixRestoreClusterFromSnapshotRequest"
             }
           }
         ],
@@ -4373,6 +5970,24 @@ var Files = map[string]string{
             "required": false,
             "type": "string",
             "format": "date-time"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "health_status",
+            "description": "health status eg.[healthy|unhealthy].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
           }
         ],
         "tags": [
@@ -4539,6 +6154,7 @@ var Files = map[string]string{
         "parameters": [
           {
             "name": "runtime_id",
+            "description": "required, id of runtime.",
             "in": "query",
             "required": false,
             "type": "string"
@@ -4551,12 +6167,14 @@ var Files = map[string]string{
           },
           {
             "name": "namespace",
+            "description": "namespace, default to all namespaces.",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
             "name": "status",
+            "description": "release status eg.[deployed|failed|uninstalled|all], default to deployed and failed.",
             "in": "query",
             "required": false,
             "type": "string"
@@ -4667,6 +6285,74 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/releases/diff": {
+      "get": {
+        "summary": "Diff values and manifest between two revisions of the release of cluster",
+        "operationId": "DiffReleaseRevisions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDiffReleaseRevisionsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "cluster_id",
+            "description": "required, id of kubernetes cluster.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "from_version",
+            "description": "required, version of revision to diff from.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "to_version",
+            "description": "version of revision to diff to, default to the current revision.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          }
+        ],
+        "tags": [
+          "ReleaseManager"
+        ]
+      }
+    },
+    "/v1/releases/history": {
+      "get": {
+        "summary": "Describe revision history of the release of cluster",
+        "operationId": "DescribeReleaseHistory",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeReleaseHistoryResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "cluster_id",
+            "description": "required, id of kubernetes cluster.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ReleaseManager"
+        ]
+      }
+    },
     "/v1/app_vendors": {
       "get": {
         "summary": "Get vendor verifies info, can filer with these fields(user_id, status), default return all VendorVerifyInfos",
@@ -5091,7 +6777,193 @@ var Files = map[string]string{
           },
           {
             "name": "status",
-            "description": "status eg.[successful|failed|running|pending].",
+            "description": "status eg.[successful|failed|running|pending|scheduled|cancelled].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "JobManager"
+        ]
+      }
+    },
+    "/v1/jobs/cancel": {
+      "post": {
+        "summary": "Cancel pending or working job, the remaining tasks of the job will not be executed",
+        "operationId": "CancelJob",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixCancelJobResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixCancelJobRequest"
+            }
+          }
+        ],
+        "tags": [
+          "JobManager"
+        ]
+      }
+    },
+    "/v1/jobs/export": {
+      "get": {
+        "summary": "Export jobs, stream all the jobs page by page for bulk reporting",
+        "operationId": "ExportJobs",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixDescribeJobsResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixDescribeJobsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "description": "query key, support these fields(job_id, cluster_id, app_id, version_id, executor, provider, status, owner).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select column to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "job_id",
+            "description": "job ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "cluster_id",
+            "description": "cluster id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "app_id",
+            "description": "app id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "version_id",
+            "description": "specific app version id to filter result.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "executor",
+            "description": "host name of server.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "provider",
+            "description": "runtime provider eg.[qingcloud|aliyun|aws|kubernetes].",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "runtime_id",
+            "description": "runtime id.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "description": "status eg.[successful|failed|running|pending|scheduled|cancelled].",
             "in": "query",
             "required": false,
             "type": "array",
@@ -5101,15 +6973,57 @@ var Files = map[string]string{
             "collectionFormat": "multi"
           },
           {
-            "name": "owner",
-            "description": "owner.",
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "JobManager"
+        ]
+      }
+    },
+    "/v1/jobs/watch": {
+      "get": {
+        "summary": "Watch job, stream the changes of job and its tasks until the job finished",
+        "operationId": "WatchJob",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixWatchJobResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixWatchJobResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "job_id",
+            "description": "required, id of job to watch.",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
+            "type": "string"
           }
         ],
         "tags": [
@@ -5820,6 +7734,108 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "RepoIndexer"
+        ]
+      }
+    },
+    "/v1/repo_events/export": {
+      "get": {
+        "summary": "Export repository events, stream all the repository events page by page for bulk reporting",
+        "operationId": "ExportRepoEvents",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixDescribeRepoEventsResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixDescribeRepoEventsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "repo_event_id",
+            "description": "repository event ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "repo_id",
+            "description": "repository ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "status",
+            "description": "repository event status eg.[failed|successful|working|pending].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -6524,6 +8540,33 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/runtimes/credentials:rotate_key": {
+      "post": {
+        "summary": "Re-encrypt runtime credentials with the primary master key, only for global admin",
+        "operationId": "RotateCredentialKey",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixRotateCredentialKeyResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/openpitrixRotateCredentialKeyRequest"
+            }
+          }
+        ],
+        "tags": [
+          "RuntimeManager"
+        ]
+      }
+    },
     "/v1/runtimes/credentials:validate": {
       "post": {
         "summary": "Validate runtime credential",
@@ -6681,9 +8724,151 @@ var Files = map[string]string{
         "operationId": "DescribeTasks",
         "responses": {
           "200": {
-            "description": "A successful response.",
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/openpitrixDescribeTasksResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "description": "query key, support these fields(job_id, task_id, executor, status, owner).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "description": "sort key, order by sort_key, default create_time.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "description": "value = 0 sort ASC, value = 1 sort DESC.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "limit",
+            "description": "data limit per page, default value 20, max value 200.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "offset",
+            "description": "data offset, default 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "display_columns",
+            "description": "select columns to display.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "task_id",
+            "description": "task ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "job_id",
+            "description": "job ids.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "executor",
+            "description": "host name of server.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "target",
+            "description": "target eg.[runtime|pilot].",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "description": "task status eg.[running|successful|failed|pending].",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "owner",
+            "description": "owner.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TaskManager"
+        ]
+      }
+    },
+    "/v1/tasks/export": {
+      "get": {
+        "summary": "Export tasks, stream all the tasks page by page for bulk reporting",
+        "operationId": "ExportTasks",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
             "schema": {
-              "$ref": "#/definitions/openpitrixDescribeTasksResponse"
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/openpitrixDescribeTasksResponse"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of openpitrixDescribeTasksResponse"
             }
           }
         },
@@ -6794,6 +8979,13 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multi"
+          },
+          {
+            "name": "page_token",
+            "description": "page token of the next page returned by previous page, the offset is ignored if it is set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -6830,6 +9022,18 @@ var Files = map[string]string{
     }
   },
   "definitions": {
+    "protobufAny": {
+      "type": "object",
+      "properties": {
+        "type_url": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
     "openpitrixActionBundle": {
       "type": "object",
       "properties": {
@@ -7230,6 +9434,23 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixDescribeSessionsResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total count of active sessions"
+        },
+        "session_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixSession"
+          },
+          "title": "list of active session"
+        }
+      }
+    },
     "openpitrixDescribeUsersDetailResponse": {
       "type": "object",
       "properties": {
@@ -7462,6 +9683,22 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixLogoutRequest": {
+      "type": "object"
+    },
+    "openpitrixLogoutResponse": {
+      "type": "object",
+      "properties": {
+        "user_id": {
+          "type": "string",
+          "title": "id of user logged out"
+        },
+        "session_id": {
+          "type": "string",
+          "title": "id of session revoked"
+        }
+      }
+    },
     "openpitrixModifyGroupRequest": {
       "type": "object",
       "properties": {
@@ -7619,6 +9856,60 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRevokeTokenRequest": {
+      "type": "object",
+      "properties": {
+        "token": {
+          "type": "string",
+          "title": "access token or refresh token to revoke, required if session_id is empty"
+        },
+        "token_type_hint": {
+          "type": "string",
+          "title": "hint of token type to revoke.eg.[access_token or refresh_token]"
+        },
+        "session_id": {
+          "type": "string",
+          "title": "id of session to revoke, required if token is empty"
+        }
+      }
+    },
+    "openpitrixRevokeTokenResponse": {
+      "type": "object",
+      "properties": {
+        "user_id": {
+          "type": "string",
+          "title": "id of user who owned the token"
+        },
+        "session_id": {
+          "type": "string",
+          "title": "id of session revoked, empty if access token revoked"
+        }
+      }
+    },
+    "openpitrixRevokeUserTokensRequest": {
+      "type": "object",
+      "properties": {
+        "user_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "required, ids of users to revoke all tokens"
+        }
+      }
+    },
+    "openpitrixRevokeUserTokensResponse": {
+      "type": "object",
+      "properties": {
+        "user_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of users whose tokens are revoked"
+        }
+      }
+    },
     "openpitrixRole": {
       "type": "object",
       "properties": {
@@ -7671,6 +9962,41 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixSession": {
+      "type": "object",
+      "properties": {
+        "session_id": {
+          "type": "string",
+          "title": "session id"
+        },
+        "client_id": {
+          "type": "string",
+          "title": "client id of session"
+        },
+        "user_id": {
+          "type": "string",
+          "title": "id of user who owned the session"
+        },
+        "scope": {
+          "type": "string",
+          "title": "scope"
+        },
+        "status": {
+          "type": "string",
+          "title": "session status eg.[active|deleted]"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when session create"
+        },
+        "expire_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when refresh token of session expire"
+        }
+      }
+    },
     "openpitrixTokenRequest": {
       "type": "object",
       "properties": {
@@ -8185,6 +10511,13 @@ var Files = map[string]string{
         "version_type": {
           "type": "string",
           "title": "version type"
+        },
+        "stage": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ordered stages of the review, eg.[isv, business, technical]"
         }
       }
     },
@@ -8323,6 +10656,43 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixCreateReviewPolicyRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "review policy name"
+        },
+        "scope_type": {
+          "type": "string",
+          "title": "required, scope type of review policy eg.[category|market]"
+        },
+        "scope_id": {
+          "type": "string",
+          "title": "required, id of category or market which the review policy applies to"
+        },
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixReviewStage"
+          },
+          "title": "required, ordered review stages"
+        },
+        "description": {
+          "type": "string",
+          "title": "review policy description"
+        }
+      }
+    },
+    "openpitrixCreateReviewPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "string",
+          "title": "id of review policy created"
+        }
+      }
+    },
     "openpitrixDeleteAppVersionRequest": {
       "type": "object",
       "properties": {
@@ -8365,6 +10735,30 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixDeleteReviewPoliciesRequest": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "required, ids of review policy to delete"
+        }
+      }
+    },
+    "openpitrixDeleteReviewPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of review policy deleted"
+        }
+      }
+    },
     "openpitrixDescribeAppVersionAuditsResponse": {
       "type": "object",
       "properties": {
@@ -8430,6 +10824,27 @@ var Files = map[string]string{
             "$ref": "#/definitions/openpitrixApp"
           },
           "title": "list of app"
+        },
+        "next_page_token": {
+          "type": "string",
+          "title": "page token of the next page, empty if there are no more apps"
+        }
+      }
+    },
+    "openpitrixDescribeReviewPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total count of review policies"
+        },
+        "review_policy_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixReviewPolicy"
+          },
+          "title": "list of review policies"
         }
       }
     },
@@ -8596,12 +11011,49 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixModifyReviewPolicyRequest": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "string",
+          "title": "required, id of review policy to modify"
+        },
+        "name": {
+          "type": "string",
+          "title": "review policy name"
+        },
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixReviewStage"
+          },
+          "title": "ordered review stages"
+        },
+        "description": {
+          "type": "string",
+          "title": "review policy description"
+        }
+      }
+    },
+    "openpitrixModifyReviewPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "string",
+          "title": "id of review policy modified"
+        }
+      }
+    },
     "openpitrixPassAppVersionRequest": {
       "type": "object",
       "properties": {
         "version_id": {
           "type": "string",
           "title": "required, id of version to pass"
+        },
+        "stage": {
+          "type": "string",
+          "title": "stage of the review to pass, required by PassAppVersion"
         }
       }
     },
@@ -8642,6 +11094,10 @@ var Files = map[string]string{
         "message": {
           "type": "string",
           "title": "reject message"
+        },
+        "stage": {
+          "type": "string",
+          "title": "stage of the review to reject, required by RejectAppVersion"
         }
       }
     },
@@ -8695,34 +11151,129 @@ var Files = map[string]string{
         "locale": {
           "type": "string"
         },
-        "status": {
-          "type": "string"
+        "status": {
+          "type": "string"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "openpitrixReviewAppVersionRequest": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string",
+          "title": "required, id of version to review"
+        },
+        "stage": {
+          "type": "string",
+          "title": "stage of the review to start, required by ReviewAppVersion"
+        }
+      }
+    },
+    "openpitrixReviewAppVersionResponse": {
+      "type": "object",
+      "properties": {
+        "version_id": {
+          "type": "string",
+          "title": "id of version reviewed"
+        }
+      }
+    },
+    "openpitrixReviewAutoPass": {
+      "type": "object",
+      "properties": {
+        "submitter_action_bundle": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "the stage is passed automatically if the submitter has one of the action bundles"
+        },
+        "owner_path_prefix": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "the stage is passed automatically if the owner path of app version has one of the prefixes"
+        }
+      }
+    },
+    "openpitrixReviewPolicy": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "string",
+          "title": "review policy id"
+        },
+        "name": {
+          "type": "string",
+          "title": "review policy name"
+        },
+        "scope_type": {
+          "type": "string",
+          "title": "scope type of review policy eg.[category|market]"
+        },
+        "scope_id": {
+          "type": "string",
+          "title": "id of category or market which the review policy applies to"
+        },
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixReviewStage"
+          },
+          "title": "ordered review stages"
+        },
+        "description": {
+          "type": "string",
+          "title": "review policy description"
+        },
+        "owner": {
+          "type": "string",
+          "title": "owner"
+        },
+        "owner_path": {
+          "type": "string",
+          "title": "owner path of review policy, concat string group_path:user_id"
         },
         "create_time": {
           "type": "string",
-          "format": "date-time"
+          "format": "date-time",
+          "title": "the time when review policy create"
         },
         "status_time": {
           "type": "string",
-          "format": "date-time"
+          "format": "date-time",
+          "title": "record status changed time"
         }
       }
     },
-    "openpitrixReviewAppVersionRequest": {
+    "openpitrixReviewStage": {
       "type": "object",
       "properties": {
-        "version_id": {
+        "name": {
           "type": "string",
-          "title": "required, id of version to review"
-        }
-      }
-    },
-    "openpitrixReviewAppVersionResponse": {
-      "type": "object",
-      "properties": {
-        "version_id": {
+          "title": "required, name of stage, recorded as the operator type of review phase eg.[isv|business|technical|security]"
+        },
+        "action_bundle": {
           "type": "string",
-          "title": "id of version reviewed"
+          "title": "required, action bundle required by the reviewers of the stage"
+        },
+        "isv_scoped": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "the reviewers of the stage are the users of the isv which the submitter belongs to"
+        },
+        "auto_pass": {
+          "$ref": "#/definitions/openpitrixReviewAutoPass",
+          "title": "rules to pass the stage automatically"
         }
       }
     },
@@ -8862,6 +11413,31 @@ var Files = map[string]string{
         }
       }
     },
+    "runtimeStreamError": {
+      "type": "object",
+      "properties": {
+        "grpc_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "http_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "http_status": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          }
+        }
+      }
+    },
     "openpitrixAppendAttachmentResponse": {
       "type": "object",
       "properties": {
@@ -9154,6 +11730,11 @@ var Files = map[string]string{
             "type": "string"
           },
           "title": "advanced param"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
         }
       }
     },
@@ -9167,6 +11748,10 @@ var Files = map[string]string{
         "job_id": {
           "type": "string",
           "title": "id of job of add node to cluster"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
         }
       }
     },
@@ -9687,6 +12272,11 @@ var Files = map[string]string{
         "owner": {
           "type": "string",
           "title": "owner"
+        },
+        "health_check_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when the health check of role last ran on cluster node"
         }
       }
     },
@@ -9758,6 +12348,141 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixClusterServiceAudit": {
+      "type": "object",
+      "properties": {
+        "cluster_service_audit_id": {
+          "type": "string",
+          "title": "cluster service audit id"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster service run on"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "id of job running service"
+        },
+        "service_name": {
+          "type": "string",
+          "title": "name of service, restart or the custom service defined by app"
+        },
+        "roles": {
+          "type": "string",
+          "title": "roles service run on, separated by comma"
+        },
+        "node_ids": {
+          "type": "string",
+          "title": "ids of nodes service run on, separated by comma"
+        },
+        "service_params": {
+          "type": "string",
+          "title": "params of service in json format"
+        },
+        "status": {
+          "type": "string",
+          "title": "status eg.[pending|successful|failed]"
+        },
+        "owner": {
+          "type": "string",
+          "title": "owner"
+        },
+        "owner_path": {
+          "type": "string",
+          "title": "owner path, concat string group_path:user_id"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when service run"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "record status changed time"
+        }
+      }
+    },
+    "openpitrixClusterSnapshot": {
+      "type": "object",
+      "properties": {
+        "snapshot_id": {
+          "type": "string",
+          "title": "snapshot id"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster backed up"
+        },
+        "name": {
+          "type": "string",
+          "title": "snapshot name"
+        },
+        "description": {
+          "type": "string",
+          "title": "snapshot description"
+        },
+        "roles": {
+          "type": "string",
+          "title": "roles backed up, separated by comma"
+        },
+        "node_ids": {
+          "type": "string",
+          "title": "ids of nodes backed up, separated by comma"
+        },
+        "app_id": {
+          "type": "string",
+          "title": "app id of cluster"
+        },
+        "version_id": {
+          "type": "string",
+          "title": "app version id of cluster when backed up"
+        },
+        "snapshot_type": {
+          "type": "string",
+          "title": "snapshot type eg.[full|incremental]"
+        },
+        "parent_snapshot_id": {
+          "type": "string",
+          "title": "id of the full snapshot which the incremental snapshot is based on"
+        },
+        "child_snapshot_ids": {
+          "type": "string",
+          "title": "ids of the incremental snapshots based on the full snapshot, separated by comma"
+        },
+        "auto_backup": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "created by the backup policy of app or not"
+        },
+        "status": {
+          "type": "string",
+          "title": "status eg.[pending|active|failed|deleted]"
+        },
+        "transition_status": {
+          "type": "string",
+          "title": "transition status eg.[creating|deleting]"
+        },
+        "owner": {
+          "type": "string",
+          "title": "owner"
+        },
+        "owner_path": {
+          "type": "string",
+          "title": "owner path, concat string group_path:user_id"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when snapshot create"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "record status changed time"
+        }
+      }
+    },
     "openpitrixCreateClusterRequest": {
       "type": "object",
       "properties": {
@@ -9803,6 +12528,61 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixCreateClusterSnapshotRequest": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "required, id of cluster to back up"
+        },
+        "name": {
+          "type": "string",
+          "title": "snapshot name"
+        },
+        "description": {
+          "type": "string",
+          "title": "snapshot description"
+        },
+        "role": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "roles to back up, default back up all the roles with backup service"
+        },
+        "incremental": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "back up incrementally based on the last full snapshot if app supports incremental backup"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
+        }
+      }
+    },
+    "openpitrixCreateClusterSnapshotResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster backed up"
+        },
+        "snapshot_id": {
+          "type": "string",
+          "title": "id of snapshot created"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
+        }
+      }
+    },
     "openpitrixCreateKeyPairRequest": {
       "type": "object",
       "properties": {
@@ -9829,6 +12609,61 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixCreateQuotaRequest": {
+      "type": "object",
+      "properties": {
+        "subject_type": {
+          "type": "string",
+          "title": "required, subject type of quota eg.[user|group|owner_path]"
+        },
+        "subject": {
+          "type": "string",
+          "title": "required, user id, group path, or prefix of owner path limited by quota"
+        },
+        "runtime_id": {
+          "type": "string",
+          "title": "runtime id limited by quota, empty for all runtimes"
+        },
+        "max_clusters": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max count of clusters, 0 for unlimited"
+        },
+        "max_nodes": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max count of nodes, 0 for unlimited"
+        },
+        "max_cpu": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max cpu cores of nodes, 0 for unlimited"
+        },
+        "max_memory": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max memory(MB) of nodes, 0 for unlimited"
+        },
+        "max_volume_size": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max volume size(GB) of nodes, 0 for unlimited"
+        },
+        "description": {
+          "type": "string",
+          "title": "quota description"
+        }
+      }
+    },
+    "openpitrixCreateQuotaResponse": {
+      "type": "object",
+      "properties": {
+        "quota_id": {
+          "type": "string",
+          "title": "id of quota created"
+        }
+      }
+    },
     "openpitrixDeleteClusterInRuntimeResponse": {
       "type": "object",
       "properties": {
@@ -9860,6 +12695,11 @@ var Files = map[string]string{
             "type": "string"
           },
           "title": "advanced param"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
         }
       }
     },
@@ -9871,8 +12711,43 @@ var Files = map[string]string{
           "title": "id of cluster deleted node"
         },
         "job_id": {
-          "type": "string",
-          "title": "job id"
+          "type": "string",
+          "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
+        }
+      }
+    },
+    "openpitrixDeleteClusterSnapshotsRequest": {
+      "type": "object",
+      "properties": {
+        "snapshot_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "required, ids of snapshots to delete, the incremental snapshots based on the full snapshots are deleted too"
+        }
+      }
+    },
+    "openpitrixDeleteClusterSnapshotsResponse": {
+      "type": "object",
+      "properties": {
+        "snapshot_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of snapshots deleted"
+        },
+        "job_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of jobs"
         }
       }
     },
@@ -9951,6 +12826,30 @@ var Files = map[string]string{
     "openpitrixDeleteNodeKeyPairsResponse": {
       "type": "object"
     },
+    "openpitrixDeleteQuotasRequest": {
+      "type": "object",
+      "properties": {
+        "quota_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "required, ids of quotas to delete"
+        }
+      }
+    },
+    "openpitrixDeleteQuotasResponse": {
+      "type": "object",
+      "properties": {
+        "quota_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of quotas deleted"
+        }
+      }
+    },
     "openpitrixDescribeAppClustersResponse": {
       "type": "object",
       "properties": {
@@ -9968,6 +12867,29 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixDescribeClusterMonitorResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster monitored"
+        },
+        "role_monitor_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRoleMonitor"
+          },
+          "title": "monitor definitions of roles"
+        },
+        "node_monitor_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixNodeMonitor"
+          },
+          "title": "time series of nodes"
+        }
+      }
+    },
     "openpitrixDescribeClusterNodesResponse": {
       "type": "object",
       "properties": {
@@ -9982,6 +12904,44 @@ var Files = map[string]string{
             "$ref": "#/definitions/openpitrixClusterNode"
           },
           "title": "list of cluster node"
+        },
+        "next_page_token": {
+          "type": "string",
+          "title": "page token of the next page, empty if there are no more cluster nodes"
+        }
+      }
+    },
+    "openpitrixDescribeClusterServiceAuditsResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total count of qualified cluster service audit"
+        },
+        "cluster_service_audit_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixClusterServiceAudit"
+          },
+          "title": "list of cluster service audit"
+        }
+      }
+    },
+    "openpitrixDescribeClusterSnapshotsResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total count of qualified snapshot"
+        },
+        "cluster_snapshot_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixClusterSnapshot"
+          },
+          "title": "list of snapshot"
         }
       }
     },
@@ -9999,6 +12959,10 @@ var Files = map[string]string{
             "$ref": "#/definitions/openpitrixCluster"
           },
           "title": "list of cluster"
+        },
+        "next_page_token": {
+          "type": "string",
+          "title": "page token of the next page, empty if there are no more clusters"
         }
       }
     },
@@ -10019,6 +12983,35 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixDescribeQuotaUsageResponse": {
+      "type": "object",
+      "properties": {
+        "quota_usage_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixQuotaUsage"
+          },
+          "title": "usage of the quotas limiting the clusters of owner path"
+        }
+      }
+    },
+    "openpitrixDescribeQuotasResponse": {
+      "type": "object",
+      "properties": {
+        "total_count": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total count of qualified quota"
+        },
+        "quota_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixQuota"
+          },
+          "title": "list of quota"
+        }
+      }
+    },
     "openpitrixDescribeSubnetsResponse": {
       "type": "object",
       "properties": {
@@ -10149,6 +13142,11 @@ var Files = map[string]string{
         "owner": {
           "type": "string",
           "title": "owner"
+        },
+        "health_check_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when the health check of role last ran on cluster node"
         }
       }
     },
@@ -10229,6 +13227,151 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixModifyClusterServiceAuditResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_service_audit_id": {
+          "type": "string",
+          "title": "id of cluster service audit modified"
+        }
+      }
+    },
+    "openpitrixModifyClusterSnapshotResponse": {
+      "type": "object",
+      "properties": {
+        "snapshot_id": {
+          "type": "string",
+          "title": "id of snapshot modified"
+        }
+      }
+    },
+    "openpitrixModifyQuotaRequest": {
+      "type": "object",
+      "properties": {
+        "quota_id": {
+          "type": "string",
+          "title": "required, id of quota to modify"
+        },
+        "max_clusters": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max count of clusters, 0 for unlimited"
+        },
+        "max_nodes": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max count of nodes, 0 for unlimited"
+        },
+        "max_cpu": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max cpu cores of nodes, 0 for unlimited"
+        },
+        "max_memory": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max memory(MB) of nodes, 0 for unlimited"
+        },
+        "max_volume_size": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max volume size(GB) of nodes, 0 for unlimited"
+        },
+        "description": {
+          "type": "string",
+          "title": "quota description"
+        }
+      }
+    },
+    "openpitrixModifyQuotaResponse": {
+      "type": "object",
+      "properties": {
+        "quota_id": {
+          "type": "string",
+          "title": "id of quota modified"
+        }
+      }
+    },
+    "openpitrixMonitorGroup": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of group"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "names of items displayed together in group"
+        }
+      }
+    },
+    "openpitrixMonitorItem": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of item"
+        },
+        "unit": {
+          "type": "string",
+          "title": "unit of value"
+        },
+        "value_type": {
+          "type": "string",
+          "title": "type of value eg.[int|str]"
+        },
+        "statistics_type": {
+          "type": "string",
+          "title": "how the values are counted eg.[latest|delta|rate]"
+        },
+        "scale_factor_when_display": {
+          "type": "integer",
+          "format": "int64",
+          "title": "the values are multiplied by scale factor when displayed"
+        },
+        "enums": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "values of str item, whose index is the value of item"
+        }
+      }
+    },
+    "openpitrixMonitorPoint": {
+      "type": "object",
+      "properties": {
+        "time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when value collected"
+        },
+        "value": {
+          "type": "number",
+          "format": "double",
+          "title": "value of item, counted by statistics type"
+        }
+      }
+    },
+    "openpitrixMonitorSeries": {
+      "type": "object",
+      "properties": {
+        "item": {
+          "type": "string",
+          "title": "name of item"
+        },
+        "point_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixMonitorPoint"
+          },
+          "title": "points of item in time order"
+        }
+      }
+    },
     "openpitrixNodeKeyPair": {
       "type": "object",
       "properties": {
@@ -10236,9 +13379,131 @@ var Files = map[string]string{
           "type": "string",
           "title": "id of node with key pair"
         },
-        "key_pair_id": {
-          "type": "string",
-          "title": "id of key pair in node"
+        "key_pair_id": {
+          "type": "string",
+          "title": "id of key pair in node"
+        }
+      }
+    },
+    "openpitrixNodeMonitor": {
+      "type": "object",
+      "properties": {
+        "node_id": {
+          "type": "string",
+          "title": "id of cluster node"
+        },
+        "role": {
+          "type": "string",
+          "title": "role of cluster node"
+        },
+        "series_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixMonitorSeries"
+          },
+          "title": "time series of items"
+        }
+      }
+    },
+    "openpitrixQuota": {
+      "type": "object",
+      "properties": {
+        "quota_id": {
+          "type": "string",
+          "title": "quota id"
+        },
+        "subject_type": {
+          "type": "string",
+          "title": "subject type of quota eg.[user|group|owner_path]"
+        },
+        "subject": {
+          "type": "string",
+          "title": "user id, group path, or prefix of owner path limited by quota"
+        },
+        "runtime_id": {
+          "type": "string",
+          "title": "runtime id limited by quota, empty for all runtimes"
+        },
+        "max_clusters": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max count of clusters, 0 for unlimited"
+        },
+        "max_nodes": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max count of nodes, 0 for unlimited"
+        },
+        "max_cpu": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max cpu cores of nodes, 0 for unlimited"
+        },
+        "max_memory": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max memory(MB) of nodes, 0 for unlimited"
+        },
+        "max_volume_size": {
+          "type": "integer",
+          "format": "int64",
+          "title": "max volume size(GB) of nodes, 0 for unlimited"
+        },
+        "description": {
+          "type": "string",
+          "title": "quota description"
+        },
+        "owner": {
+          "type": "string",
+          "title": "owner"
+        },
+        "owner_path": {
+          "type": "string",
+          "title": "owner path, concat string group_path:user_id"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when quota create"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "record status changed time"
+        }
+      }
+    },
+    "openpitrixQuotaUsage": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "$ref": "#/definitions/openpitrixQuota",
+          "title": "quota"
+        },
+        "used_clusters": {
+          "type": "integer",
+          "format": "int64",
+          "title": "count of clusters used"
+        },
+        "used_nodes": {
+          "type": "integer",
+          "format": "int64",
+          "title": "count of nodes used"
+        },
+        "used_cpu": {
+          "type": "integer",
+          "format": "int64",
+          "title": "cpu cores used"
+        },
+        "used_memory": {
+          "type": "integer",
+          "format": "int64",
+          "title": "memory(MB) used"
+        },
+        "used_volume_size": {
+          "type": "integer",
+          "format": "int64",
+          "title": "volume size(GB) used"
         }
       }
     },
@@ -10300,6 +13565,11 @@ var Files = map[string]string{
             "type": "string"
           },
           "title": "advanced param"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
         }
       }
     },
@@ -10313,6 +13583,75 @@ var Files = map[string]string{
         "job_id": {
           "type": "string",
           "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
+        }
+      }
+    },
+    "openpitrixRestoreClusterFromSnapshotRequest": {
+      "type": "object",
+      "properties": {
+        "snapshot_id": {
+          "type": "string",
+          "title": "required, id of snapshot to restore cluster from"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
+        }
+      }
+    },
+    "openpitrixRestoreClusterFromSnapshotResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster restored"
+        },
+        "snapshot_id": {
+          "type": "string",
+          "title": "id of snapshot restored from"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
+        }
+      }
+    },
+    "openpitrixRoleMonitor": {
+      "type": "object",
+      "properties": {
+        "role": {
+          "type": "string",
+          "title": "role"
+        },
+        "item_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixMonitorItem"
+          },
+          "title": "monitor items of role defined by app"
+        },
+        "group_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixMonitorGroup"
+          },
+          "title": "monitor groups of role defined by app"
+        },
+        "display": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "names of items and groups to display in order"
         }
       }
     },
@@ -10363,6 +13702,16 @@ var Files = map[string]string{
             "type": "string"
           },
           "title": "advanced param"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
+        },
+        "release_version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "version of helm release revision to roll back a kubernetes cluster to, default to the previous revision"
         }
       }
     },
@@ -10373,6 +13722,103 @@ var Files = map[string]string{
           "type": "string",
           "title": "id of cluster to rollbacked"
         },
+        "job_id": {
+          "type": "string",
+          "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
+        }
+      }
+    },
+    "openpitrixRunClusterNodeCommandRequest": {
+      "type": "object",
+      "properties": {
+        "node_id": {
+          "type": "string",
+          "title": "required, id of cluster node to run command on"
+        },
+        "command": {
+          "type": "string",
+          "title": "required, command run by /bin/sh -c"
+        },
+        "timeout_seconds": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds to kill the command, no timeout if not set"
+        }
+      }
+    },
+    "openpitrixRunClusterNodeCommandResponse": {
+      "type": "object",
+      "properties": {
+        "node_id": {
+          "type": "string",
+          "title": "id of cluster node"
+        },
+        "stdout": {
+          "type": "string",
+          "title": "chunk of stdout"
+        },
+        "stderr": {
+          "type": "string",
+          "title": "chunk of stderr"
+        },
+        "exited": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "true if command exited, it is the last response"
+        },
+        "exit_code": {
+          "type": "integer",
+          "format": "int32",
+          "title": "exit code of command"
+        }
+      }
+    },
+    "openpitrixRunClusterServiceRequest": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "required, id of cluster to run service on"
+        },
+        "service_name": {
+          "type": "string",
+          "title": "required, name of service to run, restart or the custom service defined by app"
+        },
+        "role": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "roles to run service on, default run on all the roles with the service"
+        },
+        "node_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of nodes to run service on, the roles are ignored if nodes are given"
+        },
+        "service_params": {
+          "type": "string",
+          "title": "params of service in json format, validated against the service params declared by app"
+        }
+      }
+    },
+    "openpitrixRunClusterServiceResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster service run on"
+        },
+        "cluster_service_audit_id": {
+          "type": "string",
+          "title": "id of cluster service audit recording the run"
+        },
         "job_id": {
           "type": "string",
           "title": "job id"
@@ -10497,6 +13943,141 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixTask": {
+      "type": "object",
+      "properties": {
+        "task_id": {
+          "type": "string",
+          "title": "task id"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "job id,job will be split to one more task"
+        },
+        "task_action": {
+          "type": "string",
+          "title": "describe the action of the task eg.[WaitFrontgateAvailable|PingFrontgate|AttachVolumes|StartInstances|...]"
+        },
+        "status": {
+          "type": "string",
+          "title": "task status eg.[running|successful|failed|pending]"
+        },
+        "error_code": {
+          "type": "integer",
+          "format": "int64",
+          "title": "error code"
+        },
+        "directive": {
+          "type": "string",
+          "title": "directive,a json string, describe the info of running the task action"
+        },
+        "executor": {
+          "type": "string",
+          "title": "host name of server"
+        },
+        "owner_path": {
+          "type": "string",
+          "title": "owner path, concat string group_path:user_id"
+        },
+        "target": {
+          "type": "string",
+          "title": "describe where the task running eg.[runtime|pilot]"
+        },
+        "node_id": {
+          "type": "string",
+          "title": "the cluster contain one more node"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time when task create"
+        },
+        "status_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "record the status changed time"
+        },
+        "failure_allowed": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "allow task run failed or not"
+        },
+        "owner": {
+          "type": "string",
+          "title": "owner"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int64",
+          "title": "priority of task, task with higher priority is dispatched first"
+        },
+        "attempts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixTaskAttempt"
+          },
+          "title": "history of attempts handling the task"
+        },
+        "retry_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time to retry the failed task automatically"
+        }
+      }
+    },
+    "openpitrixTaskAttempt": {
+      "type": "object",
+      "properties": {
+        "executor": {
+          "type": "string",
+          "title": "host name of server handled the attempt"
+        },
+        "status": {
+          "type": "string",
+          "title": "status of the attempt eg.[successful|failed|cancelled]"
+        },
+        "error_code": {
+          "type": "integer",
+          "format": "int64",
+          "title": "error code, the grpc code of error if the attempt failed"
+        },
+        "error_message": {
+          "type": "string",
+          "title": "error message if the attempt failed"
+        },
+        "start_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time attempt start"
+        },
+        "end_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time attempt end"
+        },
+        "retry_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time task is retried automatically after the attempt failed"
+        }
+      }
+    },
+    "openpitrixTaskLayer": {
+      "type": "object",
+      "properties": {
+        "tasks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixTask"
+          },
+          "title": "task in task layer, a task layer contain one more task"
+        },
+        "child": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "a task layer point to another task layer"
+        }
+      }
+    },
     "openpitrixUpdateClusterEnvRequest": {
       "type": "object",
       "properties": {
@@ -10514,6 +14095,11 @@ var Files = map[string]string{
             "type": "string"
           },
           "title": "advanced param"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
         }
       }
     },
@@ -10527,6 +14113,10 @@ var Files = map[string]string{
         "job_id": {
           "type": "string",
           "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
         }
       }
     },
@@ -10547,6 +14137,11 @@ var Files = map[string]string{
             "type": "string"
           },
           "title": "advanced param"
+        },
+        "dry_run": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "dry run, return the task layers the job would be split into without executing it"
         }
       }
     },
@@ -10560,6 +14155,10 @@ var Files = map[string]string{
         "job_id": {
           "type": "string",
           "title": "job id"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers the job would be split into, set in dry run"
         }
       }
     },
@@ -10580,11 +14179,11 @@ var Files = map[string]string{
         },
         "release_name": {
           "type": "string",
-          "title": "release name"
+          "title": "required, release name, which is the name of cluster as well"
         },
         "namespace": {
           "type": "string",
-          "title": "namespace"
+          "title": "namespace, default to the zone of runtime"
         }
       }
     },
@@ -10593,6 +14192,14 @@ var Files = map[string]string{
       "properties": {
         "release_name": {
           "type": "string"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster created for the release"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "id of the job installing the release"
         }
       }
     },
@@ -10600,14 +14207,20 @@ var Files = map[string]string{
       "type": "object",
       "properties": {
         "runtime_id": {
-          "type": "string"
+          "type": "string",
+          "title": "id of runtime, required with release_name if cluster_id is not set"
         },
         "release_name": {
           "type": "string"
         },
         "purge": {
           "type": "boolean",
-          "format": "boolean"
+          "format": "boolean",
+          "title": "whether to delete the revision history and the cluster as well"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of kubernetes cluster"
         }
       }
     },
@@ -10616,6 +14229,55 @@ var Files = map[string]string{
       "properties": {
         "release_name": {
           "type": "string"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "id of the job deleting the release"
+        }
+      }
+    },
+    "openpitrixDescribeReleaseHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster"
+        },
+        "release_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixRelease"
+          },
+          "title": "revisions of the release of cluster, ordered by version"
+        }
+      }
+    },
+    "openpitrixDiffReleaseRevisionsResponse": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster"
+        },
+        "from_version": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "to_version": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "values_diff": {
+          "type": "string",
+          "title": "unified diff of the user supplied values in yaml"
+        },
+        "manifest_diff": {
+          "type": "string",
+          "title": "unified diff of the rendered manifest"
         }
       }
     },
@@ -10660,6 +14322,14 @@ var Files = map[string]string{
         "deleted_time": {
           "type": "string",
           "format": "date-time"
+        },
+        "chart": {
+          "type": "string",
+          "title": "name and version of chart deployed in the revision, eg. [wordpress-0.3.0]"
+        },
+        "app_version": {
+          "type": "string",
+          "title": "app version of chart deployed in the revision"
         }
       }
     },
@@ -10667,14 +14337,20 @@ var Files = map[string]string{
       "type": "object",
       "properties": {
         "runtime_id": {
-          "type": "string"
+          "type": "string",
+          "title": "id of runtime, required with release_name if cluster_id is not set"
         },
         "release_name": {
           "type": "string"
         },
         "version": {
           "type": "integer",
-          "format": "int32"
+          "format": "int32",
+          "title": "version of revision to roll back to, default to the previous revision"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of kubernetes cluster"
         }
       }
     },
@@ -10686,7 +14362,16 @@ var Files = map[string]string{
         },
         "version": {
           "type": "integer",
-          "format": "int32"
+          "format": "int32",
+          "title": "version of revision rolled back to"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "id of the job rolling back the cluster"
         }
       }
     },
@@ -10694,13 +14379,19 @@ var Files = map[string]string{
       "type": "object",
       "properties": {
         "runtime_id": {
-          "type": "string"
+          "type": "string",
+          "title": "id of runtime, required with release_name if cluster_id is not set"
         },
         "release_name": {
           "type": "string"
         },
         "version_id": {
-          "type": "string"
+          "type": "string",
+          "title": "required, id of app version to upgrade to"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of kubernetes cluster"
         }
       }
     },
@@ -10709,6 +14400,14 @@ var Files = map[string]string{
       "properties": {
         "release_name": {
           "type": "string"
+        },
+        "cluster_id": {
+          "type": "string",
+          "title": "id of cluster"
+        },
+        "job_id": {
+          "type": "string",
+          "title": "id of the job upgrading the cluster"
         }
       }
     },
@@ -10952,6 +14651,24 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixCancelJobRequest": {
+      "type": "object",
+      "properties": {
+        "job_id": {
+          "type": "string",
+          "title": "required, id of job to cancel"
+        }
+      }
+    },
+    "openpitrixCancelJobResponse": {
+      "type": "object",
+      "properties": {
+        "job_id": {
+          "type": "string",
+          "title": "id of job cancelled"
+        }
+      }
+    },
     "openpitrixCreateJobResponse": {
       "type": "object",
       "properties": {
@@ -10991,6 +14708,10 @@ var Files = map[string]string{
             "$ref": "#/definitions/openpitrixJob"
           },
           "title": "list of job"
+        },
+        "next_page_token": {
+          "type": "string",
+          "title": "page token of the next page, empty if there are no more jobs"
         }
       }
     },
@@ -11019,7 +14740,7 @@ var Files = map[string]string{
         },
         "status": {
           "type": "string",
-          "title": "status eg.[successful|failed|running|pending]"
+          "title": "status eg.[successful|failed|running|pending|scheduled|cancelled]"
         },
         "error_code": {
           "type": "integer",
@@ -11064,6 +14785,51 @@ var Files = map[string]string{
         "owner": {
           "type": "string",
           "title": "owner"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int64",
+          "title": "priority of job, job with higher priority is dispatched first"
+        },
+        "scheduled_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time to dispatch the job"
+        }
+      }
+    },
+    "openpitrixWatchJobResponse": {
+      "type": "object",
+      "properties": {
+        "event_type": {
+          "type": "string",
+          "title": "event type eg.[snapshot|job_status|task_layer|task_status|error]"
+        },
+        "job": {
+          "$ref": "#/definitions/openpitrixJob",
+          "title": "job, set in snapshot and job_status event"
+        },
+        "task_layer": {
+          "$ref": "#/definitions/openpitrixTaskLayer",
+          "title": "task layers of job with status of tasks, set in snapshot event"
+        },
+        "layer_index": {
+          "type": "integer",
+          "format": "int64",
+          "title": "index of task layer starts to run, set in task_layer event"
+        },
+        "task": {
+          "$ref": "#/definitions/openpitrixTask",
+          "title": "task whose status changed, set in task_status event"
+        },
+        "error_message": {
+          "type": "string",
+          "title": "error message, set in error event"
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "title": "the time event happened"
         }
       }
     },
@@ -11616,6 +15382,10 @@ var Files = map[string]string{
             "$ref": "#/definitions/openpitrixRepoEvent"
           },
           "title": "list of repository event"
+        },
+        "next_page_token": {
+          "type": "string",
+          "title": "page token of the next page, empty if there are no more repository events"
         }
       }
     },
@@ -11972,6 +15742,34 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixRotateCredentialKeyRequest": {
+      "type": "object",
+      "properties": {
+        "runtime_credential_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of runtime credential to re-encrypt, default re-encrypt all runtime credentials"
+        }
+      }
+    },
+    "openpitrixRotateCredentialKeyResponse": {
+      "type": "object",
+      "properties": {
+        "key_id": {
+          "type": "string",
+          "title": "id of primary master key which encrypts runtime credentials now"
+        },
+        "runtime_credential_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ids of runtime credential re-encrypted"
+        }
+      }
+    },
     "openpitrixRuntime": {
       "type": "object",
       "properties": {
@@ -12124,6 +15922,15 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixAbortSubtaskResponse": {
+      "type": "object",
+      "properties": {
+        "task": {
+          "$ref": "#/definitions/openpitrixTask",
+          "title": "task aborted"
+        }
+      }
+    },
     "openpitrixCheckResourceResponse": {
       "type": "object",
       "properties": {
@@ -12218,87 +16025,6 @@ var Files = map[string]string{
         }
       }
     },
-    "openpitrixTask": {
-      "type": "object",
-      "properties": {
-        "task_id": {
-          "type": "string",
-          "title": "task id"
-        },
-        "job_id": {
-          "type": "string",
-          "title": "job id,job will be split to one more task"
-        },
-        "task_action": {
-          "type": "string",
-          "title": "describe the action of the task eg.[WaitFrontgateAvailable|PingFrontgate|AttachVolumes|StartInstances|...]"
-        },
-        "status": {
-          "type": "string",
-          "title": "task status eg.[running|successful|failed|pending]"
-        },
-        "error_code": {
-          "type": "integer",
-          "format": "int64",
-          "title": "error code"
-        },
-        "directive": {
-          "type": "string",
-          "title": "directive,a json string, describe the info of running the task action"
-        },
-        "executor": {
-          "type": "string",
-          "title": "host name of server"
-        },
-        "owner_path": {
-          "type": "string",
-          "title": "owner path, concat string group_path:user_id"
-        },
-        "target": {
-          "type": "string",
-          "title": "describe where the task running eg.[runtime|pilot]"
-        },
-        "node_id": {
-          "type": "string",
-          "title": "the cluster contain one more node"
-        },
-        "create_time": {
-          "type": "string",
-          "format": "date-time",
-          "title": "the time when task create"
-        },
-        "status_time": {
-          "type": "string",
-          "format": "date-time",
-          "title": "record the status changed time"
-        },
-        "failure_allowed": {
-          "type": "boolean",
-          "format": "boolean",
-          "title": "allow task run failed or not"
-        },
-        "owner": {
-          "type": "string",
-          "title": "owner"
-        }
-      }
-    },
-    "openpitrixTaskLayer": {
-      "type": "object",
-      "properties": {
-        "tasks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/openpitrixTask"
-          },
-          "title": "task in task layer, a task layer contain one more task"
-        },
-        "child": {
-          "$ref": "#/definitions/openpitrixTaskLayer",
-          "title": "a task layer point to another task layer"
-        }
-      }
-    },
     "openpitrixValidateRuntimeResponse": {
       "type": "object",
       "properties": {
@@ -12517,6 +16243,18 @@ var Files = map[string]string{
         }
       }
     },
+    "openpitrixCancelTasksResponse": {
+      "type": "object",
+      "properties": {
+        "task_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/openpitrixTask"
+          },
+          "title": "list of task cancelled"
+        }
+      }
+    },
     "openpitrixCreateTaskResponse": {
       "type": "object",
       "properties": {
@@ -12544,6 +16282,10 @@ var Files = map[string]string{
             "$ref": "#/definitions/openpitrixTask"
           },
           "title": "list of task"
+        },
+        "next_page_token": {
+          "type": "string",
+          "title": "page token of the next page, empty if there are no more tasks"
         }
       }
     },
@@ -131,6 +131,49 @@ func (c *Client) ModifyClusterNodeStatus(ctx context.Context, nodeId string, sta
 	return err
 }
 
+func (c *Client) ModifyClusterSnapshotTransitionStatus(ctx context.Context, snapshotIds []string, transitionStatus string) error {
+	for _, snapshotId := range snapshotIds {
+		_, err := c.ModifyClusterSnapshot(ctx, &pb.ModifyClusterSnapshotRequest{
+			ClusterSnapshot: &pb.ClusterSnapshot{
+				SnapshotId:       pbutil.ToProtoString(snapshotId),
+				TransitionStatus: pbutil.ToProtoString(transitionStatus),
+				StatusTime:       pbutil.ToProtoTimestamp(time.Now()),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ModifyClusterSnapshotStatus(ctx context.Context, snapshotIds []string, status string) error {
+	for _, snapshotId := range snapshotIds {
+		_, err := c.ModifyClusterSnapshot(ctx, &pb.ModifyClusterSnapshotRequest{
+			ClusterSnapshot: &pb.ClusterSnapshot{
+				SnapshotId: pbutil.ToProtoString(snapshotId),
+				Status:     pbutil.ToProtoString(status),
+				StatusTime: pbutil.ToProtoTimestamp(time.Now()),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ModifyClusterServiceAuditStatus(ctx context.Context, clusterServiceAuditId, status string) error {
+	_, err := c.ModifyClusterServiceAudit(ctx, &pb.ModifyClusterServiceAuditRequest{
+		ClusterServiceAudit: &pb.ClusterServiceAudit{
+			ClusterServiceAuditId: pbutil.ToProtoString(clusterServiceAuditId),
+			Status:                pbutil.ToProtoString(status),
+			StatusTime:            pbutil.ToProtoTimestamp(time.Now()),
+		},
+	})
+	return err
+}
+
 func (c *Client) DescribeClustersWithFrontgateId(ctx context.Context, frontgateId string, status []string, debug bool) ([]*pb.Cluster, error) {
 	var request *pb.DescribeClustersRequest
 	var response *pb.DescribeClustersResponse
@@ -31,13 +31,15 @@ func NewClient() (*Client, error) {
 func SendJob(ctx context.Context, job *models.Job) (string, error) {
 	pbJob := models.JobToPb(job)
 	jobRequest := &pb.CreateJobRequest{
-		ClusterId: pbJob.ClusterId,
-		AppId:     pbJob.AppId,
-		VersionId: pbJob.VersionId,
-		JobAction: pbJob.JobAction,
-		Provider:  pbJob.Provider,
-		Directive: pbJob.Directive,
-		RuntimeId: pbJob.RuntimeId,
+		ClusterId:     pbJob.ClusterId,
+		AppId:         pbJob.AppId,
+		VersionId:     pbJob.VersionId,
+		JobAction:     pbJob.JobAction,
+		Provider:      pbJob.Provider,
+		Directive:     pbJob.Directive,
+		RuntimeId:     pbJob.RuntimeId,
+		Priority:      pbJob.Priority,
+		ScheduledTime: pbJob.ScheduledTime,
 	}
 
 	jobClient, err := NewClient()
@@ -102,6 +102,12 @@ func (c *Client) RunCommandOnDroneWithTimeout(ctx context.Context, in *pbtypes.R
 	return c.RunCommandOnDrone(withTimeoutCtx, in, opts...)
 }
 
+func (c *Client) DescribeMonitorMetricsWithTimeout(ctx context.Context, in *pbtypes.DescribeMonitorMetricsRequest, opts ...grpc.CallOption) (*pbtypes.MonitorMetricsList, error) {
+	withTimeoutCtx, cancel := context.WithTimeout(ctx, constants.GrpcToPilotTimeout)
+	defer cancel()
+	return c.DescribeMonitorMetrics(withTimeoutCtx, in, opts...)
+}
+
 func (c *Client) WaitSubtask(ctx context.Context, taskId string, timeout time.Duration, waitInterval time.Duration) error {
 	logger.Debug(ctx, "Waiting for task [%s] finished", taskId)
 	return funcutil.WaitForSpecificOrError(func() (bool, error) {
@@ -112,6 +118,9 @@ func (c *Client) WaitSubtask(ctx context.Context, taskId string, timeout time.Du
 		defer cancel()
 		taskStatusResponse, err := c.GetSubtaskStatus(withTimeoutCtx, taskStatusRequest)
 		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
 			//network or api error, not considered task fail.
 			return false, nil
 		}
@@ -59,6 +59,9 @@ func (c *Client) WaitTask(ctx context.Context, taskId string, timeout time.Durat
 		if t.Status.GetValue() == constants.StatusFailed {
 			return false, fmt.Errorf("Task [%s] failed. ", taskId)
 		}
+		if t.Status.GetValue() == constants.StatusCancelled {
+			return false, fmt.Errorf("Task [%s] cancelled. ", taskId)
+		}
 		logger.Error(ctx, "Unknown status [%s] for task [%s]. ", t.Status.GetValue(), taskId)
 		return false, nil
 	}, timeout, waitInterval)
@@ -74,6 +77,7 @@ func (c *Client) SendTask(ctx context.Context, task *models.Task) (string, error
 		Directive:      pbTask.Directive,
 		FailureAllowed: pbTask.FailureAllowed,
 		Status:         pbTask.Status,
+		Priority:       pbTask.Priority,
 	}
 	response, err := c.CreateTask(ctx, taskRequest)
 	taskId := response.GetTaskId().GetValue()
@@ -83,3 +87,26 @@ func (c *Client) SendTask(ctx context.Context, task *models.Task) (string, error
 	}
 	return taskId, nil
 }
+
+func (c *Client) GetJobTasks(ctx context.Context, jobId string) ([]*models.Task, error) {
+	limit := uint32(50)
+	offset := uint32(0)
+	var tasks []*models.Task
+	for {
+		response, err := c.DescribeTasks(ctx, &pb.DescribeTasksRequest{
+			JobId:  []string{jobId},
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			logger.Error(ctx, "Failed to describe tasks of job [%s]: %+v", jobId, err)
+			return nil, err
+		}
+		tasks = append(tasks, models.PbsToTasks(response.GetTaskSet())...)
+		if len(response.GetTaskSet()) >= int(limit) {
+			offset += uint32(len(response.GetTaskSet()))
+		} else {
+			return tasks, nil
+		}
+	}
+}
@@ -22,13 +22,25 @@ type Config struct {
 	Etcd        EtcdConfig
 	IAM         IAMConfig
 	Attachment  AttachmentConfig
+	Credential  CredentialConfig
 	DisableGops bool `default:"false"`
 }
 
 type IAMConfig struct {
 	SecretKey              string        `default:"OpenPitrix-lC4LipAXPYsuqw5F"`
 	ExpireTime             time.Duration `default:"2h"`
-	RefreshTokenExpireTime time.Duration `default:"336h"` // default is 2 week
+	RefreshTokenExpireTime time.Duration `default:"336h"`  // default is 2 week
+	CanDoCacheSize         int           `default:"10240"` // 0 disables caching decisions of CanDo in api gateway
+	CanDoCacheTTL          time.Duration `default:"1m"`
+}
+
+// Master keys are base64 encoded 32 bytes keys, the first one encrypts new credentials,
+// the others only decrypt credentials until RotateCredentialKey re-encrypted them.
+// Credentials are stored in plaintext when no master key is configured.
+type CredentialConfig struct {
+	KeyManager    string `default:"local"` // only local is supported now
+	MasterKey     string // comma separated master keys, eg. set by OPENPITRIX_CREDENTIAL_MASTERKEY
+	MasterKeyFile string // file contains master keys one per line, loaded after MasterKey
 }
 
 type AttachmentConfig struct {
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/retryutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
 	"openpitrix.io/openpitrix/pkg/util/yamlutil"
 )
 
@@ -36,10 +39,21 @@ type RepoServiceConfig struct {
 }
 
 type ClusterServiceConfig struct {
-	FrontgateConf       string `json:"frontgate_conf"`
-	FrontgateAutoDelete bool   `json:"frontgate_auto_delete"`
-	FrontgateAutoUpdate bool   `json:"frontgate_auto_update"`
-	RegistryMirror      string `json:"registry_mirror"`
+	FrontgateConf       string                `json:"frontgate_conf"`
+	FrontgateAutoDelete bool                  `json:"frontgate_auto_delete"`
+	FrontgateAutoUpdate bool                  `json:"frontgate_auto_update"`
+	FrontgateUpdate     FrontgateUpdateConfig `json:"frontgate_update"`
+	RegistryMirror      string                `json:"registry_mirror"`
+}
+
+// release_url is the url pattern of the release dir (%s is the version), set it
+// to a mirror for air-gapped sites; the release manifest is verified with
+// release_public_key, the frontgates are not updated if it is not set
+type FrontgateUpdateConfig struct {
+	ReleaseUrl       string `json:"release_url"`
+	ReleasePublicKey string `json:"release_public_key"`
+	DroneCanaryCount int32  `json:"drone_canary_count"`
+	DroneBatchSize   int32  `json:"drone_batch_size"`
 }
 
 type PilotServiceConfig struct {
@@ -47,12 +61,45 @@ type PilotServiceConfig struct {
 	Port int32  `json:"port"`
 }
 
+// limits per runtime and per owner are shared by all job managers, 0 means unlimited
 type JobServiceConfig struct {
-	MaxWorkingJobs int32 `json:"max_working_jobs"`
+	MaxWorkingJobs           int32 `json:"max_working_jobs"`
+	MaxWorkingJobsPerRuntime int32 `json:"max_working_jobs_per_runtime"`
+	MaxWorkingJobsPerOwner   int32 `json:"max_working_jobs_per_owner"`
 }
 
+// limits per runtime and per owner are shared by all task managers, 0 means unlimited
 type TaskServiceConfig struct {
-	MaxWorkingTasks int32 `json:"max_working_tasks"`
+	MaxWorkingTasks           int32                       `json:"max_working_tasks"`
+	MaxWorkingTasksPerRuntime int32                       `json:"max_working_tasks_per_runtime"`
+	MaxWorkingTasksPerOwner   int32                       `json:"max_working_tasks_per_owner"`
+	RetryPolicies             map[string]*TaskRetryPolicy `json:"retry_policies"`
+}
+
+// TaskRetryPolicy retries the failed tasks of a task action automatically,
+// backoff in seconds doubles for each retry and is capped by max_backoff
+type TaskRetryPolicy struct {
+	// attempts including the first one
+	MaxAttempts    int32 `json:"max_attempts"`
+	InitialBackoff int32 `json:"initial_backoff"`
+	MaxBackoff     int32 `json:"max_backoff"`
+	// grpc codes of errors to retry, eg.[Unavailable|DeadlineExceeded|Unknown]
+	RetryableCodes []string `json:"retryable_codes"`
+}
+
+// GetRetryPolicy returns the retry policy of the task action, nil if not retried automatically
+func (t *TaskServiceConfig) GetRetryPolicy(taskAction string) *TaskRetryPolicy {
+	return t.RetryPolicies[taskAction]
+}
+
+func (p *TaskRetryPolicy) IsRetryable(code string) bool {
+	return stringutil.StringIn(code, p.RetryableCodes)
+}
+
+// GetBackoff returns the duration to wait before the retry, retries starts from 0
+func (p *TaskRetryPolicy) GetBackoff(retries int) time.Duration {
+	return retryutil.Backoff(
+		time.Duration(p.InitialBackoff)*time.Second, time.Duration(p.MaxBackoff)*time.Second, retries)
 }
 
 type BasicConfig struct {
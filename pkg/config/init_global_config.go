@@ -28,10 +28,30 @@ cluster:
   frontgate_conf: '{"app_id":"app-ABCDEFGHIJKLMNOPQRST","version_id":"appv-ABCDEFGHIJKLMNOPQRST","name":"frontgate","description":"OpenPitrixbuilt-infrontgateservice","subnet":"","nodes":[{"container":{"type":"docker","image":"openpitrix/openpitrix:metadata"},"count":1,"cpu":1,"memory":1024,"volume":{"size":10,"mount_point":"/data","filesystem":"ext4"}}]}'
   frontgate_auto_delete: true
   frontgate_auto_update: false
+  frontgate_update:
+    release_url: ""
+    release_public_key: ""
+    drone_canary_count: 1
+    drone_batch_size: 5
 job:
   max_working_jobs: 20
+  max_working_jobs_per_runtime: 10
+  max_working_jobs_per_owner: 10
 task:
   max_working_tasks: 20
+  max_working_tasks_per_runtime: 10
+  max_working_tasks_per_owner: 10
+  retry_policies:
+    RunInstances:
+      max_attempts: 3
+      initial_backoff: 10
+      max_backoff: 60
+      retryable_codes: [Unavailable, DeadlineExceeded, ResourceExhausted]
+    WaitFrontgateAvailable:
+      max_attempts: 3
+      initial_backoff: 30
+      max_backoff: 120
+      retryable_codes: [Unavailable, DeadlineExceeded, Unknown]
 pilot:
   ip: 127.0.0.1
   port: 9114
@@ -108,15 +108,25 @@ var Fields = []string{
 
 const (
 	ColumnAppId                    = "app_id"
+	ColumnAutoBackup               = "auto_backup"
+	ColumnBackupPolicy             = "backup_policy"
+	ColumnBackupService            = "backup_service"
 	ColumnCategoryId               = "category_id"
 	ColumnChartName                = "chart_name"
+	ColumnChildSnapshotIds         = "child_snapshot_ids"
 	ColumnClusterId                = "cluster_id"
+	ColumnClusterServiceAuditId    = "cluster_service_audit_id"
 	ColumnClusterType              = "cluster_type"
+	ColumnClusterUpgradeAuditId    = "cluster_upgrade_audit_id"
 	ColumnCreateTime               = "create_time"
 	ColumnCredential               = "credential"
 	ColumnDescription              = "description"
 	ColumnExecutor                 = "executor"
 	ColumnFrontgateId              = "frontgate_id"
+	ColumnHealthCheck              = "health_check"
+	ColumnHealthCheckTime          = "health_check_time"
+	ColumnHealthStatus             = "health_status"
+	ColumnHealthyCount             = "healthy_count"
 	ColumnHome                     = "home"
 	ColumnIcon                     = "icon"
 	ColumnInstanceId               = "instance_id"
@@ -133,7 +143,9 @@ const (
 	ColumnOwner                    = "owner"
 	ColumnOwnerPath                = "owner_path"
 	ColumnPackageName              = "package_name"
+	ColumnParentSnapshotId         = "parent_snapshot_id"
 	ColumnPrivateIp                = "private_ip"
+	ColumnQuotaId                  = "quota_id"
 	ColumnProvider                 = "provider"
 	ColumnReadme                   = "readme"
 	ColumnRepoEventId              = "repo_event_id"
@@ -151,14 +163,21 @@ const (
 	ColumnSelectorKey              = "selector_key"
 	ColumnSelectorValue            = "selector_value"
 	ColumnSequence                 = "sequence"
+	ColumnServiceName              = "service_name"
+	ColumnSnapshotId               = "snapshot_id"
+	ColumnSnapshotType             = "snapshot_type"
 	ColumnSources                  = "sources"
 	ColumnStatus                   = "status"
 	ColumnStatusTime               = "status_time"
+	ColumnSubject                  = "subject"
+	ColumnSubjectType              = "subject_type"
 	ColumnTarget                   = "target"
 	ColumnTaskAction               = "task_action"
 	ColumnTaskId                   = "task_id"
+	ColumnToVersionId              = "to_version_id"
 	ColumnTransitionStatus         = "transition_status"
 	ColumnType                     = "type"
+	ColumnUnhealthyCount           = "unhealthy_count"
 	ColumnUpdateTime               = "update_time"
 	ColumnUrl                      = "url"
 	ColumnVersionId                = "version_id"
@@ -193,6 +212,10 @@ const (
 	ColumnReviewId                 = "review_id"
 	ColumnPhase                    = "phase"
 	ColumnReviewer                 = "reviewer"
+	ColumnPolicyId                 = "policy_id"
+	ColumnScopeType                = "scope_type"
+	ColumnScopeId                  = "scope_id"
+	ColumnStages                   = "stages"
 	ColumnCompanyName              = "company_name"
 	ColumnCompanyWebsite           = "company_website"
 	ColumnCompanyProfile           = "company_profile"
@@ -206,6 +229,12 @@ const (
 	ColumnSubmitTime               = "submit_time"
 	ColumnApprover                 = "approver"
 	ColumnIsv                      = "isv"
+	ColumnCheckpoint               = "checkpoint"
+	ColumnPriority                 = "priority"
+	ColumnScheduledTime            = "scheduled_time"
+	ColumnErrorCode                = "error_code"
+	ColumnAttempts                 = "attempts"
+	ColumnRetryTime                = "retry_time"
 )
 
 var PushEventTables = map[string][]string{
@@ -216,7 +245,7 @@ var PushEventTables = map[string][]string{
 		ColumnClusterId, ColumnStatus, ColumnTransitionStatus,
 	},
 	TableClusterNode: {
-		ColumnNodeId, ColumnStatus, ColumnTransitionStatus,
+		ColumnNodeId, ColumnStatus, ColumnTransitionStatus, ColumnHealthStatus,
 	},
 	TableJob: {
 		ColumnJobId, ColumnStatus, ColumnClusterId, ColumnAppId, ColumnAppId,
@@ -267,8 +296,17 @@ var IndexedColumns = map[string][]string{
 	TableKeyPair: {
 		ColumnKeyPairId, ColumnName, ColumnOwner,
 	},
+	TableQuota: {
+		ColumnQuotaId, ColumnSubjectType, ColumnSubject, ColumnRuntimeId,
+	},
 	TableClusterNode: {
-		ColumnClusterId, ColumnNodeId, ColumnStatus, ColumnOwner,
+		ColumnClusterId, ColumnNodeId, ColumnStatus, ColumnHealthStatus, ColumnOwner,
+	},
+	TableClusterSnapshot: {
+		ColumnSnapshotId, ColumnClusterId, ColumnSnapshotType, ColumnParentSnapshotId, ColumnStatus, ColumnOwner,
+	},
+	TableClusterServiceAudit: {
+		ColumnClusterServiceAuditId, ColumnClusterId, ColumnServiceName, ColumnStatus, ColumnOwner,
 	},
 	TableCategory: {
 		ColumnCategoryId, ColumnStatus, ColumnLocale, ColumnOwner, ColumnName,
@@ -288,6 +326,9 @@ var IndexedColumns = map[string][]string{
 	TableVendorVerifyInfo: {
 		ColumnUserId, ColumnStatus,
 	},
+	TableReviewPolicy: {
+		ColumnPolicyId, ColumnScopeType, ColumnScopeId, ColumnOwner,
+	},
 }
 
 var SearchWordColumnTable = []string{
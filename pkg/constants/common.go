@@ -22,6 +22,7 @@ const (
 	CategoryManagerHost        = hyperpitrix
 	RuntimeProviderManagerHost = hyperpitrix
 	AttachmentManagerHost      = hyperpitrix
+	ReleaseManagerHost         = hyperpitrix
 	AccountServiceHost         = prefix + "account-service"
 	PilotServiceHost           = prefix + "pilot-service"
 	IMServiceHost              = prefix + "im-service"
@@ -57,6 +58,7 @@ const (
 	RuntimeProviderManagerPort = 9121
 	KubernetesProviderPort     = 9123
 	ReleaseManagerPort         = 9124
+	ApiGatewayDebugPort        = 9125 // internal, listened on loopback only
 	NotificationPort           = 9201
 	ServiceConfigPort          = 9202
 	ServicePushPort            = 9203
@@ -73,6 +75,7 @@ const (
 	StatusUpgrading   = "upgrading"
 	StatusUpdating    = "updating"
 	StatusRollbacking = "rollbacking"
+	StatusRollbacked  = "rollbacked"
 	StatusStopped     = "stopped"
 	StatusStopping    = "stopping"
 	StatusStarting    = "starting"
@@ -81,10 +84,16 @@ const (
 	StatusCeasing     = "ceasing"
 	StatusResizing    = "resizing"
 	StatusScaling     = "scaling"
+	StatusBackingUp   = "backing-up"
+	StatusRestoring   = "restoring"
 	StatusWorking     = "working"
 	StatusPending     = "pending"
 	StatusSuccessful  = "successful"
 	StatusFailed      = "failed"
+	StatusCancelled   = "cancelled"
+	StatusScheduled   = "scheduled"
+	StatusHealthy     = "healthy"
+	StatusUnhealthy   = "unhealthy"
 
 	StatusRunning    = "running"
 	StatusTerminated = "terminated"
@@ -119,6 +128,28 @@ const (
 	DefaultMaxRepoEvents   = 20
 )
 
+// Jobs and tasks with higher priority are dispatched first, tasks inherit the priority of job
+const (
+	MinJobPriority     = 0
+	MaxJobPriority     = 9
+	DefaultJobPriority = 5
+)
+
+// Events of job pushed to the watchers of job
+const (
+	JobEventSnapshot   = "snapshot"
+	JobEventJobStatus  = "job_status"
+	JobEventTaskLayer  = "task_layer"
+	JobEventTaskStatus = "task_status"
+	JobEventError      = "error"
+)
+
+var JobFinishedStatuses = []string{
+	StatusSuccessful,
+	StatusFailed,
+	StatusCancelled,
+}
+
 const (
 	MaxTaskTimeout               = 3600 * time.Second
 	WaitHelmTaskTimeout          = 7200 * time.Second
@@ -137,6 +168,41 @@ const (
 	// Maybe metadata is upgrading
 	PilotTasksRetry = 5
 	PilotTasksSleep = 2 * time.Second
+
+	// Jobs and tasks held by an executor are taken over by others after the lease expired
+	ExecutorLeaseTTL           = 30
+	ExecutorLeaseRetryInterval = 3 * time.Second
+	RecoverWorkingInterval     = 30 * time.Second
+
+	// Jobs and tasks exceeding the concurrency limit of runtime or owner are requeued after the interval
+	ConcurrencyLimitRetryInterval = 3 * time.Second
+
+	// Scheduled jobs and retried tasks are dispatched by the interval after their scheduled time
+	DispatchScheduledJobsInterval = 10 * time.Second
+	DispatchRetryTasksInterval    = 10 * time.Second
+
+	// Clusters are checked by the interval whether it is time to back up by the backup policy
+	ScheduleBackupInterval = 5 * time.Minute
+	ScheduleBackupTimeout  = 2 * time.Minute
+
+	// Nodes are checked by the interval whether it is time to run the health check of role
+	ScheduleHealthCheckInterval = 10 * time.Second
+	ScheduleHealthCheckTimeout  = 2 * time.Minute
+	MaxRunningHealthChecks      = 50
+
+	// Time series of cluster monitor are returned in the duration before end time by default
+	DefaultMonitorDuration = time.Hour
+)
+
+const (
+	MonitorStatisticsLatest = "latest"
+	MonitorStatisticsDelta  = "delta"
+	MonitorStatisticsRate   = "rate"
+)
+
+const (
+	// subtask has been handled by pilot or runtime provider, only need to wait for it
+	TaskCheckpointHandled = "handled"
 )
 
 const (
@@ -154,6 +220,12 @@ const (
 	ActionUpdateClusterEnv   = "UpdateClusterEnv"
 	ActionAttachKeyPairs     = "AttachKeyPairs"
 	ActionDetachKeyPairs     = "DetachKeyPairs"
+
+	ActionCreateClusterSnapshot      = "CreateClusterSnapshot"
+	ActionRestoreClusterFromSnapshot = "RestoreClusterFromSnapshot"
+	ActionDeleteClusterSnapshots     = "DeleteClusterSnapshots"
+
+	ActionRunClusterService = "RunClusterService"
 )
 
 const (
@@ -233,6 +305,9 @@ const (
 	GrantTypePassword          = "password"
 	GrantTypeRefreshToken      = "refresh_token"
 
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
+
 	OperatorTypeGlobalAdmin = "global_admin"
 	OperatorTypeDeveloper   = "developer"
 	OperatorTypeBusiness    = "business"
@@ -274,3 +349,45 @@ var ServiceTypes = []string{
 	ServiceTypeRuntime,
 	ServiceTypeBasicConfig,
 }
+
+const (
+	// the quota of user limits the clusters owned by the user
+	QuotaSubjectTypeUser = "user"
+	// the quota of group limits the clusters owned by the users in the group and its sub groups
+	QuotaSubjectTypeGroup = "group"
+	// the quota of owner path limits the clusters with owner path prefixed by it
+	QuotaSubjectTypeOwnerPath = "owner_path"
+)
+
+var QuotaSubjectTypes = []string{
+	QuotaSubjectTypeUser,
+	QuotaSubjectTypeGroup,
+	QuotaSubjectTypeOwnerPath,
+}
+
+const (
+	// the review policy of category applies to the apps in the category
+	ReviewPolicyScopeTypeCategory = "category"
+	// the review policy of market applies to the apps submitted by the users of the market
+	ReviewPolicyScopeTypeMarket = "market"
+)
+
+var ReviewPolicyScopeTypes = []string{
+	ReviewPolicyScopeTypeCategory,
+	ReviewPolicyScopeTypeMarket,
+}
+
+const (
+	// the full snapshot backs up all the data of cluster
+	SnapshotTypeFull = "full"
+	// the incremental snapshot backs up the data changed since the full snapshot it is based on
+	SnapshotTypeIncremental = "incremental"
+)
+
+// backup policies of app scheduling the backups of clusters,
+// a duration such as "12h" is also accepted, other policies never back up automatically
+const (
+	BackupPolicyHourly = "hourly"
+	BackupPolicyDaily  = "daily"
+	BackupPolicyWeekly = "weekly"
+)
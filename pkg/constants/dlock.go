@@ -7,4 +7,26 @@ package constants
 const (
 	RepoIndexPrefix = "repo_index_"
 	ClusterPrefix   = "cluster_"
+
+	ClusterSnapshotPrefix = "cluster_snapshot_"
+	QuotaLock             = "quota"
+	ScheduleBackupLock    = "schedule_backup"
+
+	ScheduleHealthCheckLock = "schedule_health_check"
+
+	// keys must not start with "job" or "task", which are prefixes of the job and task queues
+	JobExecutorPrefix  = "executor_job_"
+	TaskExecutorPrefix = "executor_task_"
+
+	JobRuntimeSemaphorePrefix  = "semaphore_job_runtime_"
+	JobOwnerSemaphorePrefix    = "semaphore_job_owner_"
+	TaskRuntimeSemaphorePrefix = "semaphore_task_runtime_"
+	TaskOwnerSemaphorePrefix   = "semaphore_task_owner_"
+
+	JobEventPrefix    = "event_job_"
+	AccessEventPrefix = "event_access_"
+
+	RevokedTokenPrefix   = "revoked_token_"
+	RevokedSessionPrefix = "revoked_session_"
+	RevokedUserPrefix    = "revoked_user_"
 )
@@ -15,9 +15,13 @@ const (
 	TableClusterLoadbalancer = "cluster_loadbalancer"
 	TableClusterNode         = "cluster_node"
 	TableClusterRole         = "cluster_role"
+	TableClusterServiceAudit = "cluster_service_audit"
+	TableClusterSnapshot     = "cluster_snapshot"
+	TableClusterUpgradeAudit = "cluster_upgrade_audit"
 	TableJob                 = "job"
 	TableKeyPair             = "key_pair"
 	TableNodeKeyPair         = "node_key_pair"
+	TableQuota               = "quota"
 	TableRepo                = "repo"
 	TableRepoEvent           = "repo_event"
 	TableRepoLabel           = "repo_label"
@@ -41,4 +45,5 @@ const (
 	TableAppVersionAudit  = "app_version_audit"
 	TableAppVersionReview = "app_version_review"
 	TableVendorVerifyInfo = "vendor_verify_info"
+	TableReviewPolicy     = "review_policy"
 )
@@ -105,3 +105,20 @@ func Lt(column string, value interface{}) dbr.Builder {
 func Lte(column string, value interface{}) dbr.Builder {
 	return dbr.Lte(column, value)
 }
+
+// After is the condition of the rows after the position of cursor,
+// it returns nil if the cursor starts from the first row.
+func After(c *Cursor) dbr.Builder {
+	if c.Key == "" {
+		return nil
+	}
+	cmp := Lt
+	if c.Asc {
+		cmp = Gt
+	}
+	value := c.value()
+	return Or(
+		cmp(c.Column, value),
+		And(Eq(c.Column, value), cmp(c.PrimaryKey, c.Key)),
+	)
+}
@@ -69,6 +69,16 @@ func TestCondition(t *testing.T) {
 			query: "(`a` < ?) AND ((`b` > ?) OR (`c` != ?))",
 			value: []interface{}{1, 2, 3},
 		},
+		{
+			cond:  After(&Cursor{Column: "name", PrimaryKey: "id", Asc: true, Value: "a", Key: "id-1"}),
+			query: "(`name` > ?) OR ((`name` = ?) AND (`id` > ?))",
+			value: []interface{}{"a", "a", "id-1"},
+		},
+		{
+			cond:  After(&Cursor{Column: "name", PrimaryKey: "id", Asc: false, Value: "a", Key: "id-1"}),
+			query: "(`name` < ?) OR ((`name` = ?) AND (`id` < ?))",
+			value: []interface{}{"a", "a", "id-1"},
+		},
 	} {
 		buf := dbr.NewBuffer()
 		err := test.cond.Build(dialect.MySQL, buf)
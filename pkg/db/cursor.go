@@ -0,0 +1,151 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// Cursor is the position of the last row of a page in keyset pagination.
+// Rows are ordered by the sort column and then by the primary key, and the next page
+// starts after the cursor, so that pages keep consistent while rows are being inserted.
+type Cursor struct {
+	Column     string `json:"c"`
+	PrimaryKey string `json:"p"`
+	Asc        bool   `json:"a"`
+	// Time is set instead of Value if the sort column is time
+	Time  *time.Time  `json:"t,omitempty"`
+	Value interface{} `json:"v"`
+	// Key is the primary key of the last row, empty means starting from the first row
+	Key string `json:"k,omitempty"`
+}
+
+func NewCursor(column, primaryKey string, asc bool) *Cursor {
+	return &Cursor{
+		Column:     column,
+		PrimaryKey: primaryKey,
+		Asc:        asc,
+	}
+}
+
+// DecodeCursor decodes the opaque page token encoded by Cursor.Encode
+func DecodeCursor(pageToken string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, fmt.Errorf("page token [%s] is illegal: %+v", pageToken, err)
+	}
+	var c Cursor
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return nil, fmt.Errorf("page token [%s] is illegal: %+v", pageToken, err)
+	}
+	if c.Column == "" || c.PrimaryKey == "" || c.Key == "" {
+		return nil, fmt.Errorf("page token [%s] is illegal", pageToken)
+	}
+	return &c, nil
+}
+
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// SameOrder returns whether the rows are ordered in the same way by the cursors
+func (c *Cursor) SameOrder(other *Cursor) bool {
+	return c.Column == other.Column && c.PrimaryKey == other.PrimaryKey && c.Asc == other.Asc
+}
+
+func (c *Cursor) value() interface{} {
+	if c.Time != nil {
+		return *c.Time
+	}
+	return c.Value
+}
+
+func getColumnValue(row reflect.Value, column string) (reflect.Value, error) {
+	row = reflect.Indirect(row)
+	if row.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("row [%s] is not struct", row.Type())
+	}
+	for i := 0; i < row.NumField(); i++ {
+		if stringutil.CamelCaseToUnderscore(row.Type().Field(i).Name) == column {
+			return reflect.Indirect(row.Field(i)), nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("column [%s] not found in row [%s]", column, row.Type())
+}
+
+// Next returns the cursor positioned at the row, which is loaded by the query ordered by the cursor
+func (c *Cursor) Next(row interface{}) (*Cursor, error) {
+	value, err := getColumnValue(reflect.ValueOf(row), c.Column)
+	if err != nil {
+		return nil, err
+	}
+	key, err := getColumnValue(reflect.ValueOf(row), c.PrimaryKey)
+	if err != nil {
+		return nil, err
+	}
+	if !key.IsValid() {
+		return nil, fmt.Errorf("primary key [%s] of row is nil", c.PrimaryKey)
+	}
+	next := NewCursor(c.Column, c.PrimaryKey, c.Asc)
+	next.Key = fmt.Sprint(key.Interface())
+	if value.IsValid() {
+		if t, ok := value.Interface().(time.Time); ok {
+			next.Time = &t
+		} else {
+			next.Value = value.Interface()
+		}
+	}
+	return next, nil
+}
+
+// NextPageToken returns the page token of the page after rows, rows should be a slice
+// of the rows loaded by the query ordered by the cursor. The page token is empty
+// if there are less rows than limit, which means there are no more rows.
+func (c *Cursor) NextPageToken(rows interface{}, limit uint64) (string, error) {
+	v := reflect.Indirect(reflect.ValueOf(rows))
+	if v.Kind() != reflect.Slice {
+		return "", fmt.Errorf("rows [%s] is not slice", v.Type())
+	}
+	if v.Len() == 0 || uint64(v.Len()) < limit {
+		return "", nil
+	}
+	next, err := c.Next(v.Index(v.Len() - 1).Interface())
+	if err != nil {
+		return "", err
+	}
+	return next.Encode(), nil
+}
+
+// ExportPages sends the pages loaded one by one, starting from the page of pageToken.
+// describe loads the page of the page token with the page token of next page,
+// the export finishes after the last page, whose next page token is empty.
+func ExportPages(
+	pageToken string,
+	describe func(pageToken string) (page interface{}, nextPageToken string, err error),
+	send func(page interface{}) error,
+) error {
+	for {
+		page, nextPageToken, err := describe(pageToken)
+		if err != nil {
+			return err
+		}
+		err = send(page)
+		if err != nil {
+			return err
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+type cursorTestRow struct {
+	JobId      string
+	Priority   int
+	CreateTime time.Time
+}
+
+func TestCursorNextPageToken(t *testing.T) {
+	createTime := time.Date(2018, 10, 18, 2, 36, 11, 0, time.UTC)
+	rows := []*cursorTestRow{
+		{JobId: "j-1", Priority: 1, CreateTime: createTime.Add(time.Second)},
+		{JobId: "j-2", Priority: 2, CreateTime: createTime},
+	}
+
+	cursor := NewCursor("create_time", "job_id", false)
+	pageToken, err := cursor.NextPageToken(rows, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pageToken != "" {
+		t.Errorf("Page token should be empty if rows is less than limit, got [%s]", pageToken)
+	}
+
+	pageToken, err = cursor.NextPageToken(rows, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := DecodeCursor(pageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.SameOrder(cursor) {
+		t.Errorf("Cursor [%+v] should be in the same order of [%+v]", next, cursor)
+	}
+	if next.Key != "j-2" || next.Time == nil || !next.Time.Equal(createTime) {
+		t.Errorf("Cursor [%+v] should be positioned at the last row", next)
+	}
+
+	cursor = NewCursor("priority", "job_id", true)
+	pageToken, err = cursor.NextPageToken(rows, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err = DecodeCursor(pageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// numbers are decoded as float64 from json
+	if next.Key != "j-2" || next.Time != nil || next.Value != float64(2) {
+		t.Errorf("Cursor [%+v] should be positioned at the last row", next)
+	}
+
+	cursor = NewCursor("status", "job_id", true)
+	_, err = cursor.NextPageToken(rows, 2)
+	if err == nil {
+		t.Errorf("Cursor of column not in rows should be illegal")
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	for _, pageToken := range []string{
+		"not base64!",
+		NewCursor("create_time", "job_id", false).Encode(),
+		"bm90IGpzb24",
+	} {
+		_, err := DecodeCursor(pageToken)
+		if err == nil {
+			t.Errorf("Page token [%s] should be illegal", pageToken)
+		}
+	}
+}
+
+func TestExportPages(t *testing.T) {
+	pages := map[string][]string{
+		"":   {"j-1", "j-2"},
+		"p2": {"j-3", "j-4"},
+		"p3": {"j-5"},
+	}
+	nextPageTokens := map[string]string{"": "p2", "p2": "p3", "p3": ""}
+
+	var exported []string
+	err := ExportPages("",
+		func(pageToken string) (interface{}, string, error) {
+			return pages[pageToken], nextPageTokens[pageToken], nil
+		},
+		func(page interface{}) error {
+			exported = append(exported, page.([]string)...)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != 5 || exported[4] != "j-5" {
+		t.Errorf("Exported rows [%v] should be all rows", exported)
+	}
+
+	exported = nil
+	err = ExportPages("p3",
+		func(pageToken string) (interface{}, string, error) {
+			return pages[pageToken], nextPageTokens[pageToken], nil
+		},
+		func(page interface{}) error {
+			exported = append(exported, page.([]string)...)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != 1 {
+		t.Errorf("Exported rows [%v] should start from the page token", exported)
+	}
+}
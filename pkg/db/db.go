@@ -38,6 +38,7 @@ type SelectQuery struct {
 	*dbr.SelectBuilder
 	ctx       context.Context
 	JoinCount int // for join filter
+	cursor    *Cursor
 }
 
 type InsertQuery struct {
@@ -75,15 +76,15 @@ type Conn struct {
 //          SelectAll().From().Where().Count()
 
 func (conn *Conn) Select(columns ...string) *SelectQuery {
-	return &SelectQuery{conn.Session.Select(columns...), conn.ctx, 0}
+	return &SelectQuery{conn.Session.Select(columns...), conn.ctx, 0, nil}
 }
 
 func (conn *Conn) SelectBySql(query string, value ...interface{}) *SelectQuery {
-	return &SelectQuery{conn.Session.SelectBySql(query, value...), conn.ctx, 0}
+	return &SelectQuery{conn.Session.SelectBySql(query, value...), conn.ctx, 0, nil}
 }
 
 func (conn *Conn) SelectAll(columns ...string) *SelectQuery {
-	return &SelectQuery{conn.Session.Select("*"), conn.ctx, 0}
+	return &SelectQuery{conn.Session.Select("*"), conn.ctx, 0, nil}
 }
 
 func (b *SelectQuery) Join(table, on interface{}) *SelectQuery {
@@ -141,14 +142,61 @@ func (b *SelectQuery) OrderDir(col string, isAsc bool) *SelectQuery {
 	return b
 }
 
+// OrderByCursor orders the query by the sort column and then by the primary key of cursor,
+// the rows loaded start after the position of cursor, and the offset is ignored if it is set
+func (b *SelectQuery) OrderByCursor(c *Cursor) *SelectQuery {
+	// the cursor of next page is taken from the last row loaded
+	if len(b.Column) > 0 && getColumns(b.Column) != "*" {
+		for _, column := range []string{c.Column, c.PrimaryKey} {
+			if !columnSelected(b.Column, column) {
+				b.Column = append(b.Column, column)
+			}
+		}
+	}
+	if c.Key != "" {
+		b.SelectBuilder.Offset(0)
+	}
+	b.cursor = c
+	b.SelectBuilder.OrderDir(c.Column, c.Asc)
+	if c.Column != c.PrimaryKey {
+		b.SelectBuilder.OrderDir(c.PrimaryKey, c.Asc)
+	}
+	return b
+}
+
+// whereCursor adds the condition of cursor until the returned func is called,
+// it only applies to loading rows, so that the count of query is not affected
+func (b *SelectQuery) whereCursor() func() {
+	whereCond := b.WhereCond
+	if b.cursor != nil {
+		if cond := After(b.cursor); cond != nil {
+			b.WhereCond = append(whereCond[:len(whereCond):len(whereCond)], cond)
+		}
+	}
+	return func() {
+		b.WhereCond = whereCond
+	}
+}
+
 func (b *SelectQuery) Load(value interface{}) (int, error) {
+	defer b.whereCursor()()
 	return b.SelectBuilder.LoadContext(b.ctx, value)
 }
 
 func (b *SelectQuery) LoadOne(value interface{}) error {
+	defer b.whereCursor()()
 	return b.SelectBuilder.LoadOneContext(b.ctx, value)
 }
 
+func columnSelected(dbrColumns []interface{}, column string) bool {
+	for _, c := range dbrColumns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
 func getColumns(dbrColumns []interface{}) string {
 	for _, column := range dbrColumns {
 		if c, ok := column.(string); ok {
@@ -178,7 +226,7 @@ func (b *SelectQuery) Count() (count uint32, err error) {
 		b.IsDistinct = false
 	}
 
-	err = b.LoadOne(&count)
+	err = b.SelectBuilder.LoadOneContext(b.ctx, &count)
 	// fallback SelectStmt
 	selectStmt.LimitCount = limit
 	selectStmt.OffsetCount = offset
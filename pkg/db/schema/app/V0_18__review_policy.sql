@@ -0,0 +1,24 @@
+CREATE TABLE review_policy
+(
+	policy_id   VARCHAR(50)  NOT NULL,
+	name        VARCHAR(255) NOT NULL,
+	scope_type  VARCHAR(50)  NOT NULL,
+	scope_id    VARCHAR(50)  NOT NULL,
+	stages      JSON         NOT NULL,
+	description TEXT         NOT NULL,
+	owner       VARCHAR(50)  NOT NULL,
+	owner_path  VARCHAR(255) NOT NULL,
+	create_time TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	status_time TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (policy_id)
+);
+
+CREATE UNIQUE INDEX review_policy_scope_idx
+	ON review_policy (scope_type, scope_id);
+CREATE INDEX review_policy_owner_idx
+	ON review_policy (owner);
+CREATE INDEX review_policy_create_time_idx
+	ON review_policy (create_time);
+
+ALTER TABLE app_version_review
+	ADD COLUMN stages JSON NULL;
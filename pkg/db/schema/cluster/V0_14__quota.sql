@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS quota (
+	quota_id        VARCHAR(50)   NOT NULL,
+	subject_type    VARCHAR(50)   NOT NULL,
+	subject         VARCHAR(255)  NOT NULL,
+	runtime_id      VARCHAR(50)   NOT NULL DEFAULT '',
+	max_clusters    INT UNSIGNED  NOT NULL DEFAULT 0,
+	max_nodes       INT UNSIGNED  NOT NULL DEFAULT 0,
+	max_cpu         INT UNSIGNED  NOT NULL DEFAULT 0,
+	max_memory      INT UNSIGNED  NOT NULL DEFAULT 0,
+	max_volume_size INT UNSIGNED  NOT NULL DEFAULT 0,
+	description     VARCHAR(1000) NULL,
+	owner           VARCHAR(255)  NOT NULL,
+	owner_path      VARCHAR(255)  NOT NULL,
+	create_time     TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	status_time     TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX quota_subject_index (subject_type ASC, subject ASC),
+	INDEX quota_runtime_id_index (runtime_id ASC),
+	UNIQUE INDEX quota_subject_runtime_index (subject_type, subject, runtime_id),
+	PRIMARY KEY (quota_id)
+);
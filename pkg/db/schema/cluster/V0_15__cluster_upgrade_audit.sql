@@ -0,0 +1,8 @@
+ALTER TABLE cluster_upgrade_audit
+	CHANGE COLUMN from_app_version from_version_id VARCHAR(50) NOT NULL;
+ALTER TABLE cluster_upgrade_audit
+	CHANGE COLUMN to_app_version to_version_id VARCHAR(50) NOT NULL;
+ALTER TABLE cluster_upgrade_audit
+	CHANGE COLUMN upgrade_time status_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+CREATE INDEX cluster_upgrade_audit_create_time_idx
+	ON cluster_upgrade_audit (create_time);
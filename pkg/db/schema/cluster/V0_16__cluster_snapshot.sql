@@ -0,0 +1,30 @@
+ALTER TABLE cluster_snapshot
+	DROP PRIMARY KEY,
+	DROP COLUMN role,
+	DROP COLUMN server_ids,
+	DROP COLUMN count,
+	DROP COLUMN app_version,
+	DROP COLUMN size,
+	MODIFY COLUMN snapshot_id VARCHAR(50) NOT NULL,
+	ADD COLUMN cluster_id VARCHAR(50) NOT NULL,
+	ADD COLUMN name VARCHAR(50) NOT NULL DEFAULT '',
+	ADD COLUMN description VARCHAR(1000) NULL,
+	ADD COLUMN roles TEXT NOT NULL,
+	ADD COLUMN node_ids TEXT NOT NULL,
+	ADD COLUMN version_id VARCHAR(50) NOT NULL,
+	ADD COLUMN snapshot_type VARCHAR(50) NOT NULL,
+	ADD COLUMN parent_snapshot_id VARCHAR(50) NOT NULL DEFAULT '',
+	ADD COLUMN auto_backup BOOL NOT NULL DEFAULT 0,
+	ADD COLUMN status VARCHAR(50) NOT NULL,
+	ADD COLUMN transition_status VARCHAR(50) NOT NULL DEFAULT '',
+	ADD COLUMN owner VARCHAR(255) NOT NULL,
+	ADD COLUMN owner_path VARCHAR(255) NOT NULL,
+	ADD COLUMN create_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	ADD COLUMN status_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	ADD PRIMARY KEY (snapshot_id);
+CREATE INDEX cluster_snapshot_cluster_id_index
+	ON cluster_snapshot (cluster_id);
+CREATE INDEX cluster_snapshot_parent_snapshot_id_index
+	ON cluster_snapshot (parent_snapshot_id);
+CREATE INDEX cluster_snapshot_owner_path_index
+	ON cluster_snapshot (owner_path);
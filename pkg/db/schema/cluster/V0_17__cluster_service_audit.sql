@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS cluster_service_audit (
+	cluster_service_audit_id VARCHAR(50)  NOT NULL,
+	cluster_id               VARCHAR(50)  NOT NULL,
+	job_id                   VARCHAR(50)  NOT NULL DEFAULT '',
+	service_name             VARCHAR(255) NOT NULL,
+	roles                    TEXT         NOT NULL,
+	node_ids                 TEXT         NOT NULL,
+	service_params           TEXT         NOT NULL,
+	status                   VARCHAR(50)  NOT NULL,
+	owner                    VARCHAR(255) NOT NULL,
+	owner_path               VARCHAR(255) NOT NULL,
+	create_time              TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	status_time              TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX cluster_service_audit_cluster_id_index (cluster_id ASC),
+	INDEX cluster_service_audit_owner_path_index (owner_path ASC),
+	PRIMARY KEY (cluster_service_audit_id)
+);
@@ -0,0 +1,8 @@
+ALTER TABLE cluster_node
+	ADD COLUMN healthy_count INT NOT NULL DEFAULT 0;
+ALTER TABLE cluster_node
+	ADD COLUMN unhealthy_count INT NOT NULL DEFAULT 0;
+ALTER TABLE cluster_node
+	ADD COLUMN health_check_time TIMESTAMP NULL DEFAULT NULL;
+CREATE INDEX cluster_node_health_status_index
+	ON cluster_node (health_status);
@@ -0,0 +1 @@
+ALTER TABLE job ADD COLUMN checkpoint MEDIUMTEXT NOT NULL;
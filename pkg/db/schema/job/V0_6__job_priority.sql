@@ -0,0 +1,3 @@
+ALTER TABLE job ADD COLUMN priority INT(11) NOT NULL DEFAULT 5;
+ALTER TABLE job ADD COLUMN scheduled_time TIMESTAMP NULL DEFAULT NULL;
+CREATE INDEX job_scheduled_time_index ON job (scheduled_time ASC);
@@ -0,0 +1 @@
+ALTER TABLE task ADD COLUMN checkpoint VARCHAR(50) NOT NULL DEFAULT '';
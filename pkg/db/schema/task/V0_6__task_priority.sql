@@ -0,0 +1 @@
+ALTER TABLE task ADD COLUMN priority INT(11) NOT NULL DEFAULT 5;
@@ -0,0 +1,3 @@
+ALTER TABLE task ADD COLUMN attempts MEDIUMTEXT NOT NULL;
+ALTER TABLE task ADD COLUMN retry_time TIMESTAMP NULL DEFAULT NULL;
+CREATE INDEX task_retry_time_index ON task (retry_time ASC);
@@ -0,0 +1,603 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+const (
+	LintError   = "ERROR"
+	LintWarning = "WARNING"
+)
+
+var (
+	templateLineRegExp = regexp.MustCompile(`template: ?[\w.-]+:(\d+):`)
+	driveRegExp        = regexp.MustCompile(`^[d-z]:$`)
+
+	optionalCmdServices = map[string]bool{
+		"backup":          true,
+		"restore":         true,
+		"delete_snapshot": true,
+	}
+)
+
+type LintMessage struct {
+	Severity string
+	File     string
+	Line     int
+	Message  string
+}
+
+func (m LintMessage) String() string {
+	pos := m.File
+	if m.Line > 0 {
+		pos = fmt.Sprintf("%s:%d", m.File, m.Line)
+	}
+	if len(pos) == 0 {
+		return fmt.Sprintf("[%s] %s", m.Severity, m.Message)
+	}
+	return fmt.Sprintf("[%s] %s: %s", m.Severity, pos, m.Message)
+}
+
+type LintResult struct {
+	Messages []LintMessage
+}
+
+func (r *LintResult) HasError() bool {
+	for _, m := range r.Messages {
+		if m.Severity == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+type linter struct {
+	result  *LintResult
+	dirName string
+	files   map[string][]byte
+}
+
+func (l *linter) add(m LintMessage) {
+	for _, exist := range l.result.Messages {
+		if exist == m {
+			return
+		}
+	}
+	l.result.Messages = append(l.result.Messages, m)
+}
+
+func (l *linter) errorf(file string, line int, format string, a ...interface{}) {
+	l.add(LintMessage{LintError, file, line, fmt.Sprintf(format, a...)})
+}
+
+func (l *linter) warnf(file string, line int, format string, a ...interface{}) {
+	l.add(LintMessage{LintWarning, file, line, fmt.Sprintf(format, a...)})
+}
+
+// Lint examines the app directory or archive, reports the errors and warnings
+// with the file and line they found in.
+func Lint(name string) *LintResult {
+	l := &linter{
+		result: new(LintResult),
+		files:  make(map[string][]byte),
+	}
+
+	files, err := readFiles(name)
+	if err != nil {
+		l.errorf("", 0, "failed to read app: %+v", err)
+		return l.result
+	}
+	for _, f := range files {
+		l.files[f.Name] = f.Data
+	}
+	if fi, err := os.Stat(name); err == nil && fi.IsDir() {
+		if path, err := filepath.Abs(name); err == nil {
+			l.dirName = filepath.Base(path)
+		}
+	}
+
+	metadata := l.lintPackageJson()
+	configTemplate := l.lintConfigJson()
+	if _, ok := l.files[ClusterJsonTmpl]; !ok {
+		l.errorf(ClusterJsonTmpl, 0, "file missing")
+		return l.result
+	}
+	if metadata == nil || configTemplate == nil {
+		return l.result
+	}
+
+	app, err := Load(name)
+	if app == nil || app.ConfigTemplate == nil || app.ClusterConfTemplate == nil {
+		if !l.result.HasError() {
+			l.errorf("", 0, "failed to load app: %+v", err)
+		}
+		return l.result
+	}
+	// the error of validating cluster.json.tmpl with the default config is explained by lintClusterConf
+	l.lintClusterConf(app)
+	return l.result
+}
+
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func (l *linter) decode(file string, data []byte, v interface{}) bool {
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return true
+	}
+	line := 0
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		line = lineAt(data, e.Offset)
+	case *json.UnmarshalTypeError:
+		line = lineAt(data, e.Offset)
+	}
+	l.errorf(file, line, "failed to decode: %+v", err)
+	return false
+}
+
+func (l *linter) lintPackageJson() *opapp.Metadata {
+	data, ok := l.files[PackageJson]
+	if !ok {
+		l.errorf(PackageJson, 0, "file missing")
+		return nil
+	}
+	metadata := new(opapp.Metadata)
+	if !l.decode(PackageJson, data, metadata) {
+		return nil
+	}
+	lines := indexJsonLines(data)
+
+	if len(metadata.Name) == 0 {
+		l.errorf(PackageJson, lines.line("name"), "name must not be empty")
+	} else if len(l.dirName) > 0 && metadata.Name != l.dirName {
+		l.errorf(PackageJson, lines.line("name"), "name [%s] and directory name [%s] must match", metadata.Name, l.dirName)
+	}
+
+	if len(metadata.Version) == 0 {
+		l.errorf(PackageJson, lines.line("version"), "version must not be empty")
+	} else if _, err := semver.NewVersion(metadata.Version); err != nil {
+		l.errorf(PackageJson, lines.line("version"), "version [%s] is not a valid semver: %+v", metadata.Version, err)
+	}
+
+	switch metadata.ApiVersion {
+	case ApiVersionV1:
+	case "":
+		l.warnf(PackageJson, 0, "api_version is empty")
+	default:
+		l.errorf(PackageJson, lines.line("api_version"), "unsupported api_version [%s]", metadata.ApiVersion)
+	}
+
+	if len(metadata.AppVersion) == 0 {
+		l.warnf(PackageJson, 0, "app_version is empty")
+	}
+	if len(metadata.Description) == 0 {
+		l.warnf(PackageJson, lines.line("description"), "description is empty")
+	}
+	for i, maintainer := range metadata.Maintainers {
+		if maintainer == nil || len(maintainer.Name) == 0 {
+			l.warnf(PackageJson, lines.line(joinPath("maintainers", i)), "name of maintainer is empty")
+		}
+	}
+	if len(metadata.Icon) > 0 && !strings.Contains(metadata.Icon, "://") {
+		if _, ok := l.files[metadata.Icon]; !ok {
+			l.warnf(PackageJson, lines.line("icon"), "icon [%s] not found in app", metadata.Icon)
+		}
+	}
+	return metadata
+}
+
+func (l *linter) lintConfigJson() *opapp.ConfigTemplate {
+	data, ok := l.files[ConfigJson]
+	if !ok {
+		l.errorf(ConfigJson, 0, "file missing")
+		return nil
+	}
+	configTemplate := new(opapp.ConfigTemplate)
+	if !l.decode(ConfigJson, data, configTemplate) {
+		return nil
+	}
+	configTemplate.Raw = string(data)
+
+	l.lintConfig(&configTemplate.Config, indexJsonLines(data), "", nil)
+	if err := configTemplate.Validate(configTemplate.GetDefaultConfig()); err != nil {
+		l.warnf(ConfigJson, 0, "default config is invalid: %+v", err)
+	}
+	return configTemplate
+}
+
+func configName(parent []string) string {
+	if len(parent) == 0 {
+		return "(root)"
+	}
+	return strings.Join(parent, ".")
+}
+
+func inRange(value interface{}, values []interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *linter) lintConfig(c *opapp.Config, lines jsonLines, path string, parent []string) {
+	line := lines.line(path)
+	name := configName(parent)
+	switch c.Type {
+	case opapp.TypeArray:
+		if len(c.Properties) == 0 {
+			l.errorf(ConfigJson, line, "properties of [%s] must not be empty", name)
+		}
+		keys := make(map[string]bool)
+		for i, p := range c.Properties {
+			propertyPath := joinPath(path, "properties", i)
+			if len(p.Key) == 0 {
+				l.errorf(ConfigJson, lines.line(propertyPath), "key of property [%d] in [%s] must not be empty", i, name)
+			} else if keys[p.Key] {
+				l.errorf(ConfigJson, lines.line(propertyPath), "key [%s] is duplicated in [%s]", p.Key, name)
+			}
+			keys[p.Key] = true
+			l.lintConfig(p, lines, propertyPath, append(parent[:len(parent):len(parent)], p.Key))
+		}
+	case opapp.TypeString, opapp.TypePassword, opapp.TypeInteger, opapp.TypeNumber, opapp.TypeBoolean:
+		if len(c.Range) > 0 && c.Default != nil && !inRange(c.Default, c.Range) {
+			l.warnf(ConfigJson, line, "default value [%v] of [%s] is not in range %v", c.Default, name, c.Range)
+		}
+		if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+			l.errorf(ConfigJson, line, "min [%v] of [%s] is larger than max [%v]", *c.Min, name, *c.Max)
+		}
+		if c.Pattern != nil {
+			re, err := regexp.Compile(*c.Pattern)
+			if err != nil {
+				l.errorf(ConfigJson, line, "pattern of [%s] is invalid: %+v", name, err)
+			} else if s, ok := c.Default.(string); ok && len(s) > 0 && !re.MatchString(s) {
+				l.warnf(ConfigJson, line, "default value [%s] of [%s] does not match pattern [%s]", s, name, *c.Pattern)
+			}
+		}
+	default:
+		l.warnf(ConfigJson, line, "unknown type [%s] of [%s]", c.Type, name)
+	}
+}
+
+func templateErrorLine(err error) int {
+	matches := templateLineRegExp.FindStringSubmatch(err.Error())
+	if len(matches) < 2 {
+		return 0
+	}
+	line, _ := strconv.Atoi(matches[1])
+	return line
+}
+
+// explainClusterConf renders cluster.json.tmpl and validates it like
+// opapp.ValidateClusterConfTmpl, but keeps the line of every error.
+// The expressions of template are rendered in place, so the line of
+// rendered cluster.json is the line of cluster.json.tmpl.
+func explainClusterConf(tmpl *opapp.ClusterConfTemplate, input jsonutil.Json) []LintMessage {
+	newMessage := func(line int, format string, a ...interface{}) LintMessage {
+		return LintMessage{LintError, ClusterJsonTmpl, line, fmt.Sprintf(format, a...)}
+	}
+
+	cluster, err := tmpl.Render(input)
+	if err != nil {
+		line := 0
+		switch e := errors.Cause(err).(type) {
+		case *json.SyntaxError:
+			line = lineAt([]byte(cluster.RenderJson), e.Offset)
+		case *json.UnmarshalTypeError:
+			line = lineAt([]byte(cluster.RenderJson), e.Offset)
+		default:
+			line = templateErrorLine(e)
+		}
+		return []LintMessage{newMessage(line, "%+v", err)}
+	}
+
+	schemaErrors, err := cluster.SchemaErrors()
+	if err != nil {
+		return []LintMessage{newMessage(0, "failed to validate cluster.json: %+v", err)}
+	}
+	lines := indexJsonLines([]byte(cluster.RenderJson))
+	var messages []LintMessage
+	for _, e := range schemaErrors {
+		field := e.Field()
+		if field == "(root)" {
+			field = ""
+		}
+		messages = append(messages, newMessage(lines.line(field), "%s", e.String()))
+	}
+	return messages
+}
+
+func (l *linter) lintClusterConf(app *opapp.OpApp) {
+	variants := app.ConfigTemplate.GetDefaultConfigVariants()
+	reported := make(map[LintMessage]bool)
+	for i, variant := range variants {
+		err := opapp.ValidateClusterConfTmpl(app.ClusterConfTemplate, variant.Config)
+		if err == nil {
+			continue
+		}
+		for _, m := range explainClusterConf(app.ClusterConfTemplate, variant.Config) {
+			// report the errors of variants which not found with the default config
+			if reported[m] {
+				continue
+			}
+			reported[m] = true
+			if i > 0 {
+				m.Message = fmt.Sprintf("%s (when [%s] is [%v])", m.Message, variant.Key, variant.Value)
+			}
+			l.add(m)
+		}
+	}
+
+	cluster, err := app.ClusterConfTemplate.Render(variants[0].Config)
+	if err != nil {
+		return
+	}
+	lines := indexJsonLines([]byte(cluster.RenderJson))
+	roles := make(map[string]int)
+	for i, node := range cluster.Nodes {
+		path := joinPath("nodes", i)
+		if len(node.Role) == 0 {
+			if len(cluster.Nodes) > 1 {
+				l.errorf(ClusterJsonTmpl, lines.line(path), "role of node must not be empty when cluster has multiple nodes")
+			}
+		} else if j, ok := roles[node.Role]; ok {
+			l.errorf(ClusterJsonTmpl, lines.line(joinPath(path, "role")), "role [%s] is duplicated with nodes.%d", node.Role, j)
+		} else {
+			roles[node.Role] = i
+		}
+		l.lintVolume(node, lines, path)
+		l.lintServices(node, lines, path)
+	}
+	l.lintRoleReferences(app.ConfigTemplate, roles)
+}
+
+func mountPoints(mountPoint interface{}) []string {
+	switch m := mountPoint.(type) {
+	case string:
+		if len(m) > 0 {
+			return []string{m}
+		}
+	case []interface{}:
+		var points []string
+		for _, p := range m {
+			if s, ok := p.(string); ok {
+				points = append(points, s)
+			}
+		}
+		return points
+	}
+	return nil
+}
+
+func (l *linter) lintVolume(node opapp.Node, lines jsonLines, path string) {
+	volume := node.Volume
+	line := lines.line(joinPath(path, "volume"))
+	points := mountPoints(volume.MountPoint)
+	if volume.Size > 0 && len(points) == 0 {
+		l.warnf(ClusterJsonTmpl, line, "volume size of role [%s] is set but mount_point is empty", node.Role)
+	}
+	if volume.Size == 0 && len(points) > 0 {
+		l.errorf(ClusterJsonTmpl, line, "mount_point of role [%s] is set but volume size is 0", node.Role)
+	}
+	if len(volume.MountOptions) > 0 && len(points) == 0 {
+		l.warnf(ClusterJsonTmpl, line, "mount_options of role [%s] is set but mount_point is empty", node.Role)
+	}
+	for _, point := range points {
+		isDrive := driveRegExp.MatchString(point)
+		if volume.Filesystem == "ntfs" && !isDrive {
+			l.errorf(ClusterJsonTmpl, line, "mount_point [%s] of role [%s] must be a drive letter with ntfs", point, node.Role)
+		}
+		if (volume.Filesystem == "ext4" || volume.Filesystem == "xfs") && isDrive {
+			l.errorf(ClusterJsonTmpl, line, "mount_point [%s] of role [%s] must be a path with %s", point, node.Role, volume.Filesystem)
+		}
+	}
+}
+
+func (l *linter) lintServices(node opapp.Node, lines jsonLines, path string) {
+	for name, s := range node.Services {
+		line := lines.line(joinPath(path, "services", name))
+		b, err := json.Marshal(s)
+		if err != nil {
+			continue
+		}
+		var service opapp.Service
+		if err = json.Unmarshal(b, &service); err != nil {
+			// the type of fields is reported by schema
+			continue
+		}
+		// cmd of other services is required by schema
+		if optionalCmdServices[name] && len(strings.TrimSpace(service.Cmd)) == 0 {
+			l.warnf(ClusterJsonTmpl, line, "service [%s] of role [%s] has no cmd", name, node.Role)
+		}
+		if service.NodesToExecuteOn != nil && *service.NodesToExecuteOn > node.Count {
+			l.warnf(ClusterJsonTmpl, line, "nodes_to_execute_on [%d] of service [%s] is larger than count [%d] of role [%s]",
+				*service.NodesToExecuteOn, name, node.Count, node.Role)
+		}
+	}
+	if _, ok := node.Services["restore"]; ok {
+		if _, ok := node.Services["backup"]; !ok {
+			l.warnf(ClusterJsonTmpl, lines.line(joinPath(path, "services", "restore")), "service [restore] of role [%s] is defined without service [backup]", node.Role)
+		}
+	}
+}
+
+// lintRoleReferences checks the role-based properties under cluster in config.json,
+// which are referred by the role of nodes in cluster.json.tmpl
+func (l *linter) lintRoleReferences(configTemplate *opapp.ConfigTemplate, roles map[string]int) {
+	lines := indexJsonLines(l.files[ConfigJson])
+	for i, p := range configTemplate.Properties {
+		if p.Key != "cluster" {
+			continue
+		}
+		for j, property := range p.Properties {
+			if property.Type != opapp.TypeArray || !isRoleConfig(property) {
+				continue
+			}
+			if _, ok := roles[property.Key]; !ok {
+				line := lines.line(joinPath("properties", i, "properties", j))
+				l.warnf(ConfigJson, line, "role [%s] is not found in nodes of cluster.json.tmpl", property.Key)
+			}
+		}
+	}
+}
+
+func isRoleConfig(c *opapp.Config) bool {
+	for _, p := range c.Properties {
+		switch p.Key {
+		case "count", "cpu", "memory", "instance_class":
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(elem ...interface{}) string {
+	var path []string
+	for _, e := range elem {
+		s := fmt.Sprint(e)
+		if len(s) > 0 {
+			path = append(path, s)
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+// jsonLines maps the dotted path of json values to the line they start
+type jsonLines map[string]int
+
+// line returns the line of path, or the line of its closest parent
+func (j jsonLines) line(path string) int {
+	for {
+		if line, ok := j[path]; ok {
+			return line
+		}
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return j[""]
+		}
+		path = path[:i]
+	}
+}
+
+type jsonScanner struct {
+	data  []byte
+	pos   int
+	line  int
+	lines jsonLines
+}
+
+func indexJsonLines(data []byte) jsonLines {
+	s := &jsonScanner{data: data, line: 1, lines: make(jsonLines)}
+	s.skipSpace()
+	s.value("")
+	return s.lines
+}
+
+func (s *jsonScanner) skipSpace() {
+	for ; s.pos < len(s.data); s.pos++ {
+		switch s.data[s.pos] {
+		case '\n':
+			s.line++
+		case ' ', '\t', '\r':
+		default:
+			return
+		}
+	}
+}
+
+func (s *jsonScanner) value(path string) {
+	s.lines[path] = s.line
+	if s.pos >= len(s.data) {
+		return
+	}
+	switch s.data[s.pos] {
+	case '{':
+		s.pos++
+		for {
+			s.skipSpace()
+			if s.pos >= len(s.data) || s.data[s.pos] == '}' {
+				s.pos++
+				return
+			}
+			if s.data[s.pos] == ',' {
+				s.pos++
+				continue
+			}
+			key := s.str()
+			s.skipSpace()
+			if s.pos < len(s.data) && s.data[s.pos] == ':' {
+				s.pos++
+			}
+			s.skipSpace()
+			s.value(joinPath(path, key))
+		}
+	case '[':
+		s.pos++
+		for i := 0; ; {
+			s.skipSpace()
+			if s.pos >= len(s.data) || s.data[s.pos] == ']' {
+				s.pos++
+				return
+			}
+			if s.data[s.pos] == ',' {
+				s.pos++
+				continue
+			}
+			s.value(joinPath(path, i))
+			i++
+		}
+	case '"':
+		s.str()
+	default:
+		start := s.pos
+		for s.pos < len(s.data) && !strings.ContainsRune(",]} \t\r\n", rune(s.data[s.pos])) {
+			s.pos++
+		}
+		if s.pos == start {
+			s.pos++
+		}
+	}
+}
+
+func (s *jsonScanner) str() string {
+	start := s.pos
+	for s.pos++; s.pos < len(s.data); s.pos++ {
+		switch s.data[s.pos] {
+		case '\\':
+			s.pos++
+		case '\n':
+			s.line++
+		case '"':
+			s.pos++
+			var str string
+			json.Unmarshal(s.data[start:s.pos], &str)
+			return str
+		}
+	}
+	return ""
+}
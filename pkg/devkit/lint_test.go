@@ -0,0 +1,169 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package devkit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var lintConfigJson = `{
+    "type": "array",
+    "properties": [{
+        "key": "cluster",
+        "type": "array",
+        "properties": [{
+            "key": "name",
+            "type": "string",
+            "default": "nginx"
+        }, {
+            "key": "description",
+            "type": "string",
+            "default": ""
+        }, {
+            "key": "subnet",
+            "type": "string",
+            "default": "",
+            "required": true
+        }, {
+            "key": "nginx_node",
+            "type": "array",
+            "properties": [{
+                "key": "count",
+                "type": "integer",
+                "default": 1,
+                "range": [1, 2, 300]
+            }, {
+                "key": "cpu",
+                "type": "integer",
+                "default": 1,
+                "range": [1, 2, 4]
+            }]
+        }]
+    }]
+}
+`
+
+var lintClusterJsonTmpl = `{
+    "name": "{{.cluster.name}}",
+    "description": "{{.cluster.description}}",
+    "subnet": "{{.cluster.subnet}}",
+    "nodes": [{
+        "role": "nginx_node",
+        "container": {
+            "type": "kvm",
+            "image": "img-nginx"
+        },
+        "count": "{{.cluster.nginx_node.count}}",
+        "cpu": "{{.cluster.nginx_node.cpu}}",
+        "volume": {
+            "size": 0,
+            "mount_point": "/data",
+            "filesystem": "ext4"
+        },
+        "services": {
+            "start": {
+                "cmd": "systemctl start nginx"
+            },
+            "restore": {
+                "cmd": "restore.sh"
+            }
+        }
+    }]
+}
+`
+
+func writeLintApp(t *testing.T, packageJson string) string {
+	dir, err := ioutil.TempDir("", "lint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	appDir := filepath.Join(dir, "nginx")
+	files := map[string]string{
+		PackageJson:     packageJson,
+		ConfigJson:      lintConfigJson,
+		ClusterJsonTmpl: lintClusterJsonTmpl,
+	}
+	if err = os.Mkdir(appDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err = ioutil.WriteFile(filepath.Join(appDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return appDir
+}
+
+func findLintMessage(result *LintResult, severity, file string, line int, substr string) bool {
+	for _, m := range result.Messages {
+		if m.Severity == severity && m.File == file && m.Line == line && strings.Contains(m.Message, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLint(t *testing.T) {
+	appDir := writeLintApp(t, `{
+    "api_version": "v1",
+    "name": "nginx",
+    "version": "latest",
+    "app_version": "1.14",
+    "description": "nginx"
+}`)
+	defer os.RemoveAll(filepath.Dir(appDir))
+
+	result := Lint(appDir)
+	for _, m := range result.Messages {
+		t.Log(m)
+	}
+	if !result.HasError() {
+		t.Fatal("lint should report errors")
+	}
+
+	tests := []struct {
+		severity string
+		file     string
+		line     int
+		substr   string
+	}{
+		{LintError, PackageJson, 4, "not a valid semver"},
+		{LintError, ClusterJsonTmpl, 13, "volume size is 0"},
+		{LintWarning, ClusterJsonTmpl, 22, "service [restore] of role [nginx_node] is defined without service [backup]"},
+		{LintError, ClusterJsonTmpl, 11, "when [cluster.nginx_node.count] is [300]"},
+	}
+	for _, tt := range tests {
+		if !findLintMessage(result, tt.severity, tt.file, tt.line, tt.substr) {
+			t.Errorf("expect [%s] %s:%d: %s", tt.severity, tt.file, tt.line, tt.substr)
+		}
+	}
+	if findLintMessage(result, LintError, ClusterJsonTmpl, 11, "when [cluster.nginx_node.count] is [2]") {
+		t.Error("valid variant should not be reported")
+	}
+}
+
+func TestIndexJsonLines(t *testing.T) {
+	lines := indexJsonLines([]byte(lintClusterJsonTmpl))
+	tests := map[string]int{
+		"":                              1,
+		"name":                          2,
+		"nodes":                         5,
+		"nodes.0":                       5,
+		"nodes.0.container.image":       9,
+		"nodes.0.services.start.cmd":    20,
+		"nodes.0.services.restore":      22,
+		"nodes.0.services.restore.none": 22,
+		"nodes.1":                       5,
+	}
+	for path, line := range tests {
+		if lines.line(path) != line {
+			t.Errorf("expect line of [%s] is [%d], got [%d]", path, line, lines.line(path))
+		}
+	}
+}
@@ -53,10 +53,18 @@ func LoadFile(name string) (*opapp.OpApp, error) {
 
 // LoadArchive loads from a reader containing a compressed tar archive.
 func LoadArchive(in io.Reader) (*opapp.OpApp, error) {
-	unzipped, err := gzip.NewReader(in)
+	files, err := readArchive(in)
 	if err != nil {
 		return &opapp.OpApp{}, err
 	}
+	return LoadFiles(files)
+}
+
+func readArchive(in io.Reader) ([]opapp.BufferedFile, error) {
+	unzipped, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, err
+	}
 	defer unzipped.Close()
 
 	var files []opapp.BufferedFile
@@ -68,7 +76,7 @@ func LoadArchive(in io.Reader) (*opapp.OpApp, error) {
 			break
 		}
 		if err != nil {
-			return &opapp.OpApp{}, err
+			return nil, err
 		}
 
 		if hd.FileInfo().IsDir() {
@@ -94,7 +102,7 @@ func LoadArchive(in io.Reader) (*opapp.OpApp, error) {
 		}
 
 		if _, err := io.Copy(b, tr); err != nil {
-			return &opapp.OpApp{}, err
+			return nil, err
 		}
 
 		files = append(files, opapp.BufferedFile{Name: n, Data: b.Bytes()})
@@ -105,18 +113,25 @@ func LoadArchive(in io.Reader) (*opapp.OpApp, error) {
 		return nil, fmt.Errorf("no files in app archive")
 	}
 
-	return LoadFiles(files)
+	return files, nil
 }
 
 func LoadDir(dir string) (*opapp.OpApp, error) {
+	files, err := readDir(dir)
+	if err != nil {
+		// Just used for errors.
+		return &opapp.OpApp{}, err
+	}
+
+	return LoadFiles(files)
+}
+
+func readDir(dir string) ([]opapp.BufferedFile, error) {
 	topdir, err := filepath.Abs(dir)
 	if err != nil {
 		return nil, err
 	}
 
-	// Just used for errors.
-	c := &opapp.OpApp{}
-
 	var files []opapp.BufferedFile
 	topdir += string(filepath.Separator)
 
@@ -142,10 +157,29 @@ func LoadDir(dir string) (*opapp.OpApp, error) {
 		return nil
 	})
 	if err != nil {
-		return c, err
+		return nil, err
 	}
 
-	return LoadFiles(files)
+	return files, nil
+}
+
+// readFiles reads the files of app directory or archive without decoding them
+func readFiles(name string) ([]opapp.BufferedFile, error) {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return readDir(name)
+	}
+
+	raw, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer raw.Close()
+
+	return readArchive(raw)
 }
 
 // LoadFiles loads from in-memory files.
@@ -39,3 +39,25 @@ func (a *OpApp) Validate(config jsonutil.Json) error {
 	}
 	return nil
 }
+
+// RenderClusterConf fills the config into the defaults of config.json, then
+// renders and validates the cluster conf the same way as creating a cluster
+func (a *OpApp) RenderClusterConf(config jsonutil.Json) (ClusterConf, error) {
+	if config != nil {
+		a.ConfigTemplate.FillInDefaultConfig(config)
+	}
+	conf := a.ConfigTemplate.GetDefaultConfig()
+	err := a.Validate(conf)
+	if err != nil {
+		return ClusterConf{}, err
+	}
+	clusterConf, err := a.ClusterConfTemplate.Render(conf)
+	if err != nil {
+		return ClusterConf{}, err
+	}
+	err = clusterConf.Validate()
+	if err != nil {
+		return ClusterConf{}, errors.Wrap(err, "validate rendered cluster.json failed")
+	}
+	return clusterConf, nil
+}
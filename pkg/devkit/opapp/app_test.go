@@ -0,0 +1,57 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package opapp
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+func newTestOpApp(t *testing.T) *OpApp {
+	configTemplate, err := DecodeConfigJson([]byte(testConfigJson))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &OpApp{
+		ConfigTemplate:      configTemplate,
+		ClusterConfTemplate: &ClusterConfTemplate{Raw: testClusterTmpl},
+	}
+}
+
+func TestOpApp_RenderClusterConf(t *testing.T) {
+	// default config
+	clusterConf, err := newTestOpApp(t).RenderClusterConf(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusterConf.Name != "Sample" || clusterConf.Nodes[0].Count != 3 {
+		t.Fatalf("unexpected cluster conf: %s", clusterConf.RenderJson)
+	}
+
+	// values override the default config
+	values, err := jsonutil.NewJson([]byte(`{"cluster": {"subnet": "vxnet-1", "role_name1": {"count": 5}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusterConf, err = newTestOpApp(t).RenderClusterConf(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusterConf.Name != "Sample" || clusterConf.Subnet != "vxnet-1" || clusterConf.Nodes[0].Count != 5 {
+		t.Fatalf("unexpected cluster conf: %s", clusterConf.RenderJson)
+	}
+
+	// invalid values
+	values, err = jsonutil.NewJson([]byte(`{"cluster": {"role_name1": {"count": 500}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = newTestOpApp(t).RenderClusterConf(values)
+	if err == nil {
+		t.Fatal("count large than max should be rejected")
+	}
+	t.Log(err)
+}
@@ -10,6 +10,10 @@ import (
 	"fmt"
 	"html/template"
 	"regexp"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 )
@@ -45,17 +49,17 @@ func (c *ClusterConfTemplate) Render(input jsonutil.Json) (ClusterConf, error) {
 		"getv": getv,
 	}).Parse(raw)
 	if err != nil {
-		return cluster, fmt.Errorf("failed to parse cluster.json.tmpl: %+v", err)
+		return cluster, errors.WithMessage(err, "failed to parse cluster.json.tmpl")
 	}
 	b := bytes.NewBuffer([]byte{})
 	err = tmpl.Execute(b, input.Interface())
 	if err != nil {
-		return cluster, fmt.Errorf("failed to render cluster.json: %+v", err)
+		return cluster, errors.WithMessage(err, "failed to render cluster.json")
 	}
 	cluster.RenderJson = b.String()
 	err = json.Unmarshal(b.Bytes(), &cluster)
 	if err != nil {
-		return cluster, fmt.Errorf("failed to decode cluster.json: %+v", err)
+		return cluster, errors.WithMessage(err, "failed to decode cluster.json")
 	}
 	return cluster, nil
 }
@@ -108,19 +112,68 @@ type HealthCheck struct {
 	ActionCmd          string `json:"action_cmd"`
 }
 
+const (
+	defaultHealthCheckIntervalSec = 60
+	defaultHealthCheckTimeoutSec  = 10
+)
+
+// IsEnabled returns whether the health check is run on nodes, it is enabled unless disabled explicitly
+func (h HealthCheck) IsEnabled() bool {
+	return h.CheckCmd != "" && (h.Enable == nil || *h.Enable)
+}
+
+func (h HealthCheck) GetInterval() time.Duration {
+	if h.IntervalSec == 0 {
+		return defaultHealthCheckIntervalSec * time.Second
+	}
+	return time.Duration(h.IntervalSec) * time.Second
+}
+
+func (h HealthCheck) GetTimeout() time.Duration {
+	if h.TimeoutSec == 0 {
+		return defaultHealthCheckTimeoutSec * time.Second
+	}
+	return time.Duration(h.TimeoutSec) * time.Second
+}
+
+// GetActionTimeout returns the timeout of action cmd, which is the timeout of check cmd by default
+func (h HealthCheck) GetActionTimeout() time.Duration {
+	if h.ActionTimeoutSec == 0 {
+		return h.GetTimeout()
+	}
+	return time.Duration(h.ActionTimeoutSec) * time.Second
+}
+
+type MonitorItem struct {
+	Unit                   string   `json:"unit"`
+	ValueType              string   `json:"value_type"`
+	StatisticsType         string   `json:"statistics_type"`
+	ScaleFactorWhenDisplay uint32   `json:"scale_factor_when_display"`
+	Enums                  []string `json:"enums"`
+}
+
 type Monitor struct {
-	Enable *bool  `json:"enable"`
-	Cmd    string `json:"cmd"`
-	Items  map[string]struct {
-		Unit                   string   `json:"unit"`
-		ValueType              string   `json:"value_type"`
-		StatisticsType         string   `json:"statistics_type"`
-		ScaleFactorWhenDisplay uint32   `json:"scale_factor_when_display"`
-		Enums                  []string `json:"enums"`
-	} `json:"items"`
-	Groups  map[string][]string `json:"groups"`
-	Display []string            `json:"display"`
-	Alarm   []string            `json:"alarm"`
+	Enable      *bool                  `json:"enable"`
+	IntervalSec uint32                 `json:"interval_sec"`
+	Cmd         string                 `json:"cmd"`
+	Items       map[string]MonitorItem `json:"items"`
+	Groups      map[string][]string    `json:"groups"`
+	Display     []string               `json:"display"`
+	Alarm       []string               `json:"alarm"`
+}
+
+const defaultMonitorIntervalSec = 60
+
+// IsEnabled returns whether the monitor cmd is run on nodes, it is enabled unless disabled explicitly
+func (m Monitor) IsEnabled() bool {
+	return m.Cmd != "" && (m.Enable == nil || *m.Enable)
+}
+
+func (m Monitor) GetInterval() time.Duration {
+	if m.IntervalSec == 0 {
+		return defaultMonitorIntervalSec * time.Second
+	}
+	return time.Duration(m.IntervalSec) * time.Second
 }
 
 type Node struct {
@@ -169,3 +222,28 @@ type Service struct {
 	Cmd              string                 `json:"cmd"`
 	Order            *uint32                `json:"order"`
 }
+
+// GetParamsConfig returns the config validating the params given to the custom service by user,
+// every service param declares the config item of the param, nil if the service has no params
+func (s Service) GetParamsConfig() (*Config, error) {
+	if len(s.ServiceParams) == 0 {
+		return nil, nil
+	}
+	var keys []string
+	for key := range s.ServiceParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	config := &Config{Type: TypeArray}
+	for _, key := range keys {
+		property := new(Config)
+		err := jsonutil.Decode([]byte(jsonutil.ToString(s.ServiceParams[key])), property)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed to decode service param [%s]", key))
+		}
+		property.Key = key
+		config.Properties = append(config.Properties, property)
+	}
+	return config, nil
+}
@@ -460,6 +460,45 @@ const ClusterSchema = `
                     "maximum": 86400
                   }
                 }
+              },
+              "^custom_service$": {
+                "additionalProperties": false,
+                "type": "object",
+                "patternProperties": {
+                  "^[a-zA-Z0-9_\\-]+$": {
+                    "additionalProperties": false,
+                    "required": [
+                      "cmd"
+                    ],
+                    "type": "object",
+                    "properties": {
+                      "service_params": {
+                        "additionalProperties": false,
+                        "patternProperties": {
+                          "^[a-zA-Z_][a-zA-Z0-9_]*$": {
+                            "type": "object"
+                          }
+                        },
+                        "type": "object"
+                      },
+                      "cmd": {
+                        "pattern": "^.*[^\\s]+.*$",
+                        "type": "string",
+                        "maxLength": 1000
+                      },
+                      "nodes_to_execute_on": {
+                        "type": "integer"
+                      },
+                      "order": {
+                        "type": "integer"
+                      },
+                      "timeout": {
+                        "type": "integer",
+                        "maximum": 86400
+                      }
+                    }
+                  }
+                }
               }
             },
             "type": "object"
@@ -477,6 +516,128 @@ const ClusterSchema = `
           },
           "loadbalancer": {
             "type": "array"
+          },
+          "health_check": {
+            "additionalProperties": false,
+            "required": [
+              "check_cmd"
+            ],
+            "type": "object",
+            "properties": {
+              "enable": {
+                "type": "boolean"
+              },
+              "interval_sec": {
+                "minimum": 1,
+                "type": "integer"
+              },
+              "timeout_sec": {
+                "minimum": 1,
+                "type": "integer"
+              },
+              "action_timeout_sec": {
+                "minimum": 1,
+                "type": "integer"
+              },
+              "healthy_threshold": {
+                "minimum": 1,
+                "type": "integer"
+              },
+              "unhealthy_threshold": {
+                "minimum": 1,
+                "type": "integer"
+              },
+              "check_cmd": {
+                "pattern": "^.*[^\\s]+.*$",
+                "type": "string"
+              },
+              "action_cmd": {
+                "type": "string"
+              }
+            }
+          },
+          "monitor": {
+            "additionalProperties": false,
+            "required": [
+              "cmd",
+              "items"
+            ],
+            "type": "object",
+            "properties": {
+              "enable": {
+                "type": "boolean"
+              },
+              "interval_sec": {
+                "minimum": 1,
+                "type": "integer"
+              },
+              "cmd": {
+                "pattern": "^.*[^\\s]+.*$",
+                "type": "string"
+              },
+              "items": {
+                "type": "object",
+                "patternProperties": {
+                  "^.*$": {
+                    "additionalProperties": false,
+                    "type": "object",
+                    "properties": {
+                      "unit": {
+                        "type": "string"
+                      },
+                      "value_type": {
+                        "enum": [
+                          "int",
+                          "str"
+                        ],
+                        "type": "string"
+                      },
+                      "statistics_type": {
+                        "enum": [
+                          "latest",
+                          "delta",
+                          "rate"
+                        ],
+                        "type": "string"
+                      },
+                      "scale_factor_when_display": {
+                        "minimum": 0,
+                        "type": "integer"
+                      },
+                      "enums": {
+                        "items": {
+                          "type": "string"
+                        },
+                        "type": "array"
+                      }
+                    }
+                  }
+                }
+              },
+              "groups": {
+                "type": "object",
+                "patternProperties": {
+                  "^.*$": {
+                    "items": {
+                      "type": "string"
+                    },
+                    "type": "array"
+                  }
+                }
+              },
+              "display": {
+                "items": {
+                  "type": "string"
+                },
+                "type": "array"
+              },
+              "alarm": {
+                "items": {
+                  "type": "string"
+                },
+                "type": "array"
+              }
+            }
           }
         }
       },
@@ -485,6 +646,128 @@ const ClusterSchema = `
     },
     "incremental_backup_supported": {
       "type": "boolean"
+    },
+    "health_check": {
+      "additionalProperties": false,
+      "required": [
+        "check_cmd"
+      ],
+      "type": "object",
+      "properties": {
+        "enable": {
+          "type": "boolean"
+        },
+        "interval_sec": {
+          "minimum": 1,
+          "type": "integer"
+        },
+        "timeout_sec": {
+          "minimum": 1,
+          "type": "integer"
+        },
+        "action_timeout_sec": {
+          "minimum": 1,
+          "type": "integer"
+        },
+        "healthy_threshold": {
+          "minimum": 1,
+          "type": "integer"
+        },
+        "unhealthy_threshold": {
+          "minimum": 1,
+          "type": "integer"
+        },
+        "check_cmd": {
+          "pattern": "^.*[^\\s]+.*$",
+          "type": "string"
+        },
+        "action_cmd": {
+          "type": "string"
+        }
+      }
+    },
+    "monitor": {
+      "additionalProperties": false,
+      "required": [
+        "cmd",
+        "items"
+      ],
+      "type": "object",
+      "properties": {
+        "enable": {
+          "type": "boolean"
+        },
+        "interval_sec": {
+          "minimum": 1,
+          "type": "integer"
+        },
+        "cmd": {
+          "pattern": "^.*[^\\s]+.*$",
+          "type": "string"
+        },
+        "items": {
+          "type": "object",
+          "patternProperties": {
+            "^.*$": {
+              "additionalProperties": false,
+              "type": "object",
+              "properties": {
+                "unit": {
+                  "type": "string"
+                },
+                "value_type": {
+                  "enum": [
+                    "int",
+                    "str"
+                  ],
+                  "type": "string"
+                },
+                "statistics_type": {
+                  "enum": [
+                    "latest",
+                    "delta",
+                    "rate"
+                  ],
+                  "type": "string"
+                },
+                "scale_factor_when_display": {
+                  "minimum": 0,
+                  "type": "integer"
+                },
+                "enums": {
+                  "items": {
+                    "type": "string"
+                  },
+                  "type": "array"
+                }
+              }
+            }
+          }
+        },
+        "groups": {
+          "type": "object",
+          "patternProperties": {
+            "^.*$": {
+              "items": {
+                "type": "string"
+              },
+              "type": "array"
+            }
+          }
+        },
+        "display": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "alarm": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      }
     }
   }
 }
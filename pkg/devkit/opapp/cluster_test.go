@@ -7,6 +7,9 @@ package opapp
 import (
 	"encoding/json"
 	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 )
 
 func TestCluster_Render(t *testing.T) {
@@ -47,3 +50,100 @@ func TestCluster_Render(t *testing.T) {
 	}
 	t.Log(cluster.Name, cluster.Description)
 }
+
+func TestService_GetParamsConfig(t *testing.T) {
+	var service Service
+	err := json.Unmarshal([]byte(`{
+	"cmd": "/opt/rebalance.sh",
+	"service_params": {
+		"mode": {"type": "string", "default": "fast"},
+		"threads": {"type": "integer", "min": 1, "max": 16, "required": true}
+	}
+}`), &service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := service.GetParamsConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Properties) != 2 || config.Properties[0].Key != "mode" || config.Properties[1].Key != "threads" {
+		t.Fatalf("Unexpected params config [%+v]", config.Properties)
+	}
+
+	for params, valid := range map[string]bool{
+		`{"threads": 4}`:                 true,
+		`{"mode": "slow", "threads": 1}`: true,
+		`{"threads": 32}`:                false,
+		`{"mode": "slow"}`:               false,
+		`{"threads": "4"}`:               false,
+	} {
+		var paramsJson map[string]interface{}
+		err = json.Unmarshal([]byte(params), &paramsJson)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = config.Validate(jsonutil.ToJson(paramsJson))
+		if valid && err != nil {
+			t.Errorf("Params [%s] should be valid: %+v", params, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("Params [%s] should be invalid", params)
+		}
+	}
+
+	service = Service{Cmd: "/opt/restart.sh"}
+	config, err = service.GetParamsConfig()
+	if err != nil || config != nil {
+		t.Errorf("Service without params should have no params config")
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	var healthCheck HealthCheck
+	err := json.Unmarshal([]byte(`{"check_cmd": "/opt/health-check.sh", "timeout_sec": 5}`), &healthCheck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !healthCheck.IsEnabled() {
+		t.Errorf("Health check should be enabled by default")
+	}
+	if healthCheck.GetInterval() != 60*time.Second {
+		t.Errorf("Unexpected interval [%s]", healthCheck.GetInterval())
+	}
+	if healthCheck.GetTimeout() != 5*time.Second || healthCheck.GetActionTimeout() != 5*time.Second {
+		t.Errorf("Unexpected timeout [%s] and action timeout [%s]", healthCheck.GetTimeout(), healthCheck.GetActionTimeout())
+	}
+
+	enable := false
+	healthCheck.Enable = &enable
+	if healthCheck.IsEnabled() {
+		t.Errorf("Health check should be disabled")
+	}
+	if (HealthCheck{}).IsEnabled() {
+		t.Errorf("Health check without check cmd should be disabled")
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	var monitor Monitor
+	err := json.Unmarshal([]byte(`{"cmd": "/opt/monitor.sh", "items": {"conn_count": {"unit": "count"}}}`), &monitor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !monitor.IsEnabled() {
+		t.Errorf("Monitor should be enabled by default")
+	}
+	if monitor.GetInterval() != 60*time.Second {
+		t.Errorf("Unexpected interval [%s]", monitor.GetInterval())
+	}
+	if monitor.Items["conn_count"].Unit != "count" {
+		t.Errorf("Unexpected items [%+v]", monitor.Items)
+	}
+
+	enable := false
+	monitor.Enable = &enable
+	if monitor.IsEnabled() {
+		t.Errorf("Monitor should be disabled")
+	}
+}
@@ -6,6 +6,7 @@ package opapp
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
@@ -77,8 +78,9 @@ func (c *Config) fillInDefaultConfig(defaultConfig jsonutil.Json) {
 			}
 		}
 	} else {
-		if defaultConfig.Get(c.Key) != nil {
-			c.Default = defaultConfig.Get(c.Key).Interface()
+		// keys absent from defaultConfig keep their defaults
+		if v, ok := defaultConfig.CheckGet(c.Key); ok {
+			c.Default = v.Interface()
 		}
 	}
 }
@@ -114,6 +116,60 @@ func (c *Config) getDefaultConfig() interface{} {
 	}
 }
 
+type ConfigVariant struct {
+	// the key path and value changed from the default config, empty for the default config
+	Key    string
+	Value  interface{}
+	Config jsonutil.Json
+}
+
+// GetDefaultConfigVariants returns the default config, followed by the default
+// config changed by every value in the range of properties.
+func (c *Config) GetDefaultConfigVariants() []ConfigVariant {
+	variants := []ConfigVariant{{Config: c.GetDefaultConfig()}}
+	c.walkRange(nil, func(parent []string, value interface{}) {
+		conf := c.getDefaultConfig()
+		m, ok := conf.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, key := range parent[:len(parent)-1] {
+			m, ok = m[key].(map[string]interface{})
+			if !ok {
+				return
+			}
+		}
+		m[parent[len(parent)-1]] = value
+		variants = append(variants, ConfigVariant{
+			Key:    getParent(parent),
+			Value:  value,
+			Config: jsonutil.ToJson(conf),
+		})
+	})
+	return variants
+}
+
+func (c *Config) walkRange(parent []string, f func(parent []string, value interface{})) {
+	if c.Key != "" {
+		parent = append(parent[:len(parent):len(parent)], c.Key)
+	}
+	if c.Type == TypeArray {
+		for _, p := range c.Properties {
+			p.walkRange(parent, f)
+		}
+		return
+	}
+	if len(parent) == 0 {
+		return
+	}
+	for _, value := range c.Range {
+		if reflect.DeepEqual(value, c.getDefaultConfig()) {
+			continue
+		}
+		f(parent, value)
+	}
+}
+
 // input user defined config, output rendered config with default values
 func (c *Config) GetRenderedConfig(config jsonutil.Json) jsonutil.Json {
 	v := config
@@ -311,3 +311,48 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigJson_GetDefaultConfigVariants(t *testing.T) {
+	var configJson = ConfigTemplate{
+		Config: Config{
+			Type: TypeArray,
+			Properties: []*Config{
+				{
+					Key:  "cluster",
+					Type: TypeArray,
+					Properties: []*Config{
+						{
+							Key:     "name",
+							Default: "test",
+						},
+						{
+							Key:     "cpu",
+							Type:    TypeInteger,
+							Default: 1,
+							Range:   []interface{}{1, 2, 4},
+						},
+					},
+				},
+			},
+		},
+	}
+	variants := configJson.GetDefaultConfigVariants()
+	if len(variants) != 3 {
+		t.Fatalf("expect 3 variants, got %d", len(variants))
+	}
+	if cpu, _ := variants[0].Config.GetPath("cluster", "cpu").Int(); variants[0].Key != "" || cpu != 1 {
+		t.Fatalf("the first variant should be the default config")
+	}
+	for i, value := range []int{2, 4} {
+		variant := variants[i+1]
+		if variant.Key != "cluster.cpu" || variant.Value != value {
+			t.Fatalf("unexpected variant [%s: %v]", variant.Key, variant.Value)
+		}
+		if cpu, _ := variant.Config.GetPath("cluster", "cpu").Int(); cpu != value {
+			t.Fatalf("cluster.cpu of variant should be [%d]", value)
+		}
+		if name, _ := variant.Config.GetPath("cluster", "name").String(); name != "test" {
+			t.Fatalf("cluster.name of variant should keep the default value")
+		}
+	}
+}
@@ -23,9 +23,13 @@ func getError(result *gojsonschema.Result) error {
 	return fmt.Errorf(strings.Join(errs, "\n\t"))
 }
 
-func (c ClusterConf) Validate() error {
+func (c ClusterConf) validateSchema() (*gojsonschema.Result, error) {
 	documentLoader := gojsonschema.NewStringLoader(c.RenderJson)
-	result, err := gojsonschema.Validate(schemaLoader, documentLoader)
+	return gojsonschema.Validate(schemaLoader, documentLoader)
+}
+
+func (c ClusterConf) Validate() error {
+	result, err := c.validateSchema()
 	if err != nil {
 		return err
 	}
@@ -35,6 +39,16 @@ func (c ClusterConf) Validate() error {
 	return nil
 }
 
+// SchemaErrors returns every violation of cluster schema, the field of
+// errors is the path in rendered cluster.json
+func (c ClusterConf) SchemaErrors() ([]gojsonschema.ResultError, error) {
+	result, err := c.validateSchema()
+	if err != nil {
+		return nil, err
+	}
+	return result.Errors(), nil
+}
+
 func ValidateClusterConfTmpl(clusterTmpl *ClusterConfTemplate, input jsonutil.Json) error {
 	cluster, err := clusterTmpl.Render(input)
 	if err != nil {
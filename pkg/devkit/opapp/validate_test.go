@@ -82,6 +82,141 @@ var testConfigJson = `
 }
 `
 
+var testCustomServiceClusterTmpl = `
+{
+    "name": "{{.cluster.name}}",
+    "description": "{{.cluster.description}}",
+    "subnet": "{{.cluster.subnet}}",
+    "nodes": [{
+        "role": "role_name1",
+        "container": {
+            "type": "kvm",
+            "zone": "pek3a",
+            "image": "img-hlhql5ea"
+        },
+        "count": "{{.cluster.role_name1.count}}",
+        "cpu": "{{.cluster.role_name1.cpu}}",
+        "memory": "{{.cluster.role_name1.memory}}",
+        "volume": {
+            "size": "{{.cluster.role_name1.volume_size}}",
+            "mount_point": "/test_data",
+            "filesystem": "ext4"
+        },
+        "services": {
+            "restart": {
+                "cmd": "/opt/restart.sh"
+            },
+            "custom_service": {
+                "rebalance": {
+                    "cmd": "/opt/rebalance.sh",
+                    "timeout": 600,
+                    "service_params": {
+                        "mode": {
+                            "type": "string",
+                            "default": "fast"
+                        }
+                    }
+                }
+            }
+        }
+    }]
+}
+`
+
+var testHealthCheckClusterTmpl = `
+{
+    "name": "{{.cluster.name}}",
+    "description": "{{.cluster.description}}",
+    "subnet": "{{.cluster.subnet}}",
+    "nodes": [{
+        "role": "role_name1",
+        "container": {
+            "type": "kvm",
+            "zone": "pek3a",
+            "image": "img-hlhql5ea"
+        },
+        "count": "{{.cluster.role_name1.count}}",
+        "cpu": "{{.cluster.role_name1.cpu}}",
+        "memory": "{{.cluster.role_name1.memory}}",
+        "volume": {
+            "size": "{{.cluster.role_name1.volume_size}}",
+            "mount_point": "/test_data",
+            "filesystem": "ext4"
+        },
+        "health_check": {
+            "enable": true,
+            "interval_sec": 30,
+            "timeout_sec": 10,
+            "healthy_threshold": 2,
+            "unhealthy_threshold": 3,
+            "check_cmd": "/opt/health-check.sh",
+            "action_cmd": "/opt/health-action.sh"
+        }
+    }],
+    "health_check": {
+        "check_cmd": "/opt/health-check.sh"
+    }
+}
+`
+
+var testMonitorClusterTmpl = `
+{
+    "name": "{{.cluster.name}}",
+    "description": "{{.cluster.description}}",
+    "subnet": "{{.cluster.subnet}}",
+    "nodes": [{
+        "role": "role_name1",
+        "container": {
+            "type": "kvm",
+            "zone": "pek3a",
+            "image": "img-hlhql5ea"
+        },
+        "count": "{{.cluster.role_name1.count}}",
+        "cpu": "{{.cluster.role_name1.cpu}}",
+        "memory": "{{.cluster.role_name1.memory}}",
+        "volume": {
+            "size": "{{.cluster.role_name1.volume_size}}",
+            "mount_point": "/test_data",
+            "filesystem": "ext4"
+        },
+        "monitor": {
+            "enable": true,
+            "interval_sec": 30,
+            "cmd": "/opt/monitor.sh",
+            "items": {
+                "conn_count": {
+                    "unit": "count",
+                    "value_type": "int",
+                    "statistics_type": "latest"
+                },
+                "read_count": {
+                    "unit": "number per second",
+                    "value_type": "int",
+                    "statistics_type": "rate"
+                },
+                "role": {
+                    "value_type": "str",
+                    "statistics_type": "latest",
+                    "enums": ["leader", "follower"]
+                }
+            },
+            "groups": {
+                "count": ["conn_count", "read_count"]
+            },
+            "display": ["count", "role"]
+        }
+    }],
+    "monitor": {
+        "cmd": "/opt/monitor.sh",
+        "items": {
+            "conn_count": {
+                "unit": "count"
+            }
+        }
+    }
+}
+`
+
 var testErrorClusterTmpl = `
 {
     "name": "{{.cluster.name}}",
@@ -145,6 +280,27 @@ func TestValidateClusterTmpl(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// tmpl with custom service
+	clusterTmpl = &ClusterConfTemplate{Raw: testCustomServiceClusterTmpl}
+	err = ValidateClusterConfTmpl(clusterTmpl, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// tmpl with health check
+	clusterTmpl = &ClusterConfTemplate{Raw: testHealthCheckClusterTmpl}
+	err = ValidateClusterConfTmpl(clusterTmpl, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// tmpl with monitor
+	clusterTmpl = &ClusterConfTemplate{Raw: testMonitorClusterTmpl}
+	err = ValidateClusterConfTmpl(clusterTmpl, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	// error tmpl
 	clusterTmpl = &ClusterConfTemplate{Raw: testErrorClusterTmpl}
 	err = ValidateClusterConfTmpl(clusterTmpl, config)
@@ -7,6 +7,7 @@
 package etcd_test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -78,3 +79,141 @@ func TestDlockWithTimeout(t *testing.T) {
 	}
 
 }
+
+func TestLease(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	key := fmt.Sprintf("test-lease-%d", rand.Intn(10000))
+
+	lease1, err := e.NewLease(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease2, err := e.NewLease(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lease2.Close()
+
+	acquired, err := lease1.Acquire(ctx, key, "1")
+	if err != nil || !acquired {
+		t.Fatalf("first lease should acquire the key: %+v", err)
+	}
+	acquired, err = lease1.Acquire(ctx, key, "1")
+	if err != nil || acquired {
+		t.Fatalf("first lease should not acquire the key twice: %+v", err)
+	}
+	acquired, err = lease2.Acquire(ctx, key, "2")
+	if err != nil || acquired {
+		t.Fatalf("second lease should not acquire the key: %+v", err)
+	}
+
+	err = lease2.Release(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leased, err := e.IsLeased(ctx, key)
+	if err != nil || !leased {
+		t.Fatalf("key should not be released by second lease: %+v", err)
+	}
+
+	// keys are deleted after the lease is revoked
+	lease1.Close()
+	leased, err = e.IsLeased(ctx, key)
+	if err != nil || leased {
+		t.Fatalf("key should be released after the lease closed: %+v", err)
+	}
+	acquired, err = lease2.Acquire(ctx, key, "2")
+	if err != nil || !acquired {
+		t.Fatalf("second lease should acquire the key: %+v", err)
+	}
+}
+
+func TestSemaphore(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	semaphore := e.NewSemaphore(fmt.Sprintf("test-semaphore-%d", rand.Intn(10000)), 2)
+
+	lease, err := e.NewLease(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lease.Close()
+
+	for _, holder := range []string{"1", "2", "1"} {
+		acquired, err := semaphore.TryAcquire(ctx, lease, holder)
+		if err != nil || !acquired {
+			t.Fatalf("holder [%s] should acquire the semaphore: %+v", holder, err)
+		}
+	}
+	acquired, err := semaphore.TryAcquire(ctx, lease, "3")
+	if err != nil || acquired {
+		t.Fatalf("holder [3] should not acquire the semaphore: %+v", err)
+	}
+
+	err = semaphore.Release(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	acquired, err = semaphore.TryAcquire(ctx, lease, "3")
+	if err != nil || !acquired {
+		t.Fatalf("holder [3] should acquire the semaphore after released: %+v", err)
+	}
+}
+
+func TestEtcdQueueDequeueWithContext(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue := e.NewQueue(fmt.Sprintf("test-queue-%d", rand.Intn(10000)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = queue.DequeueWithContext(ctx)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("dequeue from empty queue should be stopped by context: %+v", err)
+	}
+
+	go func() {
+		time.Sleep(time.Second)
+		queue.Enqueue("1")
+	}()
+	n, err := queue.DequeueWithContext(context.Background())
+	if err != nil || n != "1" {
+		t.Fatalf("got [%s] from queue: %+v", n, err)
+	}
+}
+
+func TestEtcdPriorityQueue(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue := e.NewQueue(fmt.Sprintf("test-queue-%d", rand.Intn(10000)))
+	for _, priority := range []uint16{1, 5, 3, 5} {
+		err := queue.EnqueueWithPriority(fmt.Sprintf("%d", priority), priority)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, expected := range []string{"5", "5", "3", "1"} {
+		n, err := queue.DequeueWithContext(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != expected {
+			t.Fatalf("got [%s] from queue, expected [%s]", n, expected)
+		}
+	}
+}
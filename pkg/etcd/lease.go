@@ -0,0 +1,100 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package etcd
+
+import (
+	"context"
+	"sync"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/clientv3/concurrency"
+)
+
+// Lease is kept alive in background until it is closed or the process exits,
+// keys acquired with the lease are deleted by etcd after the lease expired.
+type Lease struct {
+	*concurrency.Session
+	// keys held in this process, so that a key is held only once
+	held      map[string]bool
+	heldMutex sync.Mutex
+}
+
+// NewLease creates a lease with ttl in seconds
+func (etcd *Etcd) NewLease(ttl int) (*Lease, error) {
+	session, err := concurrency.NewSession(etcd.Client, concurrency.WithTTL(ttl))
+	if err != nil {
+		return nil, err
+	}
+	return &Lease{Session: session, held: make(map[string]bool)}, nil
+}
+
+// Acquire puts the key with the lease if the key does not exist, it returns false
+// when the key is held by another lease or has been acquired in this process.
+func (l *Lease) Acquire(ctx context.Context, key, val string) (bool, error) {
+	l.heldMutex.Lock()
+	if l.held[key] {
+		l.heldMutex.Unlock()
+		return false, nil
+	}
+	l.held[key] = true
+	l.heldMutex.Unlock()
+
+	acquired, err := l.acquire(ctx, key, val)
+	if err != nil || !acquired {
+		l.heldMutex.Lock()
+		delete(l.held, key)
+		l.heldMutex.Unlock()
+	}
+	return acquired, err
+}
+
+func (l *Lease) acquire(ctx context.Context, key, val string) (bool, error) {
+	resp, err := l.Client().Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, val, clientv3.WithLease(l.Lease()))).
+		Else(clientv3.OpGet(key)).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	if resp.Succeeded {
+		return true, nil
+	}
+	// the key is left by a failed release of this lease
+	kvs := resp.Responses[0].GetResponseRange().Kvs
+	return len(kvs) > 0 && clientv3.LeaseID(kvs[0].Lease) == l.Lease(), nil
+}
+
+// Release deletes the key only when it is held by the lease
+func (l *Lease) Release(ctx context.Context, key string) error {
+	l.heldMutex.Lock()
+	delete(l.held, key)
+	l.heldMutex.Unlock()
+
+	_, err := l.Client().Txn(ctx).
+		If(clientv3.Compare(clientv3.LeaseValue(key), "=", l.Lease())).
+		Then(clientv3.OpDelete(key)).
+		Commit()
+	return err
+}
+
+// IsExpired returns true if the lease could not be kept alive anymore
+func (l *Lease) IsExpired() bool {
+	select {
+	case <-l.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// IsLeased returns true if the key is held by any lease
+func (etcd *Etcd) IsLeased(ctx context.Context, key string) (bool, error) {
+	resp, err := etcd.Get(ctx, key, clientv3.WithCountOnly())
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
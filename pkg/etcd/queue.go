@@ -4,22 +4,97 @@
 
 package etcd
 
-import recipe "go.etcd.io/etcd/contrib/recipes"
+import (
+	"context"
+	"math"
+
+	"go.etcd.io/etcd/clientv3"
+	recipe "go.etcd.io/etcd/contrib/recipes"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+)
 
 type Queue struct {
-	*recipe.Queue
+	*recipe.PriorityQueue
+	client    *clientv3.Client
+	keyPrefix string
 }
 
 func (etcd *Etcd) NewQueue(topic string) *Queue {
-	return &Queue{recipe.NewQueue(etcd.Client, topic)}
+	// elements are put with key "topic/<priority>/<sequence>" by recipe
+	return &Queue{recipe.NewPriorityQueue(etcd.Client, topic), etcd.Client, topic + "/"}
 }
 
+// Enqueue puts the element with the lowest priority 0, it is used by the
+// queues without priorities, e.g. repo events. Jobs and tasks are put by
+// EnqueueWithPriority with their own priorities.
 func (q *Queue) Enqueue(val string) error {
-	return q.Queue.Enqueue(val)
+	return q.EnqueueWithPriority(val, 0)
+}
+
+// EnqueueWithPriority puts the element with the priority, elements with
+// higher priority are dequeued first, and FIFO with the same priority.
+func (q *Queue) EnqueueWithPriority(val string, priority uint16) error {
+	// recipe dequeues the element with the smallest key first
+	return q.PriorityQueue.Enqueue(val, math.MaxUint16-priority)
 }
 
-// Dequeue returns Enqueue()'d elements in FIFO order. If the
+// Dequeue returns Enqueue()'d elements in priority order. If the
 // queue is empty, Dequeue blocks until elements are available.
 func (q *Queue) Dequeue() (string, error) {
-	return q.Queue.Dequeue()
+	return q.PriorityQueue.Dequeue()
+}
+
+// DequeueWithContext is the same as Dequeue, except that it stops
+// waiting for elements when the context is done.
+func (q *Queue) DequeueWithContext(ctx context.Context) (string, error) {
+	for {
+		resp, err := q.client.Get(ctx, q.keyPrefix, clientv3.WithFirstKey()...)
+		if err != nil {
+			return "", err
+		}
+		if len(resp.Kvs) > 0 {
+			kv := resp.Kvs[0]
+			// the element may be claimed by others, try the next one
+			claimed, err := q.claim(ctx, kv)
+			if err != nil {
+				return "", err
+			}
+			if claimed {
+				return string(kv.Value), nil
+			}
+			continue
+		}
+
+		err = q.waitElement(ctx, resp.Header.Revision)
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+func (q *Queue) claim(ctx context.Context, kv *mvccpb.KeyValue) (bool, error) {
+	resp, err := q.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)).
+		Then(clientv3.OpDelete(string(kv.Key))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+func (q *Queue) waitElement(ctx context.Context, revision int64) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watchChan := q.client.Watch(watchCtx, q.keyPrefix,
+		clientv3.WithPrefix(), clientv3.WithRev(revision+1), clientv3.WithFilterDelete())
+	for resp := range watchChan {
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		if len(resp.Events) > 0 {
+			return nil
+		}
+	}
+	return ctx.Err()
 }
@@ -0,0 +1,81 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package etcd
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/clientv3"
+)
+
+// Semaphore limits the count of holders across processes, each holder is a key
+// under the prefix bound with a lease, so it is released after the lease expired.
+type Semaphore struct {
+	*clientv3.Client
+	prefix string
+	limit  int64
+}
+
+func (etcd *Etcd) NewSemaphore(prefix string, limit int32) *Semaphore {
+	return &Semaphore{etcd.Client, prefix + "/", int64(limit)}
+}
+
+// TryAcquire puts the holder key with the lease, the holder acquires the semaphore
+// when less than limit holders are put before it, otherwise the key is deleted.
+func (s *Semaphore) TryAcquire(ctx context.Context, lease *Lease, holder string) (bool, error) {
+	key := s.prefix + holder
+	resp, err := s.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, "", clientv3.WithLease(lease.Lease()))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	if !resp.Succeeded {
+		// semaphore has been acquired by the holder
+		return true, nil
+	}
+	createRevision := resp.Header.Revision
+
+	getResp, err := s.Get(ctx, s.prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		s.Release(ctx, holder)
+		return false, err
+	}
+	var count int64
+	for _, kv := range getResp.Kvs {
+		if kv.CreateRevision <= createRevision {
+			count++
+		}
+	}
+	if count > s.limit {
+		return false, s.Release(ctx, holder)
+	}
+	return true, nil
+}
+
+func (s *Semaphore) Release(ctx context.Context, holder string) error {
+	_, err := s.Delete(ctx, s.prefix+holder)
+	return err
+}
+
+// AcquireSemaphores acquires all the semaphores for the holder, semaphores acquired
+// are released when any of them is not available.
+func AcquireSemaphores(ctx context.Context, lease *Lease, holder string, semaphores ...*Semaphore) (bool, error) {
+	for i, s := range semaphores {
+		acquired, err := s.TryAcquire(ctx, lease, holder)
+		if err != nil || !acquired {
+			ReleaseSemaphores(ctx, holder, semaphores[:i]...)
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func ReleaseSemaphores(ctx context.Context, holder string, semaphores ...*Semaphore) {
+	for _, s := range semaphores {
+		s.Release(ctx, holder)
+	}
+}
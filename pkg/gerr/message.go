@@ -52,6 +52,21 @@ var (
 		en:   "refresh token expired",
 		zhCN: "刷新令牌已过期",
 	}
+	ErrorAccessTokenRevoked = ErrorMessage{
+		Name: "access_token_revoked",
+		en:   "access token revoked",
+		zhCN: "访问令牌已撤销",
+	}
+	ErrorAccessTokenWithoutId = ErrorMessage{
+		Name: "access_token_without_id",
+		en:   "access token without id can not be revoked",
+		zhCN: "没有ID的访问令牌无法撤销",
+	}
+	ErrorSessionNotFound = ErrorMessage{
+		Name: "session_not_found",
+		en:   "session of access token not found",
+		zhCN: "没有找到访问令牌的会话",
+	}
 	ErrorEmailPasswordNotMatched = ErrorMessage{
 		Name: "email_password_not_matched",
 		en:   "email and password does not match",
@@ -167,16 +182,71 @@ var (
 		en:   "cease resource [%s] failed",
 		zhCN: "释放资源[%s]失败",
 	}
+	ErrorBackupResourceFailed = ErrorMessage{
+		Name: "backup_resource_failed",
+		en:   "backup resource [%s] failed",
+		zhCN: "备份资源[%s]失败",
+	}
+	ErrorRestoreResourceFailed = ErrorMessage{
+		Name: "restore_resource_failed",
+		en:   "restore resource [%s] from snapshot [%s] failed",
+		zhCN: "恢复资源[%s]到快照[%s]失败",
+	}
+	ErrorRunServiceFailed = ErrorMessage{
+		Name: "run_service_failed",
+		en:   "run resource [%s] service [%s] failed",
+		zhCN: "运行资源[%s]的服务[%s]失败",
+	}
 	ErrorRetryTaskFailed = ErrorMessage{
 		Name: "retry_task_failed",
 		en:   "retry task [%s] failed",
 		zhCN: "重试任务[%s]失败",
 	}
+	ErrorAbortTaskNotSupported = ErrorMessage{
+		Name: "abort_task_not_supported",
+		en:   "abort task [%s] is not supported by provider [%s]",
+		zhCN: "任务[%s]无法被资源提供者[%s]中止",
+	}
+	ErrorCancelJobFailed = ErrorMessage{
+		Name: "cancel_job_failed",
+		en:   "cancel job [%s] failed",
+		zhCN: "取消任务[%s]失败",
+	}
+	ErrorJobIncorrectStatus = ErrorMessage{
+		Name: "job_incorrect_status",
+		en:   "job [%s] has incorrect status [%s], cannot be cancelled",
+		zhCN: "任务[%s]状态为[%s], 无法取消",
+	}
+	ErrorWatchJobFailed = ErrorMessage{
+		Name: "watch_job_failed",
+		en:   "watch job [%s] failed",
+		zhCN: "监听任务[%s]失败",
+	}
+	ErrorRunClusterNodeCommandFailed = ErrorMessage{
+		Name: "run_cluster_node_command_failed",
+		en:   "run command on cluster node [%s] failed",
+		zhCN: "在集群节点[%s]上执行命令失败",
+	}
 	ErrorDescribeResourcesFailed = ErrorMessage{
 		Name: "describe_resources_failed",
 		en:   "describe resources failed",
 		zhCN: "获取资源失败",
 	}
+	ErrorIllegalPageToken = ErrorMessage{
+		Name: "illegal_page_token",
+		en:   "illegal page token",
+		zhCN: "非法的分页标记",
+	}
+	ErrorIllegalTimeRange = ErrorMessage{
+		Name: "illegal_time_range",
+		en:   "illegal time range, start time [%s] should be before end time [%s]",
+		zhCN: "非法的时间范围, 开始时间[%s]应早于结束时间[%s]",
+	}
+	ErrorDescribeClusterMonitorFailed = ErrorMessage{
+		Name: "describe_cluster_monitor_failed",
+		en:   "describe monitor of cluster [%s] failed",
+		zhCN: "获取集群[%s]的监控失败",
+	}
 	ErrorDescribeResourceFailed = ErrorMessage{
 		Name: "describe_resource_failed",
 		en:   "describe resource [%s] failed",
@@ -202,6 +272,21 @@ var (
 		en:   "resource [%s] role [%s] not found",
 		zhCN: "没有找到资源[%s]对应的角色[%s]",
 	}
+	ErrorResourceBackupNotSupported = ErrorMessage{
+		Name: "resource_backup_not_supported",
+		en:   "resource [%s] has no role supporting backup",
+		zhCN: "资源[%s]没有支持备份的角色",
+	}
+	ErrorResourceServiceNotFound = ErrorMessage{
+		Name: "resource_service_not_found",
+		en:   "resource [%s] service [%s] not found",
+		zhCN: "没有找到资源[%s]对应的服务[%s]",
+	}
+	ErrorIllegalServiceParams = ErrorMessage{
+		Name: "illegal_service_params",
+		en:   "illegal params of service [%s]",
+		zhCN: "服务[%s]的参数非法",
+	}
 	ErrorSubnetNotFound = ErrorMessage{
 		Name: "subnet_not_found",
 		en:   "subnet [%s] not found or vpc not bind eip",
@@ -282,11 +367,31 @@ var (
 		en:   "resource quota not enough: %s",
 		zhCN: "资源配额不足: %s",
 	}
+	ErrorQuotaExceeded = ErrorMessage{
+		Name: "quota_exceeded",
+		en:   "quota [%s] exceeded: %s",
+		zhCN: "超出配额[%s]: %s",
+	}
+	ErrorQuotaExists = ErrorMessage{
+		Name: "quota_exists",
+		en:   "quota of [%s] [%s] in runtime [%s] already exists",
+		zhCN: "[%s] [%s]在运行环境[%s]中的配额已存在",
+	}
 	ErrorHelmReleaseExists = ErrorMessage{
 		Name: "helm_release_exists",
 		en:   "helm release [%s] already exists",
 		zhCN: "",
 	}
+	ErrorHelmReleaseRevisionNotFound = ErrorMessage{
+		Name: "helm_release_revision_not_found",
+		en:   "helm release [%s] revision [%d] not found",
+		zhCN: "helm release[%s]的版本[%d]不存在",
+	}
+	ErrorHelmReleaseAmbiguous = ErrorMessage{
+		Name: "helm_release_ambiguous",
+		en:   "helm release [%s] is found in multiple clusters of runtime [%s], cluster id is required",
+		zhCN: "helm release[%s]存在于运行环境[%s]的多个集群中, 需要指定集群ID",
+	}
 	ErrorUnsupportedApiVersion = ErrorMessage{
 		Name: "unsupported_api_version",
 		en:   "unsupported api version [%s]",
@@ -317,6 +422,21 @@ var (
 		en:   "app version is under review, app cannot be modified",
 		zhCN: "应用版本审核中, 应用无法修改",
 	}
+	ErrorReviewStageNotFound = ErrorMessage{
+		Name: "review_stage_not_found",
+		en:   "review stage [%s] not found in review of app version [%s]",
+		zhCN: "审核阶段[%s]不在应用版本[%s]的审核中",
+	}
+	ErrorIllegalReviewStages = ErrorMessage{
+		Name: "illegal_review_stages",
+		en:   "illegal review stages: %s",
+		zhCN: "非法的审核阶段: %s",
+	}
+	ErrorReviewPolicyExists = ErrorMessage{
+		Name: "review_policy_exists",
+		en:   "review policy of [%s] [%s] already exists",
+		zhCN: "[%s] [%s]的审核策略已存在",
+	}
 	ErrorLoadPackageFailed = ErrorMessage{
 		Name: "load_package_failed",
 		en:   "load package failed, reason: [%s]",
@@ -387,6 +507,11 @@ var (
 		en:   "credential [%s] illegal",
 		zhCN: "credential [%s]不合法",
 	}
+	ErrorCredentialKeyNotConfigured = ErrorMessage{
+		Name: "credential_key_not_configured",
+		en:   "master key of credential not configured",
+		zhCN: "未配置凭证的主密钥",
+	}
 	ErrorNamespaceExists = ErrorMessage{
 		Name: "namespace exists",
 		en:   "namespace [%s] exists",
@@ -0,0 +1,168 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package kms implements envelope encryption of secrets stored in database.
+// Every secret is encrypted by a random data key, the data key is wrapped by a
+// master key of a KeyManager and stored along with the ciphertext.
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"openpitrix.io/openpitrix/pkg/config"
+)
+
+const (
+	KeyManagerLocal = "local"
+
+	envelopeVersion = 1
+	dataKeySize     = 32
+)
+
+var ErrNoKeyManager = fmt.Errorf("content is encrypted but no key manager configured")
+
+// KeyManager wraps and unwraps data keys with master keys which never leave it,
+// a remote KMS can be plugged in by implementing this interface.
+type KeyManager interface {
+	// PrimaryKeyId returns the id of master key used to wrap new data keys
+	PrimaryKeyId() string
+	WrapKey(ctx context.Context, dataKey []byte) (keyId string, wrappedKey []byte, err error)
+	UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error)
+}
+
+// envelope is stored as a json object, so it fits in json columns as the plain content does
+type envelope struct {
+	Version    int    `json:"kms_envelope"`
+	KeyId      string `json:"key_id"`
+	DataKey    []byte `json:"data_key"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func parseEnvelope(content string) (*envelope, bool) {
+	var e envelope
+	if err := json.Unmarshal([]byte(content), &e); err != nil {
+		return nil, false
+	}
+	if e.Version == 0 || len(e.KeyId) == 0 {
+		return nil, false
+	}
+	return &e, true
+}
+
+// NewKeyManager returns nil KeyManager when no master key is configured,
+// then the secrets are stored in plaintext
+func NewKeyManager(cfg config.CredentialConfig) (KeyManager, error) {
+	switch cfg.KeyManager {
+	case KeyManagerLocal:
+		keys, err := LoadMasterKeys(cfg.MasterKey, cfg.MasterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return nil, nil
+		}
+		return NewLocalKeyManager(keys...)
+	default:
+		return nil, fmt.Errorf("unsupported key manager [%s]", cfg.KeyManager)
+	}
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce := ciphertext[:gcm.NonceSize()]
+	return gcm.Open(nil, nonce, ciphertext[gcm.NonceSize():], nil)
+}
+
+// Seal encrypts plaintext with a new data key, the content is returned as is when km is nil
+func Seal(ctx context.Context, km KeyManager, plaintext string) (string, error) {
+	if km == nil {
+		return plaintext, nil
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", err
+	}
+	ciphertext, err := encrypt(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	keyId, wrappedKey, err := km.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(envelope{
+		Version:    envelopeVersion,
+		KeyId:      keyId,
+		DataKey:    wrappedKey,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Open decrypts the content sealed by Seal, content not sealed is returned as is
+func Open(ctx context.Context, km KeyManager, content string) (string, error) {
+	e, ok := parseEnvelope(content)
+	if !ok {
+		return content, nil
+	}
+	if km == nil {
+		return "", ErrNoKeyManager
+	}
+	if e.Version != envelopeVersion {
+		return "", fmt.Errorf("unsupported envelope version [%d]", e.Version)
+	}
+	dataKey, err := km.UnwrapKey(ctx, e.KeyId, e.DataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := decrypt(dataKey, e.Ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// KeyIdOf returns the id of master key which sealed the content
+func KeyIdOf(content string) (string, bool) {
+	e, ok := parseEnvelope(content)
+	if !ok {
+		return "", false
+	}
+	return e.KeyId, true
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, dataKeySize)
+}
+
+func TestSealOpen(t *testing.T) {
+	ctx := context.Background()
+	km, err := NewLocalKeyManager(testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := `{"access_key_id":"id","secret_access_key":"secret"}`
+	sealed, err := Seal(ctx, km, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains([]byte(sealed), []byte("secret")) {
+		t.Fatalf("sealed content contains plaintext: %s", sealed)
+	}
+	// sealed content should still be a json object
+	var m map[string]interface{}
+	if err = json.Unmarshal([]byte(sealed), &m); err != nil {
+		t.Fatal(err)
+	}
+	keyId, ok := KeyIdOf(sealed)
+	if !ok || keyId != km.PrimaryKeyId() {
+		t.Fatalf("unexpected key id [%s]", keyId)
+	}
+
+	opened, err := Open(ctx, km, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened != plaintext {
+		t.Fatalf("expect [%s], got [%s]", plaintext, opened)
+	}
+
+	if _, err = Open(ctx, nil, sealed); err != ErrNoKeyManager {
+		t.Fatalf("expect ErrNoKeyManager, got %+v", err)
+	}
+}
+
+func TestOpenPlaintext(t *testing.T) {
+	ctx := context.Background()
+	km, err := NewLocalKeyManager(testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, content := range []string{`{"apiVersion":"v1","kind":"Config"}`, `not json`, ``} {
+		if _, ok := KeyIdOf(content); ok {
+			t.Fatalf("content [%s] should not be sealed", content)
+		}
+		opened, err := Open(ctx, km, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if opened != content {
+			t.Fatalf("expect [%s], got [%s]", content, opened)
+		}
+	}
+
+	sealed, err := Seal(ctx, nil, "plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sealed != "plain" {
+		t.Fatalf("expect content not sealed without key manager, got [%s]", sealed)
+	}
+}
+
+func TestRotateMasterKey(t *testing.T) {
+	ctx := context.Background()
+	oldKm, err := NewLocalKeyManager(testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := Seal(ctx, oldKm, "content")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newKm, err := NewLocalKeyManager(testKey(2), testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newKm.PrimaryKeyId() == oldKm.PrimaryKeyId() {
+		t.Fatal("primary key id should be changed")
+	}
+	opened, err := Open(ctx, newKm, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resealed, err := Seal(ctx, newKm, opened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyId, _ := KeyIdOf(resealed); keyId != newKm.PrimaryKeyId() {
+		t.Fatalf("unexpected key id [%s]", keyId)
+	}
+
+	if _, err = Open(ctx, oldKm, resealed); err == nil {
+		t.Fatal("content sealed by new key should not be opened by old key")
+	}
+}
+
+func TestLoadMasterKeys(t *testing.T) {
+	f, err := ioutil.TempFile("", "master-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("# old keys\n" + base64.StdEncoding.EncodeToString(testKey(2)) + "\n\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	keys, err := LoadMasterKeys(base64.StdEncoding.EncodeToString(testKey(1)), f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || !bytes.Equal(keys[0], testKey(1)) || !bytes.Equal(keys[1], testKey(2)) {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+
+	if _, err = NewLocalKeyManager([]byte("short")); err == nil {
+		t.Fatal("short master key should be rejected")
+	}
+}
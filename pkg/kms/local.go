@@ -0,0 +1,112 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package kms
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// LocalKeyManager keeps the master keys in memory. The first key is the primary
+// one, the others are kept to unwrap data keys until the rows are rotated.
+type LocalKeyManager struct {
+	primaryKeyId string
+	keys         map[string][]byte
+}
+
+var _ KeyManager = &LocalKeyManager{}
+
+func localKeyId(key []byte) string {
+	sum := sha256.Sum256(key)
+	return "local-" + hex.EncodeToString(sum[:8])
+}
+
+func NewLocalKeyManager(masterKeys ...[]byte) (*LocalKeyManager, error) {
+	if len(masterKeys) == 0 {
+		return nil, fmt.Errorf("no master key")
+	}
+	km := &LocalKeyManager{keys: make(map[string][]byte)}
+	for i, key := range masterKeys {
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("master key [%d] must be %d bytes, got %d", i, dataKeySize, len(key))
+		}
+		keyId := localKeyId(key)
+		if i == 0 {
+			km.primaryKeyId = keyId
+		}
+		km.keys[keyId] = key
+	}
+	return km, nil
+}
+
+// ParseMasterKeys parses base64 encoded keys separated by commas or newlines,
+// blank lines and lines start with '#' are ignored
+func ParseMasterKeys(s string) ([][]byte, error) {
+	var keys [][]byte
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, field := range strings.Split(line, ",") {
+			field = strings.TrimSpace(field)
+			if len(field) == 0 {
+				continue
+			}
+			key, err := base64.StdEncoding.DecodeString(field)
+			if err != nil {
+				return nil, fmt.Errorf("decode master key failed: %+v", err)
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// LoadMasterKeys loads keys from masterKey (usually set by environment variable)
+// followed by the keys in masterKeyFile
+func LoadMasterKeys(masterKey, masterKeyFile string) ([][]byte, error) {
+	keys, err := ParseMasterKeys(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(masterKeyFile) > 0 {
+		content, err := ioutil.ReadFile(masterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		fileKeys, err := ParseMasterKeys(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("load master key file [%s] failed: %+v", masterKeyFile, err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+	return keys, nil
+}
+
+func (km *LocalKeyManager) PrimaryKeyId() string {
+	return km.primaryKeyId
+}
+
+func (km *LocalKeyManager) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	wrappedKey, err := encrypt(km.keys[km.primaryKeyId], dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return km.primaryKeyId, wrappedKey, nil
+}
+
+func (km *LocalKeyManager) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	key, ok := km.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("master key [%s] not found", keyId)
+	}
+	return decrypt(key, wrappedKey)
+}
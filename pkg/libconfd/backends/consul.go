@@ -0,0 +1,221 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package backends
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/libconfd"
+)
+
+var (
+	_ libconfd.BackendClient = (*ConsulClient)(nil)
+)
+
+// ConsulBackendType reads the values from the KV HTTP API of consul, or any
+// server compatible with it. The keys of consul are without the leading "/".
+// The password of the backend config is used as the ACL token.
+const ConsulBackendType = "libconfd-backend-consul"
+
+var (
+	// max time of a blocking query, consul limits it to 10m
+	ConsulWatchWait = 5 * time.Minute
+	// wait before retrying the failed watch
+	ConsulRetryInterval = time.Second
+)
+
+func init() {
+	libconfd.RegisterBackendClient(
+		ConsulBackendType,
+		func(cfg *libconfd.BackendConfig) (libconfd.BackendClient, error) {
+			return NewConsulClient(cfg)
+		},
+	)
+}
+
+type ConsulClient struct {
+	urls       []string
+	token      string
+	httpClient *http.Client
+
+	// the url used now, switched to the next one when failed
+	mu      sync.Mutex
+	current int
+}
+
+type consulKVPair struct {
+	Key   string
+	Value []byte
+}
+
+func NewConsulClient(cfg *libconfd.BackendConfig) (*ConsulClient, error) {
+	if len(cfg.Host) == 0 {
+		return nil, fmt.Errorf("libconfd: no host for consul backend")
+	}
+
+	tlsConfig, err := newClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := "http://"
+	if tlsConfig != nil {
+		scheme = "https://"
+	}
+	var urls []string
+	for _, host := range cfg.Host {
+		if !strings.Contains(host, "://") {
+			host = scheme + host
+		}
+		urls = append(urls, strings.TrimSuffix(host, "/"))
+	}
+
+	return &ConsulClient{
+		urls:  urls,
+		token: cfg.Password,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+	}, nil
+}
+
+func (c *ConsulClient) Type() string       { return ConsulBackendType }
+func (c *ConsulClient) WatchEnabled() bool { return true }
+func (c *ConsulClient) Close() error       { return nil }
+
+func (c *ConsulClient) currentUrl() (int, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current, c.urls[c.current]
+}
+
+func (c *ConsulClient) switchUrl(failed int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.current == failed {
+		c.current = (c.current + 1) % len(c.urls)
+	}
+}
+
+// list returns the pairs prefixed by prefix and the index of consul,
+// it is a blocking query if waitIndex > 0
+func (c *ConsulClient) list(ctx context.Context, prefix string, waitIndex uint64) ([]consulKVPair, uint64, error) {
+	query := url.Values{}
+	query.Set("recurse", "true")
+	if waitIndex > 0 {
+		query.Set("index", strconv.FormatUint(waitIndex, 10))
+		query.Set("wait", fmt.Sprintf("%ds", int(ConsulWatchWait.Seconds())))
+	}
+
+	i, baseUrl := c.currentUrl()
+	reqUrl := fmt.Sprintf("%s/v1/kv/%s?%s", baseUrl, strings.TrimPrefix(prefix, "/"), query.Encode())
+	req, err := http.NewRequest(http.MethodGet, reqUrl, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req = req.WithContext(ctx)
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.switchUrl(i)
+		}
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	index, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid X-Consul-Index [%s] from [%s]", resp.Header.Get("X-Consul-Index"), reqUrl)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var pairs []consulKVPair
+		if err = json.NewDecoder(resp.Body).Decode(&pairs); err != nil {
+			return nil, 0, err
+		}
+		return pairs, index, nil
+	case http.StatusNotFound:
+		return nil, index, nil
+	default:
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, 0, fmt.Errorf("consul response status [%s] from [%s]: %s", resp.Status, reqUrl, body)
+	}
+}
+
+func (c *ConsulClient) GetValues(keys []string) (map[string]string, error) {
+	vars := make(map[string]string)
+	for _, key := range keys {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		pairs, _, err := c.list(ctx, key, 0)
+		cancel()
+		if err != nil {
+			return vars, err
+		}
+		for _, pair := range pairs {
+			// folder of consul
+			if strings.HasSuffix(pair.Key, "/") {
+				continue
+			}
+			vars["/"+pair.Key] = string(pair.Value)
+		}
+	}
+	return vars, nil
+}
+
+// WatchPrefix returns the index of consul once the keys prefixed by prefix
+// changed after waitIndex, or returns waitIndex when stopChan is closed
+func (c *ConsulClient) WatchPrefix(prefix string, keys []string, waitIndex uint64, stopChan chan bool) (uint64, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		// the current index is returned immediately if waitIndex is 0,
+		// which triggers a key retrieval from the store
+		_, index, err := c.list(ctx, prefix, waitIndex)
+		if ctx.Err() != nil {
+			return waitIndex, nil
+		}
+		if err != nil {
+			select {
+			case <-time.After(ConsulRetryInterval):
+			case <-ctx.Done():
+			}
+			return waitIndex, err
+		}
+
+		if waitIndex == 0 && index == 0 {
+			return 1, nil
+		}
+		// the blocking query returns the same index when timed out,
+		// and the index may go backwards if consul is restored
+		if index != waitIndex {
+			return index, nil
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package backends
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/libconfd"
+	"openpitrix.io/openpitrix/pkg/libconfd/backendtest"
+)
+
+// fakeConsul serves the KV HTTP API of consul, including the blocking query
+type fakeConsul struct {
+	mu     sync.Mutex
+	values map[string]string
+	index  uint64
+	// closed and renewed when changed
+	changed chan struct{}
+}
+
+func newFakeConsul() *fakeConsul {
+	return &fakeConsul{
+		values:  map[string]string{},
+		index:   1,
+		changed: make(chan struct{}),
+	}
+}
+
+func (p *fakeConsul) update(fn func()) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fn()
+	p.index++
+	close(p.changed)
+	p.changed = make(chan struct{})
+	return nil
+}
+
+func (p *fakeConsul) Set(key, value string) error {
+	return p.update(func() { p.values[strings.TrimPrefix(key, "/")] = value })
+}
+
+func (p *fakeConsul) Delete(key string) error {
+	return p.update(func() { delete(p.values, strings.TrimPrefix(key, "/")) })
+}
+
+func (p *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	prefix := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	waitIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	wait, _ := time.ParseDuration(r.URL.Query().Get("wait"))
+
+	p.mu.Lock()
+	if waitIndex > 0 && waitIndex >= p.index {
+		changed := p.changed
+		p.mu.Unlock()
+		select {
+		case <-changed:
+		case <-time.After(wait):
+		case <-r.Context().Done():
+		}
+		p.mu.Lock()
+	}
+	var pairs []consulKVPair
+	for k, v := range p.values {
+		if strings.HasPrefix(k, prefix) {
+			pairs = append(pairs, consulKVPair{Key: k, Value: []byte(v)})
+		}
+	}
+	index := p.index
+	p.mu.Unlock()
+
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Key < pairs[j].Key })
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(index, 10))
+	if len(pairs) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(pairs)
+}
+
+// consulTestClient closes the fake consul with the client
+type consulTestClient struct {
+	*ConsulClient
+	server *httptest.Server
+}
+
+func (p consulTestClient) Close() error {
+	p.server.Close()
+	return p.ConsulClient.Close()
+}
+
+func TestConsulBackend(t *testing.T) {
+	backendtest.Run(t, ConsulBackendType, func(t *testing.T) (libconfd.BackendClient, backendtest.Store) {
+		consul := newFakeConsul()
+		server := httptest.NewServer(consul)
+
+		client, err := NewConsulClient(&libconfd.BackendConfig{
+			Type: ConsulBackendType,
+			Host: []string{server.URL},
+		})
+		if err != nil {
+			server.Close()
+			t.Fatal(err)
+		}
+		return consulTestClient{client, server}, consul
+	})
+}
+
+func TestConsulBackend_Failover(t *testing.T) {
+	consul := newFakeConsul()
+	consul.Set("/a", "1")
+	server := httptest.NewServer(consul)
+	defer server.Close()
+
+	// the first host is not listening
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	client, err := NewConsulClient(&libconfd.BackendConfig{
+		Type: ConsulBackendType,
+		Host: []string{down.URL, strings.TrimPrefix(server.URL, "http://")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.GetValues([]string{"/"}); err == nil {
+		t.Fatal("the host down should fail")
+	}
+	values, err := client.GetValues([]string{"/"})
+	if err != nil || values["/a"] != "1" {
+		t.Fatalf("expect switched to the next host, got %v %+v", values, err)
+	}
+}
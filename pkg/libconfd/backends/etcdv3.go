@@ -54,36 +54,11 @@ func NewEtcdClient(cfg *libconfd.BackendConfig) (libconfd.BackendClient, error)
 	etcdConfig.Username = cfg.UserName
 	etcdConfig.Password = cfg.Password
 
-	tlsEnabled := false
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: false,
-	}
-
-	if cfg.ClientCAKeys != "" {
-		certBytes, err := ioutil.ReadFile(cfg.ClientCAKeys)
-		if err != nil {
-			return nil, err
-		}
-
-		caCertPool := x509.NewCertPool()
-		ok := caCertPool.AppendCertsFromPEM(certBytes)
-
-		if ok {
-			tlsConfig.RootCAs = caCertPool
-		}
-		tlsEnabled = true
-	}
-
-	if cfg.ClientCert != "" && cfg.ClientKey != "" {
-		tlsCert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
-		if err != nil {
-			return nil, err
-		}
-		tlsConfig.Certificates = []tls.Certificate{tlsCert}
-		tlsEnabled = true
+	tlsConfig, err := newClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
 	}
-
-	if tlsEnabled {
+	if tlsConfig != nil {
 		etcdConfig.TLS = tlsConfig
 	}
 
@@ -210,21 +185,17 @@ func (c *_EtcdClient) getValues_hookKeyAdjuster(_keys []string) (map[string]stri
 	return vars, nil
 }
 
+// WatchPrefix returns the revision of etcd once the keys changed after waitIndex,
+// the current revision is returned if waitIndex is 0
 func (c *_EtcdClient) WatchPrefix(prefix string, keys []string, waitIndex uint64, stopChan chan bool) (uint64, error) {
-	var err error
-
-	// return something > 0 to trigger a key retrieval from the store
-	if waitIndex == 0 {
-		return 1, err
-	}
-
 	client, err := clientv3.New(c.cfg)
 	if err != nil {
-		return 1, err
+		return waitIndex, err
 	}
 	defer client.Close()
 
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	cancelRoutine := make(chan bool)
 	defer close(cancelRoutine)
 
@@ -237,9 +208,27 @@ func (c *_EtcdClient) WatchPrefix(prefix string, keys []string, waitIndex uint64
 		}
 	}()
 
-	rch := client.Watch(ctx, prefix, clientv3.WithPrefix())
+	// return the current revision to trigger a key retrieval from the store,
+	// the changes after it are watched next time
+	if waitIndex == 0 {
+		resp, err := client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return 0, err
+		}
+		return uint64(resp.Header.Revision), nil
+	}
+
+	// the changes after the values retrieved are not missed
+	rch := client.Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(int64(waitIndex)+1))
 
 	for wresp := range rch {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := wresp.Err(); err != nil {
+			// e.g. the revision is compacted, retrieve the keys again
+			return 0, err
+		}
 		for _, ev := range wresp.Events {
 			logger.Debug(nil, "Key updated %s", string(ev.Kv.Key))
 
@@ -249,11 +238,48 @@ func (c *_EtcdClient) WatchPrefix(prefix string, keys []string, waitIndex uint64
 			// is reducing the scope of keys that can trigger updates.
 			for _, k := range keys {
 				if strings.HasPrefix(string(ev.Kv.Key), k) {
-					return uint64(ev.Kv.Version), err
+					return uint64(ev.Kv.ModRevision), nil
 				}
 			}
 		}
 	}
 
-	return 0, err
+	return waitIndex, nil
+}
+
+// newClientTLSConfig returns nil if the TLS is not configured
+func newClientTLSConfig(cfg *libconfd.BackendConfig) (*tls.Config, error) {
+	tlsEnabled := false
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: false,
+	}
+
+	if cfg.ClientCAKeys != "" {
+		certBytes, err := ioutil.ReadFile(cfg.ClientCAKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		caCertPool := x509.NewCertPool()
+		ok := caCertPool.AppendCertsFromPEM(certBytes)
+
+		if ok {
+			tlsConfig.RootCAs = caCertPool
+		}
+		tlsEnabled = true
+	}
+
+	if cfg.ClientCert != "" && cfg.ClientKey != "" {
+		tlsCert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{tlsCert}
+		tlsEnabled = true
+	}
+
+	if !tlsEnabled {
+		return nil, nil
+	}
+	return tlsConfig, nil
 }
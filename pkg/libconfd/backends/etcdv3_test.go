@@ -0,0 +1,69 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build etcd
+
+package backends
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"openpitrix.io/openpitrix/pkg/config/test_config"
+	"openpitrix.io/openpitrix/pkg/libconfd"
+	"openpitrix.io/openpitrix/pkg/libconfd/backendtest"
+)
+
+var tc = test_config.NewEtcdTestConfig()
+
+type etcdStore struct {
+	client *clientv3.Client
+}
+
+func (p etcdStore) Set(key, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err := p.client.Put(ctx, key, value)
+	return err
+}
+
+func (p etcdStore) Delete(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err := p.client.Delete(ctx, key)
+	return err
+}
+
+func TestEtcdv3Backend(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+
+	store, err := clientv3.New(clientv3.Config{
+		Endpoints:   tc.GetTestEtcdEndpoints(),
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	backendtest.Run(t, Etcdv3BackendType, func(t *testing.T) (libconfd.BackendClient, backendtest.Store) {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if _, err := store.Delete(ctx, backendtest.Root, clientv3.WithPrefix()); err != nil {
+			t.Fatal(err)
+		}
+
+		client, err := libconfd.NewBackendClient(&libconfd.BackendConfig{
+			Type: Etcdv3BackendType,
+			Host: tc.GetTestEtcdEndpoints(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client, etcdStore{store}
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package backends
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/ghodss/yaml"
+
+	"openpitrix.io/openpitrix/pkg/libconfd"
+	"openpitrix.io/openpitrix/pkg/logger"
+)
+
+var (
+	_ libconfd.BackendClient = (*FileClient)(nil)
+)
+
+// FileBackendType loads the values from YAML/JSON files, the hosts of the
+// backend config are the files, or the dirs of *.yaml, *.yml and *.json files.
+// Nested maps and lists are flattened, e.g. {"a": {"b": [1]}} is "/a/b/0": "1".
+const FileBackendType = "libconfd-backend-file"
+
+var fileBackendExts = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+func init() {
+	libconfd.RegisterBackendClient(
+		FileBackendType,
+		func(cfg *libconfd.BackendConfig) (libconfd.BackendClient, error) {
+			return NewFileClient(cfg.Host)
+		},
+	)
+}
+
+type FileClient struct {
+	store   *libconfd.MemoryBackend
+	files   []string
+	dirs    []string
+	watcher *fsnotify.Watcher
+}
+
+func NewFileClient(paths []string) (*FileClient, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("libconfd: no file for file backend")
+	}
+
+	p := &FileClient{
+		store: libconfd.NewMemoryBackendClient(nil),
+	}
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if fi.IsDir() {
+			p.dirs = append(p.dirs, path)
+		} else {
+			p.files = append(p.files, path)
+		}
+	}
+
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// the parent dirs are watched since the files may be replaced by rename
+	watchDirs := map[string]bool{}
+	for _, dir := range p.dirs {
+		watchDirs[dir] = true
+	}
+	for _, file := range p.files {
+		watchDirs[filepath.Dir(file)] = true
+	}
+	for dir := range watchDirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, err
+		}
+	}
+	p.watcher = watcher
+
+	go p.watch()
+	return p, nil
+}
+
+func (p *FileClient) Type() string       { return FileBackendType }
+func (p *FileClient) WatchEnabled() bool { return true }
+func (p *FileClient) Close() error       { return p.watcher.Close() }
+
+func (p *FileClient) GetValues(keys []string) (map[string]string, error) {
+	return p.store.GetValues(keys)
+}
+
+func (p *FileClient) WatchPrefix(prefix string, keys []string, waitIndex uint64, stopChan chan bool) (uint64, error) {
+	return p.store.WatchPrefix(prefix, keys, waitIndex, stopChan)
+}
+
+func (p *FileClient) isValuesFile(name string) bool {
+	name = filepath.Clean(name)
+	for _, file := range p.files {
+		if name == file {
+			return true
+		}
+	}
+	for _, dir := range p.dirs {
+		if filepath.Dir(name) == dir && fileBackendExts[filepath.Ext(name)] {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *FileClient) watch() {
+	for {
+		select {
+		case event, ok := <-p.watcher.Events:
+			if !ok {
+				return
+			}
+			if !p.isValuesFile(event.Name) {
+				continue
+			}
+			logger.Debug(nil, "File [%s] changed: %s", event.Name, event.Op)
+			// the values are kept if the file is being written and invalid,
+			// it is reloaded again when the write is done
+			if err := p.reload(); err != nil {
+				logger.Warn(nil, "Reload values of file backend failed: %+v", err)
+			}
+		case err, ok := <-p.watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Error(nil, "Watch files of file backend failed: %+v", err)
+		}
+	}
+}
+
+// reload loads all the files, the later ones override the earlier ones
+func (p *FileClient) reload() error {
+	files := append([]string{}, p.files...)
+	for _, dir := range p.dirs {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		var dirFiles []string
+		for _, fi := range infos {
+			if !fi.IsDir() && fileBackendExts[filepath.Ext(fi.Name())] {
+				dirFiles = append(dirFiles, filepath.Join(dir, fi.Name()))
+			}
+		}
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+
+	values := make(map[string]string)
+	for _, file := range files {
+		if err := loadValuesFile(file, values); err != nil {
+			return fmt.Errorf("load values from file [%s] failed: %+v", file, err)
+		}
+	}
+
+	p.store.Replace(values)
+	return nil
+}
+
+func loadValuesFile(path string, values map[string]string) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// JSON is YAML too
+	jsonContent, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonContent))
+	decoder.UseNumber()
+	if err = decoder.Decode(&v); err != nil {
+		return err
+	}
+
+	flattenValues("", v, values)
+	// empty file or scalar has no key
+	delete(values, "")
+	return nil
+}
+
+func flattenValues(key string, v interface{}, values map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, x := range v {
+			flattenValues(key+"/"+strings.Trim(k, "/"), x, values)
+		}
+	case []interface{}:
+		for i, x := range v {
+			flattenValues(key+"/"+strconv.Itoa(i), x, values)
+		}
+	case string:
+		values[key] = v
+	case json.Number:
+		values[key] = v.String()
+	case bool:
+		values[key] = strconv.FormatBool(v)
+	case nil:
+		values[key] = ""
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package backends
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/ghodss/yaml"
+
+	"openpitrix.io/openpitrix/pkg/libconfd"
+	"openpitrix.io/openpitrix/pkg/libconfd/backendtest"
+)
+
+// fileStore rewrites the whole file by rename for each change, like most editors
+type fileStore struct {
+	path   string
+	values map[string]string
+	mu     sync.Mutex
+}
+
+func (p *fileStore) write() error {
+	content, err := yaml.Marshal(p.values)
+	if err != nil {
+		return err
+	}
+	tmp := p.path + ".tmp"
+	if err = ioutil.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p.path)
+}
+
+func (p *fileStore) Set(key, value string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.values[key] = value
+	return p.write()
+}
+
+func (p *fileStore) Delete(key string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.values, key)
+	return p.write()
+}
+
+func newTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "libconfd-file-backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestFileBackend(t *testing.T) {
+	dir := newTempDir(t)
+	defer os.RemoveAll(dir)
+
+	backendtest.Run(t, FileBackendType, func(t *testing.T) (libconfd.BackendClient, backendtest.Store) {
+		store := &fileStore{
+			path:   filepath.Join(dir, filepath.Base(t.Name())+".yaml"),
+			values: map[string]string{},
+		}
+		if err := store.write(); err != nil {
+			t.Fatal(err)
+		}
+
+		client, err := libconfd.NewBackendClient(&libconfd.BackendConfig{
+			Type: FileBackendType,
+			Host: []string{store.path},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client, store
+	})
+}
+
+func TestFileBackend_Dir(t *testing.T) {
+	dir := newTempDir(t)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"1.yaml": "a:\n  b: 1\n  c: [x, z]\n",
+		"2.json": `{"/a/d": true, "e": {"f": null}}`,
+		// overrides the earlier ones
+		"3.yml":     "a:\n  b: 2\n",
+		"ignore.md": "g: 1\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client, err := NewFileClient([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	values, err := client.GetValues([]string{"/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"/a/b":   "2",
+		"/a/c/0": "x",
+		"/a/c/1": "z",
+		"/a/d":   "true",
+		"/e/f":   "",
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expect %v, got %v", expected, values)
+	}
+}
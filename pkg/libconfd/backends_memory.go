@@ -0,0 +1,194 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package libconfd
+
+import (
+	"strings"
+	"sync"
+)
+
+const MemoryBackendType = "libconfd-backend-memory"
+
+var _ BackendClient = (*MemoryBackend)(nil)
+
+// MemoryBackend keeps the values in memory, every change is a new revision.
+// It is used to test templates, and as the store of backends loading the
+// values from elsewhere (e.g. files).
+type MemoryBackend struct {
+	mu       sync.Mutex
+	values   map[string]*memoryValue
+	revision uint64
+	// closed and renewed when any value changed
+	changed chan struct{}
+}
+
+type memoryValue struct {
+	value    string
+	deleted  bool
+	revision uint64
+}
+
+func init() {
+	RegisterBackendClient(
+		(*MemoryBackend)(nil).Type(),
+		func(cfg *BackendConfig) (BackendClient, error) {
+			return NewMemoryBackendClient(nil), nil
+		},
+	)
+}
+
+func NewMemoryBackendClient(values map[string]string) *MemoryBackend {
+	p := &MemoryBackend{
+		values: make(map[string]*memoryValue),
+		// the first watch returns the revision before any change
+		revision: 1,
+		changed:  make(chan struct{}),
+	}
+	p.Replace(values)
+	return p
+}
+
+func (_ *MemoryBackend) Close() error {
+	return nil
+}
+
+func (_ *MemoryBackend) Type() string {
+	return MemoryBackendType
+}
+
+func (_ *MemoryBackend) WatchEnabled() bool {
+	return true
+}
+
+func (p *MemoryBackend) Set(key, value string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.set(key, value) {
+		p.notify()
+	}
+}
+
+func (p *MemoryBackend) Delete(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.delete(key) {
+		p.notify()
+	}
+}
+
+// Replace sets all the values and deletes the keys not in values,
+// only the keys actually changed are seen by WatchPrefix
+func (p *MemoryBackend) Replace(values map[string]string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changed := false
+	for k, v := range values {
+		if p.set(k, v) {
+			changed = true
+		}
+	}
+	for k := range p.values {
+		if _, ok := values[k]; !ok && p.delete(k) {
+			changed = true
+		}
+	}
+	if changed {
+		p.notify()
+	}
+}
+
+// set must be called with lock held
+func (p *MemoryBackend) set(key, value string) bool {
+	if v, ok := p.values[key]; ok && !v.deleted && v.value == value {
+		return false
+	}
+	p.revision++
+	p.values[key] = &memoryValue{value: value, revision: p.revision}
+	return true
+}
+
+// delete must be called with lock held, the deleted key is kept
+// with the revision so that the watchers see the deletion
+func (p *MemoryBackend) delete(key string) bool {
+	v, ok := p.values[key]
+	if !ok || v.deleted {
+		return false
+	}
+	p.revision++
+	p.values[key] = &memoryValue{deleted: true, revision: p.revision}
+	return true
+}
+
+// notify must be called with lock held
+func (p *MemoryBackend) notify() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+func hasAnyPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *MemoryBackend) GetValues(keys []string) (map[string]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	m := make(map[string]string)
+	for k, v := range p.values {
+		if !v.deleted && hasAnyPrefix(k, keys) {
+			m[k] = v.value
+		}
+	}
+	return m, nil
+}
+
+// changedSince must be called with lock held
+func (p *MemoryBackend) changedSince(keys []string, revision uint64) bool {
+	for k, v := range p.values {
+		if v.revision > revision && hasAnyPrefix(k, keys) {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchPrefix returns the current revision once any key prefixed by keys
+// changed after waitIndex, or returns waitIndex when stopChan is closed
+func (p *MemoryBackend) WatchPrefix(prefix string, keys []string, waitIndex uint64, stopChan chan bool) (uint64, error) {
+	if len(keys) == 0 {
+		keys = []string{prefix}
+	}
+
+	for {
+		p.mu.Lock()
+		// return something > 0 to trigger a key retrieval from the store
+		if waitIndex == 0 {
+			revision := p.revision
+			p.mu.Unlock()
+			return revision, nil
+		}
+		if p.changedSince(keys, waitIndex) {
+			revision := p.revision
+			p.mu.Unlock()
+			return revision, nil
+		}
+		changed := p.changed
+		p.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-stopChan:
+			return waitIndex, nil
+		}
+	}
+}
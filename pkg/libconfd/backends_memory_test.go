@@ -0,0 +1,33 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package libconfd_test
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/libconfd"
+	"openpitrix.io/openpitrix/pkg/libconfd/backendtest"
+)
+
+type memoryStore struct {
+	*libconfd.MemoryBackend
+}
+
+func (p memoryStore) Set(key, value string) error {
+	p.MemoryBackend.Set(key, value)
+	return nil
+}
+
+func (p memoryStore) Delete(key string) error {
+	p.MemoryBackend.Delete(key)
+	return nil
+}
+
+func TestMemoryBackend(t *testing.T) {
+	backendtest.Run(t, libconfd.MemoryBackendType, func(t *testing.T) (libconfd.BackendClient, backendtest.Store) {
+		client := libconfd.NewMemoryBackendClient(nil)
+		return client, memoryStore{client}
+	})
+}
@@ -0,0 +1,217 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package backendtest is the conformance test suite of libconfd backends.
+package backendtest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/libconfd"
+)
+
+// Root is the prefix of all keys written by the suite,
+// the backend under test may be shared with others
+const Root = "/backendtest"
+
+// how long a watch waits for the change before failing
+var WatchTimeout = 10 * time.Second
+
+// Store changes the values of the backend under test
+type Store interface {
+	Set(key, value string) error
+	Delete(key string) error
+}
+
+// NewBackend returns the backend under test with no key under Root,
+// and the store to change its values
+type NewBackend func(t *testing.T) (libconfd.BackendClient, Store)
+
+// Run runs the conformance tests against the backend.
+//
+// WatchPrefix may return for keys not watched (false positives are allowed),
+// but must return for the changes of the watched keys, including the changes
+// happened between the returned index and the next call, and must return
+// once stopChan is closed.
+func Run(t *testing.T, typeName string, newBackend NewBackend) {
+	t.Run("Type", func(t *testing.T) {
+		client, _ := newBackend(t)
+		defer client.Close()
+
+		if client.Type() != typeName {
+			t.Errorf("expect type [%s], got [%s]", typeName, client.Type())
+		}
+		if !client.WatchEnabled() {
+			t.Errorf("watch should be enabled")
+		}
+	})
+	t.Run("GetValues", func(t *testing.T) { testGetValues(t, newBackend) })
+	t.Run("WatchPrefix", func(t *testing.T) { testWatchPrefix(t, newBackend) })
+	t.Run("WatchPrefixMissedChange", func(t *testing.T) { testWatchPrefixMissedChange(t, newBackend) })
+	t.Run("WatchPrefixStop", func(t *testing.T) { testWatchPrefixStop(t, newBackend) })
+}
+
+func mustSet(t *testing.T, store Store, key, value string) {
+	if err := store.Set(key, value); err != nil {
+		t.Fatalf("set [%s] failed: %+v", key, err)
+	}
+}
+
+func mustDelete(t *testing.T, store Store, key string) {
+	if err := store.Delete(key); err != nil {
+		t.Fatalf("delete [%s] failed: %+v", key, err)
+	}
+}
+
+// expectValues waits for the values since some backends are eventually consistent
+func expectValues(t *testing.T, client libconfd.BackendClient, keys []string, expected map[string]string) {
+	var values map[string]string
+	var err error
+	for deadline := time.Now().Add(WatchTimeout); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		values, err = client.GetValues(keys)
+		if err == nil && reflect.DeepEqual(values, expected) {
+			return
+		}
+	}
+	t.Fatalf("get values of %v, expect %v, got %v, %+v", keys, expected, values, err)
+}
+
+type watchResult struct {
+	index uint64
+	err   error
+}
+
+func watchPrefix(client libconfd.BackendClient, prefix string, keys []string, waitIndex uint64, stopChan chan bool) chan watchResult {
+	c := make(chan watchResult, 1)
+	go func() {
+		index, err := client.WatchPrefix(prefix, keys, waitIndex, stopChan)
+		c <- watchResult{index, err}
+	}()
+	return c
+}
+
+func waitWatch(t *testing.T, c chan watchResult) uint64 {
+	select {
+	case r := <-c:
+		if r.err != nil {
+			t.Fatalf("watch failed: %+v", r.err)
+		}
+		if r.index == 0 {
+			t.Fatalf("watch should return index > 0")
+		}
+		return r.index
+	case <-time.After(WatchTimeout):
+		t.Fatalf("watch does not return in %s", WatchTimeout)
+		return 0
+	}
+}
+
+func testGetValues(t *testing.T, newBackend NewBackend) {
+	client, store := newBackend(t)
+	defer client.Close()
+
+	expectValues(t, client, []string{Root}, map[string]string{})
+
+	mustSet(t, store, Root+"/a/1", "a1")
+	mustSet(t, store, Root+"/a/2", "a2")
+	mustSet(t, store, Root+"/b/1", "b1")
+
+	expectValues(t, client, []string{Root}, map[string]string{
+		Root + "/a/1": "a1",
+		Root + "/a/2": "a2",
+		Root + "/b/1": "b1",
+	})
+	expectValues(t, client, []string{Root + "/a"}, map[string]string{
+		Root + "/a/1": "a1",
+		Root + "/a/2": "a2",
+	})
+	expectValues(t, client, []string{Root + "/a/1", Root + "/b"}, map[string]string{
+		Root + "/a/1": "a1",
+		Root + "/b/1": "b1",
+	})
+	expectValues(t, client, []string{Root + "/c"}, map[string]string{})
+
+	mustSet(t, store, Root+"/a/1", "a1-new")
+	mustDelete(t, store, Root+"/a/2")
+	expectValues(t, client, []string{Root + "/a"}, map[string]string{
+		Root + "/a/1": "a1-new",
+	})
+}
+
+func testWatchPrefix(t *testing.T, newBackend NewBackend) {
+	client, store := newBackend(t)
+	defer client.Close()
+
+	stopChan := make(chan bool)
+	defer close(stopChan)
+
+	mustSet(t, store, Root+"/a/1", "a1")
+	expectValues(t, client, []string{Root + "/a"}, map[string]string{Root + "/a/1": "a1"})
+
+	keys := []string{Root + "/a"}
+
+	// the first watch returns immediately
+	index := waitWatch(t, watchPrefix(client, Root, keys, 0, stopChan))
+
+	for _, change := range []func(){
+		func() { mustSet(t, store, Root+"/a/1", "a1-new") },
+		func() { mustSet(t, store, Root+"/a/2", "a2") },
+		func() { mustDelete(t, store, Root+"/a/1") },
+	} {
+		c := watchPrefix(client, Root, keys, index, stopChan)
+		// the watch may start after the change, it must not be missed either way
+		time.Sleep(100 * time.Millisecond)
+		change()
+		index = waitWatch(t, c)
+	}
+
+	expectValues(t, client, keys, map[string]string{Root + "/a/2": "a2"})
+}
+
+func testWatchPrefixMissedChange(t *testing.T, newBackend NewBackend) {
+	client, store := newBackend(t)
+	defer client.Close()
+
+	stopChan := make(chan bool)
+	defer close(stopChan)
+
+	mustSet(t, store, Root+"/a/1", "a1")
+	expectValues(t, client, []string{Root + "/a"}, map[string]string{Root + "/a/1": "a1"})
+
+	keys := []string{Root + "/a"}
+	index := waitWatch(t, watchPrefix(client, Root, keys, 0, stopChan))
+	if _, err := client.GetValues(keys); err != nil {
+		t.Fatal(err)
+	}
+
+	// changed after the values are read and before the next watch
+	mustSet(t, store, Root+"/a/1", "a1-new")
+	expectValues(t, client, keys, map[string]string{Root + "/a/1": "a1-new"})
+
+	waitWatch(t, watchPrefix(client, Root, keys, index, stopChan))
+}
+
+func testWatchPrefixStop(t *testing.T, newBackend NewBackend) {
+	client, store := newBackend(t)
+	defer client.Close()
+
+	mustSet(t, store, Root+"/a/1", "a1")
+	expectValues(t, client, []string{Root + "/a"}, map[string]string{Root + "/a/1": "a1"})
+
+	keys := []string{Root + "/a"}
+	stopChan := make(chan bool)
+	index := waitWatch(t, watchPrefix(client, Root, keys, 0, stopChan))
+
+	c := watchPrefix(client, Root, keys, index, stopChan)
+	time.Sleep(100 * time.Millisecond)
+	close(stopChan)
+
+	select {
+	case <-c:
+	case <-time.After(WatchTimeout):
+		t.Fatalf("watch does not return in %s after stopped", WatchTimeout)
+	}
+}
@@ -15,9 +15,33 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 )
 
+// runningReloadCmds holds the reload commands being executed, keyed by
+// the path of template resource.
+var runningReloadCmds = struct {
+	sync.Mutex
+	m map[string]*exec.Cmd
+}{m: make(map[string]*exec.Cmd)}
+
+// KillReloadCmd kills the reload command of the template resource trName.
+// It returns false if the reload command is not running.
+func KillReloadCmd(trName string) (bool, error) {
+	runningReloadCmds.Lock()
+	defer runningReloadCmds.Unlock()
+
+	c, ok := runningReloadCmds.m[trName]
+	if !ok {
+		return false, nil
+	}
+	if err := killCommand(c); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type TemplateResourceProcessor struct {
 	TemplateResource
 
@@ -349,7 +373,7 @@ func (p *TemplateResourceProcessor) doCheckCmd(call *Call) (err error) {
 	if err := tmpl.Execute(&cmdBuffer, data); err != nil {
 		return err
 	}
-	return p.runCommand(cmdBuffer.String())
+	return p.runCommand("", cmdBuffer.String())
 }
 
 // reload executes the reload command.
@@ -359,14 +383,15 @@ func (p *TemplateResourceProcessor) doReloadCmd(call *Call) (err error) {
 		defer func() { fn(p.path, p.ReloadCmd, err) }()
 	}
 
-	return p.runCommand(p.ReloadCmd)
+	return p.runCommand(p.path, p.ReloadCmd)
 }
 
 // runCommand is a shared function used by check and reload
 // to run the given command and log its output.
 // It returns nil if the given cmd returns 0.
 // The command can be run on unix and windows.
-func (_ *TemplateResourceProcessor) runCommand(cmd string) error {
+// The command is killable by KillReloadCmd if trName is not empty.
+func (_ *TemplateResourceProcessor) runCommand(trName, cmd string) error {
 	cmd = strings.TrimSpace(cmd)
 
 	GetLogger().Debug("TemplateResourceProcessor.runCommand: " + cmd)
@@ -377,20 +402,34 @@ func (_ *TemplateResourceProcessor) runCommand(cmd string) error {
 		return err
 	}
 
-	var c *exec.Cmd
-	if runtime.GOOS == "windows" {
-		c = exec.Command("cmd", "/C", cmd)
-	} else {
-		c = exec.Command("/bin/sh", "-c", cmd)
+	var output bytes.Buffer
+	c := newShellCommand(cmd)
+	c.Stdout = &output
+	c.Stderr = &output
+
+	if err := c.Start(); err != nil {
+		GetLogger().Error(err)
+		return err
 	}
 
-	output, err := c.CombinedOutput()
-	if err != nil {
-		GetLogger().Errorf("%v, output: %q", err, string(output))
+	if trName != "" {
+		runningReloadCmds.Lock()
+		runningReloadCmds.m[trName] = c
+		runningReloadCmds.Unlock()
+
+		defer func() {
+			runningReloadCmds.Lock()
+			delete(runningReloadCmds.m, trName)
+			runningReloadCmds.Unlock()
+		}()
+	}
+
+	if err := c.Wait(); err != nil {
+		GetLogger().Errorf("%v, output: %q", err, output.String())
 		return err
 	}
 
-	GetLogger().Debugf("%q", string(output))
+	GetLogger().Debugf("%q", output.String())
 	return nil
 }
 
@@ -11,9 +11,23 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"syscall"
 )
 
+// newShellCommand returns a command running cmd by /bin/sh in its own
+// process group, so that the children of the shell are killed with it.
+func newShellCommand(cmd string) *exec.Cmd {
+	c := exec.Command("/bin/sh", "-c", cmd)
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return c
+}
+
+// killCommand kills the process group of the started command.
+func killCommand(c *exec.Cmd) error {
+	return syscall.Kill(-c.Process.Pid, syscall.SIGKILL)
+}
+
 // readFileStat return a fileInfo describing the named file.
 func readFileStat(name string) (fi fileInfo, err error) {
 	f, err := os.Open(name)
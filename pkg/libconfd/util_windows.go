@@ -9,8 +9,19 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 )
 
+// newShellCommand returns a command running cmd by cmd.exe.
+func newShellCommand(cmd string) *exec.Cmd {
+	return exec.Command("cmd", "/C", cmd)
+}
+
+// killCommand kills the started command.
+func killCommand(c *exec.Cmd) error {
+	return c.Process.Kill()
+}
+
 // readFileStat return a fileInfo describing the named file.
 func readFileStat(name string) (fi fileInfo, err error) {
 	f, err := os.Open(name)
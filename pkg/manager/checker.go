@@ -50,6 +50,10 @@ func (c *checker) checkRequired(param string, value interface{}) error {
 			if v == nil || len(v.GetValue()) == 0 {
 				return gerr.New(c.ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, param)
 			}
+		case *wrappers.Int32Value:
+			if v == nil {
+				return gerr.New(c.ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, param)
+			}
 		case []byte:
 			if len(v) == 0 {
 				return gerr.New(c.ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, param)
@@ -58,6 +58,21 @@ func TestChecker(t *testing.T) {
 
 	assert.Error(t, err)
 
+	req = &pb.DiffReleaseRevisionsRequest{
+		ClusterId: pbutil.ToProtoString("cl-1"),
+	}
+	err = NewChecker(context.Background(), req).Required("cluster_id", "from_version").Exec()
+
+	assert.Error(t, err)
+
+	req = &pb.DiffReleaseRevisionsRequest{
+		ClusterId:   pbutil.ToProtoString("cl-1"),
+		FromVersion: pbutil.ToProtoInt32(1),
+	}
+	err = NewChecker(context.Background(), req).Required("cluster_id", "from_version").Exec()
+
+	assert.NoError(t, err)
+
 	//req = &pb.CreateRepoRequest{}
 	//ctx := ctxutil.ContextWithSender(context.Background(), sender.GetSystemSender())
 	//err = NewChecker(ctx, req).OperatorType([]string{"developer"}).Exec()
@@ -39,6 +39,10 @@ type RequestWithReverse interface {
 	RequestWithSortKey
 	GetReverse() *wrappers.BoolValue
 }
+type RequestWithPageToken interface {
+	Request
+	GetPageToken() *wrappers.StringValue
+}
 type RequestWithOwner interface {
 	Request
 	GetOwner() []string
@@ -210,6 +214,15 @@ func AddQueryOrderDir(query *db.SelectQuery, req Request, defaultColumn string)
 }
 
 func addQueryOrderDir(query *db.SelectQuery, req Request, defaultColumn string, tableName string) *db.SelectQuery {
+	column, isAsc := getOrderDir(req, defaultColumn)
+	if len(tableName) > 0 {
+		column = tableName + "." + column
+	}
+	query = query.OrderDir(column, isAsc)
+	return query
+}
+
+func getOrderDir(req Request, defaultColumn string) (string, bool) {
 	isAsc := false
 	if r, ok := req.(RequestWithReverse); ok {
 		reverse := r.GetReverse()
@@ -226,11 +239,40 @@ func addQueryOrderDir(query *db.SelectQuery, req Request, defaultColumn string,
 	if !stringutil.StringIn(defaultColumn, constants.Fields) {
 		defaultColumn = constants.ColumnCreateTime
 	}
-	if len(tableName) > 0 {
-		defaultColumn = tableName + "." + defaultColumn
+	return defaultColumn, isAsc
+}
+
+// AddQueryCursor orders the query like AddQueryOrderDir and then by the primary key,
+// the rows loaded start after the page token of request if it is set.
+// The returned cursor builds the page token of next page.
+func AddQueryCursor(query *db.SelectQuery, req Request, defaultColumn, primaryKey string) (*db.SelectQuery, *db.Cursor, error) {
+	column, isAsc := getOrderDir(req, defaultColumn)
+	cursor, err := GetCursor(req, db.NewCursor(column, primaryKey, isAsc))
+	if err != nil {
+		return nil, nil, err
 	}
-	query = query.OrderDir(defaultColumn, isAsc)
-	return query
+	return query.OrderByCursor(cursor), cursor, nil
+}
+
+// GetCursor returns the cursor decoded from the page token of request,
+// or the order if page token is not set
+func GetCursor(req Request, order *db.Cursor) (*db.Cursor, error) {
+	r, ok := req.(RequestWithPageToken)
+	if !ok {
+		return order, nil
+	}
+	pageToken := r.GetPageToken().GetValue()
+	if pageToken == "" {
+		return order, nil
+	}
+	cursor, err := db.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if !cursor.SameOrder(order) {
+		return nil, fmt.Errorf("page token [%s] does not match the sort key and order of request", pageToken)
+	}
+	return cursor, nil
 }
 
 func AddQueryJoinWithMap(query *db.SelectQuery, table, joinTable, primaryKey, keyField, valueField string, filterMap map[string][]string) *db.SelectQuery {
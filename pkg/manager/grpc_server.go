@@ -46,6 +46,7 @@ type GrpcServer struct {
 	showErrorCause bool
 	checker        checkerT
 	builder        builderT
+	interceptor    grpc.UnaryServerInterceptor
 	mysqlConfig    config.MysqlConfig
 }
 
@@ -76,6 +77,12 @@ func (g *GrpcServer) WithBuilder(b builderT) *GrpcServer {
 	return g
 }
 
+// WithUnaryInterceptor sets the interceptor called after the builtin unary interceptors
+func (g *GrpcServer) WithUnaryInterceptor(i grpc.UnaryServerInterceptor) *GrpcServer {
+	g.interceptor = i
+	return g
+}
+
 func (g *GrpcServer) WithMysqlConfig(cfg config.MysqlConfig) *GrpcServer {
 	g.mysqlConfig = cfg
 	return g
@@ -128,8 +135,22 @@ func (g *GrpcServer) Serve(callback RegisterCallback, opt ...grpc.ServerOption)
 					return gerr.New(nil, gerr.Internal, gerr.ErrorInternalError)
 				}),
 			),
+			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+				if g.interceptor != nil {
+					return g.interceptor(ctx, req, info, handler)
+				}
+				return handler(ctx, req)
+			},
 		),
 		grpc_middleware.WithStreamServerChain(
+			func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				stream := &checkedServerStream{
+					WrappedServerStream: grpc_middleware.WrapServerStream(ss),
+					checker:             g.checker,
+				}
+				stream.WrappedContext = db.NewContext(ss.Context(), g.mysqlConfig)
+				return handler(srv, stream)
+			},
 			grpc_recovery.StreamServerInterceptor(
 				grpc_recovery.WithRecoveryHandler(func(p interface{}) error {
 					logger.Critical(nil, "GRPC server recovery with error: %+v", p)
@@ -153,6 +174,23 @@ func (g *GrpcServer) Serve(callback RegisterCallback, opt ...grpc.ServerOption)
 	}
 }
 
+// checkedServerStream checks the requests received by the stream with the checker of server
+type checkedServerStream struct {
+	*grpc_middleware.WrappedServerStream
+	checker checkerT
+}
+
+func (s *checkedServerStream) RecvMsg(m interface{}) error {
+	err := s.WrappedServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	if s.checker != nil {
+		return s.checker(s.Context(), m)
+	}
+	return nil
+}
+
 var (
 	jsonPbMarshaller = &jsonpb.Marshaler{
 		OrigName: true,
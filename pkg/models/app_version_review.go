@@ -65,6 +65,8 @@ type AppVersionReview struct {
 	Phase      AppVersionReviewPhases
 	StatusTime time.Time
 	Reviewer   string
+	// Stages is the review pipeline resolved when the app version is submitted
+	Stages ReviewStages
 }
 
 type AppVersionReviews []*AppVersionReview
@@ -86,9 +88,17 @@ func (avr *AppVersionReview) UpdatePhase(operatorType, status, operator, message
 	avr.Phase[operatorType] = p
 }
 
+// GetStages returns the default review stages for the reviews submitted without stages
+func (avr *AppVersionReview) GetStages() ReviewStages {
+	if len(avr.Stages) == 0 {
+		return DefaultReviewStages
+	}
+	return avr.Stages
+}
+
 var AppVersionReviewColumns = db.GetColumnsFromStruct(&AppVersionReview{})
 
-func NewAppVersionReview(versionId, appId, status string, ownerPath sender.OwnerPath) *AppVersionReview {
+func NewAppVersionReview(versionId, appId, status string, ownerPath sender.OwnerPath, stages ReviewStages) *AppVersionReview {
 	return &AppVersionReview{
 		ReviewId:   NewAppVersionReviewId(),
 		VersionId:  versionId,
@@ -98,6 +108,7 @@ func NewAppVersionReview(versionId, appId, status string, ownerPath sender.Owner
 		OwnerPath:  ownerPath,
 		Phase:      make(AppVersionReviewPhases),
 		StatusTime: time.Now(),
+		Stages:     stages,
 	}
 }
 
@@ -112,6 +123,7 @@ func AppVersionReviewToPb(versionReview *AppVersionReview) *pb.AppVersionReview
 	pbAppVersionReview.Status = pbutil.ToProtoString(versionReview.Status)
 	pbAppVersionReview.StatusTime = pbutil.ToProtoTimestamp(versionReview.StatusTime)
 	pbAppVersionReview.Reviewer = pbutil.ToProtoString(versionReview.Reviewer)
+	pbAppVersionReview.Stage = versionReview.GetStages().Names()
 
 	pbAppVersionReview.Phase = make(map[string]*pb.AppVersionReviewPhase)
 	for operatorType, p := range versionReview.Phase {
@@ -6,9 +6,12 @@ package models
 
 import (
 	"reflect"
+	"strings"
 
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
 )
 
@@ -43,7 +46,48 @@ type ClusterCommon struct {
 
 var ClusterCommonColumns = db.GetColumnsFromStruct(&ClusterCommon{})
 
+// customServiceAttributePrefix names the custom services of app as attributes of cluster common,
+// they are stored together in CustomService
+const customServiceAttributePrefix = "CustomService."
+
+func GetCustomServiceAttribute(serviceName string) string {
+	return customServiceAttributePrefix + serviceName
+}
+
+// GetCustomService returns the custom service defined by app, empty if the role has no such service
+func (c ClusterCommon) GetCustomService(serviceName string) string {
+	if c.CustomService == "" {
+		return ""
+	}
+	var customServices map[string]interface{}
+	err := jsonutil.Decode([]byte(c.CustomService), &customServices)
+	if err != nil {
+		return ""
+	}
+	service, exist := customServices[serviceName]
+	if !exist {
+		return ""
+	}
+	return jsonutil.ToString(service)
+}
+
+// GetMonitor returns the monitor of role defined by app, nil if the role is not monitored
+func (c ClusterCommon) GetMonitor() *opapp.Monitor {
+	if c.Monitor == "" {
+		return nil
+	}
+	monitor := new(opapp.Monitor)
+	err := jsonutil.Decode([]byte(c.Monitor), monitor)
+	if err != nil || !monitor.IsEnabled() {
+		return nil
+	}
+	return monitor
+}
+
 func (c ClusterCommon) GetAttribute(attributeName string) interface{} {
+	if strings.HasPrefix(attributeName, customServiceAttributePrefix) {
+		return c.GetCustomService(strings.TrimPrefix(attributeName, customServiceAttributePrefix))
+	}
 	common := reflect.ValueOf(c)
 	service := common.FieldByName(attributeName).Interface()
 	return service
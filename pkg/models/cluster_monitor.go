@@ -0,0 +1,116 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"sort"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// MonitorPoint is the value of monitor item collected at the time
+type MonitorPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// CountMonitorPoints counts the values collected in time order by the statistics type of item,
+// and averages the values in each step if step > 0. The values of delta and rate are counted
+// from the previous value, the counter is regarded as reset if the value is decreased.
+func CountMonitorPoints(item opapp.MonitorItem, points []MonitorPoint, step time.Duration) []MonitorPoint {
+	var counted []MonitorPoint
+	for i, point := range points {
+		value := point.Value
+		switch item.StatisticsType {
+		case constants.MonitorStatisticsDelta, constants.MonitorStatisticsRate:
+			if i == 0 {
+				continue
+			}
+			if value >= points[i-1].Value {
+				value -= points[i-1].Value
+			}
+			if item.StatisticsType == constants.MonitorStatisticsRate {
+				seconds := point.Time.Sub(points[i-1].Time).Seconds()
+				if seconds <= 0 {
+					continue
+				}
+				value /= seconds
+			}
+		}
+		if item.ScaleFactorWhenDisplay > 0 {
+			value *= float64(item.ScaleFactorWhenDisplay)
+		}
+		counted = append(counted, MonitorPoint{Time: point.Time, Value: value})
+	}
+	if step <= 0 || len(counted) == 0 {
+		return counted
+	}
+
+	var averaged []MonitorPoint
+	var sum float64
+	var count int
+	bucket := counted[0].Time.Truncate(step)
+	for _, point := range counted {
+		if t := point.Time.Truncate(step); !t.Equal(bucket) {
+			averaged = append(averaged, MonitorPoint{Time: bucket, Value: sum / float64(count)})
+			bucket, sum, count = t, 0, 0
+		}
+		sum += point.Value
+		count++
+	}
+	return append(averaged, MonitorPoint{Time: bucket, Value: sum / float64(count)})
+}
+
+func MonitorPointsToPbs(points []MonitorPoint) (pbMonitorPoints []*pb.MonitorPoint) {
+	for _, point := range points {
+		pbMonitorPoints = append(pbMonitorPoints, &pb.MonitorPoint{
+			Time:  pbutil.ToProtoTimestamp(point.Time),
+			Value: point.Value,
+		})
+	}
+	return
+}
+
+// MonitorToPb returns the definitions of monitor of role, the items and groups are sorted by name
+func MonitorToPb(role string, monitor *opapp.Monitor) *pb.RoleMonitor {
+	pbRoleMonitor := &pb.RoleMonitor{
+		Role:    pbutil.ToProtoString(role),
+		Display: monitor.Display,
+	}
+
+	var names []string
+	for name := range monitor.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		item := monitor.Items[name]
+		pbRoleMonitor.ItemSet = append(pbRoleMonitor.ItemSet, &pb.MonitorItem{
+			Name:                   pbutil.ToProtoString(name),
+			Unit:                   pbutil.ToProtoString(item.Unit),
+			ValueType:              pbutil.ToProtoString(item.ValueType),
+			StatisticsType:         pbutil.ToProtoString(item.StatisticsType),
+			ScaleFactorWhenDisplay: pbutil.ToProtoUInt32(item.ScaleFactorWhenDisplay),
+			Enums:                  item.Enums,
+		})
+	}
+
+	names = nil
+	for name := range monitor.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pbRoleMonitor.GroupSet = append(pbRoleMonitor.GroupSet, &pb.MonitorGroup{
+			Name:  pbutil.ToProtoString(name),
+			Items: monitor.Groups[name],
+		})
+	}
+	return pbRoleMonitor
+}
@@ -0,0 +1,43 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
+)
+
+func TestCountMonitorPoints(t *testing.T) {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	var points []MonitorPoint
+	// the counter is reset at the 4th point
+	for i, value := range []float64{100, 160, 280, 30, 90} {
+		points = append(points, MonitorPoint{Time: start.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+
+	for _, c := range []struct {
+		item     opapp.MonitorItem
+		step     time.Duration
+		expected []float64
+	}{
+		{opapp.MonitorItem{StatisticsType: constants.MonitorStatisticsLatest}, 0, []float64{100, 160, 280, 30, 90}},
+		{opapp.MonitorItem{StatisticsType: constants.MonitorStatisticsDelta}, 0, []float64{60, 120, 30, 60}},
+		{opapp.MonitorItem{StatisticsType: constants.MonitorStatisticsRate}, 0, []float64{1, 2, 0.5, 1}},
+		{opapp.MonitorItem{StatisticsType: constants.MonitorStatisticsRate, ScaleFactorWhenDisplay: 100}, 0, []float64{100, 200, 50, 100}},
+		{opapp.MonitorItem{StatisticsType: constants.MonitorStatisticsLatest}, 2 * time.Minute, []float64{130, 155, 90}},
+	} {
+		var values []float64
+		for _, point := range CountMonitorPoints(c.item, points, c.step) {
+			values = append(values, point.Value)
+		}
+		if !reflect.DeepEqual(values, c.expected) {
+			t.Errorf("Unexpected values [%v] of item [%+v] by step [%s], expected [%v]", values, c.item, c.step, c.expected)
+		}
+	}
+}
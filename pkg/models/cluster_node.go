@@ -7,6 +7,7 @@ package models
 import (
 	"time"
 
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/sender"
@@ -39,6 +40,9 @@ type ClusterNode struct {
 	CustomMetadata   string
 	PubKey           string
 	HealthStatus     string
+	HealthyCount     uint32
+	UnhealthyCount   uint32
+	HealthCheckTime  *time.Time
 	IsBackup         bool
 	AutoBackup       bool
 	CreateTime       time.Time
@@ -61,8 +65,40 @@ func NewClusterNode() *ClusterNode {
 	}
 }
 
+// RecordHealthCheck records the result of health check on the node. The node turns healthy or unhealthy
+// when the consecutive results reach the threshold, and needs to be healed by the action of health check
+// every time the consecutive failures reach the unhealthy threshold.
+func (n *ClusterNode) RecordHealthCheck(healthy bool, healthyThreshold, unhealthyThreshold uint32) (heal bool) {
+	if healthyThreshold == 0 {
+		healthyThreshold = 1
+	}
+	if unhealthyThreshold == 0 {
+		unhealthyThreshold = 1
+	}
+
+	if healthy {
+		n.UnhealthyCount = 0
+		if n.HealthyCount < healthyThreshold {
+			n.HealthyCount++
+		}
+		if n.HealthyCount >= healthyThreshold {
+			n.HealthStatus = constants.StatusHealthy
+		}
+		return false
+	}
+
+	n.HealthyCount = 0
+	n.UnhealthyCount++
+	if n.UnhealthyCount < unhealthyThreshold {
+		return false
+	}
+	n.UnhealthyCount = 0
+	n.HealthStatus = constants.StatusUnhealthy
+	return true
+}
+
 func ClusterNodeToPb(clusterNode *ClusterNode) *pb.ClusterNode {
-	return &pb.ClusterNode{
+	c := &pb.ClusterNode{
 		NodeId:           pbutil.ToProtoString(clusterNode.NodeId),
 		ClusterId:        pbutil.ToProtoString(clusterNode.ClusterId),
 		Name:             pbutil.ToProtoString(clusterNode.Name),
@@ -90,10 +126,14 @@ func ClusterNodeToPb(clusterNode *ClusterNode) *pb.ClusterNode {
 		HostId:           pbutil.ToProtoString(clusterNode.HostId),
 		HostIp:           pbutil.ToProtoString(clusterNode.HostIp),
 	}
+	if clusterNode.HealthCheckTime != nil {
+		c.HealthCheckTime = pbutil.ToProtoTimestamp(*clusterNode.HealthCheckTime)
+	}
+	return c
 }
 
 func ClusterNodeWithKeyPairsToPb(clusterNodeKeyPairs *ClusterNodeWithKeyPairs) *pb.ClusterNode {
-	return &pb.ClusterNode{
+	c := &pb.ClusterNode{
 		NodeId:           pbutil.ToProtoString(clusterNodeKeyPairs.NodeId),
 		ClusterId:        pbutil.ToProtoString(clusterNodeKeyPairs.ClusterId),
 		Name:             pbutil.ToProtoString(clusterNodeKeyPairs.Name),
@@ -122,6 +162,10 @@ func ClusterNodeWithKeyPairsToPb(clusterNodeKeyPairs *ClusterNodeWithKeyPairs) *
 		HostIp:           pbutil.ToProtoString(clusterNodeKeyPairs.HostIp),
 		KeyPairId:        clusterNodeKeyPairs.KeyPairId,
 	}
+	if clusterNodeKeyPairs.HealthCheckTime != nil {
+		c.HealthCheckTime = pbutil.ToProtoTimestamp(*clusterNodeKeyPairs.HealthCheckTime)
+	}
+	return c
 }
 
 func PbToClusterNode(pbClusterNode *pb.ClusterNode) *ClusterNodeWithKeyPairs {
@@ -156,6 +200,10 @@ func PbToClusterNode(pbClusterNode *pb.ClusterNode) *ClusterNodeWithKeyPairs {
 			HostIp:           pbClusterNode.HostIp.GetValue(),
 		},
 	}
+	if pbClusterNode.GetHealthCheckTime() != nil {
+		healthCheckTime := pbutil.GetTime(pbClusterNode.GetHealthCheckTime())
+		clusterNodeKeyPairs.HealthCheckTime = &healthCheckTime
+	}
 	clusterNodeKeyPairs.KeyPairId = pbClusterNode.KeyPairId
 	return clusterNodeKeyPairs
 }
@@ -0,0 +1,53 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+)
+
+func TestClusterNode_RecordHealthCheck(t *testing.T) {
+	node := &ClusterNode{NodeId: "cln-1"}
+	for i, step := range []struct {
+		healthy      bool
+		healthStatus string
+		heal         bool
+	}{
+		{true, "", false},
+		{true, constants.StatusHealthy, false},
+		{true, constants.StatusHealthy, false},
+		{false, constants.StatusHealthy, false},
+		{false, constants.StatusHealthy, false},
+		{false, constants.StatusUnhealthy, true},
+		{false, constants.StatusUnhealthy, false},
+		{false, constants.StatusUnhealthy, false},
+		// healed again after another unhealthy threshold of failures
+		{false, constants.StatusUnhealthy, true},
+		{true, constants.StatusUnhealthy, false},
+		{false, constants.StatusUnhealthy, false},
+		{true, constants.StatusUnhealthy, false},
+		{true, constants.StatusHealthy, false},
+	} {
+		heal := node.RecordHealthCheck(step.healthy, 2, 3)
+		if heal != step.heal || node.HealthStatus != step.healthStatus {
+			t.Errorf("Step [%d]: expect health status [%s] and heal [%t], got [%s] and [%t]",
+				i, step.healthStatus, step.heal, node.HealthStatus, heal)
+		}
+	}
+	if node.HealthyCount != 2 || node.UnhealthyCount != 0 {
+		t.Errorf("Unexpected healthy count [%d] and unhealthy count [%d]", node.HealthyCount, node.UnhealthyCount)
+	}
+
+	// the thresholds are 1 by default
+	node = &ClusterNode{NodeId: "cln-2"}
+	if !node.RecordHealthCheck(false, 0, 0) || node.HealthStatus != constants.StatusUnhealthy {
+		t.Errorf("Node should be unhealthy after one failure")
+	}
+	if node.RecordHealthCheck(true, 0, 0) || node.HealthStatus != constants.StatusHealthy {
+		t.Errorf("Node should be healthy after one success")
+	}
+}
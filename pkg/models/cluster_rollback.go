@@ -0,0 +1,34 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"context"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+// ClusterRollbackDirective is the directive of job rolling back a cluster, it is the
+// cluster wrapper with the revision to roll back to, so providers ignoring the revision
+// still decode it as a cluster wrapper.
+type ClusterRollbackDirective struct {
+	*ClusterWrapper
+	// version of helm release revision, 0 means the previous revision
+	ReleaseVersion int32 `json:",omitempty"`
+}
+
+func NewClusterRollbackDirective(ctx context.Context, data string) (*ClusterRollbackDirective, error) {
+	directive := &ClusterRollbackDirective{
+		ClusterWrapper: &ClusterWrapper{
+			ctx: ctx,
+		},
+	}
+	err := jsonutil.Decode([]byte(data), directive)
+	if err != nil {
+		logger.Error(ctx, "Decode [%s] into cluster rollback directive failed: %+v", data, err)
+	}
+	return directive, err
+}
@@ -0,0 +1,46 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+func TestClusterRollbackDirective(t *testing.T) {
+	clusterWrapper := &ClusterWrapper{
+		Cluster: &Cluster{ClusterId: "cl-1", Name: "wordpress"},
+	}
+	data := jsonutil.ToString(&ClusterRollbackDirective{
+		ClusterWrapper: clusterWrapper,
+		ReleaseVersion: 3,
+	})
+
+	directive, err := NewClusterRollbackDirective(nil, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directive.ReleaseVersion != 3 || directive.Cluster.Name != "wordpress" {
+		t.Errorf("Wrong rollback directive [%s]", jsonutil.ToString(directive))
+	}
+
+	// providers unaware of the revision decode the directive as a cluster wrapper
+	resumed, err := NewClusterWrapper(nil, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Cluster.ClusterId != "cl-1" {
+		t.Errorf("Wrong cluster [%s]", resumed.Cluster.ClusterId)
+	}
+
+	directive, err = NewClusterRollbackDirective(nil, jsonutil.ToString(clusterWrapper))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directive.ReleaseVersion != 0 {
+		t.Errorf("Release version should default to 0, got [%d]", directive.ReleaseVersion)
+	}
+}
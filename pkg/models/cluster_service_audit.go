@@ -0,0 +1,129 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+func NewClusterServiceAuditId() string {
+	return idutil.GetUuid("csa-")
+}
+
+// ClusterServiceAudit records a run of the restart service or custom service of app on cluster,
+// it is pending until the job running the service is done
+type ClusterServiceAudit struct {
+	ClusterServiceAuditId string
+	ClusterId             string
+	JobId                 string
+	ServiceName           string
+	Roles                 string
+	NodeIds               string
+	ServiceParams         string
+	Status                string
+	Owner                 string
+	OwnerPath             sender.OwnerPath
+	CreateTime            time.Time
+	StatusTime            time.Time
+}
+
+var ClusterServiceAuditColumns = db.GetColumnsFromStruct(&ClusterServiceAudit{})
+
+func NewClusterServiceAudit(clusterId, serviceName string, roles, nodeIds []string,
+	serviceParams map[string]interface{}, ownerPath sender.OwnerPath) *ClusterServiceAudit {
+	return &ClusterServiceAudit{
+		ClusterServiceAuditId: NewClusterServiceAuditId(),
+		ClusterId:             clusterId,
+		ServiceName:           serviceName,
+		Roles:                 strings.Join(roles, ","),
+		NodeIds:               strings.Join(nodeIds, ","),
+		ServiceParams:         jsonutil.ToString(serviceParams),
+		Status:                constants.StatusPending,
+		Owner:                 ownerPath.Owner(),
+		OwnerPath:             ownerPath,
+		CreateTime:            time.Now(),
+		StatusTime:            time.Now(),
+	}
+}
+
+func (a *ClusterServiceAudit) GetNodeIds() []string {
+	return splitCommaField(a.NodeIds)
+}
+
+// GetServiceAttribute returns the attribute name of the service in cluster common
+func (a *ClusterServiceAudit) GetServiceAttribute() string {
+	if a.ServiceName == constants.ServiceRestart {
+		return "RestartService"
+	}
+	return GetCustomServiceAttribute(a.ServiceName)
+}
+
+// GetServiceParams returns the params exported to the service
+func (a *ClusterServiceAudit) GetServiceParams() map[string]interface{} {
+	serviceParams := make(map[string]interface{})
+	if a.ServiceParams == "" {
+		return serviceParams
+	}
+	err := jsonutil.Decode([]byte(a.ServiceParams), &serviceParams)
+	if err != nil {
+		logger.Error(nil, "Decode params [%s] of service [%s] failed: %+v", a.ServiceParams, a.ServiceName, err)
+	}
+	return serviceParams
+}
+
+func ClusterServiceAuditToPb(clusterServiceAudit *ClusterServiceAudit) *pb.ClusterServiceAudit {
+	return &pb.ClusterServiceAudit{
+		ClusterServiceAuditId: pbutil.ToProtoString(clusterServiceAudit.ClusterServiceAuditId),
+		ClusterId:             pbutil.ToProtoString(clusterServiceAudit.ClusterId),
+		JobId:                 pbutil.ToProtoString(clusterServiceAudit.JobId),
+		ServiceName:           pbutil.ToProtoString(clusterServiceAudit.ServiceName),
+		Roles:                 pbutil.ToProtoString(clusterServiceAudit.Roles),
+		NodeIds:               pbutil.ToProtoString(clusterServiceAudit.NodeIds),
+		ServiceParams:         pbutil.ToProtoString(clusterServiceAudit.ServiceParams),
+		Status:                pbutil.ToProtoString(clusterServiceAudit.Status),
+		Owner:                 pbutil.ToProtoString(clusterServiceAudit.Owner),
+		OwnerPath:             clusterServiceAudit.OwnerPath.ToProtoString(),
+		CreateTime:            pbutil.ToProtoTimestamp(clusterServiceAudit.CreateTime),
+		StatusTime:            pbutil.ToProtoTimestamp(clusterServiceAudit.StatusTime),
+	}
+}
+
+func ClusterServiceAuditsToPbs(clusterServiceAudits []*ClusterServiceAudit) (pbClusterServiceAudits []*pb.ClusterServiceAudit) {
+	for _, clusterServiceAudit := range clusterServiceAudits {
+		pbClusterServiceAudits = append(pbClusterServiceAudits, ClusterServiceAuditToPb(clusterServiceAudit))
+	}
+	return
+}
+
+// ClusterServiceDirective is the directive of job running the service of the vm-based cluster
+// recorded by the cluster service audit
+type ClusterServiceDirective struct {
+	*ClusterWrapper
+	ClusterServiceAudit *ClusterServiceAudit
+}
+
+func NewClusterServiceDirective(ctx context.Context, data string) (*ClusterServiceDirective, error) {
+	directive := &ClusterServiceDirective{
+		ClusterWrapper: &ClusterWrapper{
+			ctx: ctx,
+		},
+	}
+	err := jsonutil.Decode([]byte(data), directive)
+	if err != nil {
+		logger.Error(ctx, "Decode [%s] into cluster service directive failed: %+v", data, err)
+	}
+	return directive, err
+}
@@ -4,17 +4,149 @@
 
 package models
 
-import "openpitrix.io/openpitrix/pkg/db"
+import (
+	"context"
+	"strings"
+	"time"
 
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+func NewClusterSnapshotId() string {
+	return idutil.GetUuid("ss-")
+}
+
+// ClusterSnapshot is the backup of the nodes of roles in cluster. The incremental snapshots are based on
+// a full snapshot, which records them in ChildSnapshotIds in the order they are created.
 type ClusterSnapshot struct {
 	SnapshotId       string
-	Role             string
-	ServerIds        string
-	count            int32
+	ClusterId        string
+	Name             string
+	Description      string
+	Roles            string
+	NodeIds          string
 	AppId            string
 	VersionId        string
+	SnapshotType     string
+	ParentSnapshotId string
 	ChildSnapshotIds string
-	Size             int32
+	AutoBackup       bool
+	Status           string
+	TransitionStatus string
+	Owner            string
+	OwnerPath        sender.OwnerPath
+	CreateTime       time.Time
+	StatusTime       time.Time
 }
 
 var ClusterSnapshotColumns = db.GetColumnsFromStruct(&ClusterSnapshot{})
+
+func splitCommaField(field string) []string {
+	var result []string
+	for _, s := range strings.Split(field, ",") {
+		if s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func (s *ClusterSnapshot) GetRoles() []string {
+	return splitCommaField(s.Roles)
+}
+
+func (s *ClusterSnapshot) GetNodeIds() []string {
+	return splitCommaField(s.NodeIds)
+}
+
+func (s *ClusterSnapshot) GetChildSnapshotIds() []string {
+	return splitCommaField(s.ChildSnapshotIds)
+}
+
+// GetServiceParams returns the params exported to the backup, restore and delete snapshot services
+func (s *ClusterSnapshot) GetServiceParams() map[string]interface{} {
+	return map[string]interface{}{
+		"SNAPSHOT_ID":        s.SnapshotId,
+		"SNAPSHOT_TYPE":      s.SnapshotType,
+		"PARENT_SNAPSHOT_ID": s.ParentSnapshotId,
+	}
+}
+
+func ClusterSnapshotToPb(clusterSnapshot *ClusterSnapshot) *pb.ClusterSnapshot {
+	return &pb.ClusterSnapshot{
+		SnapshotId:       pbutil.ToProtoString(clusterSnapshot.SnapshotId),
+		ClusterId:        pbutil.ToProtoString(clusterSnapshot.ClusterId),
+		Name:             pbutil.ToProtoString(clusterSnapshot.Name),
+		Description:      pbutil.ToProtoString(clusterSnapshot.Description),
+		Roles:            pbutil.ToProtoString(clusterSnapshot.Roles),
+		NodeIds:          pbutil.ToProtoString(clusterSnapshot.NodeIds),
+		AppId:            pbutil.ToProtoString(clusterSnapshot.AppId),
+		VersionId:        pbutil.ToProtoString(clusterSnapshot.VersionId),
+		SnapshotType:     pbutil.ToProtoString(clusterSnapshot.SnapshotType),
+		ParentSnapshotId: pbutil.ToProtoString(clusterSnapshot.ParentSnapshotId),
+		ChildSnapshotIds: pbutil.ToProtoString(clusterSnapshot.ChildSnapshotIds),
+		AutoBackup:       pbutil.ToProtoBool(clusterSnapshot.AutoBackup),
+		Status:           pbutil.ToProtoString(clusterSnapshot.Status),
+		TransitionStatus: pbutil.ToProtoString(clusterSnapshot.TransitionStatus),
+		Owner:            pbutil.ToProtoString(clusterSnapshot.Owner),
+		OwnerPath:        clusterSnapshot.OwnerPath.ToProtoString(),
+		CreateTime:       pbutil.ToProtoTimestamp(clusterSnapshot.CreateTime),
+		StatusTime:       pbutil.ToProtoTimestamp(clusterSnapshot.StatusTime),
+	}
+}
+
+func ClusterSnapshotsToPbs(clusterSnapshots []*ClusterSnapshot) (pbClusterSnapshots []*pb.ClusterSnapshot) {
+	for _, clusterSnapshot := range clusterSnapshots {
+		pbClusterSnapshots = append(pbClusterSnapshots, ClusterSnapshotToPb(clusterSnapshot))
+	}
+	return
+}
+
+// ClusterSnapshotDirective is the directive of job creating, restoring or deleting the snapshots of
+// vm-based cluster, the snapshots to restore from are in the order of the snapshot chain
+type ClusterSnapshotDirective struct {
+	*ClusterWrapper
+	Snapshots []*ClusterSnapshot
+}
+
+func NewClusterSnapshotDirective(ctx context.Context, data string) (*ClusterSnapshotDirective, error) {
+	directive := &ClusterSnapshotDirective{
+		ClusterWrapper: &ClusterWrapper{
+			ctx: ctx,
+		},
+	}
+	err := jsonutil.Decode([]byte(data), directive)
+	if err != nil {
+		logger.Error(ctx, "Decode [%s] into cluster snapshot directive failed: %+v", data, err)
+	}
+	return directive, err
+}
+
+// GetBackupInterval returns the interval between the scheduled backups of the backup policy,
+// 0 if the clusters are never backed up automatically
+func GetBackupInterval(backupPolicy string) time.Duration {
+	switch backupPolicy {
+	case constants.BackupPolicyHourly:
+		return time.Hour
+	case constants.BackupPolicyDaily:
+		return 24 * time.Hour
+	case constants.BackupPolicyWeekly:
+		return 7 * 24 * time.Hour
+	}
+	interval, err := time.ParseDuration(backupPolicy)
+	if err != nil || interval <= 0 {
+		return 0
+	}
+	// clusters can not be backed up more often than they are checked
+	if interval < constants.ScheduleBackupInterval {
+		return constants.ScheduleBackupInterval
+	}
+	return interval
+}
@@ -5,12 +5,22 @@
 package models
 
 import (
+	"context"
 	"time"
 
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 )
 
+func NewClusterUpgradeAuditId() string {
+	return idutil.GetUuid("cua-")
+}
+
+// ClusterUpgradeAudit records an upgrade of cluster, it is active until the cluster is rolled back
 type ClusterUpgradeAudit struct {
 	ClusterUpgradeAuditId string
 	ClusterId             string
@@ -25,3 +35,39 @@ type ClusterUpgradeAudit struct {
 }
 
 var ClusterUpgradeAuditColumns = db.GetColumnsFromStruct(&ClusterUpgradeAudit{})
+
+func NewClusterUpgradeAudit(clusterId, fromVersionId, toVersionId, serviceParams string, ownerPath sender.OwnerPath) *ClusterUpgradeAudit {
+	return &ClusterUpgradeAudit{
+		ClusterUpgradeAuditId: NewClusterUpgradeAuditId(),
+		ClusterId:             clusterId,
+		FromVersionId:         fromVersionId,
+		ToVersionId:           toVersionId,
+		ServiceParams:         serviceParams,
+		CreateTime:            time.Now(),
+		StatusTime:            time.Now(),
+		Status:                constants.StatusActive,
+		Owner:                 ownerPath.Owner(),
+		OwnerPath:             ownerPath,
+	}
+}
+
+// ClusterUpgradeDirective is the directive of job upgrading or rolling back a vm-based cluster,
+// it is the cluster wrapper with the cluster commons of the target version, and the cluster
+// commons of current version, whose services are used to stop the cluster before upgrading.
+type ClusterUpgradeDirective struct {
+	*ClusterWrapper
+	FromClusterCommons map[string]*ClusterCommon `json:",omitempty"`
+}
+
+func NewClusterUpgradeDirective(ctx context.Context, data string) (*ClusterUpgradeDirective, error) {
+	directive := &ClusterUpgradeDirective{
+		ClusterWrapper: &ClusterWrapper{
+			ctx: ctx,
+		},
+	}
+	err := jsonutil.Decode([]byte(data), directive)
+	if err != nil {
+		logger.Error(ctx, "Decode [%s] into cluster upgrade directive failed: %+v", data, err)
+	}
+	return directive, err
+}
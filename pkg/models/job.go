@@ -20,26 +20,53 @@ func NewJobId() string {
 }
 
 type Job struct {
-	JobId      string
-	ClusterId  string
-	AppId      string
-	VersionId  string
-	JobAction  string
-	Directive  string
-	Provider   string
-	Owner      string
-	OwnerPath  sender.OwnerPath
-	Status     string
-	ErrorCode  uint32
-	Executor   string
-	RuntimeId  string
-	TaskCount  uint32
-	CreateTime time.Time
-	StatusTime time.Time
+	JobId         string
+	ClusterId     string
+	AppId         string
+	VersionId     string
+	JobAction     string
+	Directive     string
+	Provider      string
+	Owner         string
+	OwnerPath     sender.OwnerPath
+	Status        string
+	ErrorCode     uint32
+	Executor      string
+	RuntimeId     string
+	TaskCount     uint32
+	Checkpoint    string
+	Priority      uint32
+	ScheduledTime *time.Time
+	CreateTime    time.Time
+	StatusTime    time.Time
 }
 
 var JobColumns = db.GetColumnsFromStruct(&Job{})
 
+// jobActionPriorities are the priorities of job actions other than DefaultJobPriority,
+// starting and stopping clusters are dispatched before the slow creating and deleting
+var jobActionPriorities = map[string]uint32{
+	constants.ActionStartClusters:          7,
+	constants.ActionStopClusters:           7,
+	constants.ActionRecoverClusters:        7,
+	constants.ActionRunClusterService:      6,
+	constants.ActionUpdateClusterEnv:       6,
+	constants.ActionAttachKeyPairs:         6,
+	constants.ActionDetachKeyPairs:         6,
+	constants.ActionDeleteClusterNodes:     3,
+	constants.ActionDeleteClusters:         3,
+	constants.ActionCeaseClusters:          3,
+	constants.ActionDeleteClusterSnapshots: 3,
+}
+
+// GetJobPriority returns the priority of the job action
+func GetJobPriority(jobAction string) uint32 {
+	if priority, ok := jobActionPriorities[jobAction]; ok {
+		return priority
+	}
+	return constants.DefaultJobPriority
+}
+
 func NewJob(jobId, clusterId, appId, versionId, jobAction, directive, provider string, ownerPath sender.OwnerPath, runtimeId string) *Job {
 	if jobId == "" {
 		jobId = NewJobId()
@@ -58,6 +85,7 @@ func NewJob(jobId, clusterId, appId, versionId, jobAction, directive, provider s
 		OwnerPath:  ownerPath,
 		RuntimeId:  runtimeId,
 		Status:     constants.StatusPending,
+		Priority:   GetJobPriority(jobAction),
 		CreateTime: time.Now(),
 		StatusTime: time.Now(),
 	}
@@ -79,6 +107,10 @@ func JobToPb(job *Job) *pb.Job {
 	pbJob.Executor = pbutil.ToProtoString(job.Executor)
 	pbJob.RuntimeId = pbutil.ToProtoString(job.RuntimeId)
 	pbJob.TaskCount = pbutil.ToProtoUInt32(job.TaskCount)
+	pbJob.Priority = pbutil.ToProtoUInt32(job.Priority)
+	if job.ScheduledTime != nil {
+		pbJob.ScheduledTime = pbutil.ToProtoTimestamp(*job.ScheduledTime)
+	}
 	pbJob.CreateTime = pbutil.ToProtoTimestamp(job.CreateTime)
 	pbJob.StatusTime = pbutil.ToProtoTimestamp(job.StatusTime)
 	return &pbJob
@@ -93,7 +125,7 @@ func JobsToPbs(jobs []*Job) (pbJobs []*pb.Job) {
 
 func PbToJob(pbJob *pb.Job) *Job {
 	ownerPath := sender.OwnerPath(pbJob.GetOwnerPath().GetValue())
-	return &Job{
+	job := &Job{
 		JobId:      pbJob.GetJobId().GetValue(),
 		ClusterId:  pbJob.GetClusterId().GetValue(),
 		AppId:      pbJob.GetAppId().GetValue(),
@@ -108,7 +140,13 @@ func PbToJob(pbJob *pb.Job) *Job {
 		Executor:   pbJob.GetExecutor().GetValue(),
 		RuntimeId:  pbJob.GetRuntimeId().GetValue(),
 		TaskCount:  pbJob.GetTaskCount().GetValue(),
+		Priority:   pbJob.GetPriority().GetValue(),
 		CreateTime: pbutil.GetTime(pbJob.GetCreateTime()),
 		StatusTime: pbutil.GetTime(pbJob.GetStatusTime()),
 	}
+	if pbJob.GetScheduledTime() != nil {
+		scheduledTime := pbutil.GetTime(pbJob.GetScheduledTime())
+		job.ScheduledTime = &scheduledTime
+	}
+	return job
 }
@@ -0,0 +1,59 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+// JobCheckpoint is the progress of a working job, it is saved every time a task
+// is sent or a task layer is finished, so that the job can be resumed by another
+// job manager when the former executor is gone.
+type JobCheckpoint struct {
+	// task layers split from the job, TaskId of the task is set once it has been sent
+	TaskLayer *TaskLayer
+	// count of the leading task layers whose tasks are all finished
+	FinishedLayers int
+}
+
+func NewJobCheckpoint(data string) (*JobCheckpoint, error) {
+	checkpoint := &JobCheckpoint{}
+	err := jsonutil.Decode([]byte(data), checkpoint)
+	if err != nil {
+		logger.Error(nil, "Decode [%s] into job checkpoint failed: %+v", data, err)
+	}
+	return checkpoint, err
+}
+
+func (c *JobCheckpoint) IsTaskSent(task *Task) bool {
+	return task.TaskId != ""
+}
+
+// SentTaskIds returns ids of all tasks that have been sent to task manager
+func (c *JobCheckpoint) SentTaskIds() []string {
+	var taskIds []string
+	c.TaskLayer.WalkTree(func(parent *TaskLayer, current *TaskLayer) {
+		if current == nil {
+			return
+		}
+		for _, task := range current.Tasks {
+			if c.IsTaskSent(task) {
+				taskIds = append(taskIds, task.TaskId)
+			}
+		}
+	})
+	return taskIds
+}
+
+func (c *JobCheckpoint) IsLayerFinished(layerIndex int) bool {
+	return layerIndex < c.FinishedLayers
+}
+
+func (c *JobCheckpoint) FinishLayer(layerIndex int) {
+	if layerIndex+1 > c.FinishedLayers {
+		c.FinishedLayers = layerIndex + 1
+	}
+}
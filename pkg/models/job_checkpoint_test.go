@@ -0,0 +1,42 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+func TestJobCheckpoint(t *testing.T) {
+	taskLayer := &TaskLayer{
+		Tasks: []*Task{{TaskId: "0", TaskAction: "a"}},
+		Child: &TaskLayer{
+			Tasks: []*Task{{TaskId: "1", TaskAction: "b"}, {TaskAction: "c"}},
+		},
+	}
+	checkpoint := &JobCheckpoint{TaskLayer: taskLayer}
+	checkpoint.FinishLayer(0)
+
+	resumed, err := NewJobCheckpoint(jsonutil.ToString(checkpoint))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(resumed.SentTaskIds(), ",") != "0,1" {
+		t.Errorf("Wrong sent tasks [%s]", strings.Join(resumed.SentTaskIds(), ","))
+	}
+	if !resumed.IsLayerFinished(0) || resumed.IsLayerFinished(1) {
+		t.Errorf("Wrong finished layers [%d]", resumed.FinishedLayers)
+	}
+	resumed.FinishLayer(0)
+	if resumed.FinishedLayers != 1 {
+		t.Errorf("Finished layers should not go back, got [%d]", resumed.FinishedLayers)
+	}
+	if resumed.IsTaskSent(resumed.TaskLayer.Child.Tasks[1]) {
+		t.Errorf("Task [%s] should not be sent", resumed.TaskLayer.Child.Tasks[1].TaskAction)
+	}
+}
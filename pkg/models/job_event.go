@@ -0,0 +1,93 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// JobEvent is a change of job or its tasks, pushed to the watchers of job
+type JobEvent struct {
+	EventType    string
+	JobId        string
+	Job          *Job
+	TaskLayer    *TaskLayer
+	LayerIndex   uint32
+	Task         *Task
+	ErrorMessage string
+	EventTime    time.Time
+}
+
+func NewJobStatusEvent(job *Job) *JobEvent {
+	return &JobEvent{
+		EventType: constants.JobEventJobStatus,
+		JobId:     job.JobId,
+		Job:       job,
+		EventTime: time.Now(),
+	}
+}
+
+func NewTaskLayerEvent(jobId string, layerIndex int) *JobEvent {
+	return &JobEvent{
+		EventType:  constants.JobEventTaskLayer,
+		JobId:      jobId,
+		LayerIndex: uint32(layerIndex),
+		EventTime:  time.Now(),
+	}
+}
+
+func NewTaskStatusEvent(task *Task) *JobEvent {
+	return &JobEvent{
+		EventType: constants.JobEventTaskStatus,
+		JobId:     task.JobId,
+		Task:      task,
+		EventTime: time.Now(),
+	}
+}
+
+func NewJobErrorEvent(jobId string, err error) *JobEvent {
+	return &JobEvent{
+		EventType:    constants.JobEventError,
+		JobId:        jobId,
+		ErrorMessage: err.Error(),
+		EventTime:    time.Now(),
+	}
+}
+
+// IsFinished returns true if the event is the last one of job
+func (e *JobEvent) IsFinished() bool {
+	if e.Job == nil {
+		return false
+	}
+	if e.EventType != constants.JobEventJobStatus && e.EventType != constants.JobEventSnapshot {
+		return false
+	}
+	return stringutil.StringIn(e.Job.Status, constants.JobFinishedStatuses)
+}
+
+func JobEventToPb(event *JobEvent) *pb.WatchJobResponse {
+	pbEvent := pb.WatchJobResponse{}
+	pbEvent.EventType = pbutil.ToProtoString(event.EventType)
+	if event.Job != nil {
+		pbEvent.Job = JobToPb(event.Job)
+	}
+	pbEvent.TaskLayer = TaskLayerToPb(event.TaskLayer)
+	if event.EventType == constants.JobEventTaskLayer {
+		pbEvent.LayerIndex = pbutil.ToProtoUInt32(event.LayerIndex)
+	}
+	if event.Task != nil {
+		pbEvent.Task = TaskToPb(event.Task)
+	}
+	if event.ErrorMessage != "" {
+		pbEvent.ErrorMessage = pbutil.ToProtoString(event.ErrorMessage)
+	}
+	pbEvent.EventTime = pbutil.ToProtoTimestamp(event.EventTime)
+	return &pbEvent
+}
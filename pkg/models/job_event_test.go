@@ -0,0 +1,29 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+)
+
+func TestJobEventIsFinished(t *testing.T) {
+	job := &Job{JobId: "j-test", Status: constants.StatusWorking}
+	if NewJobStatusEvent(job).IsFinished() {
+		t.Errorf("Event of working job should not be finished")
+	}
+	job.Status = constants.StatusCancelled
+	if !NewJobStatusEvent(job).IsFinished() {
+		t.Errorf("Event of cancelled job should be finished")
+	}
+	if NewJobErrorEvent(job.JobId, errors.New("test")).IsFinished() {
+		t.Errorf("Error event should not be finished")
+	}
+	if NewTaskStatusEvent(&Task{JobId: job.JobId, Status: constants.StatusFailed}).IsFinished() {
+		t.Errorf("Task status event should not be finished")
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+)
+
+func TestGetJobPriority(t *testing.T) {
+	start := GetJobPriority(constants.ActionStartClusters)
+	stop := GetJobPriority(constants.ActionStopClusters)
+	del := GetJobPriority(constants.ActionDeleteClusters)
+	if start <= del || stop <= del {
+		t.Errorf("Start [%d] and stop [%d] should be prior to delete [%d]", start, stop, del)
+	}
+	if p := GetJobPriority(constants.ActionCreateCluster); p != constants.DefaultJobPriority {
+		t.Errorf("Priority of create should be default, got [%d]", p)
+	}
+	for action, priority := range jobActionPriorities {
+		if priority > constants.MaxJobPriority {
+			t.Errorf("Priority [%d] of action [%s] is out of range", priority, action)
+		}
+	}
+}
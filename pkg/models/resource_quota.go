@@ -0,0 +1,186 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+func NewQuotaId() string {
+	return idutil.GetUuid("quota-")
+}
+
+// ResourceQuota limits the resources of the clusters owned by the subject,
+// in the runtime or in all runtimes if RuntimeId is empty. 0 means unlimited.
+type ResourceQuota struct {
+	QuotaId     string
+	SubjectType string
+	Subject     string
+	RuntimeId   string
+	MaxClusters uint32
+	MaxNodes    uint32
+	MaxCpu      uint32
+	// MB
+	MaxMemory uint32
+	// GB
+	MaxVolumeSize uint32
+	Description   string
+	Owner         string
+	OwnerPath     sender.OwnerPath
+	CreateTime    time.Time
+	StatusTime    time.Time
+}
+
+var ResourceQuotaColumns = db.GetColumnsFromStruct(&ResourceQuota{})
+
+// ResourceUsage is the resources used by clusters, or requested by an operation
+type ResourceUsage struct {
+	Clusters   uint32
+	Nodes      uint32
+	Cpu        uint32
+	Memory     uint32
+	VolumeSize uint32
+}
+
+func (u *ResourceUsage) Add(usage *ResourceUsage) {
+	u.Clusters += usage.Clusters
+	u.Nodes += usage.Nodes
+	u.Cpu += usage.Cpu
+	u.Memory += usage.Memory
+	u.VolumeSize += usage.VolumeSize
+}
+
+// GetNodesResourceUsage returns the resources of the nodes, which are in the roles
+func GetNodesResourceUsage(clusterRoles map[string]*ClusterRole, clusterNodes []*ClusterNode) *ResourceUsage {
+	usage := new(ResourceUsage)
+	for _, clusterNode := range clusterNodes {
+		usage.Nodes++
+		clusterRole, isExist := clusterRoles[clusterNode.Role]
+		if !isExist {
+			continue
+		}
+		usage.Cpu += clusterRole.Cpu
+		usage.Memory += clusterRole.Memory
+		usage.VolumeSize += clusterRole.StorageSize
+	}
+	return usage
+}
+
+// GetClusterResourceUsage returns the resources of the cluster and all its nodes
+func GetClusterResourceUsage(clusterWrapper *ClusterWrapper) *ResourceUsage {
+	var clusterNodes []*ClusterNode
+	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		clusterNodes = append(clusterNodes, clusterNode.ClusterNode)
+	}
+	usage := GetNodesResourceUsage(clusterWrapper.ClusterRoles, clusterNodes)
+	usage.Clusters = 1
+	return usage
+}
+
+// GetRoleResizeUsage returns the resources increased by resizing the nodes of role,
+// the decreased resources are not released until the resize is done
+func GetRoleResizeUsage(previous, current *ClusterRole, nodeCount uint32) *ResourceUsage {
+	usage := new(ResourceUsage)
+	if current.Cpu > previous.Cpu {
+		usage.Cpu = (current.Cpu - previous.Cpu) * nodeCount
+	}
+	if current.Memory > previous.Memory {
+		usage.Memory = (current.Memory - previous.Memory) * nodeCount
+	}
+	if current.StorageSize > previous.StorageSize {
+		usage.VolumeSize = (current.StorageSize - previous.StorageSize) * nodeCount
+	}
+	return usage
+}
+
+// IsApplied returns whether the quota limits the clusters of the owner in the runtime
+func (q *ResourceQuota) IsApplied(ownerPath sender.OwnerPath, runtimeId string) bool {
+	if q.RuntimeId != "" && q.RuntimeId != runtimeId {
+		return false
+	}
+	switch q.SubjectType {
+	case constants.QuotaSubjectTypeUser:
+		return ownerPath.Owner() == q.Subject
+	case constants.QuotaSubjectTypeGroup:
+		return strings.HasPrefix(string(ownerPath), q.Subject+":") ||
+			strings.HasPrefix(string(ownerPath), q.Subject+".")
+	case constants.QuotaSubjectTypeOwnerPath:
+		return strings.HasPrefix(string(ownerPath), q.Subject)
+	}
+	return false
+}
+
+// Check returns error if the requested resources are more than left by the used,
+// only the requested resources are checked so that the exceeded quota can be reduced
+func (q *ResourceQuota) Check(used, requested *ResourceUsage) error {
+	limits := []struct {
+		name      string
+		max       uint32
+		used      uint32
+		requested uint32
+	}{
+		{"clusters", q.MaxClusters, used.Clusters, requested.Clusters},
+		{"nodes", q.MaxNodes, used.Nodes, requested.Nodes},
+		{"cpu", q.MaxCpu, used.Cpu, requested.Cpu},
+		{"memory", q.MaxMemory, used.Memory, requested.Memory},
+		{"volume size", q.MaxVolumeSize, used.VolumeSize, requested.VolumeSize},
+	}
+	for _, limit := range limits {
+		if limit.max == 0 || limit.requested == 0 {
+			continue
+		}
+		if uint64(limit.used)+uint64(limit.requested) > uint64(limit.max) {
+			return fmt.Errorf("max %s is %d, %d used, %d requested",
+				limit.name, limit.max, limit.used, limit.requested)
+		}
+	}
+	return nil
+}
+
+func ResourceQuotaToPb(quota *ResourceQuota) *pb.Quota {
+	pbQuota := pb.Quota{}
+	pbQuota.QuotaId = pbutil.ToProtoString(quota.QuotaId)
+	pbQuota.SubjectType = pbutil.ToProtoString(quota.SubjectType)
+	pbQuota.Subject = pbutil.ToProtoString(quota.Subject)
+	pbQuota.RuntimeId = pbutil.ToProtoString(quota.RuntimeId)
+	pbQuota.MaxClusters = pbutil.ToProtoUInt32(quota.MaxClusters)
+	pbQuota.MaxNodes = pbutil.ToProtoUInt32(quota.MaxNodes)
+	pbQuota.MaxCpu = pbutil.ToProtoUInt32(quota.MaxCpu)
+	pbQuota.MaxMemory = pbutil.ToProtoUInt32(quota.MaxMemory)
+	pbQuota.MaxVolumeSize = pbutil.ToProtoUInt32(quota.MaxVolumeSize)
+	pbQuota.Description = pbutil.ToProtoString(quota.Description)
+	pbQuota.Owner = pbutil.ToProtoString(quota.Owner)
+	pbQuota.OwnerPath = quota.OwnerPath.ToProtoString()
+	pbQuota.CreateTime = pbutil.ToProtoTimestamp(quota.CreateTime)
+	pbQuota.StatusTime = pbutil.ToProtoTimestamp(quota.StatusTime)
+	return &pbQuota
+}
+
+func ResourceQuotasToPbs(quotas []*ResourceQuota) (pbQuotas []*pb.Quota) {
+	for _, quota := range quotas {
+		pbQuotas = append(pbQuotas, ResourceQuotaToPb(quota))
+	}
+	return
+}
+
+func ResourceUsageToPb(quota *ResourceQuota, used *ResourceUsage) *pb.QuotaUsage {
+	return &pb.QuotaUsage{
+		Quota:          ResourceQuotaToPb(quota),
+		UsedClusters:   pbutil.ToProtoUInt32(used.Clusters),
+		UsedNodes:      pbutil.ToProtoUInt32(used.Nodes),
+		UsedCpu:        pbutil.ToProtoUInt32(used.Cpu),
+		UsedMemory:     pbutil.ToProtoUInt32(used.Memory),
+		UsedVolumeSize: pbutil.ToProtoUInt32(used.VolumeSize),
+	}
+}
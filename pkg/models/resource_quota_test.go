@@ -0,0 +1,86 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/sender"
+)
+
+func TestResourceQuotaIsApplied(t *testing.T) {
+	for _, c := range []struct {
+		quota     ResourceQuota
+		ownerPath sender.OwnerPath
+		runtimeId string
+		expected  bool
+	}{
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeUser, Subject: "usr-1"}, "gid-1:usr-1", "rt-1", true},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeUser, Subject: "usr-1"}, "gid-1:usr-10", "rt-1", false},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeUser, Subject: "usr-1", RuntimeId: "rt-1"}, "gid-1:usr-1", "rt-1", true},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeUser, Subject: "usr-1", RuntimeId: "rt-2"}, "gid-1:usr-1", "rt-1", false},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeGroup, Subject: "gid-1"}, "gid-1:usr-1", "rt-1", true},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeGroup, Subject: "gid-1"}, "gid-1.gid-2:usr-1", "rt-1", true},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeGroup, Subject: "gid-1"}, "gid-10:usr-1", "rt-1", false},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeOwnerPath, Subject: "gid-1.gid-2"}, "gid-1.gid-2:usr-1", "rt-1", true},
+		{ResourceQuota{SubjectType: constants.QuotaSubjectTypeOwnerPath, Subject: "gid-1.gid-2"}, "gid-1:usr-1", "rt-1", false},
+		{ResourceQuota{SubjectType: "unknown", Subject: "gid-1"}, "gid-1:usr-1", "rt-1", false},
+	} {
+		if c.quota.IsApplied(c.ownerPath, c.runtimeId) != c.expected {
+			t.Errorf("Quota [%+v] applied to [%s] in [%s] should be [%t]", c.quota, c.ownerPath, c.runtimeId, c.expected)
+		}
+	}
+}
+
+func TestResourceQuotaCheck(t *testing.T) {
+	quota := &ResourceQuota{MaxClusters: 2, MaxCpu: 8}
+	used := &ResourceUsage{Clusters: 1, Nodes: 100, Cpu: 6, Memory: 100000}
+
+	if err := quota.Check(used, &ResourceUsage{Clusters: 1, Nodes: 10, Cpu: 2, Memory: 1024}); err != nil {
+		t.Errorf("Check should pass: %+v", err)
+	}
+	if err := quota.Check(used, &ResourceUsage{Clusters: 1, Cpu: 4}); err == nil {
+		t.Errorf("Check should fail for cpu")
+	}
+	if err := quota.Check(used, &ResourceUsage{Clusters: 2}); err == nil {
+		t.Errorf("Check should fail for clusters")
+	}
+
+	// the exceeded resources are not checked if not requested
+	exceeded := &ResourceUsage{Clusters: 3, Cpu: 10}
+	if err := quota.Check(exceeded, &ResourceUsage{Memory: 1024}); err != nil {
+		t.Errorf("Check should pass: %+v", err)
+	}
+}
+
+func TestGetResourceUsage(t *testing.T) {
+	clusterWrapper := &ClusterWrapper{
+		ClusterRoles: map[string]*ClusterRole{
+			"master": {Role: "master", Cpu: 2, Memory: 2048, StorageSize: 10},
+			"slave":  {Role: "slave", Cpu: 4, Memory: 4096, StorageSize: 100},
+		},
+		ClusterNodesWithKeyPairs: map[string]*ClusterNodeWithKeyPairs{
+			"cln-1": {ClusterNode: &ClusterNode{Role: "master"}},
+			"cln-2": {ClusterNode: &ClusterNode{Role: "slave"}},
+			"cln-3": {ClusterNode: &ClusterNode{Role: "slave"}},
+		},
+	}
+	usage := GetClusterResourceUsage(clusterWrapper)
+	expected := ResourceUsage{Clusters: 1, Nodes: 3, Cpu: 10, Memory: 10240, VolumeSize: 210}
+	if *usage != expected {
+		t.Errorf("Expect usage [%+v], got [%+v]", expected, *usage)
+	}
+
+	usage = GetRoleResizeUsage(
+		&ClusterRole{Cpu: 4, Memory: 4096, StorageSize: 100},
+		&ClusterRole{Cpu: 8, Memory: 2048, StorageSize: 100},
+		2,
+	)
+	expected = ResourceUsage{Cpu: 8}
+	if *usage != expected {
+		t.Errorf("Expect resize usage [%+v], got [%+v]", expected, *usage)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+func NewReviewPolicyId() string {
+	return idutil.GetUuid("rvp-")
+}
+
+// ReviewAutoPass skips the review stage if any of the rules is matched
+type ReviewAutoPass struct {
+	// the submitter has one of the action bundles
+	SubmitterActionBundles []string
+	// the owner path of app version has one of the prefixes
+	OwnerPathPrefixes []string
+}
+
+func (a *ReviewAutoPass) MatchOwnerPath(ownerPath sender.OwnerPath) bool {
+	if a == nil {
+		return false
+	}
+	for _, prefix := range a.OwnerPathPrefixes {
+		if strings.HasPrefix(string(ownerPath), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type ReviewStage struct {
+	// Name is the operator type of the review phase, eg. isv, business, technical
+	Name string
+	// ActionBundle is required by the reviewers of the stage
+	ActionBundle string
+	// IsvScoped means the reviewers are the users of the isv which the submitter belongs to
+	IsvScoped bool
+	AutoPass  *ReviewAutoPass
+}
+
+type ReviewStages []ReviewStage
+
+// DefaultReviewStages is the review pipeline of app version without review policy
+var DefaultReviewStages = ReviewStages{
+	{
+		Name:         constants.OperatorTypeIsv,
+		ActionBundle: constants.ActionBundleIsvReview,
+		IsvScoped:    true,
+		AutoPass: &ReviewAutoPass{
+			SubmitterActionBundles: []string{constants.ActionBundleIsvReview},
+		},
+	},
+	{
+		Name:         constants.OperatorTypeBusiness,
+		ActionBundle: constants.ActionBundleBusinessReview,
+	},
+	{
+		Name:         constants.OperatorTypeTechnical,
+		ActionBundle: constants.ActionBundleTechnicalReview,
+	},
+}
+
+// ReservedReviewStageNames are the operator types not reviewing app version in stage
+var ReservedReviewStageNames = []string{
+	constants.OperatorTypeDeveloper,
+	constants.OperatorTypeAdmin,
+	constants.OperatorTypeGlobalAdmin,
+}
+
+func (s *ReviewStages) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(b, s)
+	}
+	str, _ := value.(string)
+	return json.Unmarshal([]byte(str), s)
+}
+
+// Value implements the driver Valuer interface.
+func (s ReviewStages) Value() (driver.Value, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (s ReviewStages) Validate() error {
+	if len(s) == 0 {
+		return fmt.Errorf("review stages is empty")
+	}
+	var names []string
+	for _, stage := range s {
+		if stage.Name == "" {
+			return fmt.Errorf("name of review stage is empty")
+		}
+		if stringutil.StringIn(stage.Name, ReservedReviewStageNames) {
+			return fmt.Errorf("name of review stage [%s] is reserved", stage.Name)
+		}
+		if stringutil.StringIn(stage.Name, names) {
+			return fmt.Errorf("name of review stage [%s] is duplicated", stage.Name)
+		}
+		if stage.ActionBundle == "" {
+			return fmt.Errorf("action bundle of review stage [%s] is empty", stage.Name)
+		}
+		names = append(names, stage.Name)
+	}
+	return nil
+}
+
+// Index returns the index of the stage, or -1 if the stage is not found
+func (s ReviewStages) Index(name string) int {
+	for i, stage := range s {
+		if stage.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s ReviewStages) Names() []string {
+	var names []string
+	for _, stage := range s {
+		names = append(names, stage.Name)
+	}
+	return names
+}
+
+// IsLast returns whether the stage is the last one, passing which passes the app version
+func (s ReviewStages) IsLast(name string) bool {
+	return len(s) > 0 && s[len(s)-1].Name == name
+}
+
+// Next returns the first stage not passed in the phases, or nil if all stages are passed
+func (s ReviewStages) Next(phases AppVersionReviewPhases) *ReviewStage {
+	for i, stage := range s {
+		if p, ok := phases[stage.Name]; !ok || p.Status != constants.StatusPassed {
+			return &s[i]
+		}
+	}
+	return nil
+}
+
+// ReviewPolicy is the review pipeline of the app versions in the scope
+type ReviewPolicy struct {
+	PolicyId    string
+	Name        string
+	ScopeType   string
+	ScopeId     string
+	Stages      ReviewStages
+	Description string
+	Owner       string
+	OwnerPath   sender.OwnerPath
+	CreateTime  time.Time
+	StatusTime  time.Time
+}
+
+var ReviewPolicyColumns = db.GetColumnsFromStruct(&ReviewPolicy{})
+
+func NewReviewPolicy(name, scopeType, scopeId, description string, stages ReviewStages, ownerPath sender.OwnerPath) *ReviewPolicy {
+	return &ReviewPolicy{
+		PolicyId:    NewReviewPolicyId(),
+		Name:        name,
+		ScopeType:   scopeType,
+		ScopeId:     scopeId,
+		Stages:      stages,
+		Description: description,
+		Owner:       ownerPath.Owner(),
+		OwnerPath:   ownerPath,
+		CreateTime:  time.Now(),
+		StatusTime:  time.Now(),
+	}
+}
+
+func PbToReviewStages(pbStages []*pb.ReviewStage) ReviewStages {
+	var stages ReviewStages
+	for _, pbStage := range pbStages {
+		stage := ReviewStage{
+			Name:         pbStage.GetName().GetValue(),
+			ActionBundle: pbStage.GetActionBundle().GetValue(),
+			IsvScoped:    pbStage.GetIsvScoped().GetValue(),
+		}
+		if pbAutoPass := pbStage.GetAutoPass(); pbAutoPass != nil {
+			stage.AutoPass = &ReviewAutoPass{
+				SubmitterActionBundles: pbAutoPass.GetSubmitterActionBundle(),
+				OwnerPathPrefixes:      pbAutoPass.GetOwnerPathPrefix(),
+			}
+		}
+		stages = append(stages, stage)
+	}
+	return stages
+}
+
+func ReviewStagesToPbs(stages ReviewStages) (pbStages []*pb.ReviewStage) {
+	for _, stage := range stages {
+		pbStage := pb.ReviewStage{}
+		pbStage.Name = pbutil.ToProtoString(stage.Name)
+		pbStage.ActionBundle = pbutil.ToProtoString(stage.ActionBundle)
+		pbStage.IsvScoped = pbutil.ToProtoBool(stage.IsvScoped)
+		if stage.AutoPass != nil {
+			pbStage.AutoPass = &pb.ReviewAutoPass{
+				SubmitterActionBundle: stage.AutoPass.SubmitterActionBundles,
+				OwnerPathPrefix:       stage.AutoPass.OwnerPathPrefixes,
+			}
+		}
+		pbStages = append(pbStages, &pbStage)
+	}
+	return
+}
+
+func ReviewPolicyToPb(policy *ReviewPolicy) *pb.ReviewPolicy {
+	pbPolicy := pb.ReviewPolicy{}
+	pbPolicy.PolicyId = pbutil.ToProtoString(policy.PolicyId)
+	pbPolicy.Name = pbutil.ToProtoString(policy.Name)
+	pbPolicy.ScopeType = pbutil.ToProtoString(policy.ScopeType)
+	pbPolicy.ScopeId = pbutil.ToProtoString(policy.ScopeId)
+	pbPolicy.Stages = ReviewStagesToPbs(policy.Stages)
+	pbPolicy.Description = pbutil.ToProtoString(policy.Description)
+	pbPolicy.Owner = pbutil.ToProtoString(policy.Owner)
+	pbPolicy.OwnerPath = policy.OwnerPath.ToProtoString()
+	pbPolicy.CreateTime = pbutil.ToProtoTimestamp(policy.CreateTime)
+	pbPolicy.StatusTime = pbutil.ToProtoTimestamp(policy.StatusTime)
+	return &pbPolicy
+}
+
+func ReviewPoliciesToPbs(policies []*ReviewPolicy) (pbPolicies []*pb.ReviewPolicy) {
+	for _, policy := range policies {
+		pbPolicies = append(pbPolicies, ReviewPolicyToPb(policy))
+	}
+	return
+}
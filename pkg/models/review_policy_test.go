@@ -0,0 +1,97 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+)
+
+func TestReviewStagesValidate(t *testing.T) {
+	if err := DefaultReviewStages.Validate(); err != nil {
+		t.Errorf("Default review stages should be valid: %+v", err)
+	}
+	for _, stages := range []ReviewStages{
+		nil,
+		{{Name: "", ActionBundle: "security_review"}},
+		{{Name: "security", ActionBundle: ""}},
+		{{Name: constants.OperatorTypeAdmin, ActionBundle: "security_review"}},
+		{{Name: "security", ActionBundle: "security_review"}, {Name: "security", ActionBundle: "technical_review"}},
+	} {
+		if err := stages.Validate(); err == nil {
+			t.Errorf("Review stages [%+v] should be invalid", stages)
+		}
+	}
+}
+
+func TestReviewStagesNext(t *testing.T) {
+	stages := ReviewStages{
+		{Name: "security", ActionBundle: "security_review"},
+		{Name: constants.OperatorTypeTechnical, ActionBundle: constants.ActionBundleTechnicalReview},
+	}
+	phases := AppVersionReviewPhases{}
+	if next := stages.Next(phases); next == nil || next.Name != "security" {
+		t.Errorf("Next stage should be [security], got [%+v]", next)
+	}
+	phases["security"] = AppVersionReviewPhase{Status: constants.StatusInReview}
+	if next := stages.Next(phases); next == nil || next.Name != "security" {
+		t.Errorf("Next stage should be [security], got [%+v]", next)
+	}
+	phases["security"] = AppVersionReviewPhase{Status: constants.StatusPassed}
+	if next := stages.Next(phases); next == nil || next.Name != constants.OperatorTypeTechnical {
+		t.Errorf("Next stage should be [technical], got [%+v]", next)
+	}
+	phases[constants.OperatorTypeTechnical] = AppVersionReviewPhase{Status: constants.StatusPassed}
+	if next := stages.Next(phases); next != nil {
+		t.Errorf("Next stage should be nil, got [%+v]", next)
+	}
+
+	if !stages.IsLast(constants.OperatorTypeTechnical) || stages.IsLast("security") {
+		t.Errorf("Last stage should be [technical]")
+	}
+	if stages.Index(constants.OperatorTypeBusiness) != -1 {
+		t.Errorf("Stage [business] should not be found")
+	}
+}
+
+func TestReviewStagesValue(t *testing.T) {
+	value, err := DefaultReviewStages.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stages ReviewStages
+	err = stages.Scan([]byte(value.(string)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(stages, DefaultReviewStages) {
+		t.Errorf("Expect stages [%+v], got [%+v]", DefaultReviewStages, stages)
+	}
+
+	versionReview := &AppVersionReview{}
+	err = versionReview.Stages.Scan(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(versionReview.GetStages(), DefaultReviewStages) {
+		t.Errorf("Review without stages should use the default stages")
+	}
+}
+
+func TestReviewAutoPassMatchOwnerPath(t *testing.T) {
+	var autoPass *ReviewAutoPass
+	if autoPass.MatchOwnerPath("gid-1:usr-1") {
+		t.Errorf("Nil auto pass should not match")
+	}
+	autoPass = &ReviewAutoPass{OwnerPathPrefixes: []string{"gid-internal"}}
+	if !autoPass.MatchOwnerPath("gid-internal.gid-2:usr-1") {
+		t.Errorf("Auto pass should match the owner path in internal group")
+	}
+	if autoPass.MatchOwnerPath("gid-1:usr-1") {
+		t.Errorf("Auto pass should not match the owner path out of internal group")
+	}
+}
@@ -75,6 +75,8 @@ type RoleResizeResource struct {
 	Memory       bool
 	InstanceSize bool
 	StorageSize  bool
+	// resized role of dry run, which is not saved into db
+	ClusterRole *ClusterRole `json:",omitempty"`
 }
 
 type RoleResizeResources []*RoleResizeResource
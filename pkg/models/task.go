@@ -34,6 +34,10 @@ type Task struct {
 	Target         string
 	NodeId         string
 	FailureAllowed bool
+	Checkpoint     string
+	Priority       uint32
+	Attempts       string
+	RetryTime      *time.Time
 	CreateTime     time.Time
 	StatusTime     time.Time
 }
@@ -56,6 +60,7 @@ func NewTask(taskId, jobId, nodeId, target, taskAction, directive string, ownerP
 		Owner:          ownerPath.Owner(),
 		OwnerPath:      ownerPath,
 		Status:         constants.StatusPending,
+		Priority:       constants.DefaultJobPriority,
 		CreateTime:     time.Now(),
 		StatusTime:     time.Now(),
 		FailureAllowed: failureAllowed,
@@ -78,6 +83,12 @@ func TaskToPb(task *Task) *pb.Task {
 	pbTask.CreateTime = pbutil.ToProtoTimestamp(task.CreateTime)
 	pbTask.StatusTime = pbutil.ToProtoTimestamp(task.StatusTime)
 	pbTask.FailureAllowed = pbutil.ToProtoBool(task.FailureAllowed)
+	pbTask.Priority = pbutil.ToProtoUInt32(task.Priority)
+	if task.RetryTime != nil {
+		pbTask.RetryTime = pbutil.ToProtoTimestamp(*task.RetryTime)
+	}
+	attempts, _ := NewTaskAttempts(task.Attempts)
+	pbTask.Attempts = TaskAttemptsToPbs(attempts)
 	return &pbTask
 }
 
@@ -103,6 +114,7 @@ func PbToTask(pbTask *pb.Task) *Task {
 		Target:         pbTask.GetTarget().GetValue(),
 		NodeId:         pbTask.GetNodeId().GetValue(),
 		FailureAllowed: pbTask.GetFailureAllowed().GetValue(),
+		Priority:       pbTask.GetPriority().GetValue(),
 		CreateTime:     pbutil.GetTime(pbTask.GetCreateTime()),
 		StatusTime:     pbutil.GetTime(pbTask.GetStatusTime()),
 	}
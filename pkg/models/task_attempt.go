@@ -0,0 +1,73 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// TaskAttempt records a finished attempt of handling the task, attempts of a task
+// are saved in order as json in the attempts column of task.
+type TaskAttempt struct {
+	Executor     string
+	Status       string
+	ErrorCode    uint32
+	ErrorMessage string
+	StartTime    time.Time
+	EndTime      time.Time
+	// set if the task is retried automatically after the attempt failed
+	RetryTime *time.Time
+}
+
+func NewTaskAttempts(data string) ([]*TaskAttempt, error) {
+	var attempts []*TaskAttempt
+	if data == "" {
+		return attempts, nil
+	}
+	err := jsonutil.Decode([]byte(data), &attempts)
+	if err != nil {
+		logger.Error(nil, "Decode [%s] into task attempts failed: %+v", data, err)
+	}
+	return attempts, err
+}
+
+// CountRetries returns count of the latest attempts retried automatically,
+// it restarts from 0 after the task is retried by hand.
+func CountRetries(attempts []*TaskAttempt) int {
+	count := 0
+	for i := len(attempts) - 1; i >= 0; i-- {
+		if attempts[i].RetryTime == nil {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+func TaskAttemptToPb(attempt *TaskAttempt) *pb.TaskAttempt {
+	pbAttempt := pb.TaskAttempt{}
+	pbAttempt.Executor = pbutil.ToProtoString(attempt.Executor)
+	pbAttempt.Status = pbutil.ToProtoString(attempt.Status)
+	pbAttempt.ErrorCode = pbutil.ToProtoUInt32(attempt.ErrorCode)
+	pbAttempt.ErrorMessage = pbutil.ToProtoString(attempt.ErrorMessage)
+	pbAttempt.StartTime = pbutil.ToProtoTimestamp(attempt.StartTime)
+	pbAttempt.EndTime = pbutil.ToProtoTimestamp(attempt.EndTime)
+	if attempt.RetryTime != nil {
+		pbAttempt.RetryTime = pbutil.ToProtoTimestamp(*attempt.RetryTime)
+	}
+	return &pbAttempt
+}
+
+func TaskAttemptsToPbs(attempts []*TaskAttempt) (pbAttempts []*pb.TaskAttempt) {
+	for _, attempt := range attempts {
+		pbAttempts = append(pbAttempts, TaskAttemptToPb(attempt))
+	}
+	return
+}
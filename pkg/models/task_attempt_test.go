@@ -0,0 +1,39 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+func TestTaskAttempts(t *testing.T) {
+	attempts, err := NewTaskAttempts("")
+	if err != nil || len(attempts) != 0 {
+		t.Fatalf("Empty attempts expected, got [%d]: %+v", len(attempts), err)
+	}
+
+	retryTime := time.Now()
+	attempts = []*TaskAttempt{
+		{Status: constants.StatusFailed, RetryTime: &retryTime},
+		// retried by hand
+		{Status: constants.StatusFailed},
+		{Status: constants.StatusFailed, RetryTime: &retryTime},
+		{Status: constants.StatusFailed, RetryTime: &retryTime},
+	}
+	resumed, err := NewTaskAttempts(jsonutil.ToString(attempts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resumed) != len(attempts) {
+		t.Fatalf("Wrong count of attempts [%d]", len(resumed))
+	}
+	if CountRetries(resumed) != 2 {
+		t.Errorf("Wrong count of retries [%d]", CountRetries(resumed))
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"openpitrix.io/openpitrix/pkg/constants"
 
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
 )
 
 func NewTokenId() string {
@@ -42,3 +44,26 @@ func NewToken(clientId, userId, scope string) *Token {
 		StatusTime:   time.Now(),
 	}
 }
+
+// TokenToPbSession returns the session of token, the refresh token itself is not exposed
+func TokenToPbSession(token *Token, refreshTokenExpireTime time.Duration) *pb.Session {
+	if token == nil {
+		return nil
+	}
+	return &pb.Session{
+		SessionId:  token.TokenId,
+		ClientId:   token.ClientId,
+		UserId:     token.UserId,
+		Scope:      token.Scope,
+		Status:     token.Status,
+		CreateTime: pbutil.ToProtoTimestamp(token.CreateTime),
+		ExpireTime: pbutil.ToProtoTimestamp(token.CreateTime.Add(refreshTokenExpireTime)),
+	}
+}
+
+func TokensToPbSessions(tokens []*Token, refreshTokenExpireTime time.Duration) (pbSessions []*pb.Session) {
+	for _, token := range tokens {
+		pbSessions = append(pbSessions, TokenToPbSession(token, refreshTokenExpireTime))
+	}
+	return
+}
@@ -6,6 +6,7 @@ package models
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNewToken(t *testing.T) {
@@ -19,3 +20,16 @@ func TestNewToken(t *testing.T) {
 	}
 
 }
+
+func TestTokenToPbSession(t *testing.T) {
+	token := NewToken("client-1", "usr-1", "")
+	session := TokenToPbSession(token, time.Hour)
+	if session.SessionId != token.TokenId || session.UserId != "usr-1" || session.ClientId != "client-1" {
+		t.Errorf("Wrong session [%+v] of token [%+v]", session, token)
+	}
+	createTime := session.CreateTime.GetSeconds()
+	expireTime := session.ExpireTime.GetSeconds()
+	if expireTime-createTime != int64(time.Hour/time.Second) {
+		t.Errorf("Wrong expire time [%d] of session created at [%d]", expireTime, createTime)
+	}
+}
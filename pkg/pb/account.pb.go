@@ -3732,6 +3732,474 @@ func (m *TokenResponse) GetIdToken() string {
 	return ""
 }
 
+type RevokeTokenRequest struct {
+	// access token or refresh token to revoke, required if session_id is empty
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// hint of token type to revoke.eg.[access_token or refresh_token]
+	TokenTypeHint string `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3" json:"token_type_hint,omitempty"`
+	// id of session to revoke, required if token is empty
+	SessionId            string   `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenRequest) Reset()         { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()    {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{64}
+}
+
+func (m *RevokeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenRequest.Unmarshal(m, b)
+}
+func (m *RevokeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenRequest.Merge(m, src)
+}
+func (m *RevokeTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenRequest.Size(m)
+}
+func (m *RevokeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *RevokeTokenRequest) GetTokenTypeHint() string {
+	if m != nil {
+		return m.TokenTypeHint
+	}
+	return ""
+}
+
+func (m *RevokeTokenRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type RevokeTokenResponse struct {
+	// id of user who owned the token
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// id of session revoked, empty if access token revoked
+	SessionId            string   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenResponse) Reset()         { *m = RevokeTokenResponse{} }
+func (m *RevokeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenResponse) ProtoMessage()    {}
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{65}
+}
+
+func (m *RevokeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenResponse.Unmarshal(m, b)
+}
+func (m *RevokeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenResponse.Merge(m, src)
+}
+func (m *RevokeTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenResponse.Size(m)
+}
+func (m *RevokeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenResponse proto.InternalMessageInfo
+
+func (m *RevokeTokenResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RevokeTokenResponse) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type Session struct {
+	// session id
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// client id of session
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// id of user who owned the session
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// scope
+	Scope string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	// session status eg.[active|deleted]
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// the time when session create
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the time when refresh token of session expire
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{66}
+}
+
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *Session) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Session) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Session) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *Session) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Session) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Session) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type DescribeSessionsRequest struct {
+	// user ids, default the sender
+	UserId []string `protobuf:"bytes,1,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// data limit, default 20, max 200
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// data offset, default 0
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeSessionsRequest) Reset()         { *m = DescribeSessionsRequest{} }
+func (m *DescribeSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeSessionsRequest) ProtoMessage()    {}
+func (*DescribeSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{67}
+}
+
+func (m *DescribeSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSessionsRequest.Unmarshal(m, b)
+}
+func (m *DescribeSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSessionsRequest.Merge(m, src)
+}
+func (m *DescribeSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeSessionsRequest.Size(m)
+}
+func (m *DescribeSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSessionsRequest proto.InternalMessageInfo
+
+func (m *DescribeSessionsRequest) GetUserId() []string {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *DescribeSessionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeSessionsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type DescribeSessionsResponse struct {
+	// total count of active sessions
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// list of active session
+	SessionSet           []*Session `protobuf:"bytes,2,rep,name=session_set,json=sessionSet,proto3" json:"session_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DescribeSessionsResponse) Reset()         { *m = DescribeSessionsResponse{} }
+func (m *DescribeSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeSessionsResponse) ProtoMessage()    {}
+func (*DescribeSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{68}
+}
+
+func (m *DescribeSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSessionsResponse.Unmarshal(m, b)
+}
+func (m *DescribeSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSessionsResponse.Merge(m, src)
+}
+func (m *DescribeSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeSessionsResponse.Size(m)
+}
+func (m *DescribeSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSessionsResponse proto.InternalMessageInfo
+
+func (m *DescribeSessionsResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeSessionsResponse) GetSessionSet() []*Session {
+	if m != nil {
+		return m.SessionSet
+	}
+	return nil
+}
+
+type LogoutRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{69}
+}
+
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
+}
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
+}
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
+}
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
+}
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
+
+type LogoutResponse struct {
+	// id of user logged out
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// id of session revoked
+	SessionId            string   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{70}
+}
+
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
+}
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
+}
+func (m *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(m, src)
+}
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
+}
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
+
+func (m *LogoutResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *LogoutResponse) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type RevokeUserTokensRequest struct {
+	// required, ids of users to revoke all tokens
+	UserId               []string `protobuf:"bytes,1,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeUserTokensRequest) Reset()         { *m = RevokeUserTokensRequest{} }
+func (m *RevokeUserTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeUserTokensRequest) ProtoMessage()    {}
+func (*RevokeUserTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{71}
+}
+
+func (m *RevokeUserTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeUserTokensRequest.Unmarshal(m, b)
+}
+func (m *RevokeUserTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeUserTokensRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeUserTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeUserTokensRequest.Merge(m, src)
+}
+func (m *RevokeUserTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeUserTokensRequest.Size(m)
+}
+func (m *RevokeUserTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeUserTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeUserTokensRequest proto.InternalMessageInfo
+
+func (m *RevokeUserTokensRequest) GetUserId() []string {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+type RevokeUserTokensResponse struct {
+	// ids of users whose tokens are revoked
+	UserId               []string `protobuf:"bytes,1,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeUserTokensResponse) Reset()         { *m = RevokeUserTokensResponse{} }
+func (m *RevokeUserTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeUserTokensResponse) ProtoMessage()    {}
+func (*RevokeUserTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{72}
+}
+
+func (m *RevokeUserTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeUserTokensResponse.Unmarshal(m, b)
+}
+func (m *RevokeUserTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeUserTokensResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeUserTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeUserTokensResponse.Merge(m, src)
+}
+func (m *RevokeUserTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeUserTokensResponse.Size(m)
+}
+func (m *RevokeUserTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeUserTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeUserTokensResponse proto.InternalMessageInfo
+
+func (m *RevokeUserTokensResponse) GetUserId() []string {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "openpitrix.User")
 	proto.RegisterType((*UserDetail)(nil), "openpitrix.UserDetail")
@@ -3797,235 +4265,263 @@ func init() {
 	proto.RegisterType((*CreateClientResponse)(nil), "openpitrix.CreateClientResponse")
 	proto.RegisterType((*TokenRequest)(nil), "openpitrix.TokenRequest")
 	proto.RegisterType((*TokenResponse)(nil), "openpitrix.TokenResponse")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "openpitrix.RevokeTokenRequest")
+	proto.RegisterType((*RevokeTokenResponse)(nil), "openpitrix.RevokeTokenResponse")
+	proto.RegisterType((*Session)(nil), "openpitrix.Session")
+	proto.RegisterType((*DescribeSessionsRequest)(nil), "openpitrix.DescribeSessionsRequest")
+	proto.RegisterType((*DescribeSessionsResponse)(nil), "openpitrix.DescribeSessionsResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "openpitrix.LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "openpitrix.LogoutResponse")
+	proto.RegisterType((*RevokeUserTokensRequest)(nil), "openpitrix.RevokeUserTokensRequest")
+	proto.RegisterType((*RevokeUserTokensResponse)(nil), "openpitrix.RevokeUserTokensResponse")
 }
 
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 3561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xb5, 0x77, 0x29, 0x8a, 0x12, 0x0f, 0x45, 0x49, 0x1e, 0xcb, 0x32, 0x4d, 0x49, 0xf6, 0x7a, 0x63,
-	0xd8, 0xb2, 0x2c, 0x4b, 0x89, 0x92, 0x20, 0x81, 0x73, 0xe3, 0x80, 0x96, 0x13, 0x5b, 0x49, 0xec,
-	0x04, 0x74, 0x9c, 0x00, 0x37, 0x37, 0x21, 0x56, 0xdc, 0xa1, 0xb8, 0xf1, 0x72, 0x97, 0xd9, 0x5d,
-	0x4a, 0xf1, 0xbd, 0x17, 0xb7, 0x68, 0xd0, 0x00, 0x6d, 0x81, 0xa0, 0x29, 0x8b, 0x14, 0xfd, 0x78,
-	0x6a, 0x81, 0xbe, 0x04, 0x7d, 0x08, 0xfa, 0x52, 0xf4, 0xb1, 0x2f, 0x05, 0x0a, 0xf4, 0xa1, 0x1f,
-	0xff, 0xa0, 0x2d, 0xd0, 0xf7, 0xf6, 0x0f, 0x14, 0x73, 0x66, 0x76, 0x77, 0x66, 0xb9, 0x94, 0x48,
-	0x2b, 0x09, 0x50, 0xa0, 0x4f, 0xe2, 0xce, 0x39, 0x33, 0xe7, 0x63, 0xce, 0x9c, 0x4f, 0x41, 0xd9,
-	0x6c, 0x36, 0xbd, 0x9e, 0x1b, 0x6e, 0x74, 0x7d, 0x2f, 0xf4, 0x08, 0x78, 0x5d, 0xea, 0x76, 0xed,
-	0xd0, 0xb7, 0x3f, 0xa8, 0x9e, 0xdd, 0xf3, 0xbc, 0x3d, 0x87, 0x6e, 0x22, 0x64, 0xb7, 0xd7, 0xda,
-	0x3c, 0xf0, 0xcd, 0x6e, 0x97, 0xfa, 0x01, 0xc7, 0xad, 0x9e, 0x4b, 0xc3, 0x43, 0xbb, 0x43, 0x83,
-	0xd0, 0xec, 0x74, 0x05, 0xc2, 0xb2, 0x40, 0x30, 0xbb, 0xf6, 0xa6, 0xe9, 0xba, 0x5e, 0x68, 0x86,
-	0xb6, 0xe7, 0x46, 0xdb, 0xd7, 0xf1, 0x4f, 0xf3, 0xea, 0x1e, 0x75, 0xaf, 0x06, 0x07, 0xe6, 0xde,
-	0x1e, 0xf5, 0x37, 0xbd, 0x2e, 0x62, 0x0c, 0x62, 0x1b, 0x3f, 0xcc, 0x43, 0xfe, 0x7e, 0x40, 0x7d,
-	0xf2, 0x34, 0x4c, 0xf5, 0x02, 0xea, 0x37, 0x6c, 0xab, 0xa2, 0xe9, 0xda, 0x6a, 0x69, 0x6b, 0x79,
-	0x83, 0x93, 0xd9, 0x88, 0xf8, 0xd8, 0xb8, 0x17, 0xfa, 0xb6, 0xbb, 0xf7, 0xa6, 0xe9, 0xf4, 0x68,
-	0xbd, 0xc0, 0x90, 0x77, 0x2c, 0xf2, 0x2c, 0x4c, 0xb3, 0x5f, 0xae, 0xd9, 0xa1, 0x95, 0xdc, 0x08,
-	0xfb, 0x62, 0x6c, 0xb2, 0x05, 0x93, 0xb4, 0x63, 0xda, 0x4e, 0x65, 0x62, 0x84, 0x6d, 0x1c, 0x95,
-	0xbc, 0x00, 0x33, 0xdd, 0xb6, 0xe7, 0xd2, 0x86, 0xdb, 0xeb, 0xec, 0x52, 0xbf, 0x92, 0x1f, 0x61,
-	0x6b, 0x09, 0x77, 0xdc, 0xc5, 0x0d, 0xe4, 0x3a, 0x94, 0x2c, 0x1a, 0x34, 0x7d, 0x1b, 0x15, 0x52,
-	0x99, 0x1c, 0x65, 0xbf, 0xb4, 0x81, 0x3c, 0x05, 0x85, 0x20, 0x34, 0xc3, 0x5e, 0x50, 0x29, 0x8c,
-	0xa2, 0x24, 0x8e, 0x4b, 0x9e, 0x83, 0x52, 0xd3, 0xa7, 0x66, 0x48, 0x1b, 0xec, 0x2a, 0x2b, 0x53,
-	0xb8, 0xb5, 0x3a, 0xb0, 0xf5, 0x8d, 0xe8, 0x9e, 0xeb, 0xc0, 0xd1, 0xd9, 0x02, 0xdb, 0xdc, 0xeb,
-	0x5a, 0xf1, 0xe6, 0xe9, 0xa3, 0x37, 0x73, 0xf4, 0x68, 0x33, 0xe7, 0x81, 0x6f, 0x2e, 0x1e, 0xbd,
-	0x99, 0xa3, 0xb3, 0x05, 0xe3, 0x3b, 0x1a, 0x00, 0xb3, 0x8d, 0x9b, 0x34, 0x64, 0xca, 0xbf, 0x00,
-	0x79, 0x76, 0x79, 0xc2, 0x3c, 0xe6, 0x37, 0x12, 0x93, 0xde, 0x60, 0x58, 0x75, 0x84, 0x92, 0x2b,
-	0x30, 0xed, 0x7b, 0x0e, 0x6d, 0x04, 0x34, 0xac, 0xe4, 0xf4, 0x89, 0x34, 0x66, 0xdd, 0x73, 0x68,
-	0x7d, 0x8a, 0x61, 0xdc, 0xa3, 0x21, 0xd9, 0x80, 0xe2, 0x9e, 0xef, 0xf5, 0xba, 0x88, 0x3d, 0x81,
-	0xd8, 0x27, 0x64, 0xec, 0x5b, 0x0c, 0x58, 0x9f, 0x46, 0x9c, 0x7b, 0x34, 0x34, 0x7e, 0x9a, 0x87,
-	0x49, 0x5c, 0x23, 0x37, 0x61, 0xae, 0x6b, 0xfa, 0xd4, 0x0d, 0x1b, 0xfc, 0x80, 0x11, 0xcd, 0xb6,
-	0xcc, 0x37, 0xe1, 0x19, 0x3b, 0x16, 0x79, 0x06, 0xa6, 0xe3, 0xed, 0xa3, 0x58, 0xef, 0xd4, 0x9e,
-	0xd8, 0xf8, 0x1c, 0x00, 0xdf, 0xd8, 0x35, 0xc3, 0xf6, 0x48, 0x16, 0xcc, 0x05, 0x7d, 0xdd, 0x0c,
-	0xdb, 0xe4, 0x71, 0xc8, 0xe3, 0x7b, 0x19, 0xc5, 0x7a, 0x11, 0x53, 0x32, 0xbb, 0xc9, 0x31, 0xcc,
-	0x2e, 0x65, 0xec, 0x85, 0x71, 0x8d, 0xfd, 0x5f, 0xd4, 0x6c, 0x9b, 0x50, 0xc2, 0xfb, 0x15, 0x66,
-	0x7b, 0x09, 0x26, 0x51, 0xf5, 0xc2, 0x3e, 0x32, 0xec, 0x8b, 0xc3, 0x99, 0xe5, 0xa2, 0x07, 0x1c,
-	0x62, 0xb9, 0x68, 0xe3, 0xe8, 0x23, 0x99, 0x25, 0xfe, 0x7e, 0x02, 0x16, 0x6e, 0xa2, 0xae, 0x76,
-	0x29, 0x83, 0x04, 0x75, 0xfa, 0x7e, 0x8f, 0x06, 0x21, 0x79, 0x1e, 0x4a, 0x01, 0x35, 0xfd, 0x66,
-	0xbb, 0x71, 0xe0, 0xf9, 0xa3, 0x19, 0x25, 0xf0, 0x0d, 0x6f, 0x79, 0x3e, 0x5a, 0x64, 0xe0, 0xf9,
-	0x61, 0xe3, 0x01, 0x7d, 0x38, 0x9a, 0x45, 0x32, 0xec, 0x57, 0xe8, 0x43, 0xf2, 0x14, 0x4c, 0xf9,
-	0x74, 0x9f, 0xfa, 0x01, 0x15, 0xe6, 0x38, 0xa8, 0xae, 0x1b, 0x9e, 0xe7, 0x88, 0x5d, 0x02, 0x95,
-	0x2c, 0xc0, 0xa4, 0x63, 0x77, 0xec, 0x10, 0x6d, 0xb1, 0x5c, 0xe7, 0x1f, 0x64, 0x11, 0x0a, 0x5e,
-	0xab, 0xc5, 0xf4, 0x30, 0x89, 0xcb, 0xe2, 0x8b, 0x18, 0x50, 0xf6, 0x3d, 0x4f, 0x7a, 0x72, 0x05,
-	0x7d, 0x62, 0xb5, 0x58, 0x2f, 0xb1, 0xc5, 0xe8, 0x49, 0x9d, 0x91, 0x9e, 0xd4, 0x14, 0x82, 0xe3,
-	0x47, 0x73, 0x3a, 0x09, 0x31, 0xd3, 0x08, 0x89, 0x82, 0xc8, 0x62, 0x6c, 0xde, 0x45, 0xbe, 0x2e,
-	0x0c, 0xf8, 0x34, 0xa0, 0xa7, 0x60, 0x1b, 0x80, 0x03, 0xd8, 0xe7, 0x8e, 0x45, 0xaa, 0x52, 0xd4,
-	0x29, 0x21, 0x24, 0x89, 0x2b, 0x0b, 0x51, 0x5c, 0x99, 0x41, 0x80, 0x88, 0x1c, 0xe7, 0x53, 0x91,
-	0xa3, 0xcc, 0x39, 0x97, 0x62, 0x83, 0x41, 0xe1, 0x54, 0xea, 0x46, 0x83, 0xae, 0xe7, 0x06, 0x94,
-	0x9c, 0x83, 0x52, 0xe8, 0x85, 0xa6, 0xd3, 0xc0, 0x88, 0x8e, 0x57, 0x5a, 0xae, 0x03, 0x2e, 0x6d,
-	0xb3, 0x95, 0xf1, 0x2c, 0xe7, 0xff, 0x61, 0x49, 0x21, 0xc3, 0xcd, 0x74, 0x74, 0x62, 0xd7, 0x61,
-	0x0e, 0x89, 0x59, 0xb8, 0x4f, 0xa2, 0xb9, 0x98, 0xa6, 0x29, 0x4e, 0x2e, 0xf7, 0xe2, 0xdf, 0x8c,
-	0xfe, 0x3f, 0x72, 0x70, 0xe2, 0x8e, 0x67, 0xd9, 0xad, 0x87, 0xc8, 0x97, 0x30, 0xdb, 0x47, 0x0c,
-	0xff, 0x71, 0x10, 0xcf, 0x8d, 0x1e, 0xc4, 0xe5, 0x94, 0x61, 0x62, 0xac, 0x94, 0x21, 0xe5, 0xd0,
-	0xf2, 0xe3, 0x3a, 0xb4, 0x67, 0x61, 0xba, 0x6b, 0x06, 0x01, 0x3e, 0xcc, 0x51, 0x1c, 0x69, 0x8c,
-	0x3d, 0x90, 0x78, 0x14, 0xc6, 0x4c, 0x3c, 0x8c, 0x57, 0x80, 0xc8, 0x4a, 0x17, 0x97, 0xfd, 0x68,
-	0x5a, 0x37, 0xae, 0x02, 0xb9, 0x49, 0x1d, 0x1a, 0xaa, 0x9e, 0xe7, 0xb4, 0x7c, 0x98, 0xf4, 0xbc,
-	0x8c, 0x0d, 0x38, 0xa9, 0xa0, 0x0b, 0xe2, 0x43, 0xf1, 0x3f, 0xd6, 0xa0, 0xba, 0x8d, 0x9e, 0xfc,
-	0x75, 0x21, 0x7f, 0x9d, 0x06, 0x34, 0x3c, 0xa6, 0xa9, 0xc8, 0xca, 0xcf, 0x8d, 0xa3, 0x7c, 0xc6,
-	0xcf, 0x52, 0x26, 0x3f, 0xc7, 0xd2, 0x22, 0x73, 0xb5, 0x3e, 0x3b, 0x67, 0xe4, 0xe0, 0x8f, 0xd8,
-	0x3b, 0x96, 0xf1, 0x5d, 0x0d, 0x4e, 0x6d, 0xb7, 0x4d, 0x77, 0x4f, 0xe2, 0x87, 0xab, 0xe6, 0x05,
-	0x98, 0x71, 0xe9, 0x41, 0x23, 0x96, 0x73, 0x14, 0x76, 0x4a, 0x2e, 0x3d, 0x88, 0xce, 0x79, 0x74,
-	0x9e, 0x5e, 0x83, 0xc5, 0x34, 0x4b, 0xc7, 0xb3, 0xb1, 0xa7, 0xe0, 0xf4, 0x2d, 0x1a, 0x66, 0x1a,
-	0xc0, 0x19, 0x89, 0x49, 0x76, 0x64, 0x31, 0x61, 0xe3, 0x2e, 0x54, 0x06, 0x77, 0x09, 0x46, 0x86,
-	0x6f, 0x93, 0x4d, 0x31, 0x87, 0x90, 0x88, 0x8b, 0x5f, 0xe5, 0xe0, 0x04, 0xbf, 0x7a, 0xd9, 0x59,
-	0xc5, 0x5e, 0x47, 0x7b, 0xf4, 0xd2, 0x21, 0x37, 0x6e, 0xe9, 0x20, 0xdb, 0xef, 0xc4, 0x58, 0xce,
-	0xe3, 0xe9, 0x24, 0x8c, 0x8d, 0xe2, 0xb2, 0xa2, 0x20, 0x77, 0xcc, 0x5a, 0x85, 0xb9, 0x1c, 0x59,
-	0x75, 0xc7, 0x33, 0x87, 0xd7, 0x60, 0xe9, 0x4d, 0xd3, 0xb1, 0x2d, 0x71, 0x5c, 0xda, 0xf0, 0x17,
-	0xe4, 0x1b, 0x89, 0x83, 0x6e, 0x35, 0xf5, 0xe4, 0x8b, 0xd2, 0xa3, 0xfe, 0x4f, 0x58, 0xce, 0x3e,
-	0x50, 0xf0, 0xb9, 0x0c, 0xc5, 0x7d, 0x01, 0xe7, 0x9c, 0x4e, 0xd7, 0x93, 0x05, 0xe3, 0x77, 0x5a,
-	0x24, 0x1c, 0x4f, 0xe1, 0x04, 0x1b, 0x5f, 0x4c, 0x55, 0x10, 0xe5, 0xe7, 0xb9, 0x91, 0xf3, 0xf3,
-	0xd4, 0x55, 0x4d, 0x8c, 0x7b, 0x55, 0x77, 0xe1, 0xa4, 0x22, 0x8d, 0xd0, 0x81, 0x5c, 0x9e, 0x68,
-	0x63, 0x94, 0x27, 0xc6, 0xe7, 0x13, 0x49, 0x2e, 0x83, 0x47, 0xfe, 0x3b, 0x3d, 0x15, 0x77, 0x7b,
-	0x71, 0xd0, 0x42, 0x78, 0x96, 0x9a, 0xb2, 0x01, 0x39, 0x8d, 0x9d, 0x56, 0xd3, 0xd8, 0x15, 0xa5,
-	0xf6, 0xe3, 0x19, 0xab, 0x54, 0xdd, 0xc5, 0x60, 0xb4, 0x21, 0x90, 0xc0, 0x77, 0x99, 0xa9, 0x24,
-	0xb9, 0x6e, 0x49, 0xce, 0x75, 0x0d, 0x1b, 0x16, 0xd3, 0x37, 0x36, 0x6a, 0x46, 0xa8, 0x54, 0xd1,
-	0xb9, 0xa3, 0xab, 0xe8, 0x0f, 0x35, 0x58, 0x56, 0x69, 0x8d, 0x9b, 0x83, 0xd6, 0x60, 0x9e, 0x53,
-	0x1c, 0x48, 0x42, 0x4f, 0x0f, 0x10, 0x16, 0x67, 0xcf, 0xee, 0x25, 0x1f, 0x8c, 0x89, 0x8f, 0x72,
-	0x51, 0x46, 0xa4, 0xbc, 0xe0, 0x47, 0x35, 0xf9, 0xaf, 0xfe, 0xd1, 0x66, 0x39, 0x9b, 0xfc, 0xd8,
-	0xce, 0x86, 0x3d, 0x7d, 0x45, 0x0d, 0xc7, 0x7d, 0xfa, 0x8f, 0x47, 0xc9, 0x9e, 0xfa, 0xee, 0xcf,
-	0x28, 0xe7, 0xc9, 0xf6, 0x6c, 0x3c, 0xc1, 0x2a, 0x59, 0x79, 0x47, 0x12, 0xaf, 0x87, 0x6d, 0x79,
-	0x09, 0xe6, 0x5f, 0xf6, 0x6c, 0x57, 0xb9, 0xb9, 0xe1, 0xe8, 0x6a, 0x78, 0x97, 0x33, 0xcd, 0x5b,
-	0x70, 0x42, 0x3a, 0xe7, 0x48, 0xba, 0x87, 0x1e, 0xf4, 0x2a, 0x35, 0xf7, 0xe9, 0xb1, 0x39, 0xba,
-	0x0d, 0x44, 0x3e, 0xe8, 0x18, 0x2c, 0xfd, 0x68, 0x02, 0xf2, 0x75, 0xcf, 0xa1, 0x72, 0x15, 0xcb,
-	0xa3, 0x63, 0x14, 0xe0, 0x97, 0xa0, 0x88, 0x80, 0xd8, 0x6e, 0x8b, 0x75, 0xec, 0x9d, 0xa1, 0x9f,
-	0xd0, 0x07, 0xad, 0xb3, 0xa8, 0xda, 0xdf, 0x22, 0x14, 0xba, 0x9e, 0x1f, 0x9a, 0x0e, 0x9a, 0x5d,
-	0xb1, 0x2e, 0xbe, 0x98, 0xd3, 0xf4, 0x0e, 0x5c, 0xea, 0xa3, 0x77, 0x2c, 0xd6, 0xf9, 0x07, 0x73,
-	0x4b, 0xf8, 0x83, 0x7b, 0xad, 0x02, 0x82, 0x8a, 0xb8, 0x82, 0x5e, 0x2b, 0x71, 0x4b, 0x53, 0xfc,
-	0x30, 0x51, 0x82, 0x9f, 0x05, 0x68, 0x7a, 0x6e, 0xe8, 0x7b, 0x8e, 0x43, 0x7d, 0xec, 0xe2, 0x14,
-	0xeb, 0xd2, 0x4a, 0xba, 0x47, 0x54, 0x3c, 0x4e, 0x8f, 0x08, 0x8e, 0xd3, 0x23, 0x2a, 0x8d, 0xd5,
-	0x23, 0x72, 0x60, 0xa2, 0xd6, 0xb5, 0xc9, 0x29, 0x28, 0x98, 0x5d, 0x3b, 0xb9, 0x99, 0x49, 0xb3,
-	0x6b, 0x73, 0x0f, 0xcf, 0x96, 0x3b, 0x34, 0x6c, 0x7b, 0x51, 0xe6, 0x52, 0x34, 0xbb, 0xf6, 0x1d,
-	0x5c, 0x60, 0xe0, 0x9e, 0xef, 0x44, 0x60, 0x7e, 0x33, 0xc5, 0x9e, 0xef, 0x08, 0xf0, 0x3c, 0x4c,
-	0xf4, 0xfc, 0xe8, 0x52, 0xd8, 0x4f, 0xe3, 0x13, 0x0d, 0x66, 0x6a, 0x4d, 0x76, 0x69, 0x37, 0x7a,
-	0xae, 0xe5, 0x50, 0xb2, 0x0a, 0xf3, 0x26, 0x7e, 0x37, 0x76, 0x71, 0x21, 0xe1, 0x60, 0xd6, 0x94,
-	0xf0, 0x76, 0x2c, 0xb2, 0x0e, 0x44, 0xc5, 0x94, 0x8c, 0x65, 0x5e, 0xc6, 0x45, 0xa3, 0x59, 0x85,
-	0x29, 0xc6, 0x78, 0xd2, 0x4d, 0x9d, 0x93, 0xdd, 0x71, 0xad, 0x6b, 0xd7, 0x99, 0xbc, 0xcc, 0xfd,
-	0xfe, 0x41, 0x83, 0xa9, 0x97, 0xa8, 0x19, 0xf6, 0x7c, 0xca, 0xe4, 0x69, 0xf1, 0x9f, 0x09, 0x1f,
-	0x45, 0xb1, 0xb2, 0x63, 0x91, 0xf3, 0x30, 0x13, 0x81, 0x25, 0xe2, 0x25, 0xb1, 0x86, 0x74, 0x6f,
-	0xc2, 0x09, 0x95, 0xcb, 0x84, 0x83, 0x8a, 0xc2, 0x81, 0xc4, 0x70, 0x7d, 0x4e, 0x66, 0xff, 0x1e,
-	0x0d, 0xc9, 0x75, 0x58, 0x6e, 0xb6, 0x69, 0xf3, 0x01, 0xb5, 0x1a, 0x69, 0xed, 0xe0, 0x81, 0x79,
-	0x7c, 0x5f, 0x15, 0x81, 0x53, 0x53, 0x14, 0xc5, 0x64, 0xfa, 0xb5, 0x06, 0x70, 0xc7, 0xb3, 0x7a,
-	0x0e, 0x7d, 0xd1, 0xa1, 0x1d, 0xf6, 0xbc, 0x3a, 0xf8, 0x95, 0x48, 0x35, 0xcd, 0x17, 0x76, 0x2c,
-	0x16, 0xe2, 0x04, 0x50, 0x92, 0x09, 0xf8, 0xd2, 0x5d, 0xde, 0x72, 0x8d, 0x24, 0x94, 0x84, 0x39,
-	0x29, 0x0b, 0x23, 0xd4, 0x57, 0x8f, 0x94, 0xc7, 0x44, 0x58, 0x01, 0xb0, 0xcc, 0xd0, 0x6c, 0x38,
-	0x74, 0x9f, 0x46, 0x26, 0x50, 0x64, 0x2b, 0xaf, 0xb2, 0x05, 0xa2, 0xc3, 0x8c, 0x1d, 0x34, 0x50,
-	0x80, 0x86, 0xe9, 0x38, 0xf8, 0x42, 0xa7, 0xeb, 0x60, 0x07, 0xdb, 0x6c, 0xa9, 0xe6, 0x38, 0xc6,
-	0x6d, 0x28, 0x70, 0x11, 0xc8, 0x75, 0x98, 0x13, 0x1c, 0x52, 0x87, 0x76, 0x90, 0x09, 0x6d, 0xb0,
-	0xcf, 0x93, 0xc8, 0x5b, 0x2f, 0x77, 0xe2, 0xdf, 0x4c, 0x1b, 0x07, 0x30, 0xb3, 0x6d, 0xba, 0x37,
-	0xbd, 0xcc, 0xf6, 0x80, 0x54, 0x63, 0x45, 0xf6, 0x9a, 0x8b, 0xed, 0xf5, 0x28, 0x03, 0x57, 0x9f,
-	0x47, 0x3e, 0xf5, 0x3c, 0x8c, 0x36, 0x94, 0x05, 0xe1, 0xac, 0x46, 0x83, 0x4c, 0xf9, 0x1c, 0x94,
-	0xcc, 0x66, 0x93, 0x06, 0x01, 0x77, 0x4a, 0xe2, 0x12, 0xf8, 0x52, 0x94, 0x4b, 0x49, 0x4e, 0x6b,
-	0x22, 0xe5, 0xb4, 0x8c, 0x4d, 0x58, 0xb8, 0x45, 0x43, 0xe6, 0x64, 0xb9, 0x1a, 0x24, 0x51, 0x33,
-	0x3d, 0xae, 0xf1, 0xdf, 0x70, 0x2a, 0xb5, 0x21, 0x61, 0x31, 0xdb, 0x47, 0xaf, 0x41, 0x81, 0xab,
-	0x55, 0x24, 0x16, 0x64, 0x50, 0xf9, 0x75, 0x81, 0x61, 0xbc, 0x0b, 0xa7, 0x79, 0x28, 0x1f, 0x9d,
-	0xa3, 0xb1, 0xce, 0x7f, 0x12, 0x2a, 0x83, 0xe7, 0x1f, 0x21, 0x80, 0xf1, 0x5e, 0x54, 0x40, 0xe3,
-	0xe4, 0x45, 0xb0, 0xa3, 0x44, 0x1e, 0xed, 0xf0, 0xc8, 0x93, 0x3b, 0x2c, 0xf2, 0x4c, 0xc8, 0x91,
-	0xc7, 0xb8, 0x1a, 0x15, 0x65, 0x9c, 0xd6, 0x51, 0xac, 0xc5, 0x6d, 0x2c, 0x86, 0x1e, 0x64, 0xaa,
-	0x4a, 0x6a, 0xfa, 0x26, 0x6d, 0x2c, 0x81, 0x9e, 0x75, 0xbc, 0x8c, 0xff, 0x20, 0xea, 0x73, 0xca,
-	0x92, 0x7f, 0x49, 0xc1, 0x98, 0xc9, 0x22, 0x13, 0x3b, 0x4a, 0xf4, 0xcb, 0x30, 0x2b, 0x0c, 0xf1,
-	0x48, 0x9b, 0x7d, 0x06, 0xe6, 0x62, 0x54, 0x71, 0xec, 0x05, 0xc8, 0x33, 0x60, 0xd6, 0x24, 0x0e,
-	0xf1, 0x10, 0x6a, 0x7c, 0x96, 0x4b, 0x46, 0x14, 0x8a, 0x86, 0xcf, 0x0d, 0xd6, 0x80, 0x45, 0xa5,
-	0xca, 0x3b, 0x93, 0xaa, 0xf2, 0x8a, 0x49, 0x1d, 0x57, 0x51, 0xeb, 0xb8, 0xe9, 0xa4, 0x56, 0x4b,
-	0xaa, 0xb2, 0xbc, 0x52, 0x95, 0xc5, 0x35, 0xdc, 0xa4, 0x5c, 0xc3, 0x49, 0xe2, 0x16, 0x94, 0xd6,
-	0xbe, 0x72, 0x0f, 0xbc, 0x34, 0x4b, 0xee, 0x21, 0x31, 0x3c, 0x31, 0x40, 0x10, 0x29, 0xcf, 0xb0,
-	0x01, 0x42, 0x56, 0x9c, 0xe5, 0x15, 0x59, 0x2a, 0xce, 0xca, 0xcd, 0x7f, 0xd5, 0xbc, 0x46, 0x69,
-	0xfe, 0x8f, 0x3c, 0xf0, 0x34, 0x6e, 0xc1, 0xc9, 0x1b, 0xb6, 0x6b, 0x61, 0x47, 0x46, 0xbd, 0xfc,
-	0xcc, 0x56, 0xac, 0xac, 0xa6, 0x9c, 0x62, 0xdc, 0xb7, 0x61, 0x41, 0x3d, 0xe8, 0x88, 0xa6, 0xee,
-	0xf0, 0x93, 0x76, 0xe0, 0xd4, 0x7d, 0x77, 0xf7, 0x0b, 0x61, 0xea, 0x65, 0x58, 0x4c, 0x1f, 0xf5,
-	0xc8, 0x6c, 0x6d, 0x44, 0x2d, 0x91, 0x6d, 0xc7, 0xa6, 0x6e, 0x78, 0x54, 0x14, 0x33, 0xde, 0x87,
-	0x05, 0x15, 0xff, 0xa8, 0xe0, 0xb3, 0x04, 0xc5, 0x26, 0xa2, 0x26, 0x5d, 0xc7, 0x69, 0xbe, 0xb0,
-	0x63, 0x91, 0xc7, 0xa0, 0x2c, 0x80, 0x01, 0x6d, 0xfa, 0x18, 0xff, 0x19, 0xc2, 0x0c, 0x5f, 0xbc,
-	0x87, 0x6b, 0xc6, 0x9f, 0x35, 0x98, 0x79, 0xc3, 0x7b, 0x40, 0xdd, 0x88, 0x39, 0x2c, 0xfd, 0x4d,
-	0x37, 0x6c, 0x84, 0x0f, 0xbb, 0x91, 0x5f, 0x2d, 0xe2, 0xca, 0x1b, 0x0f, 0xbb, 0xf4, 0xf8, 0x14,
-	0xd9, 0x5b, 0x0a, 0x9a, 0x5e, 0x97, 0x8a, 0xa0, 0xcb, 0x3f, 0x94, 0x69, 0x18, 0xcf, 0xf9, 0x93,
-	0x91, 0x89, 0xdc, 0x82, 0x2b, 0xa8, 0x2d, 0x38, 0x46, 0xd2, 0xa7, 0x2d, 0x9f, 0x06, 0xed, 0x46,
-	0xc8, 0xc4, 0x10, 0xa9, 0xff, 0x8c, 0x58, 0x44, 0xd1, 0x8c, 0xcf, 0x35, 0x28, 0x0b, 0x21, 0x85,
-	0x46, 0x57, 0x00, 0x10, 0x5d, 0x91, 0x12, 0x57, 0x50, 0xca, 0x15, 0x00, 0xfa, 0x41, 0xd7, 0xf6,
-	0x69, 0xd0, 0xb0, 0x79, 0xf4, 0x98, 0xac, 0x17, 0xc5, 0xca, 0x8e, 0xcb, 0xb2, 0x49, 0x11, 0xf3,
-	0x39, 0x4d, 0xe1, 0x4b, 0xf9, 0x1a, 0x12, 0x1a, 0xe4, 0x2b, 0x3f, 0xc8, 0x17, 0xf3, 0x51, 0xb6,
-	0x25, 0xe0, 0x5c, 0xe8, 0x29, 0xdb, 0x42, 0xd0, 0xd6, 0xdf, 0x16, 0x61, 0xb6, 0xc6, 0xff, 0xfd,
-	0xe6, 0x8e, 0xe9, 0x9a, 0x7b, 0xd4, 0x27, 0xbf, 0xd5, 0xa0, 0xac, 0x4c, 0xdd, 0x88, 0x2e, 0x3f,
-	0xd2, 0xac, 0x49, 0x6e, 0xf5, 0xfc, 0x21, 0x18, 0x5c, 0x15, 0x46, 0xd0, 0xaf, 0x35, 0xc8, 0x3b,
-	0xb7, 0x68, 0xa8, 0x33, 0x85, 0x07, 0xeb, 0x7a, 0xcb, 0x76, 0x42, 0xea, 0xeb, 0x07, 0x76, 0xd8,
-	0xd6, 0x5b, 0x36, 0x75, 0xac, 0x60, 0x55, 0x58, 0xdf, 0xba, 0x8e, 0x3d, 0xd1, 0x75, 0x5d, 0xee,
-	0x42, 0xaf, 0xeb, 0xdc, 0x51, 0x5d, 0x5e, 0xd7, 0x2d, 0xda, 0x32, 0x7b, 0x4e, 0xa8, 0xfb, 0x34,
-	0xec, 0xf9, 0xae, 0x6e, 0x3a, 0x0e, 0x3f, 0xf3, 0xc3, 0x3f, 0xfd, 0xf5, 0x7b, 0xb9, 0x12, 0x29,
-	0x6e, 0xee, 0x3f, 0xb1, 0x89, 0x0b, 0xe4, 0x1b, 0x39, 0x16, 0x08, 0x07, 0x26, 0x88, 0x23, 0x48,
-	0x74, 0x69, 0x28, 0x86, 0xda, 0x00, 0x32, 0x7e, 0xa2, 0xf5, 0x6b, 0x1f, 0x69, 0xe4, 0x43, 0x4d,
-	0x12, 0xcd, 0x76, 0x9b, 0x4e, 0xcf, 0xa2, 0xf8, 0xa9, 0xdb, 0x6e, 0xcb, 0xd3, 0xbd, 0x96, 0xce,
-	0x1e, 0xab, 0x6e, 0xba, 0x96, 0x8e, 0xe5, 0xf0, 0x97, 0x26, 0x3f, 0x21, 0xf3, 0xb1, 0xfc, 0xa2,
-	0xe7, 0x44, 0xf6, 0x31, 0xd9, 0x17, 0x13, 0x35, 0xb2, 0x92, 0xca, 0x9b, 0xd4, 0xf1, 0x66, 0xf5,
-	0xec, 0x30, 0xb0, 0x10, 0xf8, 0x72, 0xbf, 0x46, 0xc8, 0x3c, 0x07, 0x24, 0x12, 0x22, 0xed, 0xd9,
-	0xad, 0x44, 0xf7, 0xd7, 0xb4, 0x35, 0xf2, 0x35, 0x28, 0x49, 0xd3, 0x34, 0x72, 0x56, 0xd5, 0x69,
-	0x7a, 0x2a, 0x57, 0x3d, 0x37, 0x14, 0x2e, 0x48, 0x6f, 0xf6, 0x6b, 0x15, 0xb2, 0xc8, 0x21, 0x9c,
-	0xf4, 0x2e, 0x67, 0xa1, 0x61, 0x5b, 0x9c, 0x81, 0x35, 0x95, 0x81, 0xef, 0x6b, 0x30, 0xab, 0xce,
-	0x7a, 0x88, 0x62, 0xaa, 0x99, 0xa3, 0xa9, 0xaa, 0x71, 0x18, 0x8a, 0x60, 0xe5, 0xf9, 0x7e, 0x6d,
-	0x91, 0x2c, 0x70, 0x20, 0x67, 0x25, 0xf2, 0x15, 0xc8, 0xc8, 0x59, 0xe3, 0x4c, 0xcc, 0xc8, 0x66,
-	0x04, 0xb9, 0xd6, 0x44, 0x74, 0xc6, 0xd8, 0x2f, 0xb5, 0xc8, 0x67, 0x2b, 0x03, 0x20, 0x72, 0x51,
-	0x21, 0x3d, 0x74, 0xb0, 0xa8, 0x9a, 0xe7, 0x21, 0x03, 0x3f, 0xe3, 0xb5, 0x7e, 0xed, 0x32, 0xb9,
-	0xc4, 0x31, 0x74, 0x53, 0xf7, 0xf9, 0x7e, 0x3d, 0xf4, 0x74, 0x1c, 0x28, 0x21, 0xeb, 0x97, 0x02,
-	0xc1, 0x3c, 0xb2, 0xbe, 0x62, 0x54, 0x32, 0x58, 0x47, 0x6c, 0xc6, 0xf9, 0x37, 0x35, 0x28, 0xef,
-	0x04, 0xfb, 0xc9, 0xb8, 0x44, 0xb5, 0xa7, 0x81, 0x09, 0x94, 0x6a, 0x4f, 0x83, 0x53, 0x16, 0xe3,
-	0xd9, 0x7e, 0x6d, 0x85, 0x2c, 0xed, 0x04, 0xfb, 0xec, 0x91, 0x74, 0x1d, 0x33, 0x6c, 0x79, 0x7e,
-	0x47, 0xe7, 0xad, 0x0f, 0x64, 0x8f, 0x9b, 0xb5, 0x51, 0x66, 0x5c, 0xd9, 0xc1, 0x7e, 0x23, 0xbe,
-	0xdd, 0x4f, 0x34, 0x80, 0x2f, 0x8e, 0x8f, 0xed, 0x7e, 0x6d, 0x9d, 0xac, 0x6d, 0x27, 0x74, 0xd7,
-	0x75, 0xbb, 0xc5, 0xaf, 0xb6, 0x6d, 0xee, 0x53, 0xdd, 0xb4, 0x3a, 0xb6, 0xab, 0x77, 0xa9, 0xdf,
-	0xb1, 0x83, 0xc0, 0xf6, 0x5c, 0x6e, 0x70, 0x86, 0x6a, 0x70, 0x9f, 0x69, 0x30, 0x9f, 0x9e, 0xea,
-	0x91, 0xc7, 0x94, 0x46, 0x6f, 0xf6, 0xa4, 0xb0, 0x7a, 0xe1, 0x70, 0x24, 0xc1, 0xe4, 0x2b, 0xfd,
-	0xda, 0x2a, 0xb9, 0xc8, 0x5c, 0x4d, 0x72, 0x97, 0xcc, 0xb7, 0x0c, 0xde, 0xa5, 0x30, 0xc4, 0x2a,
-	0x19, 0x7a, 0x9b, 0xe4, 0x73, 0x0d, 0x16, 0xb2, 0x06, 0x4b, 0x44, 0xb1, 0xae, 0x43, 0x66, 0x59,
-	0xd5, 0xd5, 0xa3, 0x11, 0x05, 0xe3, 0x2f, 0xf6, 0x6b, 0xcb, 0xa4, 0x1a, 0xa1, 0x70, 0xb5, 0x32,
-	0x67, 0xa8, 0x30, 0xab, 0x1b, 0x4b, 0x19, 0xcc, 0x46, 0xa3, 0x2c, 0xa6, 0xdf, 0x4f, 0x35, 0x28,
-	0x49, 0xe3, 0x1f, 0x92, 0x71, 0xa9, 0x72, 0x5f, 0x53, 0x75, 0x29, 0x19, 0x73, 0x23, 0xc6, 0xd7,
-	0x55, 0x72, 0x45, 0xdc, 0xba, 0xf0, 0xcc, 0x26, 0xff, 0xa1, 0x37, 0x3d, 0x37, 0x34, 0x6d, 0x57,
-	0xf7, 0x5c, 0xaa, 0x77, 0x3c, 0x5f, 0xb2, 0xc6, 0x39, 0x03, 0x18, 0xa3, 0x88, 0x86, 0xf7, 0xfe,
-	0x17, 0x0d, 0x66, 0xd5, 0x39, 0x01, 0xc9, 0x8c, 0x89, 0x4a, 0xa7, 0x59, 0x75, 0x34, 0xd9, 0x23,
-	0x0d, 0xe3, 0x5b, 0x5a, 0xbf, 0x16, 0x12, 0x9f, 0x5d, 0x39, 0x27, 0xb7, 0xae, 0x37, 0x4d, 0x57,
-	0x89, 0x1e, 0x61, 0x9b, 0x06, 0x34, 0x8a, 0x21, 0x51, 0xf3, 0x75, 0x5d, 0x4f, 0x75, 0xdc, 0xd7,
-	0xf5, 0x64, 0x14, 0x73, 0x78, 0x30, 0xe1, 0x74, 0x50, 0xd0, 0x19, 0x22, 0x09, 0x4a, 0x7e, 0x20,
-	0x95, 0x49, 0xf2, 0x34, 0x64, 0x14, 0x59, 0x57, 0x87, 0xa3, 0xa4, 0x22, 0xea, 0x2f, 0xb4, 0x7e,
-	0xed, 0x53, 0x8d, 0xf4, 0x35, 0x59, 0xe6, 0x28, 0xa4, 0x46, 0xc1, 0x4e, 0xb7, 0x5d, 0x3d, 0x6c,
-	0xdb, 0x41, 0x74, 0x67, 0x5f, 0xa1, 0x4e, 0x4e, 0x92, 0x13, 0x89, 0x4e, 0xa2, 0x10, 0xfb, 0xbf,
-	0x50, 0x92, 0x66, 0x13, 0x24, 0x23, 0x88, 0x0e, 0xb7, 0xcb, 0x8c, 0xa1, 0x86, 0x71, 0xa5, 0x5f,
-	0x3b, 0x49, 0x44, 0x51, 0x2e, 0xcc, 0x31, 0x0e, 0xb3, 0x73, 0x5b, 0x29, 0xeb, 0xfb, 0x1f, 0x98,
-	0x91, 0xc7, 0x12, 0x24, 0x23, 0x90, 0xaa, 0x97, 0xa1, 0x0f, 0x47, 0x10, 0xf4, 0x2f, 0xf5, 0x6b,
-	0x73, 0xa4, 0x2c, 0x42, 0xad, 0x24, 0xfc, 0xdc, 0x5a, 0x8a, 0xf6, 0xc7, 0x1a, 0x14, 0xe3, 0xc1,
-	0x04, 0x59, 0x96, 0x0f, 0x4e, 0xcf, 0x3d, 0xaa, 0x2b, 0x43, 0xa0, 0x89, 0x07, 0xbe, 0x48, 0x2e,
-	0xb0, 0xf5, 0xf8, 0xda, 0xf1, 0xaa, 0xd9, 0xdd, 0xbe, 0xc7, 0x56, 0x63, 0x00, 0xb2, 0xb2, 0x60,
-	0xcc, 0x49, 0xac, 0x30, 0x04, 0xe1, 0x21, 0x20, 0x19, 0x4b, 0xa8, 0x41, 0x61, 0x60, 0xee, 0xa1,
-	0x06, 0x85, 0xc1, 0x69, 0x86, 0x71, 0xbb, 0x5f, 0x5b, 0x23, 0xab, 0x08, 0x18, 0xe4, 0xc9, 0xc1,
-	0xe5, 0x96, 0xef, 0x75, 0x64, 0xb6, 0x4e, 0x19, 0xf3, 0x12, 0x5b, 0x88, 0x73, 0x4d, 0x5b, 0xdb,
-	0xfa, 0x63, 0x09, 0xca, 0x35, 0xcc, 0xdc, 0xa3, 0x44, 0xfb, 0xe7, 0x1a, 0x4c, 0x62, 0xf7, 0x8f,
-	0x28, 0x8d, 0x5f, 0xb9, 0x13, 0x59, 0x3d, 0x93, 0x01, 0x11, 0xac, 0xb9, 0xfd, 0xda, 0x5b, 0xe4,
-	0x7e, 0xe4, 0x51, 0x03, 0xfd, 0xa0, 0x4d, 0xc3, 0x36, 0xf5, 0x99, 0xe5, 0x23, 0x8b, 0x6f, 0x8b,
-	0xc4, 0xe8, 0x1d, 0x1e, 0xbe, 0x92, 0xc0, 0xc5, 0x82, 0xff, 0xbe, 0x1d, 0xd8, 0xcc, 0xbe, 0x03,
-	0xaf, 0xe7, 0x37, 0xe9, 0xdb, 0x49, 0x0b, 0xf3, 0x5a, 0xcf, 0x77, 0xde, 0x91, 0x43, 0x5b, 0xd3,
-	0x74, 0x2d, 0x8f, 0x29, 0xf6, 0x37, 0x1a, 0x94, 0x95, 0x8e, 0xa0, 0x9a, 0x45, 0x67, 0x75, 0x17,
-	0xd5, 0xba, 0x20, 0xb3, 0x9d, 0x68, 0x74, 0xfb, 0xb5, 0xfb, 0xe4, 0x1e, 0x7b, 0xea, 0x98, 0x20,
-	0xf3, 0xfe, 0xdd, 0xba, 0x6e, 0xd9, 0xad, 0x16, 0x65, 0x6f, 0x95, 0x2f, 0xb7, 0xcd, 0x40, 0x5a,
-	0x52, 0x65, 0xe1, 0xf5, 0x90, 0x04, 0xe6, 0x67, 0xc8, 0xd9, 0x30, 0x3b, 0x23, 0xb8, 0xc6, 0xd7,
-	0xc9, 0xcf, 0x34, 0x98, 0x4f, 0x37, 0x07, 0xd5, 0x18, 0x3d, 0xa4, 0x35, 0xa9, 0xc6, 0xe8, 0x61,
-	0xfd, 0x45, 0xe3, 0x25, 0x8c, 0xd1, 0xe2, 0xe9, 0x4a, 0x42, 0xe9, 0x61, 0xdb, 0x0c, 0xd1, 0x70,
-	0x76, 0x29, 0xbf, 0x00, 0x6a, 0xc9, 0x16, 0x23, 0x33, 0xc9, 0x14, 0xfe, 0xe3, 0x38, 0xbd, 0xc1,
-	0xd9, 0x58, 0x46, 0x7a, 0x23, 0xb5, 0x20, 0xb2, 0xd2, 0x1b, 0xb9, 0xad, 0x60, 0xdc, 0xed, 0xd7,
-	0x9e, 0x21, 0x4f, 0x8b, 0x40, 0xc7, 0x48, 0x8d, 0xac, 0xe3, 0x40, 0x36, 0x07, 0xce, 0xa4, 0xb6,
-	0xc6, 0x1c, 0x9e, 0xd4, 0x62, 0xcc, 0xca, 0xed, 0xe5, 0x46, 0x5a, 0x56, 0x6e, 0xaf, 0x34, 0x8f,
-	0x98, 0xc3, 0x5b, 0x20, 0xe4, 0x86, 0x19, 0x36, 0xdb, 0xba, 0xc5, 0xdd, 0x0e, 0xd2, 0x92, 0xf3,
-	0xfa, 0x98, 0xf8, 0xff, 0x45, 0x05, 0xcd, 0xa0, 0x66, 0x06, 0xfa, 0x98, 0x59, 0x05, 0x8d, 0xa2,
-	0x19, 0x5e, 0x55, 0x70, 0xca, 0x1d, 0xe9, 0xd6, 0xd2, 0x65, 0x4d, 0x4c, 0xbd, 0x05, 0x53, 0xc2,
-	0x96, 0x49, 0x35, 0xc3, 0xc0, 0x23, 0xba, 0x4b, 0x99, 0x30, 0x41, 0xd4, 0x40, 0xff, 0x1a, 0x9b,
-	0x7d, 0x4c, 0x0b, 0xc8, 0x74, 0x44, 0x8b, 0xf4, 0x92, 0x42, 0x9c, 0x2b, 0x39, 0xb3, 0x6c, 0x55,
-	0xd4, 0x7c, 0xfe, 0x10, 0x0c, 0x41, 0xf9, 0x5c, 0xbf, 0x56, 0x22, 0xc5, 0x88, 0xb2, 0x52, 0x34,
-	0xe3, 0x02, 0xb3, 0xbb, 0x19, 0xb9, 0x61, 0xa6, 0x86, 0x93, 0x8c, 0x9e, 0x9c, 0x1a, 0x4e, 0xb2,
-	0x7a, 0x6d, 0xcc, 0x8f, 0x5e, 0x25, 0x57, 0x18, 0x28, 0xa9, 0x83, 0x79, 0x8e, 0x6d, 0x8a, 0x42,
-	0x8e, 0x3a, 0x9e, 0xbb, 0x87, 0xcf, 0x19, 0x31, 0xe4, 0xa4, 0x9f, 0x21, 0x5c, 0x63, 0x8b, 0x4c,
-	0xf9, 0xdf, 0xd6, 0x60, 0x56, 0xed, 0x9c, 0xa9, 0xd9, 0x47, 0x66, 0x83, 0x4e, 0xcd, 0xb4, 0xb2,
-	0x1b, 0x6f, 0xc6, 0x13, 0x58, 0xd2, 0x71, 0x60, 0x92, 0xa0, 0x26, 0xcc, 0xac, 0x0d, 0x30, 0xb3,
-	0xf5, 0xf7, 0xa8, 0xad, 0x15, 0xf9, 0xf4, 0xaf, 0x6b, 0x30, 0x23, 0xf7, 0xd6, 0x48, 0x46, 0x02,
-	0xaa, 0x74, 0xe9, 0x54, 0xdd, 0x65, 0xb5, 0xe5, 0x8c, 0x0d, 0x34, 0x15, 0xf1, 0x72, 0x79, 0x8f,
-	0x0b, 0x19, 0x5a, 0x34, 0x30, 0x0f, 0xf1, 0xcc, 0x5e, 0xd8, 0xde, 0xda, 0xe4, 0x00, 0xa6, 0xa1,
-	0x77, 0x61, 0x92, 0xf7, 0x7d, 0x94, 0xb0, 0x22, 0x77, 0xdf, 0xd4, 0xb0, 0xa2, 0xb4, 0xac, 0x0c,
-	0xbd, 0x5f, 0xcb, 0xed, 0xfe, 0x87, 0xec, 0x97, 0x04, 0x01, 0xec, 0x1f, 0x5d, 0xd3, 0xd6, 0x6e,
-	0xe4, 0xff, 0x2b, 0xd7, 0xdd, 0xdd, 0x2d, 0xe0, 0xd8, 0xf8, 0xc9, 0x7f, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xa9, 0x61, 0x9c, 0x7e, 0xbe, 0x35, 0x00, 0x00,
+	// 3872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xd5, 0xdf, 0x92, 0x12, 0x25, 0x1e, 0x8a, 0x92, 0x3c, 0x92, 0x65, 0x7a, 0x75, 0x5b, 0x6f, 0x0c,
+	0x5b, 0x96, 0x65, 0x29, 0x51, 0x1c, 0x24, 0x70, 0xbe, 0x24, 0xa0, 0xe5, 0xc4, 0x56, 0x12, 0x3b,
+	0x01, 0x1d, 0x27, 0xc0, 0x97, 0x2f, 0xe1, 0xb7, 0xe2, 0x0e, 0xc5, 0x8d, 0x97, 0xbb, 0xf4, 0xee,
+	0x52, 0x8a, 0xbf, 0x2b, 0xbe, 0xa0, 0x29, 0x7a, 0x41, 0xd0, 0x94, 0x45, 0x8a, 0x5e, 0x9e, 0x5a,
+	0xa0, 0x7d, 0x08, 0xfa, 0x10, 0xf4, 0xa5, 0xe8, 0x63, 0x5f, 0x0a, 0x14, 0xe8, 0x43, 0x2f, 0xff,
+	0xa0, 0x2d, 0xd0, 0x1f, 0xd0, 0x3f, 0x50, 0xcc, 0x65, 0x77, 0x67, 0x96, 0xbb, 0xbc, 0x58, 0x49,
+	0x80, 0x02, 0x7d, 0x12, 0x77, 0xce, 0x99, 0x39, 0xd7, 0x39, 0xe7, 0xcc, 0x39, 0x82, 0xb2, 0xd1,
+	0x68, 0xb8, 0x5d, 0x27, 0xd8, 0xee, 0x78, 0x6e, 0xe0, 0x22, 0x70, 0x3b, 0xd8, 0xe9, 0x58, 0x81,
+	0x67, 0xbd, 0xaf, 0xae, 0x1d, 0xba, 0xee, 0xa1, 0x8d, 0x77, 0x28, 0xe4, 0xa0, 0xdb, 0xdc, 0x39,
+	0xf6, 0x8c, 0x4e, 0x07, 0x7b, 0x3e, 0xc3, 0x55, 0xd7, 0x93, 0xf0, 0xc0, 0x6a, 0x63, 0x3f, 0x30,
+	0xda, 0x1d, 0x8e, 0xb0, 0xc2, 0x11, 0x8c, 0x8e, 0xb5, 0x63, 0x38, 0x8e, 0x1b, 0x18, 0x81, 0xe5,
+	0x3a, 0xe1, 0xf6, 0x2d, 0xfa, 0xa7, 0x71, 0xe5, 0x10, 0x3b, 0x57, 0xfc, 0x63, 0xe3, 0xf0, 0x10,
+	0x7b, 0x3b, 0x6e, 0x87, 0x62, 0xf4, 0x63, 0xeb, 0xdf, 0x9f, 0x80, 0x89, 0x7b, 0x3e, 0xf6, 0xd0,
+	0x53, 0x30, 0xd5, 0xf5, 0xb1, 0x57, 0xb7, 0xcc, 0x8a, 0xa2, 0x29, 0x1b, 0xa5, 0xdd, 0x95, 0x6d,
+	0x46, 0x66, 0x3b, 0xe4, 0x63, 0xfb, 0x6e, 0xe0, 0x59, 0xce, 0xe1, 0x9b, 0x86, 0xdd, 0xc5, 0xb5,
+	0x02, 0x41, 0xde, 0x37, 0xd1, 0x33, 0x30, 0x4d, 0x7e, 0x39, 0x46, 0x1b, 0x57, 0x72, 0x23, 0xec,
+	0x8b, 0xb0, 0xd1, 0x2e, 0x4c, 0xe2, 0xb6, 0x61, 0xd9, 0x95, 0xfc, 0x08, 0xdb, 0x18, 0x2a, 0x7a,
+	0x01, 0x66, 0x3a, 0x2d, 0xd7, 0xc1, 0x75, 0xa7, 0xdb, 0x3e, 0xc0, 0x5e, 0x65, 0x62, 0x84, 0xad,
+	0x25, 0xba, 0xe3, 0x0e, 0xdd, 0x80, 0x9e, 0x87, 0x92, 0x89, 0xfd, 0x86, 0x67, 0x51, 0x85, 0x54,
+	0x26, 0x47, 0xd9, 0x2f, 0x6c, 0x40, 0x57, 0xa1, 0xe0, 0x07, 0x46, 0xd0, 0xf5, 0x2b, 0x85, 0x51,
+	0x94, 0xc4, 0x70, 0xd1, 0xb3, 0x50, 0x6a, 0x78, 0xd8, 0x08, 0x70, 0x9d, 0x98, 0xb2, 0x32, 0x45,
+	0xb7, 0xaa, 0x7d, 0x5b, 0xdf, 0x08, 0xed, 0x5c, 0x03, 0x86, 0x4e, 0x16, 0xc8, 0xe6, 0x6e, 0xc7,
+	0x8c, 0x36, 0x4f, 0x0f, 0xdf, 0xcc, 0xd0, 0xc3, 0xcd, 0x8c, 0x07, 0xb6, 0xb9, 0x38, 0x7c, 0x33,
+	0x43, 0x27, 0x0b, 0xfa, 0xb7, 0x14, 0x00, 0xe2, 0x1b, 0x37, 0x70, 0x40, 0x94, 0x7f, 0x1e, 0x26,
+	0x88, 0xf1, 0xb8, 0x7b, 0xcc, 0x6f, 0xc7, 0x2e, 0xbd, 0x4d, 0xb0, 0x6a, 0x14, 0x8a, 0x2e, 0xc3,
+	0xb4, 0xe7, 0xda, 0xb8, 0xee, 0xe3, 0xa0, 0x92, 0xd3, 0xf2, 0x49, 0xcc, 0x9a, 0x6b, 0xe3, 0xda,
+	0x14, 0xc1, 0xb8, 0x8b, 0x03, 0xb4, 0x0d, 0xc5, 0x43, 0xcf, 0xed, 0x76, 0x28, 0x76, 0x9e, 0x62,
+	0x9f, 0x12, 0xb1, 0x6f, 0x12, 0x60, 0x6d, 0x9a, 0xe2, 0xdc, 0xc5, 0x81, 0xfe, 0xe3, 0x09, 0x98,
+	0xa4, 0x6b, 0xe8, 0x06, 0xcc, 0x75, 0x0c, 0x0f, 0x3b, 0x41, 0x9d, 0x1d, 0x30, 0xa2, 0xdb, 0x96,
+	0xd9, 0x26, 0x7a, 0xc6, 0xbe, 0x89, 0x9e, 0x86, 0xe9, 0x68, 0xfb, 0x28, 0xde, 0x3b, 0x75, 0xc8,
+	0x37, 0x3e, 0x0b, 0xc0, 0x36, 0x76, 0x8c, 0xa0, 0x35, 0x92, 0x07, 0x33, 0x41, 0x5f, 0x37, 0x82,
+	0x16, 0x7a, 0x1c, 0x26, 0xe8, 0x7d, 0x19, 0xc5, 0x7b, 0x29, 0xa6, 0xe0, 0x76, 0x93, 0x63, 0xb8,
+	0x5d, 0xc2, 0xd9, 0x0b, 0xe3, 0x3a, 0xfb, 0x3f, 0xa8, 0xdb, 0x36, 0xa0, 0x44, 0xed, 0xcb, 0xdd,
+	0xf6, 0x22, 0x4c, 0x52, 0xd5, 0x73, 0xff, 0x48, 0xf1, 0x2f, 0x06, 0x27, 0x9e, 0x4b, 0x23, 0x60,
+	0x86, 0xe7, 0x52, 0x1f, 0xa7, 0x31, 0x92, 0x78, 0xe2, 0xef, 0xf2, 0xb0, 0x78, 0x83, 0xea, 0xea,
+	0x00, 0x13, 0x88, 0x5f, 0xc3, 0x0f, 0xba, 0xd8, 0x0f, 0xd0, 0x73, 0x50, 0xf2, 0xb1, 0xe1, 0x35,
+	0x5a, 0xf5, 0x63, 0xd7, 0x1b, 0xcd, 0x29, 0x81, 0x6d, 0x78, 0xcb, 0xf5, 0xa8, 0x47, 0xfa, 0xae,
+	0x17, 0xd4, 0xef, 0xe3, 0x87, 0xa3, 0x79, 0x24, 0xc1, 0x7e, 0x05, 0x3f, 0x44, 0x57, 0x61, 0xca,
+	0xc3, 0x47, 0xd8, 0xf3, 0x71, 0x25, 0x9f, 0xa1, 0xae, 0xeb, 0xae, 0x6b, 0xf3, 0x5d, 0x1c, 0x15,
+	0x2d, 0xc2, 0xa4, 0x6d, 0xb5, 0xad, 0x80, 0xfa, 0x62, 0xb9, 0xc6, 0x3e, 0xd0, 0x12, 0x14, 0xdc,
+	0x66, 0x93, 0xe8, 0x61, 0x92, 0x2e, 0xf3, 0x2f, 0xa4, 0x43, 0xd9, 0x73, 0x5d, 0xe1, 0xca, 0x15,
+	0xb4, 0xfc, 0x46, 0xb1, 0x56, 0x22, 0x8b, 0xe1, 0x95, 0x3a, 0x2b, 0x5c, 0xa9, 0x29, 0x0a, 0x8e,
+	0x2e, 0xcd, 0x99, 0x38, 0xc5, 0x4c, 0x53, 0x48, 0x98, 0x44, 0x96, 0x22, 0xf7, 0x2e, 0xb2, 0x75,
+	0xf6, 0x45, 0x36, 0xd0, 0x58, 0x62, 0x99, 0x15, 0x60, 0x00, 0xf2, 0xb9, 0x6f, 0x22, 0x55, 0xc8,
+	0x3a, 0x25, 0x0a, 0x89, 0xbe, 0x89, 0x48, 0x2c, 0xaf, 0xcc, 0x50, 0x00, 0xfb, 0x40, 0xe7, 0x12,
+	0x99, 0xa3, 0xcc, 0x38, 0x17, 0x72, 0x83, 0x8e, 0xe1, 0x74, 0xc2, 0xa2, 0x7e, 0xc7, 0x75, 0x7c,
+	0x8c, 0xd6, 0xa1, 0x14, 0xb8, 0x81, 0x61, 0xd7, 0x69, 0x46, 0xa7, 0x26, 0x2d, 0xd7, 0x80, 0x2e,
+	0xed, 0x91, 0x95, 0xf1, 0x3c, 0xe7, 0x7f, 0x61, 0x59, 0x22, 0xc3, 0xdc, 0x74, 0x74, 0x62, 0xcf,
+	0xc3, 0x1c, 0x25, 0x66, 0xd2, 0x7d, 0x02, 0xcd, 0xa5, 0x24, 0x4d, 0x7e, 0x72, 0xb9, 0x1b, 0xfd,
+	0x26, 0xf4, 0xff, 0x96, 0x83, 0x53, 0xb7, 0x5d, 0xd3, 0x6a, 0x3e, 0xa4, 0x7c, 0x71, 0xb7, 0x7d,
+	0xc4, 0xf4, 0x1f, 0x25, 0xf1, 0xdc, 0xe8, 0x49, 0x5c, 0x2c, 0x19, 0xf2, 0x63, 0x95, 0x0c, 0x89,
+	0x80, 0x36, 0x31, 0x6e, 0x40, 0x7b, 0x06, 0xa6, 0x3b, 0x86, 0xef, 0xd3, 0x8b, 0x39, 0x4a, 0x20,
+	0x8d, 0xb0, 0xfb, 0x0a, 0x8f, 0xc2, 0x98, 0x85, 0x87, 0xfe, 0x0a, 0x20, 0x51, 0xe9, 0xdc, 0xd8,
+	0x8f, 0xa6, 0x75, 0xfd, 0x0a, 0xa0, 0x1b, 0xd8, 0xc6, 0x81, 0x1c, 0x79, 0xce, 0x88, 0x87, 0x09,
+	0xd7, 0x4b, 0xdf, 0x86, 0x05, 0x09, 0x9d, 0x13, 0xcf, 0xc4, 0xff, 0x48, 0x01, 0x75, 0x8f, 0x46,
+	0xf2, 0xd7, 0xb9, 0xfc, 0x35, 0xec, 0xe3, 0xe0, 0x84, 0xae, 0x22, 0x2a, 0x3f, 0x37, 0x8e, 0xf2,
+	0x09, 0x3f, 0xcb, 0xa9, 0xfc, 0x9c, 0x48, 0x8b, 0x24, 0xd4, 0x7a, 0xe4, 0x9c, 0x91, 0x93, 0x3f,
+	0xc5, 0xde, 0x37, 0xf5, 0x6f, 0x2b, 0x70, 0x7a, 0xaf, 0x65, 0x38, 0x87, 0x02, 0x3f, 0x4c, 0x35,
+	0x2f, 0xc0, 0x8c, 0x83, 0x8f, 0xeb, 0x91, 0x9c, 0xa3, 0xb0, 0x53, 0x72, 0xf0, 0x71, 0x78, 0xce,
+	0xa3, 0xf3, 0xf4, 0x1a, 0x2c, 0x25, 0x59, 0x3a, 0x99, 0x8f, 0x5d, 0x85, 0x33, 0x37, 0x71, 0x90,
+	0xea, 0x00, 0x67, 0x05, 0x26, 0xc9, 0x91, 0xc5, 0x98, 0x8d, 0x3b, 0x50, 0xe9, 0xdf, 0xc5, 0x19,
+	0xc9, 0xde, 0x26, 0xba, 0x62, 0x8e, 0x42, 0x42, 0x2e, 0x7e, 0x99, 0x83, 0x53, 0xcc, 0xf4, 0x62,
+	0xb0, 0x8a, 0xa2, 0x8e, 0xf2, 0xe8, 0x4f, 0x87, 0xdc, 0xb8, 0x4f, 0x07, 0xd1, 0x7f, 0xf3, 0x63,
+	0x05, 0x8f, 0xa7, 0xe2, 0x34, 0x36, 0x4a, 0xc8, 0x0a, 0x93, 0xdc, 0x09, 0xdf, 0x2a, 0x24, 0xe4,
+	0x88, 0xaa, 0x3b, 0x99, 0x3b, 0xbc, 0x06, 0xcb, 0x6f, 0x1a, 0xb6, 0x65, 0xf2, 0xe3, 0x92, 0x8e,
+	0xbf, 0x28, 0x5a, 0x24, 0x4a, 0xba, 0x6a, 0xe2, 0xca, 0x17, 0x85, 0x4b, 0xfd, 0xaf, 0xb0, 0x92,
+	0x7e, 0x20, 0xe7, 0x73, 0x05, 0x8a, 0x47, 0x1c, 0xce, 0x38, 0x9d, 0xae, 0xc5, 0x0b, 0xfa, 0x6f,
+	0x95, 0x50, 0x38, 0x56, 0xc2, 0x71, 0x36, 0x3e, 0x9f, 0x57, 0x41, 0x58, 0x9f, 0xe7, 0x46, 0xae,
+	0xcf, 0x13, 0xa6, 0xca, 0x8f, 0x6b, 0xaa, 0x3b, 0xb0, 0x20, 0x49, 0xc3, 0x75, 0x20, 0x3e, 0x4f,
+	0x94, 0x31, 0x9e, 0x27, 0xfa, 0x67, 0xf9, 0xb8, 0x96, 0xa1, 0x47, 0xfe, 0xb3, 0x3c, 0xe5, 0xb6,
+	0xbd, 0xd0, 0xef, 0x21, 0xac, 0x4a, 0x4d, 0xf8, 0x80, 0x58, 0xc6, 0x4e, 0xcb, 0x65, 0xec, 0xaa,
+	0xf4, 0xf6, 0x63, 0x15, 0xab, 0xf0, 0xba, 0x8b, 0xc0, 0xd4, 0x87, 0x40, 0x00, 0xdf, 0x21, 0xae,
+	0x12, 0xd7, 0xba, 0x25, 0xb1, 0xd6, 0xd5, 0x2d, 0x58, 0x4a, 0x5a, 0x6c, 0xd4, 0x8a, 0x50, 0x7a,
+	0x45, 0xe7, 0x86, 0xbf, 0xa2, 0x3f, 0x50, 0x60, 0x45, 0xa6, 0x35, 0x6e, 0x0d, 0x5a, 0x85, 0x79,
+	0x46, 0xb1, 0xaf, 0x08, 0x3d, 0xd3, 0x47, 0x98, 0x9f, 0x3d, 0x7b, 0x18, 0x7f, 0x10, 0x26, 0x3e,
+	0xcc, 0x85, 0x15, 0x91, 0x74, 0x83, 0x1f, 0xd5, 0xe5, 0xbf, 0xfc, 0x4b, 0x9b, 0x16, 0x6c, 0x26,
+	0xc6, 0x0e, 0x36, 0xe4, 0xea, 0x4b, 0x6a, 0x38, 0xe9, 0xd5, 0x7f, 0x3c, 0x2c, 0xf6, 0xe4, 0x7b,
+	0x7f, 0x56, 0x3a, 0x4f, 0xf4, 0x67, 0xfd, 0x09, 0x58, 0x94, 0x77, 0xc4, 0xf9, 0x3a, 0x6b, 0xcb,
+	0x4b, 0x30, 0xff, 0xb2, 0x6b, 0x39, 0x92, 0xe5, 0xb2, 0xd1, 0xe5, 0xf4, 0x2e, 0x56, 0x9a, 0x37,
+	0xe1, 0x94, 0x70, 0xce, 0x50, 0xba, 0x03, 0x0f, 0x7a, 0x15, 0x1b, 0x47, 0xf8, 0xc4, 0x1c, 0xdd,
+	0x02, 0x24, 0x1e, 0x74, 0x02, 0x96, 0x7e, 0x90, 0x87, 0x89, 0x9a, 0x6b, 0x63, 0xf1, 0x15, 0xcb,
+	0xb2, 0x63, 0x98, 0xe0, 0x97, 0xa1, 0x48, 0x01, 0x91, 0xdf, 0x16, 0x6b, 0xb4, 0x77, 0x46, 0xe3,
+	0x84, 0xd6, 0xef, 0x9d, 0x45, 0xd9, 0xff, 0x96, 0xa0, 0xd0, 0x71, 0xbd, 0xc0, 0xb0, 0xa9, 0xdb,
+	0x15, 0x6b, 0xfc, 0x8b, 0x04, 0x4d, 0xf7, 0xd8, 0xc1, 0x1e, 0x8d, 0x8e, 0xc5, 0x1a, 0xfb, 0x20,
+	0x61, 0x89, 0xfe, 0x60, 0x51, 0xab, 0x40, 0x41, 0x45, 0xba, 0x42, 0xa3, 0x56, 0x1c, 0x96, 0xa6,
+	0xd8, 0x61, 0xec, 0x0b, 0xad, 0x01, 0x34, 0x5c, 0x27, 0xf0, 0x5c, 0xdb, 0xc6, 0x1e, 0xed, 0xe2,
+	0x14, 0x6b, 0xc2, 0x4a, 0xb2, 0x47, 0x54, 0x3c, 0x49, 0x8f, 0x08, 0x4e, 0xd2, 0x23, 0x2a, 0x8d,
+	0xd5, 0x23, 0xb2, 0x21, 0x5f, 0xed, 0x58, 0xe8, 0x34, 0x14, 0x8c, 0x8e, 0x15, 0x5b, 0x66, 0xd2,
+	0xe8, 0x58, 0x2c, 0xc2, 0x93, 0xe5, 0x36, 0x0e, 0x5a, 0x6e, 0x58, 0xb9, 0x14, 0x8d, 0x8e, 0x75,
+	0x9b, 0x2e, 0x10, 0x70, 0xd7, 0xb3, 0x43, 0x30, 0xb3, 0x4c, 0xb1, 0xeb, 0xd9, 0x1c, 0x3c, 0x0f,
+	0xf9, 0xae, 0x17, 0x1a, 0x85, 0xfc, 0xd4, 0x3f, 0x56, 0x60, 0xa6, 0xda, 0x20, 0x46, 0xbb, 0xde,
+	0x75, 0x4c, 0x1b, 0xa3, 0x0d, 0x98, 0x37, 0xe8, 0x77, 0xfd, 0x80, 0x2e, 0xc4, 0x1c, 0xcc, 0x1a,
+	0x02, 0xde, 0xbe, 0x89, 0xb6, 0x00, 0xc9, 0x98, 0x82, 0xb3, 0xcc, 0x8b, 0xb8, 0xd4, 0x69, 0x36,
+	0x60, 0x8a, 0x30, 0x1e, 0x77, 0x53, 0xe7, 0xc4, 0x70, 0x5c, 0xed, 0x58, 0x35, 0x22, 0x2f, 0x09,
+	0xbf, 0xbf, 0x57, 0x60, 0xea, 0x25, 0x6c, 0x04, 0x5d, 0x0f, 0x13, 0x79, 0x9a, 0xec, 0x67, 0xcc,
+	0x47, 0x91, 0xaf, 0xec, 0x9b, 0xa4, 0x75, 0x12, 0x82, 0x05, 0xe2, 0x25, 0xbe, 0x46, 0xe9, 0xde,
+	0x80, 0x53, 0x32, 0x97, 0x31, 0x07, 0x15, 0x89, 0x03, 0x81, 0xe1, 0xda, 0x9c, 0xc8, 0x3e, 0xe9,
+	0x06, 0x3f, 0x0f, 0x2b, 0x8d, 0x16, 0x6e, 0xdc, 0xc7, 0x66, 0x3d, 0xa9, 0x1d, 0x7a, 0xe0, 0x04,
+	0xbd, 0x5f, 0x15, 0x8e, 0x53, 0x95, 0x14, 0x45, 0x64, 0xfa, 0x95, 0x02, 0x70, 0xdb, 0x35, 0xbb,
+	0x36, 0x7e, 0xd1, 0xc6, 0x6d, 0x72, 0xbd, 0xda, 0xf4, 0x2b, 0x96, 0x6a, 0x9a, 0x2d, 0xec, 0x9b,
+	0x24, 0xc5, 0x71, 0xa0, 0x20, 0x13, 0xb0, 0xa5, 0x3b, 0xac, 0xe5, 0x1a, 0x4a, 0x28, 0x08, 0xb3,
+	0x20, 0x0a, 0xc3, 0xd5, 0x57, 0x0b, 0x95, 0x47, 0x44, 0x58, 0x05, 0x30, 0x8d, 0xc0, 0xa8, 0xdb,
+	0xf8, 0x08, 0x87, 0x2e, 0x50, 0x24, 0x2b, 0xaf, 0x92, 0x05, 0xa4, 0xc1, 0x8c, 0xe5, 0xd7, 0xa9,
+	0x00, 0x75, 0xc3, 0xb6, 0xe9, 0x0d, 0x9d, 0xae, 0x81, 0xe5, 0xef, 0x91, 0xa5, 0xaa, 0x6d, 0xeb,
+	0xb7, 0xa0, 0xc0, 0x44, 0x20, 0x7d, 0x1e, 0xce, 0x21, 0xb6, 0x71, 0x9b, 0x32, 0xa1, 0xf4, 0xf7,
+	0x79, 0x62, 0x79, 0x6b, 0xe5, 0x76, 0xf4, 0x9b, 0x68, 0xe3, 0x18, 0x66, 0xf6, 0x0c, 0xe7, 0x86,
+	0x9b, 0xda, 0x1e, 0x10, 0xde, 0x58, 0xa1, 0xbf, 0xe6, 0x22, 0x7f, 0x1d, 0xe6, 0xe0, 0xf2, 0xf5,
+	0x98, 0x48, 0x5c, 0x0f, 0xbd, 0x05, 0x65, 0x4e, 0x38, 0xad, 0xd1, 0x20, 0x52, 0x5e, 0x87, 0x92,
+	0xd1, 0x68, 0x60, 0xdf, 0x67, 0x41, 0x89, 0x1b, 0x81, 0x2d, 0x85, 0xb5, 0x94, 0x10, 0xb4, 0xf2,
+	0x89, 0xa0, 0xa5, 0xef, 0xc0, 0xe2, 0x4d, 0x1c, 0x90, 0x20, 0xcb, 0xd4, 0x20, 0x88, 0x9a, 0x1a,
+	0x71, 0xf5, 0x7f, 0x87, 0xd3, 0x89, 0x0d, 0x31, 0x8b, 0xe9, 0x31, 0x7a, 0x13, 0x0a, 0x4c, 0xad,
+	0xbc, 0xb0, 0x40, 0xfd, 0xca, 0xaf, 0x71, 0x0c, 0xfd, 0x5d, 0x38, 0xc3, 0x52, 0xf9, 0xe8, 0x1c,
+	0x8d, 0x75, 0xfe, 0x93, 0x50, 0xe9, 0x3f, 0x7f, 0x88, 0x00, 0xfa, 0x7b, 0xe1, 0x03, 0xba, 0xe6,
+	0xc6, 0xec, 0x48, 0x99, 0x47, 0x19, 0x9c, 0x79, 0x72, 0x83, 0x32, 0x4f, 0x5e, 0xcc, 0x3c, 0xa4,
+	0x2f, 0x25, 0xd2, 0x1a, 0xc6, 0x5a, 0xd4, 0xc6, 0x22, 0xe8, 0x7e, 0xaa, 0xaa, 0x84, 0xa6, 0x6f,
+	0xdc, 0xc6, 0xe2, 0xe8, 0x69, 0xc7, 0x8b, 0xf8, 0xf7, 0xc3, 0x3e, 0xa7, 0x28, 0xf9, 0x17, 0x94,
+	0x8c, 0x89, 0x2c, 0x22, 0xb1, 0x61, 0xa2, 0x5f, 0x82, 0x59, 0xee, 0x88, 0x43, 0x7d, 0xf6, 0x69,
+	0x98, 0x8b, 0x50, 0xf9, 0xb1, 0xe7, 0x61, 0x82, 0x00, 0xd3, 0x26, 0x71, 0x14, 0x8f, 0x42, 0xf5,
+	0x4f, 0x73, 0xf1, 0x88, 0x42, 0xd2, 0xf0, 0x7a, 0xff, 0x1b, 0xb0, 0x28, 0xbd, 0xf2, 0xce, 0x26,
+	0x5e, 0x79, 0xc5, 0xf8, 0x1d, 0x57, 0x91, 0xdf, 0x71, 0xd3, 0xf1, 0x5b, 0x2d, 0x7e, 0x95, 0x4d,
+	0x48, 0xaf, 0xb2, 0xe8, 0x0d, 0x37, 0x29, 0xbe, 0xe1, 0x04, 0x71, 0x0b, 0x5a, 0x3e, 0xcb, 0x0e,
+	0xec, 0x69, 0x16, 0xdb, 0x21, 0x76, 0x3c, 0x3e, 0x40, 0x60, 0x5f, 0x99, 0x03, 0x84, 0xb4, 0x3c,
+	0xcb, 0x5e, 0x64, 0x89, 0x3c, 0x2b, 0x36, 0xff, 0x65, 0xf7, 0x1a, 0xa5, 0xf9, 0x3f, 0xf2, 0xc0,
+	0x53, 0xbf, 0x09, 0x0b, 0xd7, 0x2d, 0xc7, 0xa4, 0x1d, 0x19, 0xd9, 0xf8, 0xa9, 0xad, 0x58, 0x51,
+	0x4d, 0x39, 0xc9, 0xb9, 0x6f, 0xc1, 0xa2, 0x7c, 0xd0, 0x90, 0xa6, 0x6e, 0xf6, 0x49, 0xfb, 0x70,
+	0xfa, 0x9e, 0x73, 0xf0, 0xb9, 0x30, 0xf5, 0x32, 0x2c, 0x25, 0x8f, 0x7a, 0x64, 0xb6, 0xb6, 0xc3,
+	0x96, 0xc8, 0x9e, 0x6d, 0x61, 0x27, 0x18, 0x96, 0xc5, 0xf4, 0x07, 0xb0, 0x28, 0xe3, 0x0f, 0x4b,
+	0x3e, 0xcb, 0x50, 0x6c, 0x50, 0xd4, 0xb8, 0xeb, 0x38, 0xcd, 0x16, 0xf6, 0x4d, 0xf4, 0x18, 0x94,
+	0x39, 0xd0, 0xc7, 0x0d, 0x8f, 0xe6, 0x7f, 0x82, 0x30, 0xc3, 0x16, 0xef, 0xd2, 0x35, 0xfd, 0x4f,
+	0x0a, 0xcc, 0xbc, 0xe1, 0xde, 0xc7, 0x4e, 0xc8, 0x1c, 0x7d, 0xfa, 0x1b, 0x4e, 0x50, 0x0f, 0x1e,
+	0x76, 0xc2, 0xb8, 0x5a, 0xa4, 0x2b, 0x6f, 0x3c, 0xec, 0xe0, 0x93, 0x53, 0x24, 0x77, 0xc9, 0x6f,
+	0xb8, 0x1d, 0xcc, 0x93, 0x2e, 0xfb, 0x90, 0xa6, 0x61, 0xac, 0xe6, 0x8f, 0xbe, 0xa5, 0x16, 0x5c,
+	0x41, 0x6e, 0xc1, 0x11, 0x92, 0x1e, 0x6e, 0x7a, 0xd8, 0x6f, 0xd5, 0x03, 0x22, 0x06, 0x2f, 0xfd,
+	0x67, 0xf8, 0x22, 0x15, 0x4d, 0xff, 0x4c, 0x81, 0x32, 0x17, 0x92, 0x6b, 0x74, 0x15, 0x80, 0xa2,
+	0x4b, 0x52, 0xd2, 0x15, 0x2a, 0xe5, 0x2a, 0x00, 0x7e, 0xbf, 0x63, 0x79, 0xd8, 0xaf, 0x5b, 0x2c,
+	0x7b, 0x4c, 0xd6, 0x8a, 0x7c, 0x65, 0xdf, 0x21, 0xd5, 0x24, 0xcf, 0xf9, 0x8c, 0x26, 0x8f, 0xa5,
+	0x6c, 0x8d, 0x12, 0xea, 0xe7, 0x6b, 0xa2, 0x9f, 0x2f, 0x12, 0xa3, 0x2c, 0x93, 0xc3, 0x99, 0xd0,
+	0x53, 0x96, 0xc9, 0x58, 0x7e, 0x00, 0xa8, 0x86, 0x8f, 0xdc, 0xfb, 0x58, 0x32, 0xce, 0x22, 0x4c,
+	0x32, 0x6c, 0x5e, 0xea, 0xd3, 0x0f, 0xd2, 0x0f, 0x8a, 0x85, 0xa9, 0xb7, 0x2c, 0x27, 0xe0, 0x96,
+	0x29, 0x47, 0x12, 0xdd, 0xb2, 0x1c, 0x6a, 0x5a, 0x1f, 0xfb, 0x3e, 0x09, 0x25, 0x56, 0x54, 0x12,
+	0xf1, 0x95, 0x7d, 0x53, 0xbf, 0x0d, 0x0b, 0x12, 0xc9, 0x61, 0xce, 0x27, 0x1f, 0x97, 0x4b, 0x1e,
+	0xf7, 0xd5, 0x1c, 0x4c, 0xdd, 0x65, 0x5f, 0x09, 0x54, 0x25, 0x81, 0x3a, 0xd8, 0xa9, 0x04, 0xfa,
+	0x79, 0x89, 0x7e, 0xba, 0x23, 0x2d, 0x49, 0xff, 0x66, 0x50, 0xcc, 0xfa, 0xff, 0x95, 0xc2, 0xb8,
+	0x8f, 0x3c, 0x66, 0xfd, 0x91, 0xff, 0x8b, 0x80, 0xa1, 0x93, 0x05, 0xfd, 0x3f, 0xe0, 0x4c, 0x18,
+	0x96, 0xb9, 0x3e, 0x86, 0x8e, 0xbb, 0xe2, 0x84, 0x93, 0x4b, 0x6f, 0x1a, 0xe6, 0xc5, 0xf4, 0xa4,
+	0x3f, 0x80, 0x4a, 0x3f, 0x85, 0x51, 0x63, 0xff, 0x55, 0x92, 0x49, 0x99, 0x6d, 0xe2, 0xf0, 0x2f,
+	0x3d, 0x12, 0xf8, 0x99, 0xb5, 0xd0, 0x86, 0x24, 0x09, 0xcc, 0x41, 0xf9, 0x55, 0xf7, 0xd0, 0xed,
+	0x86, 0x41, 0x4d, 0xbf, 0x05, 0xb3, 0xe1, 0xc2, 0x09, 0x1d, 0x67, 0x17, 0xce, 0x30, 0x3f, 0x24,
+	0x11, 0x98, 0xfa, 0xe2, 0xf0, 0xf1, 0xe0, 0x93, 0x50, 0xe9, 0xdf, 0x33, 0x24, 0x6e, 0xef, 0xfe,
+	0x75, 0x09, 0x66, 0xab, 0xec, 0x5f, 0xdc, 0x6e, 0x1b, 0x8e, 0x71, 0x88, 0x3d, 0xf4, 0x1b, 0x05,
+	0xca, 0xd2, 0x64, 0x1b, 0x69, 0xa2, 0x26, 0xd2, 0xfe, 0x5b, 0x42, 0x3d, 0x37, 0x00, 0x83, 0xb1,
+	0xa0, 0xfb, 0xbd, 0x6a, 0x1d, 0xbd, 0x73, 0x13, 0x07, 0x1a, 0x21, 0xed, 0x6f, 0x69, 0x4d, 0xcb,
+	0x0e, 0xb0, 0xa7, 0x1d, 0x5b, 0x41, 0x4b, 0x6b, 0x5a, 0xd8, 0x36, 0xfd, 0x0d, 0xce, 0xe3, 0x96,
+	0x46, 0xe7, 0x0e, 0x5b, 0x9a, 0x38, 0xe9, 0xd9, 0xd2, 0x98, 0x17, 0x5f, 0xda, 0xd2, 0x4c, 0xdc,
+	0x34, 0xba, 0x76, 0xa0, 0x79, 0x38, 0xe8, 0x7a, 0x8e, 0x66, 0xd8, 0x36, 0x3b, 0xf3, 0x83, 0x3f,
+	0xfe, 0xe5, 0x3b, 0xb9, 0x12, 0x2a, 0xee, 0x1c, 0x3d, 0xb1, 0x43, 0x17, 0xd0, 0x57, 0x72, 0xb0,
+	0x20, 0xb1, 0xc3, 0xff, 0x99, 0x64, 0xb8, 0x44, 0x17, 0x33, 0x31, 0xe4, 0x26, 0xab, 0xfe, 0x23,
+	0xa5, 0x57, 0xfd, 0x50, 0x41, 0x1f, 0x28, 0x82, 0x68, 0x96, 0xd3, 0xb0, 0xbb, 0x26, 0xa6, 0x9f,
+	0x9a, 0xe5, 0x34, 0x5d, 0xcd, 0x6d, 0x6a, 0x9e, 0x6b, 0x63, 0xcd, 0x70, 0x4c, 0x8d, 0xb6, 0x9c,
+	0xbe, 0x30, 0xf9, 0x11, 0x9a, 0x8f, 0xe4, 0xe7, 0x7d, 0x5d, 0x74, 0x04, 0x10, 0x4f, 0xad, 0xd1,
+	0x6a, 0xe2, 0x6d, 0x22, 0xff, 0x0b, 0x81, 0xba, 0x96, 0x05, 0xe6, 0x02, 0x5f, 0xea, 0x55, 0x11,
+	0x9a, 0x67, 0x80, 0x58, 0x42, 0x4a, 0x7b, 0x76, 0x37, 0xd6, 0xfd, 0x35, 0x65, 0x13, 0xfd, 0x1f,
+	0x94, 0x84, 0x89, 0x35, 0x5a, 0x93, 0x75, 0x9a, 0x9c, 0x7c, 0xab, 0xeb, 0x99, 0x70, 0x4e, 0x7a,
+	0xa7, 0x57, 0xad, 0xa0, 0x25, 0x06, 0x61, 0xa4, 0x0f, 0x18, 0x0b, 0x75, 0xcb, 0x64, 0x0c, 0x5c,
+	0x53, 0x36, 0x37, 0x05, 0xfb, 0x7f, 0x57, 0x81, 0x59, 0x79, 0x9e, 0x8a, 0x24, 0x57, 0x4d, 0x1d,
+	0xff, 0xaa, 0xfa, 0x20, 0x14, 0xce, 0xca, 0x73, 0xbd, 0xea, 0x12, 0x5a, 0x64, 0x40, 0xc6, 0x4a,
+	0x98, 0x8f, 0x29, 0x23, 0x6b, 0xfa, 0xd9, 0x88, 0x8b, 0x9d, 0x10, 0x72, 0xad, 0x41, 0xd1, 0x89,
+	0x66, 0x7e, 0xa1, 0x84, 0x75, 0x91, 0x34, 0x64, 0x45, 0x17, 0x24, 0xd2, 0x99, 0xc3, 0x7b, 0xf5,
+	0xe2, 0x50, 0x3c, 0xce, 0xe7, 0x6b, 0xbd, 0xea, 0x25, 0x74, 0x91, 0x61, 0x68, 0x86, 0xe6, 0xb1,
+	0xfd, 0x5a, 0xe0, 0x6a, 0x1e, 0xc1, 0xa3, 0xac, 0x5f, 0xf4, 0x39, 0xf3, 0x94, 0xf5, 0x55, 0xbd,
+	0x92, 0xc2, 0x3a, 0xc5, 0x26, 0x9c, 0x7f, 0x4d, 0x81, 0xf2, 0xbe, 0x7f, 0x14, 0x8f, 0x24, 0x65,
+	0x7f, 0xea, 0x9b, 0xf2, 0xaa, 0x6b, 0x59, 0x60, 0xce, 0xe1, 0x33, 0xbd, 0xea, 0x2a, 0x5a, 0xde,
+	0xf7, 0x8f, 0xc8, 0x25, 0xe9, 0xd8, 0x46, 0xd0, 0x74, 0xbd, 0xb6, 0xc6, 0x32, 0x0f, 0x65, 0x8f,
+	0xb9, 0xb5, 0x5e, 0x26, 0x5c, 0x59, 0xfe, 0x51, 0x3d, 0x72, 0xaf, 0x8f, 0x15, 0x80, 0xcf, 0x8f,
+	0x8f, 0xbd, 0x5e, 0x75, 0x0b, 0x6d, 0xee, 0xc5, 0x74, 0xb7, 0x34, 0xab, 0x49, 0x7f, 0x68, 0x2d,
+	0xe3, 0x08, 0x6b, 0x86, 0xd9, 0xb6, 0x1c, 0xad, 0x83, 0xbd, 0xb6, 0x45, 0xc3, 0x34, 0x73, 0x38,
+	0x5d, 0xf6, 0xf8, 0x4f, 0x15, 0x98, 0x4f, 0x4e, 0xce, 0xd1, 0x63, 0xd2, 0x30, 0x25, 0x7d, 0x1a,
+	0xaf, 0x9e, 0x1f, 0x8c, 0xc4, 0x99, 0x7c, 0xa5, 0x57, 0xdd, 0x40, 0x17, 0x48, 0xa8, 0x89, 0x6d,
+	0x49, 0x62, 0x4b, 0xbf, 0x2d, 0xb9, 0x23, 0xaa, 0x28, 0xd3, 0x9a, 0xe8, 0x33, 0x05, 0x16, 0xd3,
+	0x86, 0xb7, 0x48, 0xf2, 0xae, 0x01, 0xf3, 0x62, 0x75, 0x63, 0x38, 0x22, 0x67, 0xfc, 0xc5, 0x5e,
+	0x75, 0x05, 0xa9, 0x21, 0x0a, 0x53, 0x2b, 0x09, 0x86, 0x12, 0xb3, 0x9a, 0xbe, 0x9c, 0xc2, 0x6c,
+	0x38, 0x2e, 0x26, 0xfa, 0xfd, 0x44, 0x81, 0x92, 0x30, 0x62, 0x45, 0x29, 0x46, 0x15, 0x67, 0x07,
+	0xea, 0x7a, 0x26, 0x3c, 0xe6, 0xeb, 0x0a, 0xba, 0xcc, 0xad, 0xce, 0x23, 0xb3, 0xc1, 0x7e, 0x68,
+	0xa4, 0x3f, 0x6e, 0x58, 0x8e, 0xe6, 0x3a, 0x58, 0x6b, 0xbb, 0x9e, 0xe0, 0x8d, 0x73, 0x3a, 0x10,
+	0x46, 0x29, 0x1a, 0xb5, 0xfb, 0x9f, 0x15, 0x98, 0x95, 0x67, 0x71, 0x28, 0x35, 0x27, 0x4a, 0xd3,
+	0x1c, 0x55, 0x1f, 0x84, 0xc2, 0x19, 0xfc, 0xba, 0xd2, 0xab, 0x06, 0xc8, 0x23, 0x26, 0x67, 0xe4,
+	0xb6, 0xb4, 0x86, 0xe1, 0x48, 0xd9, 0x23, 0x68, 0x61, 0x1f, 0x87, 0x39, 0x24, 0x1c, 0x70, 0x6c,
+	0x69, 0x89, 0xa9, 0xd6, 0x96, 0x16, 0x8f, 0x3b, 0x07, 0x27, 0x13, 0x46, 0x87, 0x0a, 0x3a, 0x83,
+	0x04, 0x41, 0xd1, 0xf7, 0x84, 0x56, 0x84, 0x38, 0x71, 0x1c, 0x45, 0xd6, 0x8d, 0x6c, 0x94, 0x44,
+	0x46, 0xfd, 0xb9, 0xd2, 0xab, 0x7e, 0xa2, 0xa0, 0x9e, 0x22, 0xca, 0x1c, 0xa6, 0xd4, 0x30, 0xd9,
+	0x69, 0x96, 0xa3, 0x05, 0x2d, 0xcb, 0x0f, 0x6d, 0xf6, 0x25, 0xea, 0x64, 0x01, 0x9d, 0x8a, 0x75,
+	0x12, 0xa6, 0xd8, 0xff, 0x82, 0x92, 0x30, 0xff, 0x43, 0x29, 0x49, 0x34, 0xdb, 0x2f, 0x53, 0x06,
+	0x87, 0xfa, 0xe5, 0x5e, 0x75, 0x01, 0xf1, 0xc6, 0x17, 0x77, 0xc7, 0x28, 0xcd, 0xce, 0xed, 0x26,
+	0xbc, 0xef, 0x3f, 0x61, 0x46, 0x1c, 0xfd, 0xa1, 0x94, 0x44, 0x2a, 0x1b, 0x43, 0xcb, 0x46, 0xe0,
+	0xf4, 0x2f, 0xf6, 0xaa, 0x73, 0xa8, 0xcc, 0x40, 0xa2, 0xf0, 0x73, 0x9b, 0x09, 0xda, 0x1f, 0x29,
+	0x50, 0x8c, 0x86, 0x7f, 0x68, 0x45, 0x3c, 0x38, 0x39, 0x5b, 0x54, 0x57, 0x33, 0xa0, 0x71, 0x04,
+	0xbe, 0x80, 0xce, 0x93, 0xf5, 0xc8, 0xec, 0xd4, 0xd4, 0xc4, 0xb6, 0xef, 0x91, 0x55, 0xcb, 0x11,
+	0x59, 0x59, 0xd4, 0xe7, 0x04, 0x56, 0x08, 0x02, 0x8f, 0x10, 0x10, 0x8f, 0xfe, 0xe4, 0xa4, 0xd0,
+	0x37, 0x5b, 0x54, 0xd7, 0xb2, 0xc0, 0x9c, 0xa5, 0x5b, 0xbd, 0xea, 0x26, 0xda, 0xa0, 0x80, 0x7e,
+	0x9e, 0x6c, 0xba, 0xdc, 0xf4, 0xdc, 0xb6, 0xc8, 0xd6, 0xe9, 0x6b, 0xca, 0xa6, 0x3e, 0x2f, 0x70,
+	0x46, 0xd1, 0x76, 0xff, 0x50, 0x82, 0x72, 0x95, 0xbe, 0x8e, 0xc3, 0x42, 0xfb, 0x67, 0x0a, 0x4c,
+	0xd2, 0x0e, 0x3b, 0x92, 0x86, 0x2b, 0x62, 0xb7, 0x5f, 0x3d, 0x9b, 0x02, 0xe1, 0xac, 0x39, 0xbd,
+	0xea, 0x5b, 0xe8, 0x5e, 0x18, 0x51, 0x7d, 0xed, 0xb8, 0x85, 0x83, 0x16, 0xf6, 0x88, 0xe7, 0x53,
+	0x16, 0xdf, 0xe6, 0x85, 0xd1, 0x3b, 0x2c, 0x7d, 0xc5, 0x89, 0x8b, 0x24, 0xff, 0x23, 0xcb, 0xb7,
+	0x88, 0x7f, 0xfb, 0x6e, 0xd7, 0x6b, 0xe0, 0xb7, 0xe3, 0x31, 0xc1, 0xb5, 0xae, 0x67, 0xbf, 0x13,
+	0xd5, 0x52, 0x2c, 0xbb, 0x35, 0x0c, 0xc7, 0x74, 0xd1, 0xaf, 0x15, 0x28, 0x4b, 0x5d, 0x77, 0xb9,
+	0x8a, 0x4e, 0xeb, 0xe0, 0xab, 0xe7, 0x06, 0x60, 0x70, 0x31, 0x3a, 0xbd, 0xea, 0x3d, 0x74, 0x97,
+	0x5c, 0x75, 0x5a, 0x20, 0xb3, 0x1e, 0xf9, 0x96, 0x66, 0x5a, 0xcd, 0x26, 0x26, 0x77, 0x95, 0x2d,
+	0xb7, 0x0c, 0x5f, 0x58, 0x92, 0x65, 0x61, 0x3d, 0x07, 0x01, 0xcc, 0xce, 0x10, 0xab, 0x61, 0x72,
+	0x86, 0x7f, 0x8d, 0xad, 0xa3, 0x9f, 0x28, 0x30, 0x9f, 0x6c, 0xc0, 0xcb, 0x39, 0x3a, 0xa3, 0xfd,
+	0xaf, 0x9e, 0x1f, 0x8c, 0xc4, 0x25, 0x7a, 0x89, 0xe6, 0x68, 0x06, 0x16, 0x85, 0xd2, 0x82, 0x96,
+	0x11, 0x50, 0xc7, 0x39, 0xc0, 0xcc, 0x00, 0x98, 0xa5, 0xbd, 0xd3, 0x7a, 0x1f, 0x93, 0xc4, 0x93,
+	0x7f, 0x18, 0x95, 0x37, 0x84, 0x48, 0x5a, 0x79, 0x23, 0xb4, 0xf9, 0xd4, 0xb5, 0x2c, 0x30, 0xe7,
+	0xea, 0x4e, 0xaf, 0xfa, 0x34, 0x7a, 0x8a, 0x01, 0x28, 0x57, 0x23, 0xeb, 0xd8, 0x17, 0x2b, 0x1d,
+	0xc6, 0xa4, 0xb2, 0x49, 0x02, 0x9e, 0xd0, 0xc6, 0x4f, 0xab, 0xed, 0xc5, 0x66, 0xb5, 0xba, 0x9e,
+	0x09, 0x8f, 0x03, 0xde, 0x22, 0x42, 0xd7, 0x8d, 0xa0, 0xd1, 0xd2, 0x4c, 0x0a, 0xa7, 0x5c, 0x71,
+	0xe2, 0x9b, 0x32, 0xf1, 0xff, 0x0e, 0x1f, 0x34, 0xfd, 0x9a, 0xe9, 0x9b, 0x15, 0xa8, 0x6b, 0x59,
+	0x60, 0xe9, 0x55, 0xc1, 0x28, 0xb7, 0x05, 0xab, 0x25, 0x9f, 0x35, 0x11, 0xf5, 0x26, 0x4c, 0x71,
+	0x5f, 0x46, 0x6a, 0x8a, 0x83, 0x87, 0x74, 0x97, 0x53, 0x61, 0x9c, 0xa8, 0x4e, 0xe3, 0x6b, 0xe4,
+	0xf6, 0x11, 0x2d, 0x40, 0xd3, 0x21, 0x2d, 0xd4, 0x8d, 0x1f, 0xe2, 0x4c, 0xc9, 0xa9, 0xcf, 0x56,
+	0x49, 0xcd, 0xe7, 0x06, 0x60, 0x70, 0xca, 0xeb, 0xbd, 0x6a, 0x09, 0x15, 0x43, 0xca, 0xd2, 0xa3,
+	0x99, 0x2e, 0x10, 0xbf, 0x9b, 0x11, 0x9b, 0xd2, 0x72, 0x3a, 0x49, 0xe9, 0x7b, 0xab, 0x5a, 0x36,
+	0x42, 0x1c, 0x47, 0xaf, 0xa0, 0xcb, 0x04, 0x14, 0xbf, 0x83, 0x59, 0x8d, 0x6d, 0xf0, 0x87, 0x1c,
+	0xb6, 0x5d, 0xe7, 0x90, 0x5e, 0x67, 0x8a, 0x21, 0x16, 0xfd, 0x04, 0xe1, 0x1a, 0x59, 0x24, 0xca,
+	0xff, 0x86, 0x02, 0xb3, 0x72, 0x77, 0x5a, 0xae, 0x3e, 0x52, 0x9b, 0xe0, 0xaa, 0x3e, 0x08, 0x85,
+	0xf3, 0xf8, 0x04, 0x7d, 0xd2, 0x31, 0x60, 0x5c, 0xa0, 0xc6, 0xcc, 0x6c, 0xf6, 0x31, 0xb3, 0xdb,
+	0x2b, 0xf0, 0xd6, 0x71, 0x18, 0xd3, 0xff, 0x5f, 0x81, 0x19, 0xb1, 0x7f, 0x8d, 0x52, 0x0a, 0x50,
+	0xa9, 0x13, 0xae, 0x6a, 0xd9, 0x08, 0x9c, 0xaf, 0x6d, 0xea, 0x2a, 0x0c, 0xa4, 0xb1, 0x9e, 0x20,
+	0x65, 0x68, 0x49, 0xa7, 0x75, 0x88, 0x6b, 0x74, 0x83, 0xd6, 0xee, 0x0e, 0x03, 0x10, 0x0d, 0xbd,
+	0x0b, 0x93, 0xac, 0xb7, 0x2a, 0xa5, 0x15, 0xb1, 0x89, 0xaa, 0x9e, 0x4d, 0x81, 0x70, 0x6a, 0x5a,
+	0xaf, 0x9a, 0x3b, 0xf8, 0x17, 0x31, 0x2e, 0x71, 0x02, 0xb4, 0x91, 0x4a, 0xce, 0xff, 0x1f, 0x28,
+	0x09, 0x4d, 0x52, 0xf9, 0xe6, 0xf7, 0x37, 0x6c, 0xd5, 0xf5, 0x4c, 0x38, 0xa7, 0x78, 0xa5, 0x57,
+	0x9d, 0x45, 0x33, 0x0c, 0xa2, 0x51, 0x2a, 0x4c, 0x3c, 0x92, 0x7f, 0x44, 0x09, 0x3d, 0x8a, 0x81,
+	0xbe, 0xa9, 0xc0, 0x7c, 0xb2, 0xd5, 0x27, 0x87, 0xef, 0x8c, 0x56, 0xa3, 0x7a, 0x7e, 0x30, 0x12,
+	0x67, 0x67, 0x8b, 0xb2, 0x43, 0xee, 0x07, 0xef, 0xc7, 0xf1, 0xb4, 0x8e, 0x16, 0x04, 0x5e, 0x42,
+	0x10, 0x6a, 0x41, 0x81, 0xf5, 0xfc, 0x90, 0xa4, 0x53, 0xa9, 0x31, 0xa8, 0xaa, 0x69, 0xa0, 0xb8,
+	0xd0, 0x9a, 0x46, 0xfc, 0x8c, 0x14, 0xb3, 0xda, 0x14, 0x40, 0xd4, 0xfe, 0x53, 0x05, 0xe6, 0x93,
+	0x0d, 0x3e, 0x59, 0xee, 0x8c, 0x96, 0xa1, 0x7a, 0x7e, 0x30, 0x12, 0x67, 0xe4, 0x26, 0x7d, 0xa1,
+	0x71, 0x33, 0x90, 0x92, 0x97, 0x9a, 0xc2, 0x27, 0xcf, 0xcb, 0xb8, 0xbb, 0xa4, 0xeb, 0xab, 0x7d,
+	0x16, 0xa1, 0x0f, 0x72, 0xd6, 0xc2, 0x27, 0xe1, 0xf1, 0xfa, 0xc4, 0xbf, 0xe5, 0x3a, 0x07, 0x07,
+	0x05, 0xda, 0x12, 0x7e, 0xf2, 0xef, 0x03, 0x00, 0x7e, 0x42, 0x8c, 0x80, 0x42, 0x3b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5153,6 +5649,19 @@ type TokenManagerClient interface {
 	//
 	// Get token
 	Token(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	// revoke access token or refresh token, revoking refresh token also revokes the
+	// access tokens refreshed by it
+	//
+	// Ref: https://tools.ietf.org/html/rfc7009
+	//
+	// Revoke token
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	// Get sessions, a session is the refresh token issued to client
+	DescribeSessions(ctx context.Context, in *DescribeSessionsRequest, opts ...grpc.CallOption) (*DescribeSessionsResponse, error)
+	// Logout, revoke the session of current access token
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// Revoke all the sessions and access tokens of users, e.g. the users are compromised
+	RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error)
 }
 
 type tokenManagerClient struct {
@@ -5181,6 +5690,42 @@ func (c *tokenManagerClient) Token(ctx context.Context, in *TokenRequest, opts .
 	return out, nil
 }
 
+func (c *tokenManagerClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.TokenManager/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagerClient) DescribeSessions(ctx context.Context, in *DescribeSessionsRequest, opts ...grpc.CallOption) (*DescribeSessionsResponse, error) {
+	out := new(DescribeSessionsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.TokenManager/DescribeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagerClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.TokenManager/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagerClient) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error) {
+	out := new(RevokeUserTokensResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.TokenManager/RevokeUserTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenManagerServer is the server API for TokenManager service.
 type TokenManagerServer interface {
 	// Create client
@@ -5198,6 +5743,19 @@ type TokenManagerServer interface {
 	//
 	// Get token
 	Token(context.Context, *TokenRequest) (*TokenResponse, error)
+	// revoke access token or refresh token, revoking refresh token also revokes the
+	// access tokens refreshed by it
+	//
+	// Ref: https://tools.ietf.org/html/rfc7009
+	//
+	// Revoke token
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	// Get sessions, a session is the refresh token issued to client
+	DescribeSessions(context.Context, *DescribeSessionsRequest) (*DescribeSessionsResponse, error)
+	// Logout, revoke the session of current access token
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// Revoke all the sessions and access tokens of users, e.g. the users are compromised
+	RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error)
 }
 
 // UnimplementedTokenManagerServer can be embedded to have forward compatible implementations.
@@ -5210,6 +5768,18 @@ func (*UnimplementedTokenManagerServer) CreateClient(ctx context.Context, req *C
 func (*UnimplementedTokenManagerServer) Token(ctx context.Context, req *TokenRequest) (*TokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
 }
+func (*UnimplementedTokenManagerServer) RevokeToken(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (*UnimplementedTokenManagerServer) DescribeSessions(ctx context.Context, req *DescribeSessionsRequest) (*DescribeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSessions not implemented")
+}
+func (*UnimplementedTokenManagerServer) Logout(ctx context.Context, req *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (*UnimplementedTokenManagerServer) RevokeUserTokens(ctx context.Context, req *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
 
 func RegisterTokenManagerServer(s *grpc.Server, srv TokenManagerServer) {
 	s.RegisterService(&_TokenManager_serviceDesc, srv)
@@ -5251,6 +5821,78 @@ func _TokenManager_Token_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenManager_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagerServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.TokenManager/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagerServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManager_DescribeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagerServer).DescribeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.TokenManager/DescribeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagerServer).DescribeSessions(ctx, req.(*DescribeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManager_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagerServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.TokenManager/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagerServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManager_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagerServer).RevokeUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.TokenManager/RevokeUserTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagerServer).RevokeUserTokens(ctx, req.(*RevokeUserTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TokenManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.TokenManager",
 	HandlerType: (*TokenManagerServer)(nil),
@@ -5263,6 +5905,22 @@ var _TokenManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Token",
 			Handler:    _TokenManager_Token_Handler,
 		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _TokenManager_RevokeToken_Handler,
+		},
+		{
+			MethodName: "DescribeSessions",
+			Handler:    _TokenManager_DescribeSessions_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _TokenManager_Logout_Handler,
+		},
+		{
+			MethodName: "RevokeUserTokens",
+			Handler:    _TokenManager_RevokeUserTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "account.proto",
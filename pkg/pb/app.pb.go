@@ -1008,10 +1008,12 @@ type DescribeAppsRequest struct {
 	// select column to display
 	DisplayColumns []string `protobuf:"bytes,18,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
 	// isv
-	Isv                  []string `protobuf:"bytes,19,rep,name=isv,proto3" json:"isv,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Isv []string `protobuf:"bytes,19,rep,name=isv,proto3" json:"isv,omitempty"`
+	// page token of the next page returned by previous page, the offset is ignored if it is set
+	PageToken            *wrappers.StringValue `protobuf:"bytes,20,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeAppsRequest) Reset()         { *m = DescribeAppsRequest{} }
@@ -1137,14 +1139,23 @@ func (m *DescribeAppsRequest) GetIsv() []string {
 	return nil
 }
 
+func (m *DescribeAppsRequest) GetPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
 type DescribeAppsResponse struct {
 	// total count of qualified app
 	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// list of app
-	AppSet               []*App   `protobuf:"bytes,2,rep,name=app_set,json=appSet,proto3" json:"app_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AppSet []*App `protobuf:"bytes,2,rep,name=app_set,json=appSet,proto3" json:"app_set,omitempty"`
+	// page token of the next page, empty if there are no more apps
+	NextPageToken        *wrappers.StringValue `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeAppsResponse) Reset()         { *m = DescribeAppsResponse{} }
@@ -1186,6 +1197,13 @@ func (m *DescribeAppsResponse) GetAppSet() []*App {
 	return nil
 }
 
+func (m *DescribeAppsResponse) GetNextPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 type CreateAppVersionRequest struct {
 	// required, id of app to create new version
 	AppId *wrappers.StringValue `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -1891,10 +1909,12 @@ type AppVersionReview struct {
 	// user who review the app version
 	Reviewer *wrappers.StringValue `protobuf:"bytes,9,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
 	// version type
-	VersionType          *wrappers.StringValue `protobuf:"bytes,10,opt,name=version_type,json=versionType,proto3" json:"version_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	VersionType *wrappers.StringValue `protobuf:"bytes,10,opt,name=version_type,json=versionType,proto3" json:"version_type,omitempty"`
+	// ordered stages of the review, eg.[isv, business, technical]
+	Stage                []string `protobuf:"bytes,11,rep,name=stage,proto3" json:"stage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AppVersionReview) Reset()         { *m = AppVersionReview{} }
@@ -1992,6 +2012,13 @@ func (m *AppVersionReview) GetVersionType() *wrappers.StringValue {
 	return nil
 }
 
+func (m *AppVersionReview) GetStage() []string {
+	if m != nil {
+		return m.Stage
+	}
+	return nil
+}
+
 type DescribeAppVersionReviewsRequest struct {
 	// query key, support these fields(review_id, version_id, app_id, status, reviewer, app_name, owner)
 	SearchWord *wrappers.StringValue `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
@@ -3172,7 +3199,9 @@ func (m *DeleteAppVersionResponse) GetVersionId() *wrappers.StringValue {
 
 type ReviewAppVersionRequest struct {
 	// required, id of version to review
-	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// stage of the review to start, required by ReviewAppVersion
+	Stage                *wrappers.StringValue `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -3210,6 +3239,13 @@ func (m *ReviewAppVersionRequest) GetVersionId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *ReviewAppVersionRequest) GetStage() *wrappers.StringValue {
+	if m != nil {
+		return m.Stage
+	}
+	return nil
+}
+
 type ReviewAppVersionResponse struct {
 	// id of version reviewed
 	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
@@ -3252,7 +3288,9 @@ func (m *ReviewAppVersionResponse) GetVersionId() *wrappers.StringValue {
 
 type PassAppVersionRequest struct {
 	// required, id of version to pass
-	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// stage of the review to pass, required by PassAppVersion
+	Stage                *wrappers.StringValue `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -3290,6 +3328,13 @@ func (m *PassAppVersionRequest) GetVersionId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *PassAppVersionRequest) GetStage() *wrappers.StringValue {
+	if m != nil {
+		return m.Stage
+	}
+	return nil
+}
+
 type PassAppVersionResponse struct {
 	// id of version passed
 	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
@@ -3334,7 +3379,9 @@ type RejectAppVersionRequest struct {
 	// required, id of version to reject
 	VersionId *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	// reject message
-	Message              *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Message *wrappers.StringValue `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// stage of the review to reject, required by RejectAppVersion
+	Stage                *wrappers.StringValue `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -3379,6 +3426,13 @@ func (m *RejectAppVersionRequest) GetMessage() *wrappers.StringValue {
 	return nil
 }
 
+func (m *RejectAppVersionRequest) GetStage() *wrappers.StringValue {
+	if m != nil {
+		return m.Stage
+	}
+	return nil
+}
+
 type RejectAppVersionResponse struct {
 	// id of version rejected
 	VersionId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
@@ -3579,202 +3633,894 @@ func (m *RecoverAppVersionResponse) GetVersionId() *wrappers.StringValue {
 	return nil
 }
 
-type SyncRepoRequest struct {
-	// required, id of repository to synchronize
-	RepoId               string   `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+type ReviewAutoPass struct {
+	// the stage is passed automatically if the submitter has one of the action bundles
+	SubmitterActionBundle []string `protobuf:"bytes,1,rep,name=submitter_action_bundle,json=submitterActionBundle,proto3" json:"submitter_action_bundle,omitempty"`
+	// the stage is passed automatically if the owner path of app version has one of the prefixes
+	OwnerPathPrefix      []string `protobuf:"bytes,2,rep,name=owner_path_prefix,json=ownerPathPrefix,proto3" json:"owner_path_prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncRepoRequest) Reset()         { *m = SyncRepoRequest{} }
-func (m *SyncRepoRequest) String() string { return proto.CompactTextString(m) }
-func (*SyncRepoRequest) ProtoMessage()    {}
-func (*SyncRepoRequest) Descriptor() ([]byte, []int) {
+func (m *ReviewAutoPass) Reset()         { *m = ReviewAutoPass{} }
+func (m *ReviewAutoPass) String() string { return proto.CompactTextString(m) }
+func (*ReviewAutoPass) ProtoMessage()    {}
+func (*ReviewAutoPass) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0f9056a14b86d47, []int{51}
 }
 
-func (m *SyncRepoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SyncRepoRequest.Unmarshal(m, b)
+func (m *ReviewAutoPass) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewAutoPass.Unmarshal(m, b)
 }
-func (m *SyncRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SyncRepoRequest.Marshal(b, m, deterministic)
+func (m *ReviewAutoPass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewAutoPass.Marshal(b, m, deterministic)
 }
-func (m *SyncRepoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncRepoRequest.Merge(m, src)
+func (m *ReviewAutoPass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewAutoPass.Merge(m, src)
 }
-func (m *SyncRepoRequest) XXX_Size() int {
-	return xxx_messageInfo_SyncRepoRequest.Size(m)
+func (m *ReviewAutoPass) XXX_Size() int {
+	return xxx_messageInfo_ReviewAutoPass.Size(m)
 }
-func (m *SyncRepoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncRepoRequest.DiscardUnknown(m)
+func (m *ReviewAutoPass) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewAutoPass.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncRepoRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReviewAutoPass proto.InternalMessageInfo
 
-func (m *SyncRepoRequest) GetRepoId() string {
+func (m *ReviewAutoPass) GetSubmitterActionBundle() []string {
 	if m != nil {
-		return m.RepoId
+		return m.SubmitterActionBundle
 	}
-	return ""
+	return nil
 }
 
-type SyncRepoResponse struct {
-	// synchronized ok or not
-	Failed bool `protobuf:"varint,1,opt,name=failed,proto3" json:"failed,omitempty"`
-	// result
-	Result               string   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReviewAutoPass) GetOwnerPathPrefix() []string {
+	if m != nil {
+		return m.OwnerPathPrefix
+	}
+	return nil
 }
 
-func (m *SyncRepoResponse) Reset()         { *m = SyncRepoResponse{} }
-func (m *SyncRepoResponse) String() string { return proto.CompactTextString(m) }
-func (*SyncRepoResponse) ProtoMessage()    {}
-func (*SyncRepoResponse) Descriptor() ([]byte, []int) {
+type ReviewStage struct {
+	// required, name of stage, recorded as the operator type of review phase eg.[isv|business|technical|security]
+	Name *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// required, action bundle required by the reviewers of the stage
+	ActionBundle *wrappers.StringValue `protobuf:"bytes,2,opt,name=action_bundle,json=actionBundle,proto3" json:"action_bundle,omitempty"`
+	// the reviewers of the stage are the users of the isv which the submitter belongs to
+	IsvScoped *wrappers.BoolValue `protobuf:"bytes,3,opt,name=isv_scoped,json=isvScoped,proto3" json:"isv_scoped,omitempty"`
+	// rules to pass the stage automatically
+	AutoPass             *ReviewAutoPass `protobuf:"bytes,4,opt,name=auto_pass,json=autoPass,proto3" json:"auto_pass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReviewStage) Reset()         { *m = ReviewStage{} }
+func (m *ReviewStage) String() string { return proto.CompactTextString(m) }
+func (*ReviewStage) ProtoMessage()    {}
+func (*ReviewStage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0f9056a14b86d47, []int{52}
 }
 
-func (m *SyncRepoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SyncRepoResponse.Unmarshal(m, b)
+func (m *ReviewStage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewStage.Unmarshal(m, b)
 }
-func (m *SyncRepoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SyncRepoResponse.Marshal(b, m, deterministic)
+func (m *ReviewStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewStage.Marshal(b, m, deterministic)
 }
-func (m *SyncRepoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncRepoResponse.Merge(m, src)
+func (m *ReviewStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewStage.Merge(m, src)
 }
-func (m *SyncRepoResponse) XXX_Size() int {
-	return xxx_messageInfo_SyncRepoResponse.Size(m)
+func (m *ReviewStage) XXX_Size() int {
+	return xxx_messageInfo_ReviewStage.Size(m)
 }
-func (m *SyncRepoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncRepoResponse.DiscardUnknown(m)
+func (m *ReviewStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewStage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncRepoResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReviewStage proto.InternalMessageInfo
 
-func (m *SyncRepoResponse) GetFailed() bool {
+func (m *ReviewStage) GetName() *wrappers.StringValue {
 	if m != nil {
-		return m.Failed
+		return m.Name
 	}
-	return false
+	return nil
 }
 
-func (m *SyncRepoResponse) GetResult() string {
+func (m *ReviewStage) GetActionBundle() *wrappers.StringValue {
 	if m != nil {
-		return m.Result
+		return m.ActionBundle
 	}
-	return ""
+	return nil
 }
 
-type ResortAppsRequest struct {
-	AppId                []string `protobuf:"bytes,1,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReviewStage) GetIsvScoped() *wrappers.BoolValue {
+	if m != nil {
+		return m.IsvScoped
+	}
+	return nil
 }
 
-func (m *ResortAppsRequest) Reset()         { *m = ResortAppsRequest{} }
-func (m *ResortAppsRequest) String() string { return proto.CompactTextString(m) }
-func (*ResortAppsRequest) ProtoMessage()    {}
-func (*ResortAppsRequest) Descriptor() ([]byte, []int) {
+func (m *ReviewStage) GetAutoPass() *ReviewAutoPass {
+	if m != nil {
+		return m.AutoPass
+	}
+	return nil
+}
+
+type ReviewPolicy struct {
+	// review policy id
+	PolicyId *wrappers.StringValue `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// review policy name
+	Name *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// scope type of review policy eg.[category|market]
+	ScopeType *wrappers.StringValue `protobuf:"bytes,3,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	// id of category or market which the review policy applies to
+	ScopeId *wrappers.StringValue `protobuf:"bytes,4,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// ordered review stages
+	Stages []*ReviewStage `protobuf:"bytes,5,rep,name=stages,proto3" json:"stages,omitempty"`
+	// review policy description
+	Description *wrappers.StringValue `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// owner
+	Owner *wrappers.StringValue `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner path of review policy, concat string group_path:user_id
+	OwnerPath *wrappers.StringValue `protobuf:"bytes,8,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
+	// the time when review policy create
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// record status changed time
+	StatusTime           *timestamp.Timestamp `protobuf:"bytes,10,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReviewPolicy) Reset()         { *m = ReviewPolicy{} }
+func (m *ReviewPolicy) String() string { return proto.CompactTextString(m) }
+func (*ReviewPolicy) ProtoMessage()    {}
+func (*ReviewPolicy) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0f9056a14b86d47, []int{53}
 }
 
-func (m *ResortAppsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResortAppsRequest.Unmarshal(m, b)
+func (m *ReviewPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewPolicy.Unmarshal(m, b)
 }
-func (m *ResortAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResortAppsRequest.Marshal(b, m, deterministic)
+func (m *ReviewPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewPolicy.Marshal(b, m, deterministic)
 }
-func (m *ResortAppsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResortAppsRequest.Merge(m, src)
+func (m *ReviewPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewPolicy.Merge(m, src)
 }
-func (m *ResortAppsRequest) XXX_Size() int {
-	return xxx_messageInfo_ResortAppsRequest.Size(m)
+func (m *ReviewPolicy) XXX_Size() int {
+	return xxx_messageInfo_ReviewPolicy.Size(m)
 }
-func (m *ResortAppsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResortAppsRequest.DiscardUnknown(m)
+func (m *ReviewPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResortAppsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReviewPolicy proto.InternalMessageInfo
 
-func (m *ResortAppsRequest) GetAppId() []string {
+func (m *ReviewPolicy) GetPolicyId() *wrappers.StringValue {
 	if m != nil {
-		return m.AppId
+		return m.PolicyId
 	}
 	return nil
 }
 
-type ResortAppsResponse struct {
-	AppId                []string `protobuf:"bytes,1,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReviewPolicy) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
 }
 
-func (m *ResortAppsResponse) Reset()         { *m = ResortAppsResponse{} }
-func (m *ResortAppsResponse) String() string { return proto.CompactTextString(m) }
-func (*ResortAppsResponse) ProtoMessage()    {}
-func (*ResortAppsResponse) Descriptor() ([]byte, []int) {
+func (m *ReviewPolicy) GetScopeType() *wrappers.StringValue {
+	if m != nil {
+		return m.ScopeType
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetScopeId() *wrappers.StringValue {
+	if m != nil {
+		return m.ScopeId
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetStages() []*ReviewStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetOwnerPath() *wrappers.StringValue {
+	if m != nil {
+		return m.OwnerPath
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ReviewPolicy) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type CreateReviewPolicyRequest struct {
+	// review policy name
+	Name *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// required, scope type of review policy eg.[category|market]
+	ScopeType *wrappers.StringValue `protobuf:"bytes,2,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	// required, id of category or market which the review policy applies to
+	ScopeId *wrappers.StringValue `protobuf:"bytes,3,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// required, ordered review stages
+	Stages []*ReviewStage `protobuf:"bytes,4,rep,name=stages,proto3" json:"stages,omitempty"`
+	// review policy description
+	Description          *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateReviewPolicyRequest) Reset()         { *m = CreateReviewPolicyRequest{} }
+func (m *CreateReviewPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateReviewPolicyRequest) ProtoMessage()    {}
+func (*CreateReviewPolicyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0f9056a14b86d47, []int{54}
 }
 
-func (m *ResortAppsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResortAppsResponse.Unmarshal(m, b)
+func (m *CreateReviewPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReviewPolicyRequest.Unmarshal(m, b)
 }
-func (m *ResortAppsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResortAppsResponse.Marshal(b, m, deterministic)
+func (m *CreateReviewPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReviewPolicyRequest.Marshal(b, m, deterministic)
 }
-func (m *ResortAppsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResortAppsResponse.Merge(m, src)
+func (m *CreateReviewPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReviewPolicyRequest.Merge(m, src)
 }
-func (m *ResortAppsResponse) XXX_Size() int {
-	return xxx_messageInfo_ResortAppsResponse.Size(m)
+func (m *CreateReviewPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateReviewPolicyRequest.Size(m)
 }
-func (m *ResortAppsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResortAppsResponse.DiscardUnknown(m)
+func (m *CreateReviewPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReviewPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResortAppsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateReviewPolicyRequest proto.InternalMessageInfo
 
-func (m *ResortAppsResponse) GetAppId() []string {
+func (m *CreateReviewPolicyRequest) GetName() *wrappers.StringValue {
 	if m != nil {
-		return m.AppId
+		return m.Name
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("openpitrix.UploadAppAttachmentRequest_Type", UploadAppAttachmentRequest_Type_name, UploadAppAttachmentRequest_Type_value)
-	proto.RegisterType((*CreateAppRequest)(nil), "openpitrix.CreateAppRequest")
-	proto.RegisterType((*CreateAppResponse)(nil), "openpitrix.CreateAppResponse")
-	proto.RegisterType((*ValidatePackageRequest)(nil), "openpitrix.ValidatePackageRequest")
-	proto.RegisterType((*ValidatePackageResponse)(nil), "openpitrix.ValidatePackageResponse")
-	proto.RegisterMapType((map[string]string)(nil), "openpitrix.ValidatePackageResponse.ErrorDetailsEntry")
-	proto.RegisterType((*ModifyAppRequest)(nil), "openpitrix.ModifyAppRequest")
-	proto.RegisterType((*ModifyAppResponse)(nil), "openpitrix.ModifyAppResponse")
-	proto.RegisterType((*UploadAppAttachmentRequest)(nil), "openpitrix.UploadAppAttachmentRequest")
-	proto.RegisterType((*UploadAppAttachmentResponse)(nil), "openpitrix.UploadAppAttachmentResponse")
-	proto.RegisterType((*DeleteAppsRequest)(nil), "openpitrix.DeleteAppsRequest")
-	proto.RegisterType((*DeleteAppsResponse)(nil), "openpitrix.DeleteAppsResponse")
-	proto.RegisterType((*App)(nil), "openpitrix.App")
-	proto.RegisterType((*DescribeAppsRequest)(nil), "openpitrix.DescribeAppsRequest")
-	proto.RegisterType((*DescribeAppsResponse)(nil), "openpitrix.DescribeAppsResponse")
-	proto.RegisterType((*CreateAppVersionRequest)(nil), "openpitrix.CreateAppVersionRequest")
-	proto.RegisterType((*CreateAppVersionResponse)(nil), "openpitrix.CreateAppVersionResponse")
-	proto.RegisterType((*ModifyAppVersionRequest)(nil), "openpitrix.ModifyAppVersionRequest")
-	proto.RegisterMapType((map[string][]byte)(nil), "openpitrix.ModifyAppVersionRequest.PackageFilesEntry")
-	proto.RegisterType((*ModifyAppVersionResponse)(nil), "openpitrix.ModifyAppVersionResponse")
-	proto.RegisterType((*AppVersion)(nil), "openpitrix.AppVersion")
-	proto.RegisterType((*AppVersionAudit)(nil), "openpitrix.AppVersionAudit")
-	proto.RegisterType((*AppVersionReviewPhase)(nil), "openpitrix.AppVersionReviewPhase")
-	proto.RegisterType((*AppVersionReview)(nil), "openpitrix.AppVersionReview")
-	proto.RegisterMapType((map[string]*AppVersionReviewPhase)(nil), "openpitrix.AppVersionReview.PhaseEntry")
-	proto.RegisterType((*DescribeAppVersionReviewsRequest)(nil), "openpitrix.DescribeAppVersionReviewsRequest")
-	proto.RegisterType((*DescribeAppVersionReviewsResponse)(nil), "openpitrix.DescribeAppVersionReviewsResponse")
-	proto.RegisterType((*DescribeAppVersionAuditsRequest)(nil), "openpitrix.DescribeAppVersionAuditsRequest")
+func (m *CreateReviewPolicyRequest) GetScopeType() *wrappers.StringValue {
+	if m != nil {
+		return m.ScopeType
+	}
+	return nil
+}
+
+func (m *CreateReviewPolicyRequest) GetScopeId() *wrappers.StringValue {
+	if m != nil {
+		return m.ScopeId
+	}
+	return nil
+}
+
+func (m *CreateReviewPolicyRequest) GetStages() []*ReviewStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *CreateReviewPolicyRequest) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+type CreateReviewPolicyResponse struct {
+	// id of review policy created
+	PolicyId             *wrappers.StringValue `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateReviewPolicyResponse) Reset()         { *m = CreateReviewPolicyResponse{} }
+func (m *CreateReviewPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateReviewPolicyResponse) ProtoMessage()    {}
+func (*CreateReviewPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{55}
+}
+
+func (m *CreateReviewPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReviewPolicyResponse.Unmarshal(m, b)
+}
+func (m *CreateReviewPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReviewPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateReviewPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReviewPolicyResponse.Merge(m, src)
+}
+func (m *CreateReviewPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateReviewPolicyResponse.Size(m)
+}
+func (m *CreateReviewPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReviewPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReviewPolicyResponse proto.InternalMessageInfo
+
+func (m *CreateReviewPolicyResponse) GetPolicyId() *wrappers.StringValue {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+type ModifyReviewPolicyRequest struct {
+	// required, id of review policy to modify
+	PolicyId *wrappers.StringValue `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// review policy name
+	Name *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ordered review stages
+	Stages []*ReviewStage `protobuf:"bytes,3,rep,name=stages,proto3" json:"stages,omitempty"`
+	// review policy description
+	Description          *wrappers.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyReviewPolicyRequest) Reset()         { *m = ModifyReviewPolicyRequest{} }
+func (m *ModifyReviewPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyReviewPolicyRequest) ProtoMessage()    {}
+func (*ModifyReviewPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{56}
+}
+
+func (m *ModifyReviewPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyReviewPolicyRequest.Unmarshal(m, b)
+}
+func (m *ModifyReviewPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyReviewPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyReviewPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyReviewPolicyRequest.Merge(m, src)
+}
+func (m *ModifyReviewPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyReviewPolicyRequest.Size(m)
+}
+func (m *ModifyReviewPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyReviewPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyReviewPolicyRequest proto.InternalMessageInfo
+
+func (m *ModifyReviewPolicyRequest) GetPolicyId() *wrappers.StringValue {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+func (m *ModifyReviewPolicyRequest) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ModifyReviewPolicyRequest) GetStages() []*ReviewStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *ModifyReviewPolicyRequest) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+type ModifyReviewPolicyResponse struct {
+	// id of review policy modified
+	PolicyId             *wrappers.StringValue `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyReviewPolicyResponse) Reset()         { *m = ModifyReviewPolicyResponse{} }
+func (m *ModifyReviewPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyReviewPolicyResponse) ProtoMessage()    {}
+func (*ModifyReviewPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{57}
+}
+
+func (m *ModifyReviewPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyReviewPolicyResponse.Unmarshal(m, b)
+}
+func (m *ModifyReviewPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyReviewPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyReviewPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyReviewPolicyResponse.Merge(m, src)
+}
+func (m *ModifyReviewPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyReviewPolicyResponse.Size(m)
+}
+func (m *ModifyReviewPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyReviewPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyReviewPolicyResponse proto.InternalMessageInfo
+
+func (m *ModifyReviewPolicyResponse) GetPolicyId() *wrappers.StringValue {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+type DeleteReviewPoliciesRequest struct {
+	// required, ids of review policy to delete
+	PolicyId             []string `protobuf:"bytes,1,rep,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReviewPoliciesRequest) Reset()         { *m = DeleteReviewPoliciesRequest{} }
+func (m *DeleteReviewPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReviewPoliciesRequest) ProtoMessage()    {}
+func (*DeleteReviewPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{58}
+}
+
+func (m *DeleteReviewPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReviewPoliciesRequest.Unmarshal(m, b)
+}
+func (m *DeleteReviewPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReviewPoliciesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteReviewPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReviewPoliciesRequest.Merge(m, src)
+}
+func (m *DeleteReviewPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReviewPoliciesRequest.Size(m)
+}
+func (m *DeleteReviewPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReviewPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReviewPoliciesRequest proto.InternalMessageInfo
+
+func (m *DeleteReviewPoliciesRequest) GetPolicyId() []string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+type DeleteReviewPoliciesResponse struct {
+	// ids of review policy deleted
+	PolicyId             []string `protobuf:"bytes,1,rep,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReviewPoliciesResponse) Reset()         { *m = DeleteReviewPoliciesResponse{} }
+func (m *DeleteReviewPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteReviewPoliciesResponse) ProtoMessage()    {}
+func (*DeleteReviewPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{59}
+}
+
+func (m *DeleteReviewPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReviewPoliciesResponse.Unmarshal(m, b)
+}
+func (m *DeleteReviewPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReviewPoliciesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteReviewPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReviewPoliciesResponse.Merge(m, src)
+}
+func (m *DeleteReviewPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteReviewPoliciesResponse.Size(m)
+}
+func (m *DeleteReviewPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReviewPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReviewPoliciesResponse proto.InternalMessageInfo
+
+func (m *DeleteReviewPoliciesResponse) GetPolicyId() []string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+type DescribeReviewPoliciesRequest struct {
+	// sort key, order by sort_key, default create_time
+	SortKey *wrappers.StringValue `protobuf:"bytes,1,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	// value = 0 sort ASC, value = 1 sort DESC
+	Reverse *wrappers.BoolValue `protobuf:"bytes,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// data limit per page, default is 20, max value is 200
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// data offset, default is 0
+	Offset uint32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// review policy ids
+	PolicyId []string `protobuf:"bytes,10,rep,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// scope types of review policy eg.[category|market]
+	ScopeType []string `protobuf:"bytes,11,rep,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	// ids of category or market which the review policy applies to
+	ScopeId []string `protobuf:"bytes,12,rep,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// select columns to display
+	DisplayColumns       []string `protobuf:"bytes,13,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeReviewPoliciesRequest) Reset()         { *m = DescribeReviewPoliciesRequest{} }
+func (m *DescribeReviewPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeReviewPoliciesRequest) ProtoMessage()    {}
+func (*DescribeReviewPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{60}
+}
+
+func (m *DescribeReviewPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReviewPoliciesRequest.Unmarshal(m, b)
+}
+func (m *DescribeReviewPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReviewPoliciesRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeReviewPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReviewPoliciesRequest.Merge(m, src)
+}
+func (m *DescribeReviewPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeReviewPoliciesRequest.Size(m)
+}
+func (m *DescribeReviewPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReviewPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReviewPoliciesRequest proto.InternalMessageInfo
+
+func (m *DescribeReviewPoliciesRequest) GetSortKey() *wrappers.StringValue {
+	if m != nil {
+		return m.SortKey
+	}
+	return nil
+}
+
+func (m *DescribeReviewPoliciesRequest) GetReverse() *wrappers.BoolValue {
+	if m != nil {
+		return m.Reverse
+	}
+	return nil
+}
+
+func (m *DescribeReviewPoliciesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeReviewPoliciesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeReviewPoliciesRequest) GetPolicyId() []string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+func (m *DescribeReviewPoliciesRequest) GetScopeType() []string {
+	if m != nil {
+		return m.ScopeType
+	}
+	return nil
+}
+
+func (m *DescribeReviewPoliciesRequest) GetScopeId() []string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return nil
+}
+
+func (m *DescribeReviewPoliciesRequest) GetDisplayColumns() []string {
+	if m != nil {
+		return m.DisplayColumns
+	}
+	return nil
+}
+
+type DescribeReviewPoliciesResponse struct {
+	// total count of review policies
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// list of review policies
+	ReviewPolicySet      []*ReviewPolicy `protobuf:"bytes,2,rep,name=review_policy_set,json=reviewPolicySet,proto3" json:"review_policy_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DescribeReviewPoliciesResponse) Reset()         { *m = DescribeReviewPoliciesResponse{} }
+func (m *DescribeReviewPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeReviewPoliciesResponse) ProtoMessage()    {}
+func (*DescribeReviewPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{61}
+}
+
+func (m *DescribeReviewPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReviewPoliciesResponse.Unmarshal(m, b)
+}
+func (m *DescribeReviewPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReviewPoliciesResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeReviewPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReviewPoliciesResponse.Merge(m, src)
+}
+func (m *DescribeReviewPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeReviewPoliciesResponse.Size(m)
+}
+func (m *DescribeReviewPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReviewPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReviewPoliciesResponse proto.InternalMessageInfo
+
+func (m *DescribeReviewPoliciesResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeReviewPoliciesResponse) GetReviewPolicySet() []*ReviewPolicy {
+	if m != nil {
+		return m.ReviewPolicySet
+	}
+	return nil
+}
+
+type SyncRepoRequest struct {
+	// required, id of repository to synchronize
+	RepoId               string   `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncRepoRequest) Reset()         { *m = SyncRepoRequest{} }
+func (m *SyncRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncRepoRequest) ProtoMessage()    {}
+func (*SyncRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{62}
+}
+
+func (m *SyncRepoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncRepoRequest.Unmarshal(m, b)
+}
+func (m *SyncRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncRepoRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRepoRequest.Merge(m, src)
+}
+func (m *SyncRepoRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncRepoRequest.Size(m)
+}
+func (m *SyncRepoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRepoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncRepoRequest proto.InternalMessageInfo
+
+func (m *SyncRepoRequest) GetRepoId() string {
+	if m != nil {
+		return m.RepoId
+	}
+	return ""
+}
+
+type SyncRepoResponse struct {
+	// synchronized ok or not
+	Failed bool `protobuf:"varint,1,opt,name=failed,proto3" json:"failed,omitempty"`
+	// result
+	Result               string   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncRepoResponse) Reset()         { *m = SyncRepoResponse{} }
+func (m *SyncRepoResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncRepoResponse) ProtoMessage()    {}
+func (*SyncRepoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{63}
+}
+
+func (m *SyncRepoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncRepoResponse.Unmarshal(m, b)
+}
+func (m *SyncRepoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncRepoResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncRepoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRepoResponse.Merge(m, src)
+}
+func (m *SyncRepoResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncRepoResponse.Size(m)
+}
+func (m *SyncRepoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRepoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncRepoResponse proto.InternalMessageInfo
+
+func (m *SyncRepoResponse) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *SyncRepoResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+type ResortAppsRequest struct {
+	AppId                []string `protobuf:"bytes,1,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResortAppsRequest) Reset()         { *m = ResortAppsRequest{} }
+func (m *ResortAppsRequest) String() string { return proto.CompactTextString(m) }
+func (*ResortAppsRequest) ProtoMessage()    {}
+func (*ResortAppsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{64}
+}
+
+func (m *ResortAppsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResortAppsRequest.Unmarshal(m, b)
+}
+func (m *ResortAppsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResortAppsRequest.Marshal(b, m, deterministic)
+}
+func (m *ResortAppsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResortAppsRequest.Merge(m, src)
+}
+func (m *ResortAppsRequest) XXX_Size() int {
+	return xxx_messageInfo_ResortAppsRequest.Size(m)
+}
+func (m *ResortAppsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResortAppsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResortAppsRequest proto.InternalMessageInfo
+
+func (m *ResortAppsRequest) GetAppId() []string {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+type ResortAppsResponse struct {
+	AppId                []string `protobuf:"bytes,1,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResortAppsResponse) Reset()         { *m = ResortAppsResponse{} }
+func (m *ResortAppsResponse) String() string { return proto.CompactTextString(m) }
+func (*ResortAppsResponse) ProtoMessage()    {}
+func (*ResortAppsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{65}
+}
+
+func (m *ResortAppsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResortAppsResponse.Unmarshal(m, b)
+}
+func (m *ResortAppsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResortAppsResponse.Marshal(b, m, deterministic)
+}
+func (m *ResortAppsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResortAppsResponse.Merge(m, src)
+}
+func (m *ResortAppsResponse) XXX_Size() int {
+	return xxx_messageInfo_ResortAppsResponse.Size(m)
+}
+func (m *ResortAppsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResortAppsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResortAppsResponse proto.InternalMessageInfo
+
+func (m *ResortAppsResponse) GetAppId() []string {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("openpitrix.UploadAppAttachmentRequest_Type", UploadAppAttachmentRequest_Type_name, UploadAppAttachmentRequest_Type_value)
+	proto.RegisterType((*CreateAppRequest)(nil), "openpitrix.CreateAppRequest")
+	proto.RegisterType((*CreateAppResponse)(nil), "openpitrix.CreateAppResponse")
+	proto.RegisterType((*ValidatePackageRequest)(nil), "openpitrix.ValidatePackageRequest")
+	proto.RegisterType((*ValidatePackageResponse)(nil), "openpitrix.ValidatePackageResponse")
+	proto.RegisterMapType((map[string]string)(nil), "openpitrix.ValidatePackageResponse.ErrorDetailsEntry")
+	proto.RegisterType((*ModifyAppRequest)(nil), "openpitrix.ModifyAppRequest")
+	proto.RegisterType((*ModifyAppResponse)(nil), "openpitrix.ModifyAppResponse")
+	proto.RegisterType((*UploadAppAttachmentRequest)(nil), "openpitrix.UploadAppAttachmentRequest")
+	proto.RegisterType((*UploadAppAttachmentResponse)(nil), "openpitrix.UploadAppAttachmentResponse")
+	proto.RegisterType((*DeleteAppsRequest)(nil), "openpitrix.DeleteAppsRequest")
+	proto.RegisterType((*DeleteAppsResponse)(nil), "openpitrix.DeleteAppsResponse")
+	proto.RegisterType((*App)(nil), "openpitrix.App")
+	proto.RegisterType((*DescribeAppsRequest)(nil), "openpitrix.DescribeAppsRequest")
+	proto.RegisterType((*DescribeAppsResponse)(nil), "openpitrix.DescribeAppsResponse")
+	proto.RegisterType((*CreateAppVersionRequest)(nil), "openpitrix.CreateAppVersionRequest")
+	proto.RegisterType((*CreateAppVersionResponse)(nil), "openpitrix.CreateAppVersionResponse")
+	proto.RegisterType((*ModifyAppVersionRequest)(nil), "openpitrix.ModifyAppVersionRequest")
+	proto.RegisterMapType((map[string][]byte)(nil), "openpitrix.ModifyAppVersionRequest.PackageFilesEntry")
+	proto.RegisterType((*ModifyAppVersionResponse)(nil), "openpitrix.ModifyAppVersionResponse")
+	proto.RegisterType((*AppVersion)(nil), "openpitrix.AppVersion")
+	proto.RegisterType((*AppVersionAudit)(nil), "openpitrix.AppVersionAudit")
+	proto.RegisterType((*AppVersionReviewPhase)(nil), "openpitrix.AppVersionReviewPhase")
+	proto.RegisterType((*AppVersionReview)(nil), "openpitrix.AppVersionReview")
+	proto.RegisterMapType((map[string]*AppVersionReviewPhase)(nil), "openpitrix.AppVersionReview.PhaseEntry")
+	proto.RegisterType((*DescribeAppVersionReviewsRequest)(nil), "openpitrix.DescribeAppVersionReviewsRequest")
+	proto.RegisterType((*DescribeAppVersionReviewsResponse)(nil), "openpitrix.DescribeAppVersionReviewsResponse")
+	proto.RegisterType((*DescribeAppVersionAuditsRequest)(nil), "openpitrix.DescribeAppVersionAuditsRequest")
 	proto.RegisterType((*DescribeAppVersionAuditsResponse)(nil), "openpitrix.DescribeAppVersionAuditsResponse")
 	proto.RegisterType((*DescribeAppVersionsRequest)(nil), "openpitrix.DescribeAppVersionsRequest")
 	proto.RegisterType((*DescribeAppVersionsResponse)(nil), "openpitrix.DescribeAppVersionsResponse")
@@ -3805,6 +4551,17 @@ func init() {
 	proto.RegisterType((*SuspendAppVersionResponse)(nil), "openpitrix.SuspendAppVersionResponse")
 	proto.RegisterType((*RecoverAppVersionRequest)(nil), "openpitrix.RecoverAppVersionRequest")
 	proto.RegisterType((*RecoverAppVersionResponse)(nil), "openpitrix.RecoverAppVersionResponse")
+	proto.RegisterType((*ReviewAutoPass)(nil), "openpitrix.ReviewAutoPass")
+	proto.RegisterType((*ReviewStage)(nil), "openpitrix.ReviewStage")
+	proto.RegisterType((*ReviewPolicy)(nil), "openpitrix.ReviewPolicy")
+	proto.RegisterType((*CreateReviewPolicyRequest)(nil), "openpitrix.CreateReviewPolicyRequest")
+	proto.RegisterType((*CreateReviewPolicyResponse)(nil), "openpitrix.CreateReviewPolicyResponse")
+	proto.RegisterType((*ModifyReviewPolicyRequest)(nil), "openpitrix.ModifyReviewPolicyRequest")
+	proto.RegisterType((*ModifyReviewPolicyResponse)(nil), "openpitrix.ModifyReviewPolicyResponse")
+	proto.RegisterType((*DeleteReviewPoliciesRequest)(nil), "openpitrix.DeleteReviewPoliciesRequest")
+	proto.RegisterType((*DeleteReviewPoliciesResponse)(nil), "openpitrix.DeleteReviewPoliciesResponse")
+	proto.RegisterType((*DescribeReviewPoliciesRequest)(nil), "openpitrix.DescribeReviewPoliciesRequest")
+	proto.RegisterType((*DescribeReviewPoliciesResponse)(nil), "openpitrix.DescribeReviewPoliciesResponse")
 	proto.RegisterType((*SyncRepoRequest)(nil), "openpitrix.SyncRepoRequest")
 	proto.RegisterType((*SyncRepoResponse)(nil), "openpitrix.SyncRepoResponse")
 	proto.RegisterType((*ResortAppsRequest)(nil), "openpitrix.ResortAppsRequest")
@@ -3814,259 +4571,309 @@ func init() {
 func init() { proto.RegisterFile("app.proto", fileDescriptor_e0f9056a14b86d47) }
 
 var fileDescriptor_e0f9056a14b86d47 = []byte{
-	// 4021 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x6c, 0x24, 0x49,
-	0x56, 0xde, 0xac, 0xb2, 0xdd, 0xf6, 0x2b, 0xbb, 0xed, 0x0a, 0xb7, 0xed, 0x72, 0xf5, 0x5f, 0x76,
-	0x76, 0xef, 0xf4, 0x5f, 0xb5, 0x3d, 0xeb, 0x99, 0xd9, 0x69, 0x66, 0x98, 0x6e, 0x55, 0x77, 0xcf,
-	0xec, 0xf4, 0xc2, 0x34, 0x4d, 0xb5, 0xa7, 0x1b, 0x1a, 0x89, 0x22, 0x5c, 0x15, 0xb6, 0x73, 0xbb,
-	0x9c, 0x99, 0x9b, 0x11, 0x65, 0xe3, 0x0b, 0x87, 0x95, 0x38, 0x82, 0x44, 0xad, 0x04, 0x08, 0x2d,
-	0x1a, 0xfe, 0xa5, 0x1d, 0x89, 0x15, 0x02, 0x56, 0x5a, 0x60, 0xa4, 0x15, 0xb0, 0xe2, 0xc2, 0x8f,
-	0x90, 0xe0, 0xc4, 0x81, 0x13, 0x27, 0x8e, 0x9c, 0x39, 0xa0, 0xf8, 0xc9, 0xcc, 0xc8, 0xac, 0xca,
-	0xaa, 0xcc, 0x72, 0x59, 0xc3, 0x6a, 0xf7, 0x64, 0x57, 0xc4, 0x7b, 0x11, 0x2f, 0xde, 0xfb, 0xe2,
-	0xc5, 0x7b, 0x2f, 0x22, 0x61, 0x0e, 0x7b, 0xde, 0x86, 0xe7, 0xbb, 0xcc, 0x45, 0xe0, 0x7a, 0xc4,
-	0xf1, 0x6c, 0xe6, 0xdb, 0xbf, 0x5c, 0xbd, 0xb4, 0xe7, 0xba, 0x7b, 0x1d, 0xb2, 0x29, 0x7a, 0x76,
-	0xba, 0xbb, 0x9b, 0x47, 0x3e, 0xf6, 0x3c, 0xe2, 0x53, 0x49, 0x5b, 0xbd, 0x9c, 0xec, 0x67, 0xf6,
-	0x01, 0xa1, 0x0c, 0x1f, 0xa8, 0xc1, 0xaa, 0x17, 0x14, 0x01, 0xf6, 0xec, 0x4d, 0xec, 0x38, 0x2e,
-	0xc3, 0xcc, 0x76, 0x9d, 0x80, 0xbd, 0x26, 0xfe, 0xb4, 0xee, 0xec, 0x11, 0xe7, 0x0e, 0x3d, 0xc2,
-	0x7b, 0x7b, 0xc4, 0xdf, 0x74, 0x3d, 0x41, 0x31, 0x80, 0xba, 0xc4, 0x8e, 0x3d, 0xa2, 0x7e, 0x58,
-	0xbf, 0x56, 0x84, 0xa5, 0x87, 0x3e, 0xc1, 0x8c, 0xd4, 0x3d, 0xaf, 0x41, 0xbe, 0xde, 0x25, 0x94,
-	0xa1, 0xd7, 0x61, 0xca, 0xc1, 0x07, 0xa4, 0x62, 0x98, 0xc6, 0x8d, 0xd2, 0xd6, 0x85, 0x0d, 0x39,
-	0xf9, 0x46, 0x20, 0xdd, 0xc6, 0x33, 0xe6, 0xdb, 0xce, 0xde, 0x73, 0xdc, 0xe9, 0x92, 0x86, 0xa0,
-	0x44, 0xf7, 0x61, 0xfe, 0x90, 0xf8, 0xd4, 0x76, 0x9d, 0x26, 0x1f, 0xbd, 0x52, 0xc8, 0xc0, 0x59,
-	0x52, 0x1c, 0xdb, 0xc7, 0x1e, 0x41, 0x8f, 0x60, 0x31, 0x18, 0xc0, 0xc3, 0xad, 0x57, 0x78, 0x8f,
-	0x54, 0x8a, 0x62, 0x8c, 0xf3, 0x7d, 0x63, 0x3c, 0x38, 0x66, 0x84, 0xca, 0x21, 0xce, 0x2a, 0x9e,
-	0xa7, 0x92, 0x45, 0x17, 0x43, 0x2c, 0x60, 0x3a, 0x87, 0x18, 0x4f, 0xf8, 0x3a, 0x36, 0x61, 0xca,
-	0x6e, 0xb9, 0x4e, 0x65, 0x66, 0xf4, 0xdc, 0x82, 0x10, 0x6d, 0x40, 0xd1, 0xa6, 0x87, 0x95, 0x33,
-	0x19, 0x26, 0xe2, 0x84, 0xe8, 0x12, 0x40, 0x0b, 0x33, 0xb2, 0xe7, 0xfa, 0x36, 0xa1, 0x95, 0x59,
-	0xb3, 0x78, 0x63, 0xae, 0xa1, 0xb5, 0x58, 0xbf, 0x6a, 0x40, 0x59, 0xb3, 0x07, 0xf5, 0x5c, 0x87,
-	0x12, 0xf4, 0x06, 0xcc, 0x60, 0xcf, 0x6b, 0xda, 0xed, 0x4c, 0x26, 0x99, 0xc6, 0x9e, 0xf7, 0xb8,
-	0x8d, 0xde, 0x05, 0x08, 0x94, 0x61, 0xb7, 0x33, 0x59, 0x64, 0x4e, 0xd1, 0x3f, 0x6e, 0x5b, 0x6d,
-	0x58, 0x7d, 0x8e, 0x3b, 0x76, 0x1b, 0x33, 0xa2, 0x94, 0x1b, 0x80, 0xe3, 0xca, 0x00, 0x53, 0xcf,
-	0xc5, 0x8d, 0x79, 0x7d, 0xb0, 0x31, 0xe7, 0x93, 0xf6, 0xb2, 0xfe, 0xb9, 0x08, 0x6b, 0x7d, 0xd3,
-	0xa8, 0x35, 0xbf, 0x84, 0x05, 0xe2, 0xfb, 0xae, 0xdf, 0x6c, 0x13, 0x86, 0xed, 0x0e, 0xad, 0x18,
-	0x66, 0xf1, 0x46, 0x69, 0xeb, 0xad, 0x8d, 0x68, 0x5f, 0x6d, 0xa4, 0xf0, 0x6e, 0xbc, 0xcf, 0x19,
-	0x1f, 0x49, 0xbe, 0xf7, 0x1d, 0xe6, 0x1f, 0x37, 0xe6, 0x89, 0xd6, 0x84, 0xb6, 0x60, 0x5a, 0xfc,
-	0xce, 0xa4, 0x15, 0x49, 0x1a, 0x6e, 0x8a, 0xe2, 0x38, 0x9b, 0x42, 0x70, 0x4e, 0xe5, 0x45, 0xe3,
-	0x06, 0x14, 0xbb, 0x7e, 0x27, 0x13, 0x8a, 0x39, 0x21, 0xba, 0x07, 0xa5, 0x36, 0xa1, 0x2d, 0xdf,
-	0x16, 0x7b, 0x5f, 0x81, 0x78, 0xc4, 0x7c, 0x1a, 0x43, 0xf5, 0x3e, 0x94, 0xfb, 0x34, 0x87, 0x96,
-	0xa0, 0xf8, 0x8a, 0x1c, 0x0b, 0xe0, 0xcd, 0x35, 0xf8, 0xbf, 0xe8, 0x1c, 0x4c, 0x1f, 0x72, 0x66,
-	0x65, 0x7a, 0xf9, 0xe3, 0x9d, 0xc2, 0x5d, 0xc3, 0xfa, 0xc6, 0x34, 0x2c, 0x7d, 0xe4, 0xb6, 0xed,
-	0xdd, 0x63, 0xcd, 0x9b, 0x8c, 0x05, 0xde, 0x40, 0xdb, 0x85, 0xcc, 0xda, 0x4e, 0x2c, 0xbe, 0x98,
-	0x73, 0xf1, 0x7c, 0xc6, 0x7d, 0x37, 0xa3, 0x95, 0x04, 0x25, 0x9f, 0xf1, 0x00, 0xdb, 0x0e, 0xc3,
-	0xb6, 0x43, 0x7c, 0x9a, 0xc9, 0x07, 0xe8, 0x0c, 0xe8, 0xcb, 0x70, 0x86, 0xba, 0x5d, 0xbf, 0x25,
-	0x1c, 0xc1, 0x68, 0xde, 0x80, 0x18, 0xbd, 0x09, 0x33, 0x3e, 0xc1, 0xed, 0x03, 0x52, 0x99, 0xcb,
-	0xc0, 0xa6, 0x68, 0xb9, 0xb4, 0x78, 0x87, 0x32, 0x1f, 0xb7, 0x84, 0x7e, 0x20, 0x8b, 0xb4, 0x1a,
-	0x03, 0x07, 0x23, 0x73, 0x69, 0xa5, 0x94, 0x05, 0x8c, 0xcc, 0xa5, 0xe8, 0x3d, 0x28, 0x29, 0xbf,
-	0x76, 0xcc, 0x6d, 0x3f, 0x9f, 0x81, 0x2f, 0x70, 0x84, 0xc7, 0x8f, 0xdb, 0xe8, 0x2e, 0xcc, 0xbe,
-	0x22, 0xc7, 0x47, 0xae, 0xdf, 0xa6, 0x95, 0x85, 0x0c, 0xbc, 0x21, 0xb5, 0xf5, 0x21, 0x94, 0x35,
-	0x0c, 0x9e, 0xc0, 0x83, 0x5a, 0x7f, 0x5d, 0x80, 0xea, 0xc7, 0x5e, 0xc7, 0xc5, 0xed, 0xba, 0xe7,
-	0xd5, 0x19, 0xc3, 0xad, 0xfd, 0x03, 0xe2, 0xb0, 0x13, 0x01, 0xfb, 0x3e, 0x4c, 0x85, 0x6e, 0xf3,
-	0xec, 0xd6, 0x6d, 0xdd, 0x9b, 0xa5, 0x4f, 0xb5, 0xc1, 0xdd, 0x6a, 0x43, 0x30, 0xa2, 0xaf, 0x02,
-	0xc2, 0x61, 0x7f, 0xb3, 0xe5, 0x3a, 0x8c, 0x38, 0x2c, 0xcb, 0x61, 0x59, 0x8e, 0xd8, 0x1e, 0x4a,
-	0x2e, 0xae, 0x64, 0xca, 0x67, 0x70, 0x5a, 0xe9, 0xb8, 0xff, 0xf8, 0xb1, 0xc3, 0xde, 0xd8, 0x52,
-	0x4a, 0x0e, 0xa8, 0x2d, 0x13, 0xa6, 0x84, 0xab, 0x9f, 0x95, 0x07, 0xe6, 0xd2, 0x17, 0xd0, 0x59,
-	0x00, 0xda, 0xf2, 0x09, 0x71, 0xe8, 0xbe, 0xcb, 0x96, 0x0c, 0xab, 0x01, 0xe7, 0x07, 0x2e, 0xe8,
-	0x24, 0x06, 0xb9, 0x05, 0xe5, 0x47, 0xa4, 0x43, 0xc4, 0xe1, 0x48, 0x03, 0x33, 0xac, 0x68, 0x23,
-	0xf1, 0xe3, 0x54, 0xd1, 0xde, 0x06, 0xa4, 0xd3, 0xaa, 0x69, 0x53, 0x88, 0xff, 0x61, 0x01, 0x8a,
-	0x75, 0xcf, 0x1b, 0xcf, 0xa4, 0x5b, 0x30, 0xc3, 0xf7, 0xc8, 0x61, 0xe0, 0xad, 0xaa, 0xfd, 0x56,
-	0x70, 0xdd, 0x8e, 0xda, 0x8d, 0x92, 0x72, 0x8c, 0xd3, 0xe4, 0x2d, 0x38, 0xe3, 0x13, 0xcf, 0xe5,
-	0xb2, 0x4d, 0x65, 0xdb, 0xf6, 0x9e, 0xfb, 0xb8, 0x9d, 0x74, 0x8b, 0xd3, 0x79, 0xdd, 0xe2, 0x9b,
-	0x30, 0x43, 0x19, 0x66, 0x5d, 0x9a, 0xe9, 0x38, 0x51, 0xb4, 0xa1, 0x33, 0x3d, 0x93, 0xd9, 0x99,
-	0xbe, 0xae, 0x22, 0xaf, 0x2c, 0x9e, 0x50, 0x86, 0x5e, 0xf7, 0xa0, 0x14, 0x01, 0x8e, 0x66, 0xf2,
-	0x85, 0x3a, 0x43, 0xd2, 0x7d, 0x43, 0x5e, 0xf7, 0xad, 0x7b, 0xa8, 0x52, 0x1e, 0x0f, 0xa5, 0x3b,
-	0xfe, 0xf9, 0xf1, 0x1c, 0xff, 0x42, 0x0e, 0xc7, 0xff, 0x2e, 0x40, 0x6b, 0x1f, 0xfb, 0x4c, 0x06,
-	0x21, 0x67, 0xb3, 0xc4, 0x81, 0x82, 0xfe, 0x09, 0xee, 0x3f, 0x35, 0x16, 0xc7, 0x3c, 0x35, 0x96,
-	0xb2, 0x9e, 0x1a, 0x5b, 0x30, 0xed, 0x1e, 0x39, 0xc4, 0xaf, 0x94, 0xb3, 0xec, 0x3f, 0x41, 0x8a,
-	0xde, 0x85, 0x52, 0x4b, 0x84, 0xcc, 0x4d, 0x9e, 0x36, 0x55, 0x50, 0xca, 0x26, 0xdc, 0x0e, 0x72,
-	0xaa, 0x06, 0x48, 0x72, 0xde, 0xc0, 0x99, 0x25, 0x66, 0x25, 0xf3, 0xf2, 0x68, 0x66, 0x49, 0x1e,
-	0x30, 0x77, 0xbd, 0x76, 0x38, 0xf3, 0xb9, 0xd1, 0xcc, 0x92, 0x5c, 0x30, 0xdf, 0x87, 0xf9, 0xf0,
-	0x80, 0xa4, 0x84, 0x55, 0x56, 0x44, 0x7c, 0x7b, 0x41, 0x3f, 0x11, 0x1a, 0x44, 0x9a, 0xfe, 0xa1,
-	0xa2, 0x6b, 0x84, 0x47, 0xea, 0x33, 0xc2, 0xd0, 0x23, 0x40, 0x1d, 0xcc, 0x08, 0x65, 0x4d, 0xee,
-	0xb3, 0x54, 0xe0, 0x58, 0x59, 0x15, 0x42, 0xac, 0xea, 0xc3, 0xd4, 0x3d, 0xef, 0xb9, 0xec, 0x6d,
-	0x2c, 0x49, 0x8e, 0xa8, 0x05, 0x7d, 0x08, 0x65, 0x8d, 0x5d, 0xc4, 0xf4, 0xb4, 0xb2, 0x96, 0x41,
-	0xfb, 0x8b, 0x38, 0x1c, 0x84, 0x1f, 0x05, 0x54, 0x2c, 0xc8, 0x3d, 0xf0, 0xb0, 0x73, 0x2c, 0xa1,
-	0x56, 0xc9, 0x02, 0x16, 0xc5, 0x21, 0xc0, 0xf6, 0x3e, 0x2c, 0x06, 0x03, 0x1c, 0x91, 0x1d, 0x6a,
-	0x33, 0x52, 0x59, 0xcf, 0x30, 0xc6, 0x59, 0xc5, 0xf4, 0x42, 0xf2, 0xe8, 0xc3, 0x78, 0xbe, 0xbb,
-	0x6b, 0x77, 0x48, 0xa5, 0x9a, 0x63, 0x98, 0xa7, 0x92, 0x07, 0x7d, 0x00, 0xe5, 0x60, 0x98, 0xaf,
-	0xb9, 0xb6, 0x23, 0x4d, 0x7c, 0x7e, 0xa4, 0x89, 0x83, 0xb9, 0xbf, 0xea, 0xda, 0x8e, 0x02, 0x09,
-	0x08, 0x9c, 0x36, 0x3d, 0xcc, 0xf6, 0x2b, 0x17, 0xb2, 0xec, 0x3f, 0x41, 0xff, 0x14, 0xb3, 0xfd,
-	0x20, 0xbf, 0xbc, 0x98, 0x31, 0xbf, 0xb4, 0xfe, 0xb3, 0x08, 0xcb, 0x8f, 0x84, 0xfb, 0xde, 0x89,
-	0x1d, 0x92, 0xef, 0x41, 0x89, 0x12, 0xec, 0xb7, 0xf6, 0x9b, 0xdc, 0x05, 0x65, 0x3a, 0xdd, 0x40,
-	0x32, 0xbc, 0x70, 0xfd, 0x36, 0x7a, 0x1b, 0x66, 0xa9, 0xeb, 0xb3, 0x26, 0xcf, 0x04, 0x0a, 0xd9,
-	0x5c, 0x96, 0xcf, 0x7e, 0x8a, 0x1c, 0xa3, 0x37, 0xf9, 0xa9, 0xc5, 0xb1, 0x15, 0x1c, 0x75, 0xc3,
-	0x0e, 0xc7, 0x80, 0x94, 0x67, 0x18, 0x1d, 0xfb, 0xc0, 0x66, 0xe2, 0xa4, 0x5b, 0x68, 0xc8, 0x1f,
-	0x68, 0x15, 0x66, 0xdc, 0xdd, 0x5d, 0xbe, 0x55, 0xa6, 0x45, 0xb3, 0xfa, 0xa5, 0x9d, 0xe9, 0x25,
-	0xed, 0x4c, 0x47, 0x48, 0x1d, 0xb1, 0xf3, 0xa2, 0x51, 0x1e, 0xa2, 0x6b, 0xd1, 0x21, 0xba, 0x20,
-	0x9a, 0x83, 0x63, 0x72, 0x35, 0x3c, 0xe6, 0xce, 0xca, 0x76, 0x75, 0x90, 0x9d, 0x0b, 0xfc, 0xd1,
-	0xa2, 0x1c, 0x5a, 0x7a, 0x9c, 0x8b, 0x31, 0x97, 0xba, 0x24, 0xba, 0x34, 0xa7, 0x79, 0x39, 0x1e,
-	0xfa, 0x96, 0x63, 0x59, 0x3e, 0x0f, 0x6e, 0xaf, 0xc3, 0x62, 0xdb, 0xa6, 0x5e, 0x07, 0x1f, 0x37,
-	0x5b, 0x6e, 0xa7, 0x7b, 0xe0, 0xd0, 0x0a, 0x12, 0x44, 0x67, 0x55, 0xf3, 0x43, 0xd9, 0xca, 0x93,
-	0x2f, 0x6e, 0xfe, 0x65, 0xd1, 0x29, 0x0c, 0x8c, 0xe1, 0x5c, 0xdc, 0xbe, 0x2a, 0xb0, 0xb9, 0x0c,
-	0x25, 0xe6, 0x32, 0xdc, 0x69, 0xb6, 0xdc, 0xae, 0xc3, 0x84, 0x81, 0x17, 0x1a, 0x20, 0x9a, 0x1e,
-	0xf2, 0x16, 0x74, 0x03, 0xce, 0x70, 0x2d, 0x71, 0xf5, 0x15, 0x84, 0xa7, 0x59, 0x4c, 0xb8, 0x88,
-	0x06, 0xd7, 0xe2, 0x33, 0xc2, 0xac, 0x6f, 0x17, 0x60, 0x2d, 0xac, 0x41, 0x04, 0x8e, 0xe3, 0x87,
-	0x2e, 0x99, 0x13, 0x51, 0x76, 0xa6, 0x64, 0x4e, 0x84, 0xd5, 0x6f, 0xc1, 0x99, 0xa0, 0x56, 0x31,
-	0x3d, 0x3a, 0x96, 0x0e, 0x68, 0xad, 0x17, 0x50, 0xe9, 0x57, 0x95, 0x32, 0x49, 0xbc, 0x00, 0x63,
-	0xe4, 0x2b, 0xc0, 0x7c, 0xab, 0x08, 0x6b, 0x61, 0x1a, 0x93, 0x30, 0xc2, 0x49, 0x06, 0xfe, 0x1c,
-	0x8c, 0xa1, 0xa9, 0x76, 0x2a, 0xbb, 0x6a, 0xd1, 0x4b, 0x58, 0x50, 0xff, 0x36, 0xb9, 0x3f, 0xa6,
-	0x95, 0xe9, 0xfe, 0x02, 0x50, 0x8a, 0x86, 0x36, 0x54, 0x41, 0xe8, 0x03, 0xce, 0xa7, 0x0a, 0x40,
-	0x9e, 0xd6, 0x54, 0xbd, 0x0f, 0xe5, 0x3e, 0x92, 0x51, 0x95, 0x8e, 0x79, 0xbd, 0xd2, 0xf1, 0x02,
-	0x2a, 0xfd, 0x73, 0x4f, 0xc2, 0xee, 0xdf, 0x01, 0x00, 0xed, 0x74, 0x3e, 0x91, 0xa9, 0xc7, 0x49,
-	0x4c, 0xa2, 0x0d, 0x5e, 0xcc, 0x93, 0x01, 0x29, 0x2f, 0x39, 0x95, 0x3d, 0x6a, 0x0b, 0x70, 0x38,
-	0x3d, 0x2e, 0x0e, 0x67, 0xc6, 0xad, 0xf0, 0xfc, 0x38, 0x29, 0xf9, 0xfc, 0x93, 0x92, 0xfb, 0x10,
-	0x6c, 0xc8, 0xec, 0x69, 0x49, 0x49, 0x71, 0x88, 0x33, 0x36, 0xca, 0x4b, 0x17, 0x73, 0xe4, 0xa5,
-	0x3f, 0x01, 0x73, 0x3e, 0x39, 0xb4, 0xc9, 0x11, 0x07, 0x78, 0x96, 0xa4, 0x64, 0x56, 0x92, 0x8b,
-	0x72, 0x7a, 0x2c, 0xcb, 0x28, 0x9f, 0x24, 0xcb, 0x40, 0x27, 0xc9, 0x32, 0x96, 0x73, 0x65, 0x19,
-	0x7a, 0x89, 0xe7, 0x5c, 0x9e, 0x12, 0x0f, 0x07, 0xc4, 0x01, 0xa1, 0x94, 0xbb, 0xed, 0x95, 0x2c,
-	0x80, 0x50, 0xc4, 0xe1, 0xd9, 0xbb, 0x9a, 0xf9, 0xec, 0x8d, 0x47, 0xc8, 0x6b, 0xb9, 0x22, 0x64,
-	0xeb, 0xf7, 0xa7, 0x61, 0x31, 0x72, 0x98, 0xf5, 0x6e, 0xdb, 0x3e, 0xe1, 0x01, 0x19, 0x79, 0xc0,
-	0x42, 0x76, 0x0f, 0x18, 0xc1, 0xb1, 0x98, 0x03, 0x8e, 0x27, 0xbe, 0x21, 0x78, 0x1b, 0x66, 0xb9,
-	0xac, 0x99, 0x1d, 0x29, 0x0f, 0x01, 0x05, 0xe3, 0x5d, 0x98, 0x75, 0x3d, 0xe2, 0x63, 0xe6, 0xfa,
-	0x99, 0x1c, 0x69, 0x48, 0x8d, 0xea, 0xb0, 0x10, 0xfc, 0x2f, 0x2f, 0x80, 0xb2, 0xb8, 0xd3, 0xf9,
-	0x80, 0x45, 0x14, 0x0d, 0x35, 0x64, 0xcd, 0xe6, 0x41, 0x56, 0x6c, 0xf7, 0xce, 0xe5, 0xdd, 0xbd,
-	0xfa, 0x06, 0x84, 0x5c, 0x1b, 0x30, 0x79, 0xbb, 0x59, 0xca, 0x79, 0xbb, 0x69, 0xfd, 0x6f, 0x01,
-	0x56, 0xf4, 0x40, 0x81, 0x0b, 0xf5, 0x74, 0x1f, 0x53, 0xdd, 0x8d, 0x19, 0x39, 0x70, 0xa3, 0x5b,
-	0xaf, 0x70, 0x32, 0xeb, 0x15, 0x4f, 0x62, 0xbd, 0xa9, 0x3c, 0xd6, 0x4b, 0x98, 0x60, 0x3a, 0xaf,
-	0x0f, 0x54, 0xa6, 0x17, 0xcc, 0x33, 0xa3, 0x99, 0x25, 0x39, 0x6f, 0xb0, 0xfe, 0x7e, 0x1a, 0x96,
-	0x92, 0xea, 0x8f, 0x83, 0xc9, 0xc8, 0x09, 0xa6, 0xf1, 0x6f, 0x56, 0xc7, 0x0b, 0xb0, 0x3e, 0x3f,
-	0x47, 0x31, 0x5e, 0xfd, 0xf7, 0x3d, 0x98, 0xf6, 0x38, 0xbe, 0x2b, 0x67, 0x44, 0xcc, 0x7e, 0x3d,
-	0xa5, 0x1a, 0x25, 0x14, 0xba, 0x21, 0x76, 0x82, 0x8c, 0xd2, 0x25, 0x57, 0x12, 0x2a, 0xb3, 0xb9,
-	0xa0, 0x72, 0x17, 0x94, 0xa5, 0x88, 0x9f, 0xc7, 0x49, 0x10, 0xbf, 0x6f, 0x9f, 0x43, 0xce, 0x7d,
-	0x5e, 0xfd, 0x05, 0x80, 0x68, 0x31, 0x03, 0xf2, 0x89, 0xb7, 0xf5, 0x7c, 0xa2, 0xb4, 0x75, 0x65,
-	0x98, 0x5a, 0xc4, 0x40, 0x7a, 0xca, 0xf1, 0x9d, 0x22, 0x98, 0x5a, 0xea, 0x1f, 0x23, 0xfe, 0x11,
-	0xab, 0xf3, 0x80, 0x5e, 0xe7, 0xb9, 0x18, 0xdb, 0x8d, 0xb2, 0x04, 0xa4, 0xed, 0xb7, 0xf3, 0xfa,
-	0x3e, 0x97, 0xb5, 0xa0, 0x68, 0x27, 0x47, 0x65, 0x9f, 0x85, 0x58, 0xd9, 0x67, 0x40, 0x81, 0xe6,
-	0xec, 0xc0, 0x02, 0x4d, 0x55, 0x03, 0xdb, 0xa2, 0x3e, 0x38, 0xf1, 0xad, 0xdf, 0x32, 0xe0, 0xca,
-	0x10, 0x83, 0x65, 0x2d, 0xdc, 0xfc, 0x2c, 0xac, 0xea, 0x05, 0x5a, 0xb5, 0x98, 0xa8, 0x8e, 0x73,
-	0x61, 0x18, 0x8a, 0x1a, 0xcb, 0x38, 0xd1, 0xf2, 0x8c, 0x30, 0xeb, 0xbb, 0x45, 0xb8, 0xdc, 0x2f,
-	0x99, 0x88, 0x9d, 0x7e, 0x8c, 0xa4, 0x54, 0x24, 0x45, 0x60, 0x99, 0x8f, 0x81, 0xa5, 0xaa, 0x9d,
-	0xc6, 0x12, 0x46, 0xd1, 0x79, 0x7b, 0x35, 0x79, 0xde, 0x4a, 0x18, 0xc5, 0x4f, 0xd4, 0x01, 0x68,
-	0x5b, 0x1c, 0x84, 0x36, 0xeb, 0x9b, 0xc6, 0x20, 0x17, 0x10, 0xd8, 0x2d, 0x2b, 0xa0, 0x9e, 0xc0,
-	0x8a, 0x0e, 0x28, 0xcc, 0xd9, 0x35, 0x3c, 0x9d, 0x1f, 0x8c, 0x27, 0x31, 0x4b, 0x03, 0xe1, 0x78,
-	0x03, 0x47, 0xd3, 0xff, 0x14, 0xa1, 0xda, 0x2f, 0xd5, 0x8f, 0x04, 0x90, 0xe2, 0x88, 0x81, 0x24,
-	0x62, 0x72, 0x54, 0xa6, 0xc3, 0x42, 0xf3, 0x82, 0x5e, 0x68, 0x36, 0xe3, 0x25, 0x0f, 0x09, 0x9e,
-	0x58, 0x51, 0xe3, 0x4a, 0x22, 0x91, 0x96, 0xc0, 0x89, 0xa5, 0xca, 0x11, 0x6e, 0x97, 0x62, 0xb8,
-	0x45, 0x2a, 0x51, 0x93, 0xf5, 0x69, 0x99, 0x8a, 0x65, 0xad, 0x4c, 0x5b, 0xbf, 0x02, 0xe7, 0x07,
-	0xda, 0x3c, 0x2b, 0x08, 0xef, 0xc1, 0xa2, 0x0e, 0xc2, 0x08, 0x7e, 0x69, 0x37, 0x57, 0x0b, 0x11,
-	0xf2, 0x38, 0xe8, 0x5e, 0xc2, 0xf9, 0xaf, 0x10, 0xed, 0x1e, 0x2b, 0xf1, 0x4a, 0xed, 0x44, 0x35,
-	0xb8, 0x4f, 0x0d, 0xb8, 0x30, 0x78, 0x70, 0xb5, 0xba, 0x4a, 0x54, 0xd1, 0x34, 0x44, 0x65, 0x30,
-	0x2c, 0x5a, 0x8e, 0x95, 0x3c, 0xc6, 0x85, 0x2d, 0xe6, 0x13, 0xb6, 0x0b, 0xe6, 0x20, 0x59, 0x45,
-	0x5d, 0x73, 0x22, 0x05, 0xe3, 0x73, 0x30, 0x2d, 0xeb, 0xaf, 0x05, 0x89, 0x4c, 0xf1, 0xc3, 0xfa,
-	0x6f, 0x03, 0xae, 0x0c, 0x99, 0x57, 0x29, 0xea, 0x49, 0xc0, 0x2b, 0x1f, 0xef, 0xdd, 0xd5, 0x6d,
-	0x3b, 0x92, 0x7b, 0x43, 0x2b, 0xdf, 0xca, 0x61, 0x4e, 0x14, 0x79, 0x57, 0xef, 0x02, 0x8c, 0x59,
-	0xed, 0x5d, 0x87, 0x35, 0x29, 0xed, 0x33, 0x86, 0x99, 0x4d, 0x99, 0xdd, 0x0a, 0x54, 0x6b, 0x7d,
-	0xbb, 0x08, 0x95, 0xfe, 0x3e, 0xb5, 0x7c, 0x17, 0x56, 0x3a, 0x98, 0xb2, 0x26, 0x3b, 0x72, 0x9b,
-	0x47, 0x84, 0xbc, 0x6a, 0xca, 0x92, 0x50, 0x5b, 0xa9, 0xe3, 0x27, 0xfb, 0xd5, 0xd1, 0x3f, 0xc8,
-	0xc6, 0x4f, 0x63, 0xca, 0xb6, 0x8f, 0xdc, 0x17, 0x84, 0xbc, 0x92, 0x57, 0x0d, 0x6d, 0xa9, 0x12,
-	0xd4, 0xe9, 0xeb, 0x40, 0x3f, 0x0f, 0x0b, 0xcc, 0xf5, 0x9a, 0x8c, 0xf0, 0x38, 0xc1, 0x73, 0xa9,
-	0xda, 0x53, 0x6f, 0x65, 0x9a, 0x68, 0xdb, 0xf5, 0xb6, 0x89, 0xd3, 0xe0, 0x7c, 0x72, 0x86, 0x12,
-	0x8b, 0x5a, 0x78, 0x1c, 0xc5, 0x91, 0x2d, 0xf7, 0x73, 0x51, 0xec, 0x67, 0x9e, 0x52, 0xc8, 0xdd,
-	0x7c, 0x11, 0x40, 0xdc, 0xab, 0xc9, 0x5e, 0xe9, 0x3a, 0xe7, 0x78, 0x8b, 0xe8, 0xae, 0xbe, 0x0f,
-	0x6b, 0x29, 0xab, 0x18, 0x65, 0x86, 0x05, 0xcd, 0x0c, 0xd5, 0x7b, 0xb0, 0x94, 0x94, 0x31, 0x0f,
-	0xbf, 0xf5, 0x1c, 0xd6, 0x9e, 0x75, 0x77, 0x0e, 0x6c, 0x36, 0xd9, 0x2b, 0x15, 0xeb, 0x05, 0x54,
-	0xfa, 0xc7, 0x9d, 0xc4, 0x65, 0xc0, 0x73, 0x58, 0x7b, 0x88, 0x9d, 0x16, 0xe9, 0x4c, 0x5e, 0xe0,
-	0xfe, 0x71, 0x27, 0x21, 0xf0, 0x0b, 0xa8, 0x34, 0x48, 0x87, 0x60, 0x4a, 0x26, 0x2c, 0xf1, 0xcf,
-	0xc1, 0xfa, 0x80, 0x81, 0x27, 0xa4, 0xe3, 0xf0, 0x9d, 0xd8, 0x84, 0x75, 0xdc, 0x3f, 0xee, 0x84,
-	0x04, 0x96, 0x91, 0xfc, 0xe4, 0x05, 0xee, 0x1f, 0x77, 0x12, 0x02, 0x6f, 0xc3, 0xca, 0x53, 0x4c,
-	0xe9, 0x84, 0xc5, 0xfd, 0x18, 0x56, 0x93, 0xa3, 0x4e, 0x42, 0xd8, 0x5f, 0x37, 0xb8, 0x7a, 0xbf,
-	0x46, 0x5a, 0x13, 0x76, 0x12, 0x7a, 0xd9, 0xac, 0x90, 0xa3, 0x6c, 0x26, 0xcd, 0x92, 0x94, 0x67,
-	0x42, 0x7b, 0xf5, 0x59, 0x97, 0x7a, 0xc4, 0x69, 0x4f, 0x7e, 0xaf, 0x0e, 0x18, 0x78, 0x62, 0xee,
-	0xa5, 0xe5, 0x1e, 0x12, 0xff, 0x34, 0xdc, 0x4b, 0xdf, 0xc0, 0x93, 0x10, 0xf9, 0x16, 0x2c, 0x3e,
-	0x3b, 0x76, 0x5a, 0xfc, 0xc4, 0x0a, 0x24, 0xd5, 0x1e, 0xa1, 0xc8, 0x63, 0x4b, 0x3d, 0x42, 0xb1,
-	0x1e, 0xc0, 0x52, 0x44, 0xab, 0x26, 0x5f, 0x85, 0x99, 0x5d, 0x6c, 0x77, 0x88, 0xa4, 0x9d, 0x6d,
-	0xa8, 0x5f, 0xbc, 0xdd, 0x27, 0xb4, 0xdb, 0x61, 0xea, 0x15, 0xbe, 0xfa, 0x65, 0xdd, 0x82, 0x72,
-	0x83, 0xf0, 0x14, 0x28, 0xdb, 0x13, 0x59, 0x9d, 0x76, 0xe8, 0x13, 0xd9, 0xad, 0x7f, 0x7f, 0x53,
-	0x5c, 0x4c, 0x7f, 0x84, 0x1d, 0xbc, 0x47, 0x7c, 0xf4, 0x11, 0x40, 0xc4, 0x8b, 0x2e, 0x26, 0x5f,
-	0xad, 0xc5, 0xe6, 0xaf, 0x5e, 0x4a, 0xeb, 0x96, 0x53, 0x5a, 0x5f, 0x40, 0x5f, 0x81, 0xd9, 0x60,
-	0xe9, 0x28, 0x96, 0x80, 0x26, 0x94, 0x57, 0xbd, 0x30, 0xb8, 0x33, 0x1c, 0xe8, 0xb7, 0x0d, 0x98,
-	0x0b, 0x5f, 0x64, 0xa0, 0x18, 0x75, 0xf2, 0x3b, 0xa7, 0xea, 0xc5, 0x94, 0x5e, 0x35, 0xd8, 0x93,
-	0x5e, 0xfd, 0x2e, 0xfa, 0xb2, 0x6c, 0x37, 0xb1, 0xe7, 0xd5, 0xcc, 0x2e, 0x25, 0xbe, 0xe9, 0xee,
-	0x9a, 0x36, 0x3d, 0x34, 0x5b, 0xd8, 0x31, 0x5b, 0x61, 0x9f, 0xe9, 0x3a, 0x26, 0xdb, 0x27, 0xa6,
-	0xd7, 0xc1, 0x6c, 0xd7, 0xf5, 0x0f, 0xbe, 0xf1, 0x6f, 0xff, 0xf5, 0xcd, 0xc2, 0x82, 0x35, 0xbb,
-	0x79, 0xf8, 0xa5, 0x4d, 0xec, 0x79, 0xf4, 0x1d, 0xe3, 0x16, 0xfa, 0x33, 0x03, 0x16, 0x13, 0x5f,
-	0xac, 0x20, 0x6b, 0xe8, 0xe7, 0x2c, 0x52, 0xcc, 0xab, 0x19, 0x3e, 0x79, 0xb1, 0xb6, 0x7b, 0xf5,
-	0x3b, 0xe8, 0x76, 0xd0, 0x6b, 0x72, 0x19, 0x30, 0xe3, 0xb2, 0xaa, 0xcc, 0xe4, 0x06, 0xff, 0x6b,
-	0xee, 0x1c, 0x9b, 0xae, 0x67, 0x32, 0xd7, 0xed, 0xdc, 0x14, 0x12, 0x5e, 0xb2, 0xd6, 0x03, 0x09,
-	0x37, 0x0f, 0x15, 0x6f, 0xf0, 0xcd, 0x0e, 0x17, 0xf9, 0x77, 0x0c, 0x58, 0x4a, 0xc6, 0x8b, 0xe8,
-	0xea, 0xf0, 0x68, 0x52, 0x0a, 0x7d, 0x2d, 0x4b, 0xc8, 0x69, 0xbd, 0xdb, 0xab, 0x5f, 0x44, 0x3c,
-	0x91, 0x33, 0x69, 0xd8, 0x69, 0xda, 0xce, 0xae, 0xcb, 0x25, 0xe7, 0x52, 0x09, 0x29, 0x57, 0xd0,
-	0x72, 0x28, 0x65, 0x44, 0x87, 0x7e, 0xaf, 0x00, 0xf3, 0xfa, 0x5b, 0x28, 0x74, 0x59, 0x9f, 0x73,
-	0xc0, 0x2b, 0xb8, 0xaa, 0x99, 0x4e, 0xa0, 0x04, 0xfa, 0x27, 0xa3, 0x57, 0xff, 0x9e, 0x81, 0xbe,
-	0x6b, 0x70, 0x99, 0xf8, 0x84, 0x35, 0x61, 0xe8, 0x5d, 0xbb, 0xc3, 0x88, 0x6f, 0x1e, 0xd9, 0x6c,
-	0x9f, 0x9b, 0x99, 0x12, 0x73, 0xd7, 0x26, 0x9d, 0x36, 0xbd, 0x21, 0x77, 0x4a, 0xcd, 0xe4, 0xb9,
-	0x77, 0xcd, 0x54, 0xdb, 0xba, 0x66, 0x6a, 0x19, 0x7a, 0xcd, 0x94, 0xe9, 0x76, 0xcd, 0xdc, 0x77,
-	0x39, 0x8d, 0xdd, 0x12, 0x6d, 0xd1, 0x9d, 0x7f, 0xcd, 0xd4, 0x2e, 0xf0, 0x6b, 0xa6, 0xba, 0x57,
-	0xe7, 0x83, 0xe1, 0x36, 0x67, 0x10, 0x95, 0x80, 0x9a, 0x19, 0x3d, 0x38, 0xbb, 0xc9, 0xc7, 0xdf,
-	0xc5, 0xdd, 0x0e, 0x33, 0x7d, 0xc2, 0xba, 0xbe, 0x63, 0xe2, 0x4e, 0x27, 0xd2, 0x16, 0xa0, 0x10,
-	0x75, 0xe8, 0x2f, 0x0b, 0x80, 0xc2, 0x75, 0x8a, 0x07, 0x1e, 0x93, 0x52, 0xd4, 0x7f, 0x18, 0xbd,
-	0xfa, 0xf7, 0x0d, 0xf4, 0x99, 0x54, 0x94, 0x18, 0xfa, 0x87, 0x54, 0x5f, 0x65, 0xb4, 0x28, 0xf4,
-	0x25, 0xd6, 0xd0, 0x14, 0x6a, 0xfb, 0x3a, 0xcc, 0x85, 0xaf, 0x7b, 0xe2, 0x3e, 0x24, 0xf9, 0x75,
-	0x53, 0xdc, 0x87, 0xf4, 0x7d, 0x77, 0x62, 0x5d, 0xef, 0xd5, 0xcb, 0x68, 0x51, 0xb6, 0x0b, 0x3f,
-	0xc1, 0xc1, 0x2d, 0x9d, 0xc3, 0x56, 0xcc, 0x39, 0x7c, 0x62, 0xc0, 0xf2, 0x80, 0xef, 0x25, 0xd0,
-	0x6b, 0xd9, 0xbe, 0x10, 0xa9, 0x5e, 0x1f, 0x49, 0xa7, 0x24, 0x7a, 0xbb, 0x57, 0x5f, 0x43, 0x2b,
-	0x92, 0x42, 0x48, 0x14, 0x7d, 0x16, 0x22, 0xe4, 0x5a, 0xdb, 0x42, 0x4a, 0xae, 0xcd, 0xa8, 0x87,
-	0x4b, 0x78, 0x08, 0x10, 0x7d, 0x50, 0x11, 0xf7, 0xf8, 0x7d, 0x1f, 0x65, 0xc4, 0x3d, 0x7e, 0xff,
-	0x77, 0x18, 0xd6, 0xcd, 0x5e, 0x7d, 0x19, 0x95, 0x1f, 0x60, 0xd6, 0xda, 0x37, 0xdb, 0xa2, 0x3b,
-	0x32, 0xc8, 0xc2, 0xad, 0x98, 0x66, 0x7e, 0xd7, 0xd0, 0x3e, 0x51, 0x0d, 0xde, 0x45, 0x5d, 0x1d,
-	0xe8, 0xba, 0xe3, 0x21, 0x41, 0xdc, 0x07, 0xa5, 0x3d, 0xd3, 0xb3, 0xee, 0xf5, 0xea, 0x26, 0xba,
-	0xf4, 0x50, 0x73, 0xe5, 0xbb, 0x26, 0xf5, 0x48, 0xcb, 0xde, 0xb5, 0x5b, 0xa6, 0x3a, 0xc8, 0xa5,
-	0x1b, 0xb2, 0x96, 0x94, 0x5c, 0x41, 0xd9, 0x4a, 0xc8, 0xf7, 0x47, 0x85, 0xd8, 0x93, 0xdb, 0xa0,
-	0x14, 0x16, 0xb7, 0x5c, 0x7a, 0x7d, 0x34, 0x6e, 0xb9, 0x21, 0x35, 0x35, 0xeb, 0x6f, 0x8c, 0x5e,
-	0xfd, 0x13, 0x03, 0x7d, 0x4b, 0x6c, 0xb9, 0x40, 0x02, 0xe5, 0x28, 0x47, 0x6c, 0xbb, 0x28, 0x7e,
-	0xa9, 0x99, 0xf1, 0x2d, 0xa8, 0x76, 0x4b, 0x6c, 0x03, 0xea, 0x05, 0xc5, 0x68, 0x3b, 0xb2, 0x63,
-	0x2f, 0x7d, 0x2b, 0x85, 0xf2, 0x08, 0x4d, 0x21, 0xd4, 0xa7, 0x29, 0xf4, 0xfd, 0x02, 0xac, 0xf7,
-	0xb9, 0xa2, 0xd3, 0x53, 0xd6, 0xbf, 0x1a, 0xbd, 0xfa, 0x9f, 0x1a, 0xe8, 0x53, 0xdd, 0x3f, 0xfd,
-	0xbf, 0xd2, 0x59, 0xe8, 0x32, 0xe3, 0xaa, 0x5b, 0x47, 0x6b, 0x71, 0x6f, 0x14, 0x69, 0xf0, 0xb3,
-	0x02, 0x4f, 0x29, 0x07, 0x57, 0xff, 0xd1, 0xed, 0xe1, 0x8a, 0x89, 0xdd, 0xed, 0x54, 0x6b, 0xd9,
-	0x88, 0x95, 0x2a, 0x7f, 0x60, 0xf4, 0xea, 0x7f, 0x60, 0xa0, 0x4f, 0xa4, 0x2a, 0x45, 0x9f, 0x70,
-	0x63, 0x3c, 0xc2, 0xb0, 0x5d, 0x87, 0x6b, 0x53, 0xc9, 0x78, 0x27, 0xdc, 0x37, 0xe3, 0xa9, 0x37,
-	0x50, 0x5b, 0x70, 0x3b, 0x52, 0x33, 0x7d, 0xb7, 0x33, 0x1a, 0x74, 0x4a, 0x28, 0xa1, 0xbf, 0x0a,
-	0x5a, 0x4d, 0x40, 0x4f, 0x5e, 0x70, 0x50, 0xf4, 0x1b, 0x3a, 0x00, 0x93, 0xd7, 0x71, 0x68, 0x84,
-	0x4a, 0xe2, 0xd7, 0xac, 0xd5, 0x3b, 0x19, 0xa9, 0x95, 0x06, 0x7f, 0xd3, 0xe8, 0xd5, 0x19, 0xf2,
-	0xb9, 0xfe, 0xe4, 0xf5, 0x1d, 0x1d, 0x53, 0x69, 0xe1, 0x4d, 0x66, 0xcd, 0x1c, 0xa6, 0xbf, 0xe0,
-	0x0a, 0xf2, 0x66, 0x0c, 0x52, 0x7d, 0x97, 0x88, 0x54, 0xf8, 0xd6, 0xe4, 0x3b, 0xd6, 0xb8, 0x6f,
-	0x4d, 0x79, 0x61, 0x1b, 0xf7, 0xad, 0x69, 0x4f, 0x61, 0x95, 0x6f, 0x55, 0xc7, 0x5f, 0x60, 0xad,
-	0x20, 0xbe, 0xe3, 0xf1, 0x32, 0xf6, 0x3c, 0xe9, 0x5b, 0xb7, 0x06, 0xfa, 0xd6, 0xbf, 0x30, 0xe0,
-	0xdc, 0xa0, 0x3a, 0x31, 0xba, 0x3e, 0xaa, 0x92, 0x1c, 0xc8, 0x79, 0x63, 0x34, 0xa1, 0x92, 0xf5,
-	0xc3, 0x5e, 0xfd, 0x35, 0x74, 0x8d, 0xdb, 0x48, 0xed, 0xe5, 0x54, 0x23, 0xa5, 0x68, 0x75, 0x33,
-	0xb8, 0x04, 0xf8, 0x2b, 0x03, 0xd6, 0x53, 0xab, 0xdb, 0x71, 0xa4, 0x8d, 0x2a, 0xdd, 0xc7, 0x91,
-	0x36, 0xb2, 0x64, 0x6e, 0xdd, 0x17, 0xa7, 0xbb, 0x0a, 0x5e, 0x83, 0x85, 0x98, 0xa2, 0x78, 0x2e,
-	0xa4, 0xbe, 0x8c, 0x2e, 0xa6, 0x48, 0xbd, 0x29, 0x2b, 0xec, 0x7f, 0x6c, 0xc0, 0x52, 0xb2, 0x98,
-	0x19, 0x47, 0x44, 0x4a, 0x09, 0x35, 0x8e, 0x88, 0xb4, 0x7a, 0xa8, 0xf5, 0x41, 0xaf, 0x7e, 0x1e,
-	0xad, 0xcb, 0xee, 0x10, 0x11, 0x09, 0x30, 0x58, 0x56, 0x9f, 0x90, 0xf2, 0x73, 0xb2, 0x4d, 0x2a,
-	0xf8, 0x38, 0x32, 0xb8, 0x9c, 0xc9, 0x1a, 0x66, 0x22, 0x2a, 0x18, 0x5c, 0x39, 0x4d, 0x44, 0x05,
-	0x29, 0x65, 0x50, 0x25, 0xa7, 0xec, 0xce, 0x2f, 0x67, 0x4b, 0xf0, 0x71, 0x39, 0x3f, 0x35, 0x78,
-	0x42, 0x9e, 0xa8, 0x5c, 0xa2, 0x6b, 0xf1, 0x84, 0x78, 0x70, 0xc5, 0xb4, 0xfa, 0xc5, 0x11, 0x54,
-	0x11, 0x70, 0x2f, 0xa0, 0xaa, 0xea, 0x4f, 0x93, 0xf5, 0xaa, 0x75, 0x29, 0x45, 0x56, 0x5f, 0x32,
-	0x06, 0x4a, 0x4d, 0x16, 0x2d, 0xe3, 0x4a, 0x4d, 0x29, 0x95, 0xc6, 0x95, 0x9a, 0x56, 0xf7, 0x54,
-	0x4a, 0x95, 0xdd, 0xf9, 0x95, 0x2a, 0xe3, 0x44, 0x2e, 0xe7, 0x67, 0x06, 0x2c, 0x3f, 0xa6, 0x87,
-	0xc9, 0x72, 0x65, 0x5c, 0xd4, 0x94, 0x22, 0x69, 0xf5, 0xda, 0x70, 0x22, 0x25, 0xea, 0xcb, 0x5e,
-	0xfd, 0x36, 0xba, 0xf9, 0x33, 0xea, 0x20, 0x0a, 0x92, 0x7e, 0xe9, 0x37, 0xd3, 0x44, 0x7f, 0xcd,
-	0xba, 0x92, 0xaa, 0x63, 0xce, 0xb7, 0x69, 0xd3, 0x43, 0x2e, 0xfe, 0x9f, 0x1b, 0x50, 0x7e, 0x4c,
-	0x0f, 0xe3, 0xe5, 0x4b, 0x14, 0x7b, 0x0d, 0x34, 0xb0, 0x60, 0x5a, 0xb5, 0x86, 0x91, 0x28, 0xc1,
-	0x9f, 0xf7, 0xea, 0x37, 0xd1, 0xf5, 0xa4, 0xe0, 0x1e, 0xa6, 0x34, 0x4d, 0xec, 0x6b, 0xd6, 0xe5,
-	0x14, 0xb1, 0x39, 0x57, 0x20, 0x74, 0xa8, 0xf3, 0x78, 0x2d, 0x32, 0xa9, 0xf3, 0x81, 0x95, 0xd3,
-	0xa4, 0xce, 0x07, 0x97, 0x33, 0x53, 0x75, 0xce, 0xc9, 0xc7, 0xd1, 0x39, 0xe7, 0x0b, 0xc4, 0xff,
-	0x47, 0x03, 0x2a, 0x0f, 0xba, 0xd4, 0x76, 0x08, 0xa5, 0xa7, 0x89, 0x9b, 0x76, 0xaf, 0xfe, 0x3a,
-	0xda, 0xd0, 0xd7, 0xb0, 0xa3, 0x66, 0x1d, 0x01, 0x9e, 0xdb, 0xd6, 0x6b, 0xc3, 0xc1, 0x13, 0x8c,
-	0xc3, 0x57, 0xf3, 0xb7, 0x06, 0xac, 0x06, 0xab, 0x39, 0x1d, 0x18, 0xfd, 0x52, 0xaf, 0xbe, 0x81,
-	0x6a, 0x03, 0xd7, 0x31, 0x0c, 0x4b, 0x37, 0xad, 0x6b, 0xc3, 0xb0, 0xa4, 0xaf, 0x21, 0x6e, 0x91,
-	0xd3, 0x43, 0xd5, 0x50, 0x8b, 0x0c, 0x83, 0xd6, 0x30, 0x8b, 0x08, 0x68, 0xe9, 0xab, 0xf9, 0x17,
-	0x03, 0xd6, 0xb7, 0x49, 0x6b, 0xdf, 0xb1, 0x5b, 0xb8, 0x73, 0x9a, 0x00, 0xdb, 0xed, 0xd5, 0xbf,
-	0x84, 0x36, 0xf5, 0xe5, 0xb0, 0x60, 0xda, 0x11, 0x08, 0xab, 0x59, 0xd7, 0x87, 0x23, 0x2c, 0x1c,
-	0x88, 0x2f, 0xe8, 0x07, 0x06, 0xac, 0x85, 0x0b, 0x3a, 0x1d, 0x8c, 0xed, 0xf4, 0xea, 0x9b, 0xe8,
-	0xce, 0xe0, 0xa5, 0x0c, 0x03, 0xd9, 0x2d, 0xeb, 0x8b, 0xc3, 0x40, 0x16, 0x5b, 0x46, 0xc2, 0x2e,
-	0xa7, 0x07, 0xb3, 0xe1, 0x76, 0x19, 0x86, 0xb3, 0x61, 0x76, 0x11, 0x38, 0x8b, 0x2d, 0xe8, 0x7b,
-	0x06, 0x2c, 0xd7, 0xdb, 0x07, 0xb6, 0x73, 0x3a, 0x36, 0xe9, 0xf7, 0xc1, 0x98, 0x4f, 0x36, 0xd4,
-	0x1e, 0xe9, 0x3e, 0x58, 0xd8, 0x43, 0x0c, 0xc0, 0x45, 0xff, 0x3b, 0x03, 0x56, 0x84, 0xe8, 0xa7,
-	0x69, 0x87, 0x5f, 0x14, 0x85, 0x70, 0x7d, 0x01, 0x62, 0xca, 0x11, 0x36, 0xb8, 0x61, 0x5d, 0x1d,
-	0x6e, 0x83, 0x70, 0x11, 0x7f, 0x68, 0x40, 0xb9, 0xef, 0x7a, 0x0b, 0x25, 0x82, 0xdf, 0xc1, 0xd7,
-	0x6a, 0xf1, 0x80, 0x2e, 0xf5, 0x8e, 0xcc, 0xaa, 0xf7, 0xea, 0x2b, 0x68, 0x59, 0xf5, 0xeb, 0x89,
-	0xee, 0x88, 0x48, 0x8e, 0x4a, 0x0e, 0x2e, 0xe5, 0x9f, 0x88, 0xb0, 0x33, 0x71, 0xa3, 0x95, 0x0c,
-	0x3b, 0x07, 0xdf, 0xa4, 0x25, 0xc3, 0xce, 0x94, 0x6b, 0x31, 0xeb, 0x51, 0xaf, 0x5e, 0x41, 0xab,
-	0xaa, 0x5f, 0xd7, 0x6b, 0x96, 0x90, 0x53, 0x30, 0xbd, 0x63, 0xdc, 0x7a, 0x30, 0xf5, 0xb2, 0xe0,
-	0xed, 0xec, 0xcc, 0x88, 0x6b, 0xb4, 0x37, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x46, 0x19,
-	0x21, 0x40, 0x4f, 0x00, 0x00,
+	// 4821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x24, 0xd9,
+	0x55, 0x4e, 0x75, 0xdb, 0x1e, 0xfb, 0xf8, 0xff, 0xfa, 0xaf, 0x5d, 0xb6, 0x67, 0x6a, 0x6b, 0x3c,
+	0xe3, 0x19, 0x4f, 0x8f, 0xbd, 0x71, 0x36, 0x3b, 0x93, 0x19, 0x76, 0x27, 0x3d, 0x3f, 0x9b, 0x9d,
+	0xc0, 0x0e, 0x43, 0xcf, 0xec, 0x4c, 0x58, 0x22, 0x3a, 0xe5, 0xee, 0x6b, 0xbb, 0x32, 0xed, 0xaa,
+	0x4a, 0xd5, 0x6d, 0x7b, 0xfd, 0xc2, 0x43, 0x10, 0x02, 0x21, 0xf1, 0x40, 0x47, 0x02, 0x04, 0x8b,
+	0x96, 0x7f, 0x94, 0x95, 0x08, 0x7f, 0x89, 0x14, 0xc8, 0x4a, 0x11, 0x51, 0xc4, 0x0b, 0x04, 0x21,
+	0xc1, 0x0b, 0xbc, 0xc2, 0x0b, 0x3c, 0xf2, 0x80, 0x84, 0xc4, 0x03, 0xba, 0x3f, 0x55, 0x75, 0xab,
+	0xba, 0xaa, 0xba, 0xba, 0xdd, 0x66, 0x13, 0x25, 0x4f, 0xee, 0xaa, 0x7b, 0xce, 0xbd, 0xe7, 0x9e,
+	0xf3, 0xdd, 0x73, 0xef, 0x3d, 0xe7, 0x94, 0x61, 0xcc, 0x70, 0x9c, 0x2d, 0xc7, 0xb5, 0x89, 0x8d,
+	0xc0, 0x76, 0xb0, 0xe5, 0x98, 0xc4, 0x35, 0xdf, 0x55, 0xcf, 0xef, 0xdb, 0xf6, 0x7e, 0x13, 0x6f,
+	0xb3, 0x96, 0xdd, 0xd6, 0xde, 0xf6, 0xb1, 0x6b, 0x38, 0x0e, 0x76, 0x3d, 0x4e, 0xab, 0x5e, 0x88,
+	0xb7, 0x13, 0xf3, 0x10, 0x7b, 0xc4, 0x38, 0x14, 0x9d, 0xa9, 0xab, 0x82, 0xc0, 0x70, 0xcc, 0x6d,
+	0xc3, 0xb2, 0x6c, 0x62, 0x10, 0xd3, 0xb6, 0x7c, 0xf6, 0x32, 0xfb, 0x53, 0xbf, 0xbe, 0x8f, 0xad,
+	0xeb, 0xde, 0xb1, 0xb1, 0xbf, 0x8f, 0xdd, 0x6d, 0xdb, 0x61, 0x14, 0x09, 0xd4, 0xe3, 0xe4, 0xc4,
+	0xc1, 0xe2, 0x41, 0xff, 0x95, 0x22, 0xcc, 0xdc, 0x73, 0xb1, 0x41, 0x70, 0xc5, 0x71, 0xaa, 0xf8,
+	0x4b, 0x2d, 0xec, 0x11, 0xf4, 0x32, 0x0c, 0x59, 0xc6, 0x21, 0x2e, 0x29, 0x9a, 0x72, 0x65, 0x7c,
+	0x67, 0x75, 0x8b, 0x0f, 0xbe, 0xe5, 0x4b, 0xb7, 0xf5, 0x84, 0xb8, 0xa6, 0xb5, 0xff, 0xcc, 0x68,
+	0xb6, 0x70, 0x95, 0x51, 0xa2, 0x3b, 0x30, 0x71, 0x84, 0x5d, 0xcf, 0xb4, 0xad, 0x1a, 0xed, 0xbd,
+	0x54, 0xc8, 0xc1, 0x39, 0x2e, 0x38, 0x9e, 0x9e, 0x38, 0x18, 0xdd, 0x87, 0x69, 0xbf, 0x03, 0xc7,
+	0xa8, 0xbf, 0x30, 0xf6, 0x71, 0xa9, 0xc8, 0xfa, 0x58, 0xe9, 0xe8, 0xe3, 0xee, 0x09, 0xc1, 0x1e,
+	0xef, 0x62, 0x4a, 0xf0, 0x3c, 0xe6, 0x2c, 0xb2, 0x18, 0x6c, 0x02, 0xc3, 0x3d, 0x88, 0xf1, 0x88,
+	0xce, 0x63, 0x1b, 0x86, 0xcc, 0xba, 0x6d, 0x95, 0x46, 0xba, 0x8f, 0xcd, 0x08, 0xd1, 0x16, 0x14,
+	0x4d, 0xef, 0xa8, 0x74, 0x2e, 0xc7, 0x40, 0x94, 0x10, 0x9d, 0x07, 0xa8, 0x1b, 0x04, 0xef, 0xdb,
+	0xae, 0x89, 0xbd, 0xd2, 0xa8, 0x56, 0xbc, 0x32, 0x56, 0x95, 0xde, 0xe8, 0xbf, 0xa0, 0xc0, 0xac,
+	0x64, 0x0f, 0xcf, 0xb1, 0x2d, 0x0f, 0xa3, 0x4f, 0xc0, 0x88, 0xe1, 0x38, 0x35, 0xb3, 0x91, 0xcb,
+	0x24, 0xc3, 0x86, 0xe3, 0x3c, 0x6c, 0xa0, 0xdb, 0x00, 0xbe, 0x32, 0xcc, 0x46, 0x2e, 0x8b, 0x8c,
+	0x09, 0xfa, 0x87, 0x0d, 0xbd, 0x01, 0x8b, 0xcf, 0x8c, 0xa6, 0xd9, 0x30, 0x08, 0x16, 0xca, 0xf5,
+	0xc1, 0xf1, 0x52, 0x82, 0xa9, 0xc7, 0xa2, 0xc6, 0xdc, 0x48, 0x36, 0xe6, 0x44, 0xdc, 0x5e, 0xfa,
+	0xf7, 0x8a, 0xb0, 0xd4, 0x31, 0x8c, 0x98, 0xf3, 0x3b, 0x30, 0x89, 0x5d, 0xd7, 0x76, 0x6b, 0x0d,
+	0x4c, 0x0c, 0xb3, 0xe9, 0x95, 0x14, 0xad, 0x78, 0x65, 0x7c, 0xe7, 0x93, 0x5b, 0xe1, 0xba, 0xda,
+	0x4a, 0xe1, 0xdd, 0x7a, 0x40, 0x19, 0xef, 0x73, 0xbe, 0x07, 0x16, 0x71, 0x4f, 0xaa, 0x13, 0x58,
+	0x7a, 0x85, 0x76, 0x60, 0x98, 0x3d, 0xe7, 0xd2, 0x0a, 0x27, 0x0d, 0x16, 0x45, 0xb1, 0x9f, 0x45,
+	0xc1, 0x38, 0x87, 0x7a, 0x45, 0xe3, 0x16, 0x14, 0x5b, 0x6e, 0x33, 0x17, 0x8a, 0x29, 0x21, 0x7a,
+	0x1d, 0xc6, 0x1b, 0xd8, 0xab, 0xbb, 0x26, 0x5b, 0xfb, 0xa5, 0x91, 0x1c, 0x7c, 0x32, 0x83, 0x7a,
+	0x07, 0x66, 0x3b, 0x34, 0x87, 0x66, 0xa0, 0xf8, 0x02, 0x9f, 0x30, 0xe0, 0x8d, 0x55, 0xe9, 0x4f,
+	0x34, 0x0f, 0xc3, 0x47, 0x94, 0x59, 0x98, 0x9e, 0x3f, 0xdc, 0x2a, 0xdc, 0x54, 0xf4, 0x2f, 0x0f,
+	0xc3, 0xcc, 0x5b, 0x76, 0xc3, 0xdc, 0x3b, 0x91, 0xbc, 0x49, 0x5f, 0xe0, 0xf5, 0xb5, 0x5d, 0xc8,
+	0xad, 0xed, 0xd8, 0xe4, 0x8b, 0x3d, 0x4e, 0x9e, 0x8e, 0x78, 0x60, 0xe7, 0xb4, 0x12, 0xa3, 0xa4,
+	0x23, 0x1e, 0x1a, 0xa6, 0x45, 0x0c, 0xd3, 0xc2, 0xae, 0x97, 0xcb, 0x07, 0xc8, 0x0c, 0xe8, 0x55,
+	0x38, 0xe7, 0xd9, 0x2d, 0xb7, 0xce, 0x1c, 0x41, 0x77, 0x5e, 0x9f, 0x18, 0xbd, 0x02, 0x23, 0x2e,
+	0x36, 0x1a, 0x87, 0xb8, 0x34, 0x96, 0x83, 0x4d, 0xd0, 0x52, 0x69, 0x8d, 0x5d, 0x8f, 0xb8, 0x46,
+	0x9d, 0xe9, 0x07, 0xf2, 0x48, 0x2b, 0x31, 0x50, 0x30, 0x12, 0xdb, 0x2b, 0x8d, 0xe7, 0x01, 0x23,
+	0xb1, 0x3d, 0xf4, 0x1a, 0x8c, 0x0b, 0xbf, 0x76, 0x42, 0x6d, 0x3f, 0x91, 0x83, 0xcf, 0x77, 0x84,
+	0x27, 0x0f, 0x1b, 0xe8, 0x26, 0x8c, 0xbe, 0xc0, 0x27, 0xc7, 0xb6, 0xdb, 0xf0, 0x4a, 0x93, 0x39,
+	0x78, 0x03, 0x6a, 0xfd, 0x4d, 0x98, 0x95, 0x30, 0x78, 0x0a, 0x0f, 0xaa, 0xff, 0x75, 0x01, 0xd4,
+	0xb7, 0x9d, 0xa6, 0x6d, 0x34, 0x2a, 0x8e, 0x53, 0x21, 0xc4, 0xa8, 0x1f, 0x1c, 0x62, 0x8b, 0x9c,
+	0x0a, 0xd8, 0x77, 0x60, 0x28, 0x70, 0x9b, 0x53, 0x3b, 0xd7, 0x64, 0x6f, 0x96, 0x3e, 0xd4, 0x16,
+	0x75, 0xab, 0x55, 0xc6, 0x88, 0x3e, 0x0b, 0xc8, 0x08, 0xda, 0x6b, 0x75, 0xdb, 0x22, 0xd8, 0x22,
+	0x79, 0x36, 0xcb, 0xd9, 0x90, 0xed, 0x1e, 0xe7, 0xa2, 0x4a, 0xf6, 0xe8, 0x08, 0x56, 0x3d, 0x1d,
+	0xf7, 0x6f, 0x3f, 0xb4, 0xc8, 0x27, 0x76, 0x84, 0x92, 0x7d, 0x6a, 0x5d, 0x83, 0x21, 0xe6, 0xea,
+	0x47, 0xf9, 0x86, 0x39, 0xf3, 0x31, 0x34, 0x05, 0xe0, 0xd5, 0x5d, 0x8c, 0x2d, 0xef, 0xc0, 0x26,
+	0x33, 0x8a, 0x5e, 0x85, 0x95, 0xc4, 0x09, 0x9d, 0xc6, 0x20, 0x9b, 0x30, 0x7b, 0x1f, 0x37, 0x31,
+	0xdb, 0x1c, 0x3d, 0xdf, 0x0c, 0x0b, 0x52, 0x4f, 0x74, 0x3b, 0x15, 0xb4, 0xd7, 0x00, 0xc9, 0xb4,
+	0x62, 0xd8, 0x14, 0xe2, 0xbf, 0x9d, 0x84, 0x62, 0xc5, 0x71, 0xfa, 0x33, 0xe9, 0x0e, 0x8c, 0xd0,
+	0x35, 0x72, 0xe4, 0x7b, 0x2b, 0xb5, 0xd3, 0x0a, 0xb6, 0xdd, 0x14, 0xab, 0x91, 0x53, 0xf6, 0xb1,
+	0x9b, 0x7c, 0x12, 0xce, 0xb9, 0xd8, 0xb1, 0xa9, 0x6c, 0x43, 0xf9, 0x96, 0xbd, 0x63, 0x3f, 0x6c,
+	0xc4, 0xdd, 0xe2, 0x70, 0xaf, 0x6e, 0xf1, 0x15, 0x18, 0xf1, 0x88, 0x41, 0x5a, 0x5e, 0xae, 0xed,
+	0x44, 0xd0, 0x06, 0xce, 0xf4, 0x5c, 0x6e, 0x67, 0xfa, 0xb2, 0x38, 0x79, 0xe5, 0xf1, 0x84, 0x8c,
+	0x92, 0xce, 0x2c, 0x04, 0x9c, 0x97, 0xcb, 0x17, 0xca, 0x0c, 0x71, 0xf7, 0x0d, 0xbd, 0xba, 0x6f,
+	0xd9, 0x43, 0x8d, 0xf7, 0xe2, 0xa1, 0x64, 0xc7, 0x3f, 0xd1, 0x9f, 0xe3, 0x9f, 0xec, 0xc1, 0xf1,
+	0xdf, 0x06, 0xa8, 0x1f, 0x18, 0x2e, 0xe1, 0x87, 0x90, 0xa9, 0x3c, 0xe7, 0x40, 0x46, 0xff, 0xc8,
+	0xe8, 0xdc, 0x35, 0xa6, 0xfb, 0xdc, 0x35, 0x66, 0xf2, 0xee, 0x1a, 0x3b, 0x30, 0x6c, 0x1f, 0x5b,
+	0xd8, 0x2d, 0xcd, 0xe6, 0x59, 0x7f, 0x8c, 0x14, 0xdd, 0x86, 0xf1, 0x3a, 0x3b, 0x32, 0xd7, 0xe8,
+	0xb5, 0xa9, 0x84, 0x52, 0x16, 0xe1, 0x53, 0xff, 0x4e, 0x55, 0x05, 0x4e, 0x4e, 0x5f, 0x50, 0x66,
+	0x8e, 0x59, 0xce, 0x3c, 0xd7, 0x9d, 0x99, 0x93, 0xfb, 0xcc, 0x2d, 0xa7, 0x11, 0x8c, 0x3c, 0xdf,
+	0x9d, 0x99, 0x93, 0x33, 0xe6, 0x3b, 0x30, 0x11, 0x6c, 0x90, 0x1e, 0x26, 0xa5, 0x05, 0x76, 0xbe,
+	0x5d, 0x95, 0x77, 0x84, 0x2a, 0xe6, 0xa6, 0xbf, 0x27, 0xe8, 0xaa, 0xc1, 0x96, 0xfa, 0x04, 0x13,
+	0x74, 0x1f, 0x50, 0xd3, 0x20, 0xd8, 0x23, 0x35, 0xea, 0xb3, 0xc4, 0xc1, 0xb1, 0xb4, 0xc8, 0x84,
+	0x58, 0x94, 0xbb, 0xa9, 0x38, 0xce, 0x33, 0xde, 0x5a, 0x9d, 0xe1, 0x1c, 0xe1, 0x1b, 0xf4, 0x26,
+	0xcc, 0x4a, 0xec, 0xec, 0x4c, 0xef, 0x95, 0x96, 0x72, 0x68, 0x7f, 0xda, 0x08, 0x3a, 0xa1, 0x5b,
+	0x81, 0xc7, 0x26, 0x64, 0x1f, 0x3a, 0x86, 0x75, 0xc2, 0xa1, 0x56, 0xca, 0x03, 0x16, 0xc1, 0xc1,
+	0xc0, 0xf6, 0x00, 0xa6, 0xfd, 0x0e, 0x8e, 0xf1, 0xae, 0x67, 0x12, 0x5c, 0x5a, 0xce, 0xd1, 0xc7,
+	0x94, 0x60, 0x7a, 0xce, 0x79, 0xe4, 0x6e, 0x1c, 0xd7, 0xde, 0x33, 0x9b, 0xb8, 0xa4, 0xf6, 0xd0,
+	0xcd, 0x63, 0xce, 0x83, 0xde, 0x80, 0x59, 0xbf, 0x9b, 0x2f, 0xda, 0xa6, 0xc5, 0x4d, 0xbc, 0xd2,
+	0xd5, 0xc4, 0xfe, 0xd8, 0x9f, 0xb5, 0x4d, 0x4b, 0x80, 0x04, 0x18, 0x4e, 0x6b, 0x8e, 0x41, 0x0e,
+	0x4a, 0xab, 0x79, 0xd6, 0x1f, 0xa3, 0x7f, 0x6c, 0x90, 0x03, 0xff, 0x7e, 0xb9, 0x96, 0xf3, 0x7e,
+	0xa9, 0xff, 0xd6, 0x10, 0xcc, 0xdd, 0x67, 0xee, 0x7b, 0x37, 0xb2, 0x49, 0xbe, 0x06, 0xe3, 0x1e,
+	0x36, 0xdc, 0xfa, 0x41, 0x8d, 0xba, 0xa0, 0x5c, 0xbb, 0x1b, 0x70, 0x86, 0xe7, 0xb6, 0xdb, 0x40,
+	0x37, 0x60, 0xd4, 0xb3, 0x5d, 0x52, 0xa3, 0x37, 0x81, 0x42, 0x3e, 0x97, 0xe5, 0x92, 0x1f, 0xc7,
+	0x27, 0xe8, 0x15, 0xba, 0x6b, 0x51, 0x6c, 0xf9, 0x5b, 0x5d, 0xd6, 0xe6, 0xe8, 0x93, 0xd2, 0x1b,
+	0x46, 0xd3, 0x3c, 0x34, 0x09, 0xdb, 0xe9, 0x26, 0xab, 0xfc, 0x01, 0x2d, 0xc2, 0x88, 0xbd, 0xb7,
+	0x47, 0x97, 0xca, 0x30, 0x7b, 0x2d, 0x9e, 0xa4, 0x3d, 0x7d, 0x5c, 0xda, 0xd3, 0x11, 0x12, 0x5b,
+	0xec, 0x04, 0x7b, 0xc9, 0x7e, 0xa3, 0xa5, 0x70, 0x13, 0x9d, 0x64, 0xaf, 0xfd, 0x6d, 0x72, 0x31,
+	0xd8, 0xe6, 0xa6, 0xf8, 0x7b, 0xfe, 0x44, 0x25, 0xe1, 0xfe, 0x68, 0x9a, 0x77, 0xcd, 0x1e, 0xd0,
+	0x5a, 0xc4, 0xa5, 0xce, 0xb0, 0x26, 0xc9, 0x69, 0x5e, 0x88, 0x1e, 0x7d, 0x67, 0x23, 0xb7, 0x7c,
+	0x7a, 0xb8, 0xdd, 0x80, 0xe9, 0x86, 0xe9, 0x39, 0x4d, 0xe3, 0xa4, 0x56, 0xb7, 0x9b, 0xad, 0x43,
+	0xcb, 0x2b, 0x21, 0x46, 0x34, 0x25, 0x5e, 0xdf, 0xe3, 0x6f, 0xd1, 0x0c, 0x37, 0xff, 0x1c, 0x6b,
+	0xa4, 0x3f, 0x29, 0x9a, 0x1c, 0x63, 0x1f, 0xd7, 0x88, 0xfd, 0x02, 0x5b, 0xa5, 0xf9, 0x1c, 0xb6,
+	0x18, 0xa3, 0xf4, 0x4f, 0x29, 0xb9, 0xfe, 0xc7, 0x0a, 0xcc, 0x47, 0xd1, 0x21, 0x8e, 0x45, 0x17,
+	0x60, 0x9c, 0xd8, 0xc4, 0x68, 0xd6, 0xea, 0x76, 0xcb, 0x22, 0x0c, 0x1e, 0x93, 0x55, 0x60, 0xaf,
+	0xee, 0xd1, 0x37, 0xe8, 0x0a, 0x9c, 0xa3, 0x3a, 0xa6, 0xca, 0x2f, 0x30, 0x3f, 0x35, 0x1d, 0x73,
+	0x30, 0x55, 0x6a, 0x03, 0xee, 0x95, 0xa6, 0x2d, 0xfc, 0x2e, 0xa9, 0x49, 0x52, 0xe6, 0x39, 0xe4,
+	0x4c, 0x52, 0xa6, 0xc7, 0x81, 0xa4, 0x5f, 0x2d, 0xc0, 0x52, 0x10, 0x07, 0xf1, 0x9d, 0xd7, 0x0f,
+	0xdc, 0x85, 0x92, 0x9d, 0xf4, 0x73, 0x5d, 0x28, 0x29, 0x25, 0x3d, 0xe2, 0xf9, 0xf1, 0x92, 0xe1,
+	0xee, 0xe7, 0x79, 0x9f, 0x56, 0x7f, 0x0e, 0xa5, 0x4e, 0x55, 0x09, 0xc3, 0x46, 0x83, 0x40, 0x4a,
+	0x6f, 0x41, 0xa0, 0xf7, 0x8a, 0xb0, 0x14, 0x5c, 0xa5, 0x62, 0x46, 0x38, 0x4d, 0xc7, 0x1f, 0x81,
+	0x31, 0x24, 0xd5, 0x0e, 0xe5, 0x57, 0x2d, 0x0d, 0x42, 0x89, 0x9f, 0x35, 0xba, 0x27, 0x78, 0xa5,
+	0xe1, 0xce, 0x20, 0x54, 0x8a, 0x86, 0xb6, 0x44, 0x50, 0xea, 0x0d, 0xca, 0x27, 0x82, 0x50, 0x8e,
+	0xf4, 0x8a, 0x46, 0x5b, 0x3a, 0x48, 0xba, 0x45, 0x5b, 0x26, 0xe4, 0x68, 0xcb, 0x73, 0x28, 0x75,
+	0x8e, 0x3d, 0x08, 0xbb, 0x7f, 0x0d, 0x00, 0xc2, 0x3e, 0x4f, 0x67, 0xea, 0x7e, 0x2e, 0x47, 0xe1,
+	0x02, 0x2f, 0xf6, 0x72, 0x0b, 0x13, 0x9e, 0x7a, 0x28, 0xff, 0xc9, 0xd1, 0xc7, 0xe1, 0x70, 0xbf,
+	0x38, 0x1c, 0xe9, 0x37, 0xca, 0xf4, 0xa3, 0x8b, 0xd1, 0x47, 0x7f, 0x31, 0xba, 0x03, 0xfe, 0x82,
+	0xcc, 0x7f, 0x35, 0x1a, 0x17, 0x1c, 0x6c, 0x9f, 0x0f, 0xef, 0xc6, 0xd3, 0x3d, 0xdc, 0x8d, 0x3f,
+	0x05, 0x63, 0x2e, 0x3e, 0x32, 0xf1, 0x31, 0x05, 0x78, 0x9e, 0x8b, 0xd1, 0x28, 0x27, 0x7f, 0xd8,
+	0x88, 0xdf, 0x74, 0x66, 0x4f, 0x73, 0xd3, 0x41, 0xa7, 0xb9, 0xe9, 0xcc, 0xf5, 0x74, 0xd3, 0x91,
+	0xc3, 0x4c, 0xf3, 0xbd, 0x84, 0x99, 0x28, 0x20, 0x0e, 0xb1, 0xe7, 0x51, 0xb7, 0xbd, 0x90, 0x07,
+	0x10, 0x82, 0x38, 0xd8, 0x7b, 0x17, 0x73, 0xef, 0xbd, 0xd1, 0x53, 0xfa, 0x52, 0x4f, 0xa7, 0x74,
+	0xfd, 0x77, 0x87, 0x61, 0x3a, 0x74, 0x98, 0x95, 0x56, 0xc3, 0x3c, 0xe5, 0x06, 0x19, 0x7a, 0xc0,
+	0x42, 0x7e, 0x0f, 0x18, 0xc2, 0xb1, 0xd8, 0x03, 0x1c, 0x4f, 0x9d, 0xa5, 0xb8, 0x01, 0xa3, 0x54,
+	0xd6, 0xdc, 0x8e, 0x94, 0x1e, 0x24, 0x19, 0xe3, 0x4d, 0x18, 0xb5, 0x1d, 0xec, 0x1a, 0xc4, 0x76,
+	0x73, 0x39, 0xd2, 0x80, 0x1a, 0x55, 0x60, 0xd2, 0xff, 0xcd, 0x93, 0x50, 0x79, 0xdc, 0xe9, 0x84,
+	0xcf, 0xc2, 0x02, 0x97, 0x12, 0xb2, 0x46, 0x7b, 0x41, 0x56, 0x64, 0xf5, 0x8e, 0xf5, 0xba, 0x7a,
+	0xe5, 0x05, 0x08, 0x3d, 0x2d, 0xc0, 0x78, 0x86, 0x75, 0xbc, 0xc7, 0x0c, 0xab, 0xfe, 0xbf, 0x05,
+	0x58, 0x90, 0x0f, 0x0a, 0x54, 0xa8, 0xc7, 0x07, 0x86, 0x27, 0xbb, 0x31, 0xa5, 0x07, 0xdc, 0xc8,
+	0xd6, 0x2b, 0x9c, 0xce, 0x7a, 0xc5, 0xd3, 0x58, 0x6f, 0xa8, 0x17, 0xeb, 0xc5, 0x4c, 0x30, 0xdc,
+	0xab, 0x0f, 0x14, 0xa6, 0x67, 0xcc, 0x23, 0xdd, 0x99, 0x39, 0x39, 0x7d, 0xa1, 0xff, 0xcb, 0x30,
+	0xcc, 0xc4, 0xd5, 0x1f, 0x05, 0x93, 0xd2, 0x23, 0x98, 0xfa, 0xcf, 0xee, 0xf6, 0x77, 0xc0, 0xfa,
+	0xe8, 0x1c, 0x45, 0x7f, 0x31, 0xe8, 0xd7, 0x60, 0xd8, 0xa1, 0xf8, 0x2e, 0x9d, 0x63, 0x67, 0xf6,
+	0x8d, 0x94, 0x88, 0x18, 0x53, 0xe8, 0x16, 0x5b, 0x09, 0xfc, 0x94, 0xce, 0xb9, 0xe2, 0x50, 0x19,
+	0xed, 0x09, 0x2a, 0x37, 0x41, 0x58, 0x0a, 0xbb, 0xbd, 0x38, 0x09, 0xec, 0x76, 0xac, 0x73, 0xe8,
+	0xb5, 0x92, 0x62, 0x1e, 0x86, 0x3d, 0x42, 0x17, 0x86, 0x08, 0x86, 0xb0, 0x07, 0xf5, 0x67, 0x00,
+	0xc2, 0x29, 0x26, 0xdc, 0x32, 0x6e, 0xc8, 0xb7, 0x8c, 0xf1, 0x9d, 0x97, 0xb2, 0x94, 0xc5, 0x3a,
+	0x92, 0x2f, 0x22, 0x5f, 0x2b, 0x82, 0x26, 0x85, 0x15, 0x22, 0xc4, 0x3f, 0x64, 0x11, 0x28, 0x90,
+	0x23, 0x50, 0x6b, 0x91, 0x35, 0xca, 0xed, 0x21, 0xad, 0xc2, 0x15, 0x79, 0xf5, 0xf3, 0x28, 0x55,
+	0xb8, 0xbe, 0xc3, 0x80, 0xd4, 0x64, 0x24, 0x20, 0x95, 0x10, 0x3a, 0x9a, 0x4a, 0x0c, 0x1d, 0xa9,
+	0x12, 0x04, 0xa7, 0xe5, 0xce, 0xb1, 0xab, 0xff, 0xba, 0x02, 0x2f, 0x65, 0x18, 0x2c, 0x6f, 0x50,
+	0xe8, 0xa7, 0x60, 0x51, 0x0e, 0x1d, 0x8b, 0xc9, 0x84, 0x31, 0xa2, 0xd5, 0x2c, 0x14, 0x55, 0xe7,
+	0x8c, 0xd8, 0x9b, 0x27, 0x98, 0xe8, 0xdf, 0x28, 0xc2, 0x85, 0x4e, 0xc9, 0xd8, 0x89, 0xea, 0x47,
+	0x48, 0x4a, 0x45, 0x52, 0x08, 0x96, 0x89, 0x08, 0x58, 0x54, 0x69, 0x8f, 0xe6, 0x30, 0x0a, 0x9e,
+	0xd1, 0xc5, 0xf8, 0x2e, 0xcc, 0x61, 0x14, 0xdd, 0x67, 0x13, 0xd0, 0x36, 0x9d, 0x84, 0x36, 0xfd,
+	0x2b, 0x0a, 0x68, 0xe9, 0x76, 0xcb, 0x0b, 0xa8, 0x47, 0xb0, 0x20, 0x03, 0xca, 0xa0, 0xec, 0x12,
+	0x9e, 0x56, 0x92, 0xf1, 0xc4, 0x46, 0xa9, 0x22, 0x23, 0xfa, 0x82, 0xa2, 0xe9, 0xbf, 0x8a, 0xa0,
+	0x76, 0x4a, 0xf5, 0x43, 0x01, 0xa4, 0x28, 0x62, 0x20, 0x8e, 0x98, 0x1e, 0x62, 0xe6, 0x41, 0x08,
+	0x7c, 0x52, 0x0e, 0x81, 0x6b, 0xd1, 0x40, 0x08, 0x07, 0x8f, 0xfc, 0x8a, 0x16, 0x8a, 0x45, 0xae,
+	0xd7, 0x1c, 0x38, 0x91, 0x0b, 0x74, 0x88, 0xdb, 0x99, 0x08, 0x6e, 0x91, 0xb8, 0xbe, 0xf1, 0xc8,
+	0xf9, 0x10, 0x49, 0x81, 0x62, 0x62, 0xcc, 0x5c, 0xff, 0x39, 0x58, 0x49, 0xb4, 0x79, 0x5e, 0x10,
+	0xbe, 0x0e, 0xd3, 0x32, 0x08, 0x43, 0xf8, 0xa5, 0xe5, 0xd4, 0x26, 0x43, 0xe4, 0x51, 0xd0, 0xbd,
+	0x03, 0x2b, 0x9f, 0xc1, 0x52, 0x86, 0x2d, 0x56, 0x3f, 0x77, 0xaa, 0xc8, 0xdc, 0x07, 0x0a, 0xac,
+	0x26, 0x77, 0x2e, 0x66, 0x57, 0x0a, 0xe3, 0x9c, 0x0a, 0x8b, 0x17, 0xfa, 0x8f, 0xfd, 0x5d, 0x29,
+	0xa3, 0xc2, 0x16, 0x7b, 0x13, 0xb6, 0x05, 0x5a, 0x92, 0xac, 0x2c, 0xda, 0x39, 0x90, 0x30, 0xf2,
+	0x3c, 0x0c, 0xf3, 0xa8, 0x6c, 0x81, 0x23, 0x93, 0x3d, 0xe8, 0xff, 0xa9, 0xc0, 0x4b, 0x19, 0xe3,
+	0x0a, 0x45, 0x3d, 0xf2, 0x79, 0x79, 0x59, 0xe1, 0x4d, 0xd9, 0xb6, 0x5d, 0xb9, 0xb7, 0xa4, 0xa0,
+	0x2e, 0xef, 0xe6, 0x54, 0xe7, 0x71, 0xf5, 0x26, 0x40, 0x9f, 0x31, 0xe0, 0x65, 0x58, 0xe2, 0xd2,
+	0x3e, 0x21, 0x06, 0x31, 0x3d, 0x62, 0xd6, 0x7d, 0xd5, 0xea, 0x5f, 0x2d, 0x42, 0xa9, 0xb3, 0x4d,
+	0x4c, 0xdf, 0x86, 0x85, 0xa6, 0xe1, 0x91, 0x1a, 0x39, 0xb6, 0x6b, 0xc7, 0x18, 0xbf, 0xa8, 0xf1,
+	0x40, 0x51, 0x43, 0xa8, 0xe3, 0xc7, 0x3a, 0xd5, 0xd1, 0xd9, 0xc9, 0xd6, 0x4f, 0x18, 0x1e, 0x79,
+	0x7a, 0x6c, 0x3f, 0xc7, 0xf8, 0x05, 0x4f, 0x40, 0x34, 0xb8, 0x4a, 0x50, 0xb3, 0xa3, 0x01, 0xfd,
+	0x34, 0x4c, 0x12, 0xdb, 0xa9, 0x11, 0x4c, 0xcf, 0x09, 0x8e, 0xed, 0x95, 0x0a, 0x9d, 0x91, 0xf4,
+	0xd4, 0x81, 0x9e, 0xda, 0xce, 0x53, 0x6c, 0x55, 0x29, 0x1f, 0x1f, 0x61, 0x9c, 0x84, 0x6f, 0xe8,
+	0x39, 0x8a, 0x22, 0x9b, 0xaf, 0xe7, 0x22, 0x5b, 0xcf, 0xf4, 0xa2, 0xc1, 0x57, 0xf3, 0x1a, 0x00,
+	0xcb, 0xf8, 0xf1, 0x56, 0xee, 0x3a, 0xc7, 0xe8, 0x1b, 0xd6, 0xac, 0x3e, 0x80, 0xa5, 0x94, 0x59,
+	0x74, 0x33, 0xc3, 0xa4, 0x64, 0x06, 0xf5, 0x75, 0x98, 0x89, 0xcb, 0xd8, 0x0b, 0xbf, 0xfe, 0x0c,
+	0x96, 0x9e, 0xb4, 0x76, 0x0f, 0x4d, 0x32, 0xd8, 0x44, 0x0b, 0x4d, 0x11, 0x74, 0xf6, 0x3b, 0x88,
+	0x14, 0xc1, 0x33, 0x58, 0xba, 0x67, 0x58, 0x75, 0xdc, 0x1c, 0xbc, 0xc0, 0x9d, 0xfd, 0x0e, 0x42,
+	0xe0, 0xe7, 0x50, 0xaa, 0xe2, 0x26, 0x36, 0x3c, 0x3c, 0x60, 0x89, 0x3f, 0x07, 0xcb, 0x09, 0x1d,
+	0x0f, 0x48, 0xc7, 0x41, 0x05, 0xdb, 0x80, 0x75, 0xdc, 0xd9, 0xef, 0x20, 0x04, 0xfe, 0x65, 0x05,
+	0x96, 0xf8, 0x51, 0x7e, 0xc0, 0xf9, 0xc2, 0x1d, 0xff, 0x4e, 0x9b, 0x6b, 0xeb, 0x62, 0xa4, 0xdc,
+	0xe0, 0x71, 0x59, 0x06, 0x31, 0xcb, 0x5f, 0x52, 0x60, 0xe1, 0xb1, 0xe1, 0x79, 0xdf, 0x07, 0x73,
+	0x7c, 0x1b, 0x16, 0xe3, 0x92, 0x0c, 0x62, 0x86, 0xdf, 0x61, 0x76, 0xfc, 0x22, 0xae, 0x0f, 0xd8,
+	0x1d, 0xc9, 0x61, 0xbb, 0x42, 0x2f, 0x61, 0xbb, 0x40, 0x37, 0xc5, 0x1e, 0xed, 0x1f, 0x9f, 0xc3,
+	0x80, 0x3c, 0xc9, 0x93, 0x96, 0xe7, 0x60, 0xab, 0x31, 0x78, 0x4f, 0x92, 0xd0, 0xf1, 0xc0, 0x9c,
+	0x5f, 0xdd, 0x3e, 0xc2, 0xee, 0x59, 0x38, 0xbf, 0x8e, 0x8e, 0x07, 0x21, 0x32, 0x81, 0x29, 0xb1,
+	0x7c, 0x5b, 0xc4, 0xa6, 0x20, 0x47, 0xaf, 0xc2, 0x92, 0xc7, 0xf6, 0x32, 0x82, 0xdd, 0x1a, 0x2f,
+	0x2f, 0xac, 0xed, 0xb6, 0xac, 0x46, 0x13, 0x8b, 0x5a, 0xde, 0x85, 0xa0, 0xb9, 0xc2, 0x5a, 0xef,
+	0xb2, 0x46, 0xb4, 0x09, 0xb3, 0x61, 0x66, 0xa7, 0xe6, 0xb8, 0x78, 0xcf, 0x7c, 0x57, 0x9c, 0x18,
+	0xa7, 0x83, 0x14, 0xce, 0x63, 0xf6, 0x5a, 0xff, 0x6f, 0x05, 0xc6, 0x45, 0x30, 0x82, 0x88, 0x3c,
+	0x52, 0x8f, 0x5f, 0x42, 0x55, 0x60, 0x32, 0x2a, 0x5b, 0x1e, 0xa0, 0x4f, 0x18, 0xb2, 0xc0, 0x9f,
+	0x02, 0x30, 0xbd, 0xa3, 0x9a, 0x57, 0xb7, 0x1d, 0xdc, 0xc8, 0x71, 0x43, 0x1c, 0x33, 0xbd, 0xa3,
+	0x27, 0x8c, 0x18, 0xdd, 0x80, 0x31, 0xa3, 0x45, 0xec, 0x9a, 0x63, 0x78, 0x9e, 0x08, 0xd0, 0xaa,
+	0xd1, 0x82, 0x42, 0x59, 0xa5, 0xd5, 0x51, 0x43, 0xfc, 0xd2, 0xbf, 0x37, 0x04, 0x13, 0xbc, 0xf1,
+	0xb1, 0xdd, 0x34, 0xeb, 0x27, 0x34, 0x34, 0xed, 0xb0, 0x5f, 0xb9, 0x43, 0xd3, 0x9c, 0xbc, 0xaf,
+	0xea, 0x8e, 0xdb, 0xb4, 0x76, 0xdc, 0x76, 0x70, 0xfe, 0x74, 0xc0, 0x18, 0xa3, 0x67, 0x31, 0x0a,
+	0x7a, 0x0d, 0x67, 0xcc, 0x39, 0x2b, 0xa3, 0xcf, 0x31, 0xea, 0x87, 0x0d, 0xb4, 0xcd, 0x6e, 0x9f,
+	0xfb, 0x41, 0x55, 0xc7, 0x52, 0xa7, 0xa6, 0x18, 0x0a, 0xaa, 0x82, 0xec, 0xd4, 0xc9, 0xff, 0xa0,
+	0x44, 0xe1, 0x5c, 0x2f, 0xc5, 0xad, 0x72, 0x5e, 0x72, 0xb4, 0xb7, 0xea, 0xc1, 0x58, 0xbe, 0x78,
+	0xec, 0x34, 0xf9, 0xe2, 0x9e, 0xd2, 0x55, 0xfa, 0xd7, 0x0b, 0xb0, 0xcc, 0x4f, 0xd3, 0x32, 0xaa,
+	0xfa, 0xff, 0xc0, 0x30, 0x8a, 0x90, 0x42, 0xff, 0x08, 0x29, 0xf6, 0x87, 0x90, 0xa1, 0xbe, 0x10,
+	0xd2, 0x6b, 0xb5, 0xbd, 0xfe, 0x1c, 0xd4, 0x24, 0xad, 0x09, 0x87, 0xda, 0xff, 0x9a, 0xd4, 0xff,
+	0x47, 0x81, 0x65, 0x5e, 0x2c, 0x94, 0x64, 0x8f, 0xff, 0xd7, 0xc5, 0x1e, 0x2a, 0xb5, 0xd8, 0x97,
+	0x52, 0x87, 0xfa, 0x50, 0x6a, 0xd2, 0xd4, 0x4f, 0xaf, 0xd4, 0x5b, 0xb0, 0xc2, 0x0f, 0xd2, 0x52,
+	0xc7, 0x66, 0x18, 0xdb, 0x58, 0x89, 0xf6, 0xcc, 0xc2, 0xaf, 0x01, 0xef, 0x6d, 0x58, 0x4d, 0xe6,
+	0x15, 0x62, 0x65, 0x32, 0x7f, 0x58, 0x80, 0x35, 0x3f, 0xc6, 0x95, 0x3c, 0xb6, 0x1c, 0x9b, 0x54,
+	0xfa, 0x8c, 0x4d, 0x16, 0xfa, 0x88, 0x4d, 0x16, 0x93, 0x63, 0x93, 0x43, 0x91, 0xd8, 0x64, 0x64,
+	0x6e, 0x10, 0x9d, 0x1b, 0xbd, 0xb0, 0x4b, 0x2b, 0x5d, 0x84, 0xba, 0xc3, 0xb5, 0xbc, 0x2c, 0xad,
+	0x65, 0x1e, 0xa5, 0x0c, 0x56, 0x6b, 0x42, 0x84, 0x70, 0x32, 0x31, 0x42, 0xf8, 0x8b, 0x0a, 0x9c,
+	0x4f, 0x53, 0x5f, 0xde, 0x28, 0xe1, 0x7d, 0x98, 0x15, 0xf9, 0x0e, 0x31, 0x95, 0x30, 0x4e, 0x58,
+	0xea, 0x04, 0xb4, 0xc0, 0xdc, 0xb4, 0x2b, 0x3d, 0xd1, 0x58, 0xe1, 0x26, 0x4c, 0x3f, 0x39, 0xb1,
+	0xea, 0x55, 0xec, 0xd8, 0xbe, 0xe5, 0xa4, 0x12, 0x65, 0x1e, 0x3a, 0x10, 0x25, 0xca, 0xfa, 0x5d,
+	0x98, 0x09, 0x69, 0x85, 0x98, 0x8b, 0x30, 0xb2, 0x67, 0x98, 0x4d, 0xcc, 0x69, 0x47, 0xab, 0xe2,
+	0x89, 0xbe, 0x77, 0xb1, 0xd7, 0x6a, 0x12, 0xf1, 0x8d, 0xa6, 0x78, 0xa2, 0x1f, 0x50, 0x55, 0x31,
+	0x35, 0x75, 0xbe, 0x0f, 0xa8, 0x64, 0xda, 0xcc, 0x0f, 0xa8, 0x76, 0xfe, 0xf9, 0xd3, 0xac, 0x64,
+	0xf0, 0x2d, 0xc3, 0x32, 0xf6, 0xb1, 0x8b, 0xde, 0x02, 0x08, 0x79, 0xd1, 0x5a, 0xfc, 0x9b, 0x86,
+	0xc8, 0xf8, 0xea, 0xf9, 0xb4, 0x66, 0x3e, 0xa4, 0xfe, 0x31, 0xf4, 0x19, 0x18, 0xf5, 0xa7, 0x8e,
+	0x22, 0x49, 0x80, 0x98, 0xf2, 0xd4, 0xd5, 0xe4, 0xc6, 0xa0, 0xa3, 0xdf, 0x50, 0x60, 0x2c, 0xa8,
+	0x95, 0x45, 0x11, 0xea, 0xf8, 0x57, 0xf0, 0xea, 0x5a, 0x4a, 0xab, 0xe8, 0xec, 0x51, 0xbb, 0x72,
+	0x13, 0xbd, 0xca, 0xdf, 0x6b, 0x86, 0xe3, 0x94, 0xb5, 0x96, 0x87, 0x5d, 0xcd, 0xde, 0xd3, 0x4c,
+	0xef, 0x48, 0xab, 0x1b, 0x96, 0x56, 0x0f, 0xda, 0x34, 0xdb, 0xd2, 0xc8, 0x01, 0xd6, 0x9c, 0xa6,
+	0x41, 0xf6, 0x6c, 0xf7, 0xf0, 0xcb, 0xff, 0xf4, 0xef, 0x5f, 0x29, 0x4c, 0xea, 0xa3, 0xdb, 0x47,
+	0x1f, 0xdf, 0x36, 0x1c, 0xc7, 0xbb, 0xa5, 0x6c, 0xa2, 0x3f, 0x53, 0x60, 0x3a, 0xf6, 0x3d, 0x33,
+	0xd2, 0x33, 0x3f, 0x76, 0xe6, 0x62, 0x5e, 0xcc, 0xf1, 0x41, 0xb4, 0xfe, 0xb4, 0x5d, 0xb9, 0x8e,
+	0xae, 0xf9, 0xad, 0x1a, 0x95, 0xc1, 0x20, 0x54, 0x56, 0x11, 0x1d, 0xbe, 0x42, 0xff, 0x6a, 0xbb,
+	0x27, 0x9a, 0xed, 0x68, 0xc4, 0xb6, 0x9b, 0x57, 0x99, 0x84, 0xe7, 0xf5, 0x65, 0x5f, 0xc2, 0xed,
+	0x23, 0xc1, 0xeb, 0x7f, 0xd1, 0x4d, 0x45, 0xfe, 0x4d, 0x05, 0x66, 0xe2, 0x31, 0x3b, 0x74, 0x31,
+	0x3b, 0xa2, 0xc7, 0x85, 0x5e, 0xcf, 0x13, 0xf6, 0xd3, 0x6f, 0xb7, 0x2b, 0x6b, 0x88, 0x06, 0xd3,
+	0x35, 0x2f, 0x68, 0xd4, 0x4c, 0x6b, 0xcf, 0xa6, 0x92, 0x53, 0xa9, 0x98, 0x94, 0x0b, 0x68, 0x2e,
+	0x90, 0x32, 0xa4, 0x43, 0xbf, 0x53, 0x80, 0x09, 0xb9, 0xd6, 0x1d, 0x5d, 0x90, 0xc7, 0x4c, 0xf8,
+	0x46, 0x42, 0xd5, 0xd2, 0x09, 0x84, 0x40, 0x7f, 0xaf, 0xb4, 0x2b, 0xdf, 0x54, 0xd0, 0x37, 0x14,
+	0x2a, 0x13, 0x1d, 0xb0, 0xcc, 0x0c, 0xbd, 0x67, 0x36, 0x09, 0x76, 0xb5, 0x63, 0x93, 0x1c, 0x50,
+	0x33, 0x7b, 0x58, 0xdb, 0x33, 0x71, 0xb3, 0xe1, 0x5d, 0xe1, 0x2b, 0xa5, 0xac, 0xd1, 0x7d, 0xaf,
+	0xac, 0x89, 0x65, 0x5d, 0xd6, 0xa4, 0xcd, 0xa9, 0xac, 0xf1, 0x43, 0x53, 0x59, 0xa3, 0xc5, 0x9e,
+	0x65, 0xcd, 0xac, 0xb3, 0x77, 0x61, 0x35, 0x66, 0x59, 0x93, 0x4a, 0x2b, 0xcb, 0x9a, 0xa8, 0x78,
+	0x2c, 0x6b, 0xbc, 0x86, 0xb1, 0xac, 0xb1, 0xc3, 0x5e, 0x59, 0x0b, 0x3f, 0x47, 0xb8, 0x4a, 0xfb,
+	0xdf, 0x33, 0x5a, 0x4d, 0xa2, 0xb9, 0x98, 0xb4, 0x5c, 0x4b, 0x33, 0x9a, 0xcd, 0x50, 0x5b, 0x80,
+	0x02, 0xd4, 0xa1, 0x3f, 0x55, 0x00, 0x1e, 0xbc, 0xeb, 0xf8, 0xcb, 0x74, 0x00, 0x0a, 0xfa, 0x7c,
+	0xbb, 0x72, 0x17, 0x7d, 0x9a, 0xf7, 0x29, 0x14, 0xe4, 0x11, 0x17, 0x1b, 0x87, 0x4c, 0x08, 0xba,
+	0x04, 0xe8, 0x4b, 0xcd, 0x31, 0xf6, 0x31, 0x45, 0x1b, 0xfb, 0xbb, 0x67, 0xbb, 0xda, 0x6e, 0xab,
+	0xf9, 0x82, 0xe9, 0xc7, 0x25, 0xa6, 0xb5, 0xcf, 0x04, 0x9d, 0x45, 0xd3, 0x81, 0x59, 0x31, 0xeb,
+	0xf0, 0x65, 0x05, 0x7d, 0xbd, 0x00, 0x28, 0x18, 0x98, 0x15, 0x0b, 0x0f, 0x4a, 0xf2, 0x7f, 0x55,
+	0xda, 0x95, 0x6f, 0x2b, 0xe8, 0x43, 0x6e, 0x5a, 0xd6, 0xf5, 0x0f, 0xa8, 0x85, 0x7d, 0xc5, 0xb1,
+	0x39, 0xd4, 0x98, 0xa1, 0xbf, 0x04, 0x63, 0x41, 0xa5, 0x78, 0xd4, 0xeb, 0xc5, 0xbf, 0xd6, 0x57,
+	0xd7, 0x52, 0x5a, 0x85, 0x9a, 0x36, 0xda, 0x95, 0x59, 0x34, 0xcd, 0xdf, 0x33, 0xcf, 0x46, 0x97,
+	0x23, 0x77, 0x67, 0x3b, 0x11, 0x77, 0xf6, 0xbe, 0x02, 0x73, 0x09, 0xdf, 0xff, 0xa2, 0xcb, 0xf9,
+	0xbe, 0x78, 0x56, 0x37, 0xba, 0xd2, 0x09, 0x89, 0x6e, 0xb4, 0x2b, 0x4b, 0x68, 0x81, 0x53, 0x30,
+	0x89, 0xc2, 0xcf, 0x9c, 0x99, 0x5c, 0x4b, 0x3b, 0x48, 0xc8, 0xb5, 0x1d, 0xb6, 0x50, 0x09, 0x8f,
+	0x00, 0xc2, 0x0f, 0x84, 0xa3, 0x7b, 0x54, 0xc7, 0x47, 0xc6, 0xea, 0xf9, 0xb4, 0x66, 0x21, 0xc5,
+	0xd5, 0x76, 0x65, 0x0e, 0xcd, 0xde, 0x35, 0x48, 0xfd, 0x40, 0x6b, 0xb0, 0xe6, 0xd0, 0x20, 0x93,
+	0xb7, 0x94, 0xcd, 0xcd, 0x70, 0xd5, 0xfd, 0xb6, 0x22, 0xfd, 0xcb, 0x15, 0xbf, 0xc6, 0xfe, 0x62,
+	0xe2, 0x66, 0x13, 0x0d, 0xd5, 0xa8, 0xeb, 0xd9, 0x44, 0x42, 0x94, 0xd7, 0xdb, 0x15, 0x0d, 0x9d,
+	0xbf, 0x27, 0x6d, 0x3e, 0x7b, 0x9a, 0xe7, 0xe0, 0xba, 0xb9, 0x67, 0xd6, 0x35, 0x11, 0x60, 0xe1,
+	0x8e, 0x53, 0x9f, 0x11, 0x42, 0xf9, 0xc9, 0x4e, 0x66, 0xb9, 0x3f, 0x28, 0x44, 0x3e, 0x21, 0x13,
+	0xdd, 0x7b, 0x51, 0xcb, 0xa5, 0x67, 0xd5, 0xd5, 0x8d, 0xae, 0x74, 0x42, 0xd0, 0x6f, 0x29, 0xed,
+	0xca, 0xfb, 0x0a, 0x7a, 0x8f, 0x2d, 0x39, 0x5f, 0x02, 0xe1, 0xda, 0xbb, 0x2c, 0xbb, 0x30, 0xae,
+	0x54, 0xd6, 0xa2, 0x4b, 0x50, 0xac, 0x96, 0xc8, 0x02, 0x94, 0xd3, 0xd0, 0xe1, 0x72, 0xa4, 0xe7,
+	0xcb, 0xd4, 0xa5, 0x14, 0xc8, 0xc3, 0x34, 0x85, 0x50, 0x87, 0xa6, 0xd0, 0xb7, 0x0b, 0xb0, 0xdc,
+	0xe1, 0x8a, 0xce, 0x4e, 0x59, 0xff, 0xa8, 0xb4, 0x2b, 0x7f, 0xa2, 0xa0, 0x0f, 0x64, 0xff, 0xf4,
+	0x7d, 0xa5, 0xb3, 0xc0, 0x65, 0x46, 0x55, 0xb7, 0x8c, 0x96, 0xa2, 0xde, 0x28, 0xd4, 0xe0, 0x87,
+	0x05, 0x9a, 0x88, 0x48, 0xae, 0x19, 0x41, 0xd7, 0xb2, 0x15, 0x13, 0xa9, 0x08, 0x52, 0xcb, 0xf9,
+	0x88, 0x85, 0x2a, 0xbf, 0xab, 0xb4, 0x2b, 0xbf, 0xa7, 0xa0, 0xf7, 0xb9, 0x2a, 0x59, 0x1b, 0x73,
+	0x63, 0xf4, 0x4c, 0x64, 0xda, 0x16, 0xd5, 0xa6, 0x90, 0xf1, 0x7a, 0xb0, 0x6e, 0xfa, 0x53, 0xaf,
+	0xaf, 0x36, 0xbf, 0xa6, 0xa6, 0xac, 0xb9, 0x76, 0xb3, 0x3b, 0xe8, 0x84, 0x50, 0x4c, 0x7f, 0x25,
+	0xb4, 0x18, 0x83, 0x1e, 0x2f, 0x8b, 0xf1, 0xd0, 0xaf, 0xca, 0x00, 0x8c, 0x17, 0x71, 0xa1, 0x2e,
+	0x2a, 0x89, 0x16, 0xe7, 0xa9, 0xd7, 0x73, 0x52, 0x0b, 0x0d, 0xfe, 0x9a, 0xd2, 0xae, 0x10, 0xe4,
+	0x52, 0xfd, 0xf1, 0x1b, 0x8d, 0xd7, 0xa7, 0xd2, 0x82, 0xfa, 0xb7, 0xb2, 0x96, 0xa5, 0x3f, 0x4e,
+	0x86, 0xdd, 0xab, 0x11, 0x48, 0x75, 0x94, 0x9e, 0x71, 0xdf, 0x1a, 0xff, 0x26, 0x2a, 0xea, 0x5b,
+	0x53, 0xbe, 0xd6, 0x52, 0xd7, 0xb3, 0x89, 0x22, 0xbe, 0x95, 0x37, 0x07, 0xd6, 0xf2, 0x4f, 0xa4,
+	0xe2, 0x78, 0xc3, 0x7d, 0xeb, 0x2d, 0x65, 0x73, 0xa7, 0xd3, 0x69, 0xfc, 0xa5, 0x02, 0xf3, 0x49,
+	0xd5, 0x05, 0x68, 0xa3, 0x5b, 0xfd, 0x81, 0x2f, 0xe7, 0x95, 0xee, 0x84, 0x42, 0xd6, 0x37, 0xdb,
+	0x95, 0xcb, 0x68, 0x9d, 0xda, 0x48, 0xac, 0xe5, 0x54, 0x23, 0xa5, 0x68, 0x75, 0x5b, 0xf0, 0xa1,
+	0xbf, 0x52, 0x60, 0x39, 0xb5, 0x26, 0x22, 0x8a, 0xb4, 0x6e, 0x05, 0x1f, 0xea, 0xf5, 0x9c, 0xd4,
+	0x62, 0x12, 0x77, 0xd8, 0xee, 0x2e, 0x8e, 0xdb, 0xfe, 0x44, 0x34, 0x56, 0x72, 0xc1, 0xa4, 0xbe,
+	0x80, 0xd6, 0x52, 0xa4, 0xde, 0x66, 0x44, 0xe8, 0x0f, 0x15, 0x98, 0x89, 0xa7, 0xc0, 0xa3, 0x88,
+	0x48, 0x49, 0xbc, 0xab, 0xeb, 0xd9, 0x44, 0x42, 0xc0, 0x37, 0xda, 0x95, 0x15, 0xb4, 0xcc, 0x9b,
+	0x03, 0x44, 0xc4, 0xc0, 0xa0, 0xdf, 0x52, 0x36, 0xf5, 0x0e, 0x39, 0x79, 0xcc, 0x7f, 0x9b, 0x27,
+	0x2d, 0x98, 0x9c, 0xf1, 0xcc, 0x77, 0xec, 0x54, 0x90, 0x9c, 0x6f, 0x57, 0xd7, 0xb3, 0x89, 0x22,
+	0x72, 0xf2, 0xe6, 0x54, 0x39, 0x53, 0x85, 0xac, 0x33, 0x3e, 0x7a, 0x3a, 0xf8, 0x40, 0x81, 0xd9,
+	0x8e, 0x7c, 0x37, 0x5a, 0x8f, 0x5e, 0xe1, 0x93, 0xf3, 0xec, 0xea, 0xa5, 0x2e, 0x54, 0x21, 0x70,
+	0x57, 0x91, 0x2a, 0xda, 0xd3, 0x64, 0xbd, 0x48, 0x75, 0x7a, 0x3e, 0x45, 0x5c, 0x97, 0xf3, 0x32,
+	0xa5, 0xc6, 0x53, 0xdd, 0x51, 0xa5, 0xa6, 0x24, 0xd8, 0xd5, 0xf5, 0x6c, 0xa2, 0x88, 0x52, 0x79,
+	0x73, 0xef, 0x4a, 0xe5, 0xe7, 0x44, 0xaa, 0xd4, 0x0f, 0x15, 0x98, 0x7b, 0xe8, 0x1d, 0xc5, 0xf3,
+	0xd5, 0x51, 0x51, 0x53, 0x32, 0xeb, 0xea, 0x7a, 0x36, 0x91, 0x10, 0xf5, 0x9d, 0x76, 0xe5, 0x1a,
+	0xba, 0xfa, 0x93, 0x62, 0x23, 0xf2, 0xc3, 0x14, 0xdc, 0x6f, 0xa6, 0x89, 0x7e, 0x59, 0x7f, 0x29,
+	0x55, 0xc1, 0x94, 0x6f, 0xdb, 0xf4, 0x8e, 0xa8, 0xf8, 0x7f, 0xa1, 0xc0, 0xec, 0x43, 0xef, 0x28,
+	0x9a, 0x8a, 0x46, 0x91, 0x1a, 0xf2, 0xc4, 0x84, 0xb9, 0xaa, 0x67, 0x91, 0x08, 0xc1, 0x9f, 0xb5,
+	0x2b, 0x57, 0xd1, 0x46, 0x5c, 0x70, 0x9a, 0xe0, 0x4a, 0x13, 0x7b, 0x5d, 0xbf, 0x90, 0x22, 0x36,
+	0xe5, 0xf2, 0x85, 0x0e, 0x74, 0x1e, 0xcd, 0x11, 0xc7, 0x75, 0x9e, 0x98, 0x05, 0x57, 0xd7, 0xb3,
+	0x89, 0xba, 0xe8, 0x9c, 0x92, 0xf7, 0xa3, 0x73, 0xca, 0xe7, 0x8b, 0xff, 0x77, 0x0a, 0x94, 0xee,
+	0xb6, 0x3c, 0xd3, 0xc2, 0x9e, 0x77, 0x96, 0xb8, 0x69, 0xb4, 0x2b, 0x2f, 0xa3, 0x2d, 0x79, 0x0e,
+	0xbb, 0x62, 0xd4, 0x2e, 0xe0, 0xb9, 0x46, 0x17, 0xe8, 0xe5, 0x6c, 0xfc, 0xf8, 0x5d, 0xa1, 0xbf,
+	0x51, 0x60, 0xd1, 0x9f, 0xcd, 0xd9, 0xc0, 0xe8, 0x0b, 0xed, 0xca, 0x16, 0x2a, 0x27, 0xce, 0x23,
+	0x0b, 0x4b, 0x57, 0xf5, 0xf5, 0x2c, 0x2c, 0xf9, 0x7d, 0x74, 0x5a, 0xe4, 0xec, 0x50, 0x95, 0x69,
+	0x91, 0x2c, 0x68, 0x5d, 0xcb, 0x30, 0x07, 0x83, 0x96, 0x3c, 0x9b, 0x7f, 0x50, 0x60, 0xf9, 0x29,
+	0xae, 0x1f, 0x58, 0x66, 0xdd, 0x68, 0x9e, 0x25, 0xc0, 0xf6, 0xda, 0x95, 0x8f, 0xa3, 0x6d, 0x79,
+	0x3a, 0xc4, 0x1f, 0xb6, 0x0b, 0xc2, 0xca, 0xfa, 0x46, 0x36, 0xbc, 0x82, 0x8e, 0xe8, 0x84, 0xbe,
+	0xab, 0xc0, 0x52, 0x30, 0xa1, 0xb3, 0xc1, 0xd8, 0x6e, 0xbb, 0xb2, 0x8d, 0xae, 0x27, 0x4f, 0x25,
+	0x0b, 0x64, 0x9b, 0xfa, 0xa5, 0x2c, 0x90, 0x45, 0xa6, 0x11, 0xb3, 0xcb, 0xd9, 0xc1, 0x2c, 0xdb,
+	0x2e, 0x59, 0x38, 0xcb, 0xb2, 0x0b, 0xc3, 0x59, 0x64, 0x42, 0xdf, 0x52, 0x60, 0xe6, 0x2c, 0xf1,
+	0xf5, 0x79, 0x36, 0x8f, 0xaa, 0xb8, 0x41, 0xf8, 0xb2, 0xb2, 0xd4, 0x62, 0x17, 0x7c, 0xa5, 0xef,
+	0xdc, 0x9c, 0x8f, 0x4a, 0xff, 0xe7, 0x0a, 0x4c, 0x9d, 0x0d, 0x98, 0x3e, 0xc7, 0xc0, 0x94, 0x2c,
+	0x77, 0x16, 0x98, 0x34, 0x7d, 0x25, 0x03, 0x4c, 0xa1, 0xc6, 0xcf, 0x0e, 0x39, 0xd9, 0x1a, 0xcf,
+	0x42, 0x4e, 0x96, 0xc6, 0x29, 0x1f, 0x95, 0xfe, 0x9b, 0x0a, 0xcc, 0x55, 0x1a, 0x87, 0xa6, 0x75,
+	0x36, 0x6a, 0xef, 0xdc, 0xb3, 0x0d, 0x3a, 0x58, 0xa6, 0xca, 0xd3, 0xf7, 0x6c, 0xb6, 0x7e, 0x59,
+	0x07, 0x54, 0xf4, 0xef, 0x28, 0xb0, 0xc0, 0x44, 0x3f, 0x4b, 0xed, 0xff, 0x2c, 0x4b, 0xf5, 0xc8,
+	0x13, 0x60, 0x43, 0x76, 0xd1, 0xfc, 0x15, 0xfd, 0x62, 0xf6, 0x9a, 0x0d, 0x26, 0xf1, 0xfb, 0x0a,
+	0xcc, 0x76, 0x94, 0xa9, 0xa1, 0xd8, 0x65, 0x29, 0xb9, 0x3c, 0x4e, 0xbd, 0xd4, 0x85, 0x4a, 0x4c,
+	0xa1, 0xd2, 0xae, 0x2c, 0xa0, 0x39, 0xd1, 0x2e, 0x07, 0x46, 0xf8, 0xc9, 0x3f, 0xf5, 0xd8, 0xef,
+	0x71, 0x0e, 0x2a, 0xe5, 0x1f, 0xb1, 0x6b, 0x4a, 0xac, 0x32, 0x2d, 0x7e, 0x4d, 0x49, 0xae, 0x88,
+	0x53, 0x2f, 0x75, 0xa1, 0x12, 0x52, 0xde, 0x6f, 0x57, 0x4a, 0x68, 0x51, 0xb4, 0xcb, 0x7a, 0xcd,
+	0x79, 0x45, 0x61, 0x7c, 0xe8, 0x3f, 0x14, 0x40, 0x9d, 0x25, 0x1f, 0xe8, 0x52, 0x67, 0xa8, 0x37,
+	0xa1, 0x70, 0x43, 0xbd, 0xdc, 0x8d, 0x4c, 0xc8, 0xfa, 0xf3, 0x4a, 0xbb, 0xb2, 0x8b, 0xbe, 0xc0,
+	0x29, 0x7c, 0xb7, 0xc7, 0x13, 0xd7, 0x65, 0xed, 0xf8, 0xc0, 0xac, 0x1f, 0x68, 0x2e, 0x76, 0x9a,
+	0x46, 0x1d, 0x7b, 0x0c, 0x16, 0x61, 0x7c, 0x8a, 0x51, 0xf2, 0x42, 0x0c, 0x09, 0x33, 0x34, 0x60,
+	0xa6, 0xf9, 0xff, 0xd0, 0x4a, 0xb3, 0x5d, 0xed, 0xd0, 0x70, 0x5f, 0x60, 0x1e, 0x70, 0x2f, 0xe9,
+	0x2c, 0x1f, 0x27, 0x67, 0xc7, 0x4d, 0xcc, 0x1c, 0xcf, 0x7b, 0x0a, 0xa0, 0xce, 0x4a, 0x8c, 0xe8,
+	0x5c, 0x53, 0x8b, 0x54, 0xd4, 0xcb, 0xdd, 0xc8, 0xc2, 0x84, 0xc0, 0x22, 0x9a, 0xe7, 0x04, 0xd1,
+	0xa9, 0x72, 0xf1, 0x68, 0x64, 0x26, 0x49, 0x42, 0x7a, 0xb5, 0x9d, 0x4f, 0xaa, 0xc9, 0x40, 0x1b,
+	0x9d, 0x97, 0xc1, 0xc4, 0xaa, 0x0b, 0xf5, 0x4a, 0x77, 0xc2, 0x30, 0xae, 0x71, 0x1e, 0xad, 0x46,
+	0xf2, 0x05, 0xb2, 0xa8, 0xa6, 0x08, 0x6f, 0x94, 0x36, 0xd3, 0x74, 0xf9, 0x6f, 0x0a, 0x2c, 0x26,
+	0xd7, 0x30, 0xa0, 0xab, 0x49, 0xc1, 0xbc, 0x64, 0x81, 0x37, 0xf3, 0x90, 0x0a, 0x91, 0xdd, 0x76,
+	0xe5, 0x29, 0xaa, 0x86, 0x31, 0xbf, 0x40, 0xd2, 0x2e, 0x31, 0xbe, 0xa0, 0xd4, 0xa3, 0xac, 0xf1,
+	0xca, 0x0d, 0x1a, 0x44, 0xf6, 0x7f, 0x9b, 0x8d, 0xab, 0x72, 0x12, 0x37, 0x36, 0xd1, 0xbb, 0x43,
+	0xef, 0x14, 0x9c, 0xdd, 0xdd, 0x11, 0x56, 0x7a, 0xf2, 0x89, 0xff, 0x1b, 0x00, 0x9e, 0x45, 0x3c,
+	0x2e, 0x5e, 0x5f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4091,6 +4898,8 @@ type AppManagerClient interface {
 	GetAppStatistics(ctx context.Context, in *GetAppStatisticsRequest, opts ...grpc.CallOption) (*GetAppStatisticsResponse, error)
 	// Get apps, can filter with these fields(app_id, name, repo_id, description, status, home, icon, screenshots, maintainers, sources, readme, owner, chart_name), default return all apps
 	DescribeApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (*DescribeAppsResponse, error)
+	// Export apps, stream all the apps page by page for bulk reporting
+	ExportApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (AppManager_ExportAppsClient, error)
 	// Get active apps, can filter with these fields(app_id, name, repo_id, description, status, home, icon, screenshots, maintainers, sources, readme, owner, chart_name), default return all apps
 	DescribeActiveApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (*DescribeAppsResponse, error)
 	// Modify app info
@@ -4141,6 +4950,12 @@ type AppManagerClient interface {
 	TechnicalPassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error)
 	// Operator of technical reject version of the app
 	TechnicalRejectAppVersion(ctx context.Context, in *RejectAppVersionRequest, opts ...grpc.CallOption) (*RejectAppVersionResponse, error)
+	// Reviewer of the stage review version of the app
+	ReviewAppVersion(ctx context.Context, in *ReviewAppVersionRequest, opts ...grpc.CallOption) (*ReviewAppVersionResponse, error)
+	// Reviewer of the stage pass version of the app
+	PassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error)
+	// Reviewer of the stage reject version of the app
+	RejectAppVersion(ctx context.Context, in *RejectAppVersionRequest, opts ...grpc.CallOption) (*RejectAppVersionResponse, error)
 	// Operator of admin pass version of the app
 	AdminPassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error)
 	// Operator of admin reject version of the app
@@ -4149,6 +4964,14 @@ type AppManagerClient interface {
 	SuspendAppVersion(ctx context.Context, in *SuspendAppVersionRequest, opts ...grpc.CallOption) (*SuspendAppVersionResponse, error)
 	// Recover version of app
 	RecoverAppVersion(ctx context.Context, in *RecoverAppVersionRequest, opts ...grpc.CallOption) (*RecoverAppVersionResponse, error)
+	// Create review policy, which replaces the default review stages of the apps in category or market
+	CreateReviewPolicy(ctx context.Context, in *CreateReviewPolicyRequest, opts ...grpc.CallOption) (*CreateReviewPolicyResponse, error)
+	// Modify review policy
+	ModifyReviewPolicy(ctx context.Context, in *ModifyReviewPolicyRequest, opts ...grpc.CallOption) (*ModifyReviewPolicyResponse, error)
+	// Batch delete review policies
+	DeleteReviewPolicies(ctx context.Context, in *DeleteReviewPoliciesRequest, opts ...grpc.CallOption) (*DeleteReviewPoliciesResponse, error)
+	// Get review policies, can filter with these fields(policy_id, scope_type, scope_id)
+	DescribeReviewPolicies(ctx context.Context, in *DescribeReviewPoliciesRequest, opts ...grpc.CallOption) (*DescribeReviewPoliciesResponse, error)
 }
 
 type appManagerClient struct {
@@ -4213,6 +5036,38 @@ func (c *appManagerClient) DescribeApps(ctx context.Context, in *DescribeAppsReq
 	return out, nil
 }
 
+func (c *appManagerClient) ExportApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (AppManager_ExportAppsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppManager_serviceDesc.Streams[0], "/openpitrix.AppManager/ExportApps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appManagerExportAppsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppManager_ExportAppsClient interface {
+	Recv() (*DescribeAppsResponse, error)
+	grpc.ClientStream
+}
+
+type appManagerExportAppsClient struct {
+	grpc.ClientStream
+}
+
+func (x *appManagerExportAppsClient) Recv() (*DescribeAppsResponse, error) {
+	m := new(DescribeAppsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *appManagerClient) DescribeActiveApps(ctx context.Context, in *DescribeAppsRequest, opts ...grpc.CallOption) (*DescribeAppsResponse, error) {
 	out := new(DescribeAppsResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeActiveApps", in, out, opts...)
@@ -4438,6 +5293,33 @@ func (c *appManagerClient) TechnicalRejectAppVersion(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *appManagerClient) ReviewAppVersion(ctx context.Context, in *ReviewAppVersionRequest, opts ...grpc.CallOption) (*ReviewAppVersionResponse, error) {
+	out := new(ReviewAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ReviewAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) PassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error) {
+	out := new(PassAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/PassAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) RejectAppVersion(ctx context.Context, in *RejectAppVersionRequest, opts ...grpc.CallOption) (*RejectAppVersionResponse, error) {
+	out := new(RejectAppVersionResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/RejectAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appManagerClient) AdminPassAppVersion(ctx context.Context, in *PassAppVersionRequest, opts ...grpc.CallOption) (*PassAppVersionResponse, error) {
 	out := new(PassAppVersionResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/AdminPassAppVersion", in, out, opts...)
@@ -4474,6 +5356,42 @@ func (c *appManagerClient) RecoverAppVersion(ctx context.Context, in *RecoverApp
 	return out, nil
 }
 
+func (c *appManagerClient) CreateReviewPolicy(ctx context.Context, in *CreateReviewPolicyRequest, opts ...grpc.CallOption) (*CreateReviewPolicyResponse, error) {
+	out := new(CreateReviewPolicyResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/CreateReviewPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) ModifyReviewPolicy(ctx context.Context, in *ModifyReviewPolicyRequest, opts ...grpc.CallOption) (*ModifyReviewPolicyResponse, error) {
+	out := new(ModifyReviewPolicyResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/ModifyReviewPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) DeleteReviewPolicies(ctx context.Context, in *DeleteReviewPoliciesRequest, opts ...grpc.CallOption) (*DeleteReviewPoliciesResponse, error) {
+	out := new(DeleteReviewPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DeleteReviewPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagerClient) DescribeReviewPolicies(ctx context.Context, in *DescribeReviewPoliciesRequest, opts ...grpc.CallOption) (*DescribeReviewPoliciesResponse, error) {
+	out := new(DescribeReviewPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.AppManager/DescribeReviewPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppManagerServer is the server API for AppManager service.
 type AppManagerServer interface {
 	ResortApps(context.Context, *ResortAppsRequest) (*ResortAppsResponse, error)
@@ -4486,6 +5404,8 @@ type AppManagerServer interface {
 	GetAppStatistics(context.Context, *GetAppStatisticsRequest) (*GetAppStatisticsResponse, error)
 	// Get apps, can filter with these fields(app_id, name, repo_id, description, status, home, icon, screenshots, maintainers, sources, readme, owner, chart_name), default return all apps
 	DescribeApps(context.Context, *DescribeAppsRequest) (*DescribeAppsResponse, error)
+	// Export apps, stream all the apps page by page for bulk reporting
+	ExportApps(*DescribeAppsRequest, AppManager_ExportAppsServer) error
 	// Get active apps, can filter with these fields(app_id, name, repo_id, description, status, home, icon, screenshots, maintainers, sources, readme, owner, chart_name), default return all apps
 	DescribeActiveApps(context.Context, *DescribeAppsRequest) (*DescribeAppsResponse, error)
 	// Modify app info
@@ -4536,6 +5456,12 @@ type AppManagerServer interface {
 	TechnicalPassAppVersion(context.Context, *PassAppVersionRequest) (*PassAppVersionResponse, error)
 	// Operator of technical reject version of the app
 	TechnicalRejectAppVersion(context.Context, *RejectAppVersionRequest) (*RejectAppVersionResponse, error)
+	// Reviewer of the stage review version of the app
+	ReviewAppVersion(context.Context, *ReviewAppVersionRequest) (*ReviewAppVersionResponse, error)
+	// Reviewer of the stage pass version of the app
+	PassAppVersion(context.Context, *PassAppVersionRequest) (*PassAppVersionResponse, error)
+	// Reviewer of the stage reject version of the app
+	RejectAppVersion(context.Context, *RejectAppVersionRequest) (*RejectAppVersionResponse, error)
 	// Operator of admin pass version of the app
 	AdminPassAppVersion(context.Context, *PassAppVersionRequest) (*PassAppVersionResponse, error)
 	// Operator of admin reject version of the app
@@ -4544,6 +5470,14 @@ type AppManagerServer interface {
 	SuspendAppVersion(context.Context, *SuspendAppVersionRequest) (*SuspendAppVersionResponse, error)
 	// Recover version of app
 	RecoverAppVersion(context.Context, *RecoverAppVersionRequest) (*RecoverAppVersionResponse, error)
+	// Create review policy, which replaces the default review stages of the apps in category or market
+	CreateReviewPolicy(context.Context, *CreateReviewPolicyRequest) (*CreateReviewPolicyResponse, error)
+	// Modify review policy
+	ModifyReviewPolicy(context.Context, *ModifyReviewPolicyRequest) (*ModifyReviewPolicyResponse, error)
+	// Batch delete review policies
+	DeleteReviewPolicies(context.Context, *DeleteReviewPoliciesRequest) (*DeleteReviewPoliciesResponse, error)
+	// Get review policies, can filter with these fields(policy_id, scope_type, scope_id)
+	DescribeReviewPolicies(context.Context, *DescribeReviewPoliciesRequest) (*DescribeReviewPoliciesResponse, error)
 }
 
 // UnimplementedAppManagerServer can be embedded to have forward compatible implementations.
@@ -4568,6 +5502,9 @@ func (*UnimplementedAppManagerServer) GetAppStatistics(ctx context.Context, req
 func (*UnimplementedAppManagerServer) DescribeApps(ctx context.Context, req *DescribeAppsRequest) (*DescribeAppsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeApps not implemented")
 }
+func (*UnimplementedAppManagerServer) ExportApps(req *DescribeAppsRequest, srv AppManager_ExportAppsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportApps not implemented")
+}
 func (*UnimplementedAppManagerServer) DescribeActiveApps(ctx context.Context, req *DescribeAppsRequest) (*DescribeAppsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeActiveApps not implemented")
 }
@@ -4643,6 +5580,15 @@ func (*UnimplementedAppManagerServer) TechnicalPassAppVersion(ctx context.Contex
 func (*UnimplementedAppManagerServer) TechnicalRejectAppVersion(ctx context.Context, req *RejectAppVersionRequest) (*RejectAppVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TechnicalRejectAppVersion not implemented")
 }
+func (*UnimplementedAppManagerServer) ReviewAppVersion(ctx context.Context, req *ReviewAppVersionRequest) (*ReviewAppVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewAppVersion not implemented")
+}
+func (*UnimplementedAppManagerServer) PassAppVersion(ctx context.Context, req *PassAppVersionRequest) (*PassAppVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PassAppVersion not implemented")
+}
+func (*UnimplementedAppManagerServer) RejectAppVersion(ctx context.Context, req *RejectAppVersionRequest) (*RejectAppVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAppVersion not implemented")
+}
 func (*UnimplementedAppManagerServer) AdminPassAppVersion(ctx context.Context, req *PassAppVersionRequest) (*PassAppVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminPassAppVersion not implemented")
 }
@@ -4655,6 +5601,18 @@ func (*UnimplementedAppManagerServer) SuspendAppVersion(ctx context.Context, req
 func (*UnimplementedAppManagerServer) RecoverAppVersion(ctx context.Context, req *RecoverAppVersionRequest) (*RecoverAppVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverAppVersion not implemented")
 }
+func (*UnimplementedAppManagerServer) CreateReviewPolicy(ctx context.Context, req *CreateReviewPolicyRequest) (*CreateReviewPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewPolicy not implemented")
+}
+func (*UnimplementedAppManagerServer) ModifyReviewPolicy(ctx context.Context, req *ModifyReviewPolicyRequest) (*ModifyReviewPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyReviewPolicy not implemented")
+}
+func (*UnimplementedAppManagerServer) DeleteReviewPolicies(ctx context.Context, req *DeleteReviewPoliciesRequest) (*DeleteReviewPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewPolicies not implemented")
+}
+func (*UnimplementedAppManagerServer) DescribeReviewPolicies(ctx context.Context, req *DescribeReviewPoliciesRequest) (*DescribeReviewPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReviewPolicies not implemented")
+}
 
 func RegisterAppManagerServer(s *grpc.Server, srv AppManagerServer) {
 	s.RegisterService(&_AppManager_serviceDesc, srv)
@@ -4768,6 +5726,27 @@ func _AppManager_DescribeApps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_ExportApps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DescribeAppsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppManagerServer).ExportApps(m, &appManagerExportAppsServer{stream})
+}
+
+type AppManager_ExportAppsServer interface {
+	Send(*DescribeAppsResponse) error
+	grpc.ServerStream
+}
+
+type appManagerExportAppsServer struct {
+	grpc.ServerStream
+}
+
+func (x *appManagerExportAppsServer) Send(m *DescribeAppsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AppManager_DescribeActiveApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeAppsRequest)
 	if err := dec(in); err != nil {
@@ -5218,6 +6197,60 @@ func _AppManager_TechnicalRejectAppVersion_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_ReviewAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ReviewAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/ReviewAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ReviewAppVersion(ctx, req.(*ReviewAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_PassAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PassAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).PassAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/PassAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).PassAppVersion(ctx, req.(*PassAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_RejectAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).RejectAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/RejectAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).RejectAppVersion(ctx, req.(*RejectAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManager_AdminPassAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PassAppVersionRequest)
 	if err := dec(in); err != nil {
@@ -5290,6 +6323,78 @@ func _AppManager_RecoverAppVersion_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManager_CreateReviewPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).CreateReviewPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/CreateReviewPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).CreateReviewPolicy(ctx, req.(*CreateReviewPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_ModifyReviewPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyReviewPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).ModifyReviewPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/ModifyReviewPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).ModifyReviewPolicy(ctx, req.(*ModifyReviewPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_DeleteReviewPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).DeleteReviewPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/DeleteReviewPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).DeleteReviewPolicies(ctx, req.(*DeleteReviewPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManager_DescribeReviewPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReviewPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagerServer).DescribeReviewPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.AppManager/DescribeReviewPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagerServer).DescribeReviewPolicies(ctx, req.(*DescribeReviewPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AppManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.AppManager",
 	HandlerType: (*AppManagerServer)(nil),
@@ -5418,6 +6523,18 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TechnicalRejectAppVersion",
 			Handler:    _AppManager_TechnicalRejectAppVersion_Handler,
 		},
+		{
+			MethodName: "ReviewAppVersion",
+			Handler:    _AppManager_ReviewAppVersion_Handler,
+		},
+		{
+			MethodName: "PassAppVersion",
+			Handler:    _AppManager_PassAppVersion_Handler,
+		},
+		{
+			MethodName: "RejectAppVersion",
+			Handler:    _AppManager_RejectAppVersion_Handler,
+		},
 		{
 			MethodName: "AdminPassAppVersion",
 			Handler:    _AppManager_AdminPassAppVersion_Handler,
@@ -5434,7 +6551,29 @@ var _AppManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverAppVersion",
 			Handler:    _AppManager_RecoverAppVersion_Handler,
 		},
+		{
+			MethodName: "CreateReviewPolicy",
+			Handler:    _AppManager_CreateReviewPolicy_Handler,
+		},
+		{
+			MethodName: "ModifyReviewPolicy",
+			Handler:    _AppManager_ModifyReviewPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteReviewPolicies",
+			Handler:    _AppManager_DeleteReviewPolicies_Handler,
+		},
+		{
+			MethodName: "DescribeReviewPolicies",
+			Handler:    _AppManager_DescribeReviewPolicies_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportApps",
+			Handler:       _AppManager_ExportApps_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "app.proto",
 }
@@ -1176,10 +1176,12 @@ type UpgradeClusterRequest struct {
 	// app version id
 	VersionId *wrappers.StringValue `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	// advanced param
-	AdvancedParam        []string `protobuf:"bytes,3,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdvancedParam []string `protobuf:"bytes,3,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *UpgradeClusterRequest) Reset()         { *m = UpgradeClusterRequest{} }
@@ -1228,14 +1230,23 @@ func (m *UpgradeClusterRequest) GetAdvancedParam() []string {
 	return nil
 }
 
+func (m *UpgradeClusterRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
 type UpgradeClusterResponse struct {
 	// id of cluster upgraded
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// job id
-	JobId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	JobId *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *UpgradeClusterResponse) Reset()         { *m = UpgradeClusterResponse{} }
@@ -1277,14 +1288,25 @@ func (m *UpgradeClusterResponse) GetJobId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *UpgradeClusterResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
 type RollbackClusterRequest struct {
 	// required, id of cluster to rollback
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// advanced param
-	AdvancedParam        []string `protobuf:"bytes,2,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdvancedParam []string `protobuf:"bytes,2,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun *wrappers.BoolValue `protobuf:"bytes,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// version of helm release revision to roll back a kubernetes cluster to, default to the previous revision
+	ReleaseVersion       *wrappers.Int32Value `protobuf:"bytes,4,opt,name=release_version,json=releaseVersion,proto3" json:"release_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *RollbackClusterRequest) Reset()         { *m = RollbackClusterRequest{} }
@@ -1326,14 +1348,30 @@ func (m *RollbackClusterRequest) GetAdvancedParam() []string {
 	return nil
 }
 
+func (m *RollbackClusterRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
+func (m *RollbackClusterRequest) GetReleaseVersion() *wrappers.Int32Value {
+	if m != nil {
+		return m.ReleaseVersion
+	}
+	return nil
+}
+
 type RollbackClusterResponse struct {
 	// id of cluster to rollbacked
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// job id
-	JobId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	JobId *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *RollbackClusterResponse) Reset()         { *m = RollbackClusterResponse{} }
@@ -1375,6 +1413,13 @@ func (m *RollbackClusterResponse) GetJobId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *RollbackClusterResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
 type RoleResource struct {
 	// role.eg:[mysql|wordpress]
 	Role *wrappers.StringValue `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -1466,10 +1511,12 @@ type ResizeClusterRequest struct {
 	// list of role resource
 	RoleResource []*RoleResource `protobuf:"bytes,2,rep,name=role_resource,json=roleResource,proto3" json:"role_resource,omitempty"`
 	// advanced param
-	AdvancedParam        []string `protobuf:"bytes,5,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdvancedParam []string `protobuf:"bytes,5,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *ResizeClusterRequest) Reset()         { *m = ResizeClusterRequest{} }
@@ -1518,14 +1565,23 @@ func (m *ResizeClusterRequest) GetAdvancedParam() []string {
 	return nil
 }
 
+func (m *ResizeClusterRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
 type ResizeClusterResponse struct {
 	// id of cluster resized
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// job id
-	JobId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	JobId *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ResizeClusterResponse) Reset()         { *m = ResizeClusterResponse{} }
@@ -1567,6 +1623,13 @@ func (m *ResizeClusterResponse) GetJobId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *ResizeClusterResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
 type AddClusterNodesRequest struct {
 	// required, id of cluster to add node
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
@@ -1575,10 +1638,12 @@ type AddClusterNodesRequest struct {
 	// number of node added to cluster
 	NodeCount *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
 	// advanced param
-	AdvancedParam        []string `protobuf:"bytes,4,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdvancedParam []string `protobuf:"bytes,4,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *AddClusterNodesRequest) Reset()         { *m = AddClusterNodesRequest{} }
@@ -1634,14 +1699,23 @@ func (m *AddClusterNodesRequest) GetAdvancedParam() []string {
 	return nil
 }
 
+func (m *AddClusterNodesRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
 type AddClusterNodesResponse struct {
 	// id of cluster added node
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// id of job of add node to cluster
-	JobId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	JobId *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *AddClusterNodesResponse) Reset()         { *m = AddClusterNodesResponse{} }
@@ -1683,16 +1757,25 @@ func (m *AddClusterNodesResponse) GetJobId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *AddClusterNodesResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
 type DeleteClusterNodesRequest struct {
 	// required, id of cluster to delete node
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// required, node ids
 	NodeId []string `protobuf:"bytes,2,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	// advanced param
-	AdvancedParam        []string `protobuf:"bytes,3,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdvancedParam []string `protobuf:"bytes,3,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *DeleteClusterNodesRequest) Reset()         { *m = DeleteClusterNodesRequest{} }
@@ -1741,14 +1824,23 @@ func (m *DeleteClusterNodesRequest) GetAdvancedParam() []string {
 	return nil
 }
 
+func (m *DeleteClusterNodesRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
 type DeleteClusterNodesResponse struct {
 	// id of cluster deleted node
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// job id
-	JobId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	JobId *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *DeleteClusterNodesResponse) Reset()         { *m = DeleteClusterNodesResponse{} }
@@ -1790,16 +1882,25 @@ func (m *DeleteClusterNodesResponse) GetJobId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *DeleteClusterNodesResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
 type UpdateClusterEnvRequest struct {
 	// id of cluster to update env
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// env
 	Env *wrappers.StringValue `protobuf:"bytes,2,opt,name=env,proto3" json:"env,omitempty"`
 	// advanced param
-	AdvancedParam        []string `protobuf:"bytes,3,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdvancedParam []string `protobuf:"bytes,3,rep,name=advanced_param,json=advancedParam,proto3" json:"advanced_param,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *UpdateClusterEnvRequest) Reset()         { *m = UpdateClusterEnvRequest{} }
@@ -1848,14 +1949,23 @@ func (m *UpdateClusterEnvRequest) GetAdvancedParam() []string {
 	return nil
 }
 
+func (m *UpdateClusterEnvRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
 type UpdateClusterEnvResponse struct {
 	// id of cluster to updated env
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	// job id
-	JobId                *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	JobId *wrappers.StringValue `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *UpdateClusterEnvResponse) Reset()         { *m = UpdateClusterEnvResponse{} }
@@ -1897,6 +2007,13 @@ func (m *UpdateClusterEnvResponse) GetJobId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *UpdateClusterEnvResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
 type ClusterCommon struct {
 	// cluster id
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
@@ -2219,10 +2336,12 @@ type ClusterNode struct {
 	// list of ssh key pair id
 	KeyPairId []string `protobuf:"bytes,28,rep,name=key_pair_id,json=keyPairId,proto3" json:"key_pair_id,omitempty"`
 	// owner
-	Owner                *wrappers.StringValue `protobuf:"bytes,29,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Owner *wrappers.StringValue `protobuf:"bytes,29,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the time when the health check of role last ran on cluster node
+	HealthCheckTime      *timestamp.Timestamp `protobuf:"bytes,30,opt,name=health_check_time,json=healthCheckTime,proto3" json:"health_check_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ClusterNode) Reset()         { *m = ClusterNode{} }
@@ -2453,6 +2572,13 @@ func (m *ClusterNode) GetOwner() *wrappers.StringValue {
 	return nil
 }
 
+func (m *ClusterNode) GetHealthCheckTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.HealthCheckTime
+	}
+	return nil
+}
+
 type ClusterRole struct {
 	// cluster id
 	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
@@ -3098,11 +3224,13 @@ type DescribeClustersRequest struct {
 	// select column to display
 	DisplayColumns []string `protobuf:"bytes,17,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
 	// namespace
-	Zone                 []string             `protobuf:"bytes,18,rep,name=zone,proto3" json:"zone,omitempty"`
-	MinCreateTime        *timestamp.Timestamp `protobuf:"bytes,19,opt,name=min_create_time,json=minCreateTime,proto3" json:"min_create_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Zone          []string             `protobuf:"bytes,18,rep,name=zone,proto3" json:"zone,omitempty"`
+	MinCreateTime *timestamp.Timestamp `protobuf:"bytes,19,opt,name=min_create_time,json=minCreateTime,proto3" json:"min_create_time,omitempty"`
+	// page token of the next page returned by previous page, the offset is ignored if it is set
+	PageToken            *wrappers.StringValue `protobuf:"bytes,20,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeClustersRequest) Reset()         { *m = DescribeClustersRequest{} }
@@ -3263,14 +3391,23 @@ func (m *DescribeClustersRequest) GetMinCreateTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *DescribeClustersRequest) GetPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
 type DescribeClustersResponse struct {
 	// total count of qualified cluster
 	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// list of cluster
-	ClusterSet           []*Cluster `protobuf:"bytes,2,rep,name=cluster_set,json=clusterSet,proto3" json:"cluster_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	ClusterSet []*Cluster `protobuf:"bytes,2,rep,name=cluster_set,json=clusterSet,proto3" json:"cluster_set,omitempty"`
+	// page token of the next page, empty if there are no more clusters
+	NextPageToken        *wrappers.StringValue `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeClustersResponse) Reset()         { *m = DescribeClustersResponse{} }
@@ -3312,6 +3449,13 @@ func (m *DescribeClustersResponse) GetClusterSet() []*Cluster {
 	return nil
 }
 
+func (m *DescribeClustersResponse) GetNextPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 type DescribeAppClustersRequest struct {
 	// app ids
 	AppId []string `protobuf:"bytes,1,rep,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -3511,7 +3655,11 @@ type DescribeClusterNodesRequest struct {
 	// owner
 	Owner []string `protobuf:"bytes,9,rep,name=owner,proto3" json:"owner,omitempty"`
 	// select columns to display
-	DisplayColumns       []string `protobuf:"bytes,10,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
+	DisplayColumns []string `protobuf:"bytes,10,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
+	// page token of the next page returned by previous page, the offset is ignored if it is set
+	PageToken *wrappers.StringValue `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// health status eg.[healthy|unhealthy]
+	HealthStatus         []string `protobuf:"bytes,12,rep,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3612,14 +3760,30 @@ func (m *DescribeClusterNodesRequest) GetDisplayColumns() []string {
 	return nil
 }
 
+func (m *DescribeClusterNodesRequest) GetPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
+func (m *DescribeClusterNodesRequest) GetHealthStatus() []string {
+	if m != nil {
+		return m.HealthStatus
+	}
+	return nil
+}
+
 type DescribeClusterNodesResponse struct {
 	// total count of node in the cluster
 	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// list of cluster node
-	ClusterNodeSet       []*ClusterNode `protobuf:"bytes,2,rep,name=cluster_node_set,json=clusterNodeSet,proto3" json:"cluster_node_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	ClusterNodeSet []*ClusterNode `protobuf:"bytes,2,rep,name=cluster_node_set,json=clusterNodeSet,proto3" json:"cluster_node_set,omitempty"`
+	// page token of the next page, empty if there are no more cluster nodes
+	NextPageToken        *wrappers.StringValue `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeClusterNodesResponse) Reset()         { *m = DescribeClusterNodesResponse{} }
@@ -3661,6 +3825,13 @@ func (m *DescribeClusterNodesResponse) GetClusterNodeSet() []*ClusterNode {
 	return nil
 }
 
+func (m *DescribeClusterNodesResponse) GetNextPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 type StopClustersRequest struct {
 	// required, ids of cluster to stop
 	ClusterId []string `protobuf:"bytes,1,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
@@ -4178,105 +4349,239 @@ func (m *GetClusterStatisticsResponse) GetTopTenApps() map[string]uint32 {
 	return nil
 }
 
-type KeyPair struct {
-	// ssh key pair id
-	KeyPairId *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_pair_id,json=keyPairId,proto3" json:"key_pair_id,omitempty"`
-	// key pair name
-	Name *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// key pair description
-	Description *wrappers.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// public key
-	PubKey *wrappers.StringValue `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// owner path, concat string group_path:user_id
-	OwnerPath *wrappers.StringValue `protobuf:"bytes,5,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
-	// the time when key pair create
-	CreateTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// record status changed time
-	StatusTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
-	// list of node used the keypair
-	NodeId []string `protobuf:"bytes,8,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// owner
-	Owner                *wrappers.StringValue `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+type RunClusterNodeCommandRequest struct {
+	// required, id of cluster node to run command on
+	NodeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// required, command run by /bin/sh -c
+	Command *wrappers.StringValue `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// seconds to kill the command, no timeout if not set
+	TimeoutSeconds       *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *KeyPair) Reset()         { *m = KeyPair{} }
-func (m *KeyPair) String() string { return proto.CompactTextString(m) }
-func (*KeyPair) ProtoMessage()    {}
-func (*KeyPair) Descriptor() ([]byte, []int) {
+func (m *RunClusterNodeCommandRequest) Reset()         { *m = RunClusterNodeCommandRequest{} }
+func (m *RunClusterNodeCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*RunClusterNodeCommandRequest) ProtoMessage()    {}
+func (*RunClusterNodeCommandRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3cfb3b8ec240c376, []int{56}
 }
 
-func (m *KeyPair) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_KeyPair.Unmarshal(m, b)
+func (m *RunClusterNodeCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunClusterNodeCommandRequest.Unmarshal(m, b)
 }
-func (m *KeyPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_KeyPair.Marshal(b, m, deterministic)
+func (m *RunClusterNodeCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunClusterNodeCommandRequest.Marshal(b, m, deterministic)
 }
-func (m *KeyPair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KeyPair.Merge(m, src)
+func (m *RunClusterNodeCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunClusterNodeCommandRequest.Merge(m, src)
 }
-func (m *KeyPair) XXX_Size() int {
-	return xxx_messageInfo_KeyPair.Size(m)
+func (m *RunClusterNodeCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_RunClusterNodeCommandRequest.Size(m)
 }
-func (m *KeyPair) XXX_DiscardUnknown() {
-	xxx_messageInfo_KeyPair.DiscardUnknown(m)
+func (m *RunClusterNodeCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunClusterNodeCommandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KeyPair proto.InternalMessageInfo
+var xxx_messageInfo_RunClusterNodeCommandRequest proto.InternalMessageInfo
 
-func (m *KeyPair) GetKeyPairId() *wrappers.StringValue {
+func (m *RunClusterNodeCommandRequest) GetNodeId() *wrappers.StringValue {
 	if m != nil {
-		return m.KeyPairId
+		return m.NodeId
 	}
 	return nil
 }
 
-func (m *KeyPair) GetName() *wrappers.StringValue {
+func (m *RunClusterNodeCommandRequest) GetCommand() *wrappers.StringValue {
 	if m != nil {
-		return m.Name
+		return m.Command
 	}
 	return nil
 }
 
-func (m *KeyPair) GetDescription() *wrappers.StringValue {
+func (m *RunClusterNodeCommandRequest) GetTimeoutSeconds() *wrappers.UInt32Value {
 	if m != nil {
-		return m.Description
+		return m.TimeoutSeconds
 	}
 	return nil
 }
 
-func (m *KeyPair) GetPubKey() *wrappers.StringValue {
+type RunClusterNodeCommandResponse struct {
+	// id of cluster node
+	NodeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// chunk of stdout
+	Stdout *wrappers.StringValue `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// chunk of stderr
+	Stderr *wrappers.StringValue `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// true if command exited, it is the last response
+	Exited *wrappers.BoolValue `protobuf:"bytes,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	// exit code of command
+	ExitCode             *wrappers.Int32Value `protobuf:"bytes,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RunClusterNodeCommandResponse) Reset()         { *m = RunClusterNodeCommandResponse{} }
+func (m *RunClusterNodeCommandResponse) String() string { return proto.CompactTextString(m) }
+func (*RunClusterNodeCommandResponse) ProtoMessage()    {}
+func (*RunClusterNodeCommandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{57}
+}
+
+func (m *RunClusterNodeCommandResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunClusterNodeCommandResponse.Unmarshal(m, b)
+}
+func (m *RunClusterNodeCommandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunClusterNodeCommandResponse.Marshal(b, m, deterministic)
+}
+func (m *RunClusterNodeCommandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunClusterNodeCommandResponse.Merge(m, src)
+}
+func (m *RunClusterNodeCommandResponse) XXX_Size() int {
+	return xxx_messageInfo_RunClusterNodeCommandResponse.Size(m)
+}
+func (m *RunClusterNodeCommandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunClusterNodeCommandResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunClusterNodeCommandResponse proto.InternalMessageInfo
+
+func (m *RunClusterNodeCommandResponse) GetNodeId() *wrappers.StringValue {
 	if m != nil {
-		return m.PubKey
+		return m.NodeId
 	}
 	return nil
 }
 
-func (m *KeyPair) GetOwnerPath() *wrappers.StringValue {
+func (m *RunClusterNodeCommandResponse) GetStdout() *wrappers.StringValue {
 	if m != nil {
-		return m.OwnerPath
+		return m.Stdout
 	}
 	return nil
 }
 
-func (m *KeyPair) GetCreateTime() *timestamp.Timestamp {
+func (m *RunClusterNodeCommandResponse) GetStderr() *wrappers.StringValue {
 	if m != nil {
-		return m.CreateTime
+		return m.Stderr
 	}
 	return nil
 }
 
-func (m *KeyPair) GetStatusTime() *timestamp.Timestamp {
+func (m *RunClusterNodeCommandResponse) GetExited() *wrappers.BoolValue {
 	if m != nil {
-		return m.StatusTime
+		return m.Exited
 	}
 	return nil
 }
 
-func (m *KeyPair) GetNodeId() []string {
+func (m *RunClusterNodeCommandResponse) GetExitCode() *wrappers.Int32Value {
+	if m != nil {
+		return m.ExitCode
+	}
+	return nil
+}
+
+type KeyPair struct {
+	// ssh key pair id
+	KeyPairId *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_pair_id,json=keyPairId,proto3" json:"key_pair_id,omitempty"`
+	// key pair name
+	Name *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// key pair description
+	Description *wrappers.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// public key
+	PubKey *wrappers.StringValue `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// owner path, concat string group_path:user_id
+	OwnerPath *wrappers.StringValue `protobuf:"bytes,5,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
+	// the time when key pair create
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// record status changed time
+	StatusTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	// list of node used the keypair
+	NodeId []string `protobuf:"bytes,8,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// owner
+	Owner                *wrappers.StringValue `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *KeyPair) Reset()         { *m = KeyPair{} }
+func (m *KeyPair) String() string { return proto.CompactTextString(m) }
+func (*KeyPair) ProtoMessage()    {}
+func (*KeyPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{58}
+}
+
+func (m *KeyPair) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyPair.Unmarshal(m, b)
+}
+func (m *KeyPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyPair.Marshal(b, m, deterministic)
+}
+func (m *KeyPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyPair.Merge(m, src)
+}
+func (m *KeyPair) XXX_Size() int {
+	return xxx_messageInfo_KeyPair.Size(m)
+}
+func (m *KeyPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyPair proto.InternalMessageInfo
+
+func (m *KeyPair) GetKeyPairId() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyPairId
+	}
+	return nil
+}
+
+func (m *KeyPair) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *KeyPair) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *KeyPair) GetPubKey() *wrappers.StringValue {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *KeyPair) GetOwnerPath() *wrappers.StringValue {
+	if m != nil {
+		return m.OwnerPath
+	}
+	return nil
+}
+
+func (m *KeyPair) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *KeyPair) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+func (m *KeyPair) GetNodeId() []string {
 	if m != nil {
 		return m.NodeId
 	}
@@ -4306,7 +4611,7 @@ func (m *CreateKeyPairRequest) Reset()         { *m = CreateKeyPairRequest{} }
 func (m *CreateKeyPairRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyPairRequest) ProtoMessage()    {}
 func (*CreateKeyPairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{57}
+	return fileDescriptor_3cfb3b8ec240c376, []int{59}
 }
 
 func (m *CreateKeyPairRequest) XXX_Unmarshal(b []byte) error {
@@ -4360,7 +4665,7 @@ func (m *CreateKeyPairResponse) Reset()         { *m = CreateKeyPairResponse{} }
 func (m *CreateKeyPairResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyPairResponse) ProtoMessage()    {}
 func (*CreateKeyPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{58}
+	return fileDescriptor_3cfb3b8ec240c376, []int{60}
 }
 
 func (m *CreateKeyPairResponse) XXX_Unmarshal(b []byte) error {
@@ -4416,7 +4721,7 @@ func (m *DescribeKeyPairsRequest) Reset()         { *m = DescribeKeyPairsRequest
 func (m *DescribeKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeKeyPairsRequest) ProtoMessage()    {}
 func (*DescribeKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{59}
+	return fileDescriptor_3cfb3b8ec240c376, []int{61}
 }
 
 func (m *DescribeKeyPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4514,7 +4819,7 @@ func (m *DescribeKeyPairsResponse) Reset()         { *m = DescribeKeyPairsRespon
 func (m *DescribeKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeKeyPairsResponse) ProtoMessage()    {}
 func (*DescribeKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{60}
+	return fileDescriptor_3cfb3b8ec240c376, []int{62}
 }
 
 func (m *DescribeKeyPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4561,7 +4866,7 @@ func (m *DeleteKeyPairsRequest) Reset()         { *m = DeleteKeyPairsRequest{} }
 func (m *DeleteKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyPairsRequest) ProtoMessage()    {}
 func (*DeleteKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{61}
+	return fileDescriptor_3cfb3b8ec240c376, []int{63}
 }
 
 func (m *DeleteKeyPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4601,7 +4906,7 @@ func (m *DeleteKeyPairsResponse) Reset()         { *m = DeleteKeyPairsResponse{}
 func (m *DeleteKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyPairsResponse) ProtoMessage()    {}
 func (*DeleteKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{62}
+	return fileDescriptor_3cfb3b8ec240c376, []int{64}
 }
 
 func (m *DeleteKeyPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4643,7 +4948,7 @@ func (m *AttachKeyPairsRequest) Reset()         { *m = AttachKeyPairsRequest{} }
 func (m *AttachKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*AttachKeyPairsRequest) ProtoMessage()    {}
 func (*AttachKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{63}
+	return fileDescriptor_3cfb3b8ec240c376, []int{65}
 }
 
 func (m *AttachKeyPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4690,7 +4995,7 @@ func (m *AttachKeyPairsResponse) Reset()         { *m = AttachKeyPairsResponse{}
 func (m *AttachKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*AttachKeyPairsResponse) ProtoMessage()    {}
 func (*AttachKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{64}
+	return fileDescriptor_3cfb3b8ec240c376, []int{66}
 }
 
 func (m *AttachKeyPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4732,7 +5037,7 @@ func (m *DetachKeyPairsRequest) Reset()         { *m = DetachKeyPairsRequest{} }
 func (m *DetachKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DetachKeyPairsRequest) ProtoMessage()    {}
 func (*DetachKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{65}
+	return fileDescriptor_3cfb3b8ec240c376, []int{67}
 }
 
 func (m *DetachKeyPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4779,7 +5084,7 @@ func (m *DetachKeyPairsResponse) Reset()         { *m = DetachKeyPairsResponse{}
 func (m *DetachKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetachKeyPairsResponse) ProtoMessage()    {}
 func (*DetachKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{66}
+	return fileDescriptor_3cfb3b8ec240c376, []int{68}
 }
 
 func (m *DetachKeyPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4821,7 +5126,7 @@ func (m *NodeKeyPair) Reset()         { *m = NodeKeyPair{} }
 func (m *NodeKeyPair) String() string { return proto.CompactTextString(m) }
 func (*NodeKeyPair) ProtoMessage()    {}
 func (*NodeKeyPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{67}
+	return fileDescriptor_3cfb3b8ec240c376, []int{69}
 }
 
 func (m *NodeKeyPair) XXX_Unmarshal(b []byte) error {
@@ -4868,7 +5173,7 @@ func (m *AddNodeKeyPairsRequest) Reset()         { *m = AddNodeKeyPairsRequest{}
 func (m *AddNodeKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddNodeKeyPairsRequest) ProtoMessage()    {}
 func (*AddNodeKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{68}
+	return fileDescriptor_3cfb3b8ec240c376, []int{70}
 }
 
 func (m *AddNodeKeyPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4906,7 +5211,7 @@ func (m *AddNodeKeyPairsResponse) Reset()         { *m = AddNodeKeyPairsResponse
 func (m *AddNodeKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddNodeKeyPairsResponse) ProtoMessage()    {}
 func (*AddNodeKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{69}
+	return fileDescriptor_3cfb3b8ec240c376, []int{71}
 }
 
 func (m *AddNodeKeyPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4939,7 +5244,7 @@ func (m *DeleteNodeKeyPairsRequest) Reset()         { *m = DeleteNodeKeyPairsReq
 func (m *DeleteNodeKeyPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNodeKeyPairsRequest) ProtoMessage()    {}
 func (*DeleteNodeKeyPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{70}
+	return fileDescriptor_3cfb3b8ec240c376, []int{72}
 }
 
 func (m *DeleteNodeKeyPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4977,7 +5282,7 @@ func (m *DeleteNodeKeyPairsResponse) Reset()         { *m = DeleteNodeKeyPairsRe
 func (m *DeleteNodeKeyPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteNodeKeyPairsResponse) ProtoMessage()    {}
 func (*DeleteNodeKeyPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3cfb3b8ec240c376, []int{71}
+	return fileDescriptor_3cfb3b8ec240c376, []int{73}
 }
 
 func (m *DeleteNodeKeyPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4998,434 +5303,3313 @@ func (m *DeleteNodeKeyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteNodeKeyPairsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*DescribeSubnetsRequest)(nil), "openpitrix.DescribeSubnetsRequest")
-	proto.RegisterType((*Subnet)(nil), "openpitrix.Subnet")
-	proto.RegisterType((*DescribeSubnetsResponse)(nil), "openpitrix.DescribeSubnetsResponse")
-	proto.RegisterType((*DeleteClusterInRuntimeRequest)(nil), "openpitrix.DeleteClusterInRuntimeRequest")
-	proto.RegisterType((*DeleteClusterInRuntimeResponse)(nil), "openpitrix.DeleteClusterInRuntimeResponse")
-	proto.RegisterType((*MigrateClusterInRuntimeRequest)(nil), "openpitrix.MigrateClusterInRuntimeRequest")
-	proto.RegisterType((*MigrateClusterInRuntimeResponse)(nil), "openpitrix.MigrateClusterInRuntimeResponse")
-	proto.RegisterType((*CreateClusterRequest)(nil), "openpitrix.CreateClusterRequest")
-	proto.RegisterType((*CreateClusterResponse)(nil), "openpitrix.CreateClusterResponse")
-	proto.RegisterType((*ModifyClusterRequest)(nil), "openpitrix.ModifyClusterRequest")
-	proto.RegisterType((*ModifyClusterResponse)(nil), "openpitrix.ModifyClusterResponse")
-	proto.RegisterType((*ModifyClusterNodeRequest)(nil), "openpitrix.ModifyClusterNodeRequest")
-	proto.RegisterType((*ModifyClusterNodeResponse)(nil), "openpitrix.ModifyClusterNodeResponse")
-	proto.RegisterType((*ModifyClusterAttributesRequest)(nil), "openpitrix.ModifyClusterAttributesRequest")
-	proto.RegisterType((*ModifyClusterAttributesResponse)(nil), "openpitrix.ModifyClusterAttributesResponse")
-	proto.RegisterType((*ModifyClusterNodeAttributesRequest)(nil), "openpitrix.ModifyClusterNodeAttributesRequest")
-	proto.RegisterType((*ModifyClusterNodeAttributesResponse)(nil), "openpitrix.ModifyClusterNodeAttributesResponse")
-	proto.RegisterType((*AddTableClusterNodesRequest)(nil), "openpitrix.AddTableClusterNodesRequest")
-	proto.RegisterType((*DeleteTableClusterNodesRequest)(nil), "openpitrix.DeleteTableClusterNodesRequest")
-	proto.RegisterType((*DeleteClustersRequest)(nil), "openpitrix.DeleteClustersRequest")
-	proto.RegisterType((*DeleteClustersResponse)(nil), "openpitrix.DeleteClustersResponse")
-	proto.RegisterType((*UpgradeClusterRequest)(nil), "openpitrix.UpgradeClusterRequest")
-	proto.RegisterType((*UpgradeClusterResponse)(nil), "openpitrix.UpgradeClusterResponse")
-	proto.RegisterType((*RollbackClusterRequest)(nil), "openpitrix.RollbackClusterRequest")
-	proto.RegisterType((*RollbackClusterResponse)(nil), "openpitrix.RollbackClusterResponse")
-	proto.RegisterType((*RoleResource)(nil), "openpitrix.RoleResource")
-	proto.RegisterType((*ResizeClusterRequest)(nil), "openpitrix.ResizeClusterRequest")
-	proto.RegisterType((*ResizeClusterResponse)(nil), "openpitrix.ResizeClusterResponse")
-	proto.RegisterType((*AddClusterNodesRequest)(nil), "openpitrix.AddClusterNodesRequest")
-	proto.RegisterType((*AddClusterNodesResponse)(nil), "openpitrix.AddClusterNodesResponse")
-	proto.RegisterType((*DeleteClusterNodesRequest)(nil), "openpitrix.DeleteClusterNodesRequest")
-	proto.RegisterType((*DeleteClusterNodesResponse)(nil), "openpitrix.DeleteClusterNodesResponse")
-	proto.RegisterType((*UpdateClusterEnvRequest)(nil), "openpitrix.UpdateClusterEnvRequest")
-	proto.RegisterType((*UpdateClusterEnvResponse)(nil), "openpitrix.UpdateClusterEnvResponse")
-	proto.RegisterType((*ClusterCommon)(nil), "openpitrix.ClusterCommon")
-	proto.RegisterType((*ClusterNode)(nil), "openpitrix.ClusterNode")
-	proto.RegisterType((*ClusterRole)(nil), "openpitrix.ClusterRole")
-	proto.RegisterType((*ClusterLoadbalancer)(nil), "openpitrix.ClusterLoadbalancer")
-	proto.RegisterType((*ClusterLink)(nil), "openpitrix.ClusterLink")
-	proto.RegisterType((*Cluster)(nil), "openpitrix.Cluster")
-	proto.RegisterType((*DescribeClustersRequest)(nil), "openpitrix.DescribeClustersRequest")
-	proto.RegisterType((*DescribeClustersResponse)(nil), "openpitrix.DescribeClustersResponse")
-	proto.RegisterType((*DescribeAppClustersRequest)(nil), "openpitrix.DescribeAppClustersRequest")
-	proto.RegisterType((*DescribeAppClustersResponse)(nil), "openpitrix.DescribeAppClustersResponse")
-	proto.RegisterType((*DescribeClusterNodesRequest)(nil), "openpitrix.DescribeClusterNodesRequest")
-	proto.RegisterType((*DescribeClusterNodesResponse)(nil), "openpitrix.DescribeClusterNodesResponse")
-	proto.RegisterType((*StopClustersRequest)(nil), "openpitrix.StopClustersRequest")
-	proto.RegisterType((*StopClustersResponse)(nil), "openpitrix.StopClustersResponse")
-	proto.RegisterType((*StartClustersRequest)(nil), "openpitrix.StartClustersRequest")
-	proto.RegisterType((*StartClustersResponse)(nil), "openpitrix.StartClustersResponse")
-	proto.RegisterType((*RecoverClustersRequest)(nil), "openpitrix.RecoverClustersRequest")
-	proto.RegisterType((*RecoverClustersResponse)(nil), "openpitrix.RecoverClustersResponse")
-	proto.RegisterType((*CeaseClustersRequest)(nil), "openpitrix.CeaseClustersRequest")
-	proto.RegisterType((*CeaseClustersResponse)(nil), "openpitrix.CeaseClustersResponse")
-	proto.RegisterType((*GetClusterStatisticsRequest)(nil), "openpitrix.GetClusterStatisticsRequest")
-	proto.RegisterType((*GetClusterStatisticsResponse)(nil), "openpitrix.GetClusterStatisticsResponse")
-	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.LastTwoWeekCreatedEntry")
-	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.TopTenAppsEntry")
-	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.TopTenRuntimesEntry")
-	proto.RegisterType((*KeyPair)(nil), "openpitrix.KeyPair")
-	proto.RegisterType((*CreateKeyPairRequest)(nil), "openpitrix.CreateKeyPairRequest")
-	proto.RegisterType((*CreateKeyPairResponse)(nil), "openpitrix.CreateKeyPairResponse")
-	proto.RegisterType((*DescribeKeyPairsRequest)(nil), "openpitrix.DescribeKeyPairsRequest")
-	proto.RegisterType((*DescribeKeyPairsResponse)(nil), "openpitrix.DescribeKeyPairsResponse")
-	proto.RegisterType((*DeleteKeyPairsRequest)(nil), "openpitrix.DeleteKeyPairsRequest")
-	proto.RegisterType((*DeleteKeyPairsResponse)(nil), "openpitrix.DeleteKeyPairsResponse")
-	proto.RegisterType((*AttachKeyPairsRequest)(nil), "openpitrix.AttachKeyPairsRequest")
-	proto.RegisterType((*AttachKeyPairsResponse)(nil), "openpitrix.AttachKeyPairsResponse")
-	proto.RegisterType((*DetachKeyPairsRequest)(nil), "openpitrix.DetachKeyPairsRequest")
-	proto.RegisterType((*DetachKeyPairsResponse)(nil), "openpitrix.DetachKeyPairsResponse")
-	proto.RegisterType((*NodeKeyPair)(nil), "openpitrix.NodeKeyPair")
-	proto.RegisterType((*AddNodeKeyPairsRequest)(nil), "openpitrix.AddNodeKeyPairsRequest")
-	proto.RegisterType((*AddNodeKeyPairsResponse)(nil), "openpitrix.AddNodeKeyPairsResponse")
-	proto.RegisterType((*DeleteNodeKeyPairsRequest)(nil), "openpitrix.DeleteNodeKeyPairsRequest")
-	proto.RegisterType((*DeleteNodeKeyPairsResponse)(nil), "openpitrix.DeleteNodeKeyPairsResponse")
+type Quota struct {
+	// quota id
+	QuotaId *wrappers.StringValue `protobuf:"bytes,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	// subject type of quota eg.[user|group|owner_path]
+	SubjectType *wrappers.StringValue `protobuf:"bytes,2,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// user id, group path, or prefix of owner path limited by quota
+	Subject *wrappers.StringValue `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// runtime id limited by quota, empty for all runtimes
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,4,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// max count of clusters, 0 for unlimited
+	MaxClusters *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=max_clusters,json=maxClusters,proto3" json:"max_clusters,omitempty"`
+	// max count of nodes, 0 for unlimited
+	MaxNodes *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	// max cpu cores of nodes, 0 for unlimited
+	MaxCpu *wrappers.UInt32Value `protobuf:"bytes,7,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	// max memory(MB) of nodes, 0 for unlimited
+	MaxMemory *wrappers.UInt32Value `protobuf:"bytes,8,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	// max volume size(GB) of nodes, 0 for unlimited
+	MaxVolumeSize *wrappers.UInt32Value `protobuf:"bytes,9,opt,name=max_volume_size,json=maxVolumeSize,proto3" json:"max_volume_size,omitempty"`
+	// quota description
+	Description *wrappers.StringValue `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	// owner
+	Owner *wrappers.StringValue `protobuf:"bytes,11,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner path, concat string group_path:user_id
+	OwnerPath *wrappers.StringValue `protobuf:"bytes,12,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
+	// the time when quota create
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// record status changed time
+	StatusTime           *timestamp.Timestamp `protobuf:"bytes,14,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func init() { proto.RegisterFile("cluster.proto", fileDescriptor_3cfb3b8ec240c376) }
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{74}
+}
 
-var fileDescriptor_3cfb3b8ec240c376 = []byte{
-	// 4965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5c, 0x4d, 0x6c, 0x24, 0xc7,
-	0x75, 0x76, 0xcf, 0x90, 0x43, 0xf2, 0x0d, 0x67, 0xc8, 0x2d, 0xfe, 0xcd, 0x0e, 0xb9, 0xbb, 0xb3,
-	0xbd, 0x96, 0xb4, 0x56, 0xa8, 0xa5, 0xb4, 0x92, 0xac, 0x9f, 0xd5, 0x5a, 0x9a, 0xe5, 0x6e, 0x64,
-	0x46, 0xbb, 0xd2, 0x62, 0xb8, 0x2b, 0x39, 0x8a, 0xe2, 0x76, 0x73, 0xba, 0x38, 0x6c, 0xb3, 0xa7,
-	0xbb, 0xd5, 0x5d, 0xc3, 0x35, 0x85, 0x9c, 0x74, 0xc8, 0x8f, 0xed, 0x04, 0x30, 0x83, 0x04, 0xce,
-	0x0f, 0x02, 0x04, 0x41, 0x0c, 0x27, 0x88, 0x10, 0x5b, 0x40, 0x80, 0x08, 0x39, 0xe4, 0x14, 0x23,
-	0x40, 0x80, 0x24, 0x40, 0x2e, 0x81, 0x4f, 0x39, 0xf8, 0xe4, 0x5c, 0x73, 0xcb, 0x25, 0xa8, 0x9f,
-	0xfe, 0xa9, 0x9e, 0x9e, 0x61, 0x0d, 0x87, 0xbb, 0x2b, 0x23, 0x27, 0x72, 0xba, 0xdf, 0x7b, 0xf5,
-	0xf5, 0xab, 0x57, 0xef, 0xbd, 0xaa, 0x7a, 0x55, 0x50, 0x69, 0x3b, 0xbd, 0x90, 0xe0, 0xe0, 0x8a,
-	0x1f, 0x78, 0xc4, 0x43, 0xe0, 0xf9, 0xd8, 0xf5, 0x6d, 0x12, 0xd8, 0xdf, 0xaa, 0xaf, 0x76, 0x3c,
-	0xaf, 0xe3, 0xe0, 0x0d, 0xf6, 0x66, 0xa7, 0xb7, 0xbb, 0x81, 0xbb, 0x3e, 0x39, 0xe4, 0x84, 0xf5,
-	0xf3, 0xd9, 0x97, 0x0f, 0x02, 0xd3, 0xf7, 0x71, 0x10, 0x8a, 0xf7, 0x17, 0xb2, 0xef, 0x89, 0xdd,
-	0xc5, 0x21, 0x31, 0xbb, 0xbe, 0x20, 0x58, 0x13, 0x04, 0xa6, 0x6f, 0x6f, 0x98, 0xae, 0xeb, 0x11,
-	0x93, 0xd8, 0x9e, 0x1b, 0xb1, 0xaf, 0xb3, 0x3f, 0xed, 0x67, 0x3a, 0xd8, 0x7d, 0x26, 0x7c, 0x60,
-	0x76, 0x3a, 0x38, 0xd8, 0xf0, 0x7c, 0x46, 0xd1, 0x4f, 0xad, 0xff, 0x71, 0x01, 0x96, 0x6f, 0xe2,
-	0xb0, 0x1d, 0xd8, 0x3b, 0x78, 0xbb, 0xb7, 0xe3, 0x62, 0x12, 0xb6, 0xf0, 0x87, 0x3d, 0x1c, 0x12,
-	0x74, 0x0d, 0x20, 0xe8, 0xb9, 0xb4, 0x71, 0xc3, 0xb6, 0x6a, 0x5a, 0x43, 0xbb, 0x5c, 0xbe, 0xba,
-	0x76, 0x85, 0xb7, 0x7d, 0x25, 0x02, 0x77, 0x65, 0x9b, 0x04, 0xb6, 0xdb, 0x79, 0xd7, 0x74, 0x7a,
-	0xb8, 0x35, 0x23, 0xe8, 0xb7, 0x2c, 0xb4, 0x08, 0x93, 0x8e, 0xdd, 0xb5, 0x49, 0xad, 0xd0, 0xd0,
-	0x2e, 0x57, 0x5a, 0xfc, 0x07, 0x5a, 0x86, 0x92, 0xb7, 0xbb, 0x1b, 0x62, 0x52, 0x2b, 0xb2, 0xc7,
-	0xe2, 0x17, 0xba, 0x0e, 0xe5, 0x90, 0x35, 0x6e, 0x90, 0x43, 0x1f, 0xd7, 0x26, 0x06, 0xb4, 0x75,
-	0x7f, 0xcb, 0x25, 0xcf, 0x5f, 0xe5, 0x6d, 0x01, 0x67, 0xb8, 0x77, 0xe8, 0x63, 0xb4, 0x0a, 0x33,
-	0x82, 0xdd, 0xb6, 0x6a, 0x93, 0x8d, 0xe2, 0xe5, 0x99, 0xd6, 0x34, 0x7f, 0xb0, 0x65, 0x21, 0x04,
-	0x13, 0x1f, 0x79, 0x2e, 0xae, 0x95, 0xd8, 0x73, 0xf6, 0x3f, 0x7a, 0x02, 0xaa, 0xa6, 0x75, 0x60,
-	0xba, 0x6d, 0x6c, 0x19, 0xbe, 0x19, 0x98, 0xdd, 0xda, 0x14, 0x7b, 0x5b, 0x89, 0x9e, 0xde, 0xa5,
-	0x0f, 0xf5, 0xcf, 0x8a, 0x50, 0xe2, 0x4a, 0x41, 0xaf, 0xa4, 0x9b, 0x50, 0xd1, 0x45, 0x02, 0xe0,
-	0x59, 0x98, 0x70, 0xcd, 0x2e, 0x66, 0x9a, 0x38, 0x8e, 0x8b, 0x51, 0x52, 0x0e, 0x06, 0xb9, 0xa8,
-	0xc2, 0xc1, 0x3e, 0xe8, 0x1a, 0x94, 0xdb, 0x01, 0x36, 0x09, 0x36, 0xa8, 0xfe, 0x85, 0x02, 0xeb,
-	0x7d, 0x8c, 0xf7, 0x22, 0x4b, 0x6a, 0x01, 0x27, 0xa7, 0x0f, 0xd0, 0x57, 0xa0, 0x6c, 0x31, 0x13,
-	0x60, 0x56, 0x52, 0x9b, 0x54, 0x68, 0x35, 0xcd, 0x80, 0x2e, 0x40, 0xd9, 0x76, 0x43, 0x42, 0x15,
-	0x47, 0xb5, 0xc3, 0x15, 0x0d, 0xd1, 0xa3, 0x2d, 0x0b, 0x3d, 0x0f, 0xa5, 0x03, 0xbf, 0x4d, 0xdf,
-	0x4d, 0x29, 0xc8, 0x9e, 0x3c, 0xf0, 0xdb, 0x5b, 0x56, 0xd6, 0x26, 0xa6, 0x47, 0xb3, 0x09, 0xbd,
-	0x0b, 0x2b, 0x7d, 0x76, 0x1d, 0xfa, 0x9e, 0x1b, 0x62, 0x8a, 0x97, 0x78, 0xc4, 0x74, 0x8c, 0xb6,
-	0xd7, 0x73, 0x09, 0xeb, 0xcd, 0x4a, 0x0b, 0xd8, 0xa3, 0x4d, 0xfa, 0x04, 0x3d, 0x07, 0x42, 0x92,
-	0x41, 0x4d, 0xb5, 0xd0, 0x28, 0x5e, 0x2e, 0x5f, 0x45, 0x57, 0x92, 0xf1, 0x7d, 0x85, 0x4b, 0x6c,
-	0x09, 0x93, 0xd8, 0xc6, 0x44, 0xff, 0x0a, 0x9c, 0xbb, 0x89, 0x1d, 0x4c, 0xf0, 0x26, 0x77, 0x0a,
-	0x5b, 0x6e, 0x8b, 0x8f, 0x85, 0x68, 0x34, 0x9d, 0xcb, 0x8c, 0x26, 0xaa, 0xa3, 0x64, 0xbc, 0xe8,
-	0xaf, 0xc3, 0xf9, 0x41, 0xfc, 0x02, 0xf5, 0x31, 0x02, 0x1c, 0x38, 0x7f, 0xc7, 0xee, 0x04, 0xe6,
-	0x60, 0x04, 0x4f, 0xc2, 0xdc, 0x6e, 0xe0, 0x75, 0x8d, 0xcc, 0xa0, 0x9e, 0x69, 0x55, 0xe8, 0xe3,
-	0x56, 0x3c, 0x74, 0x75, 0xa8, 0x10, 0x2f, 0x4d, 0x55, 0x60, 0x54, 0x65, 0xe2, 0xc5, 0x34, 0x7a,
-	0x17, 0x2e, 0x0c, 0x6c, 0x4d, 0xe0, 0x3d, 0xcd, 0xe6, 0xfe, 0xbd, 0x00, 0x8b, 0x9b, 0xcc, 0x60,
-	0x45, 0x73, 0xd1, 0x37, 0x3d, 0x0f, 0x25, 0xd3, 0xf7, 0x55, 0xc7, 0xe4, 0xa4, 0xe9, 0xfb, 0x5b,
-	0x16, 0x75, 0x6c, 0x07, 0x38, 0x08, 0x6d, 0xcf, 0x8d, 0x9a, 0x3b, 0xd6, 0xb1, 0x09, 0x7a, 0xce,
-	0x9c, 0xc2, 0x5a, 0x1c, 0xcd, 0x2b, 0x3e, 0x0b, 0x13, 0x6d, 0xcf, 0xdd, 0x1d, 0xe8, 0xe0, 0xa4,
-	0x81, 0x4d, 0x29, 0x73, 0x3c, 0xd5, 0x64, 0x8e, 0xa7, 0x8a, 0x3d, 0x46, 0x49, 0xd5, 0x63, 0xe8,
-	0xbf, 0xa3, 0xc1, 0x52, 0x46, 0xa5, 0xa2, 0xe3, 0xae, 0x01, 0x88, 0xc8, 0xa6, 0xec, 0xf7, 0x05,
-	0x3d, 0x1f, 0xea, 0xdf, 0xf4, 0x76, 0x54, 0xf5, 0x3a, 0xf9, 0x4d, 0x6f, 0x67, 0xcb, 0xd2, 0x3f,
-	0x2d, 0xc2, 0xe2, 0x1d, 0xcf, 0xb2, 0x77, 0x0f, 0x33, 0xdd, 0xfb, 0x0c, 0x4c, 0x09, 0xd1, 0x02,
-	0xc7, 0x42, 0x7a, 0x14, 0x46, 0xc4, 0x11, 0x0d, 0x6a, 0xc2, 0x7c, 0x84, 0xdc, 0xf5, 0x2c, 0x9c,
-	0x1a, 0xbd, 0x2b, 0x39, 0x7c, 0x6f, 0x7b, 0x16, 0x6e, 0x55, 0xdb, 0xc9, 0x8f, 0x6d, 0x4c, 0xd2,
-	0x22, 0x02, 0xcf, 0xe1, 0x22, 0x8a, 0x03, 0x45, 0xb4, 0x3c, 0x27, 0x11, 0x41, 0x7f, 0x64, 0x44,
-	0x38, 0xb6, 0xbb, 0xcf, 0x44, 0x4c, 0x0c, 0x14, 0x71, 0xdb, 0x76, 0xf7, 0x63, 0x11, 0xf4, 0x07,
-	0x15, 0xf1, 0x26, 0xa0, 0x48, 0x44, 0xdb, 0xeb, 0x76, 0x3d, 0x97, 0x09, 0x99, 0x64, 0x42, 0xce,
-	0xe6, 0x08, 0xd9, 0x64, 0x44, 0xad, 0xa8, 0x5d, 0xfe, 0x93, 0x0a, 0xfa, 0x55, 0xa8, 0xc5, 0x58,
-	0x3c, 0xd3, 0xda, 0x31, 0x1d, 0x6a, 0x34, 0x01, 0x13, 0x57, 0x62, 0xe2, 0x2e, 0xe4, 0x61, 0x4a,
-	0x91, 0xb6, 0x96, 0xdb, 0xfd, 0x0f, 0xa9, 0xc7, 0xbb, 0x07, 0x4b, 0x99, 0x3e, 0x3b, 0x05, 0xfb,
-	0xd1, 0xdf, 0x85, 0x9a, 0x24, 0x95, 0x75, 0x92, 0xb0, 0x86, 0x57, 0x61, 0x36, 0xdd, 0xbd, 0x42,
-	0xf4, 0xc0, 0xae, 0x2d, 0xa7, 0xba, 0x56, 0x6f, 0xc1, 0xd9, 0x1c, 0xb9, 0x02, 0xf1, 0x8b, 0x30,
-	0xc5, 0xec, 0x45, 0x11, 0x6e, 0x89, 0x12, 0x6f, 0x59, 0xfa, 0xbf, 0x69, 0x70, 0x5e, 0x12, 0xda,
-	0x24, 0x24, 0xb0, 0x77, 0x7a, 0x04, 0xa7, 0x73, 0xa8, 0x93, 0x8f, 0xa5, 0xd1, 0x13, 0x87, 0x4c,
-	0x24, 0x2f, 0x8e, 0x18, 0xc9, 0xf5, 0xaf, 0xc3, 0x85, 0x81, 0x1f, 0x74, 0x1a, 0xbd, 0xfb, 0xbb,
-	0x1a, 0xe8, 0x7d, 0xdd, 0xd0, 0xaf, 0xb5, 0x93, 0xf5, 0xc7, 0xe8, 0xfa, 0xd2, 0x3f, 0x80, 0x4b,
-	0x43, 0xe1, 0x8c, 0x67, 0x1f, 0xdf, 0x80, 0xd5, 0xa6, 0x65, 0xdd, 0x33, 0x77, 0x1c, 0x9c, 0x92,
-	0x1f, 0x7f, 0x65, 0x9e, 0xb7, 0xd2, 0x46, 0xf2, 0x56, 0xfa, 0x2b, 0x51, 0xd6, 0x30, 0xb0, 0x91,
-	0x95, 0x34, 0x74, 0x1a, 0x38, 0x22, 0x70, 0x9f, 0x6a, 0xb0, 0x24, 0x65, 0x1c, 0x61, 0x2a, 0x53,
-	0x91, 0x7a, 0x98, 0x25, 0x1a, 0x89, 0x55, 0xf6, 0x47, 0xa4, 0x42, 0x7e, 0x44, 0x9a, 0xdc, 0xf5,
-	0x82, 0x76, 0x94, 0xc4, 0xf6, 0xe7, 0xa2, 0x37, 0x3c, 0xcf, 0x11, 0x51, 0x80, 0x11, 0xa2, 0x8b,
-	0x30, 0xdb, 0x09, 0xcc, 0x36, 0x36, 0x7c, 0x1c, 0xd8, 0x9e, 0xc5, 0x82, 0x64, 0xa5, 0x55, 0x66,
-	0xcf, 0xee, 0xb2, 0x47, 0xfa, 0xdb, 0x74, 0xb2, 0x22, 0x63, 0x4e, 0xb2, 0xa3, 0x61, 0xa0, 0x97,
-	0x52, 0x61, 0x89, 0xbe, 0x12, 0x81, 0xe7, 0xef, 0x35, 0x58, 0xba, 0xef, 0x77, 0x02, 0xd3, 0xca,
-	0x26, 0x16, 0x63, 0x0d, 0xdc, 0xb1, 0x12, 0x8c, 0x7e, 0xfd, 0x16, 0xf3, 0xe6, 0x26, 0xdf, 0xd6,
-	0x60, 0x39, 0x0b, 0xfd, 0xb1, 0x05, 0xf0, 0xdf, 0x80, 0xe5, 0x96, 0xe7, 0x38, 0x3b, 0x66, 0x7b,
-	0xff, 0x34, 0xf5, 0xa8, 0x66, 0x6a, 0xfa, 0x77, 0x34, 0x58, 0xe9, 0x6b, 0xfe, 0xb1, 0xe9, 0xe2,
-	0xa7, 0x05, 0x98, 0x65, 0x79, 0x01, 0x0e, 0xbd, 0x1e, 0xb5, 0xeb, 0x67, 0x61, 0x82, 0xa6, 0x12,
-	0x4a, 0x8d, 0x33, 0x4a, 0x74, 0x05, 0x8a, 0x6d, 0xbf, 0x37, 0xb0, 0xd1, 0xf4, 0x94, 0x87, 0x12,
-	0x52, 0xfa, 0x8e, 0xdf, 0x1b, 0xe8, 0xee, 0x25, 0xfa, 0x8e, 0xdf, 0x43, 0x2f, 0x40, 0xa9, 0x8b,
-	0xbb, 0x5e, 0x70, 0xa8, 0x34, 0xd3, 0x16, 0xb4, 0xa8, 0x09, 0x95, 0x78, 0x9a, 0x17, 0xda, 0x1f,
-	0xe1, 0x81, 0x13, 0xc5, 0x34, 0xf3, 0x6c, 0xc4, 0xb2, 0x6d, 0x7f, 0x84, 0xd1, 0xeb, 0x30, 0x1b,
-	0x12, 0x2f, 0x30, 0x3b, 0x42, 0x42, 0x49, 0x41, 0x42, 0x59, 0x70, 0x50, 0x01, 0xfa, 0x67, 0x1a,
-	0x2c, 0xb6, 0x30, 0xe5, 0x3d, 0x4d, 0x3b, 0xbb, 0x0e, 0x15, 0x96, 0xec, 0x05, 0xa2, 0xcb, 0x44,
-	0xd2, 0x58, 0x4b, 0xbb, 0xe1, 0x74, 0x97, 0xb6, 0x66, 0x83, 0x74, 0x07, 0xab, 0xe5, 0xe8, 0x2c,
-	0xe3, 0xce, 0x60, 0x7f, 0x6c, 0x46, 0xfa, 0xdf, 0x1a, 0x2c, 0x37, 0x2d, 0x2b, 0x2f, 0x62, 0x8c,
-	0x9b, 0xb2, 0x30, 0x5b, 0x2f, 0x28, 0xdb, 0xfa, 0x35, 0x00, 0x16, 0xa0, 0xf8, 0x5c, 0x5c, 0xc5,
-	0x84, 0x67, 0x28, 0x3d, 0x9f, 0xa8, 0xf7, 0x6b, 0x7e, 0x62, 0x90, 0x83, 0xe8, 0xfb, 0xda, 0xc7,
-	0xa6, 0xfb, 0xef, 0x6b, 0x70, 0x56, 0x8a, 0x62, 0xa7, 0xa7, 0xfe, 0x54, 0xb4, 0x2f, 0xa4, 0xa3,
-	0xbd, 0x6a, 0x50, 0xf9, 0x3d, 0x0d, 0xea, 0x79, 0xd0, 0x1e, 0x9b, 0xae, 0x3e, 0xd1, 0x60, 0xe5,
-	0xbe, 0x6f, 0x25, 0xb3, 0xd4, 0x5b, 0xee, 0xc1, 0xa9, 0x68, 0xea, 0x0a, 0x14, 0xb1, 0x7b, 0xa0,
-	0x04, 0x85, 0x12, 0xaa, 0x2a, 0xf0, 0xbb, 0x1a, 0xd4, 0xfa, 0xf1, 0x3e, 0x36, 0xf5, 0xfd, 0xb8,
-	0x0a, 0x15, 0x69, 0x8a, 0xf8, 0xa8, 0x47, 0xf7, 0x3b, 0xb0, 0x14, 0xe2, 0xe0, 0x80, 0xb5, 0x66,
-	0xf4, 0x7c, 0x1f, 0x07, 0xc6, 0x8e, 0xd7, 0x73, 0x2d, 0xa5, 0x81, 0x8e, 0x38, 0xeb, 0x96, 0x75,
-	0x9f, 0x32, 0xde, 0xa0, 0x7c, 0xe8, 0x4d, 0x98, 0x8f, 0xfb, 0xc1, 0x6c, 0xb3, 0x95, 0x6c, 0xa5,
-	0xd5, 0x94, 0xb9, 0x88, 0xab, 0xc9, 0x99, 0x68, 0x28, 0xb2, 0x5d, 0x9b, 0x18, 0xb4, 0x0d, 0xbb,
-	0x8d, 0xd5, 0x56, 0x3d, 0x29, 0xc7, 0x36, 0x67, 0xa0, 0xe1, 0x30, 0x24, 0x66, 0x90, 0x48, 0x50,
-	0x59, 0x7b, 0x99, 0x65, 0x2c, 0x91, 0x08, 0x1e, 0x0e, 0xfd, 0x58, 0x82, 0xca, 0xea, 0x28, 0x0d,
-	0x87, 0x7e, 0x24, 0xe0, 0xab, 0x70, 0x26, 0x6c, 0x9b, 0x0e, 0x36, 0xbc, 0x5e, 0x82, 0x63, 0x5a,
-	0x45, 0x1d, 0x8c, 0xed, 0x9d, 0x5e, 0x0c, 0xe5, 0x97, 0x61, 0x9e, 0x4b, 0xb2, 0xdd, 0x58, 0xd0,
-	0x8c, 0x82, 0xa0, 0x2a, 0xe3, 0xda, 0x72, 0x23, 0x39, 0xb7, 0x60, 0x2e, 0xc0, 0xb2, 0x5e, 0x40,
-	0x45, 0x8c, 0x60, 0x4a, 0x89, 0xb1, 0x70, 0x48, 0x02, 0xef, 0x30, 0x16, 0x53, 0x56, 0x11, 0x23,
-	0x98, 0x52, 0x62, 0x7a, 0x3c, 0x47, 0x8e, 0xc5, 0xcc, 0xaa, 0x88, 0x11, 0x4c, 0x91, 0x98, 0x4d,
-	0xa8, 0xb6, 0x7b, 0x21, 0xf1, 0xba, 0xb1, 0x94, 0x8a, 0x82, 0x94, 0x0a, 0xe7, 0x49, 0x09, 0xa1,
-	0x09, 0x6a, 0x2f, 0xe9, 0xee, 0xaa, 0x8a, 0x10, 0xce, 0x93, 0x51, 0xaf, 0x17, 0x24, 0x1f, 0x34,
-	0xa7, 0xaa, 0x5e, 0x2f, 0x88, 0x3f, 0xe8, 0x1e, 0xac, 0x58, 0xcc, 0xcd, 0x1b, 0xa1, 0x6b, 0xfa,
-	0xe1, 0x9e, 0x97, 0xf4, 0xd6, 0xbc, 0x82, 0xb8, 0x25, 0xce, 0xbc, 0x2d, 0x78, 0x53, 0xe6, 0xbc,
-	0x87, 0x4d, 0x87, 0xec, 0x19, 0xed, 0x3d, 0xdc, 0xde, 0xaf, 0x9d, 0x51, 0x31, 0x67, 0xce, 0xb1,
-	0x49, 0x19, 0xd0, 0x97, 0x61, 0xaa, 0xeb, 0xb9, 0x36, 0xf1, 0x82, 0x1a, 0x52, 0xe0, 0x8d, 0x88,
-	0xd1, 0x4d, 0xa8, 0xfa, 0x66, 0x18, 0xfa, 0x7b, 0x81, 0x19, 0x62, 0x07, 0x87, 0x61, 0x6d, 0x41,
-	0x45, 0x29, 0x32, 0x0f, 0x55, 0xca, 0x01, 0x0e, 0x88, 0xdd, 0x36, 0x1d, 0x83, 0x5a, 0xb5, 0xed,
-	0x76, 0x0c, 0xdf, 0x73, 0xec, 0xf6, 0x61, 0x6d, 0x51, 0x45, 0x29, 0x11, 0xf3, 0x36, 0xe7, 0xbd,
-	0xcb, 0x58, 0xd1, 0x26, 0xcc, 0x99, 0x1d, 0xec, 0x12, 0x83, 0x25, 0xc2, 0x8e, 0x83, 0xad, 0xda,
-	0xd2, 0xb1, 0x33, 0xe2, 0x2a, 0x63, 0xd9, 0x8a, 0x38, 0x50, 0x0b, 0x96, 0x85, 0x01, 0x76, 0x31,
-	0x31, 0x2d, 0x93, 0x98, 0x06, 0x5f, 0xb4, 0xa9, 0x2d, 0x2b, 0x20, 0x5b, 0xe4, 0xbc, 0x77, 0x04,
-	0xeb, 0x36, 0xe3, 0x44, 0x2f, 0xc1, 0xb4, 0xdd, 0xa5, 0x99, 0xb8, 0x6d, 0xd5, 0x56, 0x54, 0xb4,
-	0xcd, 0xa8, 0xb7, 0x2c, 0xea, 0xf8, 0x84, 0x21, 0x0b, 0xed, 0xd4, 0x54, 0x1c, 0x1f, 0x67, 0x11,
-	0x4a, 0xf9, 0x00, 0xd6, 0x6c, 0xb7, 0x1d, 0xe0, 0x2e, 0x76, 0x89, 0xe9, 0x18, 0xd1, 0xb8, 0xe8,
-	0xf9, 0xbe, 0x17, 0x10, 0x6c, 0xd5, 0xce, 0x1e, 0xab, 0xa1, 0x7a, 0x8a, 0xff, 0x06, 0x1f, 0x22,
-	0x11, 0x37, 0x7a, 0x0d, 0x60, 0xef, 0xd0, 0xa7, 0x46, 0x19, 0x7a, 0x41, 0xad, 0xae, 0x80, 0x2e,
-	0x45, 0xaf, 0xff, 0xb0, 0x02, 0xe5, 0x54, 0xf6, 0x73, 0xd2, 0xc5, 0x28, 0x39, 0xd0, 0x16, 0x4e,
-	0xb6, 0xf2, 0x57, 0x54, 0x5e, 0xf9, 0xbb, 0x2e, 0xef, 0xc1, 0xa9, 0x84, 0xc4, 0xf4, 0x0e, 0xdd,
-	0x2b, 0x30, 0x73, 0xe0, 0x39, 0x3d, 0xbe, 0xa9, 0xa1, 0x12, 0x0a, 0xa7, 0x39, 0xf9, 0x96, 0x45,
-	0x27, 0x93, 0x16, 0x56, 0x0e, 0x80, 0x82, 0x56, 0xde, 0x4f, 0x9d, 0x1a, 0x69, 0x3f, 0xf5, 0x1a,
-	0x80, 0x1f, 0xd8, 0x07, 0x26, 0xc1, 0x86, 0xed, 0x2b, 0x45, 0xbb, 0x19, 0x41, 0xbf, 0xe5, 0xb3,
-	0xbc, 0xcf, 0xf6, 0x95, 0x42, 0x1b, 0x25, 0x64, 0x38, 0xa3, 0x04, 0x66, 0x60, 0x24, 0x4b, 0x27,
-	0x2d, 0xd3, 0x51, 0xd2, 0x12, 0x67, 0x4b, 0x65, 0xe5, 0x6c, 0xe9, 0x05, 0x28, 0x85, 0xc4, 0x24,
-	0xbd, 0x50, 0x29, 0x4a, 0x09, 0x5a, 0xb4, 0x05, 0x67, 0x48, 0x60, 0xba, 0xa1, 0x4d, 0x13, 0x1b,
-	0x43, 0x08, 0x50, 0x09, 0x50, 0xf3, 0x09, 0xdb, 0x36, 0x17, 0xf5, 0x12, 0x4c, 0x77, 0x02, 0xaf,
-	0xc7, 0x36, 0xd4, 0xaa, 0x0a, 0x1f, 0x3b, 0xc5, 0xa8, 0x79, 0x9f, 0x78, 0x0f, 0x5c, 0x1c, 0x18,
-	0xbe, 0x49, 0xf6, 0x94, 0x42, 0xd2, 0x0c, 0xa3, 0xbf, 0x6b, 0x92, 0x3d, 0x9a, 0x7b, 0x74, 0x1c,
-	0x6f, 0x87, 0xba, 0xdd, 0x58, 0xd5, 0xf3, 0x0a, 0xad, 0x57, 0x39, 0xd7, 0x76, 0xa4, 0xf0, 0x5b,
-	0x30, 0x97, 0xf1, 0x92, 0x4a, 0x21, 0xa8, 0x2a, 0xbb, 0x47, 0x3a, 0xe0, 0xfd, 0xde, 0x8e, 0xb1,
-	0x8f, 0x0f, 0x95, 0xa2, 0x50, 0xc9, 0xef, 0xed, 0xbc, 0x85, 0xd9, 0xf2, 0x88, 0x88, 0x7e, 0xa2,
-	0x0b, 0x54, 0x62, 0x90, 0x08, 0x98, 0xb1, 0xfa, 0x67, 0xec, 0x50, 0x78, 0x43, 0x11, 0x73, 0x86,
-	0xf9, 0xc0, 0x69, 0x3b, 0xe4, 0xae, 0x0f, 0x5d, 0x83, 0xb2, 0xd9, 0x23, 0x5e, 0xc4, 0x7a, 0x7c,
-	0x80, 0x01, 0x4a, 0x9e, 0x30, 0xa7, 0x6b, 0x07, 0x96, 0x47, 0xaa, 0x1d, 0xb8, 0x06, 0x65, 0xfe,
-	0xb9, 0x9c, 0x79, 0xe5, 0x78, 0x66, 0x4e, 0xce, 0x98, 0x5f, 0x84, 0xa9, 0x3d, 0x2f, 0x64, 0x2e,
-	0x40, 0x25, 0x86, 0x94, 0x28, 0xf1, 0x96, 0x95, 0xb0, 0xf9, 0x22, 0x50, 0xa8, 0xb0, 0xf9, 0xe9,
-	0xed, 0x23, 0x36, 0x2e, 0xeb, 0x03, 0xb7, 0x8f, 0xd8, 0x5a, 0x4f, 0x39, 0xb5, 0xad, 0x87, 0xde,
-	0x80, 0xaa, 0xbc, 0x21, 0x57, 0x5b, 0x65, 0xdc, 0x43, 0x36, 0xe3, 0x2a, 0xd2, 0x66, 0x1c, 0x3a,
-	0x0f, 0xe5, 0x7d, 0x7c, 0x68, 0xf8, 0xa6, 0xcd, 0xec, 0x7b, 0x8d, 0xaf, 0x50, 0xef, 0xe3, 0xc3,
-	0xbb, 0xa6, 0x4d, 0x8d, 0xf7, 0x2a, 0x4c, 0xb2, 0x11, 0x51, 0x3b, 0xa7, 0x32, 0xbd, 0x63, 0xa4,
-	0xfa, 0x3f, 0x95, 0xe2, 0x50, 0xd5, 0x12, 0x6b, 0x29, 0x8f, 0x72, 0x72, 0x27, 0x96, 0x29, 0x8b,
-	0x23, 0x2e, 0x53, 0x4e, 0x8c, 0xbe, 0x4c, 0x39, 0x39, 0xce, 0x32, 0x65, 0x69, 0xec, 0x65, 0xca,
-	0xa9, 0x11, 0x97, 0x29, 0x69, 0x34, 0xee, 0x7a, 0x3d, 0x97, 0x18, 0xbe, 0x67, 0xbb, 0x44, 0x29,
-	0x46, 0x01, 0x63, 0xb8, 0x4b, 0xe9, 0xe9, 0x27, 0x70, 0x76, 0x51, 0xb7, 0xa5, 0x14, 0xae, 0x66,
-	0x19, 0xcb, 0x3b, 0x9c, 0x83, 0x22, 0xd8, 0xb5, 0x1d, 0x6c, 0x84, 0x87, 0x21, 0xc1, 0x5d, 0xa5,
-	0x39, 0x18, 0x50, 0x86, 0x6d, 0x46, 0x1f, 0x2d, 0x8f, 0x94, 0x55, 0x97, 0x47, 0x5e, 0x86, 0xe9,
-	0x00, 0xfb, 0x8e, 0xdd, 0x36, 0x07, 0xc7, 0x2e, 0x29, 0x4a, 0x46, 0xd4, 0x74, 0x5a, 0x14, 0x60,
-	0xd3, 0x3a, 0x34, 0x62, 0xfe, 0x8a, 0x02, 0x7f, 0x85, 0xf1, 0xb4, 0x22, 0x21, 0xd7, 0xa1, 0x6c,
-	0xfa, 0xb6, 0x21, 0x36, 0x51, 0x94, 0x26, 0x56, 0x60, 0xfa, 0xf6, 0xbb, 0x9c, 0x5e, 0xff, 0xdf,
-	0x02, 0x2c, 0xe4, 0x6c, 0x7d, 0x3f, 0xea, 0xf1, 0xf4, 0x2e, 0xd4, 0xa4, 0x4d, 0x7a, 0xc7, 0x0e,
-	0x09, 0x76, 0x79, 0xe3, 0x2a, 0x99, 0xe0, 0x72, 0x9a, 0xfb, 0xb6, 0x60, 0xde, 0xb2, 0x68, 0x82,
-	0x20, 0xc9, 0xa5, 0x69, 0xb2, 0xd2, 0x28, 0x9c, 0x4f, 0xb3, 0xdd, 0xf5, 0x02, 0x42, 0x27, 0x22,
-	0x19, 0x51, 0x34, 0x9f, 0x57, 0x4d, 0x1a, 0x17, 0x65, 0x79, 0x94, 0x75, 0xcb, 0xd2, 0xff, 0xa1,
-	0x10, 0x7b, 0xb1, 0xdb, 0xb6, 0xbb, 0xff, 0xa8, 0xf7, 0xcc, 0x6f, 0xc3, 0x02, 0xfe, 0x16, 0xc1,
-	0x81, 0x6b, 0x3a, 0x46, 0xaa, 0x5d, 0x15, 0x85, 0x9f, 0x89, 0x18, 0x37, 0xd3, 0x5b, 0x7f, 0xa9,
-	0x44, 0x68, 0x62, 0xb4, 0x44, 0x28, 0x8e, 0x01, 0x93, 0xea, 0x31, 0xe0, 0xa7, 0x55, 0x98, 0x12,
-	0xcd, 0xff, 0x82, 0x55, 0x1b, 0xa4, 0x8a, 0xb7, 0x26, 0x4e, 0x5a, 0xbc, 0x35, 0x39, 0xda, 0xde,
-	0xaa, 0x34, 0xeb, 0x28, 0x8d, 0x34, 0xeb, 0x38, 0x51, 0x0d, 0xe3, 0xeb, 0x30, 0xbb, 0x1b, 0x78,
-	0x2e, 0xe9, 0xb0, 0xc9, 0x8a, 0xa5, 0x14, 0x08, 0xca, 0x31, 0x07, 0x17, 0x10, 0xf5, 0x28, 0xab,
-	0x82, 0x9c, 0x51, 0x89, 0x44, 0x82, 0x83, 0x95, 0xc6, 0xbe, 0x0a, 0x33, 0xd8, 0xb5, 0x58, 0x18,
-	0x0a, 0x95, 0xa2, 0x40, 0x42, 0x9e, 0x9a, 0x8e, 0x94, 0xc7, 0x9d, 0x8e, 0xcc, 0x9e, 0x68, 0x3a,
-	0x72, 0x1b, 0x16, 0xe3, 0xf5, 0x8e, 0xc0, 0xf3, 0x88, 0x61, 0xb6, 0xdb, 0x38, 0x8c, 0x22, 0xc4,
-	0xb0, 0xfc, 0x16, 0x45, 0x7c, 0x2d, 0xcf, 0x23, 0x4d, 0xc6, 0x95, 0x19, 0x9a, 0xd5, 0xd1, 0x86,
-	0xe6, 0x75, 0x28, 0x8b, 0x39, 0x4a, 0xaf, 0x67, 0x5b, 0x4a, 0x33, 0x1c, 0xe0, 0x0c, 0xf7, 0x7b,
-	0xb6, 0x45, 0xa3, 0x5c, 0xbc, 0x10, 0xc9, 0x35, 0xa2, 0xb2, 0xce, 0x56, 0x89, 0xd6, 0x21, 0xb9,
-	0x3a, 0xae, 0xc3, 0x6c, 0x24, 0x84, 0x25, 0xdb, 0x67, 0x8e, 0x4d, 0xb6, 0xcb, 0x82, 0x5e, 0xa4,
-	0xea, 0xe9, 0xca, 0x45, 0x34, 0x5a, 0xe5, 0x62, 0x66, 0x92, 0xb0, 0x30, 0xce, 0x24, 0x61, 0x71,
-	0xa4, 0x49, 0xc2, 0x2d, 0x98, 0x33, 0x2d, 0x8b, 0x99, 0x85, 0xe9, 0x18, 0xb6, 0xbb, 0xeb, 0x89,
-	0xf9, 0xcd, 0x31, 0xb3, 0xba, 0x84, 0x69, 0xcb, 0xdd, 0xf5, 0xa2, 0x8c, 0x66, 0x59, 0x35, 0xa3,
-	0x79, 0x16, 0x26, 0x2d, 0xbc, 0xd3, 0xeb, 0x0c, 0x9c, 0xd2, 0xa4, 0xea, 0x57, 0x18, 0x61, 0x5c,
-	0x83, 0x59, 0x53, 0xae, 0xda, 0xce, 0xab, 0x00, 0x3a, 0x3b, 0x7e, 0xbd, 0x62, 0x7d, 0xfc, 0x7a,
-	0xc5, 0xd5, 0xd3, 0xa8, 0x57, 0x5c, 0x3b, 0xdd, 0x7a, 0xc5, 0x73, 0x63, 0xd5, 0x2b, 0x26, 0xc1,
-	0xf5, 0xbc, 0x7a, 0x70, 0xfd, 0xe7, 0x52, 0x52, 0x45, 0x3e, 0x62, 0x99, 0xd4, 0x52, 0x1c, 0xdc,
-	0x44, 0xc5, 0x11, 0x0f, 0x5f, 0xe7, 0xa4, 0xf0, 0xc5, 0xf7, 0x10, 0x53, 0x01, 0x6a, 0x39, 0x76,
-	0xb9, 0x7c, 0x23, 0x3b, 0x72, 0xaa, 0x72, 0xf1, 0xf7, 0x64, 0xa6, 0xf8, 0x1b, 0x5d, 0xcc, 0xc4,
-	0x19, 0x5e, 0x82, 0x2f, 0x45, 0x92, 0x01, 0x69, 0xce, 0xd4, 0xc9, 0xd2, 0x9c, 0xf8, 0x78, 0xc7,
-	0x74, 0xfe, 0xf1, 0x8e, 0x99, 0xbe, 0xe3, 0x1d, 0xd8, 0x0c, 0xda, 0x7b, 0xc6, 0x03, 0x2f, 0xb0,
-	0xd4, 0x26, 0x23, 0x9c, 0xe1, 0x3d, 0x2f, 0xb0, 0xd0, 0x4b, 0x30, 0x1d, 0x7a, 0x01, 0x61, 0x2b,
-	0x32, 0x2a, 0x91, 0x68, 0x8a, 0x52, 0xbf, 0x85, 0x0f, 0xd1, 0x0b, 0x30, 0x15, 0x60, 0xaa, 0xdc,
-	0x68, 0xdb, 0x67, 0xd8, 0x28, 0x8e, 0x48, 0xe9, 0xb7, 0x71, 0x43, 0xa9, 0xf0, 0x8e, 0x63, 0x3f,
-	0xfa, 0x22, 0xb1, 0x4a, 0xfc, 0x90, 0x22, 0xf1, 0x35, 0x28, 0x3f, 0xb0, 0xc9, 0x9e, 0x61, 0x61,
-	0x62, 0xda, 0x8e, 0x88, 0x20, 0x43, 0xd7, 0x68, 0x28, 0xf9, 0x4d, 0x46, 0xcd, 0x5a, 0x67, 0xfe,
-	0xd4, 0x32, 0x2c, 0x93, 0x60, 0xa5, 0xe5, 0x31, 0xe1, 0xb0, 0xad, 0x9b, 0x26, 0xc1, 0xe8, 0x29,
-	0x98, 0xb3, 0xec, 0xd0, 0x77, 0xcc, 0x43, 0xa3, 0xed, 0x39, 0xbd, 0xae, 0x1b, 0xd6, 0xce, 0xb0,
-	0xcf, 0xab, 0x8a, 0xc7, 0x9b, 0xfc, 0x69, 0x7c, 0x5c, 0x06, 0xa5, 0x8e, 0xcb, 0xdc, 0x80, 0xb9,
-	0xae, 0xed, 0x1a, 0xa3, 0x05, 0x80, 0x4a, 0xd7, 0x76, 0x37, 0xe3, 0x18, 0xa0, 0x7f, 0x08, 0xb5,
-	0xfe, 0x91, 0xa4, 0x7a, 0x20, 0xe3, 0x05, 0x88, 0x54, 0x99, 0xaa, 0xe9, 0xce, 0xad, 0x05, 0x8f,
-	0xc6, 0xe4, 0x36, 0x26, 0xfa, 0xcf, 0x8a, 0x50, 0x8f, 0xda, 0x6c, 0xfa, 0x7e, 0x76, 0x00, 0x2f,
-	0xa5, 0xce, 0x0e, 0xa4, 0x46, 0x68, 0x32, 0x04, 0x0b, 0xd2, 0x10, 0x8c, 0x4d, 0xbe, 0x98, 0x6f,
-	0xf2, 0x13, 0xc3, 0x4c, 0x7e, 0x72, 0x0c, 0x93, 0x2f, 0x9d, 0xd0, 0xe4, 0xa7, 0x4e, 0x60, 0xf2,
-	0xd3, 0x69, 0x93, 0xcf, 0x58, 0xec, 0xcc, 0x58, 0x16, 0x0b, 0xa7, 0x60, 0xb1, 0xe5, 0x3c, 0x8b,
-	0xd5, 0x09, 0xac, 0xe6, 0xf6, 0xf2, 0xc3, 0x35, 0xae, 0x1f, 0x14, 0x93, 0x66, 0x1f, 0x5d, 0x1d,
-	0x4f, 0x62, 0x9c, 0xc5, 0x7c, 0xe3, 0x9c, 0xc8, 0x37, 0xce, 0xc9, 0x61, 0xc6, 0x59, 0x1a, 0xc3,
-	0x38, 0xa7, 0x4e, 0x68, 0x9c, 0xd3, 0x27, 0x30, 0xce, 0x99, 0xb4, 0x71, 0xe6, 0x98, 0x07, 0xe4,
-	0x9a, 0xc7, 0xc7, 0x1a, 0xac, 0xe5, 0x77, 0x94, 0xaa, 0x81, 0x8c, 0x7f, 0xac, 0x44, 0xff, 0x35,
-	0x58, 0xd8, 0x26, 0x9e, 0xff, 0x50, 0x4a, 0xad, 0xf5, 0xdb, 0xb0, 0x28, 0x0b, 0x1f, 0xab, 0x26,
-	0xfa, 0x03, 0x2a, 0xcd, 0x0c, 0xc8, 0xc3, 0xc1, 0x7a, 0x07, 0x96, 0x32, 0xd2, 0xc7, 0x02, 0xfb,
-	0x75, 0x58, 0x6e, 0xe1, 0xb6, 0x77, 0x80, 0x83, 0x87, 0x03, 0xf7, 0x1d, 0x58, 0xe9, 0x93, 0x3f,
-	0x16, 0xe0, 0x1f, 0x6b, 0xb0, 0xb8, 0x89, 0xcd, 0xf0, 0x17, 0xa9, 0xea, 0xfe, 0x0e, 0x2c, 0x65,
-	0x20, 0x8f, 0xa5, 0x82, 0x73, 0xb0, 0xfa, 0x26, 0x8e, 0x0c, 0x80, 0x4e, 0x6d, 0xed, 0x90, 0xd8,
-	0xed, 0x48, 0x11, 0xfa, 0xcf, 0x27, 0x60, 0x2d, 0xff, 0xbd, 0x68, 0x35, 0x84, 0x25, 0xc7, 0x0c,
-	0x89, 0x41, 0x1e, 0x78, 0xc6, 0x03, 0x8c, 0xf7, 0x45, 0x5e, 0x62, 0x89, 0xc3, 0x13, 0x6f, 0xa4,
-	0xc7, 0xe4, 0x30, 0x41, 0x57, 0x6e, 0x9b, 0x21, 0xb9, 0xf7, 0xc0, 0x7b, 0x0f, 0xe3, 0x7d, 0x9e,
-	0xa8, 0x58, 0xb7, 0x5c, 0x12, 0x1c, 0xb6, 0x90, 0xd3, 0xf7, 0x02, 0xed, 0xc2, 0x3c, 0xf1, 0x7c,
-	0x83, 0x60, 0x37, 0x3a, 0xaa, 0x18, 0x0a, 0x1f, 0xf0, 0x9a, 0x72, 0x7b, 0xf7, 0x3c, 0xff, 0x1e,
-	0x8e, 0xce, 0x49, 0x86, 0xbc, 0xad, 0x2a, 0x91, 0x1e, 0xa2, 0x4b, 0xf1, 0xb1, 0xf2, 0x54, 0x45,
-	0x6c, 0xa5, 0x35, 0x1b, 0xcf, 0x94, 0xa8, 0x43, 0xba, 0x04, 0x95, 0x68, 0x36, 0xc0, 0x89, 0x78,
-	0xa7, 0xcd, 0x8a, 0x87, 0x9c, 0xe8, 0x7d, 0x98, 0x8d, 0x10, 0x9b, 0xbe, 0x1f, 0x8a, 0xd3, 0x63,
-	0x2f, 0x8f, 0x88, 0xb6, 0xe9, 0xfb, 0x02, 0x29, 0x90, 0xf8, 0x41, 0xfd, 0x16, 0xac, 0x0c, 0x50,
-	0x1e, 0x9a, 0x87, 0x22, 0x8d, 0x0b, 0xfc, 0xa8, 0x27, 0xfd, 0x97, 0xfa, 0xef, 0x03, 0x6a, 0x71,
-	0xd1, 0x51, 0x70, 0xf6, 0xe3, 0xd5, 0xc2, 0xcb, 0x5a, 0xbd, 0x09, 0x0b, 0x39, 0x3a, 0x19, 0x49,
-	0xc4, 0x75, 0x98, 0xcb, 0x00, 0x1d, 0x85, 0x5d, 0xff, 0x9f, 0x22, 0x4c, 0xbd, 0xc5, 0xf7, 0xe0,
-	0xd0, 0x6b, 0xf2, 0x0e, 0x9d, 0x52, 0xc8, 0x4e, 0xf6, 0xef, 0x1e, 0xfd, 0xf2, 0x69, 0x6a, 0x9f,
-	0x7a, 0x62, 0x84, 0x7d, 0x6a, 0x79, 0x19, 0x6c, 0x72, 0xb4, 0x65, 0xb0, 0xcc, 0x32, 0x50, 0x69,
-	0x9c, 0x65, 0xa0, 0xa9, 0x91, 0x96, 0x81, 0x52, 0x29, 0xd1, 0xb4, 0x94, 0x12, 0x5d, 0x4d, 0xd2,
-	0x03, 0xe5, 0x79, 0xfd, 0x3f, 0x6a, 0xd1, 0x79, 0x62, 0xd1, 0xf9, 0x91, 0x17, 0x8e, 0x7a, 0x51,
-	0x3b, 0x69, 0x2f, 0x16, 0xc6, 0xe8, 0xc5, 0xa2, 0x7a, 0x2f, 0xea, 0xf7, 0xa3, 0xd3, 0xbb, 0xf1,
-	0x07, 0x08, 0xef, 0x38, 0x96, 0x15, 0xeb, 0x7f, 0x53, 0x4c, 0x16, 0x3c, 0x84, 0xe4, 0x38, 0x42,
-	0xfd, 0x3f, 0x19, 0x1f, 0x8b, 0xc9, 0x26, 0x4c, 0x2a, 0xdd, 0x1c, 0x33, 0x65, 0x8e, 0xf3, 0xf3,
-	0xa9, 0xfc, 0xfc, 0x7c, 0x5a, 0xca, 0xcf, 0x73, 0x72, 0xdb, 0x99, 0xdc, 0xdc, 0x36, 0x48, 0x26,
-	0xd5, 0x49, 0x6f, 0xa9, 0xa6, 0xb5, 0x2f, 0xc2, 0x6c, 0xdc, 0x9f, 0x03, 0x26, 0x3e, 0x91, 0x71,
-	0x81, 0xe8, 0x47, 0x9a, 0xca, 0xbe, 0x14, 0x9d, 0x1b, 0xcc, 0xda, 0xc7, 0xf9, 0xac, 0x7d, 0xc8,
-	0x15, 0x0e, 0xfa, 0xcb, 0xd1, 0xe1, 0xbd, 0x3e, 0xa8, 0xc7, 0x71, 0xde, 0x85, 0xa5, 0x26, 0x21,
-	0x66, 0x7b, 0x6f, 0xc4, 0x26, 0x07, 0xce, 0xa3, 0xf4, 0x0d, 0x58, 0xce, 0x4a, 0x14, 0x58, 0x92,
-	0xa4, 0x45, 0x4b, 0x27, 0x2d, 0x77, 0xe9, 0x57, 0x9f, 0x36, 0x84, 0xac, 0xc4, 0xe1, 0x10, 0x3e,
-	0xd6, 0xa0, 0x4c, 0xe7, 0x13, 0x51, 0xbc, 0x3a, 0x61, 0x61, 0x62, 0x66, 0x18, 0x17, 0x46, 0x73,
-	0x10, 0xf7, 0xd9, 0xb9, 0xa1, 0x14, 0x8c, 0xd4, 0x84, 0xb7, 0xc2, 0xe0, 0x44, 0xc2, 0xf3, 0xce,
-	0xb2, 0xa6, 0xf8, 0x5a, 0x65, 0x37, 0xf9, 0xa1, 0x9f, 0x65, 0x07, 0x74, 0x64, 0xb1, 0x5c, 0x1b,
-	0xfa, 0xd7, 0xa2, 0xd3, 0x32, 0xa7, 0xde, 0xe8, 0x5a, 0x74, 0xd8, 0x25, 0xaf, 0xdd, 0xab, 0xbf,
-	0xf9, 0x0c, 0x54, 0x45, 0x76, 0x74, 0xc7, 0x74, 0xcd, 0x0e, 0x0e, 0xd0, 0xfb, 0x30, 0x97, 0x41,
-	0x89, 0xf4, 0x74, 0x4b, 0xf9, 0x9a, 0xa9, 0x5f, 0x1a, 0x4a, 0x23, 0x3a, 0xbd, 0x0d, 0xa8, 0x1f,
-	0x0c, 0x7a, 0x22, 0xcd, 0x3a, 0x50, 0x0d, 0xf5, 0x27, 0x8f, 0x23, 0x13, 0x8d, 0x7c, 0x47, 0x83,
-	0x8a, 0x14, 0x36, 0x50, 0x43, 0x9a, 0xc1, 0xe6, 0x84, 0xc4, 0xfa, 0xc5, 0x21, 0x14, 0xa2, 0x8b,
-	0x5e, 0x3c, 0x6a, 0x9e, 0x41, 0x73, 0xfc, 0x5d, 0x63, 0x1f, 0x1f, 0x36, 0x68, 0x57, 0x7c, 0xfc,
-	0x1f, 0x3f, 0xfb, 0xfd, 0xc2, 0xaa, 0xbe, 0xbc, 0x71, 0xf0, 0xdc, 0x86, 0xc8, 0x5e, 0xc3, 0x8d,
-	0xa8, 0x9f, 0xc2, 0x57, 0xb5, 0xa7, 0xd1, 0xcf, 0x35, 0x98, 0xcf, 0xba, 0x2f, 0x74, 0x49, 0xfe,
-	0x94, 0xdc, 0x50, 0x54, 0xff, 0xe2, 0x70, 0x22, 0x01, 0xeb, 0xdb, 0xda, 0x51, 0xd3, 0x46, 0x9d,
-	0x37, 0x31, 0x89, 0x41, 0x85, 0xeb, 0x0d, 0x51, 0x65, 0xdc, 0xd8, 0xb5, 0x1d, 0x82, 0x83, 0xc6,
-	0x03, 0x9b, 0xec, 0x35, 0xc8, 0x1e, 0x0e, 0x71, 0x63, 0xd7, 0xc6, 0x8e, 0x15, 0x5e, 0x4e, 0x8d,
-	0x8e, 0xf5, 0x06, 0x8d, 0x44, 0xeb, 0x0d, 0x16, 0x03, 0xbe, 0xb4, 0xde, 0xb0, 0xf0, 0xae, 0xd9,
-	0x73, 0x48, 0x23, 0xc0, 0xa4, 0x17, 0xb8, 0x0d, 0xd3, 0x71, 0x12, 0xc9, 0xec, 0x7b, 0x6b, 0x68,
-	0xc0, 0xf7, 0xa2, 0x3f, 0xd0, 0xa0, 0x2a, 0xbb, 0x3f, 0x74, 0xb1, 0xbf, 0xd7, 0xb2, 0x1f, 0xaa,
-	0x0f, 0x23, 0x11, 0x9f, 0xf9, 0xda, 0x51, 0xb3, 0x86, 0x96, 0x6f, 0x98, 0xa4, 0xbd, 0xd7, 0xe0,
-	0x85, 0xf9, 0x19, 0x50, 0xab, 0x4f, 0x0f, 0xe9, 0x84, 0xbf, 0xd0, 0xa0, 0x2a, 0xbb, 0x42, 0x19,
-	0x57, 0xae, 0xe3, 0x95, 0x71, 0xe5, 0x7b, 0x52, 0xfd, 0x57, 0x8e, 0x9a, 0x0d, 0x74, 0x9e, 0xe3,
-	0x32, 0x19, 0x49, 0x82, 0xab, 0x41, 0xbc, 0x06, 0x1d, 0x8a, 0x0c, 0xdf, 0x45, 0x7d, 0x2d, 0x17,
-	0xdf, 0x06, 0xe7, 0xa2, 0x28, 0xff, 0x92, 0x69, 0x6f, 0x30, 0xca, 0x5c, 0xdf, 0x9c, 0xd5, 0x5e,
-	0x2e, 0xca, 0xdb, 0x47, 0x4d, 0x1d, 0x35, 0x22, 0xed, 0x65, 0x50, 0xee, 0x06, 0x5e, 0x57, 0x01,
-	0x27, 0xe7, 0xa3, 0x38, 0x7f, 0x5b, 0x83, 0xb9, 0xcc, 0xb5, 0x43, 0x48, 0xcf, 0x33, 0x56, 0xf9,
-	0xae, 0xad, 0xfa, 0xa5, 0xa1, 0x34, 0x02, 0xea, 0xfa, 0x51, 0xb3, 0x82, 0xca, 0xd4, 0x9c, 0x79,
-	0x55, 0x02, 0xef, 0xdd, 0x65, 0xb4, 0x28, 0xa1, 0x12, 0xef, 0xd0, 0x6f, 0xc5, 0x63, 0x3d, 0x2a,
-	0x0f, 0xc9, 0x19, 0xeb, 0xf2, 0x29, 0xda, 0xbc, 0xb1, 0x9e, 0x39, 0xab, 0xaa, 0x3f, 0x77, 0xd4,
-	0x9c, 0x47, 0x55, 0x31, 0xd6, 0x45, 0xa3, 0xdc, 0xf4, 0xf5, 0x05, 0x09, 0x07, 0x4f, 0xfc, 0xa9,
-	0x52, 0xbe, 0xaf, 0x01, 0xe2, 0x0c, 0x37, 0xf1, 0x4e, 0xaf, 0x73, 0xaa, 0x70, 0xae, 0x1f, 0x35,
-	0x97, 0x91, 0xc8, 0xe5, 0x1b, 0x6c, 0x1f, 0x56, 0x02, 0x75, 0x5e, 0x3f, 0x4b, 0x41, 0xb1, 0x17,
-	0x46, 0x0e, 0xb4, 0x7b, 0x50, 0x91, 0x2e, 0x80, 0x90, 0x41, 0xe5, 0xdd, 0x49, 0x23, 0x83, 0xca,
-	0xbf, 0x01, 0xe5, 0x1b, 0x70, 0xa6, 0xef, 0x5a, 0x09, 0xf4, 0xc5, 0x81, 0x7c, 0xa9, 0x3b, 0x4e,
-	0xea, 0x4f, 0x1c, 0x43, 0x25, 0x5a, 0xf8, 0x91, 0x06, 0x2b, 0x03, 0x6e, 0xea, 0x40, 0x4f, 0x0f,
-	0x14, 0xd1, 0x77, 0xd3, 0x46, 0xfd, 0x97, 0x94, 0x68, 0x13, 0x47, 0xb3, 0x8a, 0xc4, 0x35, 0x2a,
-	0x91, 0x96, 0xe9, 0xc8, 0x16, 0x74, 0xb9, 0x56, 0xd0, 0x65, 0xd4, 0x54, 0xd5, 0xff, 0xaa, 0xc1,
-	0xea, 0x90, 0xcb, 0x36, 0xd0, 0x95, 0xa1, 0x5f, 0xde, 0x0f, 0x7d, 0x43, 0x99, 0x5e, 0xc0, 0x7f,
-	0xfb, 0xa8, 0xf9, 0x14, 0x7a, 0x42, 0xc0, 0xa7, 0x83, 0x3a, 0x85, 0xbd, 0x61, 0xbb, 0x34, 0x08,
-	0xe4, 0xd9, 0x4e, 0xe6, 0x53, 0xd8, 0x12, 0x30, 0xf3, 0x9c, 0xef, 0xc1, 0x62, 0xde, 0xf5, 0x1e,
-	0xe8, 0xa9, 0x4c, 0xb8, 0x1f, 0x74, 0x37, 0x47, 0x7d, 0xb9, 0x2f, 0xe9, 0xba, 0xd5, 0xf5, 0xc9,
-	0x21, 0xfa, 0x75, 0x3a, 0x07, 0xcb, 0xbd, 0xd5, 0x43, 0xee, 0xdb, 0xe1, 0x57, 0x7f, 0x0c, 0x14,
-	0xff, 0xbd, 0x38, 0x12, 0x45, 0x0b, 0x7a, 0x79, 0x91, 0x28, 0xb3, 0x3e, 0x99, 0x17, 0x89, 0xb2,
-	0xeb, 0x81, 0xfa, 0xcb, 0x47, 0xcd, 0x15, 0xb4, 0x24, 0x45, 0xa2, 0x48, 0x7b, 0xb9, 0xc6, 0xc1,
-	0x69, 0xa8, 0x2e, 0xbf, 0xab, 0x41, 0x55, 0xbe, 0xcd, 0x42, 0xc6, 0x94, 0x7b, 0x49, 0x87, 0x8c,
-	0x29, 0xff, 0x32, 0x0c, 0xfd, 0x79, 0x96, 0x9b, 0x88, 0x97, 0x52, 0xff, 0x9e, 0xd5, 0x65, 0xc7,
-	0x29, 0x6a, 0x65, 0x28, 0x9c, 0xef, 0x69, 0x30, 0x97, 0xb9, 0x51, 0x42, 0x76, 0xe3, 0xf9, 0xb7,
-	0x5d, 0xc8, 0x6e, 0x7c, 0xc0, 0x95, 0x14, 0x34, 0x5b, 0x42, 0x68, 0x3e, 0x7a, 0x2b, 0x41, 0xaa,
-	0xeb, 0x4b, 0x12, 0xa4, 0x40, 0x10, 0x51, 0x4c, 0xd4, 0x9f, 0x4b, 0xd7, 0x07, 0xc8, 0xbe, 0x2a,
-	0xef, 0x56, 0x04, 0xd9, 0x57, 0xe5, 0xde, 0x3d, 0x20, 0xfc, 0x39, 0x7f, 0x37, 0xd4, 0x9f, 0x07,
-	0x8c, 0x84, 0x22, 0xf9, 0x53, 0x8d, 0xe5, 0xc1, 0x92, 0x61, 0x66, 0xf3, 0xe0, 0x3c, 0x83, 0xbc,
-	0x34, 0x94, 0x46, 0xe0, 0x79, 0xe3, 0xa8, 0xb9, 0x86, 0xea, 0x22, 0x6b, 0xb0, 0x2c, 0x36, 0x50,
-	0x59, 0xba, 0x90, 0xc6, 0x96, 0x4d, 0x2b, 0x4d, 0xcb, 0x4a, 0xc6, 0xe5, 0x27, 0x5a, 0x94, 0x4a,
-	0x4b, 0x08, 0x9f, 0x18, 0x68, 0xc0, 0x12, 0xc8, 0x27, 0x8f, 0x23, 0x13, 0x38, 0xbf, 0x7a, 0xd4,
-	0xbc, 0x88, 0x2e, 0x48, 0xb6, 0xce, 0xa1, 0xb2, 0x9c, 0x61, 0x98, 0x1f, 0x11, 0x27, 0x2f, 0x63,
-	0xbc, 0x7f, 0xa2, 0xc1, 0x7c, 0xf6, 0xcc, 0xb8, 0x9c, 0x06, 0x0f, 0x38, 0x01, 0x2f, 0xa7, 0xc1,
-	0x83, 0x8e, 0x9d, 0x53, 0xb7, 0xbd, 0x82, 0x96, 0xf8, 0xeb, 0x06, 0x76, 0x0f, 0x1a, 0xde, 0xae,
-	0x84, 0x6f, 0xed, 0xea, 0x4a, 0x66, 0x1c, 0x50, 0x4a, 0x03, 0xbb, 0x07, 0x14, 0xdd, 0x1f, 0x15,
-	0x92, 0x24, 0x3d, 0xf6, 0x17, 0xb9, 0xe9, 0x4a, 0xd6, 0x63, 0x7c, 0x71, 0x38, 0x91, 0x40, 0xf7,
-	0x99, 0x76, 0xd4, 0xfc, 0x73, 0x0d, 0xfd, 0x99, 0x46, 0xf3, 0x9a, 0x08, 0xc3, 0x7a, 0xa3, 0x6d,
-	0xba, 0x83, 0x33, 0xf4, 0x64, 0xd3, 0x61, 0xbd, 0xc1, 0xb7, 0xf0, 0xd7, 0x1b, 0x49, 0x55, 0xcd,
-	0x7a, 0x83, 0x2f, 0x1b, 0xae, 0x37, 0x92, 0x92, 0x99, 0xf5, 0x46, 0xba, 0x3e, 0x46, 0x24, 0xf4,
-	0xeb, 0x8d, 0x74, 0x45, 0x47, 0x7e, 0x7a, 0x2f, 0xf9, 0xaf, 0x2a, 0x9a, 0x4d, 0x6b, 0x0a, 0xfd,
-	0xa8, 0x00, 0x4b, 0xd1, 0x87, 0xa5, 0x53, 0x9b, 0x53, 0x55, 0xd0, 0xbf, 0x68, 0x47, 0xcd, 0x4f,
-	0x34, 0xf4, 0xd7, 0x4c, 0x41, 0x52, 0x86, 0xf3, 0x39, 0x52, 0x93, 0x8c, 0x8b, 0x29, 0x6b, 0x11,
-	0xa1, 0xfe, 0xd4, 0x0b, 0xfd, 0x6d, 0x01, 0x16, 0x72, 0x76, 0xeb, 0xd1, 0x93, 0x79, 0xba, 0xe8,
-	0x2f, 0xda, 0xa8, 0x3f, 0x75, 0x2c, 0x9d, 0x50, 0xdb, 0x4f, 0xb4, 0xa3, 0xe6, 0x5f, 0x69, 0xe8,
-	0x07, 0x4c, 0x6d, 0xa6, 0xef, 0x7f, 0x0e, 0x95, 0x96, 0x46, 0xc5, 0x54, 0xb6, 0x80, 0xce, 0xc8,
-	0x6e, 0xcd, 0xf7, 0x43, 0xf4, 0x93, 0x42, 0xb2, 0xc8, 0xc7, 0x8c, 0xec, 0xa1, 0xaa, 0xed, 0x3f,
-	0xb5, 0xa3, 0xe6, 0xdf, 0x69, 0xe8, 0xd3, 0x94, 0xb5, 0x7d, 0x3e, 0x95, 0xd7, 0x8f, 0x8d, 0x07,
-	0x75, 0xb4, 0x92, 0x93, 0xf0, 0x33, 0x45, 0xfe, 0x97, 0x06, 0x8b, 0x79, 0x85, 0x00, 0xe8, 0xa9,
-	0x21, 0xe3, 0x50, 0x8a, 0x0d, 0x97, 0x8f, 0x27, 0x14, 0x6a, 0xec, 0x1d, 0x35, 0xbf, 0x86, 0xde,
-	0xa5, 0x3a, 0xe4, 0x41, 0xc1, 0x76, 0x23, 0x98, 0x23, 0x68, 0x50, 0xac, 0xee, 0x25, 0x6a, 0xe3,
-	0xcb, 0x10, 0xe9, 0xd1, 0x15, 0x7f, 0x21, 0x6b, 0x86, 0xe6, 0x08, 0xb3, 0xe9, 0x52, 0x00, 0x24,
-	0x55, 0x4b, 0xe6, 0x54, 0x20, 0xd4, 0x1b, 0x83, 0x09, 0xc4, 0xa7, 0xbc, 0x70, 0xd4, 0x5c, 0x42,
-	0x0b, 0x3c, 0xd0, 0x85, 0xc4, 0xcb, 0xe8, 0x7b, 0x59, 0x97, 0x4d, 0x96, 0x52, 0x88, 0x84, 0xae,
-	0x22, 0x6d, 0xf4, 0xa3, 0x4c, 0x4b, 0xfd, 0x15, 0x06, 0x72, 0xb6, 0x92, 0x5b, 0x25, 0xa0, 0x7f,
-	0x99, 0x4d, 0xf7, 0x22, 0x30, 0x66, 0x40, 0x64, 0x34, 0x2b, 0x3a, 0xca, 0xa0, 0x31, 0x03, 0x42,
-	0xe1, 0xfc, 0x21, 0x4d, 0xe8, 0xe4, 0x8d, 0xfc, 0x4c, 0x42, 0x97, 0x5b, 0x45, 0x90, 0x49, 0xe8,
-	0xf2, 0x2b, 0x01, 0xf4, 0x57, 0x53, 0x0b, 0x30, 0x01, 0xa7, 0xc9, 0x18, 0x65, 0x26, 0xd3, 0x14,
-	0x44, 0x91, 0x9e, 0xa4, 0xcd, 0xf5, 0xcc, 0xb4, 0x38, 0xa7, 0x54, 0x20, 0x33, 0x2d, 0xce, 0xdb,
-	0x99, 0x97, 0xf4, 0xd4, 0xa6, 0x14, 0xc3, 0xf5, 0xc4, 0x48, 0x28, 0x9c, 0x1f, 0x6a, 0xb0, 0x98,
-	0xb7, 0x2b, 0x2c, 0x8f, 0x91, 0x21, 0xdb, 0xf7, 0xf2, 0x18, 0x19, 0xb6, 0xc1, 0x4c, 0xa7, 0xee,
-	0xab, 0xe8, 0x2c, 0x5b, 0xce, 0x88, 0x5f, 0x66, 0x73, 0x13, 0x31, 0x9c, 0xd3, 0x1d, 0x1a, 0x21,
-	0xfa, 0x30, 0x73, 0x17, 0x60, 0x7c, 0x03, 0x31, 0xfa, 0xd2, 0xc0, 0x14, 0x2e, 0x7b, 0x27, 0x72,
-	0xfd, 0x69, 0x15, 0x52, 0x81, 0xf7, 0x0b, 0x88, 0xc0, 0xca, 0x80, 0x5b, 0x8f, 0x33, 0x93, 0xee,
-	0xa1, 0x17, 0x31, 0x67, 0x26, 0xdd, 0xc3, 0xaf, 0x51, 0xd6, 0xbf, 0x70, 0x63, 0xe2, 0xfd, 0x82,
-	0xbf, 0xb3, 0x53, 0x62, 0xb3, 0xb8, 0xe7, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x03, 0x63,
-	0x3c, 0x76, 0x5e, 0x00, 0x00,
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quota.Unmarshal(m, b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+}
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
+}
+func (m *Quota) XXX_Size() int {
+	return xxx_messageInfo_Quota.Size(m)
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_Quota proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *Quota) GetQuotaId() *wrappers.StringValue {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
 
-// ClusterManagerClient is the client API for ClusterManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ClusterManagerClient interface {
-	AddNodeKeyPairs(ctx context.Context, in *AddNodeKeyPairsRequest, opts ...grpc.CallOption) (*AddNodeKeyPairsResponse, error)
-	DeleteNodeKeyPairs(ctx context.Context, in *DeleteNodeKeyPairsRequest, opts ...grpc.CallOption) (*DeleteNodeKeyPairsResponse, error)
-	// Create key pair
-	CreateKeyPair(ctx context.Context, in *CreateKeyPairRequest, opts ...grpc.CallOption) (*CreateKeyPairResponse, error)
-	// Get key pairs, support filter with these fields(key_pair_id, name, owner), default return all key pairs
-	DescribeKeyPairs(ctx context.Context, in *DescribeKeyPairsRequest, opts ...grpc.CallOption) (*DescribeKeyPairsResponse, error)
-	// Batch delete key pairs
-	DeleteKeyPairs(ctx context.Context, in *DeleteKeyPairsRequest, opts ...grpc.CallOption) (*DeleteKeyPairsResponse, error)
-	// Batch attach key pairs to node
-	AttachKeyPairs(ctx context.Context, in *AttachKeyPairsRequest, opts ...grpc.CallOption) (*AttachKeyPairsResponse, error)
-	//Batch detach key pairs from node
-	DetachKeyPairs(ctx context.Context, in *DetachKeyPairsRequest, opts ...grpc.CallOption) (*DetachKeyPairsResponse, error)
-	// Get subnets
-	DescribeSubnets(ctx context.Context, in *DescribeSubnetsRequest, opts ...grpc.CallOption) (*DescribeSubnetsResponse, error)
-	// Create cluster
-	CreateCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*CreateClusterResponse, error)
-	// Create debug cluster
-	CreateDebugCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*CreateClusterResponse, error)
-	ModifyCluster(ctx context.Context, in *ModifyClusterRequest, opts ...grpc.CallOption) (*ModifyClusterResponse, error)
-	ModifyClusterNode(ctx context.Context, in *ModifyClusterNodeRequest, opts ...grpc.CallOption) (*ModifyClusterNodeResponse, error)
-	// Modify cluster attributes
+func (m *Quota) GetSubjectType() *wrappers.StringValue {
+	if m != nil {
+		return m.SubjectType
+	}
+	return nil
+}
+
+func (m *Quota) GetSubject() *wrappers.StringValue {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (m *Quota) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *Quota) GetMaxClusters() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxClusters
+	}
+	return nil
+}
+
+func (m *Quota) GetMaxNodes() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return nil
+}
+
+func (m *Quota) GetMaxCpu() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxCpu
+	}
+	return nil
+}
+
+func (m *Quota) GetMaxMemory() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxMemory
+	}
+	return nil
+}
+
+func (m *Quota) GetMaxVolumeSize() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxVolumeSize
+	}
+	return nil
+}
+
+func (m *Quota) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *Quota) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Quota) GetOwnerPath() *wrappers.StringValue {
+	if m != nil {
+		return m.OwnerPath
+	}
+	return nil
+}
+
+func (m *Quota) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Quota) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type CreateQuotaRequest struct {
+	// required, subject type of quota eg.[user|group|owner_path]
+	SubjectType *wrappers.StringValue `protobuf:"bytes,1,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// required, user id, group path, or prefix of owner path limited by quota
+	Subject *wrappers.StringValue `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// runtime id limited by quota, empty for all runtimes
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,3,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// max count of clusters, 0 for unlimited
+	MaxClusters *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=max_clusters,json=maxClusters,proto3" json:"max_clusters,omitempty"`
+	// max count of nodes, 0 for unlimited
+	MaxNodes *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	// max cpu cores of nodes, 0 for unlimited
+	MaxCpu *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	// max memory(MB) of nodes, 0 for unlimited
+	MaxMemory *wrappers.UInt32Value `protobuf:"bytes,7,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	// max volume size(GB) of nodes, 0 for unlimited
+	MaxVolumeSize *wrappers.UInt32Value `protobuf:"bytes,8,opt,name=max_volume_size,json=maxVolumeSize,proto3" json:"max_volume_size,omitempty"`
+	// quota description
+	Description          *wrappers.StringValue `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateQuotaRequest) Reset()         { *m = CreateQuotaRequest{} }
+func (m *CreateQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateQuotaRequest) ProtoMessage()    {}
+func (*CreateQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{75}
+}
+
+func (m *CreateQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateQuotaRequest.Unmarshal(m, b)
+}
+func (m *CreateQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateQuotaRequest.Merge(m, src)
+}
+func (m *CreateQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateQuotaRequest.Size(m)
+}
+func (m *CreateQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateQuotaRequest proto.InternalMessageInfo
+
+func (m *CreateQuotaRequest) GetSubjectType() *wrappers.StringValue {
+	if m != nil {
+		return m.SubjectType
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetSubject() *wrappers.StringValue {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetMaxClusters() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxClusters
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetMaxNodes() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetMaxCpu() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxCpu
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetMaxMemory() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxMemory
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetMaxVolumeSize() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxVolumeSize
+	}
+	return nil
+}
+
+func (m *CreateQuotaRequest) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+type CreateQuotaResponse struct {
+	// id of quota created
+	QuotaId              *wrappers.StringValue `protobuf:"bytes,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateQuotaResponse) Reset()         { *m = CreateQuotaResponse{} }
+func (m *CreateQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateQuotaResponse) ProtoMessage()    {}
+func (*CreateQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{76}
+}
+
+func (m *CreateQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateQuotaResponse.Unmarshal(m, b)
+}
+func (m *CreateQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateQuotaResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateQuotaResponse.Merge(m, src)
+}
+func (m *CreateQuotaResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateQuotaResponse.Size(m)
+}
+func (m *CreateQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateQuotaResponse proto.InternalMessageInfo
+
+func (m *CreateQuotaResponse) GetQuotaId() *wrappers.StringValue {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
+
+type ModifyQuotaRequest struct {
+	// required, id of quota to modify
+	QuotaId *wrappers.StringValue `protobuf:"bytes,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	// max count of clusters, 0 for unlimited
+	MaxClusters *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=max_clusters,json=maxClusters,proto3" json:"max_clusters,omitempty"`
+	// max count of nodes, 0 for unlimited
+	MaxNodes *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	// max cpu cores of nodes, 0 for unlimited
+	MaxCpu *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	// max memory(MB) of nodes, 0 for unlimited
+	MaxMemory *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	// max volume size(GB) of nodes, 0 for unlimited
+	MaxVolumeSize *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=max_volume_size,json=maxVolumeSize,proto3" json:"max_volume_size,omitempty"`
+	// quota description
+	Description          *wrappers.StringValue `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyQuotaRequest) Reset()         { *m = ModifyQuotaRequest{} }
+func (m *ModifyQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyQuotaRequest) ProtoMessage()    {}
+func (*ModifyQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{77}
+}
+
+func (m *ModifyQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyQuotaRequest.Unmarshal(m, b)
+}
+func (m *ModifyQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyQuotaRequest.Merge(m, src)
+}
+func (m *ModifyQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyQuotaRequest.Size(m)
+}
+func (m *ModifyQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyQuotaRequest proto.InternalMessageInfo
+
+func (m *ModifyQuotaRequest) GetQuotaId() *wrappers.StringValue {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
+
+func (m *ModifyQuotaRequest) GetMaxClusters() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxClusters
+	}
+	return nil
+}
+
+func (m *ModifyQuotaRequest) GetMaxNodes() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return nil
+}
+
+func (m *ModifyQuotaRequest) GetMaxCpu() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxCpu
+	}
+	return nil
+}
+
+func (m *ModifyQuotaRequest) GetMaxMemory() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxMemory
+	}
+	return nil
+}
+
+func (m *ModifyQuotaRequest) GetMaxVolumeSize() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxVolumeSize
+	}
+	return nil
+}
+
+func (m *ModifyQuotaRequest) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+type ModifyQuotaResponse struct {
+	// id of quota modified
+	QuotaId              *wrappers.StringValue `protobuf:"bytes,1,opt,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyQuotaResponse) Reset()         { *m = ModifyQuotaResponse{} }
+func (m *ModifyQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyQuotaResponse) ProtoMessage()    {}
+func (*ModifyQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{78}
+}
+
+func (m *ModifyQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyQuotaResponse.Unmarshal(m, b)
+}
+func (m *ModifyQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyQuotaResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyQuotaResponse.Merge(m, src)
+}
+func (m *ModifyQuotaResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyQuotaResponse.Size(m)
+}
+func (m *ModifyQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyQuotaResponse proto.InternalMessageInfo
+
+func (m *ModifyQuotaResponse) GetQuotaId() *wrappers.StringValue {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
+
+type DeleteQuotasRequest struct {
+	// required, ids of quotas to delete
+	QuotaId              []string `protobuf:"bytes,1,rep,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteQuotasRequest) Reset()         { *m = DeleteQuotasRequest{} }
+func (m *DeleteQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteQuotasRequest) ProtoMessage()    {}
+func (*DeleteQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{79}
+}
+
+func (m *DeleteQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQuotasRequest.Unmarshal(m, b)
+}
+func (m *DeleteQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQuotasRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQuotasRequest.Merge(m, src)
+}
+func (m *DeleteQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteQuotasRequest.Size(m)
+}
+func (m *DeleteQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQuotasRequest proto.InternalMessageInfo
+
+func (m *DeleteQuotasRequest) GetQuotaId() []string {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
+
+type DeleteQuotasResponse struct {
+	// ids of quotas deleted
+	QuotaId              []string `protobuf:"bytes,1,rep,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteQuotasResponse) Reset()         { *m = DeleteQuotasResponse{} }
+func (m *DeleteQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteQuotasResponse) ProtoMessage()    {}
+func (*DeleteQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{80}
+}
+
+func (m *DeleteQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQuotasResponse.Unmarshal(m, b)
+}
+func (m *DeleteQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQuotasResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQuotasResponse.Merge(m, src)
+}
+func (m *DeleteQuotasResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteQuotasResponse.Size(m)
+}
+func (m *DeleteQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQuotasResponse proto.InternalMessageInfo
+
+func (m *DeleteQuotasResponse) GetQuotaId() []string {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
+
+type DescribeQuotasRequest struct {
+	// quota ids
+	QuotaId []string `protobuf:"bytes,1,rep,name=quota_id,json=quotaId,proto3" json:"quota_id,omitempty"`
+	// subject types of quota eg.[user|group|owner_path]
+	SubjectType []string `protobuf:"bytes,2,rep,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// subjects of quota
+	Subject []string `protobuf:"bytes,3,rep,name=subject,proto3" json:"subject,omitempty"`
+	// runtime ids of quota
+	RuntimeId []string `protobuf:"bytes,4,rep,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// data limit per page, default value 20, max value 200
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// data offset, default 0
+	Offset uint32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// select columns to display
+	DisplayColumns       []string `protobuf:"bytes,7,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeQuotasRequest) Reset()         { *m = DescribeQuotasRequest{} }
+func (m *DescribeQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeQuotasRequest) ProtoMessage()    {}
+func (*DescribeQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{81}
+}
+
+func (m *DescribeQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeQuotasRequest.Unmarshal(m, b)
+}
+func (m *DescribeQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeQuotasRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeQuotasRequest.Merge(m, src)
+}
+func (m *DescribeQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeQuotasRequest.Size(m)
+}
+func (m *DescribeQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeQuotasRequest proto.InternalMessageInfo
+
+func (m *DescribeQuotasRequest) GetQuotaId() []string {
+	if m != nil {
+		return m.QuotaId
+	}
+	return nil
+}
+
+func (m *DescribeQuotasRequest) GetSubjectType() []string {
+	if m != nil {
+		return m.SubjectType
+	}
+	return nil
+}
+
+func (m *DescribeQuotasRequest) GetSubject() []string {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (m *DescribeQuotasRequest) GetRuntimeId() []string {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *DescribeQuotasRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeQuotasRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeQuotasRequest) GetDisplayColumns() []string {
+	if m != nil {
+		return m.DisplayColumns
+	}
+	return nil
+}
+
+type DescribeQuotasResponse struct {
+	// total count of qualified quota
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// list of quota
+	QuotaSet             []*Quota `protobuf:"bytes,2,rep,name=quota_set,json=quotaSet,proto3" json:"quota_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeQuotasResponse) Reset()         { *m = DescribeQuotasResponse{} }
+func (m *DescribeQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeQuotasResponse) ProtoMessage()    {}
+func (*DescribeQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{82}
+}
+
+func (m *DescribeQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeQuotasResponse.Unmarshal(m, b)
+}
+func (m *DescribeQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeQuotasResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeQuotasResponse.Merge(m, src)
+}
+func (m *DescribeQuotasResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeQuotasResponse.Size(m)
+}
+func (m *DescribeQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeQuotasResponse proto.InternalMessageInfo
+
+func (m *DescribeQuotasResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeQuotasResponse) GetQuotaSet() []*Quota {
+	if m != nil {
+		return m.QuotaSet
+	}
+	return nil
+}
+
+type QuotaUsage struct {
+	// quota
+	Quota *Quota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// count of clusters used
+	UsedClusters *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=used_clusters,json=usedClusters,proto3" json:"used_clusters,omitempty"`
+	// count of nodes used
+	UsedNodes *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=used_nodes,json=usedNodes,proto3" json:"used_nodes,omitempty"`
+	// cpu cores used
+	UsedCpu *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=used_cpu,json=usedCpu,proto3" json:"used_cpu,omitempty"`
+	// memory(MB) used
+	UsedMemory *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=used_memory,json=usedMemory,proto3" json:"used_memory,omitempty"`
+	// volume size(GB) used
+	UsedVolumeSize       *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=used_volume_size,json=usedVolumeSize,proto3" json:"used_volume_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{83}
+}
+
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaUsage.Unmarshal(m, b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return xxx_messageInfo_QuotaUsage.Size(m)
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsage) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *QuotaUsage) GetUsedClusters() *wrappers.UInt32Value {
+	if m != nil {
+		return m.UsedClusters
+	}
+	return nil
+}
+
+func (m *QuotaUsage) GetUsedNodes() *wrappers.UInt32Value {
+	if m != nil {
+		return m.UsedNodes
+	}
+	return nil
+}
+
+func (m *QuotaUsage) GetUsedCpu() *wrappers.UInt32Value {
+	if m != nil {
+		return m.UsedCpu
+	}
+	return nil
+}
+
+func (m *QuotaUsage) GetUsedMemory() *wrappers.UInt32Value {
+	if m != nil {
+		return m.UsedMemory
+	}
+	return nil
+}
+
+func (m *QuotaUsage) GetUsedVolumeSize() *wrappers.UInt32Value {
+	if m != nil {
+		return m.UsedVolumeSize
+	}
+	return nil
+}
+
+type DescribeQuotaUsageRequest struct {
+	// owner path of clusters, default is the owner path of sender
+	OwnerPath *wrappers.StringValue `protobuf:"bytes,1,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
+	// runtime id, default returns the usage of quotas in all runtimes
+	RuntimeId            *wrappers.StringValue `protobuf:"bytes,2,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeQuotaUsageRequest) Reset()         { *m = DescribeQuotaUsageRequest{} }
+func (m *DescribeQuotaUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeQuotaUsageRequest) ProtoMessage()    {}
+func (*DescribeQuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{84}
+}
+
+func (m *DescribeQuotaUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeQuotaUsageRequest.Unmarshal(m, b)
+}
+func (m *DescribeQuotaUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeQuotaUsageRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeQuotaUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeQuotaUsageRequest.Merge(m, src)
+}
+func (m *DescribeQuotaUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeQuotaUsageRequest.Size(m)
+}
+func (m *DescribeQuotaUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeQuotaUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeQuotaUsageRequest proto.InternalMessageInfo
+
+func (m *DescribeQuotaUsageRequest) GetOwnerPath() *wrappers.StringValue {
+	if m != nil {
+		return m.OwnerPath
+	}
+	return nil
+}
+
+func (m *DescribeQuotaUsageRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+type DescribeQuotaUsageResponse struct {
+	// usage of the quotas limiting the clusters of owner path
+	QuotaUsageSet        []*QuotaUsage `protobuf:"bytes,1,rep,name=quota_usage_set,json=quotaUsageSet,proto3" json:"quota_usage_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DescribeQuotaUsageResponse) Reset()         { *m = DescribeQuotaUsageResponse{} }
+func (m *DescribeQuotaUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeQuotaUsageResponse) ProtoMessage()    {}
+func (*DescribeQuotaUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{85}
+}
+
+func (m *DescribeQuotaUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeQuotaUsageResponse.Unmarshal(m, b)
+}
+func (m *DescribeQuotaUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeQuotaUsageResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeQuotaUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeQuotaUsageResponse.Merge(m, src)
+}
+func (m *DescribeQuotaUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeQuotaUsageResponse.Size(m)
+}
+func (m *DescribeQuotaUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeQuotaUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeQuotaUsageResponse proto.InternalMessageInfo
+
+func (m *DescribeQuotaUsageResponse) GetQuotaUsageSet() []*QuotaUsage {
+	if m != nil {
+		return m.QuotaUsageSet
+	}
+	return nil
+}
+
+type ClusterSnapshot struct {
+	// snapshot id
+	SnapshotId *wrappers.StringValue `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// id of cluster backed up
+	ClusterId *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// snapshot name
+	Name *wrappers.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// snapshot description
+	Description *wrappers.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// roles backed up, separated by comma
+	Roles *wrappers.StringValue `protobuf:"bytes,5,opt,name=roles,proto3" json:"roles,omitempty"`
+	// ids of nodes backed up, separated by comma
+	NodeIds *wrappers.StringValue `protobuf:"bytes,6,opt,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// app id of cluster
+	AppId *wrappers.StringValue `protobuf:"bytes,7,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// app version id of cluster when backed up
+	VersionId *wrappers.StringValue `protobuf:"bytes,8,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// snapshot type eg.[full|incremental]
+	SnapshotType *wrappers.StringValue `protobuf:"bytes,9,opt,name=snapshot_type,json=snapshotType,proto3" json:"snapshot_type,omitempty"`
+	// id of the full snapshot which the incremental snapshot is based on
+	ParentSnapshotId *wrappers.StringValue `protobuf:"bytes,10,opt,name=parent_snapshot_id,json=parentSnapshotId,proto3" json:"parent_snapshot_id,omitempty"`
+	// ids of the incremental snapshots based on the full snapshot, separated by comma
+	ChildSnapshotIds *wrappers.StringValue `protobuf:"bytes,11,opt,name=child_snapshot_ids,json=childSnapshotIds,proto3" json:"child_snapshot_ids,omitempty"`
+	// created by the backup policy of app or not
+	AutoBackup *wrappers.BoolValue `protobuf:"bytes,12,opt,name=auto_backup,json=autoBackup,proto3" json:"auto_backup,omitempty"`
+	// status eg.[pending|active|failed|deleted]
+	Status *wrappers.StringValue `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	// transition status eg.[creating|deleting]
+	TransitionStatus *wrappers.StringValue `protobuf:"bytes,14,opt,name=transition_status,json=transitionStatus,proto3" json:"transition_status,omitempty"`
+	// owner
+	Owner *wrappers.StringValue `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner path, concat string group_path:user_id
+	OwnerPath *wrappers.StringValue `protobuf:"bytes,16,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
+	// the time when snapshot create
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,17,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// record status changed time
+	StatusTime           *timestamp.Timestamp `protobuf:"bytes,18,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ClusterSnapshot) Reset()         { *m = ClusterSnapshot{} }
+func (m *ClusterSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ClusterSnapshot) ProtoMessage()    {}
+func (*ClusterSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{86}
+}
+
+func (m *ClusterSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterSnapshot.Unmarshal(m, b)
+}
+func (m *ClusterSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterSnapshot.Marshal(b, m, deterministic)
+}
+func (m *ClusterSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterSnapshot.Merge(m, src)
+}
+func (m *ClusterSnapshot) XXX_Size() int {
+	return xxx_messageInfo_ClusterSnapshot.Size(m)
+}
+func (m *ClusterSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterSnapshot proto.InternalMessageInfo
+
+func (m *ClusterSnapshot) GetSnapshotId() *wrappers.StringValue {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetRoles() *wrappers.StringValue {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetNodeIds() *wrappers.StringValue {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetAppId() *wrappers.StringValue {
+	if m != nil {
+		return m.AppId
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetVersionId() *wrappers.StringValue {
+	if m != nil {
+		return m.VersionId
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetSnapshotType() *wrappers.StringValue {
+	if m != nil {
+		return m.SnapshotType
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetParentSnapshotId() *wrappers.StringValue {
+	if m != nil {
+		return m.ParentSnapshotId
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetChildSnapshotIds() *wrappers.StringValue {
+	if m != nil {
+		return m.ChildSnapshotIds
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetAutoBackup() *wrappers.BoolValue {
+	if m != nil {
+		return m.AutoBackup
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetTransitionStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.TransitionStatus
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetOwnerPath() *wrappers.StringValue {
+	if m != nil {
+		return m.OwnerPath
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ClusterSnapshot) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type CreateClusterSnapshotRequest struct {
+	// required, id of cluster to back up
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// snapshot name
+	Name *wrappers.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// snapshot description
+	Description *wrappers.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// roles to back up, default back up all the roles with backup service
+	Role []string `protobuf:"bytes,4,rep,name=role,proto3" json:"role,omitempty"`
+	// back up incrementally based on the last full snapshot if app supports incremental backup
+	Incremental *wrappers.BoolValue `protobuf:"bytes,5,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CreateClusterSnapshotRequest) Reset()         { *m = CreateClusterSnapshotRequest{} }
+func (m *CreateClusterSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateClusterSnapshotRequest) ProtoMessage()    {}
+func (*CreateClusterSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{87}
+}
+
+func (m *CreateClusterSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateClusterSnapshotRequest.Unmarshal(m, b)
+}
+func (m *CreateClusterSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateClusterSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateClusterSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClusterSnapshotRequest.Merge(m, src)
+}
+func (m *CreateClusterSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateClusterSnapshotRequest.Size(m)
+}
+func (m *CreateClusterSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClusterSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClusterSnapshotRequest proto.InternalMessageInfo
+
+func (m *CreateClusterSnapshotRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotRequest) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotRequest) GetDescription() *wrappers.StringValue {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotRequest) GetRole() []string {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotRequest) GetIncremental() *wrappers.BoolValue {
+	if m != nil {
+		return m.Incremental
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
+type CreateClusterSnapshotResponse struct {
+	// id of cluster backed up
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of snapshot created
+	SnapshotId *wrappers.StringValue `protobuf:"bytes,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// job id
+	JobId *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,4,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CreateClusterSnapshotResponse) Reset()         { *m = CreateClusterSnapshotResponse{} }
+func (m *CreateClusterSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateClusterSnapshotResponse) ProtoMessage()    {}
+func (*CreateClusterSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{88}
+}
+
+func (m *CreateClusterSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateClusterSnapshotResponse.Unmarshal(m, b)
+}
+func (m *CreateClusterSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateClusterSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateClusterSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClusterSnapshotResponse.Merge(m, src)
+}
+func (m *CreateClusterSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateClusterSnapshotResponse.Size(m)
+}
+func (m *CreateClusterSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClusterSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClusterSnapshotResponse proto.InternalMessageInfo
+
+func (m *CreateClusterSnapshotResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotResponse) GetSnapshotId() *wrappers.StringValue {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *CreateClusterSnapshotResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
+type DescribeClusterSnapshotsRequest struct {
+	// snapshot ids
+	SnapshotId []string `protobuf:"bytes,1,rep,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// ids of clusters backed up
+	ClusterId []string `protobuf:"bytes,2,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// snapshot types eg.[full|incremental]
+	SnapshotType []string `protobuf:"bytes,3,rep,name=snapshot_type,json=snapshotType,proto3" json:"snapshot_type,omitempty"`
+	// ids of the full snapshots which the incremental snapshots are based on
+	ParentSnapshotId []string `protobuf:"bytes,4,rep,name=parent_snapshot_id,json=parentSnapshotId,proto3" json:"parent_snapshot_id,omitempty"`
+	// status eg.[pending|active|failed|deleted]
+	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	// owners
+	Owner []string `protobuf:"bytes,6,rep,name=owner,proto3" json:"owner,omitempty"`
+	// data limit per page, default value 20, max value 200
+	Limit uint32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	// data offset, default 0
+	Offset uint32 `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	// select columns to display
+	DisplayColumns []string `protobuf:"bytes,9,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
+	// sort key, order by sort_key, default create_time
+	SortKey *wrappers.StringValue `protobuf:"bytes,10,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	// value = 0 sort ASC, value = 1 sort DESC
+	Reverse              *wrappers.BoolValue `protobuf:"bytes,11,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DescribeClusterSnapshotsRequest) Reset()         { *m = DescribeClusterSnapshotsRequest{} }
+func (m *DescribeClusterSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterSnapshotsRequest) ProtoMessage()    {}
+func (*DescribeClusterSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{89}
+}
+
+func (m *DescribeClusterSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterSnapshotsRequest.Unmarshal(m, b)
+}
+func (m *DescribeClusterSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterSnapshotsRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterSnapshotsRequest.Merge(m, src)
+}
+func (m *DescribeClusterSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterSnapshotsRequest.Size(m)
+}
+func (m *DescribeClusterSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterSnapshotsRequest proto.InternalMessageInfo
+
+func (m *DescribeClusterSnapshotsRequest) GetSnapshotId() []string {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetClusterId() []string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetSnapshotType() []string {
+	if m != nil {
+		return m.SnapshotType
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetParentSnapshotId() []string {
+	if m != nil {
+		return m.ParentSnapshotId
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetOwner() []string {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetDisplayColumns() []string {
+	if m != nil {
+		return m.DisplayColumns
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetSortKey() *wrappers.StringValue {
+	if m != nil {
+		return m.SortKey
+	}
+	return nil
+}
+
+func (m *DescribeClusterSnapshotsRequest) GetReverse() *wrappers.BoolValue {
+	if m != nil {
+		return m.Reverse
+	}
+	return nil
+}
+
+type DescribeClusterSnapshotsResponse struct {
+	// total count of qualified snapshot
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// list of snapshot
+	ClusterSnapshotSet   []*ClusterSnapshot `protobuf:"bytes,2,rep,name=cluster_snapshot_set,json=clusterSnapshotSet,proto3" json:"cluster_snapshot_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DescribeClusterSnapshotsResponse) Reset()         { *m = DescribeClusterSnapshotsResponse{} }
+func (m *DescribeClusterSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterSnapshotsResponse) ProtoMessage()    {}
+func (*DescribeClusterSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{90}
+}
+
+func (m *DescribeClusterSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterSnapshotsResponse.Unmarshal(m, b)
+}
+func (m *DescribeClusterSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterSnapshotsResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterSnapshotsResponse.Merge(m, src)
+}
+func (m *DescribeClusterSnapshotsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterSnapshotsResponse.Size(m)
+}
+func (m *DescribeClusterSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterSnapshotsResponse proto.InternalMessageInfo
+
+func (m *DescribeClusterSnapshotsResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeClusterSnapshotsResponse) GetClusterSnapshotSet() []*ClusterSnapshot {
+	if m != nil {
+		return m.ClusterSnapshotSet
+	}
+	return nil
+}
+
+type RestoreClusterFromSnapshotRequest struct {
+	// required, id of snapshot to restore cluster from
+	SnapshotId *wrappers.StringValue `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun               *wrappers.BoolValue `protobuf:"bytes,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RestoreClusterFromSnapshotRequest) Reset()         { *m = RestoreClusterFromSnapshotRequest{} }
+func (m *RestoreClusterFromSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreClusterFromSnapshotRequest) ProtoMessage()    {}
+func (*RestoreClusterFromSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{91}
+}
+
+func (m *RestoreClusterFromSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreClusterFromSnapshotRequest.Unmarshal(m, b)
+}
+func (m *RestoreClusterFromSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreClusterFromSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreClusterFromSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreClusterFromSnapshotRequest.Merge(m, src)
+}
+func (m *RestoreClusterFromSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreClusterFromSnapshotRequest.Size(m)
+}
+func (m *RestoreClusterFromSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreClusterFromSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreClusterFromSnapshotRequest proto.InternalMessageInfo
+
+func (m *RestoreClusterFromSnapshotRequest) GetSnapshotId() *wrappers.StringValue {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+func (m *RestoreClusterFromSnapshotRequest) GetDryRun() *wrappers.BoolValue {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
+type RestoreClusterFromSnapshotResponse struct {
+	// id of cluster restored
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of snapshot restored from
+	SnapshotId *wrappers.StringValue `protobuf:"bytes,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// job id
+	JobId *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// task layers the job would be split into, set in dry run
+	TaskLayer            *TaskLayer `protobuf:"bytes,4,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RestoreClusterFromSnapshotResponse) Reset()         { *m = RestoreClusterFromSnapshotResponse{} }
+func (m *RestoreClusterFromSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreClusterFromSnapshotResponse) ProtoMessage()    {}
+func (*RestoreClusterFromSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{92}
+}
+
+func (m *RestoreClusterFromSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreClusterFromSnapshotResponse.Unmarshal(m, b)
+}
+func (m *RestoreClusterFromSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreClusterFromSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreClusterFromSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreClusterFromSnapshotResponse.Merge(m, src)
+}
+func (m *RestoreClusterFromSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreClusterFromSnapshotResponse.Size(m)
+}
+func (m *RestoreClusterFromSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreClusterFromSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreClusterFromSnapshotResponse proto.InternalMessageInfo
+
+func (m *RestoreClusterFromSnapshotResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *RestoreClusterFromSnapshotResponse) GetSnapshotId() *wrappers.StringValue {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+func (m *RestoreClusterFromSnapshotResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *RestoreClusterFromSnapshotResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
+type DeleteClusterSnapshotsRequest struct {
+	// required, ids of snapshots to delete, the incremental snapshots based on the full snapshots are deleted too
+	SnapshotId           []string `protobuf:"bytes,1,rep,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteClusterSnapshotsRequest) Reset()         { *m = DeleteClusterSnapshotsRequest{} }
+func (m *DeleteClusterSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteClusterSnapshotsRequest) ProtoMessage()    {}
+func (*DeleteClusterSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{93}
+}
+
+func (m *DeleteClusterSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteClusterSnapshotsRequest.Unmarshal(m, b)
+}
+func (m *DeleteClusterSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteClusterSnapshotsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteClusterSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteClusterSnapshotsRequest.Merge(m, src)
+}
+func (m *DeleteClusterSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteClusterSnapshotsRequest.Size(m)
+}
+func (m *DeleteClusterSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteClusterSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteClusterSnapshotsRequest proto.InternalMessageInfo
+
+func (m *DeleteClusterSnapshotsRequest) GetSnapshotId() []string {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+type DeleteClusterSnapshotsResponse struct {
+	// ids of snapshots deleted
+	SnapshotId []string `protobuf:"bytes,1,rep,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// ids of jobs
+	JobId                []string `protobuf:"bytes,2,rep,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteClusterSnapshotsResponse) Reset()         { *m = DeleteClusterSnapshotsResponse{} }
+func (m *DeleteClusterSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteClusterSnapshotsResponse) ProtoMessage()    {}
+func (*DeleteClusterSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{94}
+}
+
+func (m *DeleteClusterSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteClusterSnapshotsResponse.Unmarshal(m, b)
+}
+func (m *DeleteClusterSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteClusterSnapshotsResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteClusterSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteClusterSnapshotsResponse.Merge(m, src)
+}
+func (m *DeleteClusterSnapshotsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteClusterSnapshotsResponse.Size(m)
+}
+func (m *DeleteClusterSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteClusterSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteClusterSnapshotsResponse proto.InternalMessageInfo
+
+func (m *DeleteClusterSnapshotsResponse) GetSnapshotId() []string {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+func (m *DeleteClusterSnapshotsResponse) GetJobId() []string {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type ModifyClusterSnapshotRequest struct {
+	// required, snapshot to modify
+	ClusterSnapshot      *ClusterSnapshot `protobuf:"bytes,1,opt,name=cluster_snapshot,json=clusterSnapshot,proto3" json:"cluster_snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ModifyClusterSnapshotRequest) Reset()         { *m = ModifyClusterSnapshotRequest{} }
+func (m *ModifyClusterSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyClusterSnapshotRequest) ProtoMessage()    {}
+func (*ModifyClusterSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{95}
+}
+
+func (m *ModifyClusterSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyClusterSnapshotRequest.Unmarshal(m, b)
+}
+func (m *ModifyClusterSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyClusterSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyClusterSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyClusterSnapshotRequest.Merge(m, src)
+}
+func (m *ModifyClusterSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyClusterSnapshotRequest.Size(m)
+}
+func (m *ModifyClusterSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyClusterSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyClusterSnapshotRequest proto.InternalMessageInfo
+
+func (m *ModifyClusterSnapshotRequest) GetClusterSnapshot() *ClusterSnapshot {
+	if m != nil {
+		return m.ClusterSnapshot
+	}
+	return nil
+}
+
+type ModifyClusterSnapshotResponse struct {
+	// id of snapshot modified
+	SnapshotId           *wrappers.StringValue `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ModifyClusterSnapshotResponse) Reset()         { *m = ModifyClusterSnapshotResponse{} }
+func (m *ModifyClusterSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyClusterSnapshotResponse) ProtoMessage()    {}
+func (*ModifyClusterSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{96}
+}
+
+func (m *ModifyClusterSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyClusterSnapshotResponse.Unmarshal(m, b)
+}
+func (m *ModifyClusterSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyClusterSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyClusterSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyClusterSnapshotResponse.Merge(m, src)
+}
+func (m *ModifyClusterSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyClusterSnapshotResponse.Size(m)
+}
+func (m *ModifyClusterSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyClusterSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyClusterSnapshotResponse proto.InternalMessageInfo
+
+func (m *ModifyClusterSnapshotResponse) GetSnapshotId() *wrappers.StringValue {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return nil
+}
+
+type ClusterServiceAudit struct {
+	// cluster service audit id
+	ClusterServiceAuditId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_service_audit_id,json=clusterServiceAuditId,proto3" json:"cluster_service_audit_id,omitempty"`
+	// id of cluster service run on
+	ClusterId *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of job running service
+	JobId *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// name of service, restart or the custom service defined by app
+	ServiceName *wrappers.StringValue `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// roles service run on, separated by comma
+	Roles *wrappers.StringValue `protobuf:"bytes,5,opt,name=roles,proto3" json:"roles,omitempty"`
+	// ids of nodes service run on, separated by comma
+	NodeIds *wrappers.StringValue `protobuf:"bytes,6,opt,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// params of service in json format
+	ServiceParams *wrappers.StringValue `protobuf:"bytes,7,opt,name=service_params,json=serviceParams,proto3" json:"service_params,omitempty"`
+	// status eg.[pending|successful|failed]
+	Status *wrappers.StringValue `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// owner
+	Owner *wrappers.StringValue `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner path, concat string group_path:user_id
+	OwnerPath *wrappers.StringValue `protobuf:"bytes,10,opt,name=owner_path,json=ownerPath,proto3" json:"owner_path,omitempty"`
+	// the time when service run
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// record status changed time
+	StatusTime           *timestamp.Timestamp `protobuf:"bytes,12,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ClusterServiceAudit) Reset()         { *m = ClusterServiceAudit{} }
+func (m *ClusterServiceAudit) String() string { return proto.CompactTextString(m) }
+func (*ClusterServiceAudit) ProtoMessage()    {}
+func (*ClusterServiceAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{97}
+}
+
+func (m *ClusterServiceAudit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterServiceAudit.Unmarshal(m, b)
+}
+func (m *ClusterServiceAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterServiceAudit.Marshal(b, m, deterministic)
+}
+func (m *ClusterServiceAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterServiceAudit.Merge(m, src)
+}
+func (m *ClusterServiceAudit) XXX_Size() int {
+	return xxx_messageInfo_ClusterServiceAudit.Size(m)
+}
+func (m *ClusterServiceAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterServiceAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterServiceAudit proto.InternalMessageInfo
+
+func (m *ClusterServiceAudit) GetClusterServiceAuditId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterServiceAuditId
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetServiceName() *wrappers.StringValue {
+	if m != nil {
+		return m.ServiceName
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetRoles() *wrappers.StringValue {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetNodeIds() *wrappers.StringValue {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetServiceParams() *wrappers.StringValue {
+	if m != nil {
+		return m.ServiceParams
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetOwner() *wrappers.StringValue {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetOwnerPath() *wrappers.StringValue {
+	if m != nil {
+		return m.OwnerPath
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ClusterServiceAudit) GetStatusTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StatusTime
+	}
+	return nil
+}
+
+type RunClusterServiceRequest struct {
+	// required, id of cluster to run service on
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// required, name of service to run, restart or the custom service defined by app
+	ServiceName *wrappers.StringValue `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// roles to run service on, default run on all the roles with the service
+	Role []string `protobuf:"bytes,3,rep,name=role,proto3" json:"role,omitempty"`
+	// ids of nodes to run service on, the roles are ignored if nodes are given
+	NodeId []string `protobuf:"bytes,4,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// params of service in json format, validated against the service params declared by app
+	ServiceParams        *wrappers.StringValue `protobuf:"bytes,5,opt,name=service_params,json=serviceParams,proto3" json:"service_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RunClusterServiceRequest) Reset()         { *m = RunClusterServiceRequest{} }
+func (m *RunClusterServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*RunClusterServiceRequest) ProtoMessage()    {}
+func (*RunClusterServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{98}
+}
+
+func (m *RunClusterServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunClusterServiceRequest.Unmarshal(m, b)
+}
+func (m *RunClusterServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunClusterServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *RunClusterServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunClusterServiceRequest.Merge(m, src)
+}
+func (m *RunClusterServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_RunClusterServiceRequest.Size(m)
+}
+func (m *RunClusterServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunClusterServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunClusterServiceRequest proto.InternalMessageInfo
+
+func (m *RunClusterServiceRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *RunClusterServiceRequest) GetServiceName() *wrappers.StringValue {
+	if m != nil {
+		return m.ServiceName
+	}
+	return nil
+}
+
+func (m *RunClusterServiceRequest) GetRole() []string {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *RunClusterServiceRequest) GetNodeId() []string {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *RunClusterServiceRequest) GetServiceParams() *wrappers.StringValue {
+	if m != nil {
+		return m.ServiceParams
+	}
+	return nil
+}
+
+type RunClusterServiceResponse struct {
+	// id of cluster service run on
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of cluster service audit recording the run
+	ClusterServiceAuditId *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_service_audit_id,json=clusterServiceAuditId,proto3" json:"cluster_service_audit_id,omitempty"`
+	// job id
+	JobId                *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RunClusterServiceResponse) Reset()         { *m = RunClusterServiceResponse{} }
+func (m *RunClusterServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*RunClusterServiceResponse) ProtoMessage()    {}
+func (*RunClusterServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{99}
+}
+
+func (m *RunClusterServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunClusterServiceResponse.Unmarshal(m, b)
+}
+func (m *RunClusterServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunClusterServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *RunClusterServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunClusterServiceResponse.Merge(m, src)
+}
+func (m *RunClusterServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_RunClusterServiceResponse.Size(m)
+}
+func (m *RunClusterServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunClusterServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunClusterServiceResponse proto.InternalMessageInfo
+
+func (m *RunClusterServiceResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *RunClusterServiceResponse) GetClusterServiceAuditId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterServiceAuditId
+	}
+	return nil
+}
+
+func (m *RunClusterServiceResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type DescribeClusterServiceAuditsRequest struct {
+	// cluster service audit ids
+	ClusterServiceAuditId []string `protobuf:"bytes,1,rep,name=cluster_service_audit_id,json=clusterServiceAuditId,proto3" json:"cluster_service_audit_id,omitempty"`
+	// ids of clusters service run on
+	ClusterId []string `protobuf:"bytes,2,rep,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// names of service
+	ServiceName []string `protobuf:"bytes,3,rep,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// status eg.[pending|successful|failed]
+	Status []string `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty"`
+	// owners
+	Owner []string `protobuf:"bytes,5,rep,name=owner,proto3" json:"owner,omitempty"`
+	// data limit per page, default value 20, max value 200
+	Limit uint32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// data offset, default 0
+	Offset uint32 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	// select columns to display
+	DisplayColumns []string `protobuf:"bytes,8,rep,name=display_columns,json=displayColumns,proto3" json:"display_columns,omitempty"`
+	// sort key, order by sort_key, default create_time
+	SortKey *wrappers.StringValue `protobuf:"bytes,9,opt,name=sort_key,json=sortKey,proto3" json:"sort_key,omitempty"`
+	// value = 0 sort ASC, value = 1 sort DESC
+	Reverse              *wrappers.BoolValue `protobuf:"bytes,10,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DescribeClusterServiceAuditsRequest) Reset()         { *m = DescribeClusterServiceAuditsRequest{} }
+func (m *DescribeClusterServiceAuditsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterServiceAuditsRequest) ProtoMessage()    {}
+func (*DescribeClusterServiceAuditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{100}
+}
+
+func (m *DescribeClusterServiceAuditsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterServiceAuditsRequest.Unmarshal(m, b)
+}
+func (m *DescribeClusterServiceAuditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterServiceAuditsRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterServiceAuditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterServiceAuditsRequest.Merge(m, src)
+}
+func (m *DescribeClusterServiceAuditsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterServiceAuditsRequest.Size(m)
+}
+func (m *DescribeClusterServiceAuditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterServiceAuditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterServiceAuditsRequest proto.InternalMessageInfo
+
+func (m *DescribeClusterServiceAuditsRequest) GetClusterServiceAuditId() []string {
+	if m != nil {
+		return m.ClusterServiceAuditId
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetClusterId() []string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetServiceName() []string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetOwner() []string {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetDisplayColumns() []string {
+	if m != nil {
+		return m.DisplayColumns
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetSortKey() *wrappers.StringValue {
+	if m != nil {
+		return m.SortKey
+	}
+	return nil
+}
+
+func (m *DescribeClusterServiceAuditsRequest) GetReverse() *wrappers.BoolValue {
+	if m != nil {
+		return m.Reverse
+	}
+	return nil
+}
+
+type DescribeClusterServiceAuditsResponse struct {
+	// total count of qualified cluster service audit
+	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// list of cluster service audit
+	ClusterServiceAuditSet []*ClusterServiceAudit `protobuf:"bytes,2,rep,name=cluster_service_audit_set,json=clusterServiceAuditSet,proto3" json:"cluster_service_audit_set,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
+	XXX_unrecognized       []byte                 `json:"-"`
+	XXX_sizecache          int32                  `json:"-"`
+}
+
+func (m *DescribeClusterServiceAuditsResponse) Reset()         { *m = DescribeClusterServiceAuditsResponse{} }
+func (m *DescribeClusterServiceAuditsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterServiceAuditsResponse) ProtoMessage()    {}
+func (*DescribeClusterServiceAuditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{101}
+}
+
+func (m *DescribeClusterServiceAuditsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterServiceAuditsResponse.Unmarshal(m, b)
+}
+func (m *DescribeClusterServiceAuditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterServiceAuditsResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterServiceAuditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterServiceAuditsResponse.Merge(m, src)
+}
+func (m *DescribeClusterServiceAuditsResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterServiceAuditsResponse.Size(m)
+}
+func (m *DescribeClusterServiceAuditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterServiceAuditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterServiceAuditsResponse proto.InternalMessageInfo
+
+func (m *DescribeClusterServiceAuditsResponse) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DescribeClusterServiceAuditsResponse) GetClusterServiceAuditSet() []*ClusterServiceAudit {
+	if m != nil {
+		return m.ClusterServiceAuditSet
+	}
+	return nil
+}
+
+type ModifyClusterServiceAuditRequest struct {
+	// required, cluster service audit to modify
+	ClusterServiceAudit  *ClusterServiceAudit `protobuf:"bytes,1,opt,name=cluster_service_audit,json=clusterServiceAudit,proto3" json:"cluster_service_audit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ModifyClusterServiceAuditRequest) Reset()         { *m = ModifyClusterServiceAuditRequest{} }
+func (m *ModifyClusterServiceAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyClusterServiceAuditRequest) ProtoMessage()    {}
+func (*ModifyClusterServiceAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{102}
+}
+
+func (m *ModifyClusterServiceAuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyClusterServiceAuditRequest.Unmarshal(m, b)
+}
+func (m *ModifyClusterServiceAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyClusterServiceAuditRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyClusterServiceAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyClusterServiceAuditRequest.Merge(m, src)
+}
+func (m *ModifyClusterServiceAuditRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyClusterServiceAuditRequest.Size(m)
+}
+func (m *ModifyClusterServiceAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyClusterServiceAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyClusterServiceAuditRequest proto.InternalMessageInfo
+
+func (m *ModifyClusterServiceAuditRequest) GetClusterServiceAudit() *ClusterServiceAudit {
+	if m != nil {
+		return m.ClusterServiceAudit
+	}
+	return nil
+}
+
+type ModifyClusterServiceAuditResponse struct {
+	// id of cluster service audit modified
+	ClusterServiceAuditId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_service_audit_id,json=clusterServiceAuditId,proto3" json:"cluster_service_audit_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
+}
+
+func (m *ModifyClusterServiceAuditResponse) Reset()         { *m = ModifyClusterServiceAuditResponse{} }
+func (m *ModifyClusterServiceAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyClusterServiceAuditResponse) ProtoMessage()    {}
+func (*ModifyClusterServiceAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{103}
+}
+
+func (m *ModifyClusterServiceAuditResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyClusterServiceAuditResponse.Unmarshal(m, b)
+}
+func (m *ModifyClusterServiceAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyClusterServiceAuditResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyClusterServiceAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyClusterServiceAuditResponse.Merge(m, src)
+}
+func (m *ModifyClusterServiceAuditResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyClusterServiceAuditResponse.Size(m)
+}
+func (m *ModifyClusterServiceAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyClusterServiceAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyClusterServiceAuditResponse proto.InternalMessageInfo
+
+func (m *ModifyClusterServiceAuditResponse) GetClusterServiceAuditId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterServiceAuditId
+	}
+	return nil
+}
+
+type DescribeClusterMonitorRequest struct {
+	// required, id of cluster to monitor
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// roles of nodes to monitor, default all roles
+	Role []string `protobuf:"bytes,2,rep,name=role,proto3" json:"role,omitempty"`
+	// ids of nodes to monitor, default all nodes
+	NodeId []string `protobuf:"bytes,3,rep,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// start time of time series, default one hour before end time
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end time of time series, default now
+	EndTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// seconds between the points of time series, the values in a step are averaged, default no average
+	Step                 *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeClusterMonitorRequest) Reset()         { *m = DescribeClusterMonitorRequest{} }
+func (m *DescribeClusterMonitorRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterMonitorRequest) ProtoMessage()    {}
+func (*DescribeClusterMonitorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{104}
+}
+
+func (m *DescribeClusterMonitorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterMonitorRequest.Unmarshal(m, b)
+}
+func (m *DescribeClusterMonitorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterMonitorRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterMonitorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterMonitorRequest.Merge(m, src)
+}
+func (m *DescribeClusterMonitorRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterMonitorRequest.Size(m)
+}
+func (m *DescribeClusterMonitorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterMonitorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterMonitorRequest proto.InternalMessageInfo
+
+func (m *DescribeClusterMonitorRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorRequest) GetRole() []string {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorRequest) GetNodeId() []string {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorRequest) GetStep() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Step
+	}
+	return nil
+}
+
+type MonitorItem struct {
+	// name of item
+	Name *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// unit of value
+	Unit *wrappers.StringValue `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// type of value eg.[int|str]
+	ValueType *wrappers.StringValue `protobuf:"bytes,3,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	// how the values are counted eg.[latest|delta|rate]
+	StatisticsType *wrappers.StringValue `protobuf:"bytes,4,opt,name=statistics_type,json=statisticsType,proto3" json:"statistics_type,omitempty"`
+	// the values are multiplied by scale factor when displayed
+	ScaleFactorWhenDisplay *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=scale_factor_when_display,json=scaleFactorWhenDisplay,proto3" json:"scale_factor_when_display,omitempty"`
+	// values of str item, whose index is the value of item
+	Enums                []string `protobuf:"bytes,6,rep,name=enums,proto3" json:"enums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonitorItem) Reset()         { *m = MonitorItem{} }
+func (m *MonitorItem) String() string { return proto.CompactTextString(m) }
+func (*MonitorItem) ProtoMessage()    {}
+func (*MonitorItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{105}
+}
+
+func (m *MonitorItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorItem.Unmarshal(m, b)
+}
+func (m *MonitorItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorItem.Marshal(b, m, deterministic)
+}
+func (m *MonitorItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorItem.Merge(m, src)
+}
+func (m *MonitorItem) XXX_Size() int {
+	return xxx_messageInfo_MonitorItem.Size(m)
+}
+func (m *MonitorItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorItem proto.InternalMessageInfo
+
+func (m *MonitorItem) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *MonitorItem) GetUnit() *wrappers.StringValue {
+	if m != nil {
+		return m.Unit
+	}
+	return nil
+}
+
+func (m *MonitorItem) GetValueType() *wrappers.StringValue {
+	if m != nil {
+		return m.ValueType
+	}
+	return nil
+}
+
+func (m *MonitorItem) GetStatisticsType() *wrappers.StringValue {
+	if m != nil {
+		return m.StatisticsType
+	}
+	return nil
+}
+
+func (m *MonitorItem) GetScaleFactorWhenDisplay() *wrappers.UInt32Value {
+	if m != nil {
+		return m.ScaleFactorWhenDisplay
+	}
+	return nil
+}
+
+func (m *MonitorItem) GetEnums() []string {
+	if m != nil {
+		return m.Enums
+	}
+	return nil
+}
+
+type MonitorGroup struct {
+	// name of group
+	Name *wrappers.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// names of items displayed together in group
+	Items                []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonitorGroup) Reset()         { *m = MonitorGroup{} }
+func (m *MonitorGroup) String() string { return proto.CompactTextString(m) }
+func (*MonitorGroup) ProtoMessage()    {}
+func (*MonitorGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{106}
+}
+
+func (m *MonitorGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorGroup.Unmarshal(m, b)
+}
+func (m *MonitorGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorGroup.Marshal(b, m, deterministic)
+}
+func (m *MonitorGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorGroup.Merge(m, src)
+}
+func (m *MonitorGroup) XXX_Size() int {
+	return xxx_messageInfo_MonitorGroup.Size(m)
+}
+func (m *MonitorGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorGroup proto.InternalMessageInfo
+
+func (m *MonitorGroup) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *MonitorGroup) GetItems() []string {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type RoleMonitor struct {
+	// role
+	Role *wrappers.StringValue `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// monitor items of role defined by app
+	ItemSet []*MonitorItem `protobuf:"bytes,2,rep,name=item_set,json=itemSet,proto3" json:"item_set,omitempty"`
+	// monitor groups of role defined by app
+	GroupSet []*MonitorGroup `protobuf:"bytes,3,rep,name=group_set,json=groupSet,proto3" json:"group_set,omitempty"`
+	// names of items and groups to display in order
+	Display              []string `protobuf:"bytes,4,rep,name=display,proto3" json:"display,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleMonitor) Reset()         { *m = RoleMonitor{} }
+func (m *RoleMonitor) String() string { return proto.CompactTextString(m) }
+func (*RoleMonitor) ProtoMessage()    {}
+func (*RoleMonitor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{107}
+}
+
+func (m *RoleMonitor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleMonitor.Unmarshal(m, b)
+}
+func (m *RoleMonitor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleMonitor.Marshal(b, m, deterministic)
+}
+func (m *RoleMonitor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleMonitor.Merge(m, src)
+}
+func (m *RoleMonitor) XXX_Size() int {
+	return xxx_messageInfo_RoleMonitor.Size(m)
+}
+func (m *RoleMonitor) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleMonitor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleMonitor proto.InternalMessageInfo
+
+func (m *RoleMonitor) GetRole() *wrappers.StringValue {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *RoleMonitor) GetItemSet() []*MonitorItem {
+	if m != nil {
+		return m.ItemSet
+	}
+	return nil
+}
+
+func (m *RoleMonitor) GetGroupSet() []*MonitorGroup {
+	if m != nil {
+		return m.GroupSet
+	}
+	return nil
+}
+
+func (m *RoleMonitor) GetDisplay() []string {
+	if m != nil {
+		return m.Display
+	}
+	return nil
+}
+
+type MonitorPoint struct {
+	// the time when value collected
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// value of item, counted by statistics type
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonitorPoint) Reset()         { *m = MonitorPoint{} }
+func (m *MonitorPoint) String() string { return proto.CompactTextString(m) }
+func (*MonitorPoint) ProtoMessage()    {}
+func (*MonitorPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{108}
+}
+
+func (m *MonitorPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorPoint.Unmarshal(m, b)
+}
+func (m *MonitorPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorPoint.Marshal(b, m, deterministic)
+}
+func (m *MonitorPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorPoint.Merge(m, src)
+}
+func (m *MonitorPoint) XXX_Size() int {
+	return xxx_messageInfo_MonitorPoint.Size(m)
+}
+func (m *MonitorPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorPoint proto.InternalMessageInfo
+
+func (m *MonitorPoint) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *MonitorPoint) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type MonitorSeries struct {
+	// name of item
+	Item *wrappers.StringValue `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// points of item in time order
+	PointSet             []*MonitorPoint `protobuf:"bytes,2,rep,name=point_set,json=pointSet,proto3" json:"point_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MonitorSeries) Reset()         { *m = MonitorSeries{} }
+func (m *MonitorSeries) String() string { return proto.CompactTextString(m) }
+func (*MonitorSeries) ProtoMessage()    {}
+func (*MonitorSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{109}
+}
+
+func (m *MonitorSeries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorSeries.Unmarshal(m, b)
+}
+func (m *MonitorSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorSeries.Marshal(b, m, deterministic)
+}
+func (m *MonitorSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorSeries.Merge(m, src)
+}
+func (m *MonitorSeries) XXX_Size() int {
+	return xxx_messageInfo_MonitorSeries.Size(m)
+}
+func (m *MonitorSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorSeries proto.InternalMessageInfo
+
+func (m *MonitorSeries) GetItem() *wrappers.StringValue {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *MonitorSeries) GetPointSet() []*MonitorPoint {
+	if m != nil {
+		return m.PointSet
+	}
+	return nil
+}
+
+type NodeMonitor struct {
+	// id of cluster node
+	NodeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// role of cluster node
+	Role *wrappers.StringValue `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// time series of items
+	SeriesSet            []*MonitorSeries `protobuf:"bytes,3,rep,name=series_set,json=seriesSet,proto3" json:"series_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NodeMonitor) Reset()         { *m = NodeMonitor{} }
+func (m *NodeMonitor) String() string { return proto.CompactTextString(m) }
+func (*NodeMonitor) ProtoMessage()    {}
+func (*NodeMonitor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{110}
+}
+
+func (m *NodeMonitor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeMonitor.Unmarshal(m, b)
+}
+func (m *NodeMonitor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeMonitor.Marshal(b, m, deterministic)
+}
+func (m *NodeMonitor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeMonitor.Merge(m, src)
+}
+func (m *NodeMonitor) XXX_Size() int {
+	return xxx_messageInfo_NodeMonitor.Size(m)
+}
+func (m *NodeMonitor) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeMonitor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeMonitor proto.InternalMessageInfo
+
+func (m *NodeMonitor) GetNodeId() *wrappers.StringValue {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *NodeMonitor) GetRole() *wrappers.StringValue {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *NodeMonitor) GetSeriesSet() []*MonitorSeries {
+	if m != nil {
+		return m.SeriesSet
+	}
+	return nil
+}
+
+type DescribeClusterMonitorResponse struct {
+	// id of cluster monitored
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// monitor definitions of roles
+	RoleMonitorSet []*RoleMonitor `protobuf:"bytes,2,rep,name=role_monitor_set,json=roleMonitorSet,proto3" json:"role_monitor_set,omitempty"`
+	// time series of nodes
+	NodeMonitorSet       []*NodeMonitor `protobuf:"bytes,3,rep,name=node_monitor_set,json=nodeMonitorSet,proto3" json:"node_monitor_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DescribeClusterMonitorResponse) Reset()         { *m = DescribeClusterMonitorResponse{} }
+func (m *DescribeClusterMonitorResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterMonitorResponse) ProtoMessage()    {}
+func (*DescribeClusterMonitorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cfb3b8ec240c376, []int{111}
+}
+
+func (m *DescribeClusterMonitorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterMonitorResponse.Unmarshal(m, b)
+}
+func (m *DescribeClusterMonitorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterMonitorResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterMonitorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterMonitorResponse.Merge(m, src)
+}
+func (m *DescribeClusterMonitorResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterMonitorResponse.Size(m)
+}
+func (m *DescribeClusterMonitorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterMonitorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterMonitorResponse proto.InternalMessageInfo
+
+func (m *DescribeClusterMonitorResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorResponse) GetRoleMonitorSet() []*RoleMonitor {
+	if m != nil {
+		return m.RoleMonitorSet
+	}
+	return nil
+}
+
+func (m *DescribeClusterMonitorResponse) GetNodeMonitorSet() []*NodeMonitor {
+	if m != nil {
+		return m.NodeMonitorSet
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DescribeSubnetsRequest)(nil), "openpitrix.DescribeSubnetsRequest")
+	proto.RegisterType((*Subnet)(nil), "openpitrix.Subnet")
+	proto.RegisterType((*DescribeSubnetsResponse)(nil), "openpitrix.DescribeSubnetsResponse")
+	proto.RegisterType((*DeleteClusterInRuntimeRequest)(nil), "openpitrix.DeleteClusterInRuntimeRequest")
+	proto.RegisterType((*DeleteClusterInRuntimeResponse)(nil), "openpitrix.DeleteClusterInRuntimeResponse")
+	proto.RegisterType((*MigrateClusterInRuntimeRequest)(nil), "openpitrix.MigrateClusterInRuntimeRequest")
+	proto.RegisterType((*MigrateClusterInRuntimeResponse)(nil), "openpitrix.MigrateClusterInRuntimeResponse")
+	proto.RegisterType((*CreateClusterRequest)(nil), "openpitrix.CreateClusterRequest")
+	proto.RegisterType((*CreateClusterResponse)(nil), "openpitrix.CreateClusterResponse")
+	proto.RegisterType((*ModifyClusterRequest)(nil), "openpitrix.ModifyClusterRequest")
+	proto.RegisterType((*ModifyClusterResponse)(nil), "openpitrix.ModifyClusterResponse")
+	proto.RegisterType((*ModifyClusterNodeRequest)(nil), "openpitrix.ModifyClusterNodeRequest")
+	proto.RegisterType((*ModifyClusterNodeResponse)(nil), "openpitrix.ModifyClusterNodeResponse")
+	proto.RegisterType((*ModifyClusterAttributesRequest)(nil), "openpitrix.ModifyClusterAttributesRequest")
+	proto.RegisterType((*ModifyClusterAttributesResponse)(nil), "openpitrix.ModifyClusterAttributesResponse")
+	proto.RegisterType((*ModifyClusterNodeAttributesRequest)(nil), "openpitrix.ModifyClusterNodeAttributesRequest")
+	proto.RegisterType((*ModifyClusterNodeAttributesResponse)(nil), "openpitrix.ModifyClusterNodeAttributesResponse")
+	proto.RegisterType((*AddTableClusterNodesRequest)(nil), "openpitrix.AddTableClusterNodesRequest")
+	proto.RegisterType((*DeleteTableClusterNodesRequest)(nil), "openpitrix.DeleteTableClusterNodesRequest")
+	proto.RegisterType((*DeleteClustersRequest)(nil), "openpitrix.DeleteClustersRequest")
+	proto.RegisterType((*DeleteClustersResponse)(nil), "openpitrix.DeleteClustersResponse")
+	proto.RegisterType((*UpgradeClusterRequest)(nil), "openpitrix.UpgradeClusterRequest")
+	proto.RegisterType((*UpgradeClusterResponse)(nil), "openpitrix.UpgradeClusterResponse")
+	proto.RegisterType((*RollbackClusterRequest)(nil), "openpitrix.RollbackClusterRequest")
+	proto.RegisterType((*RollbackClusterResponse)(nil), "openpitrix.RollbackClusterResponse")
+	proto.RegisterType((*RoleResource)(nil), "openpitrix.RoleResource")
+	proto.RegisterType((*ResizeClusterRequest)(nil), "openpitrix.ResizeClusterRequest")
+	proto.RegisterType((*ResizeClusterResponse)(nil), "openpitrix.ResizeClusterResponse")
+	proto.RegisterType((*AddClusterNodesRequest)(nil), "openpitrix.AddClusterNodesRequest")
+	proto.RegisterType((*AddClusterNodesResponse)(nil), "openpitrix.AddClusterNodesResponse")
+	proto.RegisterType((*DeleteClusterNodesRequest)(nil), "openpitrix.DeleteClusterNodesRequest")
+	proto.RegisterType((*DeleteClusterNodesResponse)(nil), "openpitrix.DeleteClusterNodesResponse")
+	proto.RegisterType((*UpdateClusterEnvRequest)(nil), "openpitrix.UpdateClusterEnvRequest")
+	proto.RegisterType((*UpdateClusterEnvResponse)(nil), "openpitrix.UpdateClusterEnvResponse")
+	proto.RegisterType((*ClusterCommon)(nil), "openpitrix.ClusterCommon")
+	proto.RegisterType((*ClusterNode)(nil), "openpitrix.ClusterNode")
+	proto.RegisterType((*ClusterRole)(nil), "openpitrix.ClusterRole")
+	proto.RegisterType((*ClusterLoadbalancer)(nil), "openpitrix.ClusterLoadbalancer")
+	proto.RegisterType((*ClusterLink)(nil), "openpitrix.ClusterLink")
+	proto.RegisterType((*Cluster)(nil), "openpitrix.Cluster")
+	proto.RegisterType((*DescribeClustersRequest)(nil), "openpitrix.DescribeClustersRequest")
+	proto.RegisterType((*DescribeClustersResponse)(nil), "openpitrix.DescribeClustersResponse")
+	proto.RegisterType((*DescribeAppClustersRequest)(nil), "openpitrix.DescribeAppClustersRequest")
+	proto.RegisterType((*DescribeAppClustersResponse)(nil), "openpitrix.DescribeAppClustersResponse")
+	proto.RegisterType((*DescribeClusterNodesRequest)(nil), "openpitrix.DescribeClusterNodesRequest")
+	proto.RegisterType((*DescribeClusterNodesResponse)(nil), "openpitrix.DescribeClusterNodesResponse")
+	proto.RegisterType((*StopClustersRequest)(nil), "openpitrix.StopClustersRequest")
+	proto.RegisterType((*StopClustersResponse)(nil), "openpitrix.StopClustersResponse")
+	proto.RegisterType((*StartClustersRequest)(nil), "openpitrix.StartClustersRequest")
+	proto.RegisterType((*StartClustersResponse)(nil), "openpitrix.StartClustersResponse")
+	proto.RegisterType((*RecoverClustersRequest)(nil), "openpitrix.RecoverClustersRequest")
+	proto.RegisterType((*RecoverClustersResponse)(nil), "openpitrix.RecoverClustersResponse")
+	proto.RegisterType((*CeaseClustersRequest)(nil), "openpitrix.CeaseClustersRequest")
+	proto.RegisterType((*CeaseClustersResponse)(nil), "openpitrix.CeaseClustersResponse")
+	proto.RegisterType((*GetClusterStatisticsRequest)(nil), "openpitrix.GetClusterStatisticsRequest")
+	proto.RegisterType((*GetClusterStatisticsResponse)(nil), "openpitrix.GetClusterStatisticsResponse")
+	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.LastTwoWeekCreatedEntry")
+	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.TopTenAppsEntry")
+	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetClusterStatisticsResponse.TopTenRuntimesEntry")
+	proto.RegisterType((*RunClusterNodeCommandRequest)(nil), "openpitrix.RunClusterNodeCommandRequest")
+	proto.RegisterType((*RunClusterNodeCommandResponse)(nil), "openpitrix.RunClusterNodeCommandResponse")
+	proto.RegisterType((*KeyPair)(nil), "openpitrix.KeyPair")
+	proto.RegisterType((*CreateKeyPairRequest)(nil), "openpitrix.CreateKeyPairRequest")
+	proto.RegisterType((*CreateKeyPairResponse)(nil), "openpitrix.CreateKeyPairResponse")
+	proto.RegisterType((*DescribeKeyPairsRequest)(nil), "openpitrix.DescribeKeyPairsRequest")
+	proto.RegisterType((*DescribeKeyPairsResponse)(nil), "openpitrix.DescribeKeyPairsResponse")
+	proto.RegisterType((*DeleteKeyPairsRequest)(nil), "openpitrix.DeleteKeyPairsRequest")
+	proto.RegisterType((*DeleteKeyPairsResponse)(nil), "openpitrix.DeleteKeyPairsResponse")
+	proto.RegisterType((*AttachKeyPairsRequest)(nil), "openpitrix.AttachKeyPairsRequest")
+	proto.RegisterType((*AttachKeyPairsResponse)(nil), "openpitrix.AttachKeyPairsResponse")
+	proto.RegisterType((*DetachKeyPairsRequest)(nil), "openpitrix.DetachKeyPairsRequest")
+	proto.RegisterType((*DetachKeyPairsResponse)(nil), "openpitrix.DetachKeyPairsResponse")
+	proto.RegisterType((*NodeKeyPair)(nil), "openpitrix.NodeKeyPair")
+	proto.RegisterType((*AddNodeKeyPairsRequest)(nil), "openpitrix.AddNodeKeyPairsRequest")
+	proto.RegisterType((*AddNodeKeyPairsResponse)(nil), "openpitrix.AddNodeKeyPairsResponse")
+	proto.RegisterType((*DeleteNodeKeyPairsRequest)(nil), "openpitrix.DeleteNodeKeyPairsRequest")
+	proto.RegisterType((*DeleteNodeKeyPairsResponse)(nil), "openpitrix.DeleteNodeKeyPairsResponse")
+	proto.RegisterType((*Quota)(nil), "openpitrix.Quota")
+	proto.RegisterType((*CreateQuotaRequest)(nil), "openpitrix.CreateQuotaRequest")
+	proto.RegisterType((*CreateQuotaResponse)(nil), "openpitrix.CreateQuotaResponse")
+	proto.RegisterType((*ModifyQuotaRequest)(nil), "openpitrix.ModifyQuotaRequest")
+	proto.RegisterType((*ModifyQuotaResponse)(nil), "openpitrix.ModifyQuotaResponse")
+	proto.RegisterType((*DeleteQuotasRequest)(nil), "openpitrix.DeleteQuotasRequest")
+	proto.RegisterType((*DeleteQuotasResponse)(nil), "openpitrix.DeleteQuotasResponse")
+	proto.RegisterType((*DescribeQuotasRequest)(nil), "openpitrix.DescribeQuotasRequest")
+	proto.RegisterType((*DescribeQuotasResponse)(nil), "openpitrix.DescribeQuotasResponse")
+	proto.RegisterType((*QuotaUsage)(nil), "openpitrix.QuotaUsage")
+	proto.RegisterType((*DescribeQuotaUsageRequest)(nil), "openpitrix.DescribeQuotaUsageRequest")
+	proto.RegisterType((*DescribeQuotaUsageResponse)(nil), "openpitrix.DescribeQuotaUsageResponse")
+	proto.RegisterType((*ClusterSnapshot)(nil), "openpitrix.ClusterSnapshot")
+	proto.RegisterType((*CreateClusterSnapshotRequest)(nil), "openpitrix.CreateClusterSnapshotRequest")
+	proto.RegisterType((*CreateClusterSnapshotResponse)(nil), "openpitrix.CreateClusterSnapshotResponse")
+	proto.RegisterType((*DescribeClusterSnapshotsRequest)(nil), "openpitrix.DescribeClusterSnapshotsRequest")
+	proto.RegisterType((*DescribeClusterSnapshotsResponse)(nil), "openpitrix.DescribeClusterSnapshotsResponse")
+	proto.RegisterType((*RestoreClusterFromSnapshotRequest)(nil), "openpitrix.RestoreClusterFromSnapshotRequest")
+	proto.RegisterType((*RestoreClusterFromSnapshotResponse)(nil), "openpitrix.RestoreClusterFromSnapshotResponse")
+	proto.RegisterType((*DeleteClusterSnapshotsRequest)(nil), "openpitrix.DeleteClusterSnapshotsRequest")
+	proto.RegisterType((*DeleteClusterSnapshotsResponse)(nil), "openpitrix.DeleteClusterSnapshotsResponse")
+	proto.RegisterType((*ModifyClusterSnapshotRequest)(nil), "openpitrix.ModifyClusterSnapshotRequest")
+	proto.RegisterType((*ModifyClusterSnapshotResponse)(nil), "openpitrix.ModifyClusterSnapshotResponse")
+	proto.RegisterType((*ClusterServiceAudit)(nil), "openpitrix.ClusterServiceAudit")
+	proto.RegisterType((*RunClusterServiceRequest)(nil), "openpitrix.RunClusterServiceRequest")
+	proto.RegisterType((*RunClusterServiceResponse)(nil), "openpitrix.RunClusterServiceResponse")
+	proto.RegisterType((*DescribeClusterServiceAuditsRequest)(nil), "openpitrix.DescribeClusterServiceAuditsRequest")
+	proto.RegisterType((*DescribeClusterServiceAuditsResponse)(nil), "openpitrix.DescribeClusterServiceAuditsResponse")
+	proto.RegisterType((*ModifyClusterServiceAuditRequest)(nil), "openpitrix.ModifyClusterServiceAuditRequest")
+	proto.RegisterType((*ModifyClusterServiceAuditResponse)(nil), "openpitrix.ModifyClusterServiceAuditResponse")
+	proto.RegisterType((*DescribeClusterMonitorRequest)(nil), "openpitrix.DescribeClusterMonitorRequest")
+	proto.RegisterType((*MonitorItem)(nil), "openpitrix.MonitorItem")
+	proto.RegisterType((*MonitorGroup)(nil), "openpitrix.MonitorGroup")
+	proto.RegisterType((*RoleMonitor)(nil), "openpitrix.RoleMonitor")
+	proto.RegisterType((*MonitorPoint)(nil), "openpitrix.MonitorPoint")
+	proto.RegisterType((*MonitorSeries)(nil), "openpitrix.MonitorSeries")
+	proto.RegisterType((*NodeMonitor)(nil), "openpitrix.NodeMonitor")
+	proto.RegisterType((*DescribeClusterMonitorResponse)(nil), "openpitrix.DescribeClusterMonitorResponse")
+}
+
+func init() { proto.RegisterFile("cluster.proto", fileDescriptor_3cfb3b8ec240c376) }
+
+var fileDescriptor_3cfb3b8ec240c376 = []byte{
+	// 7639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5b, 0x8c, 0x1b, 0xc9,
+	0x75, 0xe8, 0x6d, 0x72, 0x86, 0x9c, 0x39, 0x1c, 0x72, 0x46, 0xa5, 0x79, 0x70, 0x38, 0x7a, 0x50,
+	0xad, 0x7d, 0x68, 0xe5, 0x59, 0x49, 0xab, 0x7d, 0xaf, 0x56, 0xde, 0xe5, 0x4a, 0xda, 0xdd, 0x59,
+	0x4b, 0xbb, 0xba, 0x1c, 0x69, 0xd7, 0x77, 0xef, 0xda, 0x74, 0x0f, 0xbb, 0x66, 0xa6, 0x3d, 0x64,
+	0x77, 0x6f, 0x77, 0x73, 0xa4, 0xd9, 0x9f, 0x0b, 0x18, 0xf7, 0x5e, 0x3f, 0x03, 0x24, 0x93, 0x38,
+	0x6f, 0x04, 0x0e, 0x8c, 0x24, 0x4e, 0x10, 0x23, 0x6b, 0x07, 0x49, 0x60, 0x04, 0x81, 0x1d, 0x27,
+	0x76, 0x02, 0x04, 0x4e, 0x02, 0xc4, 0x40, 0x12, 0x03, 0x01, 0x12, 0x7b, 0x93, 0x0f, 0x03, 0x09,
+	0x8c, 0x20, 0x41, 0x3e, 0xf2, 0x13, 0xd4, 0xa3, 0xbb, 0xab, 0x9a, 0x4d, 0xb2, 0x48, 0x8e, 0x14,
+	0x0b, 0xfe, 0x9a, 0x61, 0xf7, 0x39, 0xa7, 0x4e, 0x9d, 0x3a, 0xaf, 0xaa, 0x3a, 0x55, 0x0d, 0xc5,
+	0x66, 0xab, 0xe3, 0x07, 0xd8, 0x3b, 0xe3, 0x7a, 0x4e, 0xe0, 0x20, 0x70, 0x5c, 0x6c, 0xbb, 0x56,
+	0xe0, 0x59, 0xb7, 0x2b, 0x2b, 0x5b, 0x8e, 0xb3, 0xd5, 0xc2, 0x67, 0xe9, 0x9b, 0x8d, 0xce, 0xe6,
+	0x59, 0xdc, 0x76, 0x83, 0x3d, 0x06, 0x58, 0x39, 0x96, 0x7c, 0x79, 0xcb, 0x33, 0x5c, 0x17, 0x7b,
+	0x3e, 0x7f, 0x7f, 0x3c, 0xf9, 0x3e, 0xb0, 0xda, 0xd8, 0x0f, 0x8c, 0xb6, 0xcb, 0x01, 0x20, 0x30,
+	0xfc, 0x1d, 0xfe, 0xff, 0x11, 0x0e, 0x6c, 0xb8, 0xd6, 0x59, 0xc3, 0xb6, 0x9d, 0xc0, 0x08, 0x2c,
+	0xc7, 0x0e, 0x49, 0xad, 0xd2, 0x3f, 0xcd, 0x87, 0xb7, 0xb0, 0xfd, 0xb0, 0x7f, 0xcb, 0xd8, 0xda,
+	0xc2, 0xde, 0x59, 0xc7, 0xa5, 0x10, 0xdd, 0xd0, 0xfa, 0xcf, 0x67, 0x60, 0xf1, 0x32, 0xf6, 0x9b,
+	0x9e, 0xb5, 0x81, 0xd7, 0x3b, 0x1b, 0x36, 0x0e, 0xfc, 0x3a, 0x7e, 0xbb, 0x83, 0xfd, 0x00, 0x5d,
+	0x00, 0xf0, 0x3a, 0x36, 0x61, 0xa4, 0x61, 0x99, 0x65, 0xad, 0xaa, 0x9d, 0x2a, 0x9c, 0x3f, 0x72,
+	0x86, 0xb5, 0x7d, 0x26, 0x64, 0xf4, 0xcc, 0x7a, 0xe0, 0x59, 0xf6, 0xd6, 0xeb, 0x46, 0xab, 0x83,
+	0xeb, 0xd3, 0x1c, 0x7e, 0xcd, 0x44, 0xf3, 0x30, 0xd9, 0xb2, 0xda, 0x56, 0x50, 0xce, 0x54, 0xb5,
+	0x53, 0xc5, 0x3a, 0xfb, 0x81, 0x16, 0x21, 0xe7, 0x6c, 0x6e, 0xfa, 0x38, 0x28, 0x67, 0xe9, 0x63,
+	0xfe, 0x0b, 0x5d, 0x84, 0x82, 0x4f, 0x1b, 0x6f, 0x04, 0x7b, 0x2e, 0x2e, 0x4f, 0xf4, 0x68, 0xeb,
+	0xe6, 0x9a, 0x1d, 0x3c, 0x7a, 0x9e, 0xb5, 0x05, 0x0c, 0xe1, 0xc6, 0x9e, 0x8b, 0xd1, 0x0a, 0x4c,
+	0x73, 0x74, 0xcb, 0x2c, 0x4f, 0x56, 0xb3, 0xa7, 0xa6, 0xeb, 0x53, 0xec, 0xc1, 0x9a, 0x89, 0x10,
+	0x4c, 0xbc, 0xe3, 0xd8, 0xb8, 0x9c, 0xa3, 0xcf, 0xe9, 0xff, 0xe8, 0x7e, 0x28, 0x19, 0xe6, 0xae,
+	0x61, 0x37, 0xb1, 0xd9, 0x70, 0x0d, 0xcf, 0x68, 0x97, 0xf3, 0xf4, 0x6d, 0x31, 0x7c, 0x7a, 0x9d,
+	0x3c, 0xd4, 0xbf, 0x92, 0x85, 0x1c, 0x13, 0x0a, 0x7a, 0x5a, 0x6c, 0x42, 0x45, 0x16, 0x31, 0x03,
+	0xe7, 0x60, 0xc2, 0x36, 0xda, 0xb8, 0x9c, 0x51, 0xc0, 0xa2, 0x90, 0x04, 0x83, 0xb2, 0x9c, 0x55,
+	0xc1, 0xa0, 0x1d, 0xba, 0x00, 0x85, 0xa6, 0x87, 0x8d, 0x00, 0x37, 0x88, 0xfc, 0xb9, 0x00, 0x2b,
+	0x5d, 0x88, 0x37, 0x42, 0xad, 0xaa, 0x03, 0x03, 0x27, 0x0f, 0xd0, 0xfb, 0xa1, 0x60, 0x52, 0x15,
+	0xa0, 0x5a, 0x52, 0x9e, 0x54, 0x68, 0x55, 0x44, 0x40, 0xc7, 0xa1, 0x60, 0xd9, 0x7e, 0x40, 0x04,
+	0x47, 0xa4, 0xc3, 0x04, 0x0d, 0xe1, 0xa3, 0x35, 0x13, 0x3d, 0x0a, 0xb9, 0x5d, 0xb7, 0x49, 0xde,
+	0xe5, 0x15, 0x68, 0x4f, 0xee, 0xba, 0xcd, 0x35, 0x33, 0xa9, 0x13, 0x53, 0xc3, 0xe9, 0x84, 0xde,
+	0x86, 0xa5, 0x2e, 0xbd, 0xf6, 0x5d, 0xc7, 0xf6, 0x31, 0xe1, 0x37, 0x70, 0x02, 0xa3, 0xd5, 0x68,
+	0x3a, 0x1d, 0x3b, 0xa0, 0xa3, 0x59, 0xac, 0x03, 0x7d, 0x74, 0x89, 0x3c, 0x41, 0x8f, 0x00, 0xa7,
+	0xd4, 0x20, 0xaa, 0x9a, 0xa9, 0x66, 0x4f, 0x15, 0xce, 0xa3, 0x33, 0xb1, 0xad, 0x9f, 0x61, 0x14,
+	0xeb, 0x5c, 0x25, 0xd6, 0x71, 0xa0, 0xbf, 0x1f, 0x8e, 0x5e, 0xc6, 0x2d, 0x1c, 0xe0, 0x4b, 0xcc,
+	0x41, 0xac, 0xd9, 0x75, 0x66, 0x0b, 0xa1, 0x35, 0x1d, 0x4d, 0x58, 0x13, 0x91, 0x51, 0x6c, 0x2f,
+	0xfa, 0x73, 0x70, 0xac, 0x17, 0x3e, 0xe7, 0x7a, 0x00, 0x81, 0x16, 0x1c, 0xbb, 0x66, 0x6d, 0x79,
+	0x46, 0x6f, 0x0e, 0x1e, 0x80, 0xd9, 0x4d, 0xcf, 0x69, 0x37, 0x12, 0x46, 0x3d, 0x5d, 0x2f, 0x92,
+	0xc7, 0xf5, 0xc8, 0x74, 0x75, 0x28, 0x06, 0x8e, 0x08, 0x95, 0xa1, 0x50, 0x85, 0xc0, 0x89, 0x60,
+	0xf4, 0x36, 0x1c, 0xef, 0xd9, 0x1a, 0xe7, 0xf7, 0x20, 0x9b, 0xfb, 0xcb, 0x0c, 0xcc, 0x5f, 0xf2,
+	0x70, 0xdc, 0x5c, 0xd8, 0xa7, 0x47, 0x21, 0x67, 0xb8, 0xae, 0xaa, 0x4d, 0x4e, 0x1a, 0xae, 0xbb,
+	0x66, 0x12, 0xc7, 0xb6, 0x8b, 0x3d, 0xdf, 0x72, 0xec, 0xb0, 0xb9, 0x81, 0x8e, 0x8d, 0xc3, 0x33,
+	0x64, 0x81, 0xd7, 0xec, 0x70, 0x5e, 0xf1, 0x1c, 0x4c, 0x34, 0x1d, 0x7b, 0xb3, 0x3c, 0xa1, 0x80,
+	0x46, 0x21, 0x53, 0x3c, 0xd5, 0x64, 0x8a, 0xa7, 0x8a, 0x3c, 0x46, 0x4e, 0xd5, 0x63, 0xe8, 0x9f,
+	0xd4, 0x60, 0x21, 0x21, 0x52, 0x3e, 0x70, 0x17, 0x00, 0x78, 0x94, 0x53, 0xf6, 0xfb, 0x1c, 0x9e,
+	0x99, 0xfa, 0x47, 0x9d, 0x0d, 0x55, 0xb9, 0x4e, 0x7e, 0xd4, 0xd9, 0x58, 0x33, 0xf5, 0x2f, 0x67,
+	0x61, 0xfe, 0x9a, 0x63, 0x5a, 0x9b, 0x7b, 0x89, 0xe1, 0x7d, 0x18, 0xf2, 0x9c, 0x34, 0xe7, 0xe3,
+	0xb0, 0x68, 0x85, 0x21, 0x70, 0x08, 0x83, 0x6a, 0x30, 0x17, 0x72, 0x6e, 0x3b, 0x26, 0x16, 0xac,
+	0x77, 0x29, 0x05, 0xef, 0x55, 0xc7, 0xc4, 0xf5, 0x52, 0x33, 0xfe, 0xb1, 0x8e, 0x03, 0x91, 0x84,
+	0xe7, 0xb4, 0x18, 0x89, 0x6c, 0x4f, 0x12, 0x75, 0xa7, 0x15, 0x93, 0x20, 0x3f, 0x12, 0x24, 0x5a,
+	0x96, 0xbd, 0x43, 0x49, 0x4c, 0xf4, 0x24, 0x71, 0xd5, 0xb2, 0x77, 0x22, 0x12, 0xe4, 0x07, 0x21,
+	0xf1, 0x12, 0xa0, 0x90, 0x44, 0xd3, 0x69, 0xb7, 0x1d, 0x9b, 0x12, 0x99, 0xa4, 0x44, 0x96, 0x53,
+	0x88, 0x5c, 0xa2, 0x40, 0xf5, 0xb9, 0xa6, 0xf8, 0x93, 0x10, 0xfa, 0x5f, 0x50, 0x8e, 0x78, 0x71,
+	0x0c, 0x73, 0xc3, 0x68, 0x11, 0xa5, 0xf1, 0x28, 0xb9, 0x1c, 0x25, 0x77, 0x3c, 0x8d, 0x27, 0x01,
+	0xb4, 0xbe, 0xd8, 0xec, 0x7e, 0x48, 0x3c, 0xde, 0x0d, 0x58, 0x48, 0x8c, 0xd9, 0x01, 0xe8, 0x8f,
+	0xfe, 0x3a, 0x94, 0x25, 0xaa, 0x74, 0x90, 0xb8, 0x36, 0x3c, 0x03, 0x33, 0xe2, 0xf0, 0x72, 0xd2,
+	0x3d, 0x87, 0xb6, 0x20, 0x0c, 0xad, 0x5e, 0x87, 0xe5, 0x14, 0xba, 0x9c, 0xe3, 0xc7, 0x21, 0x4f,
+	0xf5, 0x45, 0x91, 0xdd, 0x1c, 0x01, 0x5e, 0x33, 0xf5, 0xbf, 0xd0, 0xe0, 0x98, 0x44, 0xb4, 0x16,
+	0x04, 0x9e, 0xb5, 0xd1, 0x09, 0xb0, 0x98, 0x43, 0x8d, 0x6e, 0x4b, 0xc3, 0x27, 0x0e, 0x89, 0x48,
+	0x9e, 0x1d, 0x32, 0x92, 0xeb, 0x1f, 0x86, 0xe3, 0x3d, 0x3b, 0x74, 0x10, 0xa3, 0xfb, 0x63, 0x1a,
+	0xe8, 0x5d, 0xc3, 0xd0, 0x2d, 0xb5, 0xd1, 0xc6, 0x63, 0x78, 0x79, 0xe9, 0x6f, 0xc1, 0xc9, 0xbe,
+	0xec, 0x8c, 0xa7, 0x1f, 0x1f, 0x81, 0x95, 0x9a, 0x69, 0xde, 0x30, 0x36, 0x5a, 0x58, 0xa0, 0x1f,
+	0xf5, 0x32, 0xcd, 0x5b, 0x69, 0x43, 0x79, 0x2b, 0xfd, 0xe9, 0x30, 0x6b, 0xe8, 0xd9, 0xc8, 0x92,
+	0xc8, 0x3a, 0x09, 0x1c, 0x21, 0x73, 0x5f, 0xd6, 0x60, 0x41, 0xca, 0x38, 0x7c, 0x21, 0x53, 0x91,
+	0x46, 0x98, 0x26, 0x1a, 0xb1, 0x56, 0x76, 0x47, 0xa4, 0x4c, 0x7a, 0x44, 0x9a, 0xdc, 0x74, 0xbc,
+	0x66, 0x98, 0xc4, 0x76, 0xe7, 0xa2, 0x2f, 0x38, 0x4e, 0x8b, 0x47, 0x01, 0x0a, 0x88, 0x4e, 0xc0,
+	0xcc, 0x96, 0x67, 0x34, 0x71, 0xc3, 0xc5, 0x9e, 0xe5, 0x98, 0x34, 0x48, 0x16, 0xeb, 0x05, 0xfa,
+	0xec, 0x3a, 0x7d, 0xa4, 0xbf, 0x0a, 0x8b, 0x49, 0x9e, 0xe3, 0xec, 0xa8, 0x1f, 0xd3, 0x0b, 0x42,
+	0x58, 0x22, 0xaf, 0x78, 0xe0, 0xf9, 0x67, 0x0d, 0x16, 0x6e, 0xba, 0x5b, 0x9e, 0x61, 0x26, 0x13,
+	0x8b, 0xb1, 0x0c, 0x77, 0xac, 0x04, 0xa3, 0x5b, 0xbe, 0xd9, 0x34, 0xf9, 0x3e, 0x0a, 0x79, 0xd3,
+	0xdb, 0x23, 0x79, 0x53, 0x79, 0x62, 0xa0, 0x84, 0x73, 0xa6, 0xb7, 0x57, 0xef, 0xd8, 0xfa, 0xd7,
+	0x34, 0x58, 0x4c, 0xf6, 0xf7, 0xbf, 0x2b, 0xea, 0xa3, 0xc7, 0x80, 0x4e, 0x6a, 0x1b, 0x2d, 0x63,
+	0x0f, 0x7b, 0x5c, 0x4d, 0x16, 0x44, 0xcd, 0xbf, 0x61, 0xf8, 0x3b, 0x57, 0xc9, 0xcb, 0xfa, 0x74,
+	0x10, 0xfe, 0xab, 0xff, 0x87, 0x06, 0x8b, 0x75, 0xa7, 0xd5, 0xda, 0x30, 0x9a, 0x3b, 0x07, 0x39,
+	0x66, 0x8a, 0x6a, 0x2d, 0x88, 0x3d, 0xab, 0x2a, 0x76, 0x74, 0x19, 0x66, 0x3d, 0xdc, 0xc2, 0x86,
+	0x8f, 0x1b, 0x7c, 0x9c, 0xf9, 0x98, 0xad, 0x74, 0x21, 0x0b, 0xb3, 0x99, 0x12, 0xc7, 0x79, 0x9d,
+	0xa1, 0xe8, 0x7f, 0xa8, 0xc1, 0x52, 0x57, 0xcf, 0xef, 0xb1, 0xd1, 0xfb, 0x4e, 0x06, 0x66, 0x68,
+	0xd2, 0x84, 0x7d, 0xa7, 0x43, 0x8c, 0xfe, 0x1c, 0x4c, 0x78, 0x4e, 0x0b, 0x2b, 0xb1, 0x4c, 0x21,
+	0xd1, 0x19, 0xc8, 0x36, 0xdd, 0x4e, 0x39, 0xa3, 0x30, 0x1f, 0x24, 0x80, 0x04, 0x7e, 0xcb, 0xed,
+	0x94, 0xb3, 0x2a, 0xf0, 0x5b, 0x6e, 0x07, 0x3d, 0x06, 0xb9, 0x36, 0x6e, 0x3b, 0xde, 0x9e, 0xd2,
+	0x32, 0x04, 0x87, 0x45, 0x35, 0x28, 0x46, 0x73, 0x60, 0xdf, 0x7a, 0x07, 0x97, 0x27, 0x15, 0x90,
+	0x67, 0x42, 0x94, 0x75, 0xeb, 0x1d, 0x8c, 0x9e, 0x83, 0x19, 0x3f, 0x70, 0x3c, 0x63, 0x8b, 0x53,
+	0xc8, 0x29, 0x50, 0x28, 0x70, 0x0c, 0x42, 0x40, 0xff, 0x17, 0x0d, 0xe6, 0xeb, 0x98, 0xe0, 0x1e,
+	0xa4, 0x61, 0x5c, 0x84, 0x22, 0xcd, 0x84, 0x3d, 0x3e, 0x64, 0x3c, 0xa3, 0x2e, 0x8b, 0x63, 0x2d,
+	0x0e, 0x69, 0x7d, 0xc6, 0x13, 0x07, 0x58, 0x71, 0x02, 0x23, 0xd8, 0x55, 0x4e, 0xd9, 0x9d, 0x7d,
+	0x55, 0x83, 0x85, 0x44, 0x87, 0xef, 0x31, 0x7b, 0xf8, 0x5c, 0x06, 0x16, 0x6b, 0xa6, 0x99, 0x16,
+	0xb9, 0xc7, 0x4d, 0x1d, 0xa9, 0x59, 0x65, 0x94, 0xcd, 0xea, 0x02, 0x00, 0x4d, 0x14, 0xd8, 0x9a,
+	0x88, 0x8a, 0xb5, 0x4c, 0x13, 0x78, 0xb6, 0x60, 0xd2, 0x3d, 0xc8, 0x13, 0x03, 0x06, 0x79, 0x52,
+	0x79, 0x90, 0x89, 0xdb, 0xeb, 0x12, 0xd1, 0x3d, 0x36, 0xcc, 0xdf, 0xd2, 0x60, 0x59, 0x4a, 0x5c,
+	0x0e, 0x6e, 0xa4, 0x85, 0x04, 0x2f, 0x23, 0x26, 0x78, 0x77, 0x34, 0x8f, 0xf8, 0x63, 0x0d, 0x2a,
+	0x69, 0xfd, 0xb9, 0xc7, 0x86, 0xe5, 0xbb, 0x1a, 0x2c, 0xdd, 0x74, 0xcd, 0x78, 0x0d, 0xe4, 0x8a,
+	0xbd, 0x7b, 0x20, 0x83, 0x72, 0x06, 0xb2, 0xd8, 0xde, 0x55, 0xea, 0x00, 0x01, 0xbc, 0xa3, 0x63,
+	0xf5, 0x75, 0x0d, 0xca, 0xdd, 0x9d, 0xbc, 0xc7, 0x46, 0xea, 0x4b, 0x25, 0x28, 0x4a, 0x6b, 0x1d,
+	0x77, 0xdb, 0x3d, 0xbe, 0x06, 0x0b, 0x3e, 0xf6, 0x76, 0x69, 0x6b, 0x8d, 0x8e, 0xeb, 0x62, 0xaf,
+	0xb1, 0xe1, 0x74, 0x6c, 0x53, 0xc9, 0x53, 0x22, 0x86, 0xba, 0x66, 0xde, 0x24, 0x88, 0x2f, 0x10,
+	0x3c, 0xf4, 0x12, 0xcc, 0x45, 0x43, 0x6e, 0x34, 0xe9, 0x96, 0x8c, 0xd2, 0xb2, 0xe0, 0x6c, 0x88,
+	0x55, 0x63, 0x48, 0x24, 0x6d, 0xb0, 0x6c, 0x8b, 0x2c, 0x55, 0x7b, 0xbb, 0x56, 0x13, 0xab, 0x2d,
+	0xdf, 0x13, 0x8c, 0x75, 0x86, 0x40, 0x52, 0x17, 0x3f, 0x30, 0xbc, 0x98, 0x82, 0xca, 0x22, 0xe2,
+	0x0c, 0x45, 0x09, 0x49, 0xb0, 0xd4, 0xc5, 0x8d, 0x28, 0xa8, 0x2c, 0xf3, 0x93, 0xd4, 0xc5, 0x0d,
+	0x09, 0xbc, 0x0c, 0x87, 0xfc, 0xa6, 0xd1, 0xc2, 0x0d, 0xa7, 0x13, 0xf3, 0x31, 0xa5, 0x22, 0x0e,
+	0x8a, 0xf6, 0x5a, 0x27, 0x62, 0xe5, 0x45, 0x98, 0x63, 0x94, 0x2c, 0x3b, 0x22, 0x34, 0xad, 0x40,
+	0xa8, 0x44, 0xb1, 0xd6, 0xec, 0x90, 0xce, 0x15, 0x92, 0xb3, 0xcb, 0x72, 0x01, 0x15, 0x32, 0x1c,
+	0x49, 0x20, 0x63, 0x62, 0x3f, 0xf0, 0x9c, 0xbd, 0x88, 0x4c, 0x41, 0x85, 0x0c, 0x47, 0x12, 0xc8,
+	0x74, 0xd8, 0xbc, 0x2d, 0x22, 0x33, 0xa3, 0x42, 0x86, 0x23, 0x85, 0x64, 0x2e, 0x41, 0xa9, 0xd9,
+	0xf1, 0x03, 0xa7, 0x1d, 0x51, 0x29, 0x2a, 0x50, 0x29, 0x32, 0x1c, 0x81, 0x08, 0x99, 0x82, 0x74,
+	0xe2, 0xe1, 0x2e, 0xa9, 0x10, 0x61, 0x38, 0x09, 0xf1, 0x3a, 0x5e, 0xdc, 0xa1, 0x59, 0x55, 0xf1,
+	0x3a, 0x5e, 0xd4, 0xa1, 0x1b, 0xb0, 0x64, 0xd2, 0x38, 0xd4, 0xf0, 0x6d, 0xc3, 0xf5, 0xb7, 0x9d,
+	0x78, 0xb4, 0xe6, 0x14, 0xc8, 0x2d, 0x30, 0xe4, 0x75, 0x8e, 0x2b, 0xa8, 0xf3, 0x36, 0x36, 0x5a,
+	0xc1, 0x76, 0xa3, 0xb9, 0x8d, 0x9b, 0x3b, 0xe5, 0x43, 0x2a, 0xea, 0xcc, 0x30, 0x2e, 0x11, 0x04,
+	0xf4, 0x04, 0xe4, 0xdb, 0x8e, 0x6d, 0x05, 0x8e, 0x57, 0x46, 0x0a, 0xb8, 0x21, 0x30, 0xba, 0x0c,
+	0x25, 0xd7, 0xf0, 0x7d, 0x77, 0xdb, 0x33, 0x7c, 0xdc, 0xc2, 0xbe, 0x5f, 0x3e, 0xac, 0x22, 0x14,
+	0x19, 0x87, 0x08, 0x65, 0x17, 0x7b, 0x81, 0xd5, 0x34, 0x5a, 0x0d, 0xa2, 0xd5, 0x96, 0xbd, 0xd5,
+	0x70, 0x9d, 0x96, 0xd5, 0xdc, 0x2b, 0xcf, 0xab, 0x08, 0x25, 0x44, 0x5e, 0x67, 0xb8, 0xd7, 0x29,
+	0x2a, 0xba, 0x04, 0xb3, 0xc6, 0x16, 0xb6, 0x83, 0x06, 0x9d, 0xb4, 0xb4, 0x5a, 0xd8, 0x2c, 0x2f,
+	0x0c, 0x0c, 0x42, 0x25, 0x8a, 0xb2, 0x16, 0x62, 0xa0, 0x3a, 0x2c, 0x72, 0x05, 0x6c, 0xe3, 0xc0,
+	0x30, 0x8d, 0xc0, 0x68, 0xb0, 0xd5, 0xc7, 0xf2, 0xa2, 0x02, 0x67, 0xf3, 0x0c, 0xf7, 0x1a, 0x47,
+	0x5d, 0xa7, 0x98, 0xe8, 0x49, 0x98, 0xb2, 0xda, 0x64, 0xd6, 0x64, 0x99, 0xe5, 0x25, 0x15, 0x69,
+	0x53, 0xe8, 0x35, 0x93, 0x38, 0x3e, 0xae, 0xc8, 0x5c, 0x3a, 0x65, 0x15, 0xc7, 0xc7, 0x50, 0xb8,
+	0x50, 0xde, 0x82, 0x23, 0x96, 0xdd, 0xf4, 0x70, 0x1b, 0xdb, 0x64, 0x43, 0x31, 0xb4, 0x8b, 0x8e,
+	0xeb, 0x3a, 0x5e, 0x80, 0xcd, 0xf2, 0xf2, 0x40, 0x09, 0x55, 0x04, 0xfc, 0x17, 0x98, 0x89, 0x84,
+	0xd8, 0xe8, 0x59, 0x80, 0xed, 0x3d, 0x97, 0x28, 0xa5, 0xef, 0x78, 0xe5, 0x8a, 0x02, 0x77, 0x02,
+	0xbc, 0xfe, 0x83, 0x22, 0x14, 0x84, 0xf4, 0x6c, 0xd4, 0x55, 0x55, 0x39, 0xd0, 0x66, 0x46, 0x5b,
+	0xc2, 0xce, 0x2a, 0x2f, 0x61, 0x5f, 0x94, 0x37, 0x93, 0x55, 0x42, 0xa2, 0xb8, 0xd5, 0xfc, 0x34,
+	0x4c, 0xef, 0x3a, 0xad, 0x0e, 0xdb, 0x9d, 0x53, 0x09, 0x85, 0x53, 0x0c, 0x9c, 0x66, 0x26, 0x39,
+	0x13, 0x2b, 0x07, 0x40, 0x0e, 0x2b, 0x17, 0x06, 0xe4, 0x87, 0x2a, 0x0c, 0xb8, 0x00, 0xe0, 0x7a,
+	0xd6, 0x2e, 0xd9, 0xb5, 0xb7, 0x5c, 0xa5, 0x68, 0x37, 0xcd, 0xe1, 0xd7, 0x5c, 0x9a, 0x62, 0x5a,
+	0xae, 0x52, 0x68, 0x23, 0x80, 0x94, 0xcf, 0x30, 0x81, 0x29, 0x83, 0x42, 0xd2, 0x32, 0x15, 0x26,
+	0x2d, 0x51, 0xb6, 0x54, 0x50, 0xce, 0x96, 0x1e, 0x83, 0x9c, 0x1f, 0x18, 0x41, 0xc7, 0x57, 0x8a,
+	0x52, 0x1c, 0x16, 0xad, 0xc1, 0xa1, 0xc0, 0x33, 0x6c, 0xdf, 0x22, 0x89, 0x4d, 0x83, 0x13, 0x50,
+	0x09, 0x50, 0x73, 0x31, 0xda, 0x3a, 0x23, 0xf5, 0x24, 0x4c, 0x6d, 0x79, 0x4e, 0x87, 0xee, 0x0c,
+	0x97, 0x14, 0x3a, 0x9b, 0xa7, 0xd0, 0x6c, 0x4c, 0x9c, 0x5b, 0x36, 0xf6, 0x1a, 0xae, 0x11, 0x6c,
+	0x2b, 0x85, 0xa4, 0x69, 0x0a, 0x7f, 0xdd, 0x08, 0xb6, 0x49, 0xee, 0xb1, 0xd5, 0x72, 0x36, 0x88,
+	0xdb, 0x8d, 0x44, 0x3d, 0xa7, 0xd0, 0x7a, 0x89, 0x61, 0xad, 0x87, 0x02, 0xbf, 0x02, 0xb3, 0x09,
+	0x2f, 0xa9, 0x14, 0x82, 0x4a, 0xb2, 0x7b, 0x24, 0x06, 0xef, 0x76, 0x36, 0x1a, 0x3b, 0x78, 0x4f,
+	0x29, 0x0a, 0xe5, 0xdc, 0xce, 0xc6, 0x07, 0x30, 0x5d, 0xca, 0xe2, 0xd1, 0x8f, 0x0f, 0x81, 0x4a,
+	0x0c, 0xe2, 0x01, 0x33, 0x12, 0xff, 0xb4, 0xe5, 0x73, 0x6f, 0x58, 0x9e, 0x1f, 0xe8, 0x03, 0xa7,
+	0x2c, 0x9f, 0xb9, 0x3e, 0x52, 0xc7, 0x62, 0x74, 0x02, 0x27, 0x44, 0x1d, 0x1c, 0x60, 0x80, 0x80,
+	0xc7, 0xc8, 0x62, 0x11, 0xcc, 0xe2, 0x50, 0x45, 0x30, 0x17, 0xa0, 0xc0, 0xba, 0xcb, 0x90, 0x97,
+	0x06, 0x23, 0x33, 0x70, 0x8a, 0xfc, 0x38, 0xe4, 0xb7, 0x1d, 0x9f, 0xba, 0x00, 0x95, 0x18, 0x92,
+	0x23, 0xc0, 0x6b, 0x66, 0x8c, 0xe6, 0x96, 0x97, 0x95, 0xd1, 0x5c, 0x71, 0x1f, 0x94, 0xda, 0x65,
+	0xa5, 0xe7, 0x3e, 0x28, 0x5d, 0x97, 0x2b, 0x08, 0xfb, 0xd3, 0xe8, 0x79, 0x28, 0xc9, 0x3b, 0xcb,
+	0xe5, 0x95, 0xaa, 0xd6, 0x7f, 0x57, 0xb9, 0x28, 0xed, 0x2a, 0xa3, 0x63, 0x50, 0xd8, 0xc1, 0x7b,
+	0x0d, 0xd7, 0xb0, 0xa8, 0x7e, 0x1f, 0x61, 0x5b, 0x2d, 0x3b, 0x78, 0xef, 0xba, 0x61, 0x11, 0xe5,
+	0x3d, 0x0f, 0x93, 0xd4, 0x22, 0xca, 0x47, 0x55, 0x26, 0x85, 0x14, 0x14, 0xbd, 0x08, 0x87, 0xc4,
+	0x84, 0x8b, 0x0d, 0xc1, 0xb1, 0x81, 0x43, 0x30, 0x2b, 0xe4, 0x5c, 0xe4, 0xa9, 0xfe, 0x8d, 0x5c,
+	0x14, 0xf2, 0xea, 0x7c, 0x51, 0xeb, 0x6e, 0x4e, 0x12, 0xf9, 0xd2, 0x74, 0x76, 0xc8, 0xa5, 0xe9,
+	0x89, 0xe1, 0x97, 0xa6, 0x27, 0xc7, 0x59, 0x9a, 0xce, 0x8d, 0xbd, 0x34, 0x9d, 0x1f, 0x72, 0x69,
+	0x9a, 0x44, 0xf5, 0xb6, 0xd3, 0xb1, 0x83, 0x86, 0xeb, 0x58, 0x76, 0xa0, 0x14, 0xeb, 0x80, 0x22,
+	0x5c, 0x27, 0xf0, 0xa4, 0x0b, 0x0c, 0x9d, 0x17, 0x32, 0x2a, 0x85, 0xbd, 0x19, 0x8a, 0xf2, 0x1a,
+	0xc3, 0x20, 0x1c, 0x6c, 0x5a, 0xa4, 0xa0, 0x63, 0xcf, 0x0f, 0x70, 0x5b, 0x69, 0x2e, 0x07, 0x04,
+	0x61, 0x9d, 0xc2, 0x87, 0x2b, 0x3a, 0x05, 0xd5, 0x15, 0x9d, 0xa7, 0x60, 0xca, 0xc3, 0x6e, 0xcb,
+	0x6a, 0x1a, 0xbd, 0x63, 0xa0, 0x14, 0x6d, 0x43, 0x68, 0x32, 0xbd, 0xf2, 0xb0, 0x61, 0xee, 0x35,
+	0x22, 0xfc, 0xa2, 0x02, 0x7e, 0x91, 0xe2, 0xd4, 0x43, 0x22, 0x17, 0xa1, 0x60, 0xb8, 0x56, 0xb4,
+	0xdb, 0xa4, 0x32, 0x41, 0x03, 0xc3, 0xb5, 0xc2, 0xad, 0xa6, 0xff, 0xcc, 0xc0, 0xe1, 0x94, 0x5a,
+	0x90, 0xbb, 0x6d, 0x4f, 0xaf, 0x43, 0x59, 0xaa, 0x5a, 0x69, 0x59, 0x7e, 0x80, 0x6d, 0xd6, 0xb8,
+	0x4a, 0x46, 0xb9, 0x28, 0x62, 0x5f, 0xe5, 0xc8, 0x6b, 0x26, 0x49, 0x34, 0x24, 0xba, 0xae, 0xe3,
+	0x05, 0x4a, 0x56, 0x38, 0x27, 0xa2, 0x5d, 0x77, 0xbc, 0x80, 0x4c, 0x68, 0x12, 0xa4, 0xc8, 0xbc,
+	0x40, 0x35, 0xf9, 0x9c, 0x97, 0xe9, 0x11, 0xd4, 0x35, 0x53, 0xff, 0xfd, 0x4c, 0xe4, 0xc5, 0x48,
+	0x41, 0xd0, 0xdd, 0x2e, 0x22, 0xb9, 0x0a, 0x87, 0xf1, 0xed, 0x00, 0x7b, 0x36, 0xa9, 0x90, 0x8c,
+	0xdb, 0x55, 0x11, 0xf8, 0xa1, 0x10, 0xf1, 0x92, 0xb8, 0x17, 0x2e, 0x24, 0x54, 0x13, 0xc3, 0x25,
+	0x54, 0x51, 0x2c, 0x99, 0x54, 0x8e, 0x25, 0xfa, 0x77, 0x4a, 0x90, 0xe7, 0xcd, 0xdf, 0x63, 0xe5,
+	0x37, 0x42, 0x35, 0xe3, 0xc4, 0xa8, 0xd5, 0x8c, 0x93, 0xc3, 0x15, 0x1b, 0x48, 0xb3, 0x97, 0xdc,
+	0x50, 0xb3, 0x97, 0x91, 0x8a, 0x7a, 0x9f, 0x83, 0x99, 0x4d, 0xcf, 0xb1, 0x83, 0x2d, 0x3a, 0xe9,
+	0x31, 0x95, 0x02, 0x41, 0x21, 0xc2, 0x60, 0x04, 0xc2, 0x11, 0xa5, 0x65, 0xc1, 0xd3, 0x2a, 0x91,
+	0x88, 0x63, 0xd0, 0x5a, 0xf1, 0x67, 0x60, 0x1a, 0xdb, 0x26, 0x0d, 0x43, 0xbe, 0x52, 0x14, 0x88,
+	0xc1, 0x85, 0x69, 0x4d, 0x61, 0xdc, 0x69, 0xcd, 0xcc, 0x48, 0xd3, 0x9a, 0xab, 0x30, 0x1f, 0xad,
+	0x9b, 0x78, 0x8e, 0x13, 0x34, 0x8c, 0x66, 0x13, 0xfb, 0x61, 0x84, 0xe8, 0x97, 0x27, 0xa3, 0x10,
+	0xaf, 0xee, 0x38, 0x41, 0x8d, 0x62, 0x25, 0x4c, 0xb3, 0x34, 0x9c, 0x69, 0x5e, 0x84, 0x02, 0x9f,
+	0xeb, 0x74, 0x3a, 0x96, 0xa9, 0x34, 0x53, 0x02, 0x86, 0x70, 0xb3, 0x63, 0x99, 0x24, 0xca, 0x45,
+	0x0b, 0x9a, 0x4c, 0x22, 0x2a, 0xeb, 0x75, 0x45, 0x8e, 0xc3, 0xc5, 0x71, 0x11, 0x66, 0x42, 0x22,
+	0x34, 0x63, 0x3c, 0x34, 0x30, 0x63, 0x2c, 0x70, 0x78, 0x9e, 0xf2, 0x8b, 0xa5, 0xbc, 0x68, 0xb8,
+	0x52, 0xde, 0xc4, 0x64, 0xe3, 0xf0, 0x38, 0x93, 0x8d, 0xf9, 0xa1, 0x26, 0x1b, 0x57, 0x60, 0xd6,
+	0x30, 0x4d, 0xaa, 0x16, 0x46, 0xab, 0x61, 0xd9, 0x9b, 0x4e, 0x79, 0x41, 0x81, 0xf7, 0x52, 0x8c,
+	0xb4, 0x66, 0x6f, 0x3a, 0x61, 0x46, 0xb3, 0xa8, 0x9a, 0xd1, 0x9c, 0x83, 0x49, 0x13, 0x6f, 0x74,
+	0xb6, 0xca, 0x4b, 0x03, 0x95, 0x8d, 0x01, 0x46, 0x45, 0xc9, 0x65, 0xe5, 0x63, 0x0c, 0x69, 0x25,
+	0x71, 0xcb, 0xe3, 0x17, 0xf0, 0x56, 0xc6, 0x2f, 0xe0, 0x5d, 0x39, 0x88, 0x02, 0xde, 0x23, 0x07,
+	0x5b, 0xc0, 0x7b, 0x74, 0xac, 0x02, 0xde, 0x38, 0xb8, 0x1e, 0x53, 0x0f, 0xae, 0xff, 0x2f, 0x1f,
+	0x1f, 0xab, 0x18, 0xb2, 0x6e, 0x70, 0x21, 0x0a, 0x6e, 0xbc, 0x04, 0x8f, 0x85, 0xaf, 0xa3, 0x52,
+	0xf8, 0x62, 0xdb, 0x9e, 0x42, 0x80, 0x5a, 0x8c, 0x5c, 0x2e, 0xab, 0x28, 0xe0, 0xbf, 0x12, 0xa7,
+	0x21, 0x26, 0x13, 0xa7, 0x21, 0x48, 0x2d, 0xa1, 0x14, 0x67, 0xd8, 0x99, 0x14, 0x29, 0x92, 0xf4,
+	0x48, 0x73, 0xf2, 0xa3, 0xa5, 0x39, 0xd1, 0x79, 0xa7, 0xa9, 0xf4, 0xf3, 0x4e, 0xd3, 0x5d, 0xe7,
+	0x9d, 0xb0, 0xe1, 0x35, 0xb7, 0x1b, 0xb7, 0x1c, 0xcf, 0x54, 0x9b, 0x8c, 0x30, 0x84, 0x37, 0x1c,
+	0xcf, 0x24, 0xab, 0x5b, 0xbe, 0xe3, 0x05, 0x74, 0x65, 0x47, 0x25, 0x12, 0xe5, 0x09, 0x34, 0x59,
+	0xda, 0x79, 0x0c, 0xf2, 0x1e, 0x26, 0xc2, 0x0d, 0xb7, 0x8f, 0xfa, 0x59, 0x71, 0x08, 0x4a, 0xfa,
+	0xc6, 0x14, 0xa5, 0xc8, 0x06, 0x8e, 0xfe, 0xe8, 0x8a, 0xc4, 0x2a, 0xf1, 0x43, 0x8a, 0xc4, 0x17,
+	0xa0, 0x70, 0xcb, 0x0a, 0xb6, 0x1b, 0x26, 0x0e, 0x0c, 0xab, 0x55, 0x9e, 0x1d, 0xc8, 0x10, 0x10,
+	0xf0, 0xcb, 0x14, 0x9a, 0xb6, 0x4e, 0xfd, 0xa9, 0xd9, 0x30, 0x8d, 0x00, 0x2b, 0x2d, 0xb3, 0x71,
+	0x87, 0x6d, 0x5e, 0x36, 0x02, 0x8c, 0x1e, 0x84, 0x59, 0xd3, 0xf2, 0xdd, 0x96, 0xb1, 0xd7, 0x68,
+	0x92, 0x15, 0x60, 0xdb, 0x2f, 0x1f, 0xa2, 0xdd, 0x2b, 0xf1, 0xc7, 0x97, 0xd8, 0xd3, 0xe8, 0xfc,
+	0x18, 0x12, 0xce, 0x8f, 0xbd, 0x00, 0xb3, 0x6d, 0xcb, 0x6e, 0x0c, 0x17, 0x00, 0x8a, 0x6d, 0xcb,
+	0xbe, 0x24, 0xc6, 0x00, 0x70, 0xc9, 0x84, 0x3a, 0x70, 0x76, 0xb0, 0xad, 0xb4, 0x31, 0x33, 0x4d,
+	0xe0, 0x6f, 0x10, 0x70, 0xfd, 0xf7, 0x34, 0x28, 0x77, 0xdb, 0xa1, 0xea, 0xf9, 0xa6, 0xc7, 0x20,
+	0x1c, 0x08, 0xe1, 0x88, 0x44, 0xea, 0xd1, 0x8a, 0xd0, 0xa2, 0x89, 0xbf, 0xb8, 0x0c, 0xb3, 0x36,
+	0xbe, 0x1d, 0x34, 0x04, 0xae, 0x55, 0x32, 0xdc, 0x22, 0x41, 0xba, 0x1e, 0x71, 0xfe, 0x5e, 0x16,
+	0x2a, 0x21, 0xe7, 0x35, 0xd7, 0x4d, 0x3a, 0x91, 0x05, 0xe1, 0x40, 0x8f, 0xe0, 0x25, 0x62, 0x37,
+	0x90, 0x91, 0xdc, 0x40, 0x64, 0x76, 0xd9, 0x74, 0xb3, 0x9b, 0xe8, 0x67, 0x76, 0x93, 0x63, 0x98,
+	0x5d, 0x6e, 0x44, 0xb3, 0xcb, 0x8f, 0x60, 0x76, 0x53, 0xa2, 0xd9, 0x25, 0xac, 0x66, 0x7a, 0x2c,
+	0xab, 0x81, 0x03, 0xb0, 0x9a, 0x42, 0x9a, 0xd5, 0xe8, 0x01, 0xac, 0xa4, 0x8e, 0xf2, 0x1d, 0x55,
+	0x51, 0xfd, 0x13, 0x13, 0x71, 0xb3, 0x77, 0xaf, 0xd2, 0x2a, 0x56, 0xce, 0x6c, 0xba, 0x72, 0x4e,
+	0xa4, 0x2b, 0xe7, 0x64, 0x3f, 0xe5, 0xcc, 0x8d, 0xa1, 0x9c, 0xf9, 0x11, 0x95, 0x73, 0x6a, 0x04,
+	0xe5, 0x9c, 0x16, 0x95, 0x33, 0x45, 0x3d, 0x20, 0xd5, 0xa9, 0xca, 0xce, 0xaf, 0x30, 0x94, 0xf3,
+	0x43, 0x27, 0x93, 0x1b, 0x14, 0x33, 0xb4, 0x0d, 0x69, 0x0b, 0x42, 0xff, 0x53, 0x0d, 0x8e, 0xa4,
+	0xab, 0x82, 0xaa, 0x0a, 0x1e, 0xc0, 0x69, 0xb2, 0x83, 0x71, 0x99, 0xff, 0x1b, 0x0e, 0xaf, 0x07,
+	0x8e, 0x7b, 0x47, 0xce, 0x69, 0xe8, 0x57, 0x61, 0x5e, 0x26, 0x3e, 0xd6, 0x81, 0x8a, 0xb7, 0x08,
+	0x35, 0xc3, 0x0b, 0xee, 0x0c, 0xaf, 0xd7, 0x60, 0x21, 0x41, 0x7d, 0x2c, 0x66, 0x3f, 0x0c, 0x8b,
+	0x75, 0xdc, 0x74, 0x76, 0xb1, 0x77, 0x67, 0xd8, 0x7d, 0x0d, 0x96, 0xba, 0xe8, 0x8f, 0xc5, 0xf0,
+	0x97, 0x34, 0x98, 0xbf, 0x84, 0x0d, 0xff, 0x5e, 0x3a, 0xb2, 0x73, 0x0d, 0x16, 0x12, 0x2c, 0x8f,
+	0x25, 0x82, 0xa3, 0xb0, 0xf2, 0x12, 0x0e, 0x15, 0x80, 0x98, 0xba, 0xe5, 0x07, 0x56, 0x33, 0x14,
+	0x84, 0xfe, 0xfd, 0x09, 0x38, 0x92, 0xfe, 0x9e, 0xb7, 0xea, 0xc3, 0x42, 0xcb, 0xf0, 0x83, 0x46,
+	0x70, 0xcb, 0x69, 0xdc, 0xc2, 0x78, 0x87, 0xe7, 0x70, 0x26, 0x3f, 0x79, 0xf5, 0xbc, 0x68, 0xd9,
+	0xfd, 0x08, 0x9d, 0xb9, 0x6a, 0xf8, 0xc1, 0x8d, 0x5b, 0xce, 0x1b, 0x18, 0xef, 0xb0, 0xa4, 0xce,
+	0xbc, 0x62, 0x07, 0xde, 0x5e, 0x1d, 0xb5, 0xba, 0x5e, 0xa0, 0x4d, 0x98, 0x0b, 0x1c, 0xb7, 0x11,
+	0x60, 0x3b, 0x3c, 0xe7, 0xec, 0x73, 0x4f, 0xf2, 0xac, 0x72, 0x7b, 0x37, 0x1c, 0xf7, 0x06, 0x0e,
+	0x0f, 0x59, 0xfb, 0xac, 0xad, 0x52, 0x20, 0x3d, 0x24, 0x8e, 0x31, 0x9e, 0x75, 0x86, 0x65, 0xdc,
+	0xc5, 0xfa, 0x4c, 0x34, 0xab, 0x24, 0x6e, 0xed, 0x24, 0x14, 0xc3, 0x99, 0x13, 0x03, 0x62, 0x83,
+	0x36, 0xc3, 0x1f, 0x32, 0xa0, 0x37, 0x61, 0x26, 0xe4, 0xd8, 0x70, 0x5d, 0x9f, 0x1f, 0x3d, 0x7d,
+	0x6a, 0x48, 0x6e, 0x6b, 0xae, 0xcb, 0x39, 0x85, 0x20, 0x7a, 0x50, 0xb9, 0x02, 0x4b, 0x3d, 0x84,
+	0x87, 0xe6, 0x20, 0x4b, 0xe2, 0x17, 0x3b, 0x27, 0x4e, 0xfe, 0x25, 0x71, 0x66, 0x97, 0x68, 0x5c,
+	0x78, 0x8f, 0x04, 0xfd, 0xf1, 0x4c, 0xe6, 0x29, 0xad, 0x52, 0x83, 0xc3, 0x29, 0x32, 0x19, 0x8a,
+	0xc4, 0x45, 0x98, 0x4d, 0x30, 0x3a, 0x0c, 0xba, 0xfe, 0x6d, 0x0d, 0x8e, 0xd4, 0x3b, 0xb6, 0x10,
+	0x00, 0xc8, 0xbc, 0xdd, 0xb0, 0xcd, 0x31, 0xcf, 0x31, 0x3e, 0x01, 0xf9, 0x26, 0x23, 0xa4, 0xb4,
+	0xf6, 0x1c, 0x02, 0x93, 0x85, 0x21, 0x22, 0x08, 0x56, 0x42, 0xd9, 0x74, 0x6c, 0xd3, 0x57, 0xda,
+	0x8a, 0x2c, 0x71, 0xa4, 0x75, 0x86, 0xa3, 0xbf, 0x9b, 0x81, 0xa3, 0x3d, 0xba, 0x35, 0xd6, 0x79,
+	0x48, 0xb6, 0x7c, 0x6a, 0x3a, 0x9d, 0x40, 0xa9, 0x5b, 0x1c, 0x96, 0x63, 0x61, 0xcf, 0x53, 0x0a,
+	0x9d, 0x1c, 0x16, 0x9d, 0x87, 0x1c, 0xbe, 0x6d, 0x11, 0xc3, 0x56, 0xa8, 0x94, 0x66, 0x90, 0xe8,
+	0x29, 0x98, 0x26, 0xff, 0x35, 0x9a, 0x8e, 0x19, 0x96, 0xd1, 0xf6, 0x3d, 0xa0, 0x35, 0x45, 0xa0,
+	0x2f, 0x91, 0xd3, 0xc5, 0xff, 0x96, 0x85, 0xfc, 0x07, 0xd8, 0x0e, 0x38, 0x7a, 0x56, 0xde, 0x1f,
+	0x57, 0x4a, 0x32, 0xe3, 0xdd, 0xf3, 0xbb, 0xbf, 0xe9, 0x20, 0x54, 0x89, 0x4c, 0x0c, 0x51, 0x25,
+	0x22, 0x2f, 0x1e, 0x4f, 0x0e, 0xb7, 0x78, 0x9c, 0x58, 0x3c, 0xcd, 0x8d, 0xb3, 0x78, 0x9a, 0x1f,
+	0x6a, 0xf1, 0x54, 0x48, 0xe2, 0xa7, 0xa4, 0x24, 0xfe, 0x7c, 0x9c, 0xd0, 0x2a, 0xaf, 0x86, 0x7d,
+	0x55, 0x0b, 0xaf, 0xa5, 0xe0, 0x83, 0x1f, 0x1a, 0x7e, 0x38, 0x8a, 0xda, 0xa8, 0xa3, 0x98, 0x19,
+	0x63, 0x14, 0xb3, 0xea, 0xa3, 0xa8, 0xdf, 0x84, 0x85, 0x44, 0x07, 0xb8, 0x89, 0x8f, 0xa5, 0xc5,
+	0xfa, 0x6f, 0x66, 0xe3, 0x65, 0x42, 0x4e, 0x39, 0xca, 0x55, 0x7e, 0x44, 0xec, 0x63, 0x3e, 0xde,
+	0xba, 0x14, 0x26, 0x48, 0x63, 0x4e, 0xf2, 0xa2, 0x19, 0x65, 0x3e, 0x7d, 0x46, 0x39, 0x25, 0xcd,
+	0x28, 0x53, 0x66, 0x63, 0xd3, 0xa9, 0x93, 0x75, 0x0f, 0xca, 0xdd, 0xa3, 0xa5, 0x3a, 0x4d, 0x7a,
+	0x1c, 0x66, 0xa2, 0xf1, 0xec, 0x31, 0x55, 0x0f, 0x95, 0x0b, 0xf8, 0x38, 0x92, 0xa9, 0xfa, 0x93,
+	0xe1, 0xf1, 0xf3, 0xa4, 0x7e, 0x1c, 0x4b, 0xea, 0x87, 0x5c, 0x5f, 0xa4, 0x3f, 0x05, 0x8b, 0x49,
+	0x44, 0xce, 0xea, 0x20, 0xcc, 0xeb, 0xb0, 0x50, 0x0b, 0x02, 0xa3, 0xb9, 0x3d, 0x64, 0x93, 0x3d,
+	0x67, 0xfe, 0xfa, 0x59, 0x58, 0x4c, 0x52, 0xe4, 0xbc, 0xc4, 0xe9, 0xab, 0x26, 0xa6, 0xaf, 0xd7,
+	0x49, 0xaf, 0x0f, 0x9a, 0x85, 0xcb, 0x78, 0x18, 0x16, 0x3e, 0xa6, 0x41, 0x81, 0x04, 0xf5, 0x30,
+	0x5e, 0x8d, 0x18, 0xcc, 0x13, 0x66, 0x9c, 0x19, 0xce, 0x41, 0xdc, 0xa4, 0xc7, 0x1e, 0x05, 0x36,
+	0x84, 0x25, 0x9a, 0x22, 0x65, 0x27, 0x24, 0x9e, 0x76, 0x25, 0x82, 0x80, 0x57, 0x2f, 0xd8, 0xf1,
+	0x0f, 0x7d, 0x99, 0x1e, 0x15, 0x94, 0xc9, 0x32, 0x69, 0xe8, 0x1f, 0x0c, 0x4f, 0xe0, 0x1d, 0x78,
+	0xa3, 0x47, 0xc2, 0xb3, 0x70, 0xa9, 0xed, 0xfe, 0x75, 0x0e, 0x26, 0xff, 0x67, 0xc7, 0x09, 0x0c,
+	0xb2, 0x42, 0xf3, 0x36, 0xf9, 0x47, 0x55, 0xd2, 0x79, 0x0a, 0xcd, 0xf6, 0xbc, 0xfd, 0xce, 0xc6,
+	0x47, 0x71, 0x93, 0x5f, 0x85, 0xa5, 0x14, 0x1c, 0x38, 0x06, 0x5d, 0x69, 0x7f, 0x02, 0xf2, 0xfc,
+	0xa7, 0x92, 0xfb, 0x0b, 0x81, 0x13, 0x1b, 0xa4, 0x13, 0xc3, 0x6d, 0x90, 0x3e, 0x07, 0x33, 0x6d,
+	0xe3, 0x76, 0xb8, 0xb5, 0xe2, 0x2b, 0x95, 0xac, 0x15, 0xda, 0xc6, 0xed, 0x70, 0xa2, 0x48, 0x6a,
+	0x13, 0x08, 0x01, 0x22, 0x6a, 0x5f, 0xa9, 0x66, 0x6d, 0xaa, 0x6d, 0xdc, 0xa6, 0x6b, 0x3c, 0x44,
+	0xa7, 0x69, 0xdb, 0x6e, 0x47, 0xa9, 0x54, 0x2d, 0x47, 0x9a, 0x75, 0x3b, 0xa4, 0xbf, 0x04, 0x8d,
+	0xd7, 0xd8, 0xa9, 0xdc, 0x38, 0x46, 0x38, 0xbc, 0x46, 0xc1, 0xc9, 0x5a, 0x0f, 0x41, 0xe6, 0xd5,
+	0xe7, 0xb4, 0x4c, 0x4e, 0xa5, 0x38, 0xa1, 0xd8, 0x36, 0x6e, 0xbf, 0x4e, 0x71, 0x68, 0xa1, 0x5c,
+	0x22, 0x5a, 0xc1, 0xb0, 0xd1, 0x2a, 0x4a, 0x63, 0x0a, 0xea, 0xd5, 0x97, 0x72, 0x2a, 0x37, 0x33,
+	0x56, 0x2a, 0x57, 0x1c, 0x27, 0x95, 0x2b, 0x0d, 0x93, 0xca, 0xe9, 0x5f, 0x9b, 0x00, 0xc4, 0x92,
+	0x17, 0x6a, 0x5f, 0xa1, 0x2d, 0x27, 0xad, 0x45, 0x1b, 0xc3, 0x5a, 0x32, 0xa3, 0x5b, 0x4b, 0x76,
+	0x3c, 0x6b, 0x99, 0x18, 0xcb, 0x5a, 0x26, 0x47, 0xb5, 0x96, 0xdc, 0xc8, 0xd6, 0x92, 0x1f, 0xdb,
+	0x5a, 0xa6, 0xc6, 0xb6, 0x96, 0xe9, 0x61, 0xef, 0x3b, 0x7a, 0x15, 0x0e, 0x4b, 0x1a, 0xc4, 0x23,
+	0xe7, 0xa8, 0x9e, 0x5a, 0xff, 0xa3, 0x2c, 0x20, 0x76, 0xa1, 0x90, 0xa4, 0x92, 0xe3, 0x78, 0x7e,
+	0x49, 0x2b, 0x32, 0x63, 0x69, 0x45, 0x76, 0x54, 0xad, 0x98, 0x18, 0x59, 0x2b, 0x26, 0xc7, 0xd6,
+	0x8a, 0xdc, 0xd8, 0x5a, 0x91, 0x1f, 0x41, 0x2b, 0xa4, 0x41, 0x1c, 0x57, 0x2b, 0xce, 0xc1, 0x61,
+	0x96, 0x20, 0x50, 0x7a, 0x51, 0xd2, 0xb1, 0x2c, 0xd1, 0x23, 0x19, 0x5a, 0x84, 0xf1, 0x08, 0xcc,
+	0xcb, 0x18, 0x9c, 0x85, 0x3e, 0x28, 0xff, 0x48, 0xef, 0x73, 0x62, 0x49, 0xbc, 0x6a, 0x3b, 0x64,
+	0xfd, 0x36, 0x91, 0x59, 0x90, 0xd7, 0x92, 0x37, 0x2c, 0x8b, 0xb9, 0x03, 0x45, 0xe6, 0x3f, 0x13,
+	0x25, 0x18, 0x13, 0xc9, 0x12, 0x8c, 0x68, 0xae, 0x32, 0x99, 0x3e, 0x57, 0xc9, 0x0d, 0x9a, 0xab,
+	0xe4, 0x53, 0xe7, 0x2a, 0x16, 0x2c, 0x26, 0xbb, 0xa9, 0x3a, 0x53, 0x39, 0x03, 0xd3, 0x4c, 0x10,
+	0xf1, 0x34, 0xe5, 0x90, 0x98, 0xe1, 0xb1, 0xe1, 0x66, 0xc2, 0x22, 0x53, 0x94, 0x7f, 0xcf, 0x00,
+	0xd0, 0x67, 0x37, 0x7d, 0x63, 0x8b, 0xec, 0x7d, 0x4e, 0xd2, 0x57, 0x7c, 0xf0, 0x53, 0x50, 0xd9,
+	0x7b, 0x52, 0xad, 0xde, 0xf1, 0xb1, 0x39, 0x9c, 0xd9, 0xce, 0x10, 0x94, 0xc8, 0x6e, 0x2f, 0x00,
+	0x50, 0x12, 0xea, 0x86, 0x3b, 0x4d, 0xe0, 0x99, 0xe5, 0x3e, 0x09, 0x53, 0xac, 0x7d, 0x45, 0xd3,
+	0xcd, 0xd3, 0xa6, 0xdd, 0x0e, 0x99, 0x9d, 0x52, 0xc4, 0x21, 0x8c, 0x97, 0xb2, 0xc9, 0xad, 0xf7,
+	0x45, 0x98, 0xa3, 0xe8, 0xc3, 0x9a, 0x6f, 0x89, 0x60, 0xc5, 0xf6, 0xab, 0x7f, 0x96, 0xde, 0x96,
+	0x21, 0x8c, 0x31, 0x95, 0xbf, 0xb0, 0x87, 0x2b, 0x64, 0x2b, 0xda, 0xb0, 0xd9, 0x0a, 0x24, 0x6e,
+	0x1a, 0x55, 0x8f, 0xd1, 0xfa, 0x5b, 0x50, 0x49, 0x63, 0x8b, 0xab, 0xdf, 0xfb, 0x61, 0x96, 0x69,
+	0x57, 0xc7, 0x37, 0xb6, 0xc4, 0xdb, 0xdc, 0x16, 0xbb, 0x14, 0x85, 0x21, 0x16, 0xdf, 0x8e, 0xfe,
+	0x27, 0xda, 0xf6, 0xdd, 0x29, 0x98, 0x0d, 0x17, 0xd4, 0xf9, 0x79, 0x64, 0xba, 0x5c, 0xc0, 0xff,
+	0x57, 0xf5, 0x3a, 0x10, 0x22, 0xdc, 0xfd, 0xa3, 0x9b, 0x09, 0xbf, 0x3b, 0x31, 0x42, 0xee, 0xea,
+	0x39, 0xad, 0x3e, 0xe9, 0x8b, 0x94, 0xbb, 0x52, 0x50, 0xa2, 0xeb, 0x7c, 0xf6, 0xea, 0xab, 0xd5,
+	0x64, 0xb0, 0xe9, 0xab, 0x2f, 0xd4, 0x5a, 0xe7, 0x47, 0xad, 0xb5, 0x9e, 0x1a, 0xae, 0xd6, 0x9a,
+	0xdc, 0xb3, 0x10, 0x0e, 0x66, 0xdf, 0xda, 0x65, 0xf9, 0x9e, 0x05, 0x8e, 0x42, 0x9d, 0xf1, 0x2b,
+	0x80, 0x5c, 0xc3, 0xc3, 0x76, 0xd0, 0x10, 0xd5, 0x42, 0x65, 0x92, 0x30, 0xc7, 0xf0, 0xd6, 0x63,
+	0xe5, 0x78, 0x05, 0x50, 0x73, 0xdb, 0x6a, 0x99, 0x22, 0x29, 0xb5, 0xc2, 0xe6, 0x39, 0x8a, 0x17,
+	0x93, 0xf2, 0x93, 0xc7, 0xf6, 0x66, 0x86, 0x3a, 0xb6, 0x17, 0x57, 0x55, 0x17, 0xc7, 0xad, 0xaa,
+	0x2e, 0x8d, 0x54, 0x55, 0x1d, 0xcd, 0x99, 0x66, 0x47, 0x9d, 0x33, 0xcd, 0x8d, 0x35, 0x67, 0x3a,
+	0x34, 0xce, 0x9c, 0x09, 0x0d, 0x35, 0x67, 0xfa, 0x76, 0x06, 0x8e, 0x48, 0xb7, 0xfe, 0x86, 0xa3,
+	0x78, 0x6f, 0x5e, 0x58, 0x4a, 0x8a, 0xf3, 0xe8, 0x99, 0x22, 0x96, 0x7d, 0xd0, 0xff, 0xc9, 0xca,
+	0x94, 0x70, 0xa6, 0x5e, 0xe1, 0xa6, 0x29, 0x11, 0x7c, 0xb4, 0x8b, 0xc8, 0xfe, 0x7f, 0x06, 0x8e,
+	0xf6, 0x10, 0xeb, 0x41, 0x5c, 0xb4, 0x93, 0x08, 0x03, 0x99, 0x21, 0xc3, 0x40, 0x7c, 0x4f, 0x4f,
+	0x76, 0xd4, 0x7b, 0x7a, 0x26, 0x14, 0xef, 0xe9, 0xf9, 0x42, 0x16, 0x8e, 0x27, 0xaa, 0x6e, 0x42,
+	0x51, 0x44, 0xf9, 0xe8, 0xf1, 0x64, 0x50, 0x23, 0x43, 0x27, 0xf2, 0x7b, 0x34, 0x11, 0xb6, 0x12,
+	0x95, 0x01, 0x27, 0x93, 0x7e, 0x94, 0xe5, 0xa5, 0xb2, 0xa7, 0x5c, 0x4d, 0xf5, 0x94, 0x4c, 0x4d,
+	0xba, 0x7d, 0x61, 0x5c, 0xc1, 0x35, 0x99, 0xac, 0xe0, 0x62, 0x9e, 0x21, 0x27, 0x2e, 0xe2, 0xdf,
+	0x99, 0x55, 0x78, 0xa9, 0x82, 0x0b, 0x46, 0xac, 0xe0, 0x2a, 0x28, 0x57, 0x70, 0xe9, 0xfb, 0x1a,
+	0x54, 0x7b, 0x0f, 0x95, 0x6a, 0x4e, 0x7d, 0x0d, 0xe6, 0xc3, 0xb1, 0x12, 0x6e, 0x60, 0x09, 0xd3,
+	0xeb, 0x95, 0x94, 0x42, 0xa9, 0xc8, 0x34, 0x50, 0x53, 0x7e, 0x40, 0x92, 0xa0, 0x9f, 0xd1, 0xe0,
+	0x44, 0x9d, 0x5d, 0xf1, 0xc2, 0xc1, 0x5f, 0xf4, 0x9c, 0x76, 0xd2, 0x49, 0x8d, 0x99, 0x16, 0x09,
+	0x26, 0x9e, 0x51, 0x36, 0xf1, 0x4f, 0x67, 0x40, 0xef, 0xc7, 0xd9, 0x8f, 0x96, 0x9d, 0x3f, 0x9f,
+	0xf8, 0xdc, 0xc1, 0xd0, 0x46, 0xae, 0x7f, 0x10, 0x8e, 0xf5, 0xa2, 0x10, 0xeb, 0x5e, 0x7f, 0x3f,
+	0xd1, 0xa3, 0x44, 0x68, 0x13, 0x8e, 0x48, 0x97, 0x3a, 0x27, 0xb5, 0xe7, 0xc5, 0xb8, 0xae, 0x2f,
+	0x24, 0xc6, 0x07, 0xaa, 0xaf, 0xba, 0xce, 0x26, 0xd4, 0x55, 0xff, 0x30, 0x1c, 0xed, 0xd1, 0x0e,
+	0xef, 0xc0, 0x78, 0x6a, 0xaa, 0xff, 0xeb, 0x64, 0x74, 0x08, 0x97, 0x5f, 0x4c, 0x54, 0xeb, 0x98,
+	0x56, 0x80, 0x6e, 0xc6, 0x47, 0x42, 0xf8, 0x5d, 0x47, 0x0d, 0x83, 0xbc, 0x50, 0x6d, 0x63, 0xa1,
+	0xd9, 0x4d, 0x74, 0xdc, 0xc9, 0xc2, 0x48, 0xaa, 0x47, 0x56, 0x6a, 0x79, 0x07, 0x68, 0xda, 0xa0,
+	0x34, 0x61, 0xe0, 0x18, 0xaf, 0x92, 0xec, 0xe1, 0xae, 0x4e, 0x18, 0x2e, 0x41, 0x29, 0xe4, 0x96,
+	0xd6, 0xd0, 0xf9, 0x4a, 0x13, 0x87, 0x22, 0xc7, 0xa1, 0x15, 0x76, 0xe2, 0x09, 0xc2, 0xa9, 0x21,
+	0x72, 0xdd, 0x11, 0x6a, 0x13, 0x12, 0x09, 0x2a, 0x8c, 0x95, 0xa0, 0x16, 0xc6, 0x49, 0x50, 0x67,
+	0x86, 0x4a, 0x50, 0x3f, 0x95, 0x81, 0x72, 0x5c, 0x7c, 0xc4, 0x55, 0xf4, 0x40, 0x92, 0xd3, 0xa4,
+	0xb6, 0x65, 0x86, 0xd5, 0xb6, 0x30, 0xd7, 0xcc, 0x0a, 0xb9, 0xa6, 0xb0, 0x2d, 0x3b, 0x21, 0x95,
+	0x93, 0x74, 0x6b, 0xcb, 0xe4, 0xd0, 0xda, 0xa2, 0xbf, 0xa7, 0xc1, 0x72, 0x8a, 0x30, 0x0e, 0x22,
+	0xd4, 0xf4, 0x73, 0x22, 0x99, 0xd1, 0x9d, 0xc8, 0x28, 0x7e, 0x40, 0xff, 0xa9, 0x2c, 0x9c, 0x4c,
+	0x66, 0x22, 0x02, 0x59, 0x3f, 0x5e, 0x46, 0xef, 0xe7, 0xf8, 0x88, 0xf4, 0x7b, 0x70, 0x35, 0x20,
+	0xa1, 0x3c, 0x91, 0xd0, 0x8c, 0x2c, 0x5f, 0x05, 0x15, 0xc6, 0xbe, 0xd7, 0x31, 0xb4, 0xf4, 0x2a,
+	0x8f, 0x28, 0x41, 0xcc, 0xa5, 0x27, 0x88, 0xf9, 0x41, 0x09, 0xe2, 0xd4, 0xc0, 0x04, 0x71, 0x7a,
+	0xc4, 0x04, 0x11, 0xd4, 0x13, 0xc4, 0xcf, 0x6b, 0x70, 0x5f, 0xff, 0x61, 0x51, 0x4d, 0x12, 0xdf,
+	0x84, 0xe5, 0xf4, 0x81, 0x8b, 0x33, 0xc5, 0xb4, 0x53, 0x8c, 0x62, 0x6b, 0xd1, 0x29, 0x46, 0xf1,
+	0x21, 0xc9, 0x18, 0x6f, 0x41, 0x55, 0x8e, 0xc2, 0x22, 0x12, 0x57, 0x9c, 0x75, 0x58, 0x48, 0x6d,
+	0x9f, 0x1b, 0xcd, 0xc0, 0xb6, 0x0f, 0xa7, 0xb4, 0xad, 0xbf, 0x03, 0x27, 0xfa, 0x34, 0xcc, 0x45,
+	0x73, 0x67, 0x62, 0xb5, 0xfe, 0xc5, 0x0c, 0x1c, 0x4d, 0x0c, 0xcd, 0x35, 0x76, 0x85, 0xe0, 0x81,
+	0xb8, 0x4a, 0x14, 0xdd, 0xd4, 0x91, 0xea, 0xe9, 0xb2, 0x92, 0xa7, 0x7b, 0x1a, 0x80, 0x5d, 0x93,
+	0xa9, 0xf8, 0xe5, 0xb1, 0x69, 0x0a, 0xcd, 0xaf, 0x4d, 0x9a, 0xc2, 0xb6, 0xc9, 0x10, 0x27, 0x07,
+	0x22, 0xe6, 0xb1, 0x6d, 0x52, 0xb4, 0x73, 0x30, 0xe1, 0x07, 0xd8, 0x55, 0x5a, 0x5b, 0xa6, 0x90,
+	0xfa, 0x7b, 0x19, 0x28, 0x70, 0x01, 0xad, 0x05, 0xb8, 0x3d, 0x42, 0x7d, 0xde, 0x39, 0x98, 0xe8,
+	0xd8, 0x96, 0xda, 0x8e, 0x30, 0x85, 0xa4, 0x6b, 0x85, 0xe4, 0x67, 0x38, 0x47, 0x55, 0x59, 0x2b,
+	0x24, 0x7f, 0xe8, 0xf4, 0xf5, 0x0a, 0xcc, 0xfa, 0x51, 0x55, 0x75, 0xff, 0x8f, 0xe2, 0xc9, 0x97,
+	0x98, 0x46, 0x48, 0x94, 0xcc, 0x1b, 0xb0, 0xcc, 0x2e, 0x43, 0xdd, 0x34, 0x9a, 0x81, 0xe3, 0x35,
+	0x6e, 0x6d, 0x63, 0xbb, 0xc1, 0xbd, 0x8a, 0xd2, 0xf2, 0xfe, 0x22, 0x45, 0x7f, 0x91, 0x62, 0xbf,
+	0xb1, 0x8d, 0xed, 0xcb, 0x0c, 0x97, 0x78, 0x38, 0x6c, 0x77, 0xda, 0x7e, 0x38, 0x31, 0xa6, 0x3f,
+	0xf4, 0xd7, 0x61, 0x86, 0x4b, 0xf9, 0x25, 0xcf, 0xe9, 0xb8, 0x23, 0x88, 0x79, 0x1e, 0x26, 0xad,
+	0x00, 0xb7, 0xc3, 0x63, 0x7e, 0xec, 0x07, 0xb9, 0x6e, 0xba, 0x50, 0x77, 0x5a, 0x98, 0x13, 0x1f,
+	0xe1, 0xa3, 0x01, 0xe7, 0x61, 0x8a, 0x90, 0xea, 0x75, 0x86, 0x47, 0xd0, 0x8d, 0x7a, 0x9e, 0x00,
+	0x92, 0xc3, 0x3b, 0x8f, 0xc3, 0x34, 0xbb, 0x43, 0x2e, 0xfe, 0x06, 0x54, 0x39, 0x05, 0x89, 0x76,
+	0xb5, 0xce, 0xae, 0x9b, 0x23, 0x68, 0x65, 0xc8, 0x87, 0x12, 0x66, 0xb1, 0x22, 0xfc, 0xa9, 0xdf,
+	0x88, 0xc4, 0xc3, 0x6e, 0x35, 0x3a, 0x03, 0x13, 0x54, 0xf5, 0xb5, 0x81, 0xaa, 0x4f, 0xe1, 0xe4,
+	0x4a, 0x74, 0x8d, 0x57, 0xa2, 0xeb, 0xb7, 0xa1, 0xc8, 0xa9, 0xae, 0x63, 0xcf, 0xc2, 0x3e, 0x91,
+	0x0e, 0xe9, 0x82, 0x9a, 0x74, 0x08, 0x24, 0xe9, 0x29, 0xbd, 0xe1, 0x42, 0x10, 0x4f, 0x5a, 0x4f,
+	0x29, 0xd7, 0xf5, 0x29, 0x0a, 0x4a, 0x5c, 0xef, 0xbb, 0xbc, 0x92, 0x2c, 0x1c, 0x96, 0xd1, 0x3f,
+	0xdb, 0x33, 0xe4, 0xbd, 0x40, 0x4f, 0x01, 0xf8, 0xb4, 0xaf, 0xc2, 0xd0, 0x2c, 0xa7, 0x30, 0xcc,
+	0x04, 0x52, 0x9f, 0x66, 0xc0, 0x84, 0xe5, 0xef, 0x69, 0x70, 0xac, 0x97, 0xe3, 0x3c, 0x88, 0xb4,
+	0xaa, 0x06, 0x73, 0x84, 0xc3, 0x06, 0xbf, 0xd0, 0xb5, 0x97, 0xbe, 0x09, 0xca, 0x5c, 0x2f, 0x79,
+	0xf1, 0x0f, 0x7e, 0xfb, 0x00, 0x95, 0xa2, 0x48, 0x22, 0x9b, 0x5e, 0x8e, 0x16, 0x91, 0xb0, 0xe3,
+	0x1f, 0xeb, 0x38, 0x38, 0xff, 0x6b, 0x2f, 0x43, 0x29, 0xec, 0x9d, 0x61, 0x1b, 0x5b, 0xd8, 0x43,
+	0x6f, 0xc2, 0x6c, 0xa2, 0x32, 0x0e, 0xe9, 0x22, 0xb9, 0xf4, 0x6a, 0xbc, 0xca, 0xc9, 0xbe, 0x30,
+	0x5c, 0x62, 0x4d, 0x40, 0xdd, 0x05, 0x70, 0xe8, 0x7e, 0x11, 0xb5, 0x67, 0xe9, 0x5d, 0xe5, 0x81,
+	0x41, 0x60, 0xbc, 0x91, 0x4f, 0x6b, 0x50, 0x94, 0x4a, 0x95, 0x51, 0x55, 0x0a, 0xdb, 0x29, 0x65,
+	0xd8, 0x95, 0x13, 0x7d, 0x20, 0x78, 0x79, 0xde, 0xe3, 0xfb, 0xb5, 0x43, 0x68, 0x96, 0xbd, 0xab,
+	0xee, 0xe0, 0xbd, 0xaa, 0x6b, 0x58, 0xde, 0xc7, 0xfe, 0xea, 0xbd, 0x9f, 0xcc, 0xac, 0xe8, 0x8b,
+	0x67, 0x77, 0x1f, 0x39, 0x1b, 0x6e, 0xe2, 0x9e, 0x0d, 0x6b, 0x03, 0xfd, 0x67, 0xb4, 0xd3, 0xe8,
+	0xfb, 0x1a, 0xcc, 0x25, 0x4b, 0x66, 0xd1, 0x49, 0xb9, 0x2b, 0xa9, 0xe5, 0xcf, 0x95, 0xfb, 0xfa,
+	0x03, 0x71, 0xb6, 0x3e, 0xa5, 0xed, 0xd7, 0x2c, 0xb4, 0xf5, 0x12, 0x0e, 0x22, 0xa6, 0xfc, 0xd5,
+	0x2a, 0xbf, 0x57, 0xb6, 0xba, 0x69, 0xb5, 0x02, 0xec, 0x55, 0xc9, 0xc1, 0xde, 0x6a, 0xb0, 0x8d,
+	0x7d, 0x5c, 0xdd, 0xb4, 0x70, 0xcb, 0xf4, 0x4f, 0x09, 0x15, 0x99, 0xab, 0x55, 0xe2, 0x50, 0x57,
+	0xab, 0x34, 0x23, 0x7d, 0x68, 0xb5, 0x6a, 0xe2, 0x4d, 0xa3, 0xd3, 0x0a, 0xaa, 0x1e, 0x0e, 0x3a,
+	0x9e, 0x5d, 0x35, 0x5a, 0xad, 0x98, 0x32, 0xed, 0x6f, 0x19, 0xf5, 0xe8, 0x2f, 0xfa, 0xac, 0x06,
+	0x25, 0xb9, 0xe4, 0x16, 0x9d, 0xe8, 0x1e, 0xb5, 0x64, 0x47, 0xf5, 0x7e, 0x20, 0xbc, 0x9b, 0xcf,
+	0xee, 0xd7, 0xca, 0x68, 0xf1, 0x05, 0x23, 0x68, 0x6e, 0x57, 0xd9, 0x55, 0xcc, 0x09, 0xa6, 0x56,
+	0x4e, 0xf7, 0x19, 0x84, 0xcf, 0x6b, 0x50, 0x92, 0xcb, 0x6f, 0x65, 0xbe, 0x52, 0x8b, 0x7d, 0x2b,
+	0x7a, 0x3f, 0x10, 0xce, 0xd7, 0x2b, 0xfb, 0xb5, 0x2a, 0x3a, 0xc6, 0xf8, 0x32, 0x28, 0x48, 0xcc,
+	0x57, 0x35, 0x70, 0xaa, 0xc4, 0xde, 0x28, 0x7f, 0x27, 0x9e, 0xd1, 0x4e, 0xeb, 0x47, 0x52, 0x59,
+	0x3c, 0xcb, 0x10, 0xd1, 0xaf, 0x50, 0xe9, 0xf5, 0xe6, 0xf2, 0x32, 0x1e, 0xc8, 0x65, 0x7a, 0x81,
+	0xaf, 0x7e, 0x75, 0xbf, 0xa6, 0xa3, 0x6a, 0x28, 0xbd, 0x04, 0x97, 0x9b, 0x9e, 0xd3, 0x16, 0xf8,
+	0xec, 0xc5, 0x24, 0xc3, 0x23, 0xd2, 0xfc, 0x84, 0x06, 0xb3, 0x89, 0x2f, 0xa6, 0x22, 0x3d, 0x4d,
+	0x59, 0xe5, 0xcf, 0x04, 0x57, 0x4e, 0xf6, 0x85, 0xe1, 0xac, 0xae, 0xee, 0xd7, 0x8a, 0xa8, 0x40,
+	0xd4, 0x99, 0xdd, 0x1f, 0xc5, 0x46, 0x77, 0x11, 0xcd, 0x4b, 0x5c, 0xf1, 0x77, 0xe8, 0xe3, 0x91,
+	0xad, 0x87, 0x17, 0x79, 0xa5, 0xd8, 0xba, 0xfc, 0x8d, 0x9b, 0xca, 0x89, 0x3e, 0x10, 0x9c, 0x89,
+	0x47, 0xf6, 0x6b, 0x73, 0xa8, 0xc4, 0x6d, 0x9d, 0x37, 0xca, 0x54, 0x5f, 0x3f, 0x2c, 0xf1, 0xc1,
+	0x16, 0x33, 0x88, 0x50, 0x7e, 0x56, 0x0b, 0x6b, 0x0c, 0x2f, 0xe3, 0x8d, 0xce, 0xd6, 0x81, 0xb2,
+	0x73, 0x71, 0xbf, 0xb6, 0x88, 0xf8, 0xf9, 0x91, 0x2a, 0xbd, 0x31, 0x47, 0x62, 0xea, 0x18, 0x51,
+	0xad, 0x65, 0xc2, 0x17, 0x7d, 0xd7, 0x48, 0x70, 0x87, 0x6e, 0x40, 0x51, 0x9a, 0x7f, 0xc8, 0x4c,
+	0xa5, 0x7d, 0x4e, 0xb3, 0x72, 0xa2, 0x0f, 0x04, 0x77, 0xb3, 0x1f, 0x81, 0x43, 0x5d, 0x5f, 0xc4,
+	0x43, 0xf7, 0xf5, 0xc4, 0x13, 0x3e, 0xcf, 0x58, 0xb9, 0x7f, 0x00, 0x14, 0x6f, 0xe1, 0x5d, 0x0d,
+	0x96, 0x7a, 0x7c, 0x64, 0x10, 0x9d, 0xee, 0x49, 0xa2, 0xeb, 0x23, 0x81, 0x95, 0xf7, 0x29, 0xc1,
+	0xc6, 0x8e, 0x66, 0x05, 0xf1, 0x2f, 0x40, 0x86, 0x52, 0xae, 0x1a, 0x11, 0x5c, 0xaa, 0x16, 0xb4,
+	0x29, 0x34, 0xd1, 0x82, 0x3f, 0xd7, 0x60, 0xa5, 0xcf, 0x77, 0x02, 0xd1, 0x99, 0xbe, 0x3d, 0xef,
+	0x66, 0xfd, 0xac, 0x32, 0x3c, 0x67, 0xff, 0xd5, 0xfd, 0xda, 0x83, 0xe8, 0x7e, 0xce, 0x3e, 0x31,
+	0x6a, 0x81, 0xf7, 0xaa, 0x65, 0x93, 0x20, 0x20, 0xeb, 0x0e, 0x53, 0x9c, 0x44, 0x57, 0x58, 0x35,
+	0x11, 0xe9, 0xd0, 0x1b, 0x30, 0x9f, 0xf6, 0x65, 0x42, 0xf4, 0x60, 0x22, 0xdc, 0xf7, 0xfa, 0xac,
+	0x60, 0x65, 0xb1, 0x2b, 0x21, 0xba, 0x42, 0xbe, 0x76, 0x8e, 0x3e, 0x44, 0xce, 0xfd, 0xa4, 0x7e,
+	0x90, 0x50, 0x1e, 0xdb, 0xfe, 0x5f, 0x2d, 0xec, 0x49, 0xfe, 0x27, 0xa2, 0x48, 0x14, 0x55, 0x4a,
+	0xa5, 0x44, 0xa2, 0xc4, 0xe9, 0xe8, 0x8a, 0xde, 0x0f, 0x84, 0x4b, 0xf8, 0xa9, 0xfd, 0xda, 0x12,
+	0x5a, 0x90, 0x22, 0x51, 0x28, 0x3d, 0xa6, 0x1c, 0xc4, 0x1a, 0x65, 0xfd, 0x60, 0x60, 0xe8, 0x33,
+	0x1a, 0x94, 0xe4, 0x6f, 0xea, 0xc9, 0x3c, 0xa5, 0x7e, 0x5f, 0xb0, 0xa2, 0xf7, 0x03, 0xe1, 0x3c,
+	0x3d, 0x4a, 0x73, 0x13, 0xfe, 0x52, 0x1a, 0xdf, 0x65, 0xc2, 0x8d, 0xec, 0x3b, 0xf9, 0xc5, 0x66,
+	0x44, 0x44, 0xb3, 0x89, 0xaf, 0xc4, 0xc9, 0x6e, 0x3c, 0xfd, 0xe3, 0x79, 0x95, 0x93, 0x7d, 0x61,
+	0xe2, 0x6c, 0x09, 0xa1, 0xb9, 0xf0, 0xad, 0xc4, 0x52, 0x85, 0xb0, 0xb4, 0x20, 0xb1, 0xe4, 0x71,
+	0x38, 0xea, 0xcf, 0xa5, 0xef, 0x74, 0xc9, 0xbe, 0x2a, 0xed, 0x9b, 0x65, 0x95, 0x13, 0x7d, 0x20,
+	0x24, 0x7f, 0xce, 0xde, 0xf5, 0xf5, 0xe7, 0x1e, 0x05, 0x21, 0x8a, 0xff, 0x8b, 0x1a, 0xcd, 0x83,
+	0x25, 0xc5, 0x4c, 0xe6, 0xc1, 0x69, 0x0a, 0x79, 0xb2, 0x2f, 0x0c, 0xe7, 0xe7, 0xf9, 0xfd, 0xda,
+	0x11, 0x54, 0xe1, 0x59, 0x83, 0x69, 0x52, 0x43, 0xa5, 0xe9, 0x82, 0xc8, 0x5b, 0x32, 0xad, 0x34,
+	0x4c, 0x33, 0xb6, 0xcb, 0x2f, 0x6a, 0x61, 0x2a, 0x2d, 0x71, 0x78, 0x7f, 0x4f, 0x05, 0x96, 0x98,
+	0x7c, 0x60, 0x10, 0x18, 0xe7, 0xf3, 0xe5, 0xfd, 0xda, 0x09, 0x74, 0x5c, 0xd2, 0x75, 0xc6, 0x2a,
+	0xcd, 0x19, 0xd2, 0x63, 0x50, 0x42, 0xeb, 0x19, 0xcb, 0xe8, 0x17, 0x34, 0x98, 0x4b, 0x7e, 0x5b,
+	0x48, 0x4e, 0x83, 0x7b, 0x7c, 0x5e, 0xa9, 0x72, 0x5f, 0x7f, 0xa0, 0xd8, 0x6d, 0x2f, 0xa1, 0x05,
+	0xf6, 0xba, 0x8a, 0xed, 0xdd, 0xaa, 0xb3, 0x29, 0xf1, 0x77, 0xe4, 0xfc, 0x52, 0xc2, 0x08, 0x08,
+	0x64, 0x03, 0xdb, 0xbb, 0x44, 0x9a, 0x3f, 0x97, 0x89, 0x93, 0xf4, 0xc8, 0x5f, 0xa4, 0xa6, 0x2b,
+	0x49, 0x8f, 0x71, 0x5f, 0x7f, 0x20, 0xce, 0xdd, 0x57, 0xb4, 0xfd, 0xda, 0x2f, 0x6b, 0xe8, 0x97,
+	0x34, 0x92, 0xd7, 0x84, 0x3c, 0xac, 0x56, 0x9b, 0x86, 0xdd, 0x3b, 0x43, 0x8f, 0xa7, 0x96, 0xab,
+	0x55, 0x56, 0x7f, 0xb6, 0x5a, 0x8d, 0x4b, 0xca, 0x56, 0xab, 0x6c, 0x5d, 0x79, 0xb5, 0x1a, 0x57,
+	0x29, 0xae, 0x56, 0xc5, 0x9b, 0xcc, 0x78, 0x42, 0xbf, 0x5a, 0x15, 0xef, 0xde, 0x4a, 0x4f, 0xef,
+	0x25, 0xff, 0x55, 0x42, 0x33, 0xa2, 0xa4, 0xd0, 0x9f, 0x68, 0x50, 0xba, 0x72, 0xdb, 0x75, 0xbc,
+	0xe0, 0x4e, 0x48, 0x66, 0x7b, 0xbf, 0xf6, 0x0a, 0x7a, 0x99, 0xd1, 0x17, 0x24, 0xe3, 0x07, 0x1e,
+	0x36, 0xda, 0x94, 0x39, 0x21, 0x62, 0xf9, 0x55, 0xd7, 0xd8, 0xc2, 0xd5, 0x8d, 0x3d, 0xf6, 0x77,
+	0xd3, 0xf1, 0xaa, 0x1b, 0x9d, 0xd6, 0x4e, 0xd5, 0xc3, 0x04, 0xdd, 0xb2, 0xb7, 0x68, 0x07, 0x16,
+	0x90, 0x6c, 0xd3, 0x98, 0x12, 0x3f, 0xa7, 0xa1, 0x77, 0x33, 0x71, 0xe1, 0xb3, 0x98, 0xa4, 0x1d,
+	0x68, 0x87, 0xfe, 0x4c, 0xdb, 0xaf, 0x7d, 0x51, 0x43, 0xbf, 0x41, 0x87, 0x5a, 0xca, 0xd5, 0x7e,
+	0x88, 0x06, 0x5c, 0xe6, 0x8b, 0x4a, 0x6d, 0x1e, 0xa1, 0xee, 0x0c, 0x12, 0xfd, 0x56, 0x06, 0x0e,
+	0x87, 0x5d, 0x15, 0x6e, 0x67, 0x42, 0x0f, 0xa4, 0xc9, 0xa2, 0xfb, 0x92, 0xae, 0xca, 0x83, 0x03,
+	0xe1, 0xb8, 0xd8, 0xbe, 0xa9, 0xed, 0xd7, 0x7e, 0x5d, 0x43, 0xbf, 0x4a, 0xc5, 0x66, 0xb8, 0xee,
+	0x0f, 0xa1, 0xd0, 0x44, 0xae, 0xa8, 0xc8, 0x0e, 0xa3, 0x43, 0xb2, 0x83, 0x76, 0x5d, 0x1f, 0x7d,
+	0x33, 0x03, 0x65, 0x49, 0xc9, 0xee, 0xa8, 0xd8, 0xfe, 0x46, 0xdb, 0xaf, 0xfd, 0x8e, 0x86, 0xbe,
+	0x2c, 0x68, 0xdb, 0x0f, 0xa7, 0xf0, 0xba, 0x79, 0x63, 0xe9, 0x09, 0x5a, 0x4a, 0x99, 0xb7, 0x50,
+	0x41, 0xfe, 0x40, 0x83, 0xf9, 0xb0, 0xeb, 0xbd, 0x53, 0xcf, 0x3e, 0x77, 0x78, 0x55, 0x4e, 0x0d,
+	0x06, 0xe4, 0x62, 0xfc, 0xbf, 0xda, 0x7e, 0x6d, 0x0b, 0x61, 0x22, 0x44, 0x16, 0xdf, 0x2c, 0x3b,
+	0xe4, 0x73, 0x08, 0x11, 0xf2, 0x25, 0xcd, 0x58, 0x6e, 0xd2, 0xdd, 0x53, 0xe1, 0x02, 0x8b, 0x68,
+	0x6d, 0x51, 0x8f, 0x59, 0x90, 0xfc, 0x9e, 0x06, 0x48, 0x72, 0xb5, 0x77, 0xac, 0xc3, 0xb7, 0xf7,
+	0x6b, 0x37, 0x50, 0x5d, 0x76, 0xbb, 0xac, 0xeb, 0xbd, 0x7c, 0x2f, 0x17, 0x8c, 0x8a, 0x03, 0x5e,
+	0x41, 0xcb, 0xdd, 0x9d, 0x8b, 0xdd, 0xf0, 0xc7, 0x35, 0x98, 0x11, 0x2f, 0x92, 0x42, 0xd2, 0xae,
+	0x5a, 0xca, 0xfd, 0x55, 0x95, 0x6a, 0x6f, 0x00, 0xde, 0x9f, 0xc7, 0xf6, 0x6b, 0x0b, 0xe8, 0x30,
+	0x4b, 0x54, 0xfc, 0xc0, 0x49, 0x68, 0xd9, 0xa2, 0x2e, 0x1b, 0x2a, 0x81, 0x20, 0x61, 0xff, 0x33,
+	0x1a, 0x14, 0xa5, 0x6b, 0xa2, 0x50, 0xa2, 0xa5, 0xee, 0xfb, 0xa9, 0x2a, 0x27, 0xfa, 0x40, 0x70,
+	0x66, 0x9e, 0xa0, 0xd3, 0xf5, 0x90, 0x19, 0xc3, 0x0b, 0x64, 0x6e, 0x96, 0x74, 0x94, 0xe0, 0xc6,
+	0xf0, 0x02, 0xc2, 0xce, 0x4f, 0x93, 0x84, 0x5c, 0xbe, 0x06, 0x2a, 0x91, 0x90, 0xa7, 0xde, 0x41,
+	0x55, 0x39, 0xd9, 0x17, 0x86, 0x33, 0xf5, 0x8c, 0xb0, 0x80, 0xe6, 0x31, 0x98, 0x84, 0x29, 0x26,
+	0xa6, 0x09, 0x1c, 0x28, 0x94, 0x93, 0x74, 0x35, 0x53, 0x62, 0x59, 0x23, 0xe5, 0xa2, 0xa9, 0xca,
+	0x89, 0x3e, 0x10, 0x29, 0x72, 0x6a, 0x12, 0x88, 0xfe, 0x72, 0xa2, 0x20, 0x84, 0x9d, 0x2f, 0x68,
+	0x30, 0x9f, 0x76, 0xa7, 0x90, 0x6c, 0x28, 0x7d, 0x2e, 0x7f, 0xaa, 0x9c, 0x1a, 0x0c, 0x18, 0x2f,
+	0xbd, 0xac, 0xa0, 0x65, 0xba, 0x1c, 0x15, 0xbd, 0x4c, 0xe6, 0x96, 0xdc, 0x89, 0x89, 0x03, 0x1a,
+	0x72, 0xf4, 0xf7, 0xe4, 0xbb, 0xc3, 0x69, 0x37, 0xe4, 0x20, 0x89, 0x85, 0x7e, 0x77, 0x03, 0x55,
+	0x1e, 0x52, 0x80, 0xe4, 0xdc, 0xba, 0xfb, 0xb5, 0xcb, 0xe8, 0x85, 0x7a, 0xc7, 0xae, 0xf2, 0x9b,
+	0x7e, 0xaa, 0x8e, 0x2d, 0x19, 0x70, 0x64, 0xdd, 0x4e, 0x27, 0x70, 0x3b, 0x01, 0xed, 0x09, 0x87,
+	0x24, 0x3e, 0xbe, 0x55, 0x65, 0x17, 0xdc, 0xd0, 0x6e, 0x9d, 0xd4, 0x8f, 0xa5, 0x98, 0xb1, 0xd7,
+	0xb1, 0x1b, 0x1c, 0xe5, 0x19, 0xed, 0xf4, 0x39, 0x8d, 0x4c, 0x94, 0x0a, 0xc2, 0xd1, 0x48, 0x74,
+	0xac, 0x7b, 0x3d, 0x4b, 0x3c, 0xe2, 0x58, 0x39, 0xde, 0xf3, 0x7d, 0xbc, 0x58, 0xf9, 0x3e, 0xf4,
+	0x10, 0x7b, 0x53, 0xa5, 0x27, 0x5f, 0x08, 0x9b, 0x1d, 0x9f, 0xf8, 0x62, 0xba, 0x77, 0x56, 0x75,
+	0x3c, 0xe6, 0x4b, 0xab, 0xa4, 0x62, 0x29, 0x75, 0x1e, 0x47, 0xd1, 0xe8, 0x44, 0xe9, 0xff, 0x90,
+	0xfd, 0xdc, 0xe8, 0x88, 0x9e, 0xcc, 0x5d, 0xf7, 0x01, 0xcc, 0xca, 0xf1, 0x9e, 0xef, 0x39, 0x77,
+	0x67, 0xf7, 0x6b, 0x25, 0x34, 0xc3, 0xde, 0x30, 0xee, 0xa2, 0x59, 0xff, 0xf9, 0x34, 0x1e, 0xd0,
+	0x27, 0x35, 0x98, 0x11, 0x8f, 0xe8, 0xc9, 0xee, 0x2e, 0xe5, 0xb8, 0x5f, 0xa5, 0xda, 0x1b, 0x20,
+	0xb6, 0x9c, 0xc8, 0xdd, 0xf1, 0x79, 0x19, 0x6b, 0x8d, 0xf1, 0x72, 0xba, 0x97, 0x30, 0xbe, 0x4f,
+	0x57, 0x45, 0xc4, 0x33, 0x71, 0xc9, 0x55, 0x91, 0x94, 0x63, 0x81, 0x15, 0xbd, 0x1f, 0x08, 0xe7,
+	0xe8, 0xc7, 0xb5, 0xfd, 0x9a, 0x87, 0x5c, 0x62, 0x28, 0xac, 0xb9, 0x01, 0x71, 0x33, 0x3c, 0x67,
+	0xb8, 0x5a, 0xe5, 0x87, 0x04, 0x69, 0xee, 0x10, 0xfd, 0x12, 0x73, 0x8e, 0xf4, 0x84, 0x42, 0xe8,
+	0x6f, 0x32, 0xe1, 0xe7, 0x82, 0xff, 0x3a, 0x9d, 0x22, 0x27, 0x4f, 0x61, 0x25, 0xa7, 0xc8, 0x3d,
+	0x0e, 0x8f, 0x55, 0x1e, 0x18, 0x04, 0xc6, 0x3b, 0xfe, 0xa1, 0xfd, 0xda, 0xd3, 0xe8, 0x49, 0xd2,
+	0x6f, 0x7a, 0x9a, 0x8b, 0xa8, 0x2a, 0x6b, 0xbf, 0x4a, 0x6b, 0x79, 0x2c, 0x7b, 0x4b, 0x9e, 0xc1,
+	0x38, 0x9b, 0x49, 0xdd, 0x4d, 0x86, 0x4b, 0x86, 0x7e, 0x96, 0x92, 0x43, 0x5f, 0xd3, 0xc2, 0x7b,
+	0x77, 0x92, 0x27, 0xbe, 0x4e, 0xf5, 0x5c, 0x37, 0x4e, 0x94, 0xb1, 0x56, 0x1e, 0x52, 0x80, 0xe4,
+	0xbd, 0xa9, 0xef, 0xd7, 0xce, 0xa0, 0x55, 0x72, 0xdc, 0xa6, 0xda, 0x89, 0x82, 0x28, 0x89, 0xf7,
+	0xa4, 0x0f, 0xec, 0xcc, 0x4e, 0x95, 0x97, 0xa7, 0x90, 0x9e, 0x18, 0xae, 0x9b, 0xba, 0x54, 0x11,
+	0xd6, 0xa6, 0x52, 0xa5, 0xfb, 0x83, 0x4c, 0xd7, 0x0d, 0xc4, 0x61, 0xbb, 0x3e, 0x7a, 0x5f, 0x9f,
+	0x94, 0x25, 0x59, 0x2a, 0x5c, 0x59, 0x55, 0x03, 0xe6, 0x7d, 0xf9, 0x86, 0xb6, 0x5f, 0xfb, 0xbc,
+	0x86, 0x3e, 0x47, 0x73, 0xe3, 0x88, 0x23, 0xc1, 0x79, 0x0f, 0xd2, 0x51, 0xa1, 0x24, 0x37, 0x4e,
+	0x71, 0xc9, 0xff, 0xd2, 0xa1, 0x82, 0xd5, 0x6a, 0xf7, 0xf1, 0x81, 0x38, 0x05, 0xec, 0xb3, 0xa9,
+	0x16, 0xb1, 0x94, 0xba, 0xa9, 0x16, 0xbd, 0x45, 0xff, 0xa4, 0x41, 0xa5, 0x77, 0x3d, 0x39, 0x7a,
+	0x38, 0xb1, 0xfc, 0xd5, 0xbf, 0x22, 0xbe, 0x72, 0x46, 0x15, 0x9c, 0x4b, 0xd1, 0xda, 0xaf, 0x5d,
+	0x44, 0x17, 0x38, 0x60, 0xa4, 0x11, 0x74, 0xfd, 0x27, 0x64, 0x31, 0xd4, 0x0f, 0xfe, 0xc5, 0xd5,
+	0x34, 0x05, 0x49, 0xc6, 0x92, 0xa8, 0x77, 0x67, 0x39, 0x12, 0x51, 0x94, 0xaf, 0x68, 0xe1, 0x85,
+	0x3d, 0x5d, 0x6a, 0xf2, 0x50, 0xcf, 0x05, 0xab, 0x2e, 0x25, 0x39, 0xad, 0x02, 0x1a, 0xaf, 0x6f,
+	0xdd, 0x87, 0x74, 0xc9, 0x8f, 0xa6, 0x2a, 0x4a, 0xea, 0x0e, 0xa3, 0xa4, 0xe4, 0x2d, 0x58, 0x48,
+	0x2d, 0xf1, 0x96, 0xcd, 0xb4, 0x5f, 0xb5, 0x79, 0xe5, 0x21, 0x05, 0x48, 0xbe, 0x33, 0xf2, 0x2d,
+	0x0d, 0x0e, 0x75, 0x95, 0x7b, 0xca, 0x9b, 0x2f, 0xbd, 0x4a, 0x63, 0x2b, 0xf7, 0x0f, 0x80, 0xe2,
+	0xa2, 0xd9, 0xd9, 0xaf, 0x5d, 0x41, 0x97, 0x48, 0x2a, 0xc1, 0xbf, 0x53, 0x1c, 0x0f, 0xab, 0xc7,
+	0x3c, 0x1a, 0xfd, 0x84, 0x60, 0xf4, 0xd4, 0xc4, 0x9b, 0x96, 0x8d, 0x4d, 0xa2, 0x0b, 0x64, 0x96,
+	0x17, 0xe7, 0x1d, 0x54, 0x76, 0x47, 0xf5, 0xb2, 0x9c, 0x5c, 0x76, 0xa2, 0x6f, 0x2e, 0x13, 0xe9,
+	0xfd, 0x43, 0xa6, 0xeb, 0x0a, 0x5e, 0xa9, 0x80, 0x10, 0x9d, 0xed, 0x67, 0xf9, 0x29, 0x15, 0xa0,
+	0x95, 0x73, 0xea, 0x08, 0xbc, 0xc3, 0x7f, 0xa7, 0xed, 0xd7, 0x7e, 0x57, 0x43, 0xbf, 0x4d, 0xdd,
+	0xc5, 0xb6, 0x45, 0xb4, 0x72, 0x8f, 0xe8, 0x00, 0x67, 0xd3, 0x27, 0x11, 0x49, 0xe8, 0x96, 0xea,
+	0xdc, 0x3a, 0x59, 0xcc, 0x97, 0xf0, 0x24, 0x42, 0x35, 0xa9, 0x92, 0xd3, 0x08, 0x0d, 0x30, 0x14,
+	0x3b, 0x25, 0xcb, 0xf4, 0xf3, 0x28, 0x5a, 0x91, 0xf5, 0x53, 0x6c, 0xd9, 0x47, 0xb7, 0x61, 0xb9,
+	0x67, 0x15, 0x22, 0x5a, 0xed, 0xad, 0x7b, 0xdd, 0x55, 0x92, 0x95, 0x87, 0x15, 0xa1, 0xb9, 0xb6,
+	0xfe, 0xad, 0x16, 0x9f, 0xc4, 0x97, 0x4b, 0x69, 0x92, 0x76, 0xdd, 0xa7, 0x4e, 0xb1, 0x72, 0x5a,
+	0x05, 0x34, 0x5e, 0x55, 0x5c, 0x43, 0x2f, 0x91, 0x81, 0x24, 0x39, 0x44, 0x95, 0x55, 0xf5, 0x90,
+	0xc1, 0x24, 0x8a, 0xcb, 0xcb, 0x65, 0xaa, 0xb4, 0x62, 0x2c, 0x45, 0x6f, 0xd9, 0x44, 0x37, 0x91,
+	0xe3, 0x27, 0x37, 0xa0, 0x39, 0x11, 0xf4, 0x76, 0xc2, 0x65, 0xad, 0x85, 0x97, 0x8c, 0xf6, 0x71,
+	0x59, 0x11, 0xcc, 0x60, 0x97, 0x25, 0x80, 0xf2, 0xae, 0xfd, 0x0f, 0x14, 0xc0, 0xd2, 0x35, 0x6b,
+	0xcb, 0x33, 0x52, 0xda, 0x94, 0x77, 0x45, 0xd3, 0x81, 0xd2, 0x77, 0x45, 0x7b, 0xc1, 0x86, 0xad,
+	0xbe, 0x30, 0xf1, 0x66, 0xc6, 0xdd, 0xd8, 0xc8, 0xd1, 0x6d, 0xb6, 0x47, 0xff, 0x6b, 0x00, 0x25,
+	0x66, 0xc6, 0x52, 0xde, 0x98, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ClusterManagerClient is the client API for ClusterManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterManagerClient interface {
+	AddNodeKeyPairs(ctx context.Context, in *AddNodeKeyPairsRequest, opts ...grpc.CallOption) (*AddNodeKeyPairsResponse, error)
+	DeleteNodeKeyPairs(ctx context.Context, in *DeleteNodeKeyPairsRequest, opts ...grpc.CallOption) (*DeleteNodeKeyPairsResponse, error)
+	// Create key pair
+	CreateKeyPair(ctx context.Context, in *CreateKeyPairRequest, opts ...grpc.CallOption) (*CreateKeyPairResponse, error)
+	// Get key pairs, support filter with these fields(key_pair_id, name, owner), default return all key pairs
+	DescribeKeyPairs(ctx context.Context, in *DescribeKeyPairsRequest, opts ...grpc.CallOption) (*DescribeKeyPairsResponse, error)
+	// Batch delete key pairs
+	DeleteKeyPairs(ctx context.Context, in *DeleteKeyPairsRequest, opts ...grpc.CallOption) (*DeleteKeyPairsResponse, error)
+	// Batch attach key pairs to node
+	AttachKeyPairs(ctx context.Context, in *AttachKeyPairsRequest, opts ...grpc.CallOption) (*AttachKeyPairsResponse, error)
+	//Batch detach key pairs from node
+	DetachKeyPairs(ctx context.Context, in *DetachKeyPairsRequest, opts ...grpc.CallOption) (*DetachKeyPairsResponse, error)
+	// Get subnets
+	DescribeSubnets(ctx context.Context, in *DescribeSubnetsRequest, opts ...grpc.CallOption) (*DescribeSubnetsResponse, error)
+	// Create cluster
+	CreateCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*CreateClusterResponse, error)
+	// Create debug cluster
+	CreateDebugCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*CreateClusterResponse, error)
+	ModifyCluster(ctx context.Context, in *ModifyClusterRequest, opts ...grpc.CallOption) (*ModifyClusterResponse, error)
+	ModifyClusterNode(ctx context.Context, in *ModifyClusterNodeRequest, opts ...grpc.CallOption) (*ModifyClusterNodeResponse, error)
+	// Modify cluster attributes
 	ModifyClusterAttributes(ctx context.Context, in *ModifyClusterAttributesRequest, opts ...grpc.CallOption) (*ModifyClusterAttributesResponse, error)
 	// Modify node attributes in the cluster
 	ModifyClusterNodeAttributes(ctx context.Context, in *ModifyClusterNodeAttributesRequest, opts ...grpc.CallOption) (*ModifyClusterNodeAttributesResponse, error)
@@ -5447,6 +8631,8 @@ type ClusterManagerClient interface {
 	UpdateClusterEnv(ctx context.Context, in *UpdateClusterEnvRequest, opts ...grpc.CallOption) (*UpdateClusterEnvResponse, error)
 	// Get clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all clusters
 	DescribeClusters(ctx context.Context, in *DescribeClustersRequest, opts ...grpc.CallOption) (*DescribeClustersResponse, error)
+	// Export clusters, stream all the clusters page by page for bulk reporting
+	ExportClusters(ctx context.Context, in *DescribeClustersRequest, opts ...grpc.CallOption) (ClusterManager_ExportClustersClient, error)
 	// Get debug clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all debug clusters
 	DescribeDebugClusters(ctx context.Context, in *DescribeClustersRequest, opts ...grpc.CallOption) (*DescribeClustersResponse, error)
 	// Get app clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all app clusters
@@ -5455,6 +8641,8 @@ type ClusterManagerClient interface {
 	DescribeDebugAppClusters(ctx context.Context, in *DescribeAppClustersRequest, opts ...grpc.CallOption) (*DescribeAppClustersResponse, error)
 	// Get nodes in cluster, can filter with these fields(cluster_id, node_id, status, owner)
 	DescribeClusterNodes(ctx context.Context, in *DescribeClusterNodesRequest, opts ...grpc.CallOption) (*DescribeClusterNodesResponse, error)
+	// Export cluster nodes, stream all the cluster nodes page by page for bulk reporting
+	ExportClusterNodes(ctx context.Context, in *DescribeClusterNodesRequest, opts ...grpc.CallOption) (ClusterManager_ExportClusterNodesClient, error)
 	// Batch stop clusters
 	StopClusters(ctx context.Context, in *StopClustersRequest, opts ...grpc.CallOption) (*StopClustersResponse, error)
 	// Batch start cluster
@@ -5465,6 +8653,34 @@ type ClusterManagerClient interface {
 	CeaseClusters(ctx context.Context, in *CeaseClustersRequest, opts ...grpc.CallOption) (*CeaseClustersResponse, error)
 	// Get statistics of cluster
 	GetClusterStatistics(ctx context.Context, in *GetClusterStatisticsRequest, opts ...grpc.CallOption) (*GetClusterStatisticsResponse, error)
+	// Run command on cluster node, stream output of command until exited
+	RunClusterNodeCommand(ctx context.Context, in *RunClusterNodeCommandRequest, opts ...grpc.CallOption) (ClusterManager_RunClusterNodeCommandClient, error)
+	// Create quota of user, group or owner path
+	CreateQuota(ctx context.Context, in *CreateQuotaRequest, opts ...grpc.CallOption) (*CreateQuotaResponse, error)
+	// Modify quota
+	ModifyQuota(ctx context.Context, in *ModifyQuotaRequest, opts ...grpc.CallOption) (*ModifyQuotaResponse, error)
+	// Batch delete quotas
+	DeleteQuotas(ctx context.Context, in *DeleteQuotasRequest, opts ...grpc.CallOption) (*DeleteQuotasResponse, error)
+	// Get quotas, can filter with these fields(quota_id, subject_type, subject, runtime_id), default return all quotas
+	DescribeQuotas(ctx context.Context, in *DescribeQuotasRequest, opts ...grpc.CallOption) (*DescribeQuotasResponse, error)
+	// Get usage of quotas limiting the clusters of owner path
+	DescribeQuotaUsage(ctx context.Context, in *DescribeQuotaUsageRequest, opts ...grpc.CallOption) (*DescribeQuotaUsageResponse, error)
+	// Back up cluster by the backup service of app
+	CreateClusterSnapshot(ctx context.Context, in *CreateClusterSnapshotRequest, opts ...grpc.CallOption) (*CreateClusterSnapshotResponse, error)
+	// Get snapshots of clusters, can filter with these fields(snapshot_id, cluster_id, snapshot_type, parent_snapshot_id, status, owner), default return all snapshots
+	DescribeClusterSnapshots(ctx context.Context, in *DescribeClusterSnapshotsRequest, opts ...grpc.CallOption) (*DescribeClusterSnapshotsResponse, error)
+	// Restore cluster from snapshot by the restore service of app
+	RestoreClusterFromSnapshot(ctx context.Context, in *RestoreClusterFromSnapshotRequest, opts ...grpc.CallOption) (*RestoreClusterFromSnapshotResponse, error)
+	// Batch delete snapshots of clusters
+	DeleteClusterSnapshots(ctx context.Context, in *DeleteClusterSnapshotsRequest, opts ...grpc.CallOption) (*DeleteClusterSnapshotsResponse, error)
+	ModifyClusterSnapshot(ctx context.Context, in *ModifyClusterSnapshotRequest, opts ...grpc.CallOption) (*ModifyClusterSnapshotResponse, error)
+	// Run restart service or the custom service defined by app on cluster
+	RunClusterService(ctx context.Context, in *RunClusterServiceRequest, opts ...grpc.CallOption) (*RunClusterServiceResponse, error)
+	// Get history of services run on clusters, can filter with these fields(cluster_service_audit_id, cluster_id, service_name, status, owner), default return all cluster service audits
+	DescribeClusterServiceAudits(ctx context.Context, in *DescribeClusterServiceAuditsRequest, opts ...grpc.CallOption) (*DescribeClusterServiceAuditsResponse, error)
+	ModifyClusterServiceAudit(ctx context.Context, in *ModifyClusterServiceAuditRequest, opts ...grpc.CallOption) (*ModifyClusterServiceAuditResponse, error)
+	// Get time series of the monitor items defined by app on nodes of cluster
+	DescribeClusterMonitor(ctx context.Context, in *DescribeClusterMonitorRequest, opts ...grpc.CallOption) (*DescribeClusterMonitorResponse, error)
 	// for kubesphere
 	DeleteClusterInRuntime(ctx context.Context, in *DeleteClusterInRuntimeRequest, opts ...grpc.CallOption) (*DeleteClusterInRuntimeResponse, error)
 	MigrateClusterInRuntime(ctx context.Context, in *MigrateClusterInRuntimeRequest, opts ...grpc.CallOption) (*MigrateClusterInRuntimeResponse, error)
@@ -5694,6 +8910,38 @@ func (c *clusterManagerClient) DescribeClusters(ctx context.Context, in *Describ
 	return out, nil
 }
 
+func (c *clusterManagerClient) ExportClusters(ctx context.Context, in *DescribeClustersRequest, opts ...grpc.CallOption) (ClusterManager_ExportClustersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ClusterManager_serviceDesc.Streams[0], "/openpitrix.ClusterManager/ExportClusters", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterManagerExportClustersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClusterManager_ExportClustersClient interface {
+	Recv() (*DescribeClustersResponse, error)
+	grpc.ClientStream
+}
+
+type clusterManagerExportClustersClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterManagerExportClustersClient) Recv() (*DescribeClustersResponse, error) {
+	m := new(DescribeClustersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *clusterManagerClient) DescribeDebugClusters(ctx context.Context, in *DescribeClustersRequest, opts ...grpc.CallOption) (*DescribeClustersResponse, error) {
 	out := new(DescribeClustersResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeDebugClusters", in, out, opts...)
@@ -5730,6 +8978,38 @@ func (c *clusterManagerClient) DescribeClusterNodes(ctx context.Context, in *Des
 	return out, nil
 }
 
+func (c *clusterManagerClient) ExportClusterNodes(ctx context.Context, in *DescribeClusterNodesRequest, opts ...grpc.CallOption) (ClusterManager_ExportClusterNodesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ClusterManager_serviceDesc.Streams[1], "/openpitrix.ClusterManager/ExportClusterNodes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterManagerExportClusterNodesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClusterManager_ExportClusterNodesClient interface {
+	Recv() (*DescribeClusterNodesResponse, error)
+	grpc.ClientStream
+}
+
+type clusterManagerExportClusterNodesClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterManagerExportClusterNodesClient) Recv() (*DescribeClusterNodesResponse, error) {
+	m := new(DescribeClusterNodesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *clusterManagerClient) StopClusters(ctx context.Context, in *StopClustersRequest, opts ...grpc.CallOption) (*StopClustersResponse, error) {
 	out := new(StopClustersResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/StopClusters", in, out, opts...)
@@ -5775,6 +9055,164 @@ func (c *clusterManagerClient) GetClusterStatistics(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *clusterManagerClient) RunClusterNodeCommand(ctx context.Context, in *RunClusterNodeCommandRequest, opts ...grpc.CallOption) (ClusterManager_RunClusterNodeCommandClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ClusterManager_serviceDesc.Streams[2], "/openpitrix.ClusterManager/RunClusterNodeCommand", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterManagerRunClusterNodeCommandClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClusterManager_RunClusterNodeCommandClient interface {
+	Recv() (*RunClusterNodeCommandResponse, error)
+	grpc.ClientStream
+}
+
+type clusterManagerRunClusterNodeCommandClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterManagerRunClusterNodeCommandClient) Recv() (*RunClusterNodeCommandResponse, error) {
+	m := new(RunClusterNodeCommandResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clusterManagerClient) CreateQuota(ctx context.Context, in *CreateQuotaRequest, opts ...grpc.CallOption) (*CreateQuotaResponse, error) {
+	out := new(CreateQuotaResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/CreateQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) ModifyQuota(ctx context.Context, in *ModifyQuotaRequest, opts ...grpc.CallOption) (*ModifyQuotaResponse, error) {
+	out := new(ModifyQuotaResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/ModifyQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DeleteQuotas(ctx context.Context, in *DeleteQuotasRequest, opts ...grpc.CallOption) (*DeleteQuotasResponse, error) {
+	out := new(DeleteQuotasResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DeleteQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DescribeQuotas(ctx context.Context, in *DescribeQuotasRequest, opts ...grpc.CallOption) (*DescribeQuotasResponse, error) {
+	out := new(DescribeQuotasResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DescribeQuotaUsage(ctx context.Context, in *DescribeQuotaUsageRequest, opts ...grpc.CallOption) (*DescribeQuotaUsageResponse, error) {
+	out := new(DescribeQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) CreateClusterSnapshot(ctx context.Context, in *CreateClusterSnapshotRequest, opts ...grpc.CallOption) (*CreateClusterSnapshotResponse, error) {
+	out := new(CreateClusterSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/CreateClusterSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DescribeClusterSnapshots(ctx context.Context, in *DescribeClusterSnapshotsRequest, opts ...grpc.CallOption) (*DescribeClusterSnapshotsResponse, error) {
+	out := new(DescribeClusterSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeClusterSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) RestoreClusterFromSnapshot(ctx context.Context, in *RestoreClusterFromSnapshotRequest, opts ...grpc.CallOption) (*RestoreClusterFromSnapshotResponse, error) {
+	out := new(RestoreClusterFromSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/RestoreClusterFromSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DeleteClusterSnapshots(ctx context.Context, in *DeleteClusterSnapshotsRequest, opts ...grpc.CallOption) (*DeleteClusterSnapshotsResponse, error) {
+	out := new(DeleteClusterSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DeleteClusterSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) ModifyClusterSnapshot(ctx context.Context, in *ModifyClusterSnapshotRequest, opts ...grpc.CallOption) (*ModifyClusterSnapshotResponse, error) {
+	out := new(ModifyClusterSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/ModifyClusterSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) RunClusterService(ctx context.Context, in *RunClusterServiceRequest, opts ...grpc.CallOption) (*RunClusterServiceResponse, error) {
+	out := new(RunClusterServiceResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/RunClusterService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DescribeClusterServiceAudits(ctx context.Context, in *DescribeClusterServiceAuditsRequest, opts ...grpc.CallOption) (*DescribeClusterServiceAuditsResponse, error) {
+	out := new(DescribeClusterServiceAuditsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeClusterServiceAudits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) ModifyClusterServiceAudit(ctx context.Context, in *ModifyClusterServiceAuditRequest, opts ...grpc.CallOption) (*ModifyClusterServiceAuditResponse, error) {
+	out := new(ModifyClusterServiceAuditResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/ModifyClusterServiceAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerClient) DescribeClusterMonitor(ctx context.Context, in *DescribeClusterMonitorRequest, opts ...grpc.CallOption) (*DescribeClusterMonitorResponse, error) {
+	out := new(DescribeClusterMonitorResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DescribeClusterMonitor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerClient) DeleteClusterInRuntime(ctx context.Context, in *DeleteClusterInRuntimeRequest, opts ...grpc.CallOption) (*DeleteClusterInRuntimeResponse, error) {
 	out := new(DeleteClusterInRuntimeResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.ClusterManager/DeleteClusterInRuntime", in, out, opts...)
@@ -5837,6 +9275,8 @@ type ClusterManagerServer interface {
 	UpdateClusterEnv(context.Context, *UpdateClusterEnvRequest) (*UpdateClusterEnvResponse, error)
 	// Get clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all clusters
 	DescribeClusters(context.Context, *DescribeClustersRequest) (*DescribeClustersResponse, error)
+	// Export clusters, stream all the clusters page by page for bulk reporting
+	ExportClusters(*DescribeClustersRequest, ClusterManager_ExportClustersServer) error
 	// Get debug clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all debug clusters
 	DescribeDebugClusters(context.Context, *DescribeClustersRequest) (*DescribeClustersResponse, error)
 	// Get app clusters, can filter with these fields(cluster_id, app_id, version_id, status, runtime_id, frontgate_id, owner, cluster_type), default return all app clusters
@@ -5845,6 +9285,8 @@ type ClusterManagerServer interface {
 	DescribeDebugAppClusters(context.Context, *DescribeAppClustersRequest) (*DescribeAppClustersResponse, error)
 	// Get nodes in cluster, can filter with these fields(cluster_id, node_id, status, owner)
 	DescribeClusterNodes(context.Context, *DescribeClusterNodesRequest) (*DescribeClusterNodesResponse, error)
+	// Export cluster nodes, stream all the cluster nodes page by page for bulk reporting
+	ExportClusterNodes(*DescribeClusterNodesRequest, ClusterManager_ExportClusterNodesServer) error
 	// Batch stop clusters
 	StopClusters(context.Context, *StopClustersRequest) (*StopClustersResponse, error)
 	// Batch start cluster
@@ -5855,6 +9297,34 @@ type ClusterManagerServer interface {
 	CeaseClusters(context.Context, *CeaseClustersRequest) (*CeaseClustersResponse, error)
 	// Get statistics of cluster
 	GetClusterStatistics(context.Context, *GetClusterStatisticsRequest) (*GetClusterStatisticsResponse, error)
+	// Run command on cluster node, stream output of command until exited
+	RunClusterNodeCommand(*RunClusterNodeCommandRequest, ClusterManager_RunClusterNodeCommandServer) error
+	// Create quota of user, group or owner path
+	CreateQuota(context.Context, *CreateQuotaRequest) (*CreateQuotaResponse, error)
+	// Modify quota
+	ModifyQuota(context.Context, *ModifyQuotaRequest) (*ModifyQuotaResponse, error)
+	// Batch delete quotas
+	DeleteQuotas(context.Context, *DeleteQuotasRequest) (*DeleteQuotasResponse, error)
+	// Get quotas, can filter with these fields(quota_id, subject_type, subject, runtime_id), default return all quotas
+	DescribeQuotas(context.Context, *DescribeQuotasRequest) (*DescribeQuotasResponse, error)
+	// Get usage of quotas limiting the clusters of owner path
+	DescribeQuotaUsage(context.Context, *DescribeQuotaUsageRequest) (*DescribeQuotaUsageResponse, error)
+	// Back up cluster by the backup service of app
+	CreateClusterSnapshot(context.Context, *CreateClusterSnapshotRequest) (*CreateClusterSnapshotResponse, error)
+	// Get snapshots of clusters, can filter with these fields(snapshot_id, cluster_id, snapshot_type, parent_snapshot_id, status, owner), default return all snapshots
+	DescribeClusterSnapshots(context.Context, *DescribeClusterSnapshotsRequest) (*DescribeClusterSnapshotsResponse, error)
+	// Restore cluster from snapshot by the restore service of app
+	RestoreClusterFromSnapshot(context.Context, *RestoreClusterFromSnapshotRequest) (*RestoreClusterFromSnapshotResponse, error)
+	// Batch delete snapshots of clusters
+	DeleteClusterSnapshots(context.Context, *DeleteClusterSnapshotsRequest) (*DeleteClusterSnapshotsResponse, error)
+	ModifyClusterSnapshot(context.Context, *ModifyClusterSnapshotRequest) (*ModifyClusterSnapshotResponse, error)
+	// Run restart service or the custom service defined by app on cluster
+	RunClusterService(context.Context, *RunClusterServiceRequest) (*RunClusterServiceResponse, error)
+	// Get history of services run on clusters, can filter with these fields(cluster_service_audit_id, cluster_id, service_name, status, owner), default return all cluster service audits
+	DescribeClusterServiceAudits(context.Context, *DescribeClusterServiceAuditsRequest) (*DescribeClusterServiceAuditsResponse, error)
+	ModifyClusterServiceAudit(context.Context, *ModifyClusterServiceAuditRequest) (*ModifyClusterServiceAuditResponse, error)
+	// Get time series of the monitor items defined by app on nodes of cluster
+	DescribeClusterMonitor(context.Context, *DescribeClusterMonitorRequest) (*DescribeClusterMonitorResponse, error)
 	// for kubesphere
 	DeleteClusterInRuntime(context.Context, *DeleteClusterInRuntimeRequest) (*DeleteClusterInRuntimeResponse, error)
 	MigrateClusterInRuntime(context.Context, *MigrateClusterInRuntimeRequest) (*MigrateClusterInRuntimeResponse, error)
@@ -5936,6 +9406,9 @@ func (*UnimplementedClusterManagerServer) UpdateClusterEnv(ctx context.Context,
 func (*UnimplementedClusterManagerServer) DescribeClusters(ctx context.Context, req *DescribeClustersRequest) (*DescribeClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusters not implemented")
 }
+func (*UnimplementedClusterManagerServer) ExportClusters(req *DescribeClustersRequest, srv ClusterManager_ExportClustersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportClusters not implemented")
+}
 func (*UnimplementedClusterManagerServer) DescribeDebugClusters(ctx context.Context, req *DescribeClustersRequest) (*DescribeClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeDebugClusters not implemented")
 }
@@ -5945,23 +9418,71 @@ func (*UnimplementedClusterManagerServer) DescribeAppClusters(ctx context.Contex
 func (*UnimplementedClusterManagerServer) DescribeDebugAppClusters(ctx context.Context, req *DescribeAppClustersRequest) (*DescribeAppClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeDebugAppClusters not implemented")
 }
-func (*UnimplementedClusterManagerServer) DescribeClusterNodes(ctx context.Context, req *DescribeClusterNodesRequest) (*DescribeClusterNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusterNodes not implemented")
+func (*UnimplementedClusterManagerServer) DescribeClusterNodes(ctx context.Context, req *DescribeClusterNodesRequest) (*DescribeClusterNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusterNodes not implemented")
+}
+func (*UnimplementedClusterManagerServer) ExportClusterNodes(req *DescribeClusterNodesRequest, srv ClusterManager_ExportClusterNodesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportClusterNodes not implemented")
+}
+func (*UnimplementedClusterManagerServer) StopClusters(ctx context.Context, req *StopClustersRequest) (*StopClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopClusters not implemented")
+}
+func (*UnimplementedClusterManagerServer) StartClusters(ctx context.Context, req *StartClustersRequest) (*StartClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartClusters not implemented")
+}
+func (*UnimplementedClusterManagerServer) RecoverClusters(ctx context.Context, req *RecoverClustersRequest) (*RecoverClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverClusters not implemented")
+}
+func (*UnimplementedClusterManagerServer) CeaseClusters(ctx context.Context, req *CeaseClustersRequest) (*CeaseClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CeaseClusters not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetClusterStatistics(ctx context.Context, req *GetClusterStatisticsRequest) (*GetClusterStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStatistics not implemented")
+}
+func (*UnimplementedClusterManagerServer) RunClusterNodeCommand(req *RunClusterNodeCommandRequest, srv ClusterManager_RunClusterNodeCommandServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunClusterNodeCommand not implemented")
+}
+func (*UnimplementedClusterManagerServer) CreateQuota(ctx context.Context, req *CreateQuotaRequest) (*CreateQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuota not implemented")
+}
+func (*UnimplementedClusterManagerServer) ModifyQuota(ctx context.Context, req *ModifyQuotaRequest) (*ModifyQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyQuota not implemented")
+}
+func (*UnimplementedClusterManagerServer) DeleteQuotas(ctx context.Context, req *DeleteQuotasRequest) (*DeleteQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuotas not implemented")
+}
+func (*UnimplementedClusterManagerServer) DescribeQuotas(ctx context.Context, req *DescribeQuotasRequest) (*DescribeQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeQuotas not implemented")
+}
+func (*UnimplementedClusterManagerServer) DescribeQuotaUsage(ctx context.Context, req *DescribeQuotaUsageRequest) (*DescribeQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeQuotaUsage not implemented")
+}
+func (*UnimplementedClusterManagerServer) CreateClusterSnapshot(ctx context.Context, req *CreateClusterSnapshotRequest) (*CreateClusterSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClusterSnapshot not implemented")
+}
+func (*UnimplementedClusterManagerServer) DescribeClusterSnapshots(ctx context.Context, req *DescribeClusterSnapshotsRequest) (*DescribeClusterSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusterSnapshots not implemented")
+}
+func (*UnimplementedClusterManagerServer) RestoreClusterFromSnapshot(ctx context.Context, req *RestoreClusterFromSnapshotRequest) (*RestoreClusterFromSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreClusterFromSnapshot not implemented")
+}
+func (*UnimplementedClusterManagerServer) DeleteClusterSnapshots(ctx context.Context, req *DeleteClusterSnapshotsRequest) (*DeleteClusterSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClusterSnapshots not implemented")
 }
-func (*UnimplementedClusterManagerServer) StopClusters(ctx context.Context, req *StopClustersRequest) (*StopClustersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopClusters not implemented")
+func (*UnimplementedClusterManagerServer) ModifyClusterSnapshot(ctx context.Context, req *ModifyClusterSnapshotRequest) (*ModifyClusterSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyClusterSnapshot not implemented")
 }
-func (*UnimplementedClusterManagerServer) StartClusters(ctx context.Context, req *StartClustersRequest) (*StartClustersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartClusters not implemented")
+func (*UnimplementedClusterManagerServer) RunClusterService(ctx context.Context, req *RunClusterServiceRequest) (*RunClusterServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunClusterService not implemented")
 }
-func (*UnimplementedClusterManagerServer) RecoverClusters(ctx context.Context, req *RecoverClustersRequest) (*RecoverClustersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecoverClusters not implemented")
+func (*UnimplementedClusterManagerServer) DescribeClusterServiceAudits(ctx context.Context, req *DescribeClusterServiceAuditsRequest) (*DescribeClusterServiceAuditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusterServiceAudits not implemented")
 }
-func (*UnimplementedClusterManagerServer) CeaseClusters(ctx context.Context, req *CeaseClustersRequest) (*CeaseClustersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CeaseClusters not implemented")
+func (*UnimplementedClusterManagerServer) ModifyClusterServiceAudit(ctx context.Context, req *ModifyClusterServiceAuditRequest) (*ModifyClusterServiceAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyClusterServiceAudit not implemented")
 }
-func (*UnimplementedClusterManagerServer) GetClusterStatistics(ctx context.Context, req *GetClusterStatisticsRequest) (*GetClusterStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStatistics not implemented")
+func (*UnimplementedClusterManagerServer) DescribeClusterMonitor(ctx context.Context, req *DescribeClusterMonitorRequest) (*DescribeClusterMonitorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusterMonitor not implemented")
 }
 func (*UnimplementedClusterManagerServer) DeleteClusterInRuntime(ctx context.Context, req *DeleteClusterInRuntimeRequest) (*DeleteClusterInRuntimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteClusterInRuntime not implemented")
@@ -6406,6 +9927,27 @@ func _ClusterManager_DescribeClusters_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterManager_ExportClusters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DescribeClustersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterManagerServer).ExportClusters(m, &clusterManagerExportClustersServer{stream})
+}
+
+type ClusterManager_ExportClustersServer interface {
+	Send(*DescribeClustersResponse) error
+	grpc.ServerStream
+}
+
+type clusterManagerExportClustersServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterManagerExportClustersServer) Send(m *DescribeClustersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ClusterManager_DescribeDebugClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeClustersRequest)
 	if err := dec(in); err != nil {
@@ -6478,6 +10020,27 @@ func _ClusterManager_DescribeClusterNodes_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterManager_ExportClusterNodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DescribeClusterNodesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterManagerServer).ExportClusterNodes(m, &clusterManagerExportClusterNodesServer{stream})
+}
+
+type ClusterManager_ExportClusterNodesServer interface {
+	Send(*DescribeClusterNodesResponse) error
+	grpc.ServerStream
+}
+
+type clusterManagerExportClusterNodesServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterManagerExportClusterNodesServer) Send(m *DescribeClusterNodesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ClusterManager_StopClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopClustersRequest)
 	if err := dec(in); err != nil {
@@ -6568,6 +10131,279 @@ func _ClusterManager_GetClusterStatistics_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterManager_RunClusterNodeCommand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunClusterNodeCommandRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterManagerServer).RunClusterNodeCommand(m, &clusterManagerRunClusterNodeCommandServer{stream})
+}
+
+type ClusterManager_RunClusterNodeCommandServer interface {
+	Send(*RunClusterNodeCommandResponse) error
+	grpc.ServerStream
+}
+
+type clusterManagerRunClusterNodeCommandServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterManagerRunClusterNodeCommandServer) Send(m *RunClusterNodeCommandResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ClusterManager_CreateQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).CreateQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/CreateQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).CreateQuota(ctx, req.(*CreateQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_ModifyQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ModifyQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/ModifyQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ModifyQuota(ctx, req.(*ModifyQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DeleteQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DeleteQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DeleteQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DeleteQuotas(ctx, req.(*DeleteQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DescribeQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeQuotas(ctx, req.(*DescribeQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DescribeQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeQuotaUsage(ctx, req.(*DescribeQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_CreateClusterSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClusterSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).CreateClusterSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/CreateClusterSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).CreateClusterSnapshot(ctx, req.(*CreateClusterSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DescribeClusterSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeClusterSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeClusterSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeClusterSnapshots(ctx, req.(*DescribeClusterSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_RestoreClusterFromSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreClusterFromSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RestoreClusterFromSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/RestoreClusterFromSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RestoreClusterFromSnapshot(ctx, req.(*RestoreClusterFromSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DeleteClusterSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClusterSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DeleteClusterSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DeleteClusterSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DeleteClusterSnapshots(ctx, req.(*DeleteClusterSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_ModifyClusterSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyClusterSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ModifyClusterSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/ModifyClusterSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ModifyClusterSnapshot(ctx, req.(*ModifyClusterSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_RunClusterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunClusterServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RunClusterService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/RunClusterService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RunClusterService(ctx, req.(*RunClusterServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DescribeClusterServiceAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterServiceAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeClusterServiceAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeClusterServiceAudits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeClusterServiceAudits(ctx, req.(*DescribeClusterServiceAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_ModifyClusterServiceAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyClusterServiceAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ModifyClusterServiceAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/ModifyClusterServiceAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ModifyClusterServiceAudit(ctx, req.(*ModifyClusterServiceAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_DescribeClusterMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeClusterMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ClusterManager/DescribeClusterMonitor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeClusterMonitor(ctx, req.(*DescribeClusterMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterManager_DeleteClusterInRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteClusterInRuntimeRequest)
 	if err := dec(in); err != nil {
@@ -6740,6 +10576,62 @@ var _ClusterManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetClusterStatistics",
 			Handler:    _ClusterManager_GetClusterStatistics_Handler,
 		},
+		{
+			MethodName: "CreateQuota",
+			Handler:    _ClusterManager_CreateQuota_Handler,
+		},
+		{
+			MethodName: "ModifyQuota",
+			Handler:    _ClusterManager_ModifyQuota_Handler,
+		},
+		{
+			MethodName: "DeleteQuotas",
+			Handler:    _ClusterManager_DeleteQuotas_Handler,
+		},
+		{
+			MethodName: "DescribeQuotas",
+			Handler:    _ClusterManager_DescribeQuotas_Handler,
+		},
+		{
+			MethodName: "DescribeQuotaUsage",
+			Handler:    _ClusterManager_DescribeQuotaUsage_Handler,
+		},
+		{
+			MethodName: "CreateClusterSnapshot",
+			Handler:    _ClusterManager_CreateClusterSnapshot_Handler,
+		},
+		{
+			MethodName: "DescribeClusterSnapshots",
+			Handler:    _ClusterManager_DescribeClusterSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreClusterFromSnapshot",
+			Handler:    _ClusterManager_RestoreClusterFromSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteClusterSnapshots",
+			Handler:    _ClusterManager_DeleteClusterSnapshots_Handler,
+		},
+		{
+			MethodName: "ModifyClusterSnapshot",
+			Handler:    _ClusterManager_ModifyClusterSnapshot_Handler,
+		},
+		{
+			MethodName: "RunClusterService",
+			Handler:    _ClusterManager_RunClusterService_Handler,
+		},
+		{
+			MethodName: "DescribeClusterServiceAudits",
+			Handler:    _ClusterManager_DescribeClusterServiceAudits_Handler,
+		},
+		{
+			MethodName: "ModifyClusterServiceAudit",
+			Handler:    _ClusterManager_ModifyClusterServiceAudit_Handler,
+		},
+		{
+			MethodName: "DescribeClusterMonitor",
+			Handler:    _ClusterManager_DescribeClusterMonitor_Handler,
+		},
 		{
 			MethodName: "DeleteClusterInRuntime",
 			Handler:    _ClusterManager_DeleteClusterInRuntime_Handler,
@@ -6749,6 +10641,22 @@ var _ClusterManager_serviceDesc = grpc.ServiceDesc{
 			Handler:    _ClusterManager_MigrateClusterInRuntime_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportClusters",
+			Handler:       _ClusterManager_ExportClusters_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportClusterNodes",
+			Handler:       _ClusterManager_ExportClusterNodes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RunClusterNodeCommand",
+			Handler:       _ClusterManager_RunClusterNodeCommand_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cluster.proto",
 }
@@ -31,14 +31,18 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Release struct {
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Version              *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Namespace            *wrappers.StringValue `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Status               *wrappers.StringValue `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Description          *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	FirstDeployedTime    *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=first_deployed_time,json=firstDeployedTime,proto3" json:"first_deployed_time,omitempty"`
-	LastDeployedTime     *timestamp.Timestamp  `protobuf:"bytes,7,opt,name=last_deployed_time,json=lastDeployedTime,proto3" json:"last_deployed_time,omitempty"`
-	DeletedTime          *timestamp.Timestamp  `protobuf:"bytes,8,opt,name=deleted_time,json=deletedTime,proto3" json:"deleted_time,omitempty"`
+	ReleaseName       *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Version           *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Namespace         *wrappers.StringValue `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Status            *wrappers.StringValue `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Description       *wrappers.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	FirstDeployedTime *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=first_deployed_time,json=firstDeployedTime,proto3" json:"first_deployed_time,omitempty"`
+	LastDeployedTime  *timestamp.Timestamp  `protobuf:"bytes,7,opt,name=last_deployed_time,json=lastDeployedTime,proto3" json:"last_deployed_time,omitempty"`
+	DeletedTime       *timestamp.Timestamp  `protobuf:"bytes,8,opt,name=deleted_time,json=deletedTime,proto3" json:"deleted_time,omitempty"`
+	// name and version of chart deployed in the revision, eg. [wordpress-0.3.0]
+	Chart *wrappers.StringValue `protobuf:"bytes,9,opt,name=chart,proto3" json:"chart,omitempty"`
+	// app version of chart deployed in the revision
+	AppVersion           *wrappers.StringValue `protobuf:"bytes,10,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -125,10 +129,27 @@ func (m *Release) GetDeletedTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Release) GetChart() *wrappers.StringValue {
+	if m != nil {
+		return m.Chart
+	}
+	return nil
+}
+
+func (m *Release) GetAppVersion() *wrappers.StringValue {
+	if m != nil {
+		return m.AppVersion
+	}
+	return nil
+}
+
 type ListReleasesRequest struct {
-	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Namespace            *wrappers.StringValue `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// required, id of runtime
+	RuntimeId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// namespace, default to all namespaces
+	Namespace *wrappers.StringValue `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// release status eg.[deployed|failed|uninstalled|all], default to deployed and failed
 	Status               *wrappers.StringValue `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -234,9 +255,9 @@ type CreateReleaseRequest struct {
 	VersionId *wrappers.StringValue `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	// required, id of runtime
 	RuntimeId *wrappers.StringValue `protobuf:"bytes,3,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	// release name
+	// required, release name, which is the name of cluster as well
 	ReleaseName *wrappers.StringValue `protobuf:"bytes,4,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	// namespace
+	// namespace, default to the zone of runtime
 	Namespace            *wrappers.StringValue `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -304,7 +325,11 @@ func (m *CreateReleaseRequest) GetNamespace() *wrappers.StringValue {
 }
 
 type CreateReleaseResponse struct {
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// id of cluster created for the release
+	ClusterId *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of the job installing the release
+	JobId                *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -342,10 +367,28 @@ func (m *CreateReleaseResponse) GetReleaseName() *wrappers.StringValue {
 	return nil
 }
 
+func (m *CreateReleaseResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *CreateReleaseResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
 type UpgradeReleaseRequest struct {
-	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	VersionId            *wrappers.StringValue `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// id of runtime, required with release_name if cluster_id is not set
+	RuntimeId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// required, id of app version to upgrade to
+	VersionId *wrappers.StringValue `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// id of kubernetes cluster
+	ClusterId            *wrappers.StringValue `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -397,8 +440,19 @@ func (m *UpgradeReleaseRequest) GetVersionId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *UpgradeReleaseRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
 type UpgradeReleaseResponse struct {
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// id of cluster
+	ClusterId *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of the job upgrading the cluster
+	JobId                *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -436,10 +490,28 @@ func (m *UpgradeReleaseResponse) GetReleaseName() *wrappers.StringValue {
 	return nil
 }
 
+func (m *UpgradeReleaseResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *UpgradeReleaseResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
 type RollbackReleaseRequest struct {
-	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Version              *wrappers.Int32Value  `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// id of runtime, required with release_name if cluster_id is not set
+	RuntimeId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// version of revision to roll back to, default to the previous revision
+	Version *wrappers.Int32Value `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// id of kubernetes cluster
+	ClusterId            *wrappers.StringValue `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -491,9 +563,21 @@ func (m *RollbackReleaseRequest) GetVersion() *wrappers.Int32Value {
 	return nil
 }
 
+func (m *RollbackReleaseRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
 type RollbackReleaseResponse struct {
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Version              *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// version of revision rolled back to
+	Version *wrappers.Int32Value `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// id of cluster
+	ClusterId *wrappers.StringValue `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of the job rolling back the cluster
+	JobId                *wrappers.StringValue `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -538,10 +622,28 @@ func (m *RollbackReleaseResponse) GetVersion() *wrappers.Int32Value {
 	return nil
 }
 
+func (m *RollbackReleaseResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *RollbackReleaseResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
 type DeleteReleaseRequest struct {
-	RuntimeId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
-	Purge                *wrappers.BoolValue   `protobuf:"bytes,3,opt,name=purge,proto3" json:"purge,omitempty"`
+	// id of runtime, required with release_name if cluster_id is not set
+	RuntimeId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// whether to delete the revision history and the cluster as well
+	Purge *wrappers.BoolValue `protobuf:"bytes,3,opt,name=purge,proto3" json:"purge,omitempty"`
+	// id of kubernetes cluster
+	ClusterId            *wrappers.StringValue `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -593,8 +695,19 @@ func (m *DeleteReleaseRequest) GetPurge() *wrappers.BoolValue {
 	return nil
 }
 
+func (m *DeleteReleaseRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
 type DeleteReleaseResponse struct {
-	ReleaseName          *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	ReleaseName *wrappers.StringValue `protobuf:"bytes,1,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// id of cluster
+	ClusterId *wrappers.StringValue `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// id of the job deleting the release
+	JobId                *wrappers.StringValue `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -632,6 +745,241 @@ func (m *DeleteReleaseResponse) GetReleaseName() *wrappers.StringValue {
 	return nil
 }
 
+func (m *DeleteReleaseResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DeleteReleaseResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type DescribeReleaseHistoryRequest struct {
+	// required, id of kubernetes cluster
+	ClusterId            *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DescribeReleaseHistoryRequest) Reset()         { *m = DescribeReleaseHistoryRequest{} }
+func (m *DescribeReleaseHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeReleaseHistoryRequest) ProtoMessage()    {}
+func (*DescribeReleaseHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141ba45979af1a8e, []int{11}
+}
+
+func (m *DescribeReleaseHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReleaseHistoryRequest.Unmarshal(m, b)
+}
+func (m *DescribeReleaseHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReleaseHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeReleaseHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReleaseHistoryRequest.Merge(m, src)
+}
+func (m *DescribeReleaseHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeReleaseHistoryRequest.Size(m)
+}
+func (m *DescribeReleaseHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReleaseHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReleaseHistoryRequest proto.InternalMessageInfo
+
+func (m *DescribeReleaseHistoryRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+type DescribeReleaseHistoryResponse struct {
+	// id of cluster
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// revisions of the release of cluster, ordered by version
+	ReleaseSet           []*Release `protobuf:"bytes,2,rep,name=release_set,json=releaseSet,proto3" json:"release_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DescribeReleaseHistoryResponse) Reset()         { *m = DescribeReleaseHistoryResponse{} }
+func (m *DescribeReleaseHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeReleaseHistoryResponse) ProtoMessage()    {}
+func (*DescribeReleaseHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141ba45979af1a8e, []int{12}
+}
+
+func (m *DescribeReleaseHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReleaseHistoryResponse.Unmarshal(m, b)
+}
+func (m *DescribeReleaseHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReleaseHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeReleaseHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReleaseHistoryResponse.Merge(m, src)
+}
+func (m *DescribeReleaseHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeReleaseHistoryResponse.Size(m)
+}
+func (m *DescribeReleaseHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReleaseHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReleaseHistoryResponse proto.InternalMessageInfo
+
+func (m *DescribeReleaseHistoryResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DescribeReleaseHistoryResponse) GetReleaseSet() []*Release {
+	if m != nil {
+		return m.ReleaseSet
+	}
+	return nil
+}
+
+type DiffReleaseRevisionsRequest struct {
+	// required, id of kubernetes cluster
+	ClusterId *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// required, version of revision to diff from
+	FromVersion *wrappers.Int32Value `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// version of revision to diff to, default to the current revision
+	ToVersion            *wrappers.Int32Value `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DiffReleaseRevisionsRequest) Reset()         { *m = DiffReleaseRevisionsRequest{} }
+func (m *DiffReleaseRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffReleaseRevisionsRequest) ProtoMessage()    {}
+func (*DiffReleaseRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141ba45979af1a8e, []int{13}
+}
+
+func (m *DiffReleaseRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffReleaseRevisionsRequest.Unmarshal(m, b)
+}
+func (m *DiffReleaseRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffReleaseRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *DiffReleaseRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffReleaseRevisionsRequest.Merge(m, src)
+}
+func (m *DiffReleaseRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffReleaseRevisionsRequest.Size(m)
+}
+func (m *DiffReleaseRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffReleaseRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffReleaseRevisionsRequest proto.InternalMessageInfo
+
+func (m *DiffReleaseRevisionsRequest) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DiffReleaseRevisionsRequest) GetFromVersion() *wrappers.Int32Value {
+	if m != nil {
+		return m.FromVersion
+	}
+	return nil
+}
+
+func (m *DiffReleaseRevisionsRequest) GetToVersion() *wrappers.Int32Value {
+	if m != nil {
+		return m.ToVersion
+	}
+	return nil
+}
+
+type DiffReleaseRevisionsResponse struct {
+	// id of cluster
+	ClusterId   *wrappers.StringValue `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	FromVersion *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   *wrappers.Int32Value  `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// unified diff of the user supplied values in yaml
+	ValuesDiff *wrappers.StringValue `protobuf:"bytes,4,opt,name=values_diff,json=valuesDiff,proto3" json:"values_diff,omitempty"`
+	// unified diff of the rendered manifest
+	ManifestDiff         *wrappers.StringValue `protobuf:"bytes,5,opt,name=manifest_diff,json=manifestDiff,proto3" json:"manifest_diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DiffReleaseRevisionsResponse) Reset()         { *m = DiffReleaseRevisionsResponse{} }
+func (m *DiffReleaseRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffReleaseRevisionsResponse) ProtoMessage()    {}
+func (*DiffReleaseRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141ba45979af1a8e, []int{14}
+}
+
+func (m *DiffReleaseRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffReleaseRevisionsResponse.Unmarshal(m, b)
+}
+func (m *DiffReleaseRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffReleaseRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *DiffReleaseRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffReleaseRevisionsResponse.Merge(m, src)
+}
+func (m *DiffReleaseRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffReleaseRevisionsResponse.Size(m)
+}
+func (m *DiffReleaseRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffReleaseRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffReleaseRevisionsResponse proto.InternalMessageInfo
+
+func (m *DiffReleaseRevisionsResponse) GetClusterId() *wrappers.StringValue {
+	if m != nil {
+		return m.ClusterId
+	}
+	return nil
+}
+
+func (m *DiffReleaseRevisionsResponse) GetFromVersion() *wrappers.Int32Value {
+	if m != nil {
+		return m.FromVersion
+	}
+	return nil
+}
+
+func (m *DiffReleaseRevisionsResponse) GetToVersion() *wrappers.Int32Value {
+	if m != nil {
+		return m.ToVersion
+	}
+	return nil
+}
+
+func (m *DiffReleaseRevisionsResponse) GetValuesDiff() *wrappers.StringValue {
+	if m != nil {
+		return m.ValuesDiff
+	}
+	return nil
+}
+
+func (m *DiffReleaseRevisionsResponse) GetManifestDiff() *wrappers.StringValue {
+	if m != nil {
+		return m.ManifestDiff
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Release)(nil), "openpitrix.Release")
 	proto.RegisterType((*ListReleasesRequest)(nil), "openpitrix.ListReleasesRequest")
@@ -644,61 +992,86 @@ func init() {
 	proto.RegisterType((*RollbackReleaseResponse)(nil), "openpitrix.RollbackReleaseResponse")
 	proto.RegisterType((*DeleteReleaseRequest)(nil), "openpitrix.DeleteReleaseRequest")
 	proto.RegisterType((*DeleteReleaseResponse)(nil), "openpitrix.DeleteReleaseResponse")
+	proto.RegisterType((*DescribeReleaseHistoryRequest)(nil), "openpitrix.DescribeReleaseHistoryRequest")
+	proto.RegisterType((*DescribeReleaseHistoryResponse)(nil), "openpitrix.DescribeReleaseHistoryResponse")
+	proto.RegisterType((*DiffReleaseRevisionsRequest)(nil), "openpitrix.DiffReleaseRevisionsRequest")
+	proto.RegisterType((*DiffReleaseRevisionsResponse)(nil), "openpitrix.DiffReleaseRevisionsResponse")
 }
 
 func init() { proto.RegisterFile("helm.proto", fileDescriptor_141ba45979af1a8e) }
 
 var fileDescriptor_141ba45979af1a8e = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x96, 0xf3, 0x57, 0x3a, 0x09, 0x69, 0xbb, 0xfd, 0x21, 0x72, 0x2b, 0x1a, 0x8c, 0x90, 0xaa,
-	0xd2, 0x26, 0x90, 0xc2, 0xa5, 0x08, 0x50, 0x4a, 0x0f, 0x94, 0xbf, 0x43, 0x0a, 0x08, 0xb8, 0x44,
-	0x9b, 0x78, 0x6b, 0x2c, 0x1c, 0x7b, 0xd9, 0xdd, 0xb4, 0xf4, 0x86, 0x10, 0x48, 0xbd, 0x12, 0x9e,
-	0x80, 0x57, 0xe1, 0xc0, 0x8d, 0x0b, 0xbc, 0x02, 0x0f, 0x82, 0x6c, 0xaf, 0x69, 0xd6, 0x0e, 0x6d,
-	0x4a, 0x2b, 0xe8, 0x29, 0x91, 0xe7, 0xfb, 0xbe, 0x99, 0x9d, 0x6f, 0x76, 0x16, 0xe0, 0x25, 0x71,
-	0x3a, 0x15, 0xca, 0x3c, 0xe1, 0x21, 0xf0, 0x28, 0x71, 0xa9, 0x2d, 0x98, 0xfd, 0x46, 0x9f, 0xb5,
-	0x3c, 0xcf, 0x72, 0x48, 0x35, 0x88, 0xb4, 0xba, 0x5b, 0x55, 0xd2, 0xa1, 0x62, 0x37, 0x04, 0xea,
-	0xe7, 0xe3, 0xc1, 0x1d, 0x86, 0x29, 0x25, 0x8c, 0xcb, 0xf8, 0x7c, 0x3c, 0x2e, 0xec, 0x0e, 0xe1,
-	0x02, 0x77, 0xa8, 0x04, 0xcc, 0x49, 0x00, 0xa6, 0x76, 0x15, 0xbb, 0xae, 0x27, 0xb0, 0xb0, 0x3d,
-	0x37, 0xa2, 0x2f, 0x05, 0x3f, 0xed, 0x65, 0x8b, 0xb8, 0xcb, 0x7c, 0x07, 0x5b, 0x16, 0x61, 0x55,
-	0x8f, 0x06, 0x88, 0x24, 0xda, 0xd8, 0xcb, 0xc0, 0x48, 0x83, 0x38, 0x04, 0x73, 0x82, 0x6e, 0x43,
-	0x81, 0x85, 0x7f, 0x9b, 0x2e, 0xee, 0x90, 0x92, 0x56, 0xd6, 0x16, 0xf2, 0xb5, 0xb9, 0x4a, 0x98,
-	0xae, 0x12, 0xd5, 0x53, 0xd9, 0x14, 0xcc, 0x76, 0xad, 0xa7, 0xd8, 0xe9, 0x92, 0x46, 0x5e, 0x32,
-	0x1e, 0xe1, 0x0e, 0x41, 0xd7, 0x61, 0x64, 0x9b, 0x30, 0x6e, 0x7b, 0x6e, 0x29, 0x15, 0x70, 0x67,
-	0x13, 0xdc, 0x0d, 0x57, 0xac, 0xd4, 0x42, 0x6a, 0x84, 0x45, 0xab, 0x30, 0xea, 0xe7, 0xe3, 0x14,
-	0xb7, 0x49, 0x29, 0x3d, 0x44, 0xd2, 0x7d, 0x38, 0xba, 0x06, 0x39, 0x2e, 0xb0, 0xe8, 0xf2, 0x52,
-	0x66, 0x08, 0xa2, 0xc4, 0xa2, 0x5b, 0x90, 0x37, 0x09, 0x6f, 0x33, 0x3b, 0xe8, 0x4b, 0x29, 0x3b,
-	0xcc, 0x41, 0xfb, 0x08, 0xe8, 0x1e, 0x4c, 0x6e, 0xd9, 0x8c, 0x8b, 0xa6, 0x49, 0xa8, 0xe3, 0xed,
-	0x12, 0xb3, 0xe9, 0x7b, 0x54, 0xca, 0x05, 0x3a, 0x7a, 0x42, 0xe7, 0x71, 0x64, 0x60, 0x63, 0x22,
-	0xa0, 0xad, 0x4b, 0x96, 0xff, 0x1d, 0xdd, 0x05, 0xe4, 0xe0, 0x84, 0xd4, 0xc8, 0xa1, 0x52, 0xe3,
-	0x3e, 0x4b, 0x51, 0xba, 0x09, 0x05, 0x93, 0x38, 0x44, 0x44, 0x1a, 0x67, 0x0e, 0xd5, 0xc8, 0x4b,
-	0xbc, 0xff, 0xc5, 0xf8, 0x90, 0x82, 0xc9, 0x07, 0x36, 0x17, 0x72, 0x1c, 0x78, 0x83, 0xbc, 0xee,
-	0x12, 0x2e, 0xd0, 0x0d, 0x00, 0xd6, 0x75, 0x7d, 0xc5, 0xa6, 0x6d, 0x0e, 0x35, 0x14, 0xa3, 0x12,
-	0xbf, 0x61, 0x26, 0x66, 0x2a, 0x75, 0xd4, 0x99, 0xfa, 0xe7, 0xc3, 0x61, 0xdc, 0x57, 0xda, 0xd0,
-	0x20, 0x9c, 0x7a, 0x2e, 0xf7, 0xc5, 0xa2, 0xba, 0x9a, 0x9c, 0x88, 0x92, 0x56, 0x4e, 0x2f, 0xe4,
-	0x6b, 0x93, 0x95, 0xfd, 0x5b, 0x5f, 0x89, 0x18, 0x20, 0x71, 0x9b, 0x44, 0x18, 0x5f, 0x52, 0x30,
-	0x75, 0x87, 0x11, 0x2c, 0xc8, 0x6f, 0xbd, 0xb0, 0xab, 0x2b, 0x90, 0xc3, 0x94, 0x0e, 0xdb, 0xd1,
-	0x2c, 0xa6, 0x74, 0xc3, 0xf4, 0xad, 0x90, 0x97, 0xc6, 0x27, 0x0e, 0xd3, 0xcb, 0x51, 0x89, 0x0f,
-	0xc9, 0x7d, 0x3e, 0xa6, 0x8f, 0xe7, 0x63, 0xe6, 0x58, 0x3e, 0x66, 0x8f, 0xe4, 0xa3, 0xf1, 0x0c,
-	0xa6, 0x63, 0x3d, 0x94, 0x9e, 0x1c, 0x77, 0x63, 0x19, 0xdf, 0x35, 0x98, 0x7e, 0x42, 0x2d, 0x86,
-	0xcd, 0xb8, 0x3f, 0xff, 0x77, 0xea, 0x55, 0xa3, 0xd3, 0x47, 0x32, 0xda, 0x78, 0x0e, 0x33, 0xf1,
-	0x33, 0x9d, 0x54, 0xbf, 0xbe, 0x69, 0x30, 0xd3, 0xf0, 0x1c, 0xa7, 0x85, 0xdb, 0xaf, 0x4e, 0x55,
-	0xc3, 0xfa, 0x9e, 0x9e, 0xf4, 0xf0, 0x4f, 0x8f, 0xf1, 0x51, 0x83, 0x73, 0x89, 0xf3, 0x9c, 0x50,
-	0xb3, 0xfe, 0xf2, 0x39, 0x34, 0xbe, 0x6a, 0x30, 0xb5, 0x1e, 0xec, 0xe5, 0x53, 0xd5, 0xe1, 0x2b,
-	0x90, 0xa5, 0x5d, 0x66, 0x45, 0x4b, 0x38, 0xf9, 0xac, 0xac, 0x79, 0x9e, 0x23, 0xb7, 0x55, 0x00,
-	0xf4, 0xaf, 0x6d, 0xec, 0x1c, 0x27, 0xd4, 0xd9, 0xda, 0xe7, 0x2c, 0x14, 0xa5, 0xe8, 0x43, 0xec,
-	0x62, 0x8b, 0x30, 0xb4, 0x03, 0x85, 0xfe, 0xc7, 0x0b, 0xcd, 0xf7, 0x6f, 0xe6, 0x01, 0xcf, 0x9a,
-	0xfe, 0x27, 0x40, 0x54, 0xa5, 0x71, 0xa9, 0x57, 0x2f, 0xa2, 0x40, 0xb4, 0x2c, 0x43, 0xef, 0x7e,
-	0xfc, 0xfc, 0x94, 0x2a, 0xa2, 0x42, 0x75, 0xfb, 0x6a, 0x95, 0x45, 0x89, 0xde, 0x6a, 0x70, 0x56,
-	0xd9, 0x4e, 0xa8, 0xdc, 0xaf, 0x3c, 0x68, 0xf9, 0xeb, 0x17, 0x0e, 0x40, 0xc8, 0xec, 0x8b, 0xbd,
-	0xfa, 0x38, 0x2a, 0x86, 0x31, 0x25, 0xff, 0x84, 0xae, 0xe4, 0x5f, 0xd5, 0x16, 0xd1, 0x7b, 0x0d,
-	0x8a, 0xea, 0x8d, 0x47, 0x4a, 0x86, 0x81, 0x1b, 0x4e, 0x37, 0x0e, 0x82, 0xc8, 0x2a, 0x2e, 0xf7,
-	0xea, 0x13, 0x68, 0x4c, 0x06, 0xd5, 0x32, 0x6a, 0x89, 0x32, 0xf6, 0x34, 0x18, 0x8b, 0x5d, 0x26,
-	0xa4, 0x24, 0x19, 0xbc, 0x39, 0xf4, 0x8b, 0x07, 0x62, 0x64, 0x25, 0x4b, 0xbd, 0x3a, 0x42, 0xe3,
-	0x51, 0x54, 0x2d, 0xc5, 0x48, 0x94, 0xe2, 0x9b, 0xa2, 0xcc, 0x9e, 0x6a, 0xca, 0xa0, 0xeb, 0xa5,
-	0x9a, 0x32, 0x70, 0x70, 0xa5, 0x29, 0x61, 0x4c, 0x2d, 0x61, 0x31, 0x5e, 0xc2, 0x5a, 0xe6, 0x45,
-	0x8a, 0xb6, 0x5a, 0xb9, 0x60, 0x98, 0x57, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0a, 0xf9, 0x10,
-	0xa6, 0x2a, 0x0c, 0x00, 0x00,
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xd6, 0xae, 0xed, 0xa4, 0x79, 0x76, 0xd3, 0x64, 0x92, 0x06, 0x6b, 0x13, 0xda, 0xad, 0x11,
+	0x22, 0x84, 0xd6, 0x06, 0x87, 0x5e, 0x52, 0xb5, 0xc8, 0x25, 0x87, 0xa4, 0xfc, 0x38, 0xb8, 0x25,
+	0x07, 0x84, 0x64, 0x8d, 0xbd, 0xb3, 0xce, 0x96, 0xf5, 0xce, 0x30, 0x33, 0x4e, 0xc8, 0x0d, 0x51,
+	0x90, 0x10, 0x17, 0x24, 0xf7, 0xef, 0xe1, 0x82, 0xc4, 0x05, 0x4e, 0x08, 0x09, 0x89, 0x3b, 0xff,
+	0x05, 0x17, 0x34, 0xbb, 0xb3, 0xe9, 0xae, 0xed, 0x38, 0xeb, 0x3a, 0xa2, 0xed, 0x29, 0xf1, 0xce,
+	0xf7, 0x7e, 0x7d, 0xdf, 0x9b, 0xb7, 0x6f, 0x01, 0x0e, 0x89, 0xdf, 0xab, 0x32, 0x4e, 0x25, 0x45,
+	0x40, 0x19, 0x09, 0x98, 0x27, 0xb9, 0xf7, 0xb5, 0xb5, 0xde, 0xa5, 0xb4, 0xeb, 0x93, 0x5a, 0x78,
+	0xd2, 0xee, 0xbb, 0x35, 0xd2, 0x63, 0xf2, 0x24, 0x02, 0x5a, 0xd7, 0x86, 0x0f, 0x8f, 0x39, 0x66,
+	0x8c, 0x70, 0xa1, 0xcf, 0xaf, 0x0f, 0x9f, 0x4b, 0xaf, 0x47, 0x84, 0xc4, 0x3d, 0xa6, 0x01, 0x1b,
+	0x1a, 0x80, 0x99, 0x57, 0xc3, 0x41, 0x40, 0x25, 0x96, 0x1e, 0x0d, 0x62, 0xf3, 0x9b, 0xe1, 0x9f,
+	0xce, 0xad, 0x2e, 0x09, 0x6e, 0x89, 0x63, 0xdc, 0xed, 0x12, 0x5e, 0xa3, 0x2c, 0x44, 0x8c, 0xa2,
+	0x2b, 0xff, 0xe6, 0x61, 0xbe, 0x49, 0x7c, 0x82, 0x05, 0x41, 0x1f, 0x40, 0x89, 0x47, 0xff, 0xb6,
+	0x02, 0xdc, 0x23, 0x65, 0xc3, 0x36, 0x36, 0x8b, 0xf5, 0x8d, 0x6a, 0x14, 0xae, 0x1a, 0xe7, 0x53,
+	0x7d, 0x28, 0xb9, 0x17, 0x74, 0x0f, 0xb0, 0xdf, 0x27, 0xcd, 0xa2, 0xb6, 0xf8, 0x14, 0xf7, 0x08,
+	0xba, 0x0d, 0xf3, 0x47, 0x84, 0x0b, 0x8f, 0x06, 0x65, 0x33, 0xb4, 0x5d, 0x1f, 0xb1, 0xdd, 0x0f,
+	0xe4, 0x76, 0x3d, 0x32, 0x8d, 0xb1, 0x68, 0x07, 0x16, 0x54, 0x3c, 0xc1, 0x70, 0x87, 0x94, 0x73,
+	0x19, 0x82, 0x3e, 0x83, 0xa3, 0xf7, 0x61, 0x4e, 0x48, 0x2c, 0xfb, 0xa2, 0x9c, 0xcf, 0x60, 0xa8,
+	0xb1, 0xe8, 0x1e, 0x14, 0x1d, 0x22, 0x3a, 0xdc, 0x0b, 0x79, 0x29, 0x17, 0xb2, 0x14, 0x9a, 0x30,
+	0x40, 0x0f, 0x60, 0xc5, 0xf5, 0xb8, 0x90, 0x2d, 0x87, 0x30, 0x9f, 0x9e, 0x10, 0xa7, 0xa5, 0x34,
+	0x2a, 0xcf, 0x85, 0x7e, 0xac, 0x11, 0x3f, 0x8f, 0x62, 0x01, 0x9b, 0xcb, 0xa1, 0xd9, 0xae, 0xb6,
+	0x52, 0xcf, 0xd1, 0x1e, 0x20, 0x1f, 0x8f, 0xb8, 0x9a, 0x3f, 0xd7, 0xd5, 0x92, 0x8f, 0x87, 0x3c,
+	0xdd, 0x85, 0x92, 0x43, 0x7c, 0x22, 0x63, 0x1f, 0x97, 0xce, 0xf5, 0x51, 0xd4, 0xf8, 0xd0, 0xbc,
+	0x0e, 0x85, 0xce, 0x21, 0xe6, 0xb2, 0xbc, 0x90, 0x81, 0x8e, 0x08, 0x8a, 0xee, 0x42, 0x11, 0x33,
+	0xd6, 0x8a, 0x55, 0x87, 0x0c, 0x96, 0x80, 0x19, 0x3b, 0x88, 0xf0, 0x95, 0xef, 0x4d, 0x58, 0xf9,
+	0xd8, 0x13, 0x52, 0x77, 0xa0, 0x68, 0x92, 0xaf, 0xfa, 0x44, 0x48, 0x74, 0x07, 0x80, 0xf7, 0x03,
+	0x55, 0x44, 0xcb, 0x73, 0x32, 0xf5, 0xe1, 0x82, 0xc6, 0xef, 0x3b, 0x23, 0x6d, 0x6c, 0x4e, 0xdb,
+	0xc6, 0xff, 0x7b, 0x3f, 0x56, 0x3e, 0x4a, 0xd1, 0xd0, 0x24, 0x82, 0xd1, 0x40, 0x28, 0x67, 0x71,
+	0x5e, 0x2d, 0x41, 0x64, 0xd9, 0xb0, 0x73, 0x9b, 0xc5, 0xfa, 0x4a, 0xf5, 0xd9, 0xa0, 0xa9, 0xc6,
+	0x16, 0xa0, 0x71, 0x0f, 0x89, 0xac, 0xfc, 0x62, 0xc2, 0xea, 0x87, 0x9c, 0x60, 0x49, 0x4e, 0xfd,
+	0x45, 0xac, 0x6e, 0xc3, 0x9c, 0x12, 0x2b, 0x23, 0xa3, 0x05, 0xcc, 0xd8, 0xbe, 0xa3, 0xa4, 0xd0,
+	0xea, 0x2a, 0xc3, 0x2c, 0x5c, 0x2e, 0x68, 0x7c, 0x64, 0x9c, 0xd0, 0x31, 0x37, 0x9b, 0x8e, 0xf9,
+	0x99, 0x74, 0x2c, 0x4c, 0xa5, 0x63, 0xe5, 0x37, 0x03, 0xae, 0x0e, 0x91, 0xa8, 0x45, 0x99, 0x79,
+	0x4a, 0xde, 0x01, 0xe8, 0xf8, 0x7d, 0x21, 0x09, 0xcf, 0xcc, 0xa8, 0xc6, 0xef, 0x3b, 0x4a, 0xc3,
+	0xc7, 0xb4, 0x9d, 0x95, 0xcd, 0xc2, 0x63, 0xda, 0xde, 0x77, 0x2a, 0x3f, 0x99, 0x70, 0xf5, 0x33,
+	0xd6, 0xe5, 0xd8, 0x19, 0x6e, 0x89, 0x17, 0x7b, 0xd1, 0xd2, 0xbd, 0x95, 0x9b, 0xba, 0xb7, 0x12,
+	0x34, 0xe6, 0xa7, 0xa2, 0xb1, 0xf2, 0xbb, 0x01, 0x6b, 0xc3, 0x8c, 0xbc, 0xba, 0xfa, 0xfe, 0x68,
+	0xc2, 0x5a, 0x93, 0xfa, 0x7e, 0x1b, 0x77, 0xbe, 0x7c, 0xa9, 0x04, 0x4e, 0x2c, 0x04, 0xb9, 0x29,
+	0x16, 0x82, 0x99, 0xa4, 0x7d, 0x62, 0xc2, 0x6b, 0x23, 0x64, 0x5c, 0x94, 0xb6, 0xcf, 0xb9, 0xe1,
+	0xa4, 0x0b, 0xca, 0x3d, 0x6f, 0x4b, 0xe4, 0xb3, 0xb7, 0xc4, 0x13, 0x13, 0x56, 0x77, 0xc3, 0x97,
+	0xfb, 0x4b, 0xd5, 0x10, 0xef, 0x42, 0x81, 0xf5, 0x79, 0x37, 0x7e, 0xad, 0x8e, 0xee, 0x26, 0xf7,
+	0x29, 0xf5, 0x75, 0x21, 0x21, 0x70, 0xb6, 0x5e, 0x50, 0x53, 0x7c, 0x88, 0x85, 0x57, 0xf7, 0x96,
+	0x7f, 0x01, 0xaf, 0xef, 0x86, 0x3b, 0x68, 0x3b, 0xae, 0x66, 0xcf, 0x13, 0x92, 0xf2, 0x93, 0x84,
+	0xb4, 0x89, 0x94, 0x8c, 0xe9, 0xa8, 0x7a, 0x6a, 0xc0, 0xb5, 0xb3, 0xdc, 0x6b, 0xce, 0x66, 0xf1,
+	0x3f, 0xbc, 0xcb, 0x98, 0xd9, 0x76, 0x99, 0xbf, 0x0c, 0x58, 0xdf, 0xf5, 0x5c, 0x37, 0x3e, 0x23,
+	0x47, 0x9e, 0xba, 0x50, 0xe2, 0x22, 0x4a, 0x46, 0xf7, 0xa0, 0xe4, 0x72, 0xda, 0x6b, 0x4d, 0x71,
+	0xa3, 0x8b, 0xca, 0xe0, 0xe0, 0xf4, 0xbb, 0x05, 0x24, 0x6d, 0x4d, 0x31, 0xe0, 0x16, 0x24, 0x8d,
+	0x37, 0xdf, 0xbf, 0x4d, 0xd8, 0x18, 0x5f, 0xd8, 0x45, 0x90, 0xfd, 0x02, 0x2b, 0x53, 0x9f, 0x04,
+	0x47, 0xea, 0x99, 0x68, 0x39, 0x9e, 0xeb, 0x66, 0xba, 0xb1, 0x10, 0x19, 0x28, 0x3e, 0x50, 0x03,
+	0x2e, 0xf7, 0x70, 0xe0, 0xb9, 0x44, 0xc8, 0xc8, 0x41, 0x96, 0xc5, 0xad, 0x14, 0x9b, 0x28, 0x17,
+	0xf5, 0x9f, 0x2f, 0xc1, 0xa2, 0xe6, 0xf5, 0x13, 0x1c, 0xe0, 0x2e, 0xe1, 0xe8, 0x18, 0x4a, 0xc9,
+	0xef, 0x0c, 0x74, 0x3d, 0xd9, 0x78, 0x63, 0xbe, 0x40, 0xac, 0xb3, 0x00, 0xb1, 0x3e, 0x95, 0x37,
+	0x07, 0x8d, 0x45, 0x14, 0x3a, 0xb5, 0xf5, 0xd1, 0xb7, 0x7f, 0xfe, 0xf3, 0xd4, 0x5c, 0x44, 0xa5,
+	0xda, 0xd1, 0x7b, 0x35, 0x1e, 0x07, 0xfa, 0xc6, 0x80, 0xcb, 0xa9, 0x3d, 0x12, 0xd9, 0x49, 0xcf,
+	0xe3, 0xf6, 0x74, 0xeb, 0xc6, 0x04, 0x84, 0x8e, 0xbe, 0x35, 0x68, 0x2c, 0xa1, 0xc5, 0xe8, 0x2c,
+	0x15, 0x7f, 0xd9, 0x4a, 0xc5, 0xdf, 0x31, 0xb6, 0xd0, 0x77, 0x06, 0x2c, 0xa6, 0x77, 0x1d, 0x94,
+	0x8a, 0x30, 0x76, 0x33, 0xb4, 0x2a, 0x93, 0x20, 0x3a, 0x8b, 0x77, 0x06, 0x8d, 0x65, 0x74, 0x45,
+	0x1f, 0xa6, 0xd3, 0xd8, 0x31, 0xb6, 0xea, 0x69, 0x26, 0x7e, 0x30, 0xe0, 0xca, 0xd0, 0x7b, 0x19,
+	0xa5, 0x82, 0x8c, 0xdf, 0x60, 0xac, 0x37, 0x26, 0x62, 0x74, 0x26, 0x37, 0x07, 0x0d, 0x84, 0x96,
+	0xe2, 0xd3, 0x74, 0x2a, 0x95, 0x11, 0x46, 0x94, 0x28, 0xa9, 0xd7, 0x42, 0x5a, 0x94, 0x71, 0xef,
+	0x4d, 0xeb, 0xc6, 0x04, 0x44, 0x4a, 0x94, 0xe8, 0x6c, 0x84, 0x8d, 0xad, 0x34, 0x1b, 0xbf, 0x1a,
+	0xb0, 0x36, 0x7e, 0xdc, 0xa2, 0xb7, 0xd3, 0x91, 0x26, 0x4c, 0x7c, 0x6b, 0x2b, 0x0b, 0x54, 0x67,
+	0xf7, 0x68, 0xd0, 0xb8, 0x8d, 0xb6, 0x63, 0x90, 0xcd, 0xf5, 0xc4, 0xb1, 0x0f, 0x23, 0x9c, 0x4d,
+	0x5d, 0x5b, 0x1e, 0xaa, 0xe7, 0xa1, 0xb5, 0xfa, 0xa9, 0xe7, 0x49, 0x58, 0xc2, 0x1a, 0x5a, 0x4d,
+	0xe6, 0x5f, 0xd3, 0x56, 0xe8, 0x0f, 0x03, 0x56, 0xc7, 0xcd, 0x31, 0xf4, 0x56, 0x2a, 0xb5, 0xb3,
+	0x47, 0xb8, 0xb5, 0x79, 0x3e, 0x50, 0x57, 0xe0, 0x0e, 0x1a, 0x0f, 0xd0, 0x9e, 0x82, 0xd8, 0xd1,
+	0xc0, 0xb0, 0x71, 0xe0, 0xd8, 0xf1, 0xd5, 0xb7, 0xdb, 0x44, 0x1e, 0x13, 0x12, 0xd8, 0xf2, 0x98,
+	0x9e, 0x56, 0x27, 0xce, 0x29, 0x6b, 0x05, 0x2d, 0xa7, 0xca, 0x52, 0x13, 0xe7, 0x7e, 0xfe, 0x73,
+	0x93, 0xb5, 0xdb, 0x73, 0xe1, 0xa4, 0xd9, 0xfe, 0x6f, 0x00, 0xde, 0x0e, 0x12, 0xc3, 0xe4, 0x13,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -718,6 +1091,8 @@ type ReleaseManagerClient interface {
 	UpgradeRelease(ctx context.Context, in *UpgradeReleaseRequest, opts ...grpc.CallOption) (*UpgradeReleaseResponse, error)
 	RollbackRelease(ctx context.Context, in *RollbackReleaseRequest, opts ...grpc.CallOption) (*RollbackReleaseResponse, error)
 	DeleteRelease(ctx context.Context, in *DeleteReleaseRequest, opts ...grpc.CallOption) (*DeleteReleaseResponse, error)
+	DescribeReleaseHistory(ctx context.Context, in *DescribeReleaseHistoryRequest, opts ...grpc.CallOption) (*DescribeReleaseHistoryResponse, error)
+	DiffReleaseRevisions(ctx context.Context, in *DiffReleaseRevisionsRequest, opts ...grpc.CallOption) (*DiffReleaseRevisionsResponse, error)
 }
 
 type releaseManagerClient struct {
@@ -773,6 +1148,24 @@ func (c *releaseManagerClient) DeleteRelease(ctx context.Context, in *DeleteRele
 	return out, nil
 }
 
+func (c *releaseManagerClient) DescribeReleaseHistory(ctx context.Context, in *DescribeReleaseHistoryRequest, opts ...grpc.CallOption) (*DescribeReleaseHistoryResponse, error) {
+	out := new(DescribeReleaseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ReleaseManager/DescribeReleaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *releaseManagerClient) DiffReleaseRevisions(ctx context.Context, in *DiffReleaseRevisionsRequest, opts ...grpc.CallOption) (*DiffReleaseRevisionsResponse, error) {
+	out := new(DiffReleaseRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.ReleaseManager/DiffReleaseRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReleaseManagerServer is the server API for ReleaseManager service.
 type ReleaseManagerServer interface {
 	ListReleases(context.Context, *ListReleasesRequest) (*ListReleaseResponse, error)
@@ -780,6 +1173,8 @@ type ReleaseManagerServer interface {
 	UpgradeRelease(context.Context, *UpgradeReleaseRequest) (*UpgradeReleaseResponse, error)
 	RollbackRelease(context.Context, *RollbackReleaseRequest) (*RollbackReleaseResponse, error)
 	DeleteRelease(context.Context, *DeleteReleaseRequest) (*DeleteReleaseResponse, error)
+	DescribeReleaseHistory(context.Context, *DescribeReleaseHistoryRequest) (*DescribeReleaseHistoryResponse, error)
+	DiffReleaseRevisions(context.Context, *DiffReleaseRevisionsRequest) (*DiffReleaseRevisionsResponse, error)
 }
 
 // UnimplementedReleaseManagerServer can be embedded to have forward compatible implementations.
@@ -801,6 +1196,12 @@ func (*UnimplementedReleaseManagerServer) RollbackRelease(ctx context.Context, r
 func (*UnimplementedReleaseManagerServer) DeleteRelease(ctx context.Context, req *DeleteReleaseRequest) (*DeleteReleaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
 }
+func (*UnimplementedReleaseManagerServer) DescribeReleaseHistory(ctx context.Context, req *DescribeReleaseHistoryRequest) (*DescribeReleaseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReleaseHistory not implemented")
+}
+func (*UnimplementedReleaseManagerServer) DiffReleaseRevisions(ctx context.Context, req *DiffReleaseRevisionsRequest) (*DiffReleaseRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffReleaseRevisions not implemented")
+}
 
 func RegisterReleaseManagerServer(s *grpc.Server, srv ReleaseManagerServer) {
 	s.RegisterService(&_ReleaseManager_serviceDesc, srv)
@@ -896,6 +1297,42 @@ func _ReleaseManager_DeleteRelease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReleaseManager_DescribeReleaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReleaseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseManagerServer).DescribeReleaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ReleaseManager/DescribeReleaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseManagerServer).DescribeReleaseHistory(ctx, req.(*DescribeReleaseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReleaseManager_DiffReleaseRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffReleaseRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseManagerServer).DiffReleaseRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.ReleaseManager/DiffReleaseRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseManagerServer).DiffReleaseRevisions(ctx, req.(*DiffReleaseRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReleaseManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.ReleaseManager",
 	HandlerType: (*ReleaseManagerServer)(nil),
@@ -920,6 +1357,14 @@ var _ReleaseManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRelease",
 			Handler:    _ReleaseManager_DeleteRelease_Handler,
 		},
+		{
+			MethodName: "DescribeReleaseHistory",
+			Handler:    _ReleaseManager_DescribeReleaseHistory_Handler,
+		},
+		{
+			MethodName: "DiffReleaseRevisions",
+			Handler:    _ReleaseManager_DiffReleaseRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "helm.proto",
@@ -43,10 +43,14 @@ type CreateJobRequest struct {
 	// required, directive, a json string, describe the info of running the job action
 	Directive *wrappers.StringValue `protobuf:"bytes,6,opt,name=directive,proto3" json:"directive,omitempty"`
 	// required, runtime id
-	RuntimeId            *wrappers.StringValue `protobuf:"bytes,7,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,7,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// priority of job, range [0-9], default 5, job with higher priority is dispatched first
+	Priority *wrappers.UInt32Value `protobuf:"bytes,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// the time to dispatch the job, job is dispatched immediately if not set
+	ScheduledTime        *timestamp.Timestamp `protobuf:"bytes,9,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
@@ -123,6 +127,20 @@ func (m *CreateJobRequest) GetRuntimeId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *CreateJobRequest) GetPriority() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetScheduledTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
 type CreateJobResponse struct {
 	// id of job created
 	JobId *wrappers.StringValue `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -210,7 +228,7 @@ type Job struct {
 	VersionId *wrappers.StringValue `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	// describe job's action eg:[CreateCluster|StartClusters|...]
 	JobAction *wrappers.StringValue `protobuf:"bytes,5,opt,name=job_action,json=jobAction,proto3" json:"job_action,omitempty"`
-	// status eg.[successful|failed|running|pending]
+	// status eg.[successful|failed|running|pending|scheduled|cancelled]
 	Status *wrappers.StringValue `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	// error code, if job run failed will return a error code
 	ErrorCode *wrappers.UInt32Value `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
@@ -231,10 +249,14 @@ type Job struct {
 	// record the status changed time
 	StatusTime *timestamp.Timestamp `protobuf:"bytes,15,opt,name=status_time,json=statusTime,proto3" json:"status_time,omitempty"`
 	// owner
-	Owner                *wrappers.StringValue `protobuf:"bytes,16,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Owner *wrappers.StringValue `protobuf:"bytes,16,opt,name=owner,proto3" json:"owner,omitempty"`
+	// priority of job, job with higher priority is dispatched first
+	Priority *wrappers.UInt32Value `protobuf:"bytes,17,opt,name=priority,proto3" json:"priority,omitempty"`
+	// the time to dispatch the job
+	ScheduledTime        *timestamp.Timestamp `protobuf:"bytes,18,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Job) Reset()         { *m = Job{} }
@@ -374,6 +396,20 @@ func (m *Job) GetOwner() *wrappers.StringValue {
 	return nil
 }
 
+func (m *Job) GetPriority() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
+func (m *Job) GetScheduledTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
 type DescribeJobsRequest struct {
 	// query key, support these fields(job_id, cluster_id, app_id, version_id, executor, provider, status, owner)
 	SearchWord *wrappers.StringValue `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
@@ -401,13 +437,15 @@ type DescribeJobsRequest struct {
 	Provider *wrappers.StringValue `protobuf:"bytes,16,opt,name=provider,proto3" json:"provider,omitempty"`
 	// runtime id
 	RuntimeId *wrappers.StringValue `protobuf:"bytes,17,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	// status eg.[successful|failed|running|pending]
+	// status eg.[successful|failed|running|pending|scheduled|cancelled]
 	Status []string `protobuf:"bytes,18,rep,name=status,proto3" json:"status,omitempty"`
 	// owner
-	Owner                []string `protobuf:"bytes,19,rep,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Owner []string `protobuf:"bytes,19,rep,name=owner,proto3" json:"owner,omitempty"`
+	// page token of the next page returned by previous page, the offset is ignored if it is set
+	PageToken            *wrappers.StringValue `protobuf:"bytes,20,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeJobsRequest) Reset()         { *m = DescribeJobsRequest{} }
@@ -540,21 +578,244 @@ func (m *DescribeJobsRequest) GetOwner() []string {
 	return nil
 }
 
+func (m *DescribeJobsRequest) GetPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
+type CancelJobRequest struct {
+	// required, id of job to cancel
+	JobId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CancelJobRequest) Reset()         { *m = CancelJobRequest{} }
+func (m *CancelJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelJobRequest) ProtoMessage()    {}
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{4}
+}
+
+func (m *CancelJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelJobRequest.Unmarshal(m, b)
+}
+func (m *CancelJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelJobRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobRequest.Merge(m, src)
+}
+func (m *CancelJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelJobRequest.Size(m)
+}
+func (m *CancelJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelJobRequest proto.InternalMessageInfo
+
+func (m *CancelJobRequest) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type CancelJobResponse struct {
+	// id of job cancelled
+	JobId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CancelJobResponse) Reset()         { *m = CancelJobResponse{} }
+func (m *CancelJobResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelJobResponse) ProtoMessage()    {}
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{5}
+}
+
+func (m *CancelJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelJobResponse.Unmarshal(m, b)
+}
+func (m *CancelJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelJobResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobResponse.Merge(m, src)
+}
+func (m *CancelJobResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelJobResponse.Size(m)
+}
+func (m *CancelJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelJobResponse proto.InternalMessageInfo
+
+func (m *CancelJobResponse) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type WatchJobRequest struct {
+	// required, id of job to watch
+	JobId                *wrappers.StringValue `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WatchJobRequest) Reset()         { *m = WatchJobRequest{} }
+func (m *WatchJobRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobRequest) ProtoMessage()    {}
+func (*WatchJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{6}
+}
+
+func (m *WatchJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobRequest.Unmarshal(m, b)
+}
+func (m *WatchJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobRequest.Merge(m, src)
+}
+func (m *WatchJobRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchJobRequest.Size(m)
+}
+func (m *WatchJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobRequest proto.InternalMessageInfo
+
+func (m *WatchJobRequest) GetJobId() *wrappers.StringValue {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+type WatchJobResponse struct {
+	// event type eg.[snapshot|job_status|task_layer|task_status|error]
+	EventType *wrappers.StringValue `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// job, set in snapshot and job_status event
+	Job *Job `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	// task layers of job with status of tasks, set in snapshot event
+	TaskLayer *TaskLayer `protobuf:"bytes,3,opt,name=task_layer,json=taskLayer,proto3" json:"task_layer,omitempty"`
+	// index of task layer starts to run, set in task_layer event
+	LayerIndex *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=layer_index,json=layerIndex,proto3" json:"layer_index,omitempty"`
+	// task whose status changed, set in task_status event
+	Task *Task `protobuf:"bytes,5,opt,name=task,proto3" json:"task,omitempty"`
+	// error message, set in error event
+	ErrorMessage *wrappers.StringValue `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// the time event happened
+	EventTime            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WatchJobResponse) Reset()         { *m = WatchJobResponse{} }
+func (m *WatchJobResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchJobResponse) ProtoMessage()    {}
+func (*WatchJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{7}
+}
+
+func (m *WatchJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobResponse.Unmarshal(m, b)
+}
+func (m *WatchJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobResponse.Merge(m, src)
+}
+func (m *WatchJobResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchJobResponse.Size(m)
+}
+func (m *WatchJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobResponse proto.InternalMessageInfo
+
+func (m *WatchJobResponse) GetEventType() *wrappers.StringValue {
+	if m != nil {
+		return m.EventType
+	}
+	return nil
+}
+
+func (m *WatchJobResponse) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *WatchJobResponse) GetTaskLayer() *TaskLayer {
+	if m != nil {
+		return m.TaskLayer
+	}
+	return nil
+}
+
+func (m *WatchJobResponse) GetLayerIndex() *wrappers.UInt32Value {
+	if m != nil {
+		return m.LayerIndex
+	}
+	return nil
+}
+
+func (m *WatchJobResponse) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *WatchJobResponse) GetErrorMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return nil
+}
+
+func (m *WatchJobResponse) GetEventTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EventTime
+	}
+	return nil
+}
+
 type DescribeJobsResponse struct {
 	// total count of job
 	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// list of job
-	JobSet               []*Job   `protobuf:"bytes,2,rep,name=job_set,json=jobSet,proto3" json:"job_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	JobSet []*Job `protobuf:"bytes,2,rep,name=job_set,json=jobSet,proto3" json:"job_set,omitempty"`
+	// page token of the next page, empty if there are no more jobs
+	NextPageToken        *wrappers.StringValue `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeJobsResponse) Reset()         { *m = DescribeJobsResponse{} }
 func (m *DescribeJobsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeJobsResponse) ProtoMessage()    {}
 func (*DescribeJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f32c477d91a04ead, []int{4}
+	return fileDescriptor_f32c477d91a04ead, []int{8}
 }
 
 func (m *DescribeJobsResponse) XXX_Unmarshal(b []byte) error {
@@ -589,75 +850,114 @@ func (m *DescribeJobsResponse) GetJobSet() []*Job {
 	return nil
 }
 
+func (m *DescribeJobsResponse) GetNextPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateJobRequest)(nil), "openpitrix.CreateJobRequest")
 	proto.RegisterType((*CreateJobResponse)(nil), "openpitrix.CreateJobResponse")
 	proto.RegisterType((*Job)(nil), "openpitrix.Job")
 	proto.RegisterType((*DescribeJobsRequest)(nil), "openpitrix.DescribeJobsRequest")
+	proto.RegisterType((*CancelJobRequest)(nil), "openpitrix.CancelJobRequest")
+	proto.RegisterType((*CancelJobResponse)(nil), "openpitrix.CancelJobResponse")
+	proto.RegisterType((*WatchJobRequest)(nil), "openpitrix.WatchJobRequest")
+	proto.RegisterType((*WatchJobResponse)(nil), "openpitrix.WatchJobResponse")
 	proto.RegisterType((*DescribeJobsResponse)(nil), "openpitrix.DescribeJobsResponse")
 }
 
 func init() { proto.RegisterFile("job.proto", fileDescriptor_f32c477d91a04ead) }
 
 var fileDescriptor_f32c477d91a04ead = []byte{
-	// 901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4f, 0x6f, 0x23, 0x35,
-	0x14, 0x57, 0x92, 0xa6, 0x49, 0x9c, 0xfe, 0x5b, 0x6f, 0x59, 0x8d, 0xa2, 0x42, 0xad, 0x5e, 0x08,
-	0xd2, 0x6c, 0x22, 0xd2, 0x95, 0x58, 0xb1, 0xe2, 0xb0, 0x5b, 0x24, 0x68, 0x11, 0x12, 0xca, 0xf2,
-	0x47, 0xe2, 0x32, 0xf2, 0xcc, 0xbc, 0x24, 0xce, 0x4e, 0xc7, 0xc6, 0xf6, 0x34, 0xdb, 0x2b, 0x12,
-	0x12, 0x67, 0xb8, 0xf2, 0x19, 0x90, 0x90, 0xb8, 0x73, 0xe6, 0xcc, 0x57, 0xe0, 0x03, 0xf0, 0x11,
-	0x90, 0xed, 0x99, 0x74, 0xba, 0xdd, 0x8a, 0x99, 0x72, 0xdc, 0x53, 0x34, 0xf6, 0xef, 0x97, 0xf7,
-	0xfc, 0x7e, 0xef, 0xf7, 0x6c, 0xd4, 0x5b, 0xf2, 0x70, 0x24, 0x24, 0xd7, 0x1c, 0x23, 0x2e, 0x20,
-	0x15, 0x4c, 0x4b, 0xf6, 0x72, 0xf0, 0xce, 0x9c, 0xf3, 0x79, 0x02, 0x63, 0xbb, 0x13, 0x66, 0xb3,
-	0xf1, 0x4a, 0x52, 0x21, 0x40, 0x2a, 0x87, 0x1d, 0x1c, 0xbe, 0xba, 0xaf, 0xd9, 0x39, 0x28, 0x4d,
-	0xcf, 0x45, 0x0e, 0x38, 0xc8, 0x01, 0x54, 0xb0, 0x31, 0x4d, 0x53, 0xae, 0xa9, 0x66, 0x3c, 0x2d,
-	0xe8, 0xbe, 0xfd, 0x89, 0x1e, 0xce, 0x21, 0x7d, 0xa8, 0x56, 0x74, 0x3e, 0x07, 0x39, 0xe6, 0xc2,
-	0x22, 0x6e, 0xa2, 0x8f, 0x7e, 0x6b, 0xa1, 0xbd, 0x13, 0x09, 0x54, 0xc3, 0x19, 0x0f, 0xa7, 0xf0,
-	0x5d, 0x06, 0x4a, 0xe3, 0x27, 0x08, 0x45, 0x49, 0xa6, 0x34, 0xc8, 0x80, 0xc5, 0x5e, 0x83, 0x34,
-	0x86, 0xfd, 0xc9, 0xc1, 0xc8, 0x45, 0x1d, 0x15, 0x69, 0x8d, 0x9e, 0x6b, 0xc9, 0xd2, 0xf9, 0xd7,
-	0x34, 0xc9, 0x60, 0xda, 0xcb, 0xf1, 0xa7, 0x31, 0x3e, 0x46, 0x9b, 0x54, 0x08, 0x43, 0x6c, 0x56,
-	0x20, 0xb6, 0xa9, 0x10, 0xa7, 0xb1, 0x89, 0x78, 0x01, 0x52, 0x31, 0x9e, 0x1a, 0x62, 0xab, 0x4a,
-	0xc4, 0x1c, 0xef, 0xc8, 0x4b, 0x1e, 0x06, 0x34, 0x32, 0x07, 0xf3, 0x36, 0xaa, 0x90, 0x97, 0x3c,
-	0x7c, 0x6a, 0xe1, 0xf8, 0x31, 0xea, 0x0a, 0xc9, 0x2f, 0x58, 0x0c, 0xd2, 0x6b, 0x57, 0xa0, 0xae,
-	0xd1, 0xf8, 0x43, 0xd4, 0x8b, 0x99, 0x84, 0x48, 0xb3, 0x0b, 0xf0, 0x36, 0xab, 0x44, 0x5d, 0xc3,
-	0x4d, 0xca, 0x32, 0x4b, 0x8d, 0xb0, 0xe6, 0xbc, 0x9d, 0x2a, 0xe4, 0x1c, 0x7f, 0x1a, 0x1f, 0xfd,
-	0xde, 0x44, 0xf7, 0x4a, 0x9a, 0x29, 0xc1, 0x53, 0x05, 0xa6, 0xee, 0xa6, 0x0a, 0x15, 0x05, 0x6b,
-	0x2f, 0x79, 0xe8, 0x4a, 0x57, 0x52, 0xba, 0x79, 0x57, 0xa5, 0x5b, 0x77, 0x55, 0x7a, 0xa3, 0xb6,
-	0xd2, 0xa5, 0xb2, 0xb5, 0xeb, 0x95, 0xed, 0xd7, 0x0e, 0x6a, 0x9d, 0xf1, 0xf0, 0x4d, 0x29, 0x54,
-	0xc9, 0x12, 0xed, 0x7a, 0x96, 0x78, 0x84, 0x36, 0x95, 0xa6, 0x3a, 0x53, 0x95, 0xba, 0x3a, 0xc7,
-	0x9a, 0x90, 0x20, 0x25, 0x97, 0x41, 0xc4, 0x63, 0xb8, 0xb5, 0xa5, 0xbf, 0x3a, 0x4d, 0xf5, 0xf1,
-	0x24, 0x0f, 0x69, 0xf1, 0x27, 0x3c, 0x86, 0xeb, 0x5e, 0xea, 0xd6, 0xf3, 0xd2, 0x63, 0xd4, 0x85,
-	0x97, 0x10, 0x65, 0x9a, 0x4b, 0xaf, 0x57, 0xc5, 0xc1, 0x05, 0xda, 0xa4, 0xac, 0xa9, 0x7a, 0x11,
-	0x44, 0x3c, 0x4b, 0xb5, 0x87, 0xaa, 0xa4, 0x6c, 0xf0, 0x27, 0x06, 0x6e, 0xc8, 0x7c, 0x95, 0x82,
-	0x0c, 0x04, 0xd5, 0x0b, 0xaf, 0x5f, 0x25, 0x67, 0x8b, 0xff, 0x82, 0xea, 0xc5, 0xb5, 0xa9, 0xb3,
-	0x55, 0x6b, 0xea, 0x5c, 0xb7, 0xc0, 0x76, 0x2d, 0x0b, 0xe0, 0x27, 0xa8, 0x1f, 0xd9, 0xc1, 0x11,
-	0x98, 0x05, 0x6f, 0xc7, 0xb2, 0x07, 0x37, 0xd8, 0x5f, 0x16, 0x17, 0xce, 0x14, 0x39, 0xb8, 0x59,
-	0x30, 0x64, 0x27, 0xb5, 0x23, 0xef, 0xfe, 0x37, 0xd9, 0xc1, 0x2d, 0x79, 0x82, 0xda, 0xf6, 0xf4,
-	0xde, 0x5e, 0x15, 0x07, 0x58, 0xe8, 0xd1, 0x1f, 0x6d, 0x74, 0xff, 0x63, 0x50, 0x91, 0x64, 0xa1,
-	0x99, 0x74, 0xaa, 0xb8, 0x9e, 0x3e, 0x42, 0x7d, 0x05, 0x54, 0x46, 0x8b, 0x60, 0xc5, 0x65, 0x35,
-	0x17, 0x23, 0x47, 0xf8, 0x86, 0xcb, 0x18, 0x7f, 0x80, 0xba, 0x8a, 0x4b, 0x1d, 0xbc, 0x80, 0xcb,
-	0x4a, 0x46, 0xee, 0x18, 0xf4, 0x67, 0x70, 0x89, 0x1f, 0xa1, 0x8e, 0x04, 0xe3, 0x31, 0xc8, 0x7d,
-	0x7c, 0xf3, 0xf0, 0xcf, 0x38, 0x4f, 0x72, 0x56, 0x0e, 0xc5, 0xfb, 0xa8, 0x9d, 0xb0, 0x73, 0xa6,
-	0xad, 0x85, 0xb7, 0xa7, 0xee, 0x03, 0x3f, 0x40, 0x9b, 0x7c, 0x36, 0x53, 0xa0, 0xad, 0x39, 0xb7,
-	0xa7, 0xf9, 0x17, 0x7e, 0x17, 0xed, 0xc6, 0x4c, 0x89, 0x84, 0x5e, 0x06, 0x11, 0x4f, 0xb2, 0xf3,
-	0xd4, 0x98, 0xb0, 0x35, 0xec, 0x4d, 0x77, 0xf2, 0xe5, 0x13, 0xb7, 0x8a, 0xdf, 0x5a, 0x4f, 0xb1,
-	0xbe, 0xdd, 0x7f, 0xed, 0x9c, 0xda, 0xba, 0xeb, 0x9c, 0xda, 0xbe, 0xeb, 0x9c, 0xda, 0xa9, 0x37,
-	0xa7, 0xca, 0xde, 0xdd, 0xad, 0xe5, 0xdd, 0xb2, 0x83, 0xf6, 0xfe, 0x87, 0x83, 0xee, 0xd5, 0x73,
-	0xd0, 0x83, 0xf5, 0x6c, 0xc4, 0xb6, 0xec, 0xc5, 0xf4, 0xdb, 0x2f, 0xfa, 0xfb, 0xbe, 0x53, 0xc3,
-	0x75, 0x30, 0x45, 0xfb, 0xd7, 0x1b, 0x38, 0xbf, 0xab, 0x0f, 0x51, 0x5f, 0x73, 0x4d, 0x93, 0x7c,
-	0xf2, 0x34, 0x6c, 0x0b, 0x20, 0xbb, 0xe4, 0x86, 0xcb, 0x10, 0x75, 0x8c, 0xba, 0xa6, 0x3f, 0x9a,
-	0xa4, 0x35, 0xec, 0x4f, 0x76, 0x47, 0x57, 0x2f, 0xc8, 0x91, 0xb9, 0xf6, 0x8d, 0xfa, 0xcf, 0x41,
-	0x4f, 0xfe, 0x6c, 0x22, 0x74, 0xc6, 0xc3, 0xcf, 0x69, 0x4a, 0xe7, 0x20, 0xf1, 0xa7, 0xa8, 0xb7,
-	0x7e, 0x1a, 0xe0, 0x83, 0x32, 0xe9, 0xd5, 0x57, 0xde, 0xe0, 0xed, 0x5b, 0x76, 0xf3, 0x1c, 0xff,
-	0x69, 0xa0, 0xad, 0x72, 0xf2, 0xf8, 0xb0, 0x8c, 0x7f, 0x8d, 0x2f, 0x07, 0xe4, 0x76, 0x80, 0xfb,
-	0xcf, 0xa3, 0x5f, 0x1a, 0x3f, 0x3d, 0xfd, 0xb1, 0x81, 0x7f, 0x68, 0x7c, 0x02, 0x9a, 0x2c, 0x79,
-	0xe8, 0x93, 0x19, 0x4b, 0x34, 0x48, 0xb2, 0x62, 0x7a, 0x41, 0xf4, 0x02, 0x14, 0x90, 0x19, 0x83,
-	0x24, 0x56, 0x43, 0xd7, 0xdc, 0x3e, 0xb9, 0xea, 0x66, 0x9f, 0xb8, 0xe6, 0xf4, 0xc9, 0x55, 0xbf,
-	0xf9, 0xa4, 0x68, 0x08, 0x9f, 0x14, 0x02, 0xfb, 0xc4, 0x69, 0xe2, 0x13, 0x2b, 0xc2, 0x7b, 0x3e,
-	0x89, 0x61, 0x46, 0xb3, 0x44, 0x13, 0x09, 0x3a, 0x93, 0x29, 0xa1, 0x49, 0x62, 0x82, 0xab, 0xef,
-	0xff, 0xfa, 0xfb, 0xe7, 0x26, 0xc2, 0xdd, 0xf1, 0xc5, 0xfb, 0x63, 0xf3, 0xfd, 0x6c, 0xe3, 0xdb,
-	0xa6, 0x08, 0xc3, 0x4d, 0xdb, 0x03, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x03, 0x9a, 0x48,
-	0x87, 0xbf, 0x0b, 0x00, 0x00,
+	// 1356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xd6, 0xc6, 0x71, 0x62, 0x4f, 0x7e, 0x39, 0xd3, 0xb4, 0x5a, 0xf9, 0x4b, 0xbf, 0xce, 0x67,
+	0x7d, 0x12, 0x01, 0xb9, 0x71, 0x49, 0x2b, 0x51, 0x5a, 0x21, 0xe1, 0xa6, 0xd0, 0xa6, 0xa5, 0x52,
+	0xe5, 0x06, 0x2a, 0x21, 0x24, 0x6b, 0x76, 0xf7, 0xb5, 0x3d, 0xc9, 0x7a, 0x66, 0x3b, 0x33, 0x8e,
+	0xe3, 0x2b, 0x12, 0x12, 0x12, 0xb7, 0x70, 0xe5, 0xce, 0x01, 0x01, 0x17, 0x6e, 0xfc, 0x19, 0xfc,
+	0x0b, 0x5c, 0xb8, 0xf1, 0x07, 0x70, 0x40, 0x33, 0xb3, 0x76, 0x36, 0x21, 0xa1, 0xeb, 0x84, 0x1b,
+	0x27, 0xef, 0xce, 0x3c, 0xcf, 0xbc, 0xcf, 0xcc, 0x3b, 0xef, 0xf3, 0xae, 0x51, 0x79, 0x4f, 0x04,
+	0x9b, 0x89, 0x14, 0x5a, 0x60, 0x24, 0x12, 0xe0, 0x09, 0xd3, 0x92, 0x1d, 0x56, 0xff, 0xdb, 0x15,
+	0xa2, 0x1b, 0x43, 0xc3, 0xce, 0x04, 0x83, 0x4e, 0x63, 0x28, 0x69, 0x92, 0x80, 0x54, 0x0e, 0x5b,
+	0xbd, 0x71, 0x7a, 0x5e, 0xb3, 0x3e, 0x28, 0x4d, 0xfb, 0x49, 0x0a, 0x58, 0x4f, 0x01, 0x34, 0x61,
+	0x0d, 0xca, 0xb9, 0xd0, 0x54, 0x33, 0xc1, 0xc7, 0xf4, 0xba, 0xfd, 0x09, 0x6f, 0x76, 0x81, 0xdf,
+	0x54, 0x43, 0xda, 0xed, 0x82, 0x6c, 0x88, 0xc4, 0x22, 0xce, 0x40, 0x23, 0x4d, 0xd5, 0xbe, 0x7b,
+	0xae, 0xfd, 0x30, 0x8b, 0x2a, 0xdb, 0x12, 0xa8, 0x86, 0x27, 0x22, 0x68, 0xc1, 0xab, 0x01, 0x28,
+	0x8d, 0xef, 0x23, 0x14, 0xc6, 0x03, 0xa5, 0x41, 0xb6, 0x59, 0xe4, 0x7b, 0xc4, 0xdb, 0x58, 0xd8,
+	0x5a, 0xdf, 0x74, 0x0a, 0x36, 0xc7, 0x12, 0x37, 0x5f, 0x68, 0xc9, 0x78, 0xf7, 0x13, 0x1a, 0x0f,
+	0xa0, 0x55, 0x4e, 0xf1, 0x3b, 0x11, 0xbe, 0x8d, 0xe6, 0x68, 0x92, 0x18, 0xe2, 0x4c, 0x0e, 0x62,
+	0x91, 0x26, 0xc9, 0x4e, 0x64, 0x22, 0x1e, 0x80, 0x54, 0x4c, 0x70, 0x43, 0x2c, 0xe4, 0x89, 0x98,
+	0xe2, 0x1d, 0x79, 0x4f, 0x04, 0x6d, 0x1a, 0x9a, 0x4d, 0xfa, 0xb3, 0x79, 0xc8, 0x7b, 0x22, 0x68,
+	0x5a, 0x38, 0xbe, 0x8b, 0x4a, 0x89, 0x14, 0x07, 0x2c, 0x02, 0xe9, 0x17, 0x73, 0x50, 0x27, 0x68,
+	0x7c, 0x0f, 0x95, 0x23, 0x26, 0x21, 0xd4, 0xec, 0x00, 0xfc, 0xb9, 0x3c, 0x51, 0x27, 0x70, 0x23,
+	0x59, 0x0e, 0xb8, 0x49, 0xb2, 0xd9, 0xef, 0x7c, 0x1e, 0x72, 0x8a, 0xdf, 0x89, 0x9c, 0x64, 0x26,
+	0x24, 0xd3, 0x23, 0xbf, 0x74, 0x0e, 0xf5, 0xe3, 0x1d, 0xae, 0x6f, 0x6f, 0x4d, 0x24, 0x3b, 0x34,
+	0x6e, 0xa2, 0x65, 0x15, 0xf6, 0x20, 0x1a, 0xc4, 0x10, 0xb5, 0xcd, 0x6a, 0x7e, 0xd9, 0xf2, 0xab,
+	0x7f, 0xe1, 0xef, 0x8e, 0xef, 0x5f, 0x6b, 0x69, 0xc2, 0x30, 0x63, 0xb5, 0x9f, 0x66, 0xd0, 0x6a,
+	0xe6, 0xc2, 0xa8, 0x44, 0x70, 0x05, 0x26, 0xe9, 0x26, 0x05, 0x39, 0x6f, 0x4b, 0x71, 0x4f, 0x04,
+	0x2e, 0x6f, 0x99, 0x6b, 0x36, 0x73, 0xd1, 0x6b, 0x56, 0xb8, 0xe8, 0x35, 0x9b, 0x9d, 0xfa, 0x9a,
+	0x65, 0x72, 0x56, 0x9c, 0x2a, 0x67, 0xb5, 0xef, 0x4a, 0xa8, 0xf0, 0x44, 0x04, 0xff, 0x96, 0x83,
+	0xca, 0xd4, 0x63, 0x71, 0xba, 0x7a, 0xbc, 0x83, 0xe6, 0x94, 0xa6, 0x7a, 0xa0, 0x72, 0x95, 0x54,
+	0x8a, 0x35, 0x21, 0x41, 0x4a, 0x21, 0xdb, 0xa1, 0x88, 0xc0, 0x9f, 0xcf, 0x51, 0x14, 0x65, 0x8b,
+	0xdf, 0x16, 0x11, 0x9c, 0x2c, 0xe4, 0xd2, 0x74, 0x85, 0x7c, 0x17, 0x95, 0xe0, 0x10, 0xc2, 0x81,
+	0x16, 0xd2, 0x2f, 0xe7, 0xa0, 0x4e, 0xd0, 0x46, 0xb2, 0xf1, 0xe1, 0x76, 0x28, 0x06, 0x5c, 0xfb,
+	0x28, 0x8f, 0x64, 0x83, 0xdf, 0x36, 0x70, 0x43, 0x16, 0x43, 0x0e, 0xb2, 0x9d, 0x50, 0xdd, 0xf3,
+	0x17, 0xf2, 0x68, 0xb6, 0xf8, 0xe7, 0x54, 0xf7, 0x4e, 0x58, 0xde, 0xe2, 0x54, 0x96, 0x77, 0xb2,
+	0x04, 0x96, 0xa6, 0xb3, 0xad, 0xfb, 0x68, 0x21, 0xb4, 0xc6, 0xe1, 0x9c, 0x67, 0xf9, 0xb5, 0xce,
+	0x83, 0x1c, 0xdc, 0x0c, 0x18, 0xb2, 0x4b, 0xb5, 0x23, 0xaf, 0xbc, 0x9e, 0xec, 0xe0, 0x96, 0xbc,
+	0x85, 0x8a, 0x76, 0xf7, 0x7e, 0x25, 0x4f, 0x05, 0x58, 0xe8, 0x09, 0x93, 0x5d, 0xbd, 0xa4, 0xc9,
+	0xe2, 0x69, 0x4d, 0xf6, 0x8f, 0x22, 0xba, 0xf2, 0x10, 0x54, 0x28, 0x59, 0x60, 0x6c, 0x56, 0x8d,
+	0x1b, 0xf3, 0x7b, 0x68, 0x41, 0x01, 0x95, 0x61, 0xaf, 0x3d, 0x14, 0x32, 0x9f, 0x85, 0x20, 0x47,
+	0x78, 0x29, 0x64, 0x84, 0xdf, 0x41, 0x25, 0x25, 0xa4, 0x6e, 0xef, 0xc3, 0x28, 0x97, 0x8b, 0xcc,
+	0x1b, 0xf4, 0x53, 0x18, 0xe1, 0x3b, 0x68, 0x5e, 0x82, 0x29, 0x70, 0xf0, 0x0b, 0xe7, 0xec, 0xe5,
+	0x81, 0x10, 0x71, 0xca, 0x4a, 0xa1, 0x78, 0x0d, 0x15, 0x63, 0xd6, 0x67, 0xda, 0xfa, 0xc7, 0x52,
+	0xcb, 0xbd, 0xe0, 0x6b, 0x68, 0x4e, 0x74, 0x3a, 0x0a, 0xb4, 0x75, 0x86, 0xa5, 0x56, 0xfa, 0x86,
+	0xdf, 0x40, 0x2b, 0x11, 0x53, 0x49, 0x4c, 0x47, 0xed, 0x50, 0xc4, 0x83, 0x3e, 0x37, 0x0e, 0x50,
+	0xd8, 0x28, 0xb7, 0x96, 0xd3, 0xe1, 0x6d, 0x37, 0x8a, 0xaf, 0x4e, 0x2c, 0x74, 0xc1, 0xce, 0x9f,
+	0x69, 0x92, 0x8b, 0x17, 0x35, 0xc9, 0xa5, 0x8b, 0x9a, 0xe4, 0xf2, 0x74, 0x26, 0x99, 0x35, 0x8e,
+	0x95, 0xa9, 0x8c, 0x23, 0x5b, 0xbe, 0x95, 0x4b, 0x94, 0xef, 0xea, 0x74, 0xe5, 0x7b, 0x6d, 0x62,
+	0xcc, 0xd8, 0x1e, 0x7b, 0xfa, 0x66, 0xb2, 0xec, 0x8a, 0xeb, 0x8a, 0xcb, 0x86, 0x7d, 0x31, 0xa1,
+	0x12, 0xda, 0x85, 0xb6, 0x16, 0xfb, 0xc0, 0xfd, 0xb5, 0x3c, 0xa1, 0x0c, 0x7e, 0xd7, 0xc0, 0x6b,
+	0x8f, 0x50, 0x65, 0x9b, 0xf2, 0x10, 0xe2, 0xcc, 0x37, 0xe9, 0x45, 0x1a, 0x67, 0xed, 0x31, 0x5a,
+	0xcd, 0x2c, 0x74, 0x89, 0x6f, 0x95, 0xda, 0x87, 0x68, 0xe5, 0x25, 0xd5, 0x61, 0xef, 0xb2, 0x8a,
+	0xbe, 0x2a, 0xa0, 0xca, 0xf1, 0x42, 0xa9, 0x22, 0xd3, 0xbd, 0x0e, 0x80, 0xeb, 0xb6, 0x1e, 0x25,
+	0x90, 0xef, 0x7b, 0xdb, 0xe2, 0x77, 0x47, 0x09, 0xe0, 0xff, 0xa1, 0xc2, 0x9e, 0x08, 0xd2, 0x7a,
+	0x5e, 0xd9, 0x3c, 0xfe, 0xd3, 0xb1, 0x69, 0x42, 0x98, 0x39, 0x7c, 0x27, 0x6d, 0x35, 0x31, 0x1d,
+	0x81, 0x4c, 0x2b, 0xf8, 0x6a, 0x16, 0xb9, 0x4b, 0xd5, 0xfe, 0x47, 0x66, 0xd2, 0xf5, 0x18, 0xfb,
+	0x68, 0xcc, 0xc6, 0x12, 0xda, 0x8c, 0x47, 0x70, 0xe8, 0xcf, 0xe6, 0x30, 0x41, 0x64, 0x09, 0x3b,
+	0x06, 0x8f, 0xff, 0x8f, 0x66, 0xcd, 0x5a, 0x69, 0xff, 0xaf, 0x9c, 0x0e, 0xd7, 0xb2, 0xb3, 0xb8,
+	0x89, 0x96, 0x5c, 0xe3, 0xee, 0x83, 0x52, 0xb4, 0x9b, 0xef, 0x43, 0x7a, 0xd1, 0x52, 0x9e, 0x39,
+	0x06, 0x7e, 0x77, 0x72, 0x7a, 0xac, 0x3f, 0xee, 0xfd, 0x7f, 0xe7, 0xb5, 0xe9, 0xd9, 0x19, 0x9f,
+	0xfd, 0xd6, 0x43, 0x6b, 0x27, 0x7d, 0x36, 0xcd, 0xc8, 0x0d, 0xb4, 0xa0, 0x85, 0xa6, 0x71, 0xda,
+	0x9d, 0x3d, 0xeb, 0x54, 0xc8, 0x0e, 0xb9, 0x06, 0xbc, 0x81, 0xe6, 0x4d, 0xf2, 0x8d, 0x8d, 0xcd,
+	0x90, 0xc2, 0x59, 0x27, 0x6f, 0x2e, 0xc7, 0x0b, 0xd0, 0xf8, 0x21, 0x5a, 0xe1, 0x70, 0xa8, 0xdb,
+	0x99, 0x72, 0xc8, 0xf3, 0x21, 0xb6, 0x64, 0x48, 0xcf, 0xc7, 0x25, 0xb1, 0xf5, 0xdb, 0x1c, 0x42,
+	0x4f, 0x44, 0xf0, 0x8c, 0x72, 0xda, 0x05, 0x89, 0x1f, 0xa3, 0xf2, 0xe4, 0x23, 0x1c, 0xaf, 0x67,
+	0x43, 0x9f, 0xfe, 0x33, 0x57, 0xbd, 0x7e, 0xce, 0x6c, 0xba, 0xd3, 0xdf, 0x3d, 0xb4, 0x98, 0x3d,
+	0x02, 0x7c, 0x23, 0x8b, 0x3f, 0xa3, 0x09, 0x55, 0xc9, 0xf9, 0x00, 0xb7, 0x66, 0xed, 0x1b, 0xef,
+	0xa8, 0xf9, 0xa5, 0x87, 0xbf, 0xf0, 0x1e, 0x81, 0x26, 0x7b, 0x22, 0xa8, 0x93, 0x0e, 0x8b, 0x35,
+	0x48, 0x32, 0x64, 0xba, 0x47, 0x74, 0x0f, 0x14, 0x90, 0x0e, 0x83, 0x38, 0x52, 0x1b, 0xae, 0x82,
+	0xea, 0xe4, 0xd8, 0xba, 0xeb, 0xc4, 0x39, 0x71, 0x9d, 0x1c, 0x9b, 0x6b, 0x9d, 0x8c, 0xdd, 0xaf,
+	0x4e, 0xc6, 0x6e, 0x56, 0x27, 0xce, 0x80, 0xea, 0xc4, 0x3a, 0xce, 0x9b, 0x75, 0x12, 0x41, 0x87,
+	0x0e, 0x62, 0x4d, 0x24, 0xe8, 0x81, 0xe4, 0x84, 0xc6, 0xb1, 0x09, 0xae, 0x3e, 0xff, 0xe5, 0xd7,
+	0xaf, 0x67, 0x10, 0x2e, 0x35, 0x0e, 0xde, 0x6e, 0x98, 0x77, 0xfc, 0xa3, 0x87, 0xd0, 0x07, 0x87,
+	0x89, 0x90, 0xfa, 0x9f, 0xda, 0xf0, 0x67, 0x47, 0xcd, 0x07, 0xf8, 0x7d, 0xb7, 0xa6, 0x8d, 0x69,
+	0xc4, 0x49, 0xa0, 0x7d, 0x2b, 0x42, 0xf7, 0xc0, 0x0e, 0x12, 0x73, 0x05, 0x48, 0x30, 0x72, 0xbf,
+	0x1d, 0x21, 0x49, 0x30, 0x88, 0xf7, 0x89, 0x04, 0x43, 0x63, 0xbc, 0x6b, 0x85, 0xae, 0xe2, 0x95,
+	0xb1, 0xd0, 0x06, 0xd8, 0x05, 0x6f, 0x79, 0xf8, 0x67, 0x0f, 0x95, 0x27, 0x46, 0x76, 0x2a, 0xdf,
+	0xa7, 0x8c, 0xb2, 0x7a, 0xfd, 0x9c, 0xd9, 0x54, 0xea, 0xab, 0xa3, 0xe6, 0x2e, 0x6e, 0xb9, 0x71,
+	0x92, 0x00, 0x8f, 0x18, 0xef, 0x12, 0x21, 0xc9, 0x50, 0xc8, 0x7d, 0xf3, 0x68, 0xd3, 0x65, 0x14,
+	0x4b, 0xe8, 0x53, 0xc6, 0xcd, 0x90, 0x29, 0x54, 0x45, 0x44, 0x67, 0xbc, 0x11, 0x32, 0x64, 0x71,
+	0x4c, 0xb8, 0xd0, 0x24, 0x80, 0x34, 0x31, 0x10, 0x59, 0xf1, 0x6b, 0xb5, 0x63, 0xf1, 0xa1, 0x0d,
+	0x71, 0xcf, 0x7b, 0x0b, 0x7f, 0xef, 0xa1, 0xd2, 0xd8, 0xf3, 0xf0, 0x7f, 0xb2, 0xf2, 0x4e, 0x59,
+	0x6a, 0x75, 0xfd, 0xec, 0xc9, 0x54, 0x7a, 0x74, 0xd4, 0x7c, 0x8a, 0x77, 0xec, 0xb0, 0x93, 0x99,
+	0x9e, 0xb1, 0x91, 0x15, 0xf6, 0x28, 0xef, 0x82, 0x55, 0x69, 0x14, 0x52, 0x1e, 0x11, 0xa6, 0x55,
+	0x2a, 0xdd, 0xf4, 0xb0, 0x49, 0x1a, 0x48, 0x87, 0x71, 0xa6, 0x7a, 0xa9, 0xe2, 0x0a, 0x5e, 0x9e,
+	0x28, 0x1e, 0x9a, 0x95, 0x6f, 0x79, 0x0f, 0x66, 0x3f, 0x9d, 0x49, 0x82, 0x60, 0xce, 0x96, 0xe5,
+	0xed, 0x3f, 0x07, 0x00, 0xab, 0x39, 0x31, 0x4b, 0xd2, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -675,6 +975,12 @@ type JobManagerClient interface {
 	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error)
 	// Get job, filter with these fields(job_id, cluster_id, app_id, version_id, executor, provider, status, owner), default return all jobs
 	DescribeJobs(ctx context.Context, in *DescribeJobsRequest, opts ...grpc.CallOption) (*DescribeJobsResponse, error)
+	// Export jobs, stream all the jobs page by page for bulk reporting
+	ExportJobs(ctx context.Context, in *DescribeJobsRequest, opts ...grpc.CallOption) (JobManager_ExportJobsClient, error)
+	// Cancel pending or working job, the remaining tasks of the job will not be executed
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	// Watch job, stream the changes of job and its tasks until the job finished
+	WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (JobManager_WatchJobClient, error)
 }
 
 type jobManagerClient struct {
@@ -703,11 +1009,90 @@ func (c *jobManagerClient) DescribeJobs(ctx context.Context, in *DescribeJobsReq
 	return out, nil
 }
 
+func (c *jobManagerClient) ExportJobs(ctx context.Context, in *DescribeJobsRequest, opts ...grpc.CallOption) (JobManager_ExportJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JobManager_serviceDesc.Streams[0], "/openpitrix.JobManager/ExportJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobManagerExportJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobManager_ExportJobsClient interface {
+	Recv() (*DescribeJobsResponse, error)
+	grpc.ClientStream
+}
+
+type jobManagerExportJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobManagerExportJobsClient) Recv() (*DescribeJobsResponse, error) {
+	m := new(DescribeJobsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobManagerClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.JobManager/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobManagerClient) WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (JobManager_WatchJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JobManager_serviceDesc.Streams[1], "/openpitrix.JobManager/WatchJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobManagerWatchJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobManager_WatchJobClient interface {
+	Recv() (*WatchJobResponse, error)
+	grpc.ClientStream
+}
+
+type jobManagerWatchJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobManagerWatchJobClient) Recv() (*WatchJobResponse, error) {
+	m := new(WatchJobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // JobManagerServer is the server API for JobManager service.
 type JobManagerServer interface {
 	CreateJob(context.Context, *CreateJobRequest) (*CreateJobResponse, error)
 	// Get job, filter with these fields(job_id, cluster_id, app_id, version_id, executor, provider, status, owner), default return all jobs
 	DescribeJobs(context.Context, *DescribeJobsRequest) (*DescribeJobsResponse, error)
+	// Export jobs, stream all the jobs page by page for bulk reporting
+	ExportJobs(*DescribeJobsRequest, JobManager_ExportJobsServer) error
+	// Cancel pending or working job, the remaining tasks of the job will not be executed
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	// Watch job, stream the changes of job and its tasks until the job finished
+	WatchJob(*WatchJobRequest, JobManager_WatchJobServer) error
 }
 
 // UnimplementedJobManagerServer can be embedded to have forward compatible implementations.
@@ -720,6 +1105,15 @@ func (*UnimplementedJobManagerServer) CreateJob(ctx context.Context, req *Create
 func (*UnimplementedJobManagerServer) DescribeJobs(ctx context.Context, req *DescribeJobsRequest) (*DescribeJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeJobs not implemented")
 }
+func (*UnimplementedJobManagerServer) ExportJobs(req *DescribeJobsRequest, srv JobManager_ExportJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportJobs not implemented")
+}
+func (*UnimplementedJobManagerServer) CancelJob(ctx context.Context, req *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (*UnimplementedJobManagerServer) WatchJob(req *WatchJobRequest, srv JobManager_WatchJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJob not implemented")
+}
 
 func RegisterJobManagerServer(s *grpc.Server, srv JobManagerServer) {
 	s.RegisterService(&_JobManager_serviceDesc, srv)
@@ -761,6 +1155,66 @@ func _JobManager_DescribeJobs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobManager_ExportJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DescribeJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobManagerServer).ExportJobs(m, &jobManagerExportJobsServer{stream})
+}
+
+type JobManager_ExportJobsServer interface {
+	Send(*DescribeJobsResponse) error
+	grpc.ServerStream
+}
+
+type jobManagerExportJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobManagerExportJobsServer) Send(m *DescribeJobsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobManager_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.JobManager/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobManager_WatchJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobManagerServer).WatchJob(m, &jobManagerWatchJobServer{stream})
+}
+
+type JobManager_WatchJobServer interface {
+	Send(*WatchJobResponse) error
+	grpc.ServerStream
+}
+
+type jobManagerWatchJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobManagerWatchJobServer) Send(m *WatchJobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _JobManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.JobManager",
 	HandlerType: (*JobManagerServer)(nil),
@@ -773,7 +1227,22 @@ var _JobManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeJobs",
 			Handler:    _JobManager_DescribeJobs_Handler,
 		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _JobManager_CancelJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportJobs",
+			Handler:       _JobManager_ExportJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJob",
+			Handler:       _JobManager_WatchJob_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "job.proto",
 }
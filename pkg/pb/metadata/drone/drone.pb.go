@@ -30,37 +30,41 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("metadata/drone/drone.proto", fileDescriptor_1725cf0b7409fde2) }
 
 var fileDescriptor_1725cf0b7409fde2 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x6f, 0x48, 0x19, 0x20, 0x45, 0x5b, 0xfe, 0x08, 0x23, 0x40, 0x5c, 0x10, 0xa7, 0x58,
-	0x02, 0x09, 0x51, 0x10, 0x07, 0xd2, 0xb8, 0x51, 0xa4, 0x94, 0x46, 0x31, 0xea, 0x81, 0xdb, 0x26,
-	0x9e, 0x58, 0xab, 0xda, 0xb3, 0x8b, 0x3d, 0x46, 0xf4, 0x9b, 0xf0, 0x71, 0x91, 0xbd, 0x71, 0xfe,
-	0x6c, 0xb2, 0x41, 0x6d, 0x2f, 0x8e, 0x32, 0xef, 0xcd, 0xdb, 0xdf, 0x8e, 0xb5, 0x6b, 0x08, 0x72,
-	0x64, 0x99, 0x48, 0x96, 0x61, 0x52, 0x68, 0x42, 0xfb, 0xec, 0x99, 0x42, 0xb3, 0x16, 0xdd, 0x56,
-	0xeb, 0x35, 0xd5, 0x60, 0xed, 0xe5, 0x6b, 0x83, 0xa5, 0x7d, 0x5a, 0xef, 0x8e, 0x36, 0xd7, 0xb4,
-	0x48, 0x3c, 0xda, 0xc6, 0x1a, 0xc1, 0x2b, 0x47, 0x5b, 0x14, 0x9a, 0x38, 0x95, 0xbc, 0xd4, 0xdf,
-	0xff, 0xbd, 0x0f, 0x0f, 0x06, 0xb5, 0x3f, 0xc6, 0xe2, 0xb7, 0x9a, 0xa3, 0x98, 0xc0, 0xd1, 0x40,
-	0x95, 0x5c, 0xa8, 0x59, 0xc5, 0xd8, 0x28, 0xe2, 0x6d, 0x6f, 0x05, 0x6a, 0x91, 0x1c, 0xc3, 0x14,
-	0x7f, 0x55, 0x58, 0x72, 0xf0, 0xc4, 0xf5, 0x45, 0xb9, 0xe1, 0x6b, 0xf1, 0x0d, 0x8e, 0x86, 0xc8,
-	0x13, 0x95, 0x69, 0xbe, 0xc4, 0xa2, 0x54, 0x9a, 0xc4, 0x7e, 0x67, 0xf0, 0xcc, 0x2d, 0xb7, 0xfe,
-	0x08, 0x8e, 0x87, 0xc8, 0x67, 0x2d, 0xfb, 0x6d, 0x63, 0x46, 0x0d, 0x49, 0xc3, 0xdc, 0x96, 0x5e,
-	0xee, 0xec, 0xad, 0x56, 0x23, 0x4a, 0x8c, 0x56, 0xc4, 0xfe, 0xa8, 0x01, 0x74, 0xdb, 0xa8, 0x53,
-	0x4d, 0x0b, 0x95, 0xfa, 0x60, 0x5e, 0xec, 0x5d, 0x60, 0xd9, 0x33, 0x80, 0x6e, 0xbc, 0x9d, 0x72,
-	0xc8, 0xee, 0x1b, 0xb0, 0x65, 0xa9, 0x3d, 0xc9, 0x0d, 0x59, 0x36, 0x7b, 0x2c, 0xcb, 0x66, 0xe5,
-	0x90, 0xdd, 0xc7, 0x32, 0x06, 0xb1, 0xf9, 0xa6, 0x0e, 0xf3, 0xbc, 0x76, 0xcb, 0x6e, 0xdf, 0x18,
-	0x44, 0xbc, 0x9b, 0xf6, 0xbf, 0x36, 0x1f, 0xdb, 0x57, 0xe8, 0x8e, 0xca, 0x66, 0x0f, 0xd3, 0x8a,
-	0x48, 0x91, 0x97, 0xeb, 0xb1, 0x5b, 0xee, 0x6b, 0x9d, 0x89, 0xcf, 0x00, 0x31, 0xcb, 0xc2, 0x8e,
-	0xc8, 0xd7, 0xea, 0x59, 0xfa, 0x04, 0x3a, 0x31, 0x6b, 0x73, 0x9b, 0xd6, 0x08, 0x1e, 0x0d, 0x91,
-	0x7f, 0x60, 0x6e, 0x32, 0xc9, 0x78, 0xa6, 0x32, 0x2c, 0x7d, 0x09, 0x81, 0x5b, 0x8e, 0xb9, 0x50,
-	0x94, 0x8e, 0x55, 0xc9, 0xa2, 0x0f, 0x9d, 0x21, 0xf2, 0xa5, 0xcc, 0x2a, 0x2c, 0xc5, 0x01, 0x63,
-	0xf0, 0x7c, 0xbf, 0x76, 0x2e, 0x8d, 0x88, 0xa0, 0x33, 0x51, 0x94, 0x36, 0x47, 0x59, 0xbc, 0xf1,
-	0xbe, 0x85, 0xd5, 0xe9, 0xf1, 0xec, 0x68, 0x04, 0x0f, 0xeb, 0x98, 0x95, 0xff, 0x0e, 0x51, 0x27,
-	0x96, 0xc8, 0xde, 0x53, 0x37, 0x9b, 0xeb, 0x05, 0x1c, 0xd7, 0xad, 0xe7, 0x4b, 0xad, 0x2f, 0xe7,
-	0x57, 0x48, 0xc9, 0x1d, 0x58, 0xbe, 0x03, 0x4c, 0x2b, 0x3a, 0xd5, 0x79, 0x2e, 0x29, 0x11, 0xef,
-	0x5c, 0xd3, 0x5a, 0xbb, 0xa0, 0xad, 0x6b, 0xf3, 0xe9, 0xfe, 0x81, 0xf7, 0x3f, 0xfd, 0xfc, 0xa8,
-	0x0d, 0x92, 0x51, 0x5c, 0xa8, 0x3f, 0x3d, 0xa5, 0xc3, 0xf5, 0xbf, 0xd0, 0x5c, 0xa5, 0xa1, 0x99,
-	0x85, 0xdb, 0x1f, 0x97, 0x2f, 0x66, 0xd6, 0xfc, 0xce, 0xee, 0x35, 0x77, 0xfb, 0x87, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x4a, 0xfb, 0x57, 0xf4, 0x7d, 0x06, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4b, 0x6f, 0x13, 0x3f,
+	0x10, 0xd7, 0xff, 0xf2, 0x17, 0x19, 0x95, 0xb4, 0x72, 0x79, 0xa8, 0x0b, 0x05, 0x71, 0x41, 0x9c,
+	0x12, 0x04, 0x12, 0xa2, 0x3c, 0x0e, 0xcd, 0xa3, 0x51, 0xa4, 0x94, 0x46, 0xd9, 0xaa, 0x07, 0x24,
+	0x0e, 0xde, 0xec, 0x24, 0xb2, 0x92, 0xb5, 0x8d, 0x3d, 0x8b, 0xe8, 0x07, 0xe3, 0xfb, 0xa1, 0xac,
+	0xb3, 0x79, 0x38, 0x71, 0x50, 0xda, 0xcb, 0x46, 0x99, 0xdf, 0xc3, 0xbf, 0x19, 0xcb, 0x36, 0x44,
+	0x19, 0x12, 0x4f, 0x39, 0xf1, 0x7a, 0x6a, 0x94, 0x44, 0xf7, 0xad, 0x69, 0xa3, 0x48, 0xb1, 0x6a,
+	0x89, 0xd5, 0x8a, 0x6a, 0xb4, 0xe4, 0xd2, 0xad, 0x46, 0xeb, 0xbe, 0x8e, 0x1b, 0x3d, 0xf7, 0xb0,
+	0xa1, 0xca, 0x32, 0x2e, 0xd3, 0x39, 0x1a, 0x6d, 0xa0, 0x72, 0x14, 0xc2, 0x56, 0x12, 0x44, 0x2f,
+	0x3c, 0x6c, 0x64, 0x94, 0xa4, 0x31, 0xa7, 0x12, 0x3f, 0xf1, 0x13, 0x71, 0x3b, 0x71, 0xd0, 0xbb,
+	0x3f, 0x07, 0x70, 0xd0, 0x9a, 0x59, 0xc5, 0x68, 0x7e, 0x89, 0x21, 0xb2, 0x3e, 0x1c, 0xb6, 0x84,
+	0x25, 0x23, 0x92, 0x9c, 0xb0, 0x40, 0xd8, 0xeb, 0xda, 0xa2, 0x43, 0xd7, 0x8b, 0x47, 0x18, 0xe0,
+	0xcf, 0x1c, 0x2d, 0x45, 0x8f, 0x7d, 0x5e, 0x3b, 0xd3, 0x74, 0xcb, 0xce, 0xe1, 0xb0, 0x83, 0xd4,
+	0x17, 0x53, 0x45, 0x37, 0x68, 0xac, 0x50, 0x92, 0x6d, 0x67, 0x46, 0x4f, 0xfd, 0x72, 0xc9, 0x6f,
+	0xc3, 0x71, 0x07, 0xe9, 0xa2, 0x6c, 0xeb, 0xae, 0x36, 0xdd, 0x22, 0x49, 0x91, 0xb9, 0x2c, 0x9d,
+	0x6e, 0xf4, 0x36, 0x43, 0xdb, 0x32, 0xd5, 0x4a, 0x48, 0x0a, 0x5b, 0xb5, 0xa0, 0x5a, 0x5a, 0x35,
+	0x95, 0x1c, 0x89, 0x71, 0x28, 0xcc, 0xb3, 0xad, 0x0b, 0xcc, 0x35, 0x2d, 0xa8, 0xc6, 0xeb, 0x2e,
+	0xbb, 0xe8, 0xa1, 0x01, 0xbb, 0x2c, 0x33, 0x4e, 0xba, 0x67, 0x96, 0x55, 0x8d, 0xcb, 0xb2, 0x5a,
+	0xd9, 0x45, 0x0f, 0x65, 0xe9, 0x01, 0x5b, 0xdd, 0xa9, 0xdd, 0x79, 0x5e, 0xfa, 0x65, 0x5f, 0xd7,
+	0x03, 0x16, 0x6f, 0xba, 0xfd, 0x4b, 0x16, 0xca, 0xf6, 0x15, 0xaa, 0x5d, 0x5b, 0xf4, 0x30, 0xc8,
+	0xa5, 0x14, 0x32, 0x98, 0xeb, 0x91, 0x5f, 0x6e, 0x28, 0x35, 0x65, 0x9f, 0x00, 0x62, 0xe2, 0xc6,
+	0x8d, 0x28, 0x24, 0x0d, 0x2c, 0x7d, 0x06, 0x95, 0x98, 0x94, 0xbe, 0x8b, 0xb4, 0x0d, 0x47, 0x1d,
+	0xa4, 0x6b, 0xcc, 0xf4, 0x94, 0x13, 0x5e, 0x88, 0x29, 0xda, 0x90, 0x43, 0xe4, 0x97, 0x63, 0x32,
+	0x42, 0x8e, 0x7b, 0xc2, 0x12, 0x6b, 0x40, 0xa5, 0x83, 0x74, 0xc3, 0xa7, 0x39, 0x5a, 0xb6, 0x83,
+	0x18, 0x9d, 0x6c, 0xc7, 0x2e, 0xb9, 0x66, 0x6d, 0xa8, 0xf4, 0x85, 0x1c, 0x17, 0x47, 0x99, 0xbd,
+	0x0a, 0xee, 0xc2, 0xe2, 0xf4, 0x04, 0x3a, 0xea, 0xc2, 0xc3, 0x99, 0xcd, 0x82, 0x7f, 0x0f, 0xab,
+	0x33, 0x97, 0xc8, 0xdd, 0x53, 0xfb, 0xcd, 0xf5, 0x0a, 0x8e, 0x67, 0xd2, 0xcb, 0x39, 0xd6, 0xe0,
+	0xc3, 0x09, 0xca, 0xf4, 0x1e, 0x59, 0xbe, 0x01, 0x0c, 0x72, 0xd9, 0x74, 0x37, 0x3a, 0x7b, 0xe3,
+	0x93, 0x96, 0xd8, 0x95, 0x5c, 0xbb, 0x36, 0x9f, 0x6c, 0x1f, 0x38, 0xfb, 0x01, 0x47, 0x4b, 0x4d,
+	0x4c, 0x06, 0x79, 0xb6, 0x87, 0xeb, 0xe9, 0xe6, 0xe1, 0x75, 0xb4, 0x9c, 0x74, 0x4e, 0x6f, 0xff,
+	0x63, 0x5f, 0xe0, 0xc1, 0x79, 0xa2, 0x0c, 0x35, 0xb3, 0x94, 0x6d, 0xee, 0x79, 0x9e, 0x5c, 0x73,
+	0x3b, 0xe9, 0xa6, 0x81, 0x66, 0x1b, 0x1f, 0xbf, 0x7f, 0x50, 0x1a, 0xa5, 0x16, 0x64, 0xc4, 0xef,
+	0x9a, 0x50, 0xf5, 0xe5, 0xbf, 0xba, 0x9e, 0x8c, 0xeb, 0x3a, 0xa9, 0xaf, 0x3f, 0x99, 0x9f, 0x75,
+	0x52, 0xfc, 0x26, 0xff, 0x17, 0x0f, 0xcf, 0xfb, 0xbf, 0x03, 0x00, 0x3c, 0x9e, 0x8f, 0x4d, 0x53,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -95,6 +99,8 @@ type DroneServiceClient interface {
 	PingDrone(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 	PingMetadataBackend(ctx context.Context, in *types.FrontgateEndpoint, opts ...grpc.CallOption) (*types.Empty, error)
 	RunCommand(ctx context.Context, in *types.RunCommandOnDroneRequest, opts ...grpc.CallOption) (*types.String, error)
+	RunCommandStream(ctx context.Context, in *types.RunCommandOnDroneRequest, opts ...grpc.CallOption) (DroneService_RunCommandStreamClient, error)
+	AbortCmd(ctx context.Context, in *types.SubTaskId, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type droneServiceClient struct {
@@ -285,6 +291,47 @@ func (c *droneServiceClient) RunCommand(ctx context.Context, in *types.RunComman
 	return out, nil
 }
 
+func (c *droneServiceClient) RunCommandStream(ctx context.Context, in *types.RunCommandOnDroneRequest, opts ...grpc.CallOption) (DroneService_RunCommandStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DroneService_serviceDesc.Streams[0], "/metadata.drone.DroneService/RunCommandStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &droneServiceRunCommandStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DroneService_RunCommandStreamClient interface {
+	Recv() (*types.CommandOutput, error)
+	grpc.ClientStream
+}
+
+type droneServiceRunCommandStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *droneServiceRunCommandStreamClient) Recv() (*types.CommandOutput, error) {
+	m := new(types.CommandOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *droneServiceClient) AbortCmd(ctx context.Context, in *types.SubTaskId, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/metadata.drone.DroneService/AbortCmd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DroneServiceServer is the server API for DroneService service.
 type DroneServiceServer interface {
 	DistributeDrone(context.Context, *types.DistributeDroneRequest) (*types.Empty, error)
@@ -307,6 +354,8 @@ type DroneServiceServer interface {
 	PingDrone(context.Context, *types.Empty) (*types.Empty, error)
 	PingMetadataBackend(context.Context, *types.FrontgateEndpoint) (*types.Empty, error)
 	RunCommand(context.Context, *types.RunCommandOnDroneRequest) (*types.String, error)
+	RunCommandStream(*types.RunCommandOnDroneRequest, DroneService_RunCommandStreamServer) error
+	AbortCmd(context.Context, *types.SubTaskId) (*types.Empty, error)
 }
 
 // UnimplementedDroneServiceServer can be embedded to have forward compatible implementations.
@@ -373,6 +422,12 @@ func (*UnimplementedDroneServiceServer) PingMetadataBackend(ctx context.Context,
 func (*UnimplementedDroneServiceServer) RunCommand(ctx context.Context, req *types.RunCommandOnDroneRequest) (*types.String, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCommand not implemented")
 }
+func (*UnimplementedDroneServiceServer) RunCommandStream(req *types.RunCommandOnDroneRequest, srv DroneService_RunCommandStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunCommandStream not implemented")
+}
+func (*UnimplementedDroneServiceServer) AbortCmd(ctx context.Context, req *types.SubTaskId) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortCmd not implemented")
+}
 
 func RegisterDroneServiceServer(s *grpc.Server, srv DroneServiceServer) {
 	s.RegisterService(&_DroneService_serviceDesc, srv)
@@ -738,6 +793,45 @@ func _DroneService_RunCommand_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DroneService_RunCommandStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.RunCommandOnDroneRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DroneServiceServer).RunCommandStream(m, &droneServiceRunCommandStreamServer{stream})
+}
+
+type DroneService_RunCommandStreamServer interface {
+	Send(*types.CommandOutput) error
+	grpc.ServerStream
+}
+
+type droneServiceRunCommandStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *droneServiceRunCommandStreamServer) Send(m *types.CommandOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DroneService_AbortCmd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.SubTaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DroneServiceServer).AbortCmd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.drone.DroneService/AbortCmd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DroneServiceServer).AbortCmd(ctx, req.(*types.SubTaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DroneService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metadata.drone.DroneService",
 	HandlerType: (*DroneServiceServer)(nil),
@@ -822,7 +916,17 @@ var _DroneService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunCommand",
 			Handler:    _DroneService_RunCommand_Handler,
 		},
+		{
+			MethodName: "AbortCmd",
+			Handler:    _DroneService_AbortCmd_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RunCommandStream",
+			Handler:       _DroneService_RunCommandStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "metadata/drone/drone.proto",
 }
@@ -126,58 +126,64 @@ func init() {
 func init() { proto.RegisterFile("metadata/frontgate/frontgate.proto", fileDescriptor_877ed7c290242df0) }
 
 var fileDescriptor_877ed7c290242df0 = []byte{
-	// 807 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x61, 0x4f, 0xe2, 0x48,
-	0x18, 0xc7, 0x03, 0x77, 0x7a, 0xc7, 0x83, 0x70, 0x3a, 0x9e, 0x8a, 0xbd, 0x78, 0x7a, 0x5e, 0x4c,
-	0xc8, 0xe5, 0x02, 0x89, 0xf7, 0xca, 0x98, 0x33, 0x0b, 0x88, 0xca, 0xae, 0xb8, 0xa4, 0xdd, 0x75,
-	0x13, 0x5f, 0x2c, 0x29, 0x74, 0x60, 0x27, 0xc0, 0xcc, 0x6c, 0x3b, 0x6c, 0xf4, 0x7b, 0xed, 0xc7,
-	0xda, 0x0f, 0xb1, 0x99, 0x29, 0x2d, 0xd0, 0x32, 0x74, 0x59, 0xf7, 0x8d, 0x29, 0xf3, 0x7f, 0xfe,
-	0xbf, 0xfe, 0x9f, 0xa7, 0xe3, 0xb4, 0x70, 0x3c, 0xc2, 0xc2, 0x76, 0x6c, 0x61, 0x97, 0x7b, 0x2e,
-	0xa3, 0xa2, 0x6f, 0x0b, 0x3c, 0xbd, 0x2a, 0x71, 0x97, 0x09, 0x86, 0x50, 0x50, 0x53, 0x0a, 0x15,
-	0xc3, 0x08, 0x7d, 0xe2, 0x89, 0x63, 0xcf, 0xff, 0xeb, 0xd7, 0x1b, 0xfb, 0x11, 0x0d, 0x8b, 0xae,
-	0x33, 0x91, 0xa2, 0xb6, 0x2e, 0xa3, 0x3d, 0x9d, 0xe6, 0xb8, 0x8c, 0x4e, 0x22, 0x18, 0x7f, 0x46,
-	0xb4, 0x48, 0xc4, 0x98, 0x97, 0x93, 0x21, 0x13, 0x9a, 0x38, 0xc2, 0xf6, 0x06, 0xbe, 0x74, 0xfc,
-	0x39, 0x0d, 0xeb, 0x35, 0x46, 0x7b, 0xa4, 0x8f, 0xf2, 0x90, 0x26, 0x4e, 0x21, 0x75, 0x94, 0x2a,
-	0x66, 0xcc, 0x34, 0x71, 0xd0, 0x21, 0x64, 0x87, 0xc4, 0x13, 0x98, 0xb6, 0x39, 0x73, 0x45, 0x21,
-	0x7d, 0x94, 0x2a, 0xae, 0x99, 0xe0, 0x2f, 0xb5, 0x98, 0x2b, 0xd0, 0x01, 0x80, 0xba, 0x4b, 0xfb,
-	0x03, 0xf3, 0x44, 0xe1, 0x27, 0x65, 0xcc, 0xa8, 0x95, 0x1b, 0xe6, 0xcd, 0xc8, 0xca, 0xfe, 0xb3,
-	0xb2, 0xfb, 0xb2, 0x72, 0x5f, 0x40, 0x86, 0x32, 0x07, 0xb7, 0x25, 0xb0, 0xb0, 0x76, 0x94, 0x2a,
-	0x66, 0x4f, 0xff, 0x2a, 0x85, 0x73, 0xf6, 0xa7, 0x79, 0x15, 0x34, 0x59, 0xa7, 0x0e, 0x67, 0x84,
-	0x0a, 0xf3, 0x57, 0xe9, 0xb9, 0x25, 0x9e, 0x40, 0xe7, 0x90, 0x95, 0x63, 0x6d, 0x77, 0x55, 0xfa,
-	0xc2, 0xba, 0x22, 0x18, 0x51, 0x42, 0x5d, 0x74, 0x1d, 0xbf, 0x3f, 0x13, 0x70, 0x78, 0x8d, 0x2e,
-	0x60, 0x43, 0x0d, 0x3e, 0x70, 0xff, 0xa2, 0xdc, 0x7f, 0x44, 0xdd, 0xb2, 0x3a, 0xb0, 0x67, 0xbb,
-	0xd3, 0x1f, 0xa7, 0x5f, 0x10, 0x6c, 0x86, 0xe1, 0x2c, 0xec, 0x7e, 0x22, 0x5d, 0x8c, 0x2a, 0xf0,
-	0xdb, 0x35, 0x16, 0x2d, 0xd9, 0xe1, 0x3d, 0x76, 0x3d, 0xc2, 0x28, 0xda, 0x89, 0xe5, 0x19, 0x71,
-	0xf1, 0x64, 0xec, 0x45, 0x97, 0x83, 0xfa, 0x3a, 0x6c, 0x5f, 0x63, 0x11, 0x92, 0xbf, 0x17, 0xd3,
-	0x50, 0x49, 0x2e, 0xe5, 0xf6, 0x09, 0x96, 0x0e, 0xa2, 0xb5, 0x4a, 0x0d, 0xe6, 0xaa, 0x47, 0x5d,
-	0x42, 0x3e, 0x68, 0x6a, 0x32, 0x3b, 0x4d, 0x98, 0xd8, 0xf0, 0x66, 0x3d, 0xb7, 0x80, 0x66, 0xfb,
-	0x5a, 0x4e, 0x3a, 0xd4, 0x6e, 0x83, 0x29, 0xcd, 0x8a, 0xd3, 0x92, 0x6c, 0xc6, 0xe2, 0xdb, 0xa1,
-	0x16, 0xec, 0xce, 0xd2, 0xee, 0x98, 0xf3, 0x5c, 0x62, 0x15, 0x36, 0x82, 0xf1, 0xab, 0xad, 0xfa,
-	0xad, 0x13, 0x53, 0x8e, 0x86, 0xa3, 0x3c, 0xb7, 0x6a, 0xee, 0x6a, 0x65, 0x92, 0x26, 0xe1, 0x09,
-	0x2e, 0xa6, 0x4d, 0xbc, 0x77, 0x90, 0xb7, 0xe6, 0x69, 0x27, 0xd1, 0xf2, 0x79, 0xdd, 0xc4, 0x1f,
-	0xc7, 0xd8, 0x13, 0xba, 0x0e, 0xfd, 0x74, 0x33, 0xff, 0x1e, 0xf1, 0x74, 0x4a, 0xd4, 0xa7, 0x9b,
-	0xf5, 0xd6, 0x21, 0xdf, 0xf0, 0xd4, 0x82, 0x39, 0xa6, 0x94, 0xd0, 0x44, 0xda, 0xef, 0x51, 0xb9,
-	0xca, 0xd8, 0x10, 0x55, 0x01, 0x2c, 0x61, 0xbb, 0x7e, 0xac, 0x24, 0x84, 0xa6, 0xb1, 0x0a, 0x64,
-	0x2c, 0xc1, 0xf8, 0x73, 0x10, 0x16, 0x6c, 0x9a, 0xb8, 0x2f, 0x8f, 0x49, 0xb7, 0x39, 0xd1, 0x51,
-	0x31, 0x36, 0xed, 0x71, 0xe7, 0x8d, 0xed, 0x0d, 0xda, 0xd1, 0x4a, 0x1d, 0xf4, 0x1d, 0xa0, 0x4b,
-	0xec, 0x46, 0xb1, 0xff, 0xe8, 0xb0, 0xf1, 0x5a, 0x1d, 0xf8, 0x01, 0xf6, 0xa2, 0x19, 0x9a, 0x36,
-	0xe7, 0xf2, 0x21, 0x3c, 0x3b, 0xf4, 0x7b, 0xd8, 0x8f, 0x07, 0x09, 0xe8, 0x3f, 0x20, 0x7b, 0x03,
-	0xb2, 0x41, 0x94, 0xda, 0xc8, 0x41, 0x7f, 0x27, 0xe5, 0xad, 0x8d, 0x1c, 0x1d, 0xaa, 0x09, 0xb9,
-	0xe9, 0x7d, 0x25, 0xec, 0x24, 0x39, 0xde, 0x12, 0xdc, 0x2b, 0xd8, 0x36, 0xb1, 0x7c, 0xef, 0x4d,
-	0x5c, 0x96, 0xb0, 0xc5, 0xd8, 0x8b, 0x6f, 0xa8, 0x39, 0x59, 0x07, 0x7b, 0x09, 0x3b, 0xd7, 0x58,
-	0xc8, 0x37, 0xd9, 0xbd, 0x3d, 0x1c, 0x63, 0xaf, 0xfa, 0xd4, 0x72, 0x71, 0x8f, 0x3c, 0xa2, 0xdd,
-	0x18, 0x4e, 0xb8, 0x84, 0xf6, 0x8d, 0xfd, 0xc5, 0xeb, 0x4d, 0x9b, 0xa3, 0x2b, 0xc8, 0xcd, 0xb1,
-	0x90, 0xb1, 0xb8, 0x56, 0x9e, 0x41, 0xcb, 0x38, 0x15, 0xc8, 0x59, 0x73, 0x1c, 0x7d, 0xad, 0xae,
-	0xad, 0x33, 0xc8, 0xb4, 0x08, 0xed, 0xab, 0xd7, 0x84, 0xee, 0x88, 0xd4, 0x58, 0xff, 0x87, 0x9c,
-	0xb4, 0x86, 0xc7, 0xf1, 0x8a, 0xf6, 0x0a, 0x6c, 0xcd, 0xd9, 0xe5, 0x91, 0xbf, 0x32, 0x42, 0x85,
-	0x57, 0x27, 0x66, 0xd2, 0xc9, 0xac, 0x41, 0xd4, 0x60, 0x5b, 0x22, 0x82, 0x4d, 0x5e, 0xb5, 0xbb,
-	0x03, 0x4c, 0x9d, 0x15, 0x73, 0x98, 0x00, 0xe6, 0x98, 0xd6, 0xd8, 0x68, 0x64, 0x53, 0x07, 0xfd,
-	0x1b, 0x2d, 0x9a, 0x6a, 0xaf, 0x69, 0xd8, 0x6e, 0x70, 0xb6, 0x6b, 0xb6, 0x0f, 0x7a, 0x0b, 0x5b,
-	0xb3, 0x3e, 0xbf, 0xc7, 0xe2, 0x32, 0xb4, 0x2a, 0x49, 0xc2, 0x9e, 0x41, 0xe6, 0x06, 0xdb, 0xae,
-	0xa8, 0x62, 0x7b, 0xc5, 0xe7, 0x5d, 0x7d, 0xf1, 0x70, 0xc1, 0x38, 0xa6, 0x9c, 0x08, 0x97, 0x3c,
-	0x96, 0x08, 0x2b, 0x4f, 0x7f, 0x95, 0xf9, 0xa0, 0x5f, 0xe6, 0x9d, 0x72, 0xfc, 0x2b, 0xfe, 0x9c,
-	0x77, 0xc2, 0xeb, 0xce, 0xba, 0xfa, 0xdc, 0xfd, 0xef, 0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcb,
-	0x7d, 0x97, 0xc4, 0xee, 0x0b, 0x00, 0x00,
+	// 899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x5f, 0x6f, 0xdb, 0x36,
+	0x14, 0xc5, 0x91, 0x6c, 0xcd, 0xea, 0xeb, 0x38, 0x6b, 0x98, 0xa6, 0x75, 0xb4, 0x25, 0xcd, 0x3a,
+	0x14, 0x33, 0x86, 0x21, 0x06, 0xba, 0xa7, 0xa1, 0x58, 0x30, 0xdb, 0xf9, 0xd3, 0x6c, 0x71, 0x67,
+	0x48, 0x5b, 0x0b, 0xf4, 0x61, 0x06, 0x2d, 0x31, 0x1e, 0x11, 0x9b, 0xd4, 0xa8, 0xeb, 0xa1, 0xf9,
+	0x5e, 0xfb, 0x6a, 0x7b, 0x1f, 0x48, 0x89, 0xb2, 0x2d, 0x85, 0xd1, 0xbc, 0xec, 0x25, 0x50, 0x78,
+	0xee, 0xf9, 0xe9, 0xdc, 0x2b, 0x5a, 0xb4, 0xe1, 0xf9, 0x94, 0x21, 0x8d, 0x28, 0xd2, 0xf6, 0x95,
+	0x92, 0x02, 0xc7, 0x14, 0xd9, 0xfc, 0xea, 0x28, 0x56, 0x12, 0x25, 0x21, 0xb6, 0xe6, 0x28, 0x57,
+	0x3c, 0x2f, 0xf7, 0xe1, 0x4d, 0xcc, 0x92, 0xf4, 0x6f, 0x5a, 0xef, 0x7d, 0x5e, 0xd0, 0x42, 0x39,
+	0x9d, 0x52, 0x11, 0x65, 0xea, 0x5e, 0x41, 0x65, 0x18, 0x5a, 0xc9, 0x2b, 0x19, 0xc5, 0x95, 0x4b,
+	0x8b, 0x94, 0x14, 0x59, 0x40, 0xef, 0xa0, 0xa0, 0x15, 0x1a, 0x28, 0x79, 0x63, 0x3e, 0x91, 0xe8,
+	0x88, 0x83, 0x34, 0xb9, 0x4e, 0xa5, 0xe7, 0x7f, 0xad, 0xc3, 0x46, 0x4f, 0x8a, 0x2b, 0x3e, 0x26,
+	0x5b, 0xb0, 0xce, 0xa3, 0xe6, 0xda, 0xe1, 0x5a, 0xab, 0xe6, 0xaf, 0xf3, 0x88, 0x3c, 0x83, 0xfa,
+	0x84, 0x27, 0xc8, 0xc4, 0x30, 0x96, 0x0a, 0x9b, 0xeb, 0x87, 0x6b, 0xad, 0x07, 0x3e, 0xa4, 0x4b,
+	0x03, 0xa9, 0x90, 0xec, 0x03, 0x98, 0xbb, 0x0c, 0x7f, 0x97, 0x09, 0x36, 0x3f, 0x32, 0xc6, 0x9a,
+	0x59, 0x79, 0x2d, 0x93, 0x05, 0xd9, 0xd8, 0x3f, 0x36, 0xf6, 0x54, 0x36, 0xee, 0x63, 0xa8, 0x09,
+	0x19, 0xb1, 0xa1, 0x06, 0x36, 0x1f, 0x1c, 0xae, 0xb5, 0xea, 0x2f, 0xbf, 0x38, 0xca, 0x9f, 0x42,
+	0x3a, 0xeb, 0x33, 0xdb, 0xe4, 0xa9, 0x88, 0x62, 0xc9, 0x05, 0xfa, 0x0f, 0xb5, 0xe7, 0x92, 0x27,
+	0x48, 0x5e, 0x41, 0x5d, 0x8f, 0x75, 0x18, 0x9a, 0xf4, 0xcd, 0x0d, 0x43, 0xf0, 0x8a, 0x84, 0x53,
+	0x0c, 0xa3, 0xb4, 0x3f, 0x1f, 0x58, 0x7e, 0x4d, 0x8e, 0x61, 0xd3, 0x0c, 0xde, 0xba, 0x3f, 0x31,
+	0xee, 0xcf, 0x8a, 0x6e, 0x5d, 0x6d, 0xed, 0xf5, 0x70, 0xfe, 0xcf, 0xcb, 0xbf, 0x77, 0xe1, 0x51,
+	0x1e, 0x2e, 0x60, 0xea, 0x4f, 0x1e, 0x32, 0xd2, 0x81, 0x4f, 0xcf, 0x19, 0x0e, 0x74, 0x87, 0x6f,
+	0x99, 0x4a, 0xb8, 0x14, 0x64, 0xb7, 0x94, 0x67, 0x1a, 0xe3, 0x8d, 0xf7, 0xb4, 0xb8, 0x6c, 0xeb,
+	0x4f, 0x61, 0xe7, 0x9c, 0x61, 0x4e, 0xfe, 0xaf, 0x98, 0x0b, 0x93, 0xe4, 0x44, 0x6f, 0x1f, 0xbb,
+	0xb4, 0x5f, 0xac, 0x35, 0xaa, 0x9d, 0xab, 0x1b, 0x75, 0x02, 0x5b, 0xb6, 0xa9, 0x6c, 0x76, 0x8e,
+	0x30, 0xa5, 0xe1, 0x2d, 0x7a, 0x2e, 0x81, 0x2c, 0xf6, 0x75, 0x37, 0xe9, 0x99, 0x73, 0x1b, 0xcc,
+	0x69, 0x41, 0x99, 0x56, 0x65, 0xf3, 0x6e, 0xbf, 0x1d, 0x19, 0xc0, 0x93, 0x45, 0xda, 0x1b, 0x19,
+	0xdd, 0x97, 0xd8, 0x85, 0x4d, 0x3b, 0x7e, 0xb3, 0x55, 0xff, 0xed, 0xc4, 0x8c, 0xe3, 0x22, 0x32,
+	0x9e, 0x4b, 0x33, 0x77, 0xb3, 0x92, 0xa5, 0xa9, 0x78, 0x82, 0xb7, 0xd3, 0x32, 0xef, 0x1b, 0xd8,
+	0x0a, 0x96, 0x69, 0x2f, 0x8a, 0xe5, 0xcb, 0xba, 0xcf, 0xfe, 0x98, 0xb1, 0x04, 0x5d, 0x1d, 0xa6,
+	0xe9, 0x16, 0x3e, 0x1e, 0xe5, 0x74, 0x46, 0x74, 0xa7, 0x5b, 0xf4, 0x9e, 0xc2, 0xd6, 0x45, 0x62,
+	0x16, 0xfc, 0x99, 0x10, 0x5c, 0x54, 0xd2, 0x1e, 0x17, 0xe5, 0xae, 0x94, 0x13, 0xd2, 0x05, 0x08,
+	0x90, 0xaa, 0x34, 0x56, 0x15, 0xc2, 0xd1, 0x58, 0x07, 0x6a, 0x01, 0xca, 0xf8, 0x3e, 0x88, 0x00,
+	0x1e, 0xf9, 0x6c, 0xac, 0x5f, 0x93, 0xaa, 0x9f, 0xe9, 0xa4, 0x55, 0x9a, 0xf6, 0x6c, 0xf4, 0x0b,
+	0x4d, 0xae, 0x87, 0xc5, 0x4a, 0x17, 0xf4, 0x1d, 0x90, 0x13, 0xa6, 0x8a, 0xd8, 0xaf, 0x5d, 0xd8,
+	0x72, 0xad, 0x0b, 0xfc, 0x1e, 0x9e, 0x16, 0x33, 0xf4, 0x69, 0x1c, 0xeb, 0x87, 0x70, 0xef, 0xd0,
+	0xbf, 0xc1, 0x5e, 0x39, 0x88, 0xa5, 0xff, 0x0f, 0xd9, 0x2f, 0xa0, 0x6e, 0xa3, 0xf4, 0xa6, 0x11,
+	0xf9, 0xb2, 0x2a, 0x6f, 0x6f, 0x1a, 0xb9, 0x50, 0x7d, 0x68, 0xcc, 0xef, 0xab, 0x61, 0x2f, 0xaa,
+	0xe3, 0xdd, 0x81, 0xeb, 0xc1, 0xc3, 0xce, 0x48, 0x2a, 0xd4, 0xa4, 0x43, 0x17, 0xc9, 0x56, 0xb8,
+	0x20, 0x3f, 0xc1, 0x8e, 0xcf, 0xf4, 0xe1, 0x99, 0x19, 0x02, 0xa4, 0x38, 0x4b, 0xca, 0xbb, 0x72,
+	0x49, 0x76, 0x37, 0xf8, 0x38, 0x85, 0xf5, 0xa5, 0xe0, 0x28, 0xf5, 0x6c, 0x15, 0x0f, 0x13, 0x72,
+	0x50, 0x2c, 0x5f, 0xd6, 0x5d, 0xb8, 0x1f, 0x61, 0xf7, 0x9c, 0xa1, 0x3e, 0x5d, 0xdf, 0xd2, 0xc9,
+	0x8c, 0x25, 0xdd, 0x9b, 0x81, 0x62, 0x57, 0xfc, 0x03, 0x79, 0x52, 0x4a, 0x87, 0x8a, 0x8b, 0xb1,
+	0xb7, 0x77, 0xfb, 0x7a, 0x9f, 0xc6, 0xe4, 0x0c, 0x1a, 0x4b, 0x2c, 0xe2, 0xdd, 0x5e, 0xab, 0xdf,
+	0x8b, 0x77, 0x71, 0x3a, 0xd0, 0x08, 0x96, 0x38, 0xee, 0x5a, 0x57, 0x5b, 0xdf, 0x41, 0x6d, 0xc0,
+	0xc5, 0xd8, 0x1c, 0x5d, 0xae, 0xd7, 0xb6, 0xc3, 0xfa, 0x3d, 0x34, 0xb4, 0x35, 0x3f, 0x22, 0x56,
+	0xb4, 0x77, 0x60, 0x7b, 0xc9, 0xae, 0x8f, 0xa1, 0x95, 0x11, 0x26, 0xbc, 0x79, 0x8b, 0x57, 0x9d,
+	0x16, 0xce, 0x7d, 0xbb, 0xa3, 0x11, 0xf6, 0x83, 0xd7, 0xa5, 0xe1, 0x35, 0x13, 0xd1, 0x8a, 0x39,
+	0x7c, 0x00, 0x7f, 0x26, 0x7a, 0xe9, 0x37, 0x62, 0xf2, 0x4d, 0xb1, 0x68, 0xae, 0xfd, 0x2c, 0xf2,
+	0x76, 0xed, 0x79, 0xe3, 0xd8, 0x3e, 0xe4, 0x57, 0xd8, 0x5e, 0xf4, 0xa5, 0x3d, 0xb6, 0xee, 0x42,
+	0x9b, 0x92, 0x2a, 0xec, 0x3b, 0xd8, 0xcc, 0x8e, 0x8c, 0x34, 0xec, 0x57, 0x6e, 0x62, 0x80, 0x8a,
+	0xd1, 0xa9, 0x05, 0x1e, 0x94, 0x8f, 0x86, 0xb4, 0x8a, 0x25, 0xe6, 0x6b, 0x93, 0x0f, 0xdb, 0x3e,
+	0xa3, 0x91, 0x4d, 0x33, 0xc3, 0x78, 0x86, 0xa4, 0xc2, 0xe4, 0xed, 0x3b, 0xf4, 0xcc, 0x7e, 0x06,
+	0x8d, 0x1e, 0x15, 0x21, 0x9b, 0xd8, 0xb4, 0x55, 0x3c, 0xf7, 0x26, 0x7f, 0xcd, 0xa8, 0xc2, 0x2e,
+	0xa3, 0x2b, 0x6e, 0xf2, 0xee, 0x0f, 0xef, 0x8f, 0x65, 0xcc, 0x44, 0xcc, 0x51, 0xf1, 0x0f, 0x47,
+	0x5c, 0xb6, 0xe7, 0xff, 0xb5, 0xe3, 0xeb, 0x71, 0x3b, 0x1e, 0xb5, 0xcb, 0x3f, 0xb6, 0x5e, 0xc5,
+	0xa3, 0xfc, 0x7a, 0xb4, 0x61, 0x7e, 0x77, 0x7c, 0xfb, 0xcf, 0x00, 0x06, 0x93, 0x86, 0x1c, 0x95,
+	0x0d, 0x00, 0x00,
 }
 
 type FrontgateService interface {
@@ -201,7 +207,9 @@ type FrontgateService interface {
 	DeregisterMetadataMapping(in *types.SubTask_DeregisterMetadata, out *types.Empty) error
 	RegisterCmd(in *types.SubTask_RegisterCmd, out *types.Empty) error
 	DeregisterCmd(in *types.SubTask_DeregisterCmd, out *types.Empty) error
+	AbortCmd(in *types.SubTask_AbortCmd, out *types.Empty) error
 	ReportSubTaskStatus(in *types.SubTaskStatus, out *types.Empty) error
+	ReportMonitorMetrics(in *types.MonitorMetrics, out *types.Empty) error
 	GetEtcdValuesByPrefix(in *types.String, out *types.StringMap) error
 	GetEtcdValues(in *types.StringList, out *types.StringMap) error
 	SetEtcdValues(in *types.StringMap, out *types.Empty) error
@@ -212,6 +220,9 @@ type FrontgateService interface {
 	PingMetadataBackend(in *types.Empty, out *types.Empty) error
 	RunCommand(in *types.RunCommandOnFrontgateRequest, out *types.String) error
 	RunCommandOnDrone(in *types.RunCommandOnDroneRequest, out *types.String) error
+	StartCommand(in *types.RunCommandStreamRequest, out *types.CommandSession) error
+	ReadCommandOutput(in *types.CommandSession, out *types.CommandOutput) error
+	CancelCommand(in *types.CommandSession, out *types.Empty) error
 	HeartBeat(in *types.Empty, out *types.Empty) error
 }
 
@@ -993,6 +1004,41 @@ func (c *FrontgateServiceClient) AsyncDeregisterCmd(in *types.SubTask_Deregister
 	)
 }
 
+func (c *FrontgateServiceClient) AbortCmd(in *types.SubTask_AbortCmd) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.SubTask_AbortCmd)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.AbortCmd", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncAbortCmd(in *types.SubTask_AbortCmd, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.SubTask_AbortCmd)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.AbortCmd",
+		in, out,
+		done,
+	)
+}
+
 func (c *FrontgateServiceClient) ReportSubTaskStatus(in *types.SubTaskStatus) (out *types.Empty, err error) {
 	if in == nil {
 		in = new(types.SubTaskStatus)
@@ -1028,6 +1074,41 @@ func (c *FrontgateServiceClient) AsyncReportSubTaskStatus(in *types.SubTaskStatu
 	)
 }
 
+func (c *FrontgateServiceClient) ReportMonitorMetrics(in *types.MonitorMetrics) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.MonitorMetrics)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.ReportMonitorMetrics", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncReportMonitorMetrics(in *types.MonitorMetrics, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.MonitorMetrics)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.ReportMonitorMetrics",
+		in, out,
+		done,
+	)
+}
+
 func (c *FrontgateServiceClient) GetEtcdValuesByPrefix(in *types.String) (out *types.StringMap, err error) {
 	if in == nil {
 		in = new(types.String)
@@ -1378,6 +1459,111 @@ func (c *FrontgateServiceClient) AsyncRunCommandOnDrone(in *types.RunCommandOnDr
 	)
 }
 
+func (c *FrontgateServiceClient) StartCommand(in *types.RunCommandStreamRequest) (out *types.CommandSession, err error) {
+	if in == nil {
+		in = new(types.RunCommandStreamRequest)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.CommandSession)
+	if err = c.Call("metadata.frontgate.FrontgateService.StartCommand", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncStartCommand(in *types.RunCommandStreamRequest, out *types.CommandSession, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.RunCommandStreamRequest)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.StartCommand",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) ReadCommandOutput(in *types.CommandSession) (out *types.CommandOutput, err error) {
+	if in == nil {
+		in = new(types.CommandSession)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.CommandOutput)
+	if err = c.Call("metadata.frontgate.FrontgateService.ReadCommandOutput", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncReadCommandOutput(in *types.CommandSession, out *types.CommandOutput, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.CommandSession)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.ReadCommandOutput",
+		in, out,
+		done,
+	)
+}
+
+func (c *FrontgateServiceClient) CancelCommand(in *types.CommandSession) (out *types.Empty, err error) {
+	if in == nil {
+		in = new(types.CommandSession)
+	}
+	type Validator interface {
+		Validate() error
+	}
+	if x, ok := proto.Message(in).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	out = new(types.Empty)
+	if err = c.Call("metadata.frontgate.FrontgateService.CancelCommand", in, out); err != nil {
+		return nil, err
+	}
+	if x, ok := proto.Message(out).(Validator); ok {
+		if err := x.Validate(); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func (c *FrontgateServiceClient) AsyncCancelCommand(in *types.CommandSession, out *types.Empty, done chan *rpc.Call) *rpc.Call {
+	if in == nil {
+		in = new(types.CommandSession)
+	}
+	return c.Go(
+		"metadata.frontgate.FrontgateService.CancelCommand",
+		in, out,
+		done,
+	)
+}
+
 func (c *FrontgateServiceClient) HeartBeat(in *types.Empty) (out *types.Empty, err error) {
 	if in == nil {
 		in = new(types.Empty)
@@ -32,53 +32,60 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("metadata/pilot/pilot.proto", fileDescriptor_9b294d1323d9005f) }
 
 var fileDescriptor_9b294d1323d9005f = []byte{
-	// 736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x35, 0x09, 0x21, 0xed, 0xc0, 0xca, 0x96, 0x01, 0xa3, 0x19, 0x1b, 0x17, 0x30, 0x69,
-	0x42, 0x5b, 0x83, 0x86, 0x84, 0x40, 0x5c, 0xad, 0xdd, 0x1f, 0xca, 0xda, 0x31, 0x35, 0x63, 0x48,
-	0xbb, 0x00, 0xb9, 0xcd, 0x59, 0xb0, 0xda, 0xda, 0x26, 0x76, 0x81, 0x3d, 0x02, 0x2f, 0xc9, 0xb3,
-	0xa0, 0x38, 0x49, 0x9b, 0x26, 0x75, 0x22, 0xad, 0xbb, 0xe2, 0x26, 0xd1, 0x7c, 0xbe, 0xf3, 0xcb,
-	0x77, 0x7c, 0xe6, 0x33, 0x0f, 0xec, 0x21, 0x2a, 0xe2, 0x11, 0x45, 0x1c, 0x41, 0x07, 0x5c, 0x45,
-	0xcf, 0x9a, 0x08, 0xb8, 0xe2, 0x56, 0x25, 0x89, 0xd5, 0xf4, 0xaa, 0xfd, 0xd4, 0xe7, 0xdc, 0x1f,
-	0xa0, 0x43, 0x04, 0x75, 0x08, 0x63, 0x5c, 0x11, 0x45, 0x39, 0x93, 0x91, 0xda, 0xde, 0xd1, 0xaf,
-	0xde, 0xae, 0x8f, 0x6c, 0x57, 0xfe, 0x22, 0xbe, 0x8f, 0x81, 0xc3, 0x85, 0x56, 0xcc, 0x50, 0x4f,
-	0xbe, 0xab, 0xae, 0x05, 0xca, 0xe8, 0x69, 0x88, 0xf5, 0x38, 0xbb, 0xf2, 0x0c, 0x31, 0x2f, 0xe0,
-	0x0c, 0xe3, 0xd8, 0x66, 0x26, 0x76, 0x15, 0x70, 0xa6, 0x7c, 0xa2, 0xd0, 0x90, 0x9b, 0xaa, 0xd5,
-	0xae, 0x66, 0xfd, 0x10, 0xd9, 0x8f, 0x42, 0x7b, 0x7f, 0x57, 0xe0, 0xfe, 0x59, 0x28, 0x75, 0x31,
-	0xf8, 0x49, 0x7b, 0x68, 0xed, 0xc3, 0x83, 0x63, 0x54, 0x7a, 0xe9, 0x02, 0x03, 0x49, 0x39, 0xb3,
-	0x1e, 0xd5, 0xc6, 0x7b, 0x15, 0x55, 0x72, 0x38, 0x14, 0xea, 0xda, 0x5e, 0xcb, 0x2e, 0x27, 0xfa,
-	0x13, 0x58, 0x3d, 0x46, 0x75, 0x94, 0x18, 0x4c, 0x96, 0xd7, 0xb3, 0xfa, 0xb1, 0xa2, 0xe9, 0x99,
-	0x61, 0x4d, 0xed, 0xe7, 0x20, 0xdc, 0x89, 0x64, 0x69, 0x23, 0xab, 0xd5, 0xd1, 0x43, 0xe6, 0x09,
-	0x4e, 0x99, 0x32, 0xa3, 0x0e, 0xa0, 0x92, 0x94, 0xd6, 0xe0, 0xec, 0x8a, 0xfa, 0xa6, 0xca, 0x72,
-	0x4e, 0xd3, 0x39, 0x17, 0xb0, 0x36, 0xa6, 0x0c, 0x28, 0x32, 0x75, 0xde, 0x72, 0x8b, 0x71, 0x2f,
-	0x66, 0xe3, 0x32, 0xc9, 0x1f, 0x61, 0x39, 0xbd, 0x6b, 0x2d, 0x2a, 0x95, 0x09, 0xf8, 0xac, 0x60,
-	0x27, 0x75, 0x5e, 0x07, 0xac, 0x34, 0x2b, 0xfe, 0x42, 0x61, 0x03, 0xcc, 0xcc, 0x38, 0xbb, 0x05,
-	0x96, 0x9b, 0x67, 0x96, 0xa5, 0xd9, 0xb3, 0x4b, 0xb0, 0x5a, 0xba, 0x17, 0xba, 0x71, 0x31, 0xa9,
-	0xa4, 0xab, 0xeb, 0x33, 0xc3, 0x71, 0xee, 0x29, 0x54, 0xdc, 0x69, 0xda, 0x56, 0x56, 0x3e, 0x1d,
-	0xef, 0xe0, 0x8f, 0x11, 0x4a, 0x55, 0xec, 0x2e, 0x94, 0x7a, 0x26, 0x77, 0x3a, 0x68, 0x76, 0x97,
-	0xce, 0x3d, 0x84, 0x4a, 0x53, 0xea, 0x85, 0xce, 0x88, 0x31, 0xca, 0x4a, 0x6b, 0x7d, 0x98, 0x0d,
-	0xd7, 0x39, 0x1f, 0x58, 0x75, 0x00, 0x57, 0x91, 0x20, 0xb2, 0x55, 0x86, 0x30, 0x14, 0xb6, 0x0f,
-	0x8b, 0xae, 0xe2, 0x62, 0x1e, 0x84, 0x0b, 0xcb, 0x1d, 0xf4, 0xa9, 0x54, 0x18, 0xb4, 0xe3, 0xb8,
-	0xb5, 0x9d, 0xdb, 0xed, 0x51, 0xf7, 0x9c, 0xc8, 0xfe, 0xb7, 0xac, 0xd2, 0x04, 0xfd, 0x02, 0xd6,
-	0x01, 0x06, 0x59, 0xec, 0x4b, 0x13, 0x36, 0xaf, 0x35, 0x81, 0x2f, 0x61, 0x2d, 0xeb, 0xa1, 0x4d,
-	0x84, 0x08, 0x9b, 0x30, 0xb7, 0xe9, 0xaf, 0x50, 0xcd, 0x1b, 0x49, 0xe8, 0xb7, 0xe0, 0xbd, 0x09,
-	0xf7, 0x12, 0x2b, 0x8d, 0xa1, 0x67, 0x3d, 0x2f, 0xf3, 0xdb, 0x18, 0x7a, 0x26, 0x54, 0x1b, 0x96,
-	0x26, 0xdf, 0x0d, 0x61, 0x5b, 0xe5, 0xf6, 0x0a, 0x70, 0x2d, 0x3d, 0xab, 0xdc, 0x51, 0x37, 0xfc,
-	0x4b, 0xe2, 0x2a, 0xa2, 0x46, 0xd2, 0xaa, 0x1a, 0x88, 0x4d, 0xcf, 0xde, 0x30, 0x84, 0xe2, 0xcc,
-	0x23, 0x58, 0xfa, 0x40, 0x98, 0x37, 0xc0, 0x18, 0x68, 0x6d, 0x1a, 0xf4, 0x6d, 0x94, 0x92, 0xf8,
-	0x68, 0x72, 0xf5, 0x0e, 0x16, 0xcf, 0x28, 0xf3, 0xf5, 0x74, 0x35, 0x8d, 0x4e, 0x43, 0x6a, 0x03,
-	0x96, 0xc2, 0xd4, 0xf1, 0xf0, 0x2a, 0x9e, 0x95, 0x06, 0xc8, 0x09, 0xac, 0x4c, 0x41, 0x4e, 0xb9,
-	0x87, 0x05, 0x03, 0x32, 0x0c, 0x9b, 0x61, 0xfb, 0x51, 0x31, 0xfa, 0x48, 0xde, 0xf0, 0xa4, 0x36,
-	0x61, 0x35, 0x44, 0x24, 0xbf, 0x66, 0x75, 0xd2, 0xeb, 0x23, 0xf3, 0x6e, 0x54, 0x1a, 0x81, 0x6a,
-	0x67, 0xc4, 0x1a, 0x7c, 0x38, 0x24, 0xcc, 0xfb, 0xc4, 0xa6, 0x4b, 0xdc, 0xc9, 0xe6, 0xcc, 0x94,
-	0x26, 0x23, 0xf7, 0x71, 0xae, 0xb9, 0x2a, 0x08, 0x0f, 0xcc, 0x67, 0x58, 0x49, 0xe7, 0x45, 0x85,
-	0x6f, 0x17, 0xa1, 0xb5, 0xa4, 0x04, 0xbb, 0xf7, 0xe7, 0x0e, 0x3c, 0x49, 0x5f, 0x70, 0x8e, 0x78,
-	0x30, 0xe9, 0xf2, 0x7f, 0x7c, 0xd9, 0x99, 0xe3, 0x30, 0xdc, 0xce, 0x3d, 0xe9, 0x04, 0x56, 0x3b,
-	0x28, 0x78, 0xa0, 0xa6, 0x0f, 0x7b, 0xf1, 0x2c, 0x30, 0x5b, 0x5a, 0x9e, 0x5c, 0x2c, 0xbe, 0x13,
-	0xc6, 0x70, 0x90, 0x37, 0x55, 0xbf, 0x56, 0x38, 0x83, 0xa0, 0x97, 0xb7, 0x17, 0x5e, 0x2d, 0xd4,
-	0xdf, 0x5e, 0xbe, 0xe1, 0x02, 0x99, 0xa0, 0x2a, 0xa0, 0xbf, 0x6b, 0x94, 0x3b, 0x93, 0x9f, 0x1c,
-	0xd1, 0xf7, 0x1d, 0xd1, 0x75, 0xa6, 0xff, 0x63, 0x78, 0x2f, 0xba, 0xfa, 0xdd, 0xbd, 0xab, 0x6f,
-	0xcb, 0xaf, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xf9, 0x19, 0xf1, 0x52, 0x0c, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0x6d, 0x4f, 0xdb, 0x3a,
+	0x14, 0xc7, 0x85, 0x74, 0x75, 0x75, 0x39, 0xf7, 0xd2, 0x5b, 0x02, 0x17, 0x6e, 0xc3, 0xc3, 0xbd,
+	0xda, 0x86, 0x86, 0x26, 0x68, 0x10, 0x93, 0xa6, 0x4d, 0x7b, 0xd5, 0x07, 0x60, 0x1d, 0x2d, 0x43,
+	0x0d, 0x63, 0x12, 0x9a, 0x36, 0xb9, 0x8d, 0xc9, 0xac, 0xb6, 0xb6, 0x67, 0x3b, 0xdb, 0xf8, 0x70,
+	0xfb, 0x1e, 0xfb, 0x38, 0x53, 0x9c, 0xa4, 0x0f, 0x49, 0x9c, 0x4a, 0xc0, 0xab, 0xbd, 0x69, 0x55,
+	0xff, 0xff, 0xe7, 0xe7, 0x73, 0x6c, 0xe7, 0xc4, 0x05, 0x7b, 0x84, 0x15, 0xf2, 0x90, 0x42, 0x0e,
+	0x27, 0x43, 0xa6, 0xa2, 0xcf, 0x2a, 0x17, 0x4c, 0x31, 0xab, 0x94, 0x68, 0x55, 0x3d, 0x6a, 0x6f,
+	0xfa, 0x8c, 0xf9, 0x43, 0xec, 0x20, 0x4e, 0x1c, 0x44, 0x29, 0x53, 0x48, 0x11, 0x46, 0x65, 0xe4,
+	0xb6, 0xf7, 0xf4, 0x57, 0x7f, 0xdf, 0xc7, 0x74, 0x5f, 0x7e, 0x45, 0xbe, 0x8f, 0x85, 0xc3, 0xb8,
+	0x76, 0xe4, 0xb8, 0x27, 0xf3, 0xaa, 0x1b, 0x8e, 0x65, 0xf4, 0x19, 0x6b, 0x9b, 0x29, 0xad, 0xcf,
+	0x46, 0x23, 0x44, 0x3d, 0x43, 0x64, 0x9f, 0xd1, 0x6b, 0x93, 0xe6, 0x09, 0x46, 0x71, 0xac, 0x6d,
+	0xa7, 0xb4, 0x6b, 0xc1, 0xa8, 0xf2, 0x91, 0xc2, 0x86, 0xd8, 0xa9, 0x95, 0xb0, 0x2b, 0x29, 0x4d,
+	0x21, 0x39, 0x88, 0xa4, 0xc3, 0xef, 0xab, 0xf0, 0xd7, 0x79, 0x68, 0x75, 0xb1, 0xf8, 0x42, 0xfa,
+	0xd8, 0xaa, 0xc1, 0xdf, 0x27, 0x58, 0xe9, 0xa1, 0x4b, 0x2c, 0x24, 0x61, 0xd4, 0xfa, 0xa7, 0x3a,
+	0x5e, 0xc9, 0xa8, 0xce, 0xa3, 0x11, 0x57, 0x37, 0xf6, 0x7a, 0x7a, 0x38, 0xf1, 0x9f, 0xc2, 0xca,
+	0x09, 0x56, 0xc7, 0x49, 0x82, 0xc9, 0xf0, 0x46, 0xda, 0x3f, 0x76, 0xb4, 0x3c, 0x33, 0xac, 0xa5,
+	0xf3, 0x69, 0x86, 0x2b, 0x91, 0x0c, 0x6d, 0xa5, 0xbd, 0x5a, 0x3d, 0xa2, 0x1e, 0x67, 0x84, 0x2a,
+	0x33, 0xaa, 0x09, 0xa5, 0xa4, 0xb4, 0x06, 0xa3, 0xd7, 0xc4, 0x37, 0x55, 0x96, 0xc9, 0x74, 0x3a,
+	0xe6, 0x12, 0xd6, 0xc7, 0x94, 0x21, 0xc1, 0x54, 0x5d, 0xb4, 0xdd, 0x62, 0xdc, 0xa3, 0x7c, 0x5c,
+	0x2a, 0xf8, 0x35, 0x94, 0xa7, 0x57, 0xad, 0x4d, 0xa4, 0x32, 0x01, 0xff, 0x2b, 0x58, 0x49, 0x1d,
+	0xd7, 0x05, 0x6b, 0x9a, 0x15, 0xcf, 0x50, 0xb8, 0x01, 0x66, 0x66, 0x1c, 0xdd, 0x06, 0xcb, 0xcd,
+	0x32, 0xe7, 0x85, 0xd9, 0xf9, 0x25, 0x58, 0x6d, 0xbd, 0x17, 0x7a, 0xe3, 0x62, 0xd2, 0x9c, 0x5d,
+	0xdd, 0xc8, 0x95, 0xe3, 0xd8, 0x33, 0x28, 0xb9, 0xb3, 0xb4, 0x9d, 0xb4, 0x7d, 0x56, 0xef, 0xe2,
+	0xcf, 0x01, 0x96, 0xaa, 0x38, 0xbb, 0xd0, 0xea, 0x99, 0xb2, 0xd3, 0xa2, 0x39, 0xbb, 0xe9, 0xd8,
+	0x23, 0x28, 0xb5, 0xa4, 0x1e, 0xe8, 0x06, 0x94, 0x12, 0x3a, 0xb7, 0xd6, 0xd5, 0xb4, 0x5c, 0x67,
+	0x6c, 0x68, 0xd5, 0x01, 0x5c, 0x85, 0x44, 0x94, 0xd6, 0x3c, 0x84, 0xa1, 0xb0, 0x1a, 0x2c, 0xba,
+	0x8a, 0xf1, 0xbb, 0x20, 0x5c, 0x28, 0x77, 0xb1, 0x4f, 0xa4, 0xc2, 0xa2, 0x13, 0xeb, 0xd6, 0x6e,
+	0x66, 0xb5, 0x83, 0xde, 0x05, 0x92, 0x83, 0x8f, 0x69, 0xa7, 0x09, 0xfa, 0x0e, 0xac, 0x26, 0x16,
+	0x69, 0xec, 0x13, 0x13, 0x36, 0xeb, 0x35, 0x81, 0xaf, 0x60, 0x3d, 0x9d, 0x43, 0x07, 0x71, 0x1e,
+	0x6e, 0xc2, 0x9d, 0x93, 0xfe, 0x00, 0x95, 0x6c, 0x22, 0x09, 0xfd, 0x1e, 0x72, 0x6f, 0xc1, 0x9f,
+	0x49, 0x2a, 0x8d, 0x91, 0x67, 0x3d, 0x9c, 0x97, 0x6f, 0x63, 0xe4, 0x99, 0x50, 0x1d, 0x58, 0x9a,
+	0xcc, 0x1b, 0xc2, 0x76, 0xe6, 0xa7, 0x57, 0x80, 0x6b, 0xc0, 0x1f, 0xb5, 0x1e, 0x13, 0x2a, 0x24,
+	0xfd, 0x6f, 0x22, 0x25, 0x0e, 0xf3, 0x43, 0x16, 0x36, 0x3c, 0x37, 0xe8, 0x85, 0xaf, 0x23, 0x57,
+	0x21, 0x15, 0x48, 0xab, 0x62, 0x80, 0xb5, 0x3c, 0x7b, 0xcb, 0x20, 0xc5, 0x91, 0xc7, 0xb0, 0xf4,
+	0x0a, 0x51, 0x6f, 0x88, 0x63, 0xa0, 0xb5, 0x6d, 0xf0, 0x77, 0xb0, 0x94, 0xc8, 0xc7, 0xa6, 0xac,
+	0x5e, 0xc0, 0xe2, 0x39, 0xa1, 0xbe, 0x6e, 0xd1, 0xa6, 0xfe, 0x6b, 0x5c, 0x95, 0xa5, 0x30, 0x74,
+	0xdc, 0x01, 0x8b, 0x1b, 0xae, 0x01, 0x72, 0x0a, 0xcb, 0x33, 0x90, 0x33, 0xe6, 0xe1, 0x82, 0x2e,
+	0x1b, 0xca, 0x66, 0x58, 0x2d, 0x2a, 0x46, 0x3f, 0xd7, 0xb7, 0x7c, 0xdc, 0x5b, 0xb0, 0x12, 0x22,
+	0x92, 0xb3, 0x5a, 0x47, 0xfd, 0x01, 0xa6, 0xde, 0xad, 0x4a, 0x43, 0x50, 0xe9, 0x06, 0xb4, 0x11,
+	0x5d, 0x87, 0xde, 0xd0, 0xd9, 0x12, 0xf7, 0xd2, 0x31, 0xb9, 0xd6, 0xa4, 0x6f, 0xaf, 0x65, 0x36,
+	0x57, 0x89, 0xf0, 0xa9, 0x7b, 0x0b, 0xcb, 0xd3, 0x71, 0x51, 0xe1, 0xbb, 0x45, 0x68, 0x6d, 0x99,
+	0x87, 0x7d, 0x0f, 0xe5, 0x49, 0x8c, 0xab, 0x04, 0x46, 0x23, 0xeb, 0xb1, 0x99, 0x1a, 0x39, 0x12,
+	0x68, 0xce, 0xab, 0x23, 0x9a, 0x3b, 0x50, 0x3c, 0x50, 0x07, 0x0b, 0x56, 0x07, 0x56, 0xbb, 0x98,
+	0x33, 0xa1, 0x3a, 0x8c, 0x12, 0xc5, 0xc2, 0xbe, 0x20, 0x48, 0x5f, 0x66, 0x4f, 0xf0, 0xac, 0x6e,
+	0x5a, 0xe6, 0x01, 0xac, 0x35, 0xb1, 0xec, 0x0b, 0xd2, 0xc3, 0x29, 0xe0, 0x7e, 0xe6, 0x04, 0xe4,
+	0xfa, 0x92, 0xc4, 0x1f, 0x14, 0xcf, 0x1f, 0xde, 0x34, 0x0e, 0x7f, 0xfc, 0x06, 0xff, 0x4e, 0xdf,
+	0x1f, 0x8f, 0x99, 0x98, 0x9c, 0xff, 0x5f, 0xf8, 0x2e, 0x79, 0x87, 0x36, 0x71, 0x3f, 0xd7, 0xd0,
+	0x53, 0x58, 0x89, 0x0e, 0xcd, 0x6c, 0x1b, 0x2c, 0xee, 0x92, 0xe6, 0xd7, 0xc3, 0xbd, 0x9e, 0xc0,
+	0x26, 0x94, 0x27, 0xd7, 0xc0, 0x4f, 0x88, 0x52, 0x3c, 0xcc, 0xd6, 0x58, 0xbf, 0x51, 0x58, 0xda,
+	0xf9, 0xc3, 0xbb, 0x0b, 0x07, 0x0b, 0xf5, 0xe7, 0x57, 0xcf, 0x18, 0xc7, 0x94, 0x13, 0x25, 0xc8,
+	0xb7, 0x2a, 0x61, 0xce, 0xe4, 0x97, 0xc3, 0x07, 0xbe, 0xc3, 0x7b, 0xce, 0xec, 0xbf, 0xbf, 0x97,
+	0xbc, 0xa7, 0xbf, 0x7b, 0xbf, 0xeb, 0xff, 0x36, 0x4f, 0x7f, 0x0e, 0x00, 0xe9, 0x13, 0x35, 0x6f,
+	0x1e, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -113,6 +120,7 @@ type PilotServiceClient interface {
 	DeregisterMetadataMapping(ctx context.Context, in *types.SubTask_DeregisterMetadata, opts ...grpc.CallOption) (*types.Empty, error)
 	RegisterCmd(ctx context.Context, in *types.SubTask_RegisterCmd, opts ...grpc.CallOption) (*types.Empty, error)
 	DeregisterCmd(ctx context.Context, in *types.SubTask_DeregisterCmd, opts ...grpc.CallOption) (*types.Empty, error)
+	AbortCmd(ctx context.Context, in *types.SubTask_AbortCmd, opts ...grpc.CallOption) (*types.Empty, error)
 	GetSubtaskStatus(ctx context.Context, in *types.SubTaskId, opts ...grpc.CallOption) (*types.SubTaskStatus, error)
 	HandleSubtask(ctx context.Context, in *types.SubTaskMessage, opts ...grpc.CallOption) (*types.Empty, error)
 	PingPilot(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
@@ -122,6 +130,10 @@ type PilotServiceClient interface {
 	PingMetadataBackend(ctx context.Context, in *types.FrontgateId, opts ...grpc.CallOption) (*types.Empty, error)
 	RunCommandOnFrontgateNode(ctx context.Context, in *types.RunCommandOnFrontgateRequest, opts ...grpc.CallOption) (*types.String, error)
 	RunCommandOnDrone(ctx context.Context, in *types.RunCommandOnDroneRequest, opts ...grpc.CallOption) (*types.String, error)
+	RunCommandStream(ctx context.Context, in *types.RunCommandStreamRequest, opts ...grpc.CallOption) (PilotService_RunCommandStreamClient, error)
+	// the metrics reported by frontgates are forwarded to the replica holding the frontgate channel
+	ReportMonitorMetrics(ctx context.Context, in *types.MonitorMetrics, opts ...grpc.CallOption) (*types.Empty, error)
+	DescribeMonitorMetrics(ctx context.Context, in *types.DescribeMonitorMetricsRequest, opts ...grpc.CallOption) (*types.MonitorMetricsList, error)
 }
 
 type pilotServiceClient struct {
@@ -312,6 +324,15 @@ func (c *pilotServiceClient) DeregisterCmd(ctx context.Context, in *types.SubTas
 	return out, nil
 }
 
+func (c *pilotServiceClient) AbortCmd(ctx context.Context, in *types.SubTask_AbortCmd, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotService/AbortCmd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pilotServiceClient) GetSubtaskStatus(ctx context.Context, in *types.SubTaskId, opts ...grpc.CallOption) (*types.SubTaskStatus, error) {
 	out := new(types.SubTaskStatus)
 	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotService/GetSubtaskStatus", in, out, opts...)
@@ -393,6 +414,56 @@ func (c *pilotServiceClient) RunCommandOnDrone(ctx context.Context, in *types.Ru
 	return out, nil
 }
 
+func (c *pilotServiceClient) RunCommandStream(ctx context.Context, in *types.RunCommandStreamRequest, opts ...grpc.CallOption) (PilotService_RunCommandStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PilotService_serviceDesc.Streams[0], "/metadata.pilot.PilotService/RunCommandStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pilotServiceRunCommandStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PilotService_RunCommandStreamClient interface {
+	Recv() (*types.CommandOutput, error)
+	grpc.ClientStream
+}
+
+type pilotServiceRunCommandStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *pilotServiceRunCommandStreamClient) Recv() (*types.CommandOutput, error) {
+	m := new(types.CommandOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pilotServiceClient) ReportMonitorMetrics(ctx context.Context, in *types.MonitorMetrics, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotService/ReportMonitorMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pilotServiceClient) DescribeMonitorMetrics(ctx context.Context, in *types.DescribeMonitorMetricsRequest, opts ...grpc.CallOption) (*types.MonitorMetricsList, error) {
+	out := new(types.MonitorMetricsList)
+	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotService/DescribeMonitorMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PilotServiceServer is the server API for PilotService service.
 type PilotServiceServer interface {
 	GetPilotVersion(context.Context, *types.Empty) (*types.Version, error)
@@ -415,6 +486,7 @@ type PilotServiceServer interface {
 	DeregisterMetadataMapping(context.Context, *types.SubTask_DeregisterMetadata) (*types.Empty, error)
 	RegisterCmd(context.Context, *types.SubTask_RegisterCmd) (*types.Empty, error)
 	DeregisterCmd(context.Context, *types.SubTask_DeregisterCmd) (*types.Empty, error)
+	AbortCmd(context.Context, *types.SubTask_AbortCmd) (*types.Empty, error)
 	GetSubtaskStatus(context.Context, *types.SubTaskId) (*types.SubTaskStatus, error)
 	HandleSubtask(context.Context, *types.SubTaskMessage) (*types.Empty, error)
 	PingPilot(context.Context, *types.Empty) (*types.Empty, error)
@@ -424,6 +496,10 @@ type PilotServiceServer interface {
 	PingMetadataBackend(context.Context, *types.FrontgateId) (*types.Empty, error)
 	RunCommandOnFrontgateNode(context.Context, *types.RunCommandOnFrontgateRequest) (*types.String, error)
 	RunCommandOnDrone(context.Context, *types.RunCommandOnDroneRequest) (*types.String, error)
+	RunCommandStream(*types.RunCommandStreamRequest, PilotService_RunCommandStreamServer) error
+	// the metrics reported by frontgates are forwarded to the replica holding the frontgate channel
+	ReportMonitorMetrics(context.Context, *types.MonitorMetrics) (*types.Empty, error)
+	DescribeMonitorMetrics(context.Context, *types.DescribeMonitorMetricsRequest) (*types.MonitorMetricsList, error)
 }
 
 // UnimplementedPilotServiceServer can be embedded to have forward compatible implementations.
@@ -490,6 +566,9 @@ func (*UnimplementedPilotServiceServer) RegisterCmd(ctx context.Context, req *ty
 func (*UnimplementedPilotServiceServer) DeregisterCmd(ctx context.Context, req *types.SubTask_DeregisterCmd) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeregisterCmd not implemented")
 }
+func (*UnimplementedPilotServiceServer) AbortCmd(ctx context.Context, req *types.SubTask_AbortCmd) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortCmd not implemented")
+}
 func (*UnimplementedPilotServiceServer) GetSubtaskStatus(ctx context.Context, req *types.SubTaskId) (*types.SubTaskStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubtaskStatus not implemented")
 }
@@ -517,6 +596,15 @@ func (*UnimplementedPilotServiceServer) RunCommandOnFrontgateNode(ctx context.Co
 func (*UnimplementedPilotServiceServer) RunCommandOnDrone(ctx context.Context, req *types.RunCommandOnDroneRequest) (*types.String, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCommandOnDrone not implemented")
 }
+func (*UnimplementedPilotServiceServer) RunCommandStream(req *types.RunCommandStreamRequest, srv PilotService_RunCommandStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunCommandStream not implemented")
+}
+func (*UnimplementedPilotServiceServer) ReportMonitorMetrics(ctx context.Context, req *types.MonitorMetrics) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMonitorMetrics not implemented")
+}
+func (*UnimplementedPilotServiceServer) DescribeMonitorMetrics(ctx context.Context, req *types.DescribeMonitorMetricsRequest) (*types.MonitorMetricsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeMonitorMetrics not implemented")
+}
 
 func RegisterPilotServiceServer(s *grpc.Server, srv PilotServiceServer) {
 	s.RegisterService(&_PilotService_serviceDesc, srv)
@@ -882,6 +970,24 @@ func _PilotService_DeregisterCmd_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PilotService_AbortCmd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.SubTask_AbortCmd)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PilotServiceServer).AbortCmd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.pilot.PilotService/AbortCmd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PilotServiceServer).AbortCmd(ctx, req.(*types.SubTask_AbortCmd))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PilotService_GetSubtaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.SubTaskId)
 	if err := dec(in); err != nil {
@@ -1044,6 +1150,63 @@ func _PilotService_RunCommandOnDrone_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PilotService_RunCommandStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.RunCommandStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PilotServiceServer).RunCommandStream(m, &pilotServiceRunCommandStreamServer{stream})
+}
+
+type PilotService_RunCommandStreamServer interface {
+	Send(*types.CommandOutput) error
+	grpc.ServerStream
+}
+
+type pilotServiceRunCommandStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *pilotServiceRunCommandStreamServer) Send(m *types.CommandOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PilotService_ReportMonitorMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.MonitorMetrics)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PilotServiceServer).ReportMonitorMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.pilot.PilotService/ReportMonitorMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PilotServiceServer).ReportMonitorMetrics(ctx, req.(*types.MonitorMetrics))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PilotService_DescribeMonitorMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.DescribeMonitorMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PilotServiceServer).DescribeMonitorMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.pilot.PilotService/DescribeMonitorMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PilotServiceServer).DescribeMonitorMetrics(ctx, req.(*types.DescribeMonitorMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PilotService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metadata.pilot.PilotService",
 	HandlerType: (*PilotServiceServer)(nil),
@@ -1128,6 +1291,10 @@ var _PilotService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeregisterCmd",
 			Handler:    _PilotService_DeregisterCmd_Handler,
 		},
+		{
+			MethodName: "AbortCmd",
+			Handler:    _PilotService_AbortCmd_Handler,
+		},
 		{
 			MethodName: "GetSubtaskStatus",
 			Handler:    _PilotService_GetSubtaskStatus_Handler,
@@ -1164,8 +1331,22 @@ var _PilotService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunCommandOnDrone",
 			Handler:    _PilotService_RunCommandOnDrone_Handler,
 		},
+		{
+			MethodName: "ReportMonitorMetrics",
+			Handler:    _PilotService_ReportMonitorMetrics_Handler,
+		},
+		{
+			MethodName: "DescribeMonitorMetrics",
+			Handler:    _PilotService_DescribeMonitorMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RunCommandStream",
+			Handler:       _PilotService_RunCommandStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "metadata/pilot/pilot.proto",
 }
 
@@ -1179,6 +1360,7 @@ type PilotServiceForFrontgateClient interface {
 	PingPilot(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 	GetPilotConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.PilotConfig, error)
 	ReportSubTaskStatus(ctx context.Context, in *types.SubTaskStatus, opts ...grpc.CallOption) (*types.Empty, error)
+	ReportMonitorMetrics(ctx context.Context, in *types.MonitorMetrics, opts ...grpc.CallOption) (*types.Empty, error)
 	FrontgateChannel(ctx context.Context, opts ...grpc.CallOption) (PilotServiceForFrontgate_FrontgateChannelClient, error)
 }
 
@@ -1244,6 +1426,15 @@ func (c *pilotServiceForFrontgateClient) ReportSubTaskStatus(ctx context.Context
 	return out, nil
 }
 
+func (c *pilotServiceForFrontgateClient) ReportMonitorMetrics(ctx context.Context, in *types.MonitorMetrics, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/metadata.pilot.PilotServiceForFrontgate/ReportMonitorMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pilotServiceForFrontgateClient) FrontgateChannel(ctx context.Context, opts ...grpc.CallOption) (PilotServiceForFrontgate_FrontgateChannelClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_PilotServiceForFrontgate_serviceDesc.Streams[0], "/metadata.pilot.PilotServiceForFrontgate/FrontgateChannel", opts...)
 	if err != nil {
@@ -1283,6 +1474,7 @@ type PilotServiceForFrontgateServer interface {
 	PingPilot(context.Context, *types.Empty) (*types.Empty, error)
 	GetPilotConfig(context.Context, *types.Empty) (*types.PilotConfig, error)
 	ReportSubTaskStatus(context.Context, *types.SubTaskStatus) (*types.Empty, error)
+	ReportMonitorMetrics(context.Context, *types.MonitorMetrics) (*types.Empty, error)
 	FrontgateChannel(PilotServiceForFrontgate_FrontgateChannelServer) error
 }
 
@@ -1308,6 +1500,9 @@ func (*UnimplementedPilotServiceForFrontgateServer) GetPilotConfig(ctx context.C
 func (*UnimplementedPilotServiceForFrontgateServer) ReportSubTaskStatus(ctx context.Context, req *types.SubTaskStatus) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportSubTaskStatus not implemented")
 }
+func (*UnimplementedPilotServiceForFrontgateServer) ReportMonitorMetrics(ctx context.Context, req *types.MonitorMetrics) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMonitorMetrics not implemented")
+}
 func (*UnimplementedPilotServiceForFrontgateServer) FrontgateChannel(srv PilotServiceForFrontgate_FrontgateChannelServer) error {
 	return status.Errorf(codes.Unimplemented, "method FrontgateChannel not implemented")
 }
@@ -1424,6 +1619,24 @@ func _PilotServiceForFrontgate_ReportSubTaskStatus_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PilotServiceForFrontgate_ReportMonitorMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.MonitorMetrics)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PilotServiceForFrontgateServer).ReportMonitorMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metadata.pilot.PilotServiceForFrontgate/ReportMonitorMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PilotServiceForFrontgateServer).ReportMonitorMetrics(ctx, req.(*types.MonitorMetrics))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PilotServiceForFrontgate_FrontgateChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(PilotServiceForFrontgateServer).FrontgateChannel(&pilotServiceForFrontgateFrontgateChannelServer{stream})
 }
@@ -1478,6 +1691,10 @@ var _PilotServiceForFrontgate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportSubTaskStatus",
 			Handler:    _PilotServiceForFrontgate_ReportSubTaskStatus_Handler,
 		},
+		{
+			MethodName: "ReportMonitorMetrics",
+			Handler:    _PilotServiceForFrontgate_ReportMonitorMetrics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
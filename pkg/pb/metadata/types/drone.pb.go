@@ -8,6 +8,7 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 )
 
@@ -101,15 +102,19 @@ func (m *DroneIdList) GetIdList() []string {
 }
 
 type DroneConfig struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host"`
-	ListenPort           int32    `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
-	CmdInfoLogPath       string   `protobuf:"bytes,4,opt,name=cmd_info_log_path,json=cmdInfoLogPath,proto3" json:"cmd_info_log_path"`
-	ConfdSelfHost        string   `protobuf:"bytes,5,opt,name=confd_self_host,json=confdSelfHost,proto3" json:"confd_self_host"`
-	LogLevel             string   `protobuf:"bytes,6,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Host           string `protobuf:"bytes,2,opt,name=host,proto3" json:"host"`
+	ListenPort     int32  `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
+	CmdInfoLogPath string `protobuf:"bytes,4,opt,name=cmd_info_log_path,json=cmdInfoLogPath,proto3" json:"cmd_info_log_path"`
+	ConfdSelfHost  string `protobuf:"bytes,5,opt,name=confd_self_host,json=confdSelfHost,proto3" json:"confd_self_host"`
+	LogLevel       string `protobuf:"bytes,6,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
+	// cmd run by drone periodically to collect the monitor metrics of node,
+	// it prints the values of monitor items in json, e.g. {"conn_count": 10}
+	MonitorCmd             string   `protobuf:"bytes,7,opt,name=monitor_cmd,json=monitorCmd,proto3" json:"monitor_cmd"`
+	MonitorIntervalSeconds int32    `protobuf:"varint,8,opt,name=monitor_interval_seconds,json=monitorIntervalSeconds,proto3" json:"monitor_interval_seconds"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *DroneConfig) Reset()         { *m = DroneConfig{} }
@@ -179,6 +184,20 @@ func (m *DroneConfig) GetLogLevel() string {
 	return ""
 }
 
+func (m *DroneConfig) GetMonitorCmd() string {
+	if m != nil {
+		return m.MonitorCmd
+	}
+	return ""
+}
+
+func (m *DroneConfig) GetMonitorIntervalSeconds() int32 {
+	if m != nil {
+		return m.MonitorIntervalSeconds
+	}
+	return 0
+}
+
 type DroneEndpoint struct {
 	FrontgateId          string   `protobuf:"bytes,1,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
 	DroneIp              string   `protobuf:"bytes,2,opt,name=drone_ip,json=droneIp,proto3" json:"drone_ip"`
@@ -337,8 +356,10 @@ func (m *RunCommandOnDroneRequest) GetTimeoutSeconds() int32 {
 }
 
 type DistributeDroneRequest struct {
-	PilotVersion         *wrappers.StringValue `protobuf:"bytes,1,opt,name=pilot_version,json=pilotVersion,proto3" json:"pilot_version"`
-	FrontgateAddress     *wrappers.StringValue `protobuf:"bytes,2,opt,name=frontgate_address,json=frontgateAddress,proto3" json:"frontgate_address"`
+	PilotVersion     *wrappers.StringValue `protobuf:"bytes,1,opt,name=pilot_version,json=pilotVersion,proto3" json:"pilot_version"`
+	FrontgateAddress *wrappers.StringValue `protobuf:"bytes,2,opt,name=frontgate_address,json=frontgateAddress,proto3" json:"frontgate_address"`
+	// hex encoded sha256 of the drone binary served by frontgate
+	DroneSha256          *wrappers.StringValue `protobuf:"bytes,3,opt,name=drone_sha256,json=droneSha256,proto3" json:"drone_sha256"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -383,6 +404,178 @@ func (m *DistributeDroneRequest) GetFrontgateAddress() *wrappers.StringValue {
 	return nil
 }
 
+func (m *DistributeDroneRequest) GetDroneSha256() *wrappers.StringValue {
+	if m != nil {
+		return m.DroneSha256
+	}
+	return nil
+}
+
+type MonitorMetrics struct {
+	FrontgateId          string               `protobuf:"bytes,1,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
+	DroneId              string               `protobuf:"bytes,2,opt,name=drone_id,json=droneId,proto3" json:"drone_id"`
+	CollectTime          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=collect_time,json=collectTime,proto3" json:"collect_time"`
+	Values               map[string]float64   `protobuf:"bytes,4,rep,name=values,proto3" json:"values" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MonitorMetrics) Reset()         { *m = MonitorMetrics{} }
+func (m *MonitorMetrics) String() string { return proto.CompactTextString(m) }
+func (*MonitorMetrics) ProtoMessage()    {}
+func (*MonitorMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a60d93f90b01620, []int{7}
+}
+
+func (m *MonitorMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorMetrics.Unmarshal(m, b)
+}
+func (m *MonitorMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorMetrics.Marshal(b, m, deterministic)
+}
+func (m *MonitorMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorMetrics.Merge(m, src)
+}
+func (m *MonitorMetrics) XXX_Size() int {
+	return xxx_messageInfo_MonitorMetrics.Size(m)
+}
+func (m *MonitorMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorMetrics proto.InternalMessageInfo
+
+func (m *MonitorMetrics) GetFrontgateId() string {
+	if m != nil {
+		return m.FrontgateId
+	}
+	return ""
+}
+
+func (m *MonitorMetrics) GetDroneId() string {
+	if m != nil {
+		return m.DroneId
+	}
+	return ""
+}
+
+func (m *MonitorMetrics) GetCollectTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CollectTime
+	}
+	return nil
+}
+
+func (m *MonitorMetrics) GetValues() map[string]float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type MonitorMetricsList struct {
+	MetricsList          []*MonitorMetrics `protobuf:"bytes,1,rep,name=metrics_list,json=metricsList,proto3" json:"metrics_list"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MonitorMetricsList) Reset()         { *m = MonitorMetricsList{} }
+func (m *MonitorMetricsList) String() string { return proto.CompactTextString(m) }
+func (*MonitorMetricsList) ProtoMessage()    {}
+func (*MonitorMetricsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a60d93f90b01620, []int{8}
+}
+
+func (m *MonitorMetricsList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorMetricsList.Unmarshal(m, b)
+}
+func (m *MonitorMetricsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorMetricsList.Marshal(b, m, deterministic)
+}
+func (m *MonitorMetricsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorMetricsList.Merge(m, src)
+}
+func (m *MonitorMetricsList) XXX_Size() int {
+	return xxx_messageInfo_MonitorMetricsList.Size(m)
+}
+func (m *MonitorMetricsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorMetricsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorMetricsList proto.InternalMessageInfo
+
+func (m *MonitorMetricsList) GetMetricsList() []*MonitorMetrics {
+	if m != nil {
+		return m.MetricsList
+	}
+	return nil
+}
+
+type DescribeMonitorMetricsRequest struct {
+	FrontgateId          string               `protobuf:"bytes,1,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
+	DroneIdList          []string             `protobuf:"bytes,2,rep,name=drone_id_list,json=droneIdList,proto3" json:"drone_id_list"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DescribeMonitorMetricsRequest) Reset()         { *m = DescribeMonitorMetricsRequest{} }
+func (m *DescribeMonitorMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeMonitorMetricsRequest) ProtoMessage()    {}
+func (*DescribeMonitorMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a60d93f90b01620, []int{9}
+}
+
+func (m *DescribeMonitorMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeMonitorMetricsRequest.Unmarshal(m, b)
+}
+func (m *DescribeMonitorMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeMonitorMetricsRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeMonitorMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeMonitorMetricsRequest.Merge(m, src)
+}
+func (m *DescribeMonitorMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeMonitorMetricsRequest.Size(m)
+}
+func (m *DescribeMonitorMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeMonitorMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeMonitorMetricsRequest proto.InternalMessageInfo
+
+func (m *DescribeMonitorMetricsRequest) GetFrontgateId() string {
+	if m != nil {
+		return m.FrontgateId
+	}
+	return ""
+}
+
+func (m *DescribeMonitorMetricsRequest) GetDroneIdList() []string {
+	if m != nil {
+		return m.DroneIdList
+	}
+	return nil
+}
+
+func (m *DescribeMonitorMetricsRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *DescribeMonitorMetricsRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DroneId)(nil), "metadata.types.DroneId")
 	proto.RegisterType((*DroneIdList)(nil), "metadata.types.DroneIdList")
@@ -391,44 +584,65 @@ func init() {
 	proto.RegisterType((*SetDroneConfigRequest)(nil), "metadata.types.SetDroneConfigRequest")
 	proto.RegisterType((*RunCommandOnDroneRequest)(nil), "metadata.types.RunCommandOnDroneRequest")
 	proto.RegisterType((*DistributeDroneRequest)(nil), "metadata.types.DistributeDroneRequest")
+	proto.RegisterType((*MonitorMetrics)(nil), "metadata.types.MonitorMetrics")
+	proto.RegisterMapType((map[string]float64)(nil), "metadata.types.MonitorMetrics.ValuesEntry")
+	proto.RegisterType((*MonitorMetricsList)(nil), "metadata.types.MonitorMetricsList")
+	proto.RegisterType((*DescribeMonitorMetricsRequest)(nil), "metadata.types.DescribeMonitorMetricsRequest")
 }
 
 func init() { proto.RegisterFile("metadata/types/drone.proto", fileDescriptor_5a60d93f90b01620) }
 
 var fileDescriptor_5a60d93f90b01620 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x96, 0xfb, 0x93, 0x34, 0x93, 0x36, 0xa5, 0x2b, 0x01, 0x6e, 0x4b, 0xa1, 0xf8, 0x50, 0xca,
-	0xc5, 0x96, 0x5a, 0x09, 0x81, 0x38, 0x95, 0x16, 0x89, 0x48, 0x95, 0xa8, 0x1c, 0xa9, 0x07, 0x2e,
-	0x96, 0xe3, 0x5d, 0x3b, 0x2b, 0xec, 0x9d, 0x65, 0x77, 0x5c, 0xe0, 0x09, 0x78, 0x05, 0x9e, 0x80,
-	0x07, 0xe1, 0xc9, 0x90, 0xd7, 0x4e, 0xda, 0x54, 0x88, 0x13, 0x27, 0x7b, 0xbe, 0xef, 0x9b, 0x6f,
-	0x7e, 0x56, 0x03, 0x7b, 0x95, 0xa0, 0x94, 0xa7, 0x94, 0x46, 0xf4, 0x5d, 0x0b, 0x1b, 0x71, 0x83,
-	0x4a, 0x84, 0xda, 0x20, 0x21, 0x1b, 0xcd, 0xb9, 0xd0, 0x71, 0x7b, 0x4f, 0x0b, 0xc4, 0xa2, 0x14,
-	0x91, 0x63, 0xa7, 0x75, 0x1e, 0x7d, 0x35, 0xa9, 0xd6, 0xc2, 0xd8, 0x56, 0xbf, 0x77, 0xdf, 0x2b,
-	0x43, 0x95, 0xf3, 0x96, 0x0b, 0x76, 0xa1, 0x7f, 0xd1, 0x58, 0x8f, 0x39, 0x1b, 0xc1, 0x8a, 0xe4,
-	0xbe, 0x77, 0xe8, 0x1d, 0x0f, 0xe2, 0x15, 0xc9, 0x83, 0x23, 0x18, 0x76, 0xd4, 0xa5, 0xb4, 0xc4,
-	0x1e, 0x43, 0x5f, 0xf2, 0xa4, 0x94, 0x96, 0x7c, 0xef, 0x70, 0xf5, 0x78, 0x10, 0xf7, 0xa4, 0x23,
-	0x82, 0xdf, 0x5e, 0x27, 0x3c, 0x47, 0x95, 0xcb, 0xe2, 0xbe, 0x0f, 0x63, 0xb0, 0x36, 0x43, 0x4b,
-	0xfe, 0x8a, 0x43, 0xdc, 0x3f, 0x7b, 0x06, 0xc3, 0xc6, 0x49, 0xa8, 0x44, 0xa3, 0x21, 0x7f, 0xf5,
-	0xd0, 0x3b, 0x5e, 0x8f, 0xa1, 0x85, 0xae, 0xd0, 0x10, 0x7b, 0x09, 0x3b, 0x59, 0xc5, 0x13, 0xa9,
-	0x72, 0x4c, 0x4a, 0x2c, 0x12, 0x9d, 0xd2, 0xcc, 0x5f, 0x73, 0x0e, 0xa3, 0xac, 0xe2, 0x63, 0x95,
-	0xe3, 0x25, 0x16, 0x57, 0x29, 0xcd, 0xd8, 0x11, 0x6c, 0xbb, 0x89, 0x12, 0x2b, 0xca, 0x3c, 0x71,
-	0xa5, 0xd6, 0x9d, 0x70, 0xcb, 0xc1, 0x13, 0x51, 0xe6, 0x1f, 0x9a, 0x9a, 0xfb, 0x30, 0x68, 0x9c,
-	0x4a, 0x71, 0x23, 0x4a, 0xbf, 0xe7, 0x14, 0x1b, 0x25, 0x16, 0x97, 0x4d, 0x1c, 0x94, 0xb0, 0xe5,
-	0x66, 0x78, 0xaf, 0xb8, 0x46, 0xa9, 0x88, 0x3d, 0x87, 0xcd, 0xdc, 0xa0, 0xa2, 0x22, 0x25, 0x91,
-	0x2c, 0xe6, 0x19, 0x2e, 0xb0, 0x31, 0x67, 0xbb, 0xb0, 0xe1, 0x9e, 0x25, 0x91, 0xba, 0x1b, 0xae,
-	0xef, 0xe2, 0xb1, 0x66, 0x07, 0x00, 0x2d, 0x75, 0x67, 0xbc, 0x81, 0x43, 0x9a, 0xe9, 0x82, 0x1f,
-	0x1e, 0x3c, 0x9c, 0x08, 0xba, 0xb3, 0xb5, 0x58, 0x7c, 0xa9, 0x85, 0x25, 0xf6, 0x06, 0x36, 0x44,
-	0xd7, 0x82, 0x2b, 0x39, 0x3c, 0x39, 0x08, 0x97, 0x9f, 0x3b, 0x5c, 0xea, 0x33, 0x5e, 0xc8, 0xd9,
-	0x29, 0xf4, 0x32, 0xe7, 0xe5, 0x9a, 0x19, 0x9e, 0xec, 0xff, 0x35, 0xb1, 0x2b, 0xd7, 0x49, 0x83,
-	0x9f, 0x1e, 0xf8, 0x71, 0xad, 0xce, 0xb1, 0xaa, 0x52, 0xc5, 0x3f, 0x2a, 0xa7, 0xf9, 0x0f, 0xcd,
-	0xf8, 0xd0, 0xcf, 0x5a, 0xcf, 0xf9, 0x6a, 0xba, 0x90, 0xbd, 0x80, 0x6d, 0x92, 0x95, 0xc0, 0x9a,
-	0x12, 0x2b, 0x32, 0x54, 0xdc, 0x76, 0xfb, 0x19, 0x75, 0xf0, 0xa4, 0x45, 0x83, 0x5f, 0x1e, 0x3c,
-	0xba, 0x90, 0x96, 0x8c, 0x9c, 0xd6, 0x24, 0x96, 0x1a, 0x3b, 0x83, 0x2d, 0x2d, 0x4b, 0xa4, 0xe4,
-	0x46, 0x18, 0x2b, 0x51, 0x75, 0xdd, 0x3d, 0x09, 0xdb, 0x4b, 0x08, 0xe7, 0x97, 0x10, 0x4e, 0xc8,
-	0x48, 0x55, 0x5c, 0xa7, 0x65, 0x2d, 0xe2, 0x4d, 0x97, 0x72, 0xdd, 0x66, 0xb0, 0x31, 0xec, 0xdc,
-	0xbe, 0x6f, 0xca, 0xb9, 0x11, 0xd6, 0x76, 0x8b, 0xfb, 0xb7, 0xcd, 0x83, 0x45, 0xda, 0x59, 0x9b,
-	0xf5, 0xee, 0xf5, 0xa7, 0x57, 0xa8, 0x85, 0xd2, 0x92, 0x8c, 0xfc, 0x16, 0x4a, 0x8c, 0x6e, 0xa3,
-	0x48, 0x7f, 0x2e, 0x22, 0x3d, 0x8d, 0x96, 0x2f, 0xf0, 0xad, 0x9e, 0xba, 0xef, 0xb4, 0xe7, 0x2a,
-	0x9c, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x68, 0x2a, 0xce, 0x34, 0xee, 0x03, 0x00, 0x00,
+	// 804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xda, 0x89, 0x1d, 0x9f, 0x75, 0xdc, 0x76, 0x04, 0x65, 0xeb, 0x92, 0x36, 0xec, 0x45,
+	0x09, 0x5c, 0xac, 0x25, 0x57, 0xad, 0x12, 0x10, 0x42, 0x69, 0x52, 0x09, 0x4b, 0xa9, 0xa8, 0xd6,
+	0xa8, 0x48, 0xdc, 0xac, 0xd6, 0x3b, 0xe3, 0xcd, 0x28, 0xbb, 0x33, 0xc3, 0xcc, 0x38, 0x90, 0x27,
+	0xe0, 0x86, 0x07, 0xe0, 0x86, 0xf7, 0xe2, 0x0d, 0x78, 0x0d, 0xb4, 0x67, 0xc6, 0x4e, 0x6c, 0x10,
+	0x18, 0xa9, 0x57, 0xde, 0x73, 0xbe, 0xef, 0x9c, 0xf3, 0xcd, 0x37, 0x3f, 0x86, 0x61, 0xcd, 0x6c,
+	0x4e, 0x73, 0x9b, 0x8f, 0xec, 0x8d, 0x62, 0x66, 0x44, 0xb5, 0x14, 0x2c, 0x51, 0x5a, 0x5a, 0x49,
+	0x06, 0x4b, 0x2c, 0x41, 0x6c, 0xf8, 0xb4, 0x94, 0xb2, 0xac, 0xd8, 0x08, 0xd1, 0xd9, 0x62, 0x3e,
+	0xb2, 0xbc, 0x66, 0xc6, 0xe6, 0xb5, 0x72, 0x05, 0xc3, 0x27, 0x9b, 0x84, 0x9f, 0x74, 0xae, 0x14,
+	0xd3, 0xc6, 0xe3, 0x9b, 0xc3, 0x0a, 0x29, 0xe6, 0xd4, 0x61, 0xf1, 0x23, 0xe8, 0x9e, 0x37, 0xb3,
+	0x27, 0x94, 0x0c, 0xa0, 0xc5, 0x69, 0x14, 0x1c, 0x06, 0x47, 0xbd, 0xb4, 0xc5, 0x69, 0xfc, 0x0c,
+	0x42, 0x0f, 0x5d, 0x70, 0x63, 0xc9, 0x47, 0xd0, 0xe5, 0x34, 0xab, 0xb8, 0xb1, 0x51, 0x70, 0xd8,
+	0x3e, 0xea, 0xa5, 0x1d, 0x8e, 0x40, 0xfc, 0x7b, 0xcb, 0x13, 0xcf, 0xa4, 0x98, 0xf3, 0x72, 0xb3,
+	0x0f, 0x21, 0xb0, 0x73, 0x29, 0x8d, 0x8d, 0x5a, 0x98, 0xc1, 0x6f, 0xf2, 0x14, 0xc2, 0xa6, 0x13,
+	0x13, 0x99, 0x92, 0xda, 0x46, 0xed, 0xc3, 0xe0, 0x68, 0x37, 0x05, 0x97, 0x7a, 0x2b, 0xb5, 0x25,
+	0x9f, 0xc1, 0x83, 0xa2, 0xa6, 0x19, 0x17, 0x73, 0x99, 0x55, 0xb2, 0xcc, 0x54, 0x6e, 0x2f, 0xa3,
+	0x1d, 0xec, 0x30, 0x28, 0x6a, 0x3a, 0x11, 0x73, 0x79, 0x21, 0xcb, 0xb7, 0xb9, 0xbd, 0x24, 0xcf,
+	0xe0, 0x1e, 0xae, 0x28, 0x33, 0xac, 0x9a, 0x67, 0x38, 0x6a, 0x17, 0x89, 0xfb, 0x98, 0x9e, 0xb2,
+	0x6a, 0xfe, 0x4d, 0x33, 0xf3, 0x31, 0xf4, 0x9a, 0x4e, 0x15, 0xbb, 0x66, 0x55, 0xd4, 0x41, 0xc6,
+	0x5e, 0x25, 0xcb, 0x8b, 0x26, 0x6e, 0x04, 0xd5, 0x52, 0x70, 0x2b, 0x75, 0x56, 0xd4, 0x34, 0xea,
+	0x22, 0x0c, 0x3e, 0x75, 0x56, 0x53, 0x72, 0x0c, 0xd1, 0x92, 0xc0, 0x85, 0x65, 0xfa, 0x3a, 0xaf,
+	0x32, 0xc3, 0x0a, 0x29, 0xa8, 0x89, 0xf6, 0x50, 0xfe, 0x43, 0x8f, 0x4f, 0x3c, 0x3c, 0x75, 0x68,
+	0x5c, 0xc1, 0x3e, 0xda, 0xf3, 0x5a, 0x50, 0x25, 0xb9, 0xb0, 0xe4, 0x13, 0xe8, 0xcf, 0xb5, 0x14,
+	0xb6, 0xcc, 0x2d, 0xcb, 0x56, 0x56, 0x85, 0xab, 0xdc, 0x84, 0x92, 0x47, 0xb0, 0x87, 0x47, 0x22,
+	0xe3, 0xca, 0xfb, 0xd6, 0xc5, 0x78, 0xa2, 0xc8, 0x01, 0x80, 0x83, 0xee, 0x38, 0xd7, 0xc3, 0x4c,
+	0x63, 0x5c, 0xfc, 0x4b, 0x00, 0x1f, 0x4e, 0x99, 0xbd, 0xb3, 0x21, 0x29, 0xfb, 0x71, 0xc1, 0x8c,
+	0x25, 0x27, 0xb0, 0xc7, 0xbc, 0x04, 0x1c, 0x19, 0x8e, 0x0f, 0x92, 0xf5, 0xa3, 0x96, 0xac, 0xe9,
+	0x4c, 0x57, 0x74, 0xf2, 0x1c, 0x3a, 0x05, 0xf6, 0x42, 0x31, 0xe1, 0xf8, 0xf1, 0x3f, 0x16, 0xfa,
+	0x71, 0x9e, 0x1a, 0xff, 0x16, 0x40, 0x94, 0x2e, 0xc4, 0x99, 0xac, 0xeb, 0x5c, 0xd0, 0x6f, 0x05,
+	0x72, 0xde, 0x83, 0x98, 0x08, 0xba, 0x85, 0xeb, 0xb9, 0xb4, 0xc6, 0x87, 0xe4, 0x53, 0xb8, 0xd7,
+	0xdc, 0x0d, 0xb9, 0xb0, 0xab, 0xad, 0x71, 0xfe, 0x0c, 0x7c, 0x7a, 0xb9, 0x25, 0x7f, 0x06, 0xf0,
+	0xf0, 0x9c, 0x1b, 0xab, 0xf9, 0x6c, 0x61, 0xd9, 0x9a, 0xb0, 0x53, 0xd8, 0x57, 0xbc, 0x92, 0x36,
+	0xbb, 0x66, 0xda, 0x70, 0x29, 0xbc, 0xba, 0x8f, 0x13, 0x77, 0xc9, 0x92, 0xe5, 0x25, 0x4b, 0xa6,
+	0x56, 0x73, 0x51, 0xbe, 0xcb, 0xab, 0x05, 0x4b, 0xfb, 0x58, 0xf2, 0xce, 0x55, 0x90, 0x09, 0x3c,
+	0xb8, 0xdd, 0xdf, 0x9c, 0x52, 0xcd, 0x8c, 0x89, 0x5a, 0x5b, 0xb4, 0xb9, 0xbf, 0x2a, 0x3b, 0x75,
+	0x55, 0xe4, 0x6b, 0xe8, 0xbb, 0xcd, 0x36, 0x97, 0xf9, 0xf8, 0xc5, 0xcb, 0xa8, 0xbd, 0x45, 0x97,
+	0x10, 0x2b, 0xa6, 0x58, 0x10, 0xff, 0xda, 0x82, 0xc1, 0x1b, 0x77, 0x2e, 0xdf, 0x30, 0xab, 0x79,
+	0x61, 0xfe, 0xdf, 0xf1, 0xa3, 0xeb, 0xc7, 0x8f, 0x92, 0xaf, 0xa0, 0x5f, 0xc8, 0xaa, 0x62, 0x85,
+	0xcd, 0x1a, 0x53, 0xbd, 0xa2, 0xe1, 0xdf, 0x14, 0x7d, 0xb7, 0x7c, 0xa4, 0xd2, 0xd0, 0xf3, 0x9b,
+	0x0c, 0x79, 0x05, 0x9d, 0xeb, 0x46, 0xa5, 0x89, 0x76, 0x0e, 0xdb, 0x47, 0xe1, 0xf8, 0xf3, 0xcd,
+	0x5d, 0x5f, 0x17, 0x9b, 0xe0, 0x92, 0xcc, 0x6b, 0x61, 0xf5, 0x4d, 0xea, 0x2b, 0x87, 0x27, 0x10,
+	0xde, 0x49, 0x93, 0xfb, 0xd0, 0xbe, 0x62, 0x37, 0x7e, 0x19, 0xcd, 0x27, 0xf9, 0x00, 0x76, 0x91,
+	0x8a, 0xda, 0x83, 0xd4, 0x05, 0x5f, 0xb4, 0x8e, 0x83, 0xf8, 0x7b, 0x20, 0xeb, 0x03, 0xf0, 0x69,
+	0x3b, 0x85, 0x7e, 0xed, 0xc2, 0xdb, 0xf7, 0x2d, 0x1c, 0x3f, 0xf9, 0x77, 0x69, 0x69, 0x58, 0xdf,
+	0xb6, 0x88, 0xff, 0x08, 0xe0, 0xe0, 0x9c, 0x99, 0x42, 0xf3, 0x19, 0xdb, 0xe0, 0xf9, 0x83, 0xb5,
+	0x85, 0xed, 0x31, 0xec, 0x2f, 0x6d, 0x77, 0x42, 0x5a, 0xf8, 0xd0, 0x86, 0xf4, 0xce, 0x33, 0x7c,
+	0x02, 0x60, 0x6c, 0xae, 0xb7, 0x76, 0xbf, 0x87, 0x6c, 0xf4, 0xfe, 0x05, 0xde, 0x39, 0x57, 0xb8,
+	0xf3, 0x9f, 0x85, 0x5d, 0x26, 0x68, 0x13, 0xbd, 0x3a, 0xfe, 0xe1, 0xa5, 0x54, 0x4c, 0x28, 0x6e,
+	0x35, 0xff, 0x39, 0xe1, 0x72, 0x74, 0x1b, 0x8d, 0xd4, 0x55, 0x39, 0x52, 0xb3, 0xd1, 0xfa, 0x1f,
+	0xcc, 0x97, 0x6a, 0x86, 0xbf, 0xb3, 0x0e, 0xb6, 0x7d, 0xfe, 0xd7, 0x00, 0x7c, 0xbe, 0x07, 0x2c,
+	0xee, 0x06, 0x00, 0x00,
 }
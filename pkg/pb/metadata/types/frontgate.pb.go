@@ -163,20 +163,21 @@ func (m *FrontgateIdList) GetIdList() []string {
 }
 
 type FrontgateConfig struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	NodeId               string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
-	Host                 string               `protobuf:"bytes,3,opt,name=host,proto3" json:"host"`
-	ListenPort           int32                `protobuf:"varint,4,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
-	PilotHost            string               `protobuf:"bytes,5,opt,name=pilot_host,json=pilotHost,proto3" json:"pilot_host"`
-	PilotPort            int32                `protobuf:"varint,6,opt,name=pilot_port,json=pilotPort,proto3" json:"pilot_port"`
-	NodeList             []*FrontgateEndpoint `protobuf:"bytes,7,rep,name=node_list,json=nodeList,proto3" json:"node_list"`
-	EtcdConfig           *EtcdConfig          `protobuf:"bytes,8,opt,name=etcd_config,json=etcdConfig,proto3" json:"etcd_config"`
-	ConfdConfig          *ConfdConfig         `protobuf:"bytes,9,opt,name=confd_config,json=confdConfig,proto3" json:"confd_config"`
-	LogLevel             string               `protobuf:"bytes,10,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
-	AutoUpdate           bool                 `protobuf:"varint,11,opt,name=auto_update,json=autoUpdate,proto3" json:"auto_update"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	NodeId               string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
+	Host                 string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host"`
+	ListenPort           int32                  `protobuf:"varint,4,opt,name=listen_port,json=listenPort,proto3" json:"listen_port"`
+	PilotHost            string                 `protobuf:"bytes,5,opt,name=pilot_host,json=pilotHost,proto3" json:"pilot_host"`
+	PilotPort            int32                  `protobuf:"varint,6,opt,name=pilot_port,json=pilotPort,proto3" json:"pilot_port"`
+	NodeList             []*FrontgateEndpoint   `protobuf:"bytes,7,rep,name=node_list,json=nodeList,proto3" json:"node_list"`
+	EtcdConfig           *EtcdConfig            `protobuf:"bytes,8,opt,name=etcd_config,json=etcdConfig,proto3" json:"etcd_config"`
+	ConfdConfig          *ConfdConfig           `protobuf:"bytes,9,opt,name=confd_config,json=confdConfig,proto3" json:"confd_config"`
+	LogLevel             string                 `protobuf:"bytes,10,opt,name=log_level,json=logLevel,proto3" json:"log_level"`
+	AutoUpdate           bool                   `protobuf:"varint,11,opt,name=auto_update,json=autoUpdate,proto3" json:"auto_update"`
+	UpdateConfig         *FrontgateUpdateConfig `protobuf:"bytes,12,opt,name=update_config,json=updateConfig,proto3" json:"update_config"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *FrontgateConfig) Reset()         { *m = FrontgateConfig{} }
@@ -281,6 +282,82 @@ func (m *FrontgateConfig) GetAutoUpdate() bool {
 	return false
 }
 
+func (m *FrontgateConfig) GetUpdateConfig() *FrontgateUpdateConfig {
+	if m != nil {
+		return m.UpdateConfig
+	}
+	return nil
+}
+
+// how the frontgate updates itself and distributes the drones when auto_update is on
+type FrontgateUpdateConfig struct {
+	// url pattern of the release dir, %s is replaced with the version,
+	// download from the github releases if not set
+	ReleaseUrl string `protobuf:"bytes,1,opt,name=release_url,json=releaseUrl,proto3" json:"release_url"`
+	// PEM encoded public key to verify the signature of the release manifest
+	ReleasePublicKey string `protobuf:"bytes,2,opt,name=release_public_key,json=releasePublicKey,proto3" json:"release_public_key"`
+	// number of drones updated first, the rest are updated only if all of them are healthy
+	DroneCanaryCount int32 `protobuf:"varint,3,opt,name=drone_canary_count,json=droneCanaryCount,proto3" json:"drone_canary_count"`
+	// number of drones updated at the same time after the canary drones
+	DroneBatchSize       int32    `protobuf:"varint,4,opt,name=drone_batch_size,json=droneBatchSize,proto3" json:"drone_batch_size"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrontgateUpdateConfig) Reset()         { *m = FrontgateUpdateConfig{} }
+func (m *FrontgateUpdateConfig) String() string { return proto.CompactTextString(m) }
+func (*FrontgateUpdateConfig) ProtoMessage()    {}
+func (*FrontgateUpdateConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9745815aa661058, []int{4}
+}
+
+func (m *FrontgateUpdateConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrontgateUpdateConfig.Unmarshal(m, b)
+}
+func (m *FrontgateUpdateConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrontgateUpdateConfig.Marshal(b, m, deterministic)
+}
+func (m *FrontgateUpdateConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrontgateUpdateConfig.Merge(m, src)
+}
+func (m *FrontgateUpdateConfig) XXX_Size() int {
+	return xxx_messageInfo_FrontgateUpdateConfig.Size(m)
+}
+func (m *FrontgateUpdateConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrontgateUpdateConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrontgateUpdateConfig proto.InternalMessageInfo
+
+func (m *FrontgateUpdateConfig) GetReleaseUrl() string {
+	if m != nil {
+		return m.ReleaseUrl
+	}
+	return ""
+}
+
+func (m *FrontgateUpdateConfig) GetReleasePublicKey() string {
+	if m != nil {
+		return m.ReleasePublicKey
+	}
+	return ""
+}
+
+func (m *FrontgateUpdateConfig) GetDroneCanaryCount() int32 {
+	if m != nil {
+		return m.DroneCanaryCount
+	}
+	return 0
+}
+
+func (m *FrontgateUpdateConfig) GetDroneBatchSize() int32 {
+	if m != nil {
+		return m.DroneBatchSize
+	}
+	return 0
+}
+
 type FrontgateEndpoint struct {
 	FrontgateId          string   `protobuf:"bytes,1,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
 	FrontgateNodeId      string   `protobuf:"bytes,2,opt,name=frontgate_node_id,json=frontgateNodeId,proto3" json:"frontgate_node_id"`
@@ -295,7 +372,7 @@ func (m *FrontgateEndpoint) Reset()         { *m = FrontgateEndpoint{} }
 func (m *FrontgateEndpoint) String() string { return proto.CompactTextString(m) }
 func (*FrontgateEndpoint) ProtoMessage()    {}
 func (*FrontgateEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a9745815aa661058, []int{4}
+	return fileDescriptor_a9745815aa661058, []int{5}
 }
 
 func (m *FrontgateEndpoint) XXX_Unmarshal(b []byte) error {
@@ -357,7 +434,7 @@ func (m *RunCommandOnFrontgateRequest) Reset()         { *m = RunCommandOnFrontg
 func (m *RunCommandOnFrontgateRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCommandOnFrontgateRequest) ProtoMessage()    {}
 func (*RunCommandOnFrontgateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a9745815aa661058, []int{5}
+	return fileDescriptor_a9745815aa661058, []int{6}
 }
 
 func (m *RunCommandOnFrontgateRequest) XXX_Unmarshal(b []byte) error {
@@ -404,6 +481,7 @@ func init() {
 	proto.RegisterType((*FrontgateNodeId)(nil), "metadata.types.FrontgateNodeId")
 	proto.RegisterType((*FrontgateIdList)(nil), "metadata.types.FrontgateIdList")
 	proto.RegisterType((*FrontgateConfig)(nil), "metadata.types.FrontgateConfig")
+	proto.RegisterType((*FrontgateUpdateConfig)(nil), "metadata.types.FrontgateUpdateConfig")
 	proto.RegisterType((*FrontgateEndpoint)(nil), "metadata.types.FrontgateEndpoint")
 	proto.RegisterType((*RunCommandOnFrontgateRequest)(nil), "metadata.types.RunCommandOnFrontgateRequest")
 }
@@ -411,39 +489,46 @@ func init() {
 func init() { proto.RegisterFile("metadata/types/frontgate.proto", fileDescriptor_a9745815aa661058) }
 
 var fileDescriptor_a9745815aa661058 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6f, 0xd3, 0x4e,
-	0x10, 0x96, 0x9b, 0xe6, 0x35, 0xae, 0x12, 0x75, 0x2f, 0x3f, 0xff, 0x52, 0x1e, 0x6e, 0x2e, 0x44,
-	0x3d, 0xc4, 0x52, 0x91, 0x00, 0xa9, 0xa2, 0x07, 0xaa, 0x22, 0x2a, 0x55, 0x80, 0x0c, 0x5c, 0xb8,
-	0x58, 0x8e, 0x77, 0x13, 0x56, 0x38, 0x3b, 0x4b, 0x3c, 0x41, 0xf4, 0x3f, 0xe1, 0xc8, 0x91, 0x3f,
-	0x13, 0xed, 0xf8, 0x91, 0x07, 0x1c, 0x7a, 0xb2, 0x67, 0xbe, 0xf9, 0xbe, 0x9d, 0xd9, 0x6f, 0x16,
-	0x1e, 0x2d, 0x15, 0xa5, 0x32, 0xa5, 0x34, 0xa2, 0x3b, 0xab, 0x8a, 0x68, 0xbe, 0x42, 0x43, 0x8b,
-	0x94, 0xd4, 0xd4, 0xae, 0x90, 0x50, 0x0c, 0x6a, 0x7c, 0xca, 0xf8, 0xe8, 0xff, 0xbd, 0x7a, 0x45,
-	0x99, 0x2c, 0x4b, 0x47, 0xa3, 0x3d, 0x28, 0x43, 0x33, 0xaf, 0xb0, 0xf1, 0x73, 0xf0, 0x5f, 0xd7,
-	0xca, 0x37, 0x52, 0x0c, 0xe0, 0x40, 0xcb, 0xc0, 0x0b, 0xbd, 0x49, 0x3f, 0x3e, 0xd0, 0x52, 0x04,
-	0xd0, 0xb5, 0xe9, 0x5d, 0x8e, 0xa9, 0x0c, 0x0e, 0x38, 0x59, 0x87, 0xe3, 0x8f, 0x30, 0x6c, 0x88,
-	0x6f, 0x51, 0xfe, 0x8b, 0xfc, 0x1f, 0x74, 0x0d, 0x4a, 0x95, 0xe8, 0x9a, 0xdc, 0x31, 0x65, 0xe1,
-	0x96, 0x6a, 0x6b, 0x57, 0xf5, 0x6c, 0x4b, 0xf5, 0x46, 0xde, 0xea, 0x82, 0x9c, 0x8a, 0x96, 0x49,
-	0xae, 0x0b, 0x0a, 0xbc, 0xb0, 0xe5, 0x54, 0x34, 0x03, 0xe3, 0xdf, 0xad, 0xad, 0xe2, 0x2b, 0x34,
-	0x73, 0xbd, 0xb8, 0x7f, 0x0b, 0x02, 0x0e, 0xbf, 0x60, 0x41, 0xd5, 0xf9, 0xfc, 0x2f, 0x1e, 0x83,
-	0xef, 0x8e, 0x51, 0x26, 0xb1, 0xb8, 0xa2, 0xe0, 0x30, 0xf4, 0x26, 0xed, 0x18, 0xca, 0xd4, 0x7b,
-	0x5c, 0x91, 0x78, 0x08, 0x60, 0x75, 0x8e, 0x94, 0x30, 0xb5, 0xcd, 0xd4, 0x3e, 0x67, 0xde, 0x38,
-	0x7e, 0x03, 0x33, 0xbd, 0xc3, 0xf4, 0x12, 0x66, 0xf6, 0x25, 0xf4, 0xb9, 0x17, 0x1e, 0xa5, 0x1b,
-	0xb6, 0x26, 0xfe, 0xf9, 0xe9, 0x74, 0xd7, 0xc5, 0x69, 0x33, 0xcf, 0xb5, 0x91, 0x16, 0xb5, 0xa1,
-	0xb8, 0xe7, 0x38, 0x7c, 0x11, 0x17, 0xe0, 0x3b, 0x53, 0x93, 0x8c, 0x47, 0x0d, 0x7a, 0xa1, 0x37,
-	0xf1, 0xcf, 0x47, 0xfb, 0x0a, 0xd7, 0x94, 0xc9, 0xf2, 0x32, 0x62, 0x50, 0xcd, 0xbf, 0xb8, 0x84,
-	0x23, 0xb6, 0xbd, 0x66, 0xf7, 0x99, 0x7d, 0xb2, 0xcf, 0x76, 0xd5, 0x35, 0xdd, 0xcf, 0x36, 0x81,
-	0x38, 0x81, 0x7e, 0x8e, 0x8b, 0x24, 0x57, 0xdf, 0x55, 0x1e, 0x00, 0x4f, 0xde, 0xcb, 0x71, 0x71,
-	0xeb, 0x62, 0x77, 0x71, 0xe9, 0x9a, 0x30, 0x59, 0x5b, 0x99, 0x92, 0x0a, 0xfc, 0xd0, 0x9b, 0xf4,
-	0x62, 0x70, 0xa9, 0x4f, 0x9c, 0x19, 0xff, 0xf4, 0xe0, 0xf8, 0xaf, 0xd1, 0xc4, 0x29, 0x1c, 0x35,
-	0x5b, 0x9d, 0x34, 0xb6, 0xf9, 0xf3, 0xad, 0x7d, 0x3c, 0x83, 0xe3, 0x4d, 0xc9, 0xae, 0x93, 0xc3,
-	0xf9, 0xde, 0xfa, 0x35, 0x5e, 0xdb, 0xca, 0xd5, 0xd2, 0x6b, 0xeb, 0x7a, 0x67, 0x60, 0xcb, 0x55,
-	0xbe, 0x55, 0xe7, 0xca, 0xf8, 0x97, 0x07, 0x0f, 0xe2, 0xb5, 0xb9, 0xc2, 0xe5, 0x32, 0x35, 0xf2,
-	0x9d, 0x69, 0xda, 0x8c, 0xd5, 0xb7, 0xb5, 0x2a, 0x48, 0xbc, 0x84, 0x9e, 0xaa, 0x3a, 0xe6, 0x0e,
-	0xef, 0xe7, 0x5a, 0x4d, 0x71, 0xbb, 0x9e, 0x95, 0xda, 0xf5, 0x0b, 0xaa, 0x42, 0xf1, 0x04, 0x86,
-	0xa4, 0x97, 0x0a, 0xd7, 0x94, 0x14, 0x2a, 0x43, 0x23, 0x0b, 0xee, 0xbb, 0x1d, 0x0f, 0xaa, 0xf4,
-	0x87, 0x32, 0xfb, 0xea, 0xc5, 0xe7, 0x67, 0x68, 0x95, 0xb1, 0x9a, 0x56, 0xfa, 0xc7, 0x54, 0x63,
-	0xb4, 0x89, 0x22, 0xfb, 0x75, 0x11, 0xd9, 0x59, 0xb4, 0xfb, 0xc2, 0x2f, 0xec, 0x8c, 0xbf, 0xb3,
-	0x0e, 0x3f, 0xf2, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xe6, 0x58, 0xc6, 0x4d, 0x04,
-	0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x56, 0xd6, 0xfd, 0xb4, 0x27, 0xa5, 0xdb, 0x2c, 0x21, 0x42, 0xc7, 0x4f, 0x57, 0x09, 0x51,
+	0x4d, 0xa8, 0x95, 0x86, 0x04, 0x48, 0x13, 0xbb, 0x58, 0x35, 0xc4, 0x60, 0x82, 0x29, 0x63, 0x37,
+	0xdc, 0x58, 0x6e, 0xec, 0x76, 0xd6, 0x52, 0xdb, 0x24, 0x0e, 0xa2, 0x7b, 0x12, 0x2e, 0x79, 0x12,
+	0x9e, 0x8a, 0x07, 0x40, 0x3e, 0x49, 0xba, 0xb4, 0x0c, 0x69, 0x57, 0xc9, 0xf9, 0xbe, 0xf3, 0x1d,
+	0x1f, 0xfb, 0x7c, 0x36, 0x3c, 0x99, 0x0a, 0xcb, 0x38, 0xb3, 0x6c, 0x60, 0x67, 0x46, 0xa4, 0x83,
+	0x71, 0xa2, 0x95, 0x9d, 0x30, 0x2b, 0xfa, 0x26, 0xd1, 0x56, 0x93, 0x56, 0xc9, 0xf7, 0x91, 0x6f,
+	0x3f, 0x5c, 0xca, 0x17, 0x36, 0xe2, 0x79, 0x6a, 0xbb, 0xbd, 0x44, 0x45, 0x5a, 0x8d, 0x0b, 0xae,
+	0xfb, 0x1a, 0xfc, 0x77, 0x65, 0xe5, 0x13, 0x4e, 0x5a, 0xb0, 0x22, 0x79, 0xe0, 0x75, 0xbc, 0x5e,
+	0x23, 0x5c, 0x91, 0x9c, 0x04, 0xb0, 0x61, 0xd8, 0x2c, 0xd6, 0x8c, 0x07, 0x2b, 0x08, 0x96, 0x61,
+	0xf7, 0x0b, 0x6c, 0xce, 0x85, 0x9f, 0x34, 0xbf, 0x4d, 0xfc, 0x00, 0x36, 0x94, 0xe6, 0x82, 0xca,
+	0x52, 0xbc, 0xae, 0xf2, 0xc4, 0x4a, 0xd5, 0xda, 0x62, 0xd5, 0xbd, 0x4a, 0xd5, 0x13, 0x7e, 0x2a,
+	0x53, 0xeb, 0xaa, 0x48, 0x4e, 0x63, 0x99, 0xda, 0xc0, 0xeb, 0xd4, 0x5c, 0x15, 0x89, 0x44, 0xf7,
+	0x4f, 0xad, 0x92, 0x3c, 0xd4, 0x6a, 0x2c, 0x27, 0x77, 0x6f, 0x81, 0xc0, 0xea, 0xa5, 0x4e, 0x6d,
+	0xb1, 0x3e, 0xfe, 0x93, 0xa7, 0xe0, 0xbb, 0x65, 0x84, 0xa2, 0x46, 0x27, 0x36, 0x58, 0xed, 0x78,
+	0xbd, 0xb5, 0x10, 0x72, 0xe8, 0x4c, 0x27, 0x96, 0x3c, 0x06, 0x30, 0x32, 0xd6, 0x96, 0xa2, 0x74,
+	0x0d, 0xa5, 0x0d, 0x44, 0xde, 0xeb, 0xb4, 0x42, 0xa3, 0x7c, 0x1d, 0xe5, 0x39, 0x8d, 0xea, 0x43,
+	0x68, 0x60, 0x2f, 0xb8, 0x95, 0x8d, 0x4e, 0xad, 0xe7, 0xef, 0xef, 0xf6, 0x17, 0xa7, 0xd8, 0x9f,
+	0xef, 0xe7, 0x58, 0x71, 0xa3, 0xa5, 0xb2, 0x61, 0xdd, 0x69, 0xf0, 0x20, 0x0e, 0xc0, 0x77, 0x43,
+	0xa5, 0x11, 0x6e, 0x35, 0xa8, 0x77, 0xbc, 0x9e, 0xbf, 0xdf, 0x5e, 0xae, 0x70, 0x6c, 0x23, 0x9e,
+	0x1f, 0x46, 0x08, 0x62, 0xfe, 0x4f, 0x0e, 0xa1, 0x89, 0x63, 0x2f, 0xd5, 0x0d, 0x54, 0xef, 0x2c,
+	0xab, 0x5d, 0x76, 0x29, 0xf7, 0xa3, 0x9b, 0x80, 0xec, 0x40, 0x23, 0xd6, 0x13, 0x1a, 0x8b, 0xef,
+	0x22, 0x0e, 0x00, 0x77, 0x5e, 0x8f, 0xf5, 0xe4, 0xd4, 0xc5, 0xee, 0xe0, 0x58, 0x66, 0x35, 0xcd,
+	0x0c, 0x67, 0x56, 0x04, 0x7e, 0xc7, 0xeb, 0xd5, 0x43, 0x70, 0xd0, 0x05, 0x22, 0xe4, 0x03, 0xdc,
+	0xcb, 0xb9, 0x72, 0xf9, 0x26, 0x2e, 0xff, 0xec, 0xbf, 0xdb, 0xcf, 0x75, 0x45, 0x23, 0xcd, 0xac,
+	0x12, 0x75, 0x7f, 0x7b, 0x70, 0xff, 0xd6, 0x3c, 0xd7, 0x46, 0x22, 0x62, 0xc1, 0x52, 0x41, 0xb3,
+	0x24, 0x2e, 0x5c, 0x00, 0x05, 0x74, 0x91, 0xc4, 0xe4, 0x05, 0x90, 0x32, 0xc1, 0x64, 0xa3, 0x58,
+	0x46, 0xf4, 0x4a, 0xcc, 0x0a, 0x63, 0x6c, 0x15, 0xcc, 0x19, 0x12, 0x1f, 0xc5, 0xcc, 0x65, 0xf3,
+	0x44, 0x2b, 0x41, 0x23, 0xa6, 0x58, 0x32, 0xa3, 0x91, 0xce, 0x54, 0x6e, 0x98, 0xb5, 0x70, 0x0b,
+	0x99, 0x21, 0x12, 0x43, 0x87, 0x93, 0x1e, 0xe4, 0x18, 0x1d, 0x31, 0x1b, 0x5d, 0xd2, 0x54, 0x5e,
+	0x8b, 0xc2, 0x41, 0x2d, 0xc4, 0x8f, 0x1c, 0x7c, 0x2e, 0xaf, 0x45, 0xf7, 0xa7, 0x07, 0xdb, 0xff,
+	0xcc, 0x99, 0xec, 0x42, 0x73, 0x7e, 0xc5, 0xe9, 0xdc, 0xc3, 0xfe, 0xb8, 0x72, 0x39, 0xf7, 0x60,
+	0xfb, 0x26, 0x65, 0xd1, 0xd6, 0x9b, 0xe3, 0xa5, 0xbb, 0x38, 0x37, 0xbe, 0x09, 0x6a, 0x15, 0xe3,
+	0x1b, 0x37, 0x48, 0x24, 0x2a, 0x16, 0x47, 0x8b, 0x39, 0x8b, 0x76, 0x7f, 0x79, 0xf0, 0x28, 0xcc,
+	0xd4, 0x50, 0x4f, 0xa7, 0x4c, 0xf1, 0xcf, 0x6a, 0xde, 0x66, 0x28, 0xbe, 0x65, 0x22, 0xb5, 0xe4,
+	0x2d, 0xd4, 0x45, 0xd1, 0x31, 0x76, 0x78, 0x37, 0x0b, 0x97, 0x12, 0x77, 0xf1, 0xa3, 0xbc, 0x76,
+	0xf9, 0x9c, 0x14, 0x21, 0x79, 0x0e, 0x9b, 0x56, 0x4e, 0x85, 0xce, 0x2c, 0x4d, 0x45, 0xa4, 0x15,
+	0x4f, 0x8b, 0x93, 0x6e, 0x15, 0xf0, 0x79, 0x8e, 0x1e, 0xbd, 0xf9, 0xfa, 0x4a, 0x1b, 0xa1, 0x8c,
+	0xb4, 0x89, 0xfc, 0xd1, 0x97, 0x7a, 0x70, 0x13, 0x0d, 0xcc, 0xd5, 0x64, 0x60, 0x46, 0x83, 0xc5,
+	0xe7, 0xee, 0xc0, 0x8c, 0xf0, 0x3b, 0x5a, 0xc7, 0x17, 0xef, 0xe5, 0xdf, 0x01, 0x00, 0xd0, 0xaf,
+	0x1d, 0x8e, 0x5a, 0x05, 0x00, 0x00,
 }
@@ -34,19 +34,21 @@ const (
 	SubTaskAction_StopConfd                 SubTaskAction = 7
 	SubTaskAction_RegisterMetadataMapping   SubTaskAction = 8
 	SubTaskAction_DeregisterMetadataMapping SubTaskAction = 9
+	SubTaskAction_AbortCmd                  SubTaskAction = 10
 )
 
 var SubTaskAction_name = map[int32]string{
-	0: "NULL",
-	1: "StartConfd",
-	2: "RegisterMetadata",
-	3: "DeregisterMetadata",
-	4: "RegisterCmd",
-	5: "DeregisterCmd",
-	6: "GetTaskStatus",
-	7: "StopConfd",
-	8: "RegisterMetadataMapping",
-	9: "DeregisterMetadataMapping",
+	0:  "NULL",
+	1:  "StartConfd",
+	2:  "RegisterMetadata",
+	3:  "DeregisterMetadata",
+	4:  "RegisterCmd",
+	5:  "DeregisterCmd",
+	6:  "GetTaskStatus",
+	7:  "StopConfd",
+	8:  "RegisterMetadataMapping",
+	9:  "DeregisterMetadataMapping",
+	10: "AbortCmd",
 }
 
 var SubTaskAction_value = map[string]int32{
@@ -60,6 +62,7 @@ var SubTaskAction_value = map[string]int32{
 	"StopConfd":                 7,
 	"RegisterMetadataMapping":   8,
 	"DeregisterMetadataMapping": 9,
+	"AbortCmd":                  10,
 }
 
 func (x SubTaskAction) String() string {
@@ -729,6 +732,75 @@ func (m *SubTask_DeregisterCmd) GetRetry() int32 {
 	return 0
 }
 
+//
+//{
+//"action": "AbortCmd",
+//"taskId": "t-abcdefgh",
+//"directive": {"frontgateId": "cl-abcdefgh", "droneIp": "192.168.0.1"}
+//}
+type SubTask_AbortCmd struct {
+	Action               string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action"`
+	TaskId               string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id"`
+	FrontgateId          string   `protobuf:"bytes,3,opt,name=frontgate_id,json=frontgateId,proto3" json:"frontgate_id"`
+	DroneIp              string   `protobuf:"bytes,4,opt,name=drone_ip,json=droneIp,proto3" json:"drone_ip"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubTask_AbortCmd) Reset()         { *m = SubTask_AbortCmd{} }
+func (m *SubTask_AbortCmd) String() string { return proto.CompactTextString(m) }
+func (*SubTask_AbortCmd) ProtoMessage()    {}
+func (*SubTask_AbortCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a76b9d476194b0, []int{9}
+}
+
+func (m *SubTask_AbortCmd) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubTask_AbortCmd.Unmarshal(m, b)
+}
+func (m *SubTask_AbortCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubTask_AbortCmd.Marshal(b, m, deterministic)
+}
+func (m *SubTask_AbortCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTask_AbortCmd.Merge(m, src)
+}
+func (m *SubTask_AbortCmd) XXX_Size() int {
+	return xxx_messageInfo_SubTask_AbortCmd.Size(m)
+}
+func (m *SubTask_AbortCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTask_AbortCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubTask_AbortCmd proto.InternalMessageInfo
+
+func (m *SubTask_AbortCmd) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *SubTask_AbortCmd) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *SubTask_AbortCmd) GetFrontgateId() string {
+	if m != nil {
+		return m.FrontgateId
+	}
+	return ""
+}
+
+func (m *SubTask_AbortCmd) GetDroneIp() string {
+	if m != nil {
+		return m.DroneIp
+	}
+	return ""
+}
+
 //
 //{
 //"action": "GetTaskStatus",
@@ -746,7 +818,7 @@ func (m *SubTask_GetTaskStatus) Reset()         { *m = SubTask_GetTaskStatus{} }
 func (m *SubTask_GetTaskStatus) String() string { return proto.CompactTextString(m) }
 func (*SubTask_GetTaskStatus) ProtoMessage()    {}
 func (*SubTask_GetTaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a76b9d476194b0, []int{9}
+	return fileDescriptor_48a76b9d476194b0, []int{10}
 }
 
 func (m *SubTask_GetTaskStatus) XXX_Unmarshal(b []byte) error {
@@ -792,42 +864,44 @@ func init() {
 	proto.RegisterType((*SubTask_DeregisterMetadata)(nil), "metadata.types.SubTask_DeregisterMetadata")
 	proto.RegisterType((*SubTask_RegisterCmd)(nil), "metadata.types.SubTask_RegisterCmd")
 	proto.RegisterType((*SubTask_DeregisterCmd)(nil), "metadata.types.SubTask_DeregisterCmd")
+	proto.RegisterType((*SubTask_AbortCmd)(nil), "metadata.types.SubTask_AbortCmd")
 	proto.RegisterType((*SubTask_GetTaskStatus)(nil), "metadata.types.SubTask_GetTaskStatus")
 }
 
 func init() { proto.RegisterFile("metadata/types/task.proto", fileDescriptor_48a76b9d476194b0) }
 
 var fileDescriptor_48a76b9d476194b0 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
+	// 507 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x66, 0xdb, 0xd8, 0x8e, 0xa7, 0x24, 0x6c, 0x97, 0x92, 0x3a, 0xfc, 0x48, 0xc5, 0xe2, 0x50,
-	0x71, 0x88, 0x0f, 0x48, 0x08, 0x89, 0x0b, 0x50, 0x24, 0x88, 0xd4, 0x70, 0x48, 0xe0, 0xc2, 0x25,
-	0xda, 0xc4, 0x5b, 0x6b, 0x15, 0xc5, 0xbb, 0x5a, 0x4f, 0x10, 0x7d, 0x17, 0x78, 0x0d, 0x9e, 0x02,
-	0x71, 0xe1, 0xc4, 0xdb, 0xa0, 0xac, 0xed, 0xd8, 0x49, 0x14, 0xa4, 0x1e, 0x40, 0xf4, 0x64, 0x7d,
-	0xf3, 0x8d, 0x66, 0xbe, 0x6f, 0x77, 0x3c, 0x0b, 0xdd, 0xb9, 0x40, 0x1e, 0x73, 0xe4, 0x11, 0x5e,
-	0x6a, 0x91, 0x45, 0xc8, 0xb3, 0x59, 0x4f, 0x1b, 0x85, 0x8a, 0xb5, 0x4b, 0xaa, 0x67, 0xa9, 0xf0,
-	0x11, 0xf8, 0xa3, 0xc5, 0xe4, 0x3d, 0xcf, 0x66, 0xfd, 0x98, 0x1d, 0x83, 0xb7, 0x4c, 0x1d, 0xcb,
-	0x38, 0x20, 0x27, 0xe4, 0xd4, 0x1f, 0xba, 0x68, 0x89, 0x70, 0x0c, 0xed, 0x22, 0x6b, 0x20, 0xb2,
-	0x8c, 0x27, 0x82, 0x75, 0xc0, 0xe5, 0x53, 0x94, 0x2a, 0x2d, 0x33, 0x73, 0x54, 0x2f, 0xb1, 0x57,
-	0x2f, 0xc1, 0xee, 0x83, 0x1f, 0x4b, 0x23, 0xa6, 0x28, 0x3f, 0x89, 0x60, 0xdf, 0x52, 0x55, 0x20,
-	0x7c, 0x01, 0xad, 0xa2, 0xc1, 0x08, 0x39, 0x2e, 0xb2, 0x9d, 0x52, 0x96, 0x8d, 0x33, 0x9b, 0x52,
-	0xd6, 0xcf, 0x51, 0xf8, 0x95, 0x00, 0x2b, 0x4a, 0x8c, 0x47, 0xc8, 0x0d, 0x9e, 0xa9, 0xf4, 0x22,
-	0xbe, 0xba, 0xce, 0x87, 0x70, 0xf3, 0xc2, 0xa8, 0x14, 0x13, 0x8e, 0x62, 0xc9, 0xe6, 0x52, 0x0f,
-	0x56, 0xb1, 0x7e, 0xcc, 0xba, 0xd0, 0x8c, 0x8d, 0x4a, 0xc5, 0x58, 0xea, 0xa0, 0x61, 0x69, 0xcf,
-	0xe2, 0xbe, 0x66, 0x01, 0x78, 0x28, 0xe7, 0x42, 0x2d, 0x30, 0x70, 0x4e, 0xc8, 0xa9, 0x33, 0x2c,
-	0x61, 0xf8, 0x85, 0xc0, 0x61, 0xa5, 0x4f, 0xe9, 0xff, 0x4c, 0xde, 0x37, 0x02, 0x41, 0x29, 0x6f,
-	0x28, 0x12, 0x99, 0xa1, 0x30, 0x83, 0x62, 0x54, 0xfe, 0x8a, 0xca, 0x0e, 0xb8, 0xd3, 0x54, 0xc5,
-	0x22, 0x2b, 0x34, 0x16, 0x68, 0xb7, 0x44, 0x76, 0x04, 0x8e, 0x11, 0x68, 0x2e, 0x03, 0xd7, 0xc6,
-	0x73, 0x10, 0xfe, 0x24, 0x70, 0xb7, 0x14, 0xfe, 0x5a, 0x98, 0x7f, 0x21, 0xfd, 0x0f, 0x07, 0x5c,
-	0xb9, 0x72, 0x76, 0xb9, 0x72, 0x77, 0xb8, 0xf2, 0xea, 0xae, 0xbe, 0x13, 0xb8, 0xbd, 0x79, 0x1d,
-	0x67, 0xf3, 0xf8, 0xba, 0xda, 0xf9, 0x41, 0xe0, 0xce, 0xf6, 0x25, 0x5d, 0x63, 0x43, 0x6f, 0x2b,
-	0x3f, 0x6f, 0x04, 0xd6, 0xf6, 0xd6, 0x55, 0xfd, 0x3c, 0xfe, 0x45, 0x56, 0xab, 0xef, 0x65, 0x9e,
-	0xda, 0x84, 0xc6, 0xbb, 0x0f, 0xe7, 0xe7, 0xf4, 0x06, 0x6b, 0x03, 0x54, 0xab, 0x8c, 0x12, 0x76,
-	0x04, 0x74, 0xf3, 0xdf, 0xa4, 0x7b, 0xac, 0x03, 0x6c, 0x7b, 0xf0, 0xe9, 0x3e, 0xbb, 0x05, 0x07,
-	0xb5, 0xd1, 0xa1, 0x0d, 0x76, 0x08, 0xad, 0xb5, 0xc3, 0xa7, 0xce, 0x32, 0xb4, 0xa6, 0x9f, 0xba,
-	0xac, 0x05, 0xfe, 0x6a, 0x3f, 0x51, 0x8f, 0xdd, 0x83, 0xe3, 0xcd, 0x9e, 0x03, 0xae, 0xb5, 0x4c,
-	0x13, 0xda, 0x64, 0x0f, 0xa0, 0xbb, 0xdd, 0xba, 0xa4, 0xfd, 0x57, 0xcf, 0x3e, 0x3e, 0x55, 0x5a,
-	0xa4, 0x5a, 0xa2, 0x91, 0x9f, 0x7b, 0x52, 0x45, 0x15, 0x8a, 0xf4, 0x2c, 0x89, 0xf4, 0x24, 0x5a,
-	0x7f, 0xa9, 0x9e, 0xeb, 0x89, 0xfd, 0x4e, 0x5c, 0xfb, 0x5a, 0x3d, 0xf9, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x66, 0xfc, 0x71, 0x49, 0xca, 0x06, 0x00, 0x00,
+	0x10, 0x66, 0xdb, 0xd8, 0x8e, 0xa7, 0x4d, 0xd8, 0x2e, 0x25, 0x75, 0xf8, 0x91, 0x8a, 0xc5, 0xa1,
+	0xe2, 0x10, 0x1f, 0x90, 0x10, 0x12, 0x17, 0x4a, 0x91, 0x20, 0x52, 0xc3, 0x21, 0x81, 0x0b, 0x97,
+	0x68, 0x1d, 0x6f, 0xad, 0x55, 0x14, 0xef, 0x6a, 0x3d, 0x41, 0xf4, 0xc8, 0x7b, 0xc0, 0x6b, 0xf0,
+	0x14, 0x88, 0x0b, 0x0f, 0xc1, 0x6b, 0xa0, 0xf8, 0x27, 0x76, 0x12, 0x05, 0xa9, 0x07, 0x2a, 0x7a,
+	0xb2, 0xbe, 0xf9, 0x46, 0x33, 0xdf, 0xb7, 0x3b, 0x9e, 0x85, 0xee, 0x4c, 0x20, 0x8f, 0x38, 0xf2,
+	0x00, 0x2f, 0xb5, 0x48, 0x03, 0xe4, 0xe9, 0xb4, 0xa7, 0x8d, 0x42, 0xc5, 0xda, 0x25, 0xd5, 0xcb,
+	0x28, 0xff, 0x31, 0xb8, 0xa3, 0x79, 0xf8, 0x9e, 0xa7, 0xd3, 0x7e, 0xc4, 0x8e, 0xc0, 0x59, 0xa4,
+	0x8e, 0x65, 0xe4, 0x91, 0x63, 0x72, 0xe2, 0x0e, 0x6d, 0xcc, 0x08, 0x7f, 0x0c, 0xed, 0x22, 0x6b,
+	0x20, 0xd2, 0x94, 0xc7, 0x82, 0x75, 0xc0, 0xe6, 0x13, 0x94, 0x2a, 0x29, 0x33, 0x73, 0x54, 0x2f,
+	0xb1, 0x53, 0x2f, 0xc1, 0x1e, 0x80, 0x1b, 0x49, 0x23, 0x26, 0x28, 0x3f, 0x09, 0x6f, 0x37, 0xa3,
+	0xaa, 0x80, 0xff, 0x12, 0x5a, 0x45, 0x83, 0x11, 0x72, 0x9c, 0xa7, 0x5b, 0xa5, 0x2c, 0x1a, 0xa7,
+	0x59, 0x4a, 0x59, 0x3f, 0x47, 0xfe, 0x37, 0x02, 0xac, 0x28, 0x31, 0x1e, 0x21, 0x37, 0x78, 0xa6,
+	0x92, 0x8b, 0xe8, 0xea, 0x3a, 0x1f, 0xc1, 0xfe, 0x85, 0x51, 0x09, 0xc6, 0x1c, 0xc5, 0x82, 0xcd,
+	0xa5, 0xee, 0x2d, 0x63, 0xfd, 0x88, 0x75, 0xa1, 0x19, 0x19, 0x95, 0x88, 0xb1, 0xd4, 0x5e, 0x23,
+	0xa3, 0x9d, 0x0c, 0xf7, 0x35, 0xf3, 0xc0, 0x41, 0x39, 0x13, 0x6a, 0x8e, 0x9e, 0x75, 0x4c, 0x4e,
+	0xac, 0x61, 0x09, 0xfd, 0xaf, 0x04, 0x0e, 0x2a, 0x7d, 0x4a, 0xff, 0x67, 0xf2, 0xbe, 0x13, 0xf0,
+	0x4a, 0x79, 0x43, 0x11, 0xcb, 0x14, 0x85, 0x19, 0x14, 0xa3, 0xf2, 0x4f, 0x54, 0x76, 0xc0, 0x9e,
+	0x24, 0x2a, 0x12, 0x69, 0xa1, 0xb1, 0x40, 0xdb, 0x25, 0xb2, 0x43, 0xb0, 0x8c, 0x40, 0x73, 0xe9,
+	0xd9, 0x59, 0x3c, 0x07, 0xfe, 0x2f, 0x02, 0xf7, 0x4a, 0xe1, 0xaf, 0x85, 0xb9, 0x0e, 0xe9, 0x7f,
+	0x39, 0xe0, 0xca, 0x95, 0xb5, 0xcd, 0x95, 0xbd, 0xc5, 0x95, 0x53, 0x77, 0xf5, 0x83, 0xc0, 0x9d,
+	0xf5, 0xeb, 0x38, 0x9b, 0x45, 0x37, 0xd5, 0xce, 0x4f, 0x02, 0x77, 0x37, 0x2f, 0xe9, 0x06, 0x1b,
+	0xfa, 0x42, 0x80, 0x96, 0x86, 0x4e, 0x43, 0x65, 0xf0, 0xfa, 0xbd, 0xf8, 0x6f, 0xab, 0x33, 0x7d,
+	0x23, 0xb0, 0xb6, 0x3b, 0xaf, 0xaa, 0xe3, 0xc9, 0x6f, 0xb2, 0x5c, 0xbf, 0xa7, 0x79, 0x6a, 0x13,
+	0x1a, 0xef, 0x3e, 0x9c, 0x9f, 0xd3, 0x5b, 0xac, 0x0d, 0x50, 0xad, 0x53, 0x4a, 0xd8, 0x21, 0xd0,
+	0xf5, 0xfd, 0x40, 0x77, 0x58, 0x07, 0xd8, 0xe6, 0xcf, 0x47, 0x77, 0xd9, 0x6d, 0xd8, 0xab, 0x8d,
+	0x2f, 0x6d, 0xb0, 0x03, 0x68, 0xad, 0x0c, 0x00, 0xb5, 0x16, 0xa1, 0x15, 0xfd, 0xd4, 0x66, 0x2d,
+	0x70, 0x97, 0x3b, 0x92, 0x3a, 0xec, 0x3e, 0x1c, 0xad, 0xf7, 0x1c, 0x70, 0xad, 0x65, 0x12, 0xd3,
+	0x26, 0x7b, 0x08, 0xdd, 0xcd, 0xd6, 0x25, 0xed, 0xb2, 0x7d, 0x68, 0x96, 0x17, 0x44, 0xe1, 0xd5,
+	0xf3, 0x8f, 0xcf, 0x94, 0x16, 0x89, 0x96, 0x68, 0xe4, 0xe7, 0x9e, 0x54, 0x41, 0x85, 0x02, 0x3d,
+	0x8d, 0x03, 0x1d, 0x06, 0xab, 0x6f, 0xe7, 0x0b, 0x1d, 0x66, 0xdf, 0xd0, 0xce, 0xde, 0xcf, 0xa7,
+	0x7f, 0x06, 0x00, 0x22, 0x53, 0xac, 0x48, 0x5c, 0x07, 0x00, 0x00,
 }
@@ -233,10 +233,12 @@ type DescribeRepoEventsRequest struct {
 	// owner
 	Owner []string `protobuf:"bytes,13,rep,name=owner,proto3" json:"owner,omitempty"`
 	// repository event status eg.[failed|successful|working|pending]
-	Status               []string `protobuf:"bytes,14,rep,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Status []string `protobuf:"bytes,14,rep,name=status,proto3" json:"status,omitempty"`
+	// page token of the next page returned by previous page, the offset is ignored if it is set
+	PageToken            *wrappers.StringValue `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeRepoEventsRequest) Reset()         { *m = DescribeRepoEventsRequest{} }
@@ -306,14 +308,23 @@ func (m *DescribeRepoEventsRequest) GetStatus() []string {
 	return nil
 }
 
+func (m *DescribeRepoEventsRequest) GetPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
 type DescribeRepoEventsResponse struct {
 	// total count of repository event
 	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// list of repository event
-	RepoEventSet         []*RepoEvent `protobuf:"bytes,2,rep,name=repo_event_set,json=repoEventSet,proto3" json:"repo_event_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	RepoEventSet []*RepoEvent `protobuf:"bytes,2,rep,name=repo_event_set,json=repoEventSet,proto3" json:"repo_event_set,omitempty"`
+	// page token of the next page, empty if there are no more repository events
+	NextPageToken        *wrappers.StringValue `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeRepoEventsResponse) Reset()         { *m = DescribeRepoEventsResponse{} }
@@ -355,6 +366,13 @@ func (m *DescribeRepoEventsResponse) GetRepoEventSet() []*RepoEvent {
 	return nil
 }
 
+func (m *DescribeRepoEventsResponse) GetNextPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IndexRepoRequest)(nil), "openpitrix.IndexRepoRequest")
 	proto.RegisterType((*IndexRepoResponse)(nil), "openpitrix.IndexRepoResponse")
@@ -366,45 +384,52 @@ func init() {
 func init() { proto.RegisterFile("repo_indexer.proto", fileDescriptor_e135b06a8245a758) }
 
 var fileDescriptor_e135b06a8245a758 = []byte{
-	// 607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x95, 0x93, 0x36, 0xfd, 0x72, 0xd3, 0xf4, 0xa3, 0xa3, 0x96, 0x1a, 0x2b, 0xb4, 0x96, 0x25,
-	0x50, 0x85, 0xa8, 0xad, 0x96, 0xb2, 0x21, 0x1b, 0xca, 0x8f, 0x50, 0x76, 0xc8, 0x45, 0x2c, 0xd8,
-	0x44, 0x93, 0xe4, 0xd6, 0xb5, 0x94, 0x7a, 0x86, 0x99, 0x71, 0x5b, 0x58, 0x21, 0x24, 0x5e, 0xa0,
-	0x48, 0x3c, 0x06, 0x2b, 0xde, 0x84, 0x25, 0x5b, 0x1e, 0x04, 0xcd, 0x8c, 0xed, 0x86, 0x86, 0xa8,
-	0x81, 0x95, 0x3d, 0x73, 0xcf, 0xb9, 0xf7, 0xdc, 0xbf, 0x01, 0x22, 0x90, 0xb3, 0x7e, 0x9a, 0x8d,
-	0xf0, 0x1c, 0x45, 0xc8, 0x05, 0x53, 0x8c, 0x00, 0xe3, 0x98, 0xf1, 0x54, 0x89, 0xf4, 0xdc, 0xdb,
-	0x4c, 0x18, 0x4b, 0xc6, 0x18, 0x19, 0xcb, 0x20, 0x3f, 0x8a, 0xce, 0x04, 0xe5, 0x1c, 0x85, 0xb4,
-	0x58, 0x6f, 0xeb, 0xaa, 0x5d, 0xa5, 0x27, 0x28, 0x15, 0x3d, 0xe1, 0x05, 0xa0, 0x53, 0x00, 0x28,
-	0x4f, 0x23, 0x9a, 0x65, 0x4c, 0x51, 0x95, 0xb2, 0xac, 0xa4, 0xdf, 0x37, 0x9f, 0xe1, 0x4e, 0x82,
-	0xd9, 0x8e, 0x3c, 0xa3, 0x49, 0x82, 0x22, 0x62, 0xdc, 0x20, 0xa6, 0xd1, 0x41, 0x0f, 0x6e, 0xf4,
-	0xb4, 0xd2, 0x18, 0x39, 0x8b, 0xf1, 0x6d, 0x8e, 0x52, 0x91, 0x87, 0xb0, 0x64, 0x53, 0x18, 0xb9,
-	0x8e, 0xef, 0x6c, 0xb7, 0xf6, 0x3a, 0xa1, 0x8d, 0x18, 0x96, 0x92, 0xc2, 0x43, 0x25, 0xd2, 0x2c,
-	0x79, 0x4d, 0xc7, 0x39, 0xc6, 0x0d, 0x0d, 0xee, 0x8d, 0x82, 0x0f, 0x0e, 0xac, 0x4e, 0xf8, 0x92,
-	0x9c, 0x65, 0x12, 0xc9, 0x3e, 0x80, 0x71, 0x86, 0xa7, 0x98, 0xa9, 0xc2, 0xdf, 0x7a, 0x78, 0x59,
-	0x8e, 0x50, 0xa3, 0x9f, 0x6b, 0x63, 0xdc, 0x14, 0xe5, 0xef, 0xa4, 0x84, 0xda, 0x5f, 0x48, 0xf8,
-	0x51, 0x87, 0x66, 0xe5, 0x8f, 0x3c, 0x86, 0xf6, 0x65, 0xe8, 0x79, 0xb3, 0x69, 0x55, 0x22, 0x7a,
-	0xa3, 0x7f, 0x94, 0x41, 0xba, 0x00, 0xec, 0x2c, 0x43, 0xd1, 0xe7, 0x54, 0x1d, 0xbb, 0xf5, 0x39,
-	0x98, 0x4d, 0x83, 0x7f, 0x49, 0xd5, 0x31, 0xd9, 0x87, 0x86, 0x54, 0x54, 0xe5, 0xd2, 0x5d, 0x98,
-	0x27, 0xa4, 0xc5, 0x6a, 0x96, 0x40, 0x99, 0x8f, 0x95, 0xbb, 0x38, 0x9f, 0x50, 0x8d, 0x25, 0x5d,
-	0x68, 0x0d, 0x05, 0x52, 0x85, 0x7d, 0x3d, 0x63, 0x6e, 0xc3, 0x50, 0xbd, 0x29, 0xea, 0xab, 0x72,
-	0x00, 0x63, 0xb0, 0x70, 0x7d, 0xa1, 0xc9, 0x36, 0xb8, 0x25, 0x2f, 0x5d, 0x4f, 0xb6, 0x70, 0x43,
-	0xde, 0x83, 0x45, 0x93, 0xb2, 0xfb, 0xdf, 0x1c, 0x72, 0x2d, 0x34, 0xf8, 0xe6, 0xc0, 0xad, 0x67,
-	0x28, 0x87, 0x22, 0x1d, 0x60, 0xd5, 0x65, 0x59, 0x4e, 0xed, 0x1a, 0x2c, 0x8e, 0xd3, 0x93, 0x54,
-	0x99, 0xb2, 0xb5, 0x63, 0x7b, 0x20, 0x37, 0xa1, 0xc1, 0x8e, 0x8e, 0x24, 0xda, 0xba, 0xb4, 0xe3,
-	0xe2, 0x44, 0x82, 0xab, 0xb3, 0xd1, 0xf2, 0xeb, 0xdb, 0xcd, 0xdf, 0xbb, 0xbf, 0x71, 0xd9, 0xfd,
-	0x65, 0x63, 0x2d, 0xfb, 0xbb, 0x56, 0x8a, 0x6f, 0x9b, 0x6b, 0x7b, 0xd0, 0xa1, 0x8a, 0xc6, 0xad,
-	0x58, 0xb4, 0x3d, 0x05, 0xef, 0xc1, 0xfb, 0x93, 0xea, 0x62, 0x3f, 0xb6, 0xa0, 0xa5, 0x98, 0xa2,
-	0xe3, 0xfe, 0x90, 0xe5, 0xc5, 0x82, 0xb4, 0x63, 0x30, 0x57, 0x4f, 0xf5, 0x0d, 0xe9, 0xc2, 0xca,
-	0x84, 0x52, 0x9d, 0x49, 0xcd, 0xaf, 0xcf, 0x5e, 0xa2, 0xe5, 0x2a, 0x83, 0x43, 0x54, 0x7b, 0x5f,
-	0x6b, 0xd0, 0xd2, 0xb6, 0x9e, 0x7d, 0x8d, 0xc8, 0x27, 0x07, 0x9a, 0xd5, 0x8e, 0x92, 0xce, 0xa4,
-	0x8b, 0xab, 0xcf, 0x80, 0x77, 0x7b, 0x86, 0xd5, 0x0a, 0x0f, 0xba, 0x17, 0x07, 0x9b, 0xa4, 0x73,
-	0xa8, 0xa8, 0x50, 0xbe, 0x79, 0xec, 0x7c, 0x1d, 0x59, 0xa6, 0x8a, 0x89, 0x77, 0xbe, 0x11, 0xfb,
-	0xf1, 0xfb, 0xcf, 0xcf, 0xb5, 0xb5, 0xe0, 0xff, 0xe8, 0x74, 0x37, 0x32, 0xb6, 0xc8, 0xe0, 0x1e,
-	0x39, 0xf7, 0xc8, 0x17, 0x07, 0xc8, 0x74, 0x51, 0xc8, 0x9d, 0xc9, 0x90, 0x33, 0x5b, 0xed, 0xdd,
-	0xbd, 0x0e, 0x56, 0x48, 0xdc, 0xbd, 0x38, 0xd8, 0x20, 0xeb, 0x2f, 0x50, 0x4d, 0x49, 0x93, 0x46,
-	0xdb, 0x2a, 0xa9, 0xb4, 0xd9, 0xf2, 0xca, 0x27, 0x0b, 0x6f, 0x6a, 0x7c, 0x30, 0x68, 0x98, 0x31,
-	0x7c, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x44, 0x04, 0xf0, 0xba, 0xcb, 0x05, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xce, 0xb6, 0x50, 0xec, 0x94, 0xf2, 0x33, 0xe1, 0x67, 0x6d, 0x2a, 0x6c, 0x9a, 0x68, 0x88,
+	0x81, 0xae, 0x20, 0xde, 0xd8, 0x1b, 0x51, 0x88, 0xe9, 0x1d, 0x59, 0x88, 0x17, 0xc4, 0xa4, 0x99,
+	0xb6, 0xa7, 0xcb, 0x86, 0xed, 0xce, 0x3a, 0x73, 0x0a, 0xe5, 0xc2, 0xc4, 0x98, 0xf8, 0x02, 0x90,
+	0xf8, 0x3c, 0xbe, 0x83, 0x97, 0xde, 0x19, 0x5f, 0xc0, 0x37, 0x30, 0x33, 0xb3, 0x5b, 0x6a, 0x2b,
+	0xa1, 0x9a, 0x78, 0xb5, 0x3b, 0x73, 0xbe, 0x6f, 0xce, 0xf7, 0x9d, 0x73, 0x66, 0x08, 0x15, 0x10,
+	0xf3, 0x46, 0x10, 0xb5, 0xa1, 0x0f, 0xa2, 0x1a, 0x0b, 0x8e, 0x9c, 0x12, 0x1e, 0x43, 0x14, 0x07,
+	0x28, 0x82, 0x7e, 0x69, 0xcd, 0xe7, 0xdc, 0x0f, 0xc1, 0xd5, 0x91, 0x66, 0xaf, 0xe3, 0x5e, 0x08,
+	0x16, 0xc7, 0x20, 0xa4, 0xc1, 0x96, 0xd6, 0x47, 0xe3, 0x18, 0x74, 0x41, 0x22, 0xeb, 0xc6, 0x09,
+	0xa0, 0x9c, 0x00, 0x58, 0x1c, 0xb8, 0x2c, 0x8a, 0x38, 0x32, 0x0c, 0x78, 0x94, 0xd2, 0x37, 0xf5,
+	0xa7, 0xb5, 0xe5, 0x43, 0xb4, 0x25, 0x2f, 0x98, 0xef, 0x83, 0x70, 0x79, 0xac, 0x11, 0xe3, 0xe8,
+	0x4a, 0x9d, 0x2c, 0xd4, 0x95, 0x52, 0x0f, 0x62, 0xee, 0xc1, 0xbb, 0x1e, 0x48, 0xa4, 0xcf, 0xc8,
+	0x8c, 0xb1, 0xd0, 0xb6, 0x2d, 0xc7, 0xda, 0x28, 0xec, 0x94, 0xab, 0x26, 0x63, 0x35, 0x95, 0x54,
+	0x3d, 0x42, 0x11, 0x44, 0xfe, 0x1b, 0x16, 0xf6, 0xc0, 0xcb, 0x29, 0x70, 0xbd, 0x5d, 0xf9, 0x60,
+	0x91, 0xc5, 0xa1, 0xb3, 0x64, 0xcc, 0x23, 0x09, 0x74, 0x97, 0x10, 0x7d, 0x18, 0x9c, 0x43, 0x84,
+	0xc9, 0x79, 0xcb, 0xd5, 0x9b, 0x72, 0x54, 0x15, 0xfa, 0x40, 0x05, 0xbd, 0xbc, 0x48, 0x7f, 0x87,
+	0x25, 0x64, 0xfe, 0x42, 0xc2, 0xb7, 0x2c, 0xc9, 0x0f, 0xce, 0xa3, 0x2f, 0x48, 0xf1, 0x26, 0xf5,
+	0xa4, 0x6e, 0x0a, 0x03, 0x11, 0xf5, 0xf6, 0x3f, 0xca, 0xa0, 0x35, 0x42, 0xf8, 0x45, 0x04, 0xa2,
+	0x11, 0x33, 0x3c, 0xb5, 0xb3, 0x13, 0x30, 0xf3, 0x1a, 0x7f, 0xc8, 0xf0, 0x94, 0xee, 0x92, 0x9c,
+	0x44, 0x86, 0x3d, 0x69, 0x4f, 0x4d, 0x92, 0xd2, 0x60, 0x15, 0x4b, 0x80, 0xec, 0x85, 0x68, 0x4f,
+	0x4f, 0x26, 0x54, 0x61, 0x69, 0x8d, 0x14, 0x5a, 0x02, 0x18, 0x42, 0x43, 0xcd, 0x98, 0x9d, 0xd3,
+	0xd4, 0xd2, 0x18, 0xf5, 0x38, 0x1d, 0x40, 0x8f, 0x18, 0xb8, 0xda, 0x50, 0x64, 0x93, 0xdc, 0x90,
+	0x67, 0xee, 0x26, 0x1b, 0xb8, 0x26, 0xef, 0x90, 0x69, 0x6d, 0xd9, 0xbe, 0x37, 0x81, 0x5c, 0x03,
+	0xad, 0xfc, 0xb4, 0xc8, 0xfd, 0x7d, 0x90, 0x2d, 0x11, 0x34, 0x61, 0xd0, 0x65, 0x99, 0x4e, 0xed,
+	0x12, 0x99, 0x0e, 0x83, 0x6e, 0x80, 0xba, 0x6c, 0x45, 0xcf, 0x2c, 0xe8, 0x0a, 0xc9, 0xf1, 0x4e,
+	0x47, 0x82, 0xa9, 0x4b, 0xd1, 0x4b, 0x56, 0xb4, 0x32, 0x3a, 0x1b, 0x05, 0x27, 0xbb, 0x91, 0xff,
+	0xbd, 0xfb, 0xab, 0x37, 0xdd, 0x9f, 0xd5, 0xd1, 0xb4, 0xbf, 0x4b, 0xa9, 0xf8, 0xa2, 0xde, 0x36,
+	0x0b, 0x95, 0x2a, 0x69, 0xdc, 0x9c, 0x41, 0x27, 0xad, 0xa9, 0x11, 0x12, 0x33, 0x1f, 0x1a, 0xc8,
+	0xcf, 0x20, 0xb2, 0xe7, 0x27, 0x99, 0x06, 0x85, 0x3f, 0x56, 0xf0, 0xca, 0x17, 0x8b, 0x94, 0xfe,
+	0xe4, 0x39, 0xb9, 0x5d, 0xeb, 0xa4, 0x80, 0x1c, 0x59, 0xd8, 0x68, 0xf1, 0x5e, 0x72, 0xbd, 0x8a,
+	0x1e, 0xd1, 0x5b, 0xaf, 0xd4, 0x0e, 0xad, 0x91, 0xb9, 0x21, 0x9f, 0xaa, 0x0e, 0x19, 0x27, 0x7b,
+	0xfb, 0x15, 0x9c, 0x1d, 0xf8, 0x3f, 0x02, 0xa4, 0xfb, 0x64, 0x3e, 0x82, 0x3e, 0x36, 0x86, 0xe4,
+	0x4f, 0x32, 0xcc, 0x45, 0x45, 0x3a, 0x4c, 0x2d, 0xec, 0x5c, 0x4f, 0x91, 0x82, 0xca, 0x50, 0x37,
+	0x2f, 0x22, 0xfd, 0x64, 0x91, 0xfc, 0xe0, 0x9d, 0xa0, 0xe5, 0x61, 0x21, 0xa3, 0x4f, 0x51, 0xe9,
+	0xc1, 0x2d, 0x51, 0x63, 0xbf, 0x52, 0xbb, 0xda, 0x5b, 0xa3, 0xe5, 0x23, 0x64, 0x02, 0x1d, 0xfd,
+	0xe0, 0x3a, 0x4a, 0xbf, 0x0c, 0x90, 0x8b, 0x4b, 0x47, 0x5b, 0xfe, 0xf8, 0xf5, 0xc7, 0x75, 0x66,
+	0xa9, 0x32, 0xef, 0x9e, 0x6f, 0xbb, 0x3a, 0xe6, 0x6a, 0xdc, 0x73, 0xeb, 0x31, 0xfd, 0x6c, 0x11,
+	0x3a, 0x5e, 0x5a, 0xfa, 0x70, 0x38, 0xe5, 0xad, 0xe3, 0x56, 0x7a, 0x74, 0x17, 0x2c, 0x91, 0xb8,
+	0x7d, 0xb5, 0xb7, 0x4a, 0x97, 0x5f, 0x03, 0x8e, 0x49, 0x93, 0x5a, 0xdb, 0x22, 0x1d, 0x68, 0x33,
+	0x4d, 0x92, 0xf4, 0xbb, 0x45, 0x16, 0x0e, 0xfa, 0x31, 0x17, 0xf8, 0xff, 0x64, 0xbd, 0xbf, 0xda,
+	0x7b, 0x4b, 0x4f, 0x4c, 0x96, 0x71, 0x65, 0x9b, 0x8e, 0x44, 0x01, 0xac, 0xeb, 0xb0, 0x30, 0x74,
+	0xf0, 0x14, 0xc6, 0x11, 0x8e, 0x9a, 0x09, 0xa7, 0x79, 0x69, 0xbe, 0x1d, 0x2e, 0x9c, 0x66, 0x2f,
+	0x3c, 0xd3, 0x38, 0x81, 0x41, 0xe4, 0x6b, 0x6f, 0x36, 0x5d, 0x19, 0xf1, 0xe6, 0x82, 0xce, 0xf8,
+	0xc4, 0x7a, 0x39, 0x75, 0x92, 0x89, 0x9b, 0xcd, 0x9c, 0x1e, 0xa0, 0xa7, 0xbf, 0x06, 0x00, 0xff,
+	0x78, 0xcf, 0xfa, 0x34, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +448,8 @@ type RepoIndexerClient interface {
 	IndexRepo(ctx context.Context, in *IndexRepoRequest, opts ...grpc.CallOption) (*IndexRepoResponse, error)
 	// Get repository events
 	DescribeRepoEvents(ctx context.Context, in *DescribeRepoEventsRequest, opts ...grpc.CallOption) (*DescribeRepoEventsResponse, error)
+	// Export repository events, stream all the repository events page by page for bulk reporting
+	ExportRepoEvents(ctx context.Context, in *DescribeRepoEventsRequest, opts ...grpc.CallOption) (RepoIndexer_ExportRepoEventsClient, error)
 }
 
 type repoIndexerClient struct {
@@ -451,12 +478,46 @@ func (c *repoIndexerClient) DescribeRepoEvents(ctx context.Context, in *Describe
 	return out, nil
 }
 
+func (c *repoIndexerClient) ExportRepoEvents(ctx context.Context, in *DescribeRepoEventsRequest, opts ...grpc.CallOption) (RepoIndexer_ExportRepoEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoIndexer_serviceDesc.Streams[0], "/openpitrix.RepoIndexer/ExportRepoEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repoIndexerExportRepoEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepoIndexer_ExportRepoEventsClient interface {
+	Recv() (*DescribeRepoEventsResponse, error)
+	grpc.ClientStream
+}
+
+type repoIndexerExportRepoEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *repoIndexerExportRepoEventsClient) Recv() (*DescribeRepoEventsResponse, error) {
+	m := new(DescribeRepoEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RepoIndexerServer is the server API for RepoIndexer service.
 type RepoIndexerServer interface {
 	// Start index repository event
 	IndexRepo(context.Context, *IndexRepoRequest) (*IndexRepoResponse, error)
 	// Get repository events
 	DescribeRepoEvents(context.Context, *DescribeRepoEventsRequest) (*DescribeRepoEventsResponse, error)
+	// Export repository events, stream all the repository events page by page for bulk reporting
+	ExportRepoEvents(*DescribeRepoEventsRequest, RepoIndexer_ExportRepoEventsServer) error
 }
 
 // UnimplementedRepoIndexerServer can be embedded to have forward compatible implementations.
@@ -469,6 +530,9 @@ func (*UnimplementedRepoIndexerServer) IndexRepo(ctx context.Context, req *Index
 func (*UnimplementedRepoIndexerServer) DescribeRepoEvents(ctx context.Context, req *DescribeRepoEventsRequest) (*DescribeRepoEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeRepoEvents not implemented")
 }
+func (*UnimplementedRepoIndexerServer) ExportRepoEvents(req *DescribeRepoEventsRequest, srv RepoIndexer_ExportRepoEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRepoEvents not implemented")
+}
 
 func RegisterRepoIndexerServer(s *grpc.Server, srv RepoIndexerServer) {
 	s.RegisterService(&_RepoIndexer_serviceDesc, srv)
@@ -510,6 +574,27 @@ func _RepoIndexer_DescribeRepoEvents_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoIndexer_ExportRepoEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DescribeRepoEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepoIndexerServer).ExportRepoEvents(m, &repoIndexerExportRepoEventsServer{stream})
+}
+
+type RepoIndexer_ExportRepoEventsServer interface {
+	Send(*DescribeRepoEventsResponse) error
+	grpc.ServerStream
+}
+
+type repoIndexerExportRepoEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *repoIndexerExportRepoEventsServer) Send(m *DescribeRepoEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _RepoIndexer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.RepoIndexer",
 	HandlerType: (*RepoIndexerServer)(nil),
@@ -523,6 +608,12 @@ var _RepoIndexer_serviceDesc = grpc.ServiceDesc{
 			Handler:    _RepoIndexer_DescribeRepoEvents_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportRepoEvents",
+			Handler:       _RepoIndexer_ExportRepoEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "repo_indexer.proto",
 }
@@ -1583,6 +1583,95 @@ func (m *DescribeRuntimeProviderZonesResponse) GetZone() []string {
 	return nil
 }
 
+type RotateCredentialKeyRequest struct {
+	// ids of runtime credential to re-encrypt, default re-encrypt all runtime credentials
+	RuntimeCredentialId  []string `protobuf:"bytes,1,rep,name=runtime_credential_id,json=runtimeCredentialId,proto3" json:"runtime_credential_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateCredentialKeyRequest) Reset()         { *m = RotateCredentialKeyRequest{} }
+func (m *RotateCredentialKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateCredentialKeyRequest) ProtoMessage()    {}
+func (*RotateCredentialKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86e2dd377c869464, []int{24}
+}
+
+func (m *RotateCredentialKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateCredentialKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateCredentialKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateCredentialKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RotateCredentialKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateCredentialKeyRequest.Merge(m, src)
+}
+func (m *RotateCredentialKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateCredentialKeyRequest.Size(m)
+}
+func (m *RotateCredentialKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateCredentialKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateCredentialKeyRequest proto.InternalMessageInfo
+
+func (m *RotateCredentialKeyRequest) GetRuntimeCredentialId() []string {
+	if m != nil {
+		return m.RuntimeCredentialId
+	}
+	return nil
+}
+
+type RotateCredentialKeyResponse struct {
+	// id of primary master key which encrypts runtime credentials now
+	KeyId *wrappers.StringValue `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// ids of runtime credential re-encrypted
+	RuntimeCredentialId  []string `protobuf:"bytes,2,rep,name=runtime_credential_id,json=runtimeCredentialId,proto3" json:"runtime_credential_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateCredentialKeyResponse) Reset()         { *m = RotateCredentialKeyResponse{} }
+func (m *RotateCredentialKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateCredentialKeyResponse) ProtoMessage()    {}
+func (*RotateCredentialKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86e2dd377c869464, []int{25}
+}
+
+func (m *RotateCredentialKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateCredentialKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateCredentialKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateCredentialKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RotateCredentialKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateCredentialKeyResponse.Merge(m, src)
+}
+func (m *RotateCredentialKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateCredentialKeyResponse.Size(m)
+}
+func (m *RotateCredentialKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateCredentialKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateCredentialKeyResponse proto.InternalMessageInfo
+
+func (m *RotateCredentialKeyResponse) GetKeyId() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyId
+	}
+	return nil
+}
+
+func (m *RotateCredentialKeyResponse) GetRuntimeCredentialId() []string {
+	if m != nil {
+		return m.RuntimeCredentialId
+	}
+	return nil
+}
+
 type GetRuntimeStatisticsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1593,7 +1682,7 @@ func (m *GetRuntimeStatisticsRequest) Reset()         { *m = GetRuntimeStatistic
 func (m *GetRuntimeStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRuntimeStatisticsRequest) ProtoMessage()    {}
 func (*GetRuntimeStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86e2dd377c869464, []int{24}
+	return fileDescriptor_86e2dd377c869464, []int{26}
 }
 
 func (m *GetRuntimeStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1632,7 +1721,7 @@ func (m *GetRuntimeStatisticsResponse) Reset()         { *m = GetRuntimeStatisti
 func (m *GetRuntimeStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRuntimeStatisticsResponse) ProtoMessage()    {}
 func (*GetRuntimeStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86e2dd377c869464, []int{25}
+	return fileDescriptor_86e2dd377c869464, []int{27}
 }
 
 func (m *GetRuntimeStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1706,6 +1795,8 @@ func init() {
 	proto.RegisterType((*DeleteRuntimeCredentialsResponse)(nil), "openpitrix.DeleteRuntimeCredentialsResponse")
 	proto.RegisterType((*DescribeRuntimeProviderZonesRequest)(nil), "openpitrix.DescribeRuntimeProviderZonesRequest")
 	proto.RegisterType((*DescribeRuntimeProviderZonesResponse)(nil), "openpitrix.DescribeRuntimeProviderZonesResponse")
+	proto.RegisterType((*RotateCredentialKeyRequest)(nil), "openpitrix.RotateCredentialKeyRequest")
+	proto.RegisterType((*RotateCredentialKeyResponse)(nil), "openpitrix.RotateCredentialKeyResponse")
 	proto.RegisterType((*GetRuntimeStatisticsRequest)(nil), "openpitrix.GetRuntimeStatisticsRequest")
 	proto.RegisterType((*GetRuntimeStatisticsResponse)(nil), "openpitrix.GetRuntimeStatisticsResponse")
 	proto.RegisterMapType((map[string]uint32)(nil), "openpitrix.GetRuntimeStatisticsResponse.LastTwoWeekCreatedEntry")
@@ -1715,128 +1806,136 @@ func init() {
 func init() { proto.RegisterFile("runtime.proto", fileDescriptor_86e2dd377c869464) }
 
 var fileDescriptor_86e2dd377c869464 = []byte{
-	// 1934 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x57, 0xcf, 0x78, 0xec, 0xf8, 0x8d, 0xc7, 0x89, 0x6b, 0x1d, 0xbb, 0xd3, 0xf1, 0x47, 0xbb,
-	0xb3, 0xb0, 0xc1, 0x19, 0xdb, 0x61, 0x88, 0xd8, 0x65, 0x57, 0x41, 0x4c, 0x9c, 0x55, 0x40, 0x24,
-	0x22, 0x1a, 0x7b, 0xb3, 0x52, 0x2e, 0xa3, 0xf6, 0x4c, 0xcd, 0xb8, 0xe5, 0x76, 0xd7, 0x6c, 0x75,
-	0x8d, 0x07, 0x73, 0x40, 0x2b, 0x96, 0x0b, 0x6c, 0x2e, 0x0c, 0x42, 0x20, 0x0e, 0x1c, 0x11, 0x27,
-	0xc4, 0x05, 0x21, 0x04, 0x12, 0x08, 0x09, 0x24, 0xce, 0x88, 0xff, 0x80, 0x1b, 0x7f, 0x00, 0x47,
-	0x50, 0x57, 0x55, 0x7b, 0xfa, 0x7b, 0xda, 0xf6, 0x24, 0x08, 0x76, 0x4f, 0xf1, 0x74, 0xbd, 0x57,
-	0xf5, 0xbe, 0x7e, 0xbf, 0x7a, 0xaf, 0x02, 0x15, 0xda, 0x77, 0x98, 0x75, 0x8c, 0xb7, 0x7b, 0x94,
-	0x30, 0x82, 0x80, 0xf4, 0xb0, 0xd3, 0xb3, 0x18, 0xb5, 0xbe, 0xa9, 0xad, 0x75, 0x09, 0xe9, 0xda,
-	0x78, 0x87, 0xaf, 0x1c, 0xf4, 0x3b, 0x3b, 0x03, 0x6a, 0xf6, 0x7a, 0x98, 0xba, 0x42, 0x56, 0x5b,
-	0x8f, 0xae, 0x7b, 0xfb, 0xb8, 0xcc, 0x3c, 0xee, 0x49, 0x81, 0x15, 0x29, 0x60, 0xf6, 0xac, 0x1d,
-	0xd3, 0x71, 0x08, 0x33, 0x99, 0x45, 0x1c, 0x5f, 0xbd, 0xca, 0xff, 0x69, 0x6d, 0x75, 0xb1, 0xb3,
-	0xe5, 0x0e, 0xcc, 0x6e, 0x17, 0xd3, 0x1d, 0xd2, 0xe3, 0x12, 0x71, 0x69, 0xe3, 0xdf, 0x25, 0x58,
-	0x68, 0x08, 0x53, 0x77, 0x29, 0x6e, 0x63, 0x87, 0x59, 0xa6, 0x8d, 0x9e, 0xc2, 0x75, 0x69, 0x7f,
-	0xb3, 0x75, 0xf6, 0xb5, 0x69, 0xb5, 0x55, 0x45, 0x57, 0x6e, 0x97, 0x6b, 0x2b, 0xdb, 0xc2, 0x82,
-	0x6d, 0xdf, 0xc4, 0xed, 0x3d, 0x46, 0x2d, 0xa7, 0xfb, 0xcc, 0xb4, 0xfb, 0xb8, 0xf1, 0x1a, 0x8d,
-	0xee, 0xf7, 0xb5, 0x36, 0xba, 0x0b, 0x53, 0x8e, 0x79, 0x8c, 0xd5, 0x42, 0x8e, 0x0d, 0xb8, 0x24,
-	0xfa, 0x32, 0x94, 0xdb, 0xd8, 0x6d, 0x51, 0x8b, 0xdb, 0xae, 0x16, 0x73, 0x28, 0x06, 0x15, 0xd0,
-	0x7d, 0x28, 0xfb, 0x3e, 0xf4, 0xa9, 0xad, 0x4e, 0xe5, 0xd0, 0x07, 0xa9, 0xf0, 0x1e, 0xb5, 0xd1,
-	0x73, 0xd0, 0x12, 0x42, 0xd0, 0x22, 0x0e, 0xc3, 0x0e, 0x53, 0x4b, 0x39, 0x76, 0x53, 0x63, 0x71,
-	0xd8, 0x15, 0xda, 0xe8, 0x1d, 0x00, 0x32, 0x70, 0x30, 0x6d, 0xf6, 0x4c, 0x76, 0xa8, 0x4e, 0xe7,
-	0xd8, 0x6b, 0x96, 0xcb, 0x3f, 0x35, 0xd9, 0x21, 0x7a, 0x0b, 0xae, 0xf4, 0x28, 0x39, 0xb1, 0xda,
-	0x98, 0xaa, 0x33, 0x39, 0x54, 0xcf, 0xa4, 0xd1, 0x3d, 0x98, 0x76, 0x99, 0xc9, 0xfa, 0xae, 0x7a,
-	0x25, 0x87, 0x9e, 0x94, 0x45, 0xef, 0x40, 0xb9, 0x45, 0xb1, 0xc9, 0x70, 0xd3, 0x73, 0x46, 0x9d,
-	0xe5, 0xaa, 0x5a, 0x4c, 0x75, 0xdf, 0x2f, 0xd2, 0x06, 0x08, 0x71, 0xef, 0x83, 0xa7, 0x2c, 0xb6,
-	0x11, 0xca, 0x30, 0x5e, 0x59, 0x88, 0x73, 0xe5, 0xbb, 0x50, 0x6a, 0xe3, 0x83, 0x7e, 0x57, 0x2d,
-	0xa7, 0xa8, 0x3d, 0x20, 0xc4, 0x16, 0xc6, 0x0a, 0x41, 0x54, 0x83, 0x12, 0x0f, 0x94, 0x3a, 0x97,
-	0xc3, 0x41, 0x21, 0x6a, 0xfc, 0xb5, 0x04, 0x33, 0x12, 0x01, 0x5e, 0x62, 0xfc, 0xa4, 0xe7, 0x2c,
-	0xf6, 0x59, 0x29, 0xff, 0x5f, 0x29, 0xf1, 0x60, 0x29, 0x4c, 0x9d, 0xab, 0x14, 0x52, 0x01, 0x5e,
-	0xba, 0x04, 0xc0, 0xbf, 0x45, 0x1c, 0x9c, 0xab, 0x9a, 0xb9, 0x64, 0x04, 0x05, 0x33, 0xe7, 0x43,
-	0xc1, 0xa7, 0xb5, 0x9c, 0x54, 0xcb, 0x2f, 0x14, 0xa8, 0xc8, 0x5a, 0x7e, 0x88, 0x99, 0x69, 0xd9,
-	0x68, 0x0b, 0x66, 0x64, 0xb6, 0x64, 0x39, 0xbf, 0xb6, 0x3d, 0xba, 0x8a, 0xb6, 0xa5, 0x6c, 0xc3,
-	0x97, 0x41, 0x8f, 0x01, 0xc5, 0xeb, 0x42, 0x56, 0xf4, 0x6a, 0x82, 0xe6, 0xa8, 0x04, 0x1a, 0x0b,
-	0xb1, 0xaa, 0x30, 0x3e, 0x2a, 0xc2, 0xe2, 0x2e, 0x0f, 0xa0, 0x7f, 0x10, 0xfe, 0xa0, 0x8f, 0x5d,
-	0x76, 0x06, 0x15, 0xe5, 0xa2, 0x50, 0x29, 0x5c, 0x06, 0x2a, 0xc5, 0xc9, 0x40, 0x65, 0xea, 0xb2,
-	0x50, 0x29, 0x9d, 0x07, 0x2a, 0x01, 0x5e, 0x82, 0x73, 0xf1, 0x92, 0xb1, 0x0f, 0xd7, 0x23, 0x49,
-	0x70, 0x7b, 0xc4, 0x71, 0x2f, 0xc7, 0x76, 0xc6, 0xef, 0x8a, 0xb0, 0xfc, 0x90, 0x07, 0xf8, 0xc0,
-	0xdf, 0xd8, 0xf5, 0xd3, 0x7b, 0x1f, 0xca, 0x2e, 0x36, 0x69, 0xeb, 0xb0, 0x39, 0x20, 0x34, 0xdf,
-	0xce, 0x20, 0x14, 0xde, 0x27, 0xb4, 0x8d, 0xde, 0x84, 0x2b, 0x2e, 0xa1, 0xac, 0x79, 0x84, 0x4f,
-	0x73, 0x25, 0x7a, 0xc6, 0x93, 0xfe, 0x3a, 0x3e, 0x45, 0xf7, 0x60, 0x86, 0xe2, 0x13, 0x4c, 0x5d,
-	0x2c, 0x73, 0x9c, 0x05, 0x33, 0x5f, 0x14, 0x2d, 0x42, 0xc9, 0xb6, 0x8e, 0x2d, 0xc6, 0x13, 0x5a,
-	0x69, 0x88, 0x1f, 0x68, 0x09, 0xa6, 0x49, 0xa7, 0xe3, 0x62, 0x71, 0xd7, 0x57, 0x1a, 0xf2, 0x17,
-	0x7a, 0x03, 0xae, 0xb6, 0x2d, 0xb7, 0x67, 0x9b, 0xa7, 0xcd, 0x16, 0xb1, 0xfb, 0xc7, 0x8e, 0xab,
-	0x4e, 0xeb, 0xc5, 0xdb, 0xb3, 0x8d, 0x79, 0xf9, 0x79, 0x57, 0x7c, 0x45, 0xab, 0xa1, 0xe8, 0x96,
-	0xb9, 0x4c, 0xe0, 0xb6, 0x58, 0x3a, 0x23, 0xb0, 0x39, 0xbe, 0xe4, 0x53, 0x94, 0x16, 0x28, 0xd4,
-	0x0a, 0x5f, 0x19, 0x95, 0xe2, 0xa2, 0x4f, 0x09, 0xf3, 0x7c, 0x41, 0xfc, 0x40, 0xb5, 0xb4, 0x02,
-	0xbd, 0xca, 0xa5, 0x92, 0x4a, 0xd0, 0xf8, 0x00, 0xd4, 0x78, 0xf2, 0x64, 0x59, 0xac, 0x43, 0x99,
-	0x11, 0xc6, 0x9b, 0x9d, 0xbe, 0xc3, 0x78, 0xf6, 0x2a, 0x0d, 0xe0, 0x9f, 0x76, 0xbd, 0x2f, 0xe8,
-	0xde, 0xa8, 0xb3, 0xf2, 0xe2, 0x53, 0xd0, 0x8b, 0x69, 0xbc, 0xe2, 0x47, 0x60, 0x0f, 0x33, 0xe3,
-	0xfb, 0x0a, 0xac, 0x45, 0xce, 0x14, 0x1c, 0x75, 0x8e, 0x93, 0x1f, 0x8d, 0xe8, 0xa9, 0xcd, 0x75,
-	0x03, 0x06, 0xdc, 0x48, 0x30, 0x40, 0x1c, 0xd0, 0xb8, 0x46, 0x83, 0x3f, 0x3d, 0x63, 0x7e, 0x5c,
-	0x80, 0xc5, 0x27, 0xa4, 0x6d, 0x75, 0x4e, 0x23, 0xcc, 0xf4, 0x3f, 0xd6, 0x01, 0x4c, 0x9c, 0x9c,
-	0x3c, 0xb6, 0x88, 0x04, 0x66, 0x12, 0x6c, 0xf1, 0xb1, 0x02, 0xd7, 0x1f, 0x62, 0x1b, 0xb3, 0x18,
-	0x57, 0xac, 0x46, 0xb6, 0x8d, 0xc0, 0xe4, 0x2e, 0x94, 0x3a, 0x84, 0xb6, 0xfc, 0x98, 0x66, 0xde,
-	0x9b, 0x5c, 0x10, 0x6d, 0xc0, 0x5c, 0x97, 0x9a, 0x2d, 0xdc, 0xec, 0x61, 0x6a, 0x91, 0x36, 0x8f,
-	0x69, 0xa5, 0x51, 0xe6, 0xdf, 0x9e, 0xf2, 0x4f, 0xc6, 0x9b, 0xb0, 0x14, 0x35, 0x46, 0x3a, 0x99,
-	0x6d, 0x8d, 0xf1, 0xdb, 0x22, 0xac, 0x85, 0xb8, 0x34, 0x70, 0xff, 0x8d, 0xb8, 0x2f, 0x38, 0x76,
-	0x28, 0x13, 0x1d, 0x3b, 0x0a, 0x97, 0x1a, 0x3b, 0x2e, 0x7e, 0x07, 0xfa, 0x85, 0x3d, 0x75, 0xd1,
-	0xc2, 0x2e, 0x4d, 0xac, 0xb0, 0xe1, 0xa2, 0x85, 0xed, 0xc2, 0x7a, 0x6a, 0xea, 0x64, 0xf6, 0x27,
-	0x3e, 0xf6, 0x1a, 0xff, 0x52, 0x40, 0x7f, 0x66, 0xda, 0x56, 0xfb, 0x93, 0x56, 0x32, 0xc6, 0x37,
-	0x60, 0x23, 0xc3, 0x71, 0x19, 0xf0, 0x4d, 0x28, 0x90, 0x23, 0xe9, 0x70, 0x16, 0xb4, 0x0b, 0xe4,
-	0xc8, 0xf8, 0x79, 0x11, 0x36, 0x22, 0xf7, 0xc7, 0x68, 0xc7, 0x4f, 0x5b, 0x8f, 0x60, 0xeb, 0x91,
-	0xda, 0x11, 0x94, 0x53, 0x3b, 0x82, 0xc9, 0xf5, 0x23, 0xc6, 0x4f, 0x15, 0x30, 0xb2, 0x12, 0x95,
-	0xf7, 0xb2, 0xdf, 0x83, 0xa5, 0x04, 0x2f, 0x46, 0x17, 0xfe, 0x98, 0x79, 0x64, 0x31, 0xe6, 0xa5,
-	0x77, 0xf1, 0xff, 0xb1, 0x00, 0x6b, 0xa1, 0xfb, 0x2d, 0x0e, 0xc7, 0xff, 0x87, 0xc7, 0xaf, 0x6c,
-	0x4e, 0x98, 0xba, 0x0c, 0x27, 0x78, 0x44, 0x9a, 0x1a, 0xc1, 0x97, 0x46, 0xa4, 0xef, 0xc1, 0x7a,
-	0xe8, 0xca, 0x4e, 0x80, 0x7e, 0x2d, 0xfd, 0xd0, 0xd4, 0x3e, 0xf8, 0x19, 0xe8, 0xe9, 0xdb, 0x4a,
-	0x67, 0x2e, 0xb2, 0xef, 0x00, 0x6e, 0x45, 0x20, 0xf0, 0x54, 0x82, 0xe6, 0x39, 0x71, 0x46, 0xcd,
-	0xcf, 0xe4, 0xe3, 0xf4, 0x42, 0x81, 0xd7, 0xb3, 0x4f, 0x7e, 0x59, 0x29, 0x42, 0x48, 0x8e, 0xb5,
-	0x05, 0x1e, 0x16, 0xfe, 0xb7, 0xb1, 0x0a, 0x37, 0x1f, 0x61, 0x26, 0x0d, 0xd9, 0x63, 0x26, 0xb3,
-	0x5c, 0x66, 0xb5, 0x7c, 0xff, 0x8d, 0xbf, 0x17, 0x61, 0x25, 0x79, 0x5d, 0x5a, 0xe9, 0xc2, 0x75,
-	0xdb, 0x74, 0x59, 0x93, 0x0d, 0x48, 0x73, 0x80, 0xf1, 0x51, 0x53, 0xbc, 0xc7, 0x88, 0xd8, 0x97,
-	0x6b, 0x5f, 0x09, 0x52, 0x40, 0xd6, 0x46, 0xdb, 0x8f, 0x4d, 0x97, 0xed, 0x0f, 0xc8, 0xfb, 0x18,
-	0x1f, 0x89, 0x2e, 0xa0, 0xfd, 0xae, 0xc3, 0xe8, 0x69, 0x03, 0xd9, 0xb1, 0x05, 0x64, 0xc1, 0x02,
-	0x23, 0xbd, 0x26, 0xc3, 0x4e, 0xd3, 0xa7, 0x3a, 0x57, 0x72, 0xce, 0xfd, 0xdc, 0x07, 0xee, 0x93,
-	0xde, 0x3e, 0x76, 0xfc, 0xd8, 0xbb, 0xe2, 0xb4, 0xab, 0x2c, 0xfc, 0x15, 0xdd, 0x3a, 0xfb, 0x8f,
-	0x02, 0x49, 0x83, 0xa2, 0x5b, 0x9d, 0xf3, 0xe3, 0xcb, 0x89, 0xf0, 0x33, 0x30, 0xef, 0xdb, 0x21,
-	0xa5, 0xc4, 0x75, 0x51, 0xf1, 0xbf, 0x72, 0x31, 0xed, 0x5d, 0x58, 0x4e, 0xf1, 0x12, 0x5d, 0x83,
-	0xa2, 0x77, 0xa3, 0x79, 0xa9, 0x9d, 0x6d, 0x78, 0x7f, 0x7a, 0xd4, 0x7d, 0xe2, 0xa5, 0x92, 0x73,
-	0x52, 0xa5, 0x21, 0x7e, 0xbc, 0x5d, 0x78, 0x4b, 0xd1, 0x1e, 0xc0, 0x62, 0x92, 0xed, 0xe7, 0xd9,
-	0xa3, 0xf6, 0x1b, 0x15, 0xe6, 0x65, 0x68, 0x9e, 0x98, 0x8e, 0xd9, 0xc5, 0x14, 0x7d, 0xa8, 0x40,
-	0x25, 0xd4, 0x7f, 0x21, 0x3d, 0x18, 0xcb, 0xa4, 0x67, 0x22, 0x6d, 0x23, 0x43, 0x42, 0x84, 0xd9,
-	0xd8, 0x1c, 0xd6, 0xaf, 0xa1, 0x79, 0xb1, 0xa6, 0xcb, 0xa8, 0x7d, 0xe7, 0x6f, 0xff, 0xf8, 0x61,
-	0x61, 0xc1, 0x98, 0xdb, 0x39, 0xf9, 0xfc, 0x8e, 0xfc, 0xe4, 0xbe, 0xad, 0x6c, 0xa2, 0x1f, 0x28,
-	0x80, 0x84, 0xe4, 0x43, 0x7c, 0xd0, 0xef, 0x4e, 0xd4, 0x8e, 0x2f, 0x0e, 0xeb, 0x4b, 0x48, 0x3e,
-	0x76, 0xe9, 0xfc, 0x01, 0x2f, 0x64, 0xcd, 0xb2, 0x81, 0x3c, 0x6b, 0xf8, 0x42, 0x33, 0x68, 0x93,
-	0xe5, 0x8d, 0x22, 0x49, 0x43, 0x31, 0xba, 0x15, 0x3c, 0x34, 0xe5, 0xa5, 0x45, 0xdb, 0xcc, 0x10,
-	0x8a, 0x4e, 0xd7, 0xff, 0x54, 0xe0, 0x5a, 0x74, 0x9f, 0x7c, 0xa7, 0xbc, 0x9e, 0x2d, 0x24, 0x43,
-	0xf0, 0xb1, 0x32, 0xac, 0x33, 0x44, 0x1f, 0x61, 0xe6, 0xbb, 0xee, 0x56, 0xf5, 0x96, 0xe9, 0xe8,
-	0x1d, 0xcb, 0x66, 0x98, 0xea, 0x03, 0x8b, 0x1d, 0xea, 0xec, 0x10, 0xbb, 0x58, 0xef, 0x58, 0xd8,
-	0x6e, 0xbb, 0xb7, 0x47, 0xa3, 0x56, 0x55, 0xf7, 0x6b, 0xb9, 0xaa, 0x7b, 0xc4, 0x51, 0xd5, 0x45,
-	0xfb, 0x51, 0xd5, 0x79, 0x4f, 0xf1, 0xb9, 0xaa, 0xde, 0xc6, 0x1d, 0xb3, 0x6f, 0x33, 0x9d, 0x62,
-	0xd6, 0xa7, 0x8e, 0x6e, 0xda, 0xf6, 0xd9, 0x29, 0x3c, 0xc2, 0xf3, 0x28, 0x94, 0x6f, 0xf4, 0x51,
-	0xc1, 0x9b, 0x38, 0x85, 0xa9, 0xc1, 0x74, 0x4f, 0xd4, 0xe5, 0x9f, 0x29, 0xc3, 0xfa, 0x87, 0x0a,
-	0xfa, 0xb6, 0xe7, 0x73, 0x28, 0xe9, 0x2f, 0xd9, 0xf3, 0xf0, 0x59, 0xdc, 0xff, 0x45, 0x94, 0x50,
-	0x61, 0x1c, 0x75, 0xa1, 0xcb, 0x3a, 0x5c, 0xed, 0x49, 0x4f, 0x20, 0xe1, 0x6a, 0x4f, 0x7c, 0x0b,
-	0x90, 0xa8, 0x13, 0x6b, 0x61, 0xd4, 0xd5, 0x62, 0xa8, 0xfb, 0xae, 0x02, 0xf3, 0xe1, 0x69, 0x1b,
-	0x6d, 0x84, 0x83, 0x9b, 0xf0, 0x2c, 0xa0, 0x19, 0x59, 0x22, 0xd2, 0x8a, 0x3b, 0xc3, 0xfa, 0x02,
-	0xba, 0x2a, 0x16, 0xc3, 0xc1, 0x58, 0xd8, 0x8c, 0x99, 0xf1, 0x6b, 0x05, 0x96, 0x53, 0xe6, 0x3f,
-	0xb4, 0x99, 0x8a, 0xef, 0x58, 0x77, 0xa8, 0xdd, 0xc9, 0x25, 0x2b, 0x2d, 0xac, 0x0f, 0xeb, 0x37,
-	0xd1, 0x8d, 0x56, 0x88, 0x9d, 0xf4, 0xd1, 0x75, 0xcb, 0x6d, 0x5d, 0x35, 0xd4, 0xa0, 0xad, 0x3b,
-	0xa3, 0x65, 0x6e, 0xf7, 0x5f, 0x14, 0x58, 0x89, 0x93, 0xd6, 0xab, 0x30, 0xfe, 0xc9, 0xb0, 0xbe,
-	0x81, 0xd6, 0x93, 0x28, 0x2d, 0xea, 0xc2, 0x2d, 0x63, 0x2d, 0x5e, 0x7b, 0x51, 0x47, 0x7e, 0x59,
-	0x00, 0x2d, 0x7d, 0x2c, 0x40, 0x5b, 0x19, 0x80, 0x8b, 0x37, 0x7b, 0xda, 0x76, 0x5e, 0x71, 0xe9,
-	0xcc, 0xaf, 0x94, 0x61, 0xfd, 0x85, 0x82, 0xbe, 0xa7, 0x04, 0xe8, 0x29, 0xe0, 0x86, 0x5b, 0x1d,
-	0x8f, 0xd5, 0x50, 0x9b, 0x34, 0x82, 0xe9, 0x08, 0xbe, 0xe3, 0xa9, 0x2a, 0x78, 0x22, 0x8f, 0x9c,
-	0x86, 0x52, 0x93, 0x8f, 0xfe, 0x5c, 0xf0, 0x7a, 0xde, 0x38, 0x83, 0xbd, 0xc2, 0xa8, 0xfd, 0x49,
-	0x19, 0xd6, 0x7f, 0xa2, 0xa0, 0x1f, 0x29, 0x31, 0x82, 0x7b, 0x85, 0xb1, 0x4b, 0x3d, 0x97, 0x47,
-	0x50, 0x47, 0x63, 0x6a, 0x8f, 0x23, 0x3f, 0x65, 0x60, 0x09, 0x83, 0x27, 0x7b, 0x2e, 0x0c, 0x83,
-	0x67, 0xcc, 0x04, 0x24, 0x91, 0x1f, 0x66, 0xc8, 0x18, 0xf2, 0x6b, 0x99, 0xc8, 0xff, 0x83, 0x02,
-	0x6a, 0xda, 0x70, 0x82, 0xee, 0xa4, 0xf2, 0x63, 0x42, 0xda, 0xab, 0xf9, 0x84, 0xa5, 0xe9, 0x5f,
-	0x1d, 0xd6, 0x0d, 0xa4, 0x3f, 0x30, 0x59, 0xeb, 0x50, 0x6f, 0x87, 0xc8, 0x35, 0x16, 0xfc, 0xd5,
-	0xcd, 0x71, 0xdc, 0x75, 0x23, 0xf5, 0x11, 0x08, 0x85, 0xac, 0x1a, 0xf7, 0x48, 0xa6, 0x6d, 0xe5,
-	0x94, 0x96, 0x4e, 0x3c, 0x1e, 0xd6, 0x57, 0xd1, 0x4d, 0x5f, 0x2e, 0x2d, 0x03, 0x9f, 0x35, 0x36,
-	0x52, 0xed, 0x3f, 0x91, 0xba, 0x9e, 0x23, 0xbf, 0x57, 0x60, 0x25, 0x6b, 0xaa, 0x42, 0x3b, 0x19,
-	0xc0, 0x4a, 0x9a, 0xfc, 0xb4, 0xbb, 0xf9, 0x15, 0xa4, 0x47, 0x5f, 0x1a, 0xd6, 0x57, 0x90, 0x16,
-	0xa4, 0x2f, 0x1f, 0x3d, 0xbc, 0x6f, 0x08, 0x75, 0x01, 0x67, 0x0e, 0xf1, 0x15, 0xf4, 0x0b, 0x05,
-	0x16, 0x93, 0x86, 0x15, 0xf4, 0xc6, 0xf8, 0x71, 0x46, 0x98, 0x7b, 0x3b, 0xef, 0xdc, 0x63, 0xdc,
-	0xe7, 0x85, 0xdf, 0xc5, 0x8c, 0x83, 0x5c, 0x2c, 0xea, 0xa4, 0x13, 0xea, 0x12, 0x6e, 0xa0, 0xe5,
-	0x90, 0x95, 0x23, 0xc9, 0x07, 0x53, 0xcf, 0x0b, 0xbd, 0x83, 0x83, 0x69, 0x3e, 0x75, 0x7e, 0xe1,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x13, 0x03, 0x48, 0xff, 0x46, 0x25, 0x00, 0x00,
+	// 2059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x57, 0xdb, 0x71, 0x32, 0xf3, 0x1c, 0x67, 0x26, 0x35, 0xf9, 0xe8, 0xe9, 0xc9, 0x47, 0xa7,
+	0xb3, 0xec, 0x84, 0x8c, 0x93, 0x0c, 0xd9, 0x11, 0xbb, 0xcc, 0x6a, 0x10, 0x9e, 0xcc, 0x6a, 0x58,
+	0xed, 0x8c, 0x08, 0x4e, 0x76, 0x56, 0x9a, 0x8b, 0xd5, 0x71, 0x97, 0x9d, 0x56, 0x3a, 0x5d, 0xbd,
+	0xd5, 0xe5, 0x18, 0x73, 0x40, 0x2b, 0x76, 0x85, 0x04, 0x3b, 0x17, 0x8c, 0x10, 0x88, 0x03, 0x47,
+	0xe0, 0x84, 0xb8, 0x70, 0x40, 0x20, 0x81, 0x90, 0x40, 0xe2, 0x8c, 0xf8, 0x0f, 0xb8, 0x21, 0xce,
+	0x1c, 0x41, 0x5d, 0x5d, 0x6d, 0x77, 0xbb, 0xbb, 0xed, 0x4e, 0xe2, 0x59, 0x04, 0xbb, 0xa7, 0xb8,
+	0xab, 0x7e, 0x55, 0xf5, 0x3e, 0x7f, 0xf5, 0x5e, 0x05, 0x4a, 0xb4, 0x65, 0x33, 0xf3, 0x14, 0x6f,
+	0x3b, 0x94, 0x30, 0x82, 0x80, 0x38, 0xd8, 0x76, 0x4c, 0x46, 0xcd, 0x6f, 0x28, 0x2b, 0x4d, 0x42,
+	0x9a, 0x16, 0xde, 0xe1, 0x33, 0x47, 0xad, 0xc6, 0x4e, 0x9b, 0xea, 0x8e, 0x83, 0xa9, 0xeb, 0x63,
+	0x95, 0xd5, 0xc1, 0x79, 0x6f, 0x1f, 0x97, 0xe9, 0xa7, 0x8e, 0x00, 0x2c, 0x09, 0x80, 0xee, 0x98,
+	0x3b, 0xba, 0x6d, 0x13, 0xa6, 0x33, 0x93, 0xd8, 0xc1, 0xf2, 0x32, 0xff, 0x53, 0xdf, 0x6a, 0x62,
+	0x7b, 0xcb, 0x6d, 0xeb, 0xcd, 0x26, 0xa6, 0x3b, 0xc4, 0xe1, 0x88, 0x38, 0x5a, 0xfb, 0x77, 0x01,
+	0x66, 0xab, 0xbe, 0xa8, 0x7b, 0x14, 0x1b, 0xd8, 0x66, 0xa6, 0x6e, 0xa1, 0x7d, 0x98, 0x17, 0xf2,
+	0xd7, 0xea, 0xbd, 0xd1, 0x9a, 0x69, 0xc8, 0x92, 0x2a, 0x6d, 0x14, 0x77, 0x97, 0xb6, 0x7d, 0x09,
+	0xb6, 0x03, 0x11, 0xb7, 0x0f, 0x18, 0x35, 0xed, 0xe6, 0x33, 0xdd, 0x6a, 0xe1, 0xea, 0x0d, 0x3a,
+	0xb8, 0xdf, 0xdb, 0x06, 0xba, 0x0b, 0x13, 0xb6, 0x7e, 0x8a, 0xe5, 0x5c, 0x86, 0x0d, 0x38, 0x12,
+	0x7d, 0x19, 0x8a, 0x06, 0x76, 0xeb, 0xd4, 0xe4, 0xb2, 0xcb, 0xf9, 0x0c, 0x0b, 0xc3, 0x0b, 0xd0,
+	0x03, 0x28, 0x06, 0x3a, 0xb4, 0xa8, 0x25, 0x4f, 0x64, 0x58, 0x0f, 0x62, 0xc1, 0xbb, 0xd4, 0x42,
+	0xcf, 0x41, 0x49, 0x30, 0x41, 0x9d, 0xd8, 0x0c, 0xdb, 0x4c, 0x2e, 0x64, 0xd8, 0x4d, 0x8e, 0xd9,
+	0x61, 0xcf, 0x5f, 0x8d, 0xde, 0x04, 0x20, 0x6d, 0x1b, 0xd3, 0x9a, 0xa3, 0xb3, 0x63, 0x79, 0x32,
+	0xc3, 0x5e, 0x57, 0x39, 0x7e, 0x5f, 0x67, 0xc7, 0xe8, 0x0d, 0xb8, 0xe2, 0x50, 0x72, 0x66, 0x1a,
+	0x98, 0xca, 0x53, 0x19, 0x96, 0xf6, 0xd0, 0xe8, 0x1e, 0x4c, 0xba, 0x4c, 0x67, 0x2d, 0x57, 0xbe,
+	0x92, 0x61, 0x9d, 0xc0, 0xa2, 0x37, 0xa1, 0x58, 0xa7, 0x58, 0x67, 0xb8, 0xe6, 0x29, 0x23, 0x5f,
+	0xe5, 0x4b, 0x95, 0xd8, 0xd2, 0xc3, 0x20, 0x48, 0xab, 0xe0, 0xc3, 0xbd, 0x01, 0x6f, 0xb1, 0xbf,
+	0x8d, 0xbf, 0x18, 0x46, 0x2f, 0xf6, 0xe1, 0x7c, 0xf1, 0x5d, 0x28, 0x18, 0xf8, 0xa8, 0xd5, 0x94,
+	0x8b, 0x29, 0xcb, 0x1e, 0x12, 0x62, 0xf9, 0xc2, 0xfa, 0x40, 0xb4, 0x0b, 0x05, 0x6e, 0x28, 0x79,
+	0x3a, 0x83, 0x82, 0x3e, 0x54, 0xfb, 0x4b, 0x01, 0xa6, 0x44, 0x06, 0x78, 0x8e, 0x09, 0x9c, 0x9e,
+	0x31, 0xd8, 0xaf, 0x0a, 0xfc, 0x7f, 0x25, 0xc4, 0xc3, 0xa1, 0x30, 0x71, 0xae, 0x50, 0x48, 0x4d,
+	0xf0, 0xc2, 0x25, 0x12, 0xfc, 0x9b, 0xc4, 0xc6, 0x99, 0xa2, 0x99, 0x23, 0x07, 0xb2, 0x60, 0xea,
+	0x7c, 0x59, 0xf0, 0x59, 0x2c, 0x27, 0xc5, 0xf2, 0x0b, 0x09, 0x4a, 0x22, 0x96, 0x1f, 0x61, 0xa6,
+	0x9b, 0x16, 0xda, 0x82, 0x29, 0xe1, 0x2d, 0x11, 0xce, 0x37, 0xb6, 0xfb, 0x57, 0xd1, 0xb6, 0xc0,
+	0x56, 0x03, 0x0c, 0x7a, 0x02, 0x28, 0x1e, 0x17, 0x22, 0xa2, 0x97, 0x13, 0x56, 0xf6, 0x43, 0xa0,
+	0x3a, 0x1b, 0x8b, 0x0a, 0xed, 0xc3, 0x3c, 0xcc, 0xed, 0x71, 0x03, 0x06, 0x07, 0xe1, 0xf7, 0x5b,
+	0xd8, 0x65, 0xbd, 0x54, 0x91, 0x2e, 0x9a, 0x2a, 0xb9, 0xcb, 0xa4, 0x4a, 0x7e, 0x3c, 0xa9, 0x32,
+	0x71, 0xd9, 0x54, 0x29, 0x9c, 0x27, 0x55, 0x42, 0xbc, 0x04, 0xe7, 0xe2, 0x25, 0xed, 0x10, 0xe6,
+	0x07, 0x9c, 0xe0, 0x3a, 0xc4, 0x76, 0x2f, 0xc7, 0x76, 0xda, 0x6f, 0xf3, 0xb0, 0xf8, 0x88, 0x1b,
+	0xf8, 0x28, 0xd8, 0xd8, 0x0d, 0xdc, 0xfb, 0x00, 0x8a, 0x2e, 0xd6, 0x69, 0xfd, 0xb8, 0xd6, 0x26,
+	0x34, 0xdb, 0xce, 0xe0, 0x2f, 0x78, 0x8f, 0x50, 0x03, 0xbd, 0x0e, 0x57, 0x5c, 0x42, 0x59, 0xed,
+	0x04, 0x77, 0x32, 0x39, 0x7a, 0xca, 0x43, 0xbf, 0x83, 0x3b, 0xe8, 0x1e, 0x4c, 0x51, 0x7c, 0x86,
+	0xa9, 0x8b, 0xe5, 0xfc, 0xc8, 0x34, 0x0b, 0xa0, 0x68, 0x0e, 0x0a, 0x96, 0x79, 0x6a, 0x32, 0xee,
+	0xd0, 0x52, 0xd5, 0xff, 0x40, 0x0b, 0x30, 0x49, 0x1a, 0x0d, 0x17, 0xfb, 0x77, 0x7d, 0xa9, 0x2a,
+	0xbe, 0xd0, 0x6d, 0xb8, 0x66, 0x98, 0xae, 0x63, 0xe9, 0x9d, 0x5a, 0x9d, 0x58, 0xad, 0x53, 0xdb,
+	0x95, 0x27, 0xd5, 0xfc, 0xc6, 0xd5, 0xea, 0x8c, 0x18, 0xde, 0xf3, 0x47, 0xd1, 0x72, 0xc4, 0xba,
+	0x45, 0x8e, 0x09, 0xdd, 0x16, 0x0b, 0x3d, 0x02, 0x9b, 0xe6, 0x53, 0xe2, 0x0b, 0x29, 0xa1, 0x40,
+	0x2d, 0xf1, 0x99, 0x7e, 0x28, 0xce, 0x05, 0x94, 0x30, 0xc3, 0x27, 0xfc, 0x0f, 0xb4, 0x9b, 0x16,
+	0xa0, 0xd7, 0x38, 0x2a, 0x29, 0x04, 0xb5, 0xf7, 0x41, 0x8e, 0x3b, 0x4f, 0x84, 0xc5, 0x2a, 0x14,
+	0x19, 0x61, 0xbc, 0xd8, 0x69, 0xd9, 0x8c, 0x7b, 0xaf, 0x54, 0x05, 0x3e, 0xb4, 0xe7, 0x8d, 0xa0,
+	0x7b, 0xfd, 0xca, 0xca, 0xb3, 0x4f, 0x4e, 0xcd, 0xa7, 0xf1, 0x4a, 0x60, 0x81, 0x03, 0xcc, 0xb4,
+	0xef, 0x49, 0xb0, 0x32, 0x70, 0xa6, 0xcf, 0x51, 0xe7, 0x38, 0xf9, 0x71, 0x9f, 0x9e, 0x0c, 0xbe,
+	0x36, 0x24, 0xc0, 0xcd, 0x04, 0x01, 0xfc, 0x03, 0xaa, 0xd7, 0x69, 0xf8, 0xd3, 0x13, 0xe6, 0x47,
+	0x39, 0x98, 0x7b, 0x4a, 0x0c, 0xb3, 0xd1, 0x19, 0x60, 0xa6, 0xff, 0xb1, 0x0a, 0x60, 0xec, 0xe4,
+	0xe4, 0xb1, 0xc5, 0x80, 0x61, 0xc6, 0xc1, 0x16, 0x1f, 0x4b, 0x30, 0xff, 0x08, 0x5b, 0x98, 0xc5,
+	0xb8, 0x62, 0x79, 0x60, 0xdb, 0x81, 0x34, 0xb9, 0x0b, 0x85, 0x06, 0xa1, 0xf5, 0xc0, 0xa6, 0x43,
+	0xef, 0x4d, 0x0e, 0x44, 0x6b, 0x30, 0xdd, 0xa4, 0x7a, 0x1d, 0xd7, 0x1c, 0x4c, 0x4d, 0x62, 0x70,
+	0x9b, 0x96, 0xaa, 0x45, 0x3e, 0xb6, 0xcf, 0x87, 0xb4, 0xd7, 0x61, 0x61, 0x50, 0x18, 0xa1, 0xe4,
+	0x70, 0x69, 0xb4, 0xdf, 0xe4, 0x61, 0x25, 0xc2, 0xa5, 0xa1, 0xfb, 0xaf, 0xcf, 0x7d, 0xe1, 0xb6,
+	0x43, 0x1a, 0x6b, 0xdb, 0x91, 0xbb, 0x54, 0xdb, 0x71, 0xf1, 0x3b, 0x30, 0x08, 0xec, 0x89, 0x8b,
+	0x06, 0x76, 0x61, 0x6c, 0x81, 0x0d, 0x17, 0x0d, 0x6c, 0x17, 0x56, 0x53, 0x5d, 0x27, 0xbc, 0x3f,
+	0xf6, 0xb6, 0x57, 0xfb, 0x97, 0x04, 0xea, 0x33, 0xdd, 0x32, 0x8d, 0x4f, 0x5b, 0xc8, 0x68, 0x5f,
+	0x83, 0xb5, 0x21, 0x8a, 0x0b, 0x83, 0x6f, 0x42, 0x8e, 0x9c, 0xc8, 0xd2, 0xc8, 0xd4, 0xce, 0x91,
+	0x13, 0xed, 0x67, 0x79, 0x58, 0x1b, 0xb8, 0x3f, 0xfa, 0x3b, 0x7e, 0x56, 0x7a, 0x84, 0x4b, 0x8f,
+	0xd4, 0x8a, 0xa0, 0x98, 0x5a, 0x11, 0x8c, 0xaf, 0x1e, 0xd1, 0x7e, 0x22, 0x81, 0x36, 0xcc, 0x51,
+	0x59, 0x2f, 0xfb, 0x03, 0x58, 0x48, 0xd0, 0xa2, 0x7f, 0xe1, 0x8f, 0xe8, 0x47, 0xe6, 0x62, 0x5a,
+	0x7a, 0x17, 0xff, 0x1f, 0x72, 0xb0, 0x12, 0xb9, 0xdf, 0xe2, 0xe9, 0xf8, 0xff, 0xf0, 0xf8, 0x35,
+	0x9c, 0x13, 0x26, 0x2e, 0xc3, 0x09, 0x1e, 0x91, 0xa6, 0x5a, 0xf0, 0xa5, 0x11, 0xe9, 0xbb, 0xb0,
+	0x1a, 0xb9, 0xb2, 0x13, 0x52, 0x7f, 0x37, 0xfd, 0xd0, 0xd4, 0x3a, 0xf8, 0x19, 0xa8, 0xe9, 0xdb,
+	0x0a, 0x65, 0x2e, 0xb2, 0x6f, 0x1b, 0xd6, 0x07, 0x52, 0x60, 0x5f, 0x24, 0xcd, 0x73, 0x62, 0xf7,
+	0x8b, 0x9f, 0xf1, 0xdb, 0xe9, 0x85, 0x04, 0xaf, 0x0c, 0x3f, 0xf9, 0x65, 0xb9, 0x08, 0x21, 0xd1,
+	0xd6, 0xe6, 0xb8, 0x59, 0xf8, 0x6f, 0x6d, 0x1f, 0x94, 0x2a, 0x61, 0x3a, 0x0b, 0x21, 0xdf, 0xc1,
+	0x9d, 0xcb, 0x78, 0xec, 0x3b, 0x12, 0xdc, 0x4a, 0xdc, 0x52, 0xe8, 0xf5, 0x1a, 0x4c, 0x9e, 0xe0,
+	0x4e, 0x56, 0x45, 0x0a, 0x27, 0xb8, 0xf3, 0xb6, 0x91, 0x2e, 0x48, 0x2e, 0x5d, 0x90, 0x65, 0xb8,
+	0xf5, 0x18, 0x33, 0x61, 0xe3, 0x03, 0xa6, 0x33, 0xd3, 0x65, 0x66, 0x3d, 0x70, 0xad, 0xf6, 0xb7,
+	0x3c, 0x2c, 0x25, 0xcf, 0x0b, 0x41, 0x5d, 0x98, 0xb7, 0x74, 0x97, 0xd5, 0x58, 0x9b, 0xd4, 0xda,
+	0x18, 0x9f, 0xd4, 0xfc, 0xa7, 0x26, 0x5f, 0xf9, 0xe2, 0xee, 0x57, 0xc2, 0xec, 0x36, 0x6c, 0xa3,
+	0xed, 0x27, 0xba, 0xcb, 0x0e, 0xdb, 0xe4, 0x3d, 0x8c, 0x4f, 0xfc, 0x02, 0xc7, 0x78, 0xcb, 0x66,
+	0xb4, 0x53, 0x45, 0x56, 0x6c, 0x02, 0x99, 0x30, 0xcb, 0x88, 0x53, 0x63, 0xd8, 0xae, 0x05, 0x2c,
+	0xee, 0x0a, 0x3a, 0x7d, 0x90, 0xf9, 0xc0, 0x43, 0xe2, 0x1c, 0x62, 0x3b, 0x08, 0x2b, 0xd7, 0x3f,
+	0xed, 0x1a, 0x8b, 0x8e, 0xa2, 0xf5, 0xde, 0xff, 0x40, 0x04, 0xc3, 0xfb, 0x85, 0xf8, 0x74, 0x60,
+	0x4b, 0x6f, 0x0c, 0x7d, 0x0e, 0x66, 0x02, 0x39, 0x04, 0xca, 0xbf, 0x09, 0x4b, 0xc1, 0x28, 0x87,
+	0x29, 0x6f, 0xc1, 0x62, 0x8a, 0x96, 0xe8, 0x3a, 0xe4, 0xbd, 0xcb, 0xda, 0x73, 0xf6, 0xd5, 0xaa,
+	0xf7, 0xd3, 0xbb, 0x95, 0xce, 0x3c, 0xe7, 0x72, 0xba, 0x2d, 0x55, 0xfd, 0x8f, 0xfb, 0xb9, 0x37,
+	0x24, 0xe5, 0x21, 0xcc, 0x25, 0xc9, 0x7e, 0x9e, 0x3d, 0x76, 0x7f, 0xae, 0xc0, 0x8c, 0x30, 0xcd,
+	0x53, 0xdd, 0xd6, 0x9b, 0x98, 0xa2, 0x0f, 0x24, 0x28, 0x45, 0x4a, 0x4b, 0xa4, 0x86, 0x6d, 0x99,
+	0xf4, 0x02, 0xa6, 0xac, 0x0d, 0x41, 0xf8, 0x66, 0xd6, 0x36, 0xbb, 0x95, 0xeb, 0x68, 0xc6, 0x9f,
+	0x53, 0x85, 0xd5, 0xbe, 0xfd, 0xd7, 0xbf, 0xff, 0x20, 0x37, 0xab, 0x4d, 0xef, 0x9c, 0x7d, 0x61,
+	0x47, 0x0c, 0xb9, 0xf7, 0xa5, 0x4d, 0xf4, 0x7d, 0x09, 0x90, 0x8f, 0x7c, 0xe4, 0x3d, 0x1e, 0x8e,
+	0x55, 0x8e, 0x2f, 0x76, 0x2b, 0x0b, 0x48, 0xbc, 0xe3, 0xa9, 0xfc, 0x6d, 0x32, 0x22, 0xcd, 0xa2,
+	0x86, 0x3c, 0x69, 0xf8, 0x44, 0x2d, 0x2c, 0x93, 0x09, 0x0b, 0x03, 0x4c, 0x24, 0xfa, 0x7d, 0xb4,
+	0x1e, 0x3e, 0x34, 0xe5, 0x11, 0x49, 0xd9, 0x1c, 0x02, 0x1a, 0x7c, 0x38, 0xf8, 0x87, 0x04, 0xd7,
+	0x07, 0xf7, 0xc9, 0x76, 0xca, 0x2b, 0xc3, 0x41, 0xc2, 0x04, 0x1f, 0x4b, 0xdd, 0x0a, 0x43, 0xf4,
+	0x31, 0x66, 0x81, 0xea, 0x6e, 0x59, 0xad, 0xeb, 0xb6, 0xda, 0x30, 0x2d, 0x86, 0xa9, 0xda, 0x36,
+	0xd9, 0xb1, 0xca, 0x8e, 0xb1, 0x8b, 0xd5, 0x86, 0x89, 0x2d, 0xc3, 0xdd, 0xe8, 0x77, 0x91, 0x65,
+	0x35, 0x88, 0xe5, 0xb2, 0xea, 0x71, 0x62, 0x59, 0xf5, 0x2b, 0xab, 0xb2, 0xca, 0xcb, 0xa5, 0xcf,
+	0x97, 0x55, 0x03, 0x37, 0xf4, 0x96, 0xc5, 0x54, 0x8a, 0x59, 0x8b, 0xda, 0xaa, 0x6e, 0x59, 0xbd,
+	0x53, 0xb8, 0x85, 0x67, 0x50, 0xc4, 0xdf, 0xe8, 0xc3, 0x1c, 0xcc, 0x07, 0xa2, 0x86, 0xdd, 0x3d,
+	0x56, 0x95, 0x7f, 0x2a, 0x75, 0x2b, 0x1f, 0x48, 0xe8, 0x5b, 0x9e, 0xce, 0x11, 0xa7, 0xbf, 0x64,
+	0xcd, 0xa3, 0x67, 0x71, 0xfd, 0xe7, 0x50, 0x42, 0x84, 0xf1, 0xac, 0x8b, 0xd4, 0x21, 0xd1, 0x68,
+	0x4f, 0x7a, 0xdd, 0x51, 0xd6, 0x86, 0x20, 0x22, 0x59, 0xe7, 0xcf, 0x45, 0xb3, 0x6e, 0x37, 0x96,
+	0x75, 0x1f, 0x49, 0x30, 0x13, 0x7d, 0x48, 0x40, 0x6b, 0x51, 0xe3, 0x26, 0xbc, 0x78, 0x28, 0xda,
+	0x30, 0x88, 0x90, 0xe2, 0x4e, 0xb7, 0x32, 0x8b, 0xae, 0xf9, 0x93, 0x51, 0x63, 0xcc, 0x6e, 0xc6,
+	0xc4, 0xf8, 0xb5, 0x04, 0x8b, 0x29, 0xad, 0x2d, 0xda, 0x4c, 0xcd, 0xef, 0x58, 0xe1, 0xab, 0xdc,
+	0xc9, 0x84, 0x15, 0x12, 0x56, 0xba, 0x95, 0x5b, 0xe8, 0x66, 0x3d, 0xc2, 0x4e, 0x6a, 0xff, 0xf2,
+	0xe4, 0xb2, 0x2e, 0x6b, 0x72, 0x58, 0xd6, 0x9d, 0xfe, 0x34, 0x97, 0xfb, 0xcf, 0x12, 0x2c, 0xc5,
+	0x49, 0xeb, 0x93, 0x10, 0xfe, 0x69, 0xb7, 0xb2, 0x86, 0x56, 0x93, 0x28, 0x6d, 0x50, 0x85, 0x75,
+	0x6d, 0x25, 0x1e, 0x7b, 0x83, 0x8a, 0xfc, 0x32, 0x07, 0x4a, 0x7a, 0xc7, 0x83, 0xb6, 0x86, 0x24,
+	0x5c, 0xbc, 0x8e, 0x55, 0xb6, 0xb3, 0xc2, 0x85, 0x32, 0xbf, 0x92, 0xba, 0x95, 0x17, 0x12, 0xfa,
+	0xae, 0x14, 0xa2, 0xa7, 0x90, 0x1a, 0x6e, 0x79, 0x74, 0xae, 0x46, 0x8a, 0x9e, 0x7e, 0x9a, 0xf6,
+	0xd3, 0x77, 0x34, 0x55, 0x85, 0x4f, 0xe4, 0x96, 0x53, 0x50, 0xaa, 0xf3, 0xd1, 0x9f, 0x72, 0xb0,
+	0x1a, 0x68, 0x96, 0xec, 0xfb, 0x97, 0x6e, 0xb5, 0x3f, 0x4a, 0xdd, 0xca, 0x8f, 0x25, 0xf4, 0x43,
+	0x29, 0x46, 0x70, 0x9f, 0xa0, 0xed, 0x52, 0xcf, 0xe5, 0x16, 0x54, 0xd1, 0x88, 0xd8, 0xe3, 0x99,
+	0x9f, 0xd2, 0x8b, 0x45, 0x93, 0x67, 0x78, 0xcb, 0xab, 0xdc, 0xc9, 0x84, 0x8d, 0x64, 0x7e, 0x94,
+	0x21, 0x63, 0x99, 0xbf, 0x3b, 0x34, 0xf3, 0x7f, 0x2f, 0x81, 0xec, 0x93, 0x5b, 0x82, 0xe3, 0xef,
+	0xa4, 0xf2, 0x63, 0x82, 0xdb, 0xcb, 0xd9, 0xc0, 0x42, 0xf4, 0xaf, 0x76, 0x2b, 0x1a, 0x52, 0x1f,
+	0xea, 0xac, 0x7e, 0xac, 0x1a, 0x11, 0x72, 0x8d, 0x19, 0x7f, 0x79, 0x73, 0x14, 0x77, 0xdd, 0x4c,
+	0x7d, 0xdf, 0x42, 0x11, 0xa9, 0x46, 0xbd, 0xff, 0x29, 0x5b, 0x19, 0xd1, 0x42, 0x89, 0x27, 0xdd,
+	0xca, 0x32, 0xba, 0x15, 0xe0, 0xd2, 0x3c, 0xf0, 0xaa, 0xb6, 0x96, 0x2a, 0xff, 0x99, 0x58, 0xeb,
+	0x29, 0xf2, 0x3b, 0x09, 0x96, 0x86, 0x35, 0x8c, 0x68, 0x67, 0x48, 0x62, 0x25, 0x35, 0xb5, 0xca,
+	0xdd, 0xec, 0x0b, 0x84, 0x46, 0x5f, 0xea, 0x56, 0x96, 0x90, 0x12, 0xa6, 0xaf, 0x20, 0x7b, 0x78,
+	0xdd, 0x10, 0xa9, 0x02, 0x7a, 0x0a, 0xf1, 0x19, 0xf4, 0x0b, 0x09, 0xe6, 0x92, 0x9a, 0x15, 0x74,
+	0x7b, 0x74, 0x3b, 0xe3, 0x8b, 0xbb, 0x91, 0xb5, 0xef, 0xd1, 0x1e, 0xf0, 0xc0, 0x6f, 0x62, 0xa6,
+	0xba, 0xbd, 0x49, 0x95, 0x34, 0x22, 0x55, 0xc2, 0x4d, 0xb4, 0x18, 0x91, 0xb2, 0x8f, 0x44, 0xff,
+	0x94, 0xe0, 0x46, 0x42, 0xe7, 0x8a, 0x5e, 0x0d, 0x0b, 0x90, 0xde, 0x2d, 0x2b, 0xb7, 0x47, 0xe2,
+	0x84, 0x9c, 0x1f, 0x49, 0xdd, 0xca, 0x01, 0xfa, 0x7a, 0x15, 0x6f, 0x61, 0xbb, 0x4e, 0x3b, 0x4e,
+	0xe2, 0xad, 0xd0, 0x63, 0x34, 0xd5, 0xa1, 0xe6, 0xa9, 0x4e, 0x3b, 0xea, 0xa9, 0xee, 0x7a, 0x4c,
+	0x77, 0x82, 0x3b, 0x65, 0x95, 0xd8, 0x56, 0x47, 0x6d, 0x10, 0xaa, 0x36, 0x2d, 0x72, 0xa4, 0x5b,
+	0xaa, 0x6e, 0x9c, 0x9a, 0x36, 0x57, 0x70, 0xe3, 0xbe, 0xb4, 0xa9, 0xad, 0xa7, 0x86, 0x16, 0xe5,
+	0x72, 0x79, 0xef, 0xa7, 0x0f, 0x27, 0x9e, 0xe7, 0x9c, 0xa3, 0xa3, 0x49, 0xde, 0x77, 0xbf, 0xf6,
+	0x9f, 0x01, 0x00, 0x4a, 0x03, 0xb5, 0x84, 0x11, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1882,6 +1981,8 @@ type RuntimeManagerClient interface {
 	DescribeRuntimeProviderZones(ctx context.Context, in *DescribeRuntimeProviderZonesRequest, opts ...grpc.CallOption) (*DescribeRuntimeProviderZonesResponse, error)
 	// Get statistics of runtime
 	GetRuntimeStatistics(ctx context.Context, in *GetRuntimeStatisticsRequest, opts ...grpc.CallOption) (*GetRuntimeStatisticsResponse, error)
+	// Re-encrypt runtime credentials with the primary master key
+	RotateCredentialKey(ctx context.Context, in *RotateCredentialKeyRequest, opts ...grpc.CallOption) (*RotateCredentialKeyResponse, error)
 }
 
 type runtimeManagerClient struct {
@@ -2036,6 +2137,15 @@ func (c *runtimeManagerClient) GetRuntimeStatistics(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *runtimeManagerClient) RotateCredentialKey(ctx context.Context, in *RotateCredentialKeyRequest, opts ...grpc.CallOption) (*RotateCredentialKeyResponse, error) {
+	out := new(RotateCredentialKeyResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.RuntimeManager/RotateCredentialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuntimeManagerServer is the server API for RuntimeManager service.
 type RuntimeManagerServer interface {
 	// create runtime
@@ -2069,6 +2179,8 @@ type RuntimeManagerServer interface {
 	DescribeRuntimeProviderZones(context.Context, *DescribeRuntimeProviderZonesRequest) (*DescribeRuntimeProviderZonesResponse, error)
 	// Get statistics of runtime
 	GetRuntimeStatistics(context.Context, *GetRuntimeStatisticsRequest) (*GetRuntimeStatisticsResponse, error)
+	// Re-encrypt runtime credentials with the primary master key
+	RotateCredentialKey(context.Context, *RotateCredentialKeyRequest) (*RotateCredentialKeyResponse, error)
 }
 
 // UnimplementedRuntimeManagerServer can be embedded to have forward compatible implementations.
@@ -2123,6 +2235,9 @@ func (*UnimplementedRuntimeManagerServer) DescribeRuntimeProviderZones(ctx conte
 func (*UnimplementedRuntimeManagerServer) GetRuntimeStatistics(ctx context.Context, req *GetRuntimeStatisticsRequest) (*GetRuntimeStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeStatistics not implemented")
 }
+func (*UnimplementedRuntimeManagerServer) RotateCredentialKey(ctx context.Context, req *RotateCredentialKeyRequest) (*RotateCredentialKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredentialKey not implemented")
+}
 
 func RegisterRuntimeManagerServer(s *grpc.Server, srv RuntimeManagerServer) {
 	s.RegisterService(&_RuntimeManager_serviceDesc, srv)
@@ -2416,6 +2531,24 @@ func _RuntimeManager_GetRuntimeStatistics_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeManager_RotateCredentialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeManagerServer).RotateCredentialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.RuntimeManager/RotateCredentialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeManagerServer).RotateCredentialKey(ctx, req.(*RotateCredentialKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RuntimeManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.RuntimeManager",
 	HandlerType: (*RuntimeManagerServer)(nil),
@@ -2484,6 +2617,10 @@ var _RuntimeManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRuntimeStatistics",
 			Handler:    _RuntimeManager_GetRuntimeStatistics_Handler,
 		},
+		{
+			MethodName: "RotateCredentialKey",
+			Handler:    _RuntimeManager_RotateCredentialKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "runtime.proto",
@@ -492,6 +492,95 @@ func (m *WaitSubtaskResponse) GetTask() *Task {
 	return nil
 }
 
+type AbortSubtaskRequest struct {
+	// required, runtime id
+	RuntimeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// required, task to abort
+	Task                 *Task    `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortSubtaskRequest) Reset()         { *m = AbortSubtaskRequest{} }
+func (m *AbortSubtaskRequest) String() string { return proto.CompactTextString(m) }
+func (*AbortSubtaskRequest) ProtoMessage()    {}
+func (*AbortSubtaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2998074df425fa49, []int{10}
+}
+
+func (m *AbortSubtaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortSubtaskRequest.Unmarshal(m, b)
+}
+func (m *AbortSubtaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortSubtaskRequest.Marshal(b, m, deterministic)
+}
+func (m *AbortSubtaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortSubtaskRequest.Merge(m, src)
+}
+func (m *AbortSubtaskRequest) XXX_Size() int {
+	return xxx_messageInfo_AbortSubtaskRequest.Size(m)
+}
+func (m *AbortSubtaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortSubtaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortSubtaskRequest proto.InternalMessageInfo
+
+func (m *AbortSubtaskRequest) GetRuntimeId() *wrappers.StringValue {
+	if m != nil {
+		return m.RuntimeId
+	}
+	return nil
+}
+
+func (m *AbortSubtaskRequest) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type AbortSubtaskResponse struct {
+	// task aborted
+	Task                 *Task    `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortSubtaskResponse) Reset()         { *m = AbortSubtaskResponse{} }
+func (m *AbortSubtaskResponse) String() string { return proto.CompactTextString(m) }
+func (*AbortSubtaskResponse) ProtoMessage()    {}
+func (*AbortSubtaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2998074df425fa49, []int{11}
+}
+
+func (m *AbortSubtaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortSubtaskResponse.Unmarshal(m, b)
+}
+func (m *AbortSubtaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortSubtaskResponse.Marshal(b, m, deterministic)
+}
+func (m *AbortSubtaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortSubtaskResponse.Merge(m, src)
+}
+func (m *AbortSubtaskResponse) XXX_Size() int {
+	return xxx_messageInfo_AbortSubtaskResponse.Size(m)
+}
+func (m *AbortSubtaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortSubtaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortSubtaskResponse proto.InternalMessageInfo
+
+func (m *AbortSubtaskResponse) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
 type CheckResourceRequest struct {
 	// required, runtime id
 	RuntimeId *wrappers.StringValue `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
@@ -506,7 +595,7 @@ func (m *CheckResourceRequest) Reset()         { *m = CheckResourceRequest{} }
 func (m *CheckResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckResourceRequest) ProtoMessage()    {}
 func (*CheckResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{10}
+	return fileDescriptor_2998074df425fa49, []int{12}
 }
 
 func (m *CheckResourceRequest) XXX_Unmarshal(b []byte) error {
@@ -553,7 +642,7 @@ func (m *CheckResourceResponse) Reset()         { *m = CheckResourceResponse{} }
 func (m *CheckResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckResourceResponse) ProtoMessage()    {}
 func (*CheckResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{11}
+	return fileDescriptor_2998074df425fa49, []int{13}
 }
 
 func (m *CheckResourceResponse) XXX_Unmarshal(b []byte) error {
@@ -595,7 +684,7 @@ func (m *DescribeVpcRequest) Reset()         { *m = DescribeVpcRequest{} }
 func (m *DescribeVpcRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeVpcRequest) ProtoMessage()    {}
 func (*DescribeVpcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{12}
+	return fileDescriptor_2998074df425fa49, []int{14}
 }
 
 func (m *DescribeVpcRequest) XXX_Unmarshal(b []byte) error {
@@ -646,7 +735,7 @@ func (m *Eip) Reset()         { *m = Eip{} }
 func (m *Eip) String() string { return proto.CompactTextString(m) }
 func (*Eip) ProtoMessage()    {}
 func (*Eip) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{13}
+	return fileDescriptor_2998074df425fa49, []int{15}
 }
 
 func (m *Eip) XXX_Unmarshal(b []byte) error {
@@ -714,7 +803,7 @@ func (m *Vpc) Reset()         { *m = Vpc{} }
 func (m *Vpc) String() string { return proto.CompactTextString(m) }
 func (*Vpc) ProtoMessage()    {}
 func (*Vpc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{14}
+	return fileDescriptor_2998074df425fa49, []int{16}
 }
 
 func (m *Vpc) XXX_Unmarshal(b []byte) error {
@@ -803,7 +892,7 @@ func (m *DescribeVpcResponse) Reset()         { *m = DescribeVpcResponse{} }
 func (m *DescribeVpcResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeVpcResponse) ProtoMessage()    {}
 func (*DescribeVpcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{15}
+	return fileDescriptor_2998074df425fa49, []int{17}
 }
 
 func (m *DescribeVpcResponse) XXX_Unmarshal(b []byte) error {
@@ -845,7 +934,7 @@ func (m *DescribeClusterDetailsRequest) Reset()         { *m = DescribeClusterDe
 func (m *DescribeClusterDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeClusterDetailsRequest) ProtoMessage()    {}
 func (*DescribeClusterDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{16}
+	return fileDescriptor_2998074df425fa49, []int{18}
 }
 
 func (m *DescribeClusterDetailsRequest) XXX_Unmarshal(b []byte) error {
@@ -892,7 +981,7 @@ func (m *DescribeClusterDetailsResponse) Reset()         { *m = DescribeClusterD
 func (m *DescribeClusterDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeClusterDetailsResponse) ProtoMessage()    {}
 func (*DescribeClusterDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{17}
+	return fileDescriptor_2998074df425fa49, []int{19}
 }
 
 func (m *DescribeClusterDetailsResponse) XXX_Unmarshal(b []byte) error {
@@ -938,7 +1027,7 @@ func (m *ValidateRuntimeRequest) Reset()         { *m = ValidateRuntimeRequest{}
 func (m *ValidateRuntimeRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateRuntimeRequest) ProtoMessage()    {}
 func (*ValidateRuntimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{18}
+	return fileDescriptor_2998074df425fa49, []int{20}
 }
 
 func (m *ValidateRuntimeRequest) XXX_Unmarshal(b []byte) error {
@@ -999,7 +1088,7 @@ func (m *ValidateRuntimeResponse) Reset()         { *m = ValidateRuntimeResponse
 func (m *ValidateRuntimeResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidateRuntimeResponse) ProtoMessage()    {}
 func (*ValidateRuntimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{19}
+	return fileDescriptor_2998074df425fa49, []int{21}
 }
 
 func (m *ValidateRuntimeResponse) XXX_Unmarshal(b []byte) error {
@@ -1041,7 +1130,7 @@ func (m *DescribeZonesRequest) Reset()         { *m = DescribeZonesRequest{} }
 func (m *DescribeZonesRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeZonesRequest) ProtoMessage()    {}
 func (*DescribeZonesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{20}
+	return fileDescriptor_2998074df425fa49, []int{22}
 }
 
 func (m *DescribeZonesRequest) XXX_Unmarshal(b []byte) error {
@@ -1088,7 +1177,7 @@ func (m *DescribeZonesResponse) Reset()         { *m = DescribeZonesResponse{} }
 func (m *DescribeZonesResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeZonesResponse) ProtoMessage()    {}
 func (*DescribeZonesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2998074df425fa49, []int{21}
+	return fileDescriptor_2998074df425fa49, []int{23}
 }
 
 func (m *DescribeZonesResponse) XXX_Unmarshal(b []byte) error {
@@ -1127,6 +1216,8 @@ func init() {
 	proto.RegisterType((*HandleSubtaskResponse)(nil), "openpitrix.HandleSubtaskResponse")
 	proto.RegisterType((*WaitSubtaskRequest)(nil), "openpitrix.WaitSubtaskRequest")
 	proto.RegisterType((*WaitSubtaskResponse)(nil), "openpitrix.WaitSubtaskResponse")
+	proto.RegisterType((*AbortSubtaskRequest)(nil), "openpitrix.AbortSubtaskRequest")
+	proto.RegisterType((*AbortSubtaskResponse)(nil), "openpitrix.AbortSubtaskResponse")
 	proto.RegisterType((*CheckResourceRequest)(nil), "openpitrix.CheckResourceRequest")
 	proto.RegisterType((*CheckResourceResponse)(nil), "openpitrix.CheckResourceResponse")
 	proto.RegisterType((*DescribeVpcRequest)(nil), "openpitrix.DescribeVpcRequest")
@@ -1144,74 +1235,76 @@ func init() {
 func init() { proto.RegisterFile("runtime_provider.proto", fileDescriptor_2998074df425fa49) }
 
 var fileDescriptor_2998074df425fa49 = []byte{
-	// 1059 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0x86, 0xec, 0xfc, 0x7c, 0x41, 0xd0, 0x44, 0xf9, 0xa5, 0x09, 0x6d, 0x7e, 0xa8, 0x1d, 0xd0,
-	0x65, 0x8b, 0x53, 0x34, 0x3b, 0x14, 0x08, 0xb6, 0x43, 0xdd, 0x00, 0x73, 0xd1, 0x76, 0x81, 0x62,
-	0x64, 0x40, 0x80, 0x21, 0xa3, 0x24, 0xc6, 0x63, 0xe3, 0x90, 0x1c, 0x49, 0x3b, 0x6b, 0x8f, 0x3b,
-	0xec, 0xb2, 0xcb, 0xb0, 0xd3, 0x4e, 0xbd, 0xee, 0x1f, 0xdc, 0x1f, 0x30, 0x50, 0xa2, 0x62, 0x49,
-	0x96, 0x3d, 0xcd, 0x33, 0xb6, 0x53, 0x62, 0xf1, 0xfb, 0x1e, 0xdf, 0xf7, 0xf1, 0xf1, 0xf1, 0xc1,
-	0xa6, 0xe8, 0x51, 0x45, 0x6e, 0xf0, 0x25, 0x17, 0xac, 0x4f, 0x22, 0x2c, 0x1a, 0x5c, 0x30, 0xc5,
-	0x6c, 0x60, 0x1c, 0x53, 0x4e, 0x94, 0x20, 0x3f, 0xba, 0xdb, 0x1d, 0xc6, 0x3a, 0x5d, 0x7c, 0x18,
-	0xaf, 0x04, 0xbd, 0xab, 0xc3, 0x5b, 0x81, 0x38, 0xc7, 0x42, 0x26, 0x58, 0x77, 0xa7, 0xb8, 0xae,
-	0x03, 0x4a, 0x85, 0x6e, 0xb8, 0x01, 0xdc, 0x37, 0x00, 0xc4, 0xc9, 0x21, 0xa2, 0x94, 0x29, 0xa4,
-	0x08, 0xa3, 0x29, 0xfd, 0xb3, 0xf8, 0x4f, 0x78, 0xd0, 0xc1, 0xf4, 0x40, 0xde, 0xa2, 0x4e, 0x07,
-	0x8b, 0x43, 0xc6, 0x63, 0x44, 0x09, 0x7a, 0x39, 0xec, 0xf6, 0xa4, 0x4a, 0xf3, 0x74, 0x97, 0x4d,
-	0xfe, 0xe6, 0xe7, 0xe2, 0x5b, 0x16, 0x98, 0x7f, 0x41, 0x21, 0x79, 0x9d, 0xfc, 0xef, 0xfd, 0x6a,
-	0xc1, 0xb6, 0x8f, 0x3b, 0x44, 0x13, 0xfd, 0x84, 0x70, 0x6a, 0xf4, 0xfa, 0xf8, 0x87, 0x1e, 0x96,
-	0xca, 0x7e, 0x06, 0x0b, 0xa9, 0x05, 0x8e, 0xb5, 0x6b, 0x3d, 0x5e, 0x7a, 0x7a, 0xbf, 0x91, 0xa4,
-	0xdd, 0x48, 0x75, 0x35, 0xce, 0x94, 0x20, 0xb4, 0x73, 0x8e, 0xba, 0x3d, 0xec, 0xdf, 0xa1, 0xed,
-	0xcf, 0x61, 0x2e, 0x64, 0xf4, 0x8a, 0x74, 0x9c, 0x5a, 0x05, 0x9e, 0xc1, 0x7a, 0xaf, 0x61, 0x67,
-	0x64, 0x46, 0x92, 0x33, 0x2a, 0xb1, 0xbd, 0x0f, 0x35, 0x76, 0x6d, 0x92, 0x71, 0x87, 0x82, 0x3e,
-	0x67, 0xac, 0x9b, 0x84, 0xac, 0xb1, 0x6b, 0xef, 0x4f, 0x0b, 0xb6, 0x4e, 0x91, 0x90, 0xb8, 0x99,
-	0xd8, 0xd3, 0x64, 0xf4, 0x2a, 0x95, 0x76, 0x0c, 0x90, 0x9e, 0x32, 0x89, 0x2a, 0x89, 0x5b, 0x34,
-	0xf8, 0x56, 0xa4, 0xc9, 0x7d, 0x2c, 0x24, 0x61, 0x54, 0x93, 0xab, 0x28, 0x5c, 0x34, 0xf8, 0x56,
-	0x64, 0x3f, 0x81, 0x19, 0x2d, 0xd7, 0xa9, 0x57, 0xa0, 0xc5, 0x48, 0xfb, 0x00, 0xe6, 0xcd, 0x01,
-	0x3b, 0x33, 0x31, 0x69, 0xad, 0x31, 0xa8, 0xc4, 0x86, 0x11, 0xe7, 0xa7, 0x18, 0xaf, 0x05, 0xce,
-	0xb0, 0x6a, 0x63, 0x5f, 0x26, 0x94, 0x55, 0x21, 0xd4, 0x7b, 0x70, 0xce, 0x78, 0x97, 0xa8, 0x97,
-	0x2c, 0x68, 0x51, 0xc5, 0xda, 0x48, 0x5e, 0xcb, 0xa9, 0x38, 0xb8, 0x07, 0xf5, 0xb7, 0x2c, 0x30,
-	0xd6, 0xdd, 0xcb, 0xe6, 0xf0, 0x92, 0x05, 0xbe, 0x5e, 0xf3, 0x4e, 0xe1, 0xa3, 0x92, 0xbd, 0x8d,
-	0x8e, 0x23, 0x58, 0xd4, 0xa5, 0xfc, 0x0a, 0xbd, 0xbb, 0x53, 0xb2, 0x91, 0x8d, 0xd2, 0x4e, 0x17,
-	0xfd, 0x01, 0xce, 0x7b, 0x07, 0xeb, 0x5f, 0x21, 0x1a, 0x75, 0xf1, 0x59, 0x2f, 0xd0, 0x5f, 0xa7,
-	0xa2, 0xe4, 0x11, 0xcc, 0xe8, 0x58, 0x46, 0xca, 0x4a, 0x31, 0x09, 0x3f, 0x5e, 0xf5, 0xbe, 0x80,
-	0x8d, 0xc2, 0xd6, 0x46, 0x48, 0x4a, 0xb7, 0xc6, 0xd2, 0x6f, 0xc1, 0xfe, 0x06, 0x11, 0xf5, 0xdf,
-	0xe7, 0x7d, 0x0c, 0x6b, 0xb9, 0x8d, 0xff, 0x51, 0xd6, 0x3f, 0x59, 0xb0, 0xde, 0xfc, 0x1e, 0x87,
-	0x9a, 0xc7, 0x7a, 0x22, 0xc4, 0x53, 0x49, 0x3c, 0x53, 0xc2, 0xb5, 0x0a, 0x25, 0xdc, 0x84, 0x8d,
-	0x42, 0x0e, 0x13, 0x74, 0x92, 0x9f, 0x2d, 0xb0, 0x5f, 0x60, 0x19, 0x0a, 0x12, 0xe0, 0x73, 0x1e,
-	0x4e, 0x45, 0xc7, 0x11, 0xcc, 0xf5, 0x79, 0x58, 0xb5, 0x81, 0xcc, 0xf6, 0x79, 0xd8, 0x8a, 0xbc,
-	0x0f, 0x16, 0xd4, 0x4f, 0x08, 0xd7, 0x64, 0x4c, 0x78, 0xd5, 0x5d, 0x67, 0x31, 0xe1, 0x49, 0xe7,
-	0xa1, 0xe8, 0x06, 0x57, 0xda, 0x2f, 0x46, 0x6a, 0x06, 0x8a, 0x22, 0x51, 0xad, 0x57, 0x69, 0xa4,
-	0xf7, 0x47, 0x1d, 0xea, 0xe7, 0x3c, 0xcc, 0xa8, 0xb3, 0x2a, 0xab, 0x9b, 0x20, 0xc1, 0x63, 0x58,
-	0x0a, 0x05, 0x46, 0x0a, 0x5f, 0x6a, 0x57, 0x4d, 0x9e, 0xc3, 0xa7, 0xd9, 0x4e, 0x1f, 0x5f, 0x1f,
-	0x12, 0xb8, 0xfe, 0x60, 0x7f, 0x09, 0x4b, 0x51, 0x7c, 0xa8, 0xf1, 0xc3, 0x6a, 0x7a, 0xeb, 0xf8,
-	0x5d, 0xb3, 0x04, 0xfd, 0xc8, 0x49, 0x85, 0x54, 0x4f, 0x3a, 0xb3, 0x55, 0x1e, 0xb9, 0x04, 0x6b,
-	0xb7, 0x60, 0x55, 0x09, 0x44, 0x25, 0xd1, 0x31, 0x2e, 0x4d, 0x80, 0xb9, 0x0a, 0x01, 0x56, 0x06,
-	0xb4, 0xb3, 0x24, 0x94, 0x03, 0xf3, 0xb2, 0x17, 0x50, 0xac, 0xa4, 0x33, 0xbf, 0x5b, 0x7f, 0xbc,
-	0xe8, 0xa7, 0x3f, 0x75, 0x7f, 0xc5, 0x84, 0x3b, 0x0b, 0xc3, 0xfd, 0xf5, 0x84, 0x70, 0x5f, 0xaf,
-	0x79, 0xcf, 0x60, 0x2d, 0x57, 0xd2, 0xe6, 0x5a, 0xec, 0x41, 0xbd, 0xcf, 0x43, 0x73, 0x6a, 0x39,
-	0xa6, 0x46, 0xe9, 0x35, 0xef, 0x17, 0x0b, 0x1e, 0xa4, 0x54, 0x73, 0xdf, 0x5e, 0x60, 0x85, 0x48,
-	0x57, 0xfe, 0x1f, 0x17, 0xfc, 0x6b, 0xd8, 0x1e, 0x95, 0xcc, 0x64, 0x8f, 0xde, 0x6f, 0x35, 0xd8,
-	0x3c, 0x47, 0x5d, 0x12, 0x21, 0x85, 0xcd, 0x18, 0x32, 0x15, 0x5d, 0x4f, 0x60, 0xe6, 0x3d, 0xa3,
-	0x15, 0xab, 0x5b, 0x23, 0xed, 0x57, 0x60, 0xa7, 0xdb, 0x85, 0x02, 0x47, 0x98, 0x2a, 0x82, 0xba,
-	0xa6, 0xc8, 0x1f, 0x64, 0x35, 0x98, 0x34, 0x9b, 0x77, 0x20, 0x7f, 0x55, 0x14, 0x3f, 0xe9, 0xbb,
-	0x42, 0x31, 0x8e, 0x2e, 0x93, 0x1b, 0x60, 0xca, 0x7d, 0x5c, 0xe7, 0x03, 0x0d, 0x6f, 0xc6, 0x68,
-	0xef, 0x04, 0xb6, 0x86, 0x3c, 0x99, 0xa0, 0x91, 0x7e, 0xb0, 0x60, 0x3d, 0x3d, 0xad, 0x0b, 0x46,
-	0xb1, 0xfc, 0xf7, 0xa3, 0x66, 0xb9, 0x49, 0xb5, 0xc9, 0x4c, 0xf2, 0x0e, 0x60, 0xa3, 0x90, 0x9f,
-	0x51, 0xb9, 0x0e, 0xb3, 0xfa, 0x4c, 0xa4, 0x63, 0xc5, 0x37, 0x2d, 0xf9, 0xf1, 0xf4, 0xf7, 0x05,
-	0xd8, 0x2c, 0x8c, 0xaa, 0xaf, 0x11, 0x45, 0x1d, 0x2c, 0x6c, 0x01, 0x5b, 0x23, 0x86, 0x59, 0x7b,
-	0x3f, 0x97, 0xd6, 0xd8, 0x19, 0xdc, 0xfd, 0xb4, 0x12, 0xd6, 0x24, 0xf9, 0x2d, 0xac, 0x14, 0x47,
-	0x3f, 0xfb, 0x61, 0x36, 0xc0, 0x88, 0x71, 0xd8, 0x7d, 0x34, 0x1e, 0x64, 0xc2, 0x7f, 0x07, 0xab,
-	0x43, 0x23, 0x99, 0x9d, 0xa3, 0x8e, 0x9a, 0x16, 0xdd, 0x8f, 0xff, 0x06, 0x65, 0x76, 0x68, 0xc3,
-	0x72, 0x6e, 0x4e, 0xb2, 0x77, 0xb3, 0xbc, 0xb2, 0xe9, 0xcd, 0xdd, 0x1b, 0x83, 0x30, 0x51, 0xdf,
-	0xc0, 0x52, 0x66, 0x8a, 0xb1, 0xb7, 0xb3, 0x8c, 0xe1, 0xb9, 0xca, 0xdd, 0x19, 0xb9, 0x6e, 0xe2,
-	0x5d, 0xc0, 0xbd, 0xb4, 0x48, 0xce, 0x4c, 0xc3, 0xf5, 0xb2, 0x9c, 0xc2, 0x62, 0x1a, 0xf7, 0xe1,
-	0x58, 0xcc, 0xc0, 0x81, 0xdc, 0xbc, 0x92, 0x77, 0xa0, 0x6c, 0x9c, 0xca, 0x3b, 0x50, 0x3e, 0xec,
-	0xbc, 0x81, 0xa5, 0x4c, 0xb3, 0xcf, 0x3b, 0x30, 0x3c, 0xd8, 0xe4, 0x1d, 0x28, 0x7b, 0x25, 0x18,
-	0x6c, 0x96, 0x37, 0x5d, 0xfb, 0x93, 0x32, 0x6a, 0xe9, 0x2b, 0xe1, 0xee, 0x57, 0x81, 0x0e, 0x2c,
-	0x2f, 0xf4, 0x9f, 0xbc, 0xe5, 0xe5, 0x0d, 0x3b, 0x6f, 0xf9, 0xa8, 0x06, 0xd6, 0x86, 0xe5, 0xdc,
-	0x9d, 0xcf, 0x5b, 0x5e, 0xd6, 0xae, 0xf2, 0x96, 0x97, 0x36, 0x8c, 0xe7, 0x33, 0x17, 0x35, 0x1e,
-	0x04, 0x73, 0x71, 0xf7, 0x3a, 0xfa, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x66, 0xae, 0xc1, 0x8e, 0x54,
-	0x10, 0x00, 0x00,
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5d, 0x6f, 0xdb, 0x36,
+	0x14, 0x85, 0x6c, 0xe7, 0xeb, 0x66, 0x41, 0x13, 0xe5, 0x4b, 0x13, 0xda, 0x7c, 0x28, 0x1d, 0xd0,
+	0x65, 0x8b, 0x53, 0x34, 0x7b, 0x28, 0x90, 0x6d, 0xc0, 0xea, 0x06, 0x98, 0x8b, 0x76, 0xcb, 0x94,
+	0x20, 0x03, 0x02, 0x0c, 0x19, 0x25, 0x31, 0x1e, 0x1b, 0x87, 0xe4, 0x48, 0x3a, 0x69, 0xfb, 0xb8,
+	0x87, 0xbd, 0xec, 0x65, 0xd8, 0x0f, 0xe8, 0xeb, 0x7e, 0xdc, 0x5e, 0xf7, 0x03, 0x06, 0x4a, 0x54,
+	0x2c, 0xd9, 0x92, 0xa7, 0x66, 0xc6, 0xfa, 0x14, 0xdb, 0xf7, 0x9c, 0xcb, 0x7b, 0x2e, 0xc9, 0xcb,
+	0x13, 0x58, 0x11, 0x3d, 0xaa, 0xc8, 0x25, 0x3e, 0xe3, 0x82, 0x5d, 0x91, 0x08, 0x8b, 0x26, 0x17,
+	0x4c, 0x31, 0x1b, 0x18, 0xc7, 0x94, 0x13, 0x25, 0xc8, 0x2b, 0x77, 0xad, 0xc3, 0x58, 0xa7, 0x8b,
+	0x77, 0xe3, 0x48, 0xd0, 0x3b, 0xdf, 0xbd, 0x16, 0x88, 0x73, 0x2c, 0x64, 0x82, 0x75, 0xd7, 0x07,
+	0xe3, 0x3a, 0xa1, 0x54, 0xe8, 0x92, 0x1b, 0xc0, 0x5d, 0x03, 0x40, 0x9c, 0xec, 0x22, 0x4a, 0x99,
+	0x42, 0x8a, 0x30, 0x9a, 0xd2, 0x3f, 0x8d, 0xff, 0x84, 0x3b, 0x1d, 0x4c, 0x77, 0xe4, 0x35, 0xea,
+	0x74, 0xb0, 0xd8, 0x65, 0x3c, 0x46, 0x14, 0xa0, 0xe7, 0xc2, 0x6e, 0x4f, 0xaa, 0xb4, 0x4e, 0x77,
+	0xce, 0xd4, 0x6f, 0xbe, 0xce, 0xbc, 0x64, 0x81, 0xf9, 0x08, 0x0a, 0xc9, 0x8b, 0xe4, 0xb3, 0xf7,
+	0xbb, 0x05, 0x6b, 0x3e, 0xee, 0x10, 0x4d, 0xf4, 0x13, 0xc2, 0xa1, 0xd1, 0xeb, 0xe3, 0x9f, 0x7b,
+	0x58, 0x2a, 0xfb, 0x31, 0x4c, 0xa7, 0x2d, 0x70, 0xac, 0x0d, 0xeb, 0xc1, 0xec, 0xa3, 0xbb, 0xcd,
+	0xa4, 0xec, 0x66, 0xaa, 0xab, 0x79, 0xa4, 0x04, 0xa1, 0x9d, 0x13, 0xd4, 0xed, 0x61, 0xff, 0x06,
+	0x6d, 0x7f, 0x06, 0x93, 0x21, 0xa3, 0xe7, 0xa4, 0xe3, 0xd4, 0x2a, 0xf0, 0x0c, 0xd6, 0x7b, 0x01,
+	0xeb, 0xa5, 0x15, 0x49, 0xce, 0xa8, 0xc4, 0xf6, 0x36, 0xd4, 0xd8, 0x85, 0x29, 0xc6, 0x1d, 0x4a,
+	0xfa, 0x84, 0xb1, 0x6e, 0x92, 0xb2, 0xc6, 0x2e, 0xbc, 0xbf, 0x2d, 0x58, 0x3d, 0x44, 0x42, 0xe2,
+	0x56, 0xd2, 0x9e, 0x16, 0xa3, 0xe7, 0xa9, 0xb4, 0x7d, 0x80, 0x74, 0x97, 0x49, 0x54, 0x49, 0xdc,
+	0x8c, 0xc1, 0xb7, 0x23, 0x4d, 0xbe, 0xc2, 0x42, 0x12, 0x46, 0x35, 0xb9, 0x8a, 0xc2, 0x19, 0x83,
+	0x6f, 0x47, 0xf6, 0x43, 0x68, 0x68, 0xb9, 0x4e, 0xbd, 0x02, 0x2d, 0x46, 0xda, 0x3b, 0x30, 0x65,
+	0x36, 0xd8, 0x69, 0xc4, 0xa4, 0xc5, 0x66, 0xff, 0x24, 0x36, 0x8d, 0x38, 0x3f, 0xc5, 0x78, 0x6d,
+	0x70, 0x86, 0x55, 0x9b, 0xf6, 0x65, 0x52, 0x59, 0x15, 0x52, 0xbd, 0x01, 0xe7, 0x88, 0x77, 0x89,
+	0x7a, 0xc6, 0x82, 0x36, 0x55, 0xec, 0x18, 0xc9, 0x0b, 0x39, 0x96, 0x0e, 0x6e, 0x42, 0xfd, 0x25,
+	0x0b, 0x4c, 0xeb, 0xee, 0x64, 0x6b, 0x78, 0xc6, 0x02, 0x5f, 0xc7, 0xbc, 0x43, 0xf8, 0xb0, 0x60,
+	0x6d, 0xa3, 0x63, 0x0f, 0x66, 0xf4, 0x51, 0x7e, 0x8e, 0x5e, 0xdf, 0x28, 0x59, 0xce, 0x66, 0x39,
+	0x4e, 0x83, 0x7e, 0x1f, 0xe7, 0xbd, 0x86, 0xa5, 0xaf, 0x11, 0x8d, 0xba, 0xf8, 0xa8, 0x17, 0xe8,
+	0x5f, 0xc7, 0xa2, 0xe4, 0x3e, 0x34, 0x74, 0x2e, 0x23, 0x65, 0x7e, 0xb0, 0x08, 0x3f, 0x8e, 0x7a,
+	0x5f, 0xc0, 0xf2, 0xc0, 0xd2, 0x46, 0x48, 0x4a, 0xb7, 0x46, 0xd2, 0xaf, 0xc1, 0xfe, 0x1e, 0x11,
+	0xf5, 0xff, 0xd7, 0xbd, 0x0f, 0x8b, 0xb9, 0x85, 0xdf, 0xa9, 0xea, 0x57, 0xb0, 0xf8, 0x55, 0xc0,
+	0xc4, 0x7b, 0x28, 0xfb, 0x73, 0x58, 0xca, 0xaf, 0xfc, 0x4e, 0x75, 0xff, 0x62, 0xc1, 0x52, 0xeb,
+	0x27, 0x1c, 0x6a, 0x1e, 0xeb, 0x89, 0x10, 0x8f, 0xa5, 0xf2, 0xcc, 0xd5, 0xab, 0x55, 0xb8, 0x7a,
+	0x2d, 0x58, 0x1e, 0xa8, 0xe1, 0x16, 0x13, 0xf0, 0x57, 0x0b, 0xec, 0xa7, 0x58, 0x86, 0x82, 0x04,
+	0xf8, 0x84, 0x87, 0x63, 0xd1, 0xb1, 0x07, 0x93, 0x57, 0x3c, 0xac, 0x3a, 0xf8, 0x26, 0xae, 0x78,
+	0xd8, 0x8e, 0xbc, 0xb7, 0x16, 0xd4, 0x0f, 0x08, 0xd7, 0x64, 0x4c, 0x78, 0xd5, 0x55, 0x27, 0x30,
+	0xe1, 0xc9, 0xc4, 0xa4, 0xe8, 0x12, 0x57, 0x5a, 0x2f, 0x46, 0x6a, 0x06, 0x8a, 0x22, 0x51, 0x6d,
+	0xc6, 0x6a, 0xa4, 0xf7, 0x67, 0x1d, 0xea, 0x27, 0x3c, 0xcc, 0xa8, 0xb3, 0x2a, 0xab, 0xbb, 0x45,
+	0x81, 0xfb, 0x30, 0x1b, 0x0a, 0x8c, 0x14, 0x3e, 0xd3, 0x5d, 0x75, 0xea, 0x25, 0xbb, 0x79, 0x9c,
+	0x9a, 0x06, 0x1f, 0x12, 0xb8, 0xfe, 0xc1, 0xfe, 0x12, 0x66, 0xa3, 0x78, 0x53, 0x63, 0x43, 0xe0,
+	0x34, 0x2a, 0xac, 0x9a, 0x25, 0xe8, 0xc7, 0x59, 0x2a, 0xa4, 0x7a, 0xd2, 0x99, 0xa8, 0xf2, 0x38,
+	0x27, 0x58, 0xbb, 0x0d, 0x0b, 0x4a, 0x20, 0x2a, 0x89, 0xce, 0x71, 0x66, 0x12, 0x4c, 0x56, 0x48,
+	0x30, 0xdf, 0xa7, 0x1d, 0x25, 0xa9, 0x1c, 0x98, 0x92, 0xbd, 0x80, 0x62, 0x25, 0x9d, 0xa9, 0x8d,
+	0xfa, 0x83, 0x19, 0x3f, 0xfd, 0xaa, 0xdf, 0x05, 0x4c, 0xb8, 0x33, 0x3d, 0xfc, 0x2e, 0x1c, 0x10,
+	0xee, 0xeb, 0x98, 0xf7, 0x18, 0x16, 0x73, 0x47, 0xda, 0x5c, 0x8b, 0x4d, 0xa8, 0x5f, 0xf1, 0xd0,
+	0xb1, 0x86, 0x99, 0x1a, 0xa5, 0x63, 0xde, 0x6f, 0x16, 0xdc, 0x4b, 0xa9, 0xe6, 0xbe, 0x3d, 0xc5,
+	0x0a, 0x91, 0xae, 0x7c, 0x1f, 0x17, 0xfc, 0x5b, 0x58, 0x2b, 0x2b, 0xe6, 0x76, 0x8f, 0xf5, 0x1f,
+	0x35, 0x58, 0x39, 0x41, 0x5d, 0x12, 0x21, 0x85, 0x8d, 0x7d, 0x1a, 0x8b, 0xae, 0x87, 0xd0, 0x78,
+	0xc3, 0x68, 0xc5, 0xd3, 0xad, 0x91, 0xf6, 0x73, 0xb0, 0xd3, 0xe5, 0x42, 0x81, 0x23, 0x4c, 0x15,
+	0x41, 0x5d, 0x73, 0xc8, 0xef, 0x65, 0x35, 0x98, 0x32, 0x5b, 0x37, 0x20, 0x7f, 0x41, 0x0c, 0xfe,
+	0xa4, 0xef, 0x0a, 0xc5, 0x38, 0x3a, 0x4b, 0x6e, 0x80, 0xd3, 0x28, 0xb9, 0x2b, 0xfd, 0xc9, 0x07,
+	0x1a, 0xde, 0x8a, 0xd1, 0xde, 0x01, 0xac, 0x0e, 0xf5, 0xe4, 0x16, 0x83, 0xf4, 0xad, 0x05, 0x4b,
+	0xe9, 0x6e, 0x9d, 0x32, 0x8a, 0xe5, 0x7f, 0xb7, 0xc8, 0xc5, 0x4d, 0xaa, 0xdd, 0xae, 0x49, 0xde,
+	0x0e, 0x2c, 0x0f, 0xd4, 0x67, 0x54, 0x2e, 0xc1, 0x84, 0xde, 0x13, 0xe9, 0x58, 0xf1, 0x4d, 0x4b,
+	0xbe, 0x3c, 0xfa, 0x6b, 0x1a, 0x56, 0x06, 0x2c, 0xf6, 0x0b, 0x44, 0x51, 0x07, 0x0b, 0x5b, 0xc0,
+	0x6a, 0x89, 0x09, 0xb7, 0xb7, 0x73, 0x65, 0x8d, 0xfc, 0xdf, 0xc1, 0xfd, 0xa4, 0x12, 0xd6, 0x14,
+	0xf9, 0x03, 0xcc, 0x0f, 0x5a, 0x56, 0x7b, 0x2b, 0x9b, 0xa0, 0xc4, 0xc6, 0xbb, 0xf7, 0x47, 0x83,
+	0x4c, 0xfa, 0x1f, 0x61, 0x61, 0xc8, 0x4a, 0xda, 0x39, 0x6a, 0x99, 0xcb, 0x75, 0x3f, 0xfa, 0x17,
+	0x94, 0x59, 0xe1, 0x18, 0xe6, 0x72, 0xfe, 0xce, 0xde, 0xc8, 0xf2, 0x8a, 0x5c, 0xa7, 0xbb, 0x39,
+	0x02, 0x61, 0xb2, 0x7e, 0x03, 0xb3, 0x19, 0xf7, 0x65, 0xaf, 0x65, 0x19, 0xc3, 0x7e, 0xd0, 0x5d,
+	0x2f, 0x8d, 0x9b, 0x7c, 0xdf, 0xc1, 0x07, 0x59, 0x5b, 0x64, 0xe7, 0x08, 0x05, 0x56, 0xcd, 0xdd,
+	0x28, 0x07, 0x98, 0x94, 0xa7, 0x70, 0x27, 0x3d, 0x77, 0x47, 0x66, 0x86, 0x7b, 0x59, 0xd2, 0x40,
+	0x30, 0x4d, 0xbc, 0x35, 0x12, 0xd3, 0x6f, 0x6a, 0xce, 0x02, 0xe5, 0x9b, 0x5a, 0xe4, 0xd0, 0xdc,
+	0xcd, 0x11, 0x88, 0x7e, 0x53, 0x33, 0xef, 0x47, 0xbe, 0xa9, 0xc3, 0x5e, 0xc9, 0x5d, 0x2f, 0x8d,
+	0x9b, 0x7c, 0x0c, 0x56, 0x8a, 0xe7, 0xb8, 0xfd, 0x71, 0x11, 0xb5, 0xf0, 0xe1, 0x71, 0xb7, 0xab,
+	0x40, 0xfb, 0x2d, 0x1f, 0x18, 0x69, 0xf9, 0x96, 0x17, 0xbf, 0x01, 0xee, 0xd6, 0x48, 0x4c, 0xbf,
+	0xe5, 0xb9, 0x31, 0x92, 0x6f, 0x79, 0xd1, 0x04, 0x74, 0x37, 0x47, 0x20, 0x92, 0xac, 0x4f, 0x1a,
+	0xa7, 0x35, 0x1e, 0x04, 0x93, 0xf1, 0x40, 0xdc, 0xfb, 0x67, 0x00, 0xa7, 0x87, 0x52, 0x18, 0x5f,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1232,6 +1325,7 @@ type RuntimeProviderManagerClient interface {
 	SplitJobIntoTasks(ctx context.Context, in *SplitJobIntoTasksRequest, opts ...grpc.CallOption) (*SplitJobIntoTasksResponse, error)
 	HandleSubtask(ctx context.Context, in *HandleSubtaskRequest, opts ...grpc.CallOption) (*HandleSubtaskResponse, error)
 	WaitSubtask(ctx context.Context, in *WaitSubtaskRequest, opts ...grpc.CallOption) (*WaitSubtaskResponse, error)
+	AbortSubtask(ctx context.Context, in *AbortSubtaskRequest, opts ...grpc.CallOption) (*AbortSubtaskResponse, error)
 	DescribeSubnets(ctx context.Context, in *DescribeSubnetsRequest, opts ...grpc.CallOption) (*DescribeSubnetsResponse, error)
 	CheckResource(ctx context.Context, in *CheckResourceRequest, opts ...grpc.CallOption) (*CheckResourceResponse, error)
 	DescribeVpc(ctx context.Context, in *DescribeVpcRequest, opts ...grpc.CallOption) (*DescribeVpcResponse, error)
@@ -1294,6 +1388,15 @@ func (c *runtimeProviderManagerClient) WaitSubtask(ctx context.Context, in *Wait
 	return out, nil
 }
 
+func (c *runtimeProviderManagerClient) AbortSubtask(ctx context.Context, in *AbortSubtaskRequest, opts ...grpc.CallOption) (*AbortSubtaskResponse, error) {
+	out := new(AbortSubtaskResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.RuntimeProviderManager/AbortSubtask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeProviderManagerClient) DescribeSubnets(ctx context.Context, in *DescribeSubnetsRequest, opts ...grpc.CallOption) (*DescribeSubnetsResponse, error) {
 	out := new(DescribeSubnetsResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.RuntimeProviderManager/DescribeSubnets", in, out, opts...)
@@ -1356,6 +1459,7 @@ type RuntimeProviderManagerServer interface {
 	SplitJobIntoTasks(context.Context, *SplitJobIntoTasksRequest) (*SplitJobIntoTasksResponse, error)
 	HandleSubtask(context.Context, *HandleSubtaskRequest) (*HandleSubtaskResponse, error)
 	WaitSubtask(context.Context, *WaitSubtaskRequest) (*WaitSubtaskResponse, error)
+	AbortSubtask(context.Context, *AbortSubtaskRequest) (*AbortSubtaskResponse, error)
 	DescribeSubnets(context.Context, *DescribeSubnetsRequest) (*DescribeSubnetsResponse, error)
 	CheckResource(context.Context, *CheckResourceRequest) (*CheckResourceResponse, error)
 	DescribeVpc(context.Context, *DescribeVpcRequest) (*DescribeVpcResponse, error)
@@ -1384,6 +1488,9 @@ func (*UnimplementedRuntimeProviderManagerServer) HandleSubtask(ctx context.Cont
 func (*UnimplementedRuntimeProviderManagerServer) WaitSubtask(ctx context.Context, req *WaitSubtaskRequest) (*WaitSubtaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitSubtask not implemented")
 }
+func (*UnimplementedRuntimeProviderManagerServer) AbortSubtask(ctx context.Context, req *AbortSubtaskRequest) (*AbortSubtaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortSubtask not implemented")
+}
 func (*UnimplementedRuntimeProviderManagerServer) DescribeSubnets(ctx context.Context, req *DescribeSubnetsRequest) (*DescribeSubnetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeSubnets not implemented")
 }
@@ -1497,6 +1604,24 @@ func _RuntimeProviderManager_WaitSubtask_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeProviderManager_AbortSubtask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortSubtaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeProviderManagerServer).AbortSubtask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.RuntimeProviderManager/AbortSubtask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeProviderManagerServer).AbortSubtask(ctx, req.(*AbortSubtaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeProviderManager_DescribeSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeSubnetsRequest)
 	if err := dec(in); err != nil {
@@ -1629,6 +1754,10 @@ var _RuntimeProviderManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WaitSubtask",
 			Handler:    _RuntimeProviderManager_WaitSubtask_Handler,
 		},
+		{
+			MethodName: "AbortSubtask",
+			Handler:    _RuntimeProviderManager_AbortSubtask_Handler,
+		},
 		{
 			MethodName: "DescribeSubnets",
 			Handler:    _RuntimeProviderManager_DescribeSubnets_Handler,
@@ -43,7 +43,9 @@ type CreateTaskRequest struct {
 	// allow failure or not
 	FailureAllowed *wrappers.BoolValue `protobuf:"bytes,6,opt,name=failure_allowed,json=failureAllowed,proto3" json:"failure_allowed,omitempty"`
 	// task status eg.[running|success|failed|pending]
-	Status               *wrappers.StringValue `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Status *wrappers.StringValue `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// priority of task, the same as the job, task with higher priority is dispatched first
+	Priority             *wrappers.UInt32Value `protobuf:"bytes,8,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -123,6 +125,13 @@ func (m *CreateTaskRequest) GetStatus() *wrappers.StringValue {
 	return nil
 }
 
+func (m *CreateTaskRequest) GetPriority() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
 type CreateTaskResponse struct {
 	// id of task created
 	TaskId *wrappers.StringValue `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -252,6 +261,86 @@ func (m *RetryTasksResponse) GetTaskSet() []*Task {
 	return nil
 }
 
+type CancelTasksRequest struct {
+	// ids of task to cancel
+	TaskId               []string `protobuf:"bytes,1,rep,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelTasksRequest) Reset()         { *m = CancelTasksRequest{} }
+func (m *CancelTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelTasksRequest) ProtoMessage()    {}
+func (*CancelTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{4}
+}
+
+func (m *CancelTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelTasksRequest.Unmarshal(m, b)
+}
+func (m *CancelTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTasksRequest.Merge(m, src)
+}
+func (m *CancelTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelTasksRequest.Size(m)
+}
+func (m *CancelTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelTasksRequest proto.InternalMessageInfo
+
+func (m *CancelTasksRequest) GetTaskId() []string {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+type CancelTasksResponse struct {
+	// list of task cancelled
+	TaskSet              []*Task  `protobuf:"bytes,1,rep,name=task_set,json=taskSet,proto3" json:"task_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelTasksResponse) Reset()         { *m = CancelTasksResponse{} }
+func (m *CancelTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelTasksResponse) ProtoMessage()    {}
+func (*CancelTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{5}
+}
+
+func (m *CancelTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelTasksResponse.Unmarshal(m, b)
+}
+func (m *CancelTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTasksResponse.Merge(m, src)
+}
+func (m *CancelTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelTasksResponse.Size(m)
+}
+func (m *CancelTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelTasksResponse proto.InternalMessageInfo
+
+func (m *CancelTasksResponse) GetTaskSet() []*Task {
+	if m != nil {
+		return m.TaskSet
+	}
+	return nil
+}
+
 type TaskLayer struct {
 	// task in task layer, a task layer contain one more task
 	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
@@ -266,7 +355,7 @@ func (m *TaskLayer) Reset()         { *m = TaskLayer{} }
 func (m *TaskLayer) String() string { return proto.CompactTextString(m) }
 func (*TaskLayer) ProtoMessage()    {}
 func (*TaskLayer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{4}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{6}
 }
 
 func (m *TaskLayer) XXX_Unmarshal(b []byte) error {
@@ -329,17 +418,23 @@ type Task struct {
 	// allow task run failed or not
 	FailureAllowed *wrappers.BoolValue `protobuf:"bytes,13,opt,name=failure_allowed,json=failureAllowed,proto3" json:"failure_allowed,omitempty"`
 	// owner
-	Owner                *wrappers.StringValue `protobuf:"bytes,14,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Owner *wrappers.StringValue `protobuf:"bytes,14,opt,name=owner,proto3" json:"owner,omitempty"`
+	// priority of task, task with higher priority is dispatched first
+	Priority *wrappers.UInt32Value `protobuf:"bytes,15,opt,name=priority,proto3" json:"priority,omitempty"`
+	// history of attempts handling the task
+	Attempts []*TaskAttempt `protobuf:"bytes,16,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// the time to retry the failed task automatically
+	RetryTime            *timestamp.Timestamp `protobuf:"bytes,17,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
 func (m *Task) String() string { return proto.CompactTextString(m) }
 func (*Task) ProtoMessage()    {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{5}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{7}
 }
 
 func (m *Task) XXX_Unmarshal(b []byte) error {
@@ -458,6 +553,121 @@ func (m *Task) GetOwner() *wrappers.StringValue {
 	return nil
 }
 
+func (m *Task) GetPriority() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
+func (m *Task) GetAttempts() []*TaskAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+func (m *Task) GetRetryTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RetryTime
+	}
+	return nil
+}
+
+type TaskAttempt struct {
+	// host name of server handled the attempt
+	Executor *wrappers.StringValue `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// status of the attempt eg.[successful|failed|cancelled]
+	Status *wrappers.StringValue `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// error code, the grpc code of error if the attempt failed
+	ErrorCode *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// error message if the attempt failed
+	ErrorMessage *wrappers.StringValue `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// the time attempt start
+	StartTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// the time attempt end
+	EndTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// the time task is retried automatically after the attempt failed
+	RetryTime            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TaskAttempt) Reset()         { *m = TaskAttempt{} }
+func (m *TaskAttempt) String() string { return proto.CompactTextString(m) }
+func (*TaskAttempt) ProtoMessage()    {}
+func (*TaskAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{8}
+}
+
+func (m *TaskAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaskAttempt.Unmarshal(m, b)
+}
+func (m *TaskAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaskAttempt.Marshal(b, m, deterministic)
+}
+func (m *TaskAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskAttempt.Merge(m, src)
+}
+func (m *TaskAttempt) XXX_Size() int {
+	return xxx_messageInfo_TaskAttempt.Size(m)
+}
+func (m *TaskAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskAttempt proto.InternalMessageInfo
+
+func (m *TaskAttempt) GetExecutor() *wrappers.StringValue {
+	if m != nil {
+		return m.Executor
+	}
+	return nil
+}
+
+func (m *TaskAttempt) GetStatus() *wrappers.StringValue {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *TaskAttempt) GetErrorCode() *wrappers.UInt32Value {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return nil
+}
+
+func (m *TaskAttempt) GetErrorMessage() *wrappers.StringValue {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return nil
+}
+
+func (m *TaskAttempt) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *TaskAttempt) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *TaskAttempt) GetRetryTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RetryTime
+	}
+	return nil
+}
+
 type DescribeTasksRequest struct {
 	// query key, support these fields(job_id, task_id, executor, status, owner)
 	SearchWord *wrappers.StringValue `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word,omitempty"`
@@ -482,17 +692,19 @@ type DescribeTasksRequest struct {
 	// task status eg.[running|successful|failed|pending]
 	Status []string `protobuf:"bytes,15,rep,name=status,proto3" json:"status,omitempty"`
 	// owner
-	Owner                []string `protobuf:"bytes,16,rep,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Owner []string `protobuf:"bytes,16,rep,name=owner,proto3" json:"owner,omitempty"`
+	// page token of the next page returned by previous page, the offset is ignored if it is set
+	PageToken            *wrappers.StringValue `protobuf:"bytes,17,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeTasksRequest) Reset()         { *m = DescribeTasksRequest{} }
 func (m *DescribeTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeTasksRequest) ProtoMessage()    {}
 func (*DescribeTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{6}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{9}
 }
 
 func (m *DescribeTasksRequest) XXX_Unmarshal(b []byte) error {
@@ -597,21 +809,30 @@ func (m *DescribeTasksRequest) GetOwner() []string {
 	return nil
 }
 
+func (m *DescribeTasksRequest) GetPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
 type DescribeTasksResponse struct {
 	// total count of task
 	TotalCount uint32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// list of task
-	TaskSet              []*Task  `protobuf:"bytes,2,rep,name=task_set,json=taskSet,proto3" json:"task_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TaskSet []*Task `protobuf:"bytes,2,rep,name=task_set,json=taskSet,proto3" json:"task_set,omitempty"`
+	// page token of the next page, empty if there are no more tasks
+	NextPageToken        *wrappers.StringValue `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DescribeTasksResponse) Reset()         { *m = DescribeTasksResponse{} }
 func (m *DescribeTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeTasksResponse) ProtoMessage()    {}
 func (*DescribeTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{7}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{10}
 }
 
 func (m *DescribeTasksResponse) XXX_Unmarshal(b []byte) error {
@@ -646,13 +867,23 @@ func (m *DescribeTasksResponse) GetTaskSet() []*Task {
 	return nil
 }
 
+func (m *DescribeTasksResponse) GetNextPageToken() *wrappers.StringValue {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateTaskRequest)(nil), "openpitrix.CreateTaskRequest")
 	proto.RegisterType((*CreateTaskResponse)(nil), "openpitrix.CreateTaskResponse")
 	proto.RegisterType((*RetryTasksRequest)(nil), "openpitrix.RetryTasksRequest")
 	proto.RegisterType((*RetryTasksResponse)(nil), "openpitrix.RetryTasksResponse")
+	proto.RegisterType((*CancelTasksRequest)(nil), "openpitrix.CancelTasksRequest")
+	proto.RegisterType((*CancelTasksResponse)(nil), "openpitrix.CancelTasksResponse")
 	proto.RegisterType((*TaskLayer)(nil), "openpitrix.TaskLayer")
 	proto.RegisterType((*Task)(nil), "openpitrix.Task")
+	proto.RegisterType((*TaskAttempt)(nil), "openpitrix.TaskAttempt")
 	proto.RegisterType((*DescribeTasksRequest)(nil), "openpitrix.DescribeTasksRequest")
 	proto.RegisterType((*DescribeTasksResponse)(nil), "openpitrix.DescribeTasksResponse")
 }
@@ -660,67 +891,84 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x56, 0x92, 0x8d, 0x77, 0xf3, 0xdc, 0xec, 0xb6, 0xa3, 0xdd, 0x62, 0x45, 0xa5, 0x35, 0x3e,
-	0xc0, 0xd2, 0xa6, 0x89, 0xc8, 0x16, 0x81, 0x5a, 0xf5, 0x90, 0x06, 0x09, 0xad, 0x0a, 0x12, 0x4a,
-	0x0b, 0x48, 0x5c, 0xcc, 0xc4, 0x7e, 0x49, 0xdc, 0x75, 0x3c, 0x66, 0x66, 0xbc, 0x69, 0x6e, 0xc0,
-	0x4f, 0x28, 0x3f, 0x03, 0x89, 0x2b, 0x3f, 0x84, 0x0b, 0x3f, 0x80, 0x0b, 0xff, 0x02, 0xcd, 0x8c,
-	0xb3, 0x71, 0x36, 0xdd, 0xae, 0x73, 0xe1, 0x14, 0xf9, 0xcd, 0xf7, 0xcd, 0xbc, 0x79, 0xef, 0x7b,
-	0xdf, 0x04, 0x40, 0x52, 0x71, 0xd6, 0x49, 0x39, 0x93, 0x8c, 0x00, 0x4b, 0x31, 0x49, 0x23, 0xc9,
-	0xa3, 0xd7, 0xad, 0xbb, 0x13, 0xc6, 0x26, 0x31, 0x76, 0xf5, 0xca, 0x28, 0x1b, 0x77, 0xe7, 0x9c,
-	0xa6, 0x29, 0x72, 0x61, 0xb0, 0xad, 0x7b, 0x97, 0xd7, 0x65, 0x34, 0x43, 0x21, 0xe9, 0x2c, 0xcd,
-	0x01, 0x77, 0x72, 0x00, 0x4d, 0xa3, 0x2e, 0x4d, 0x12, 0x26, 0xa9, 0x8c, 0x58, 0xb2, 0xa4, 0xb7,
-	0xf5, 0x4f, 0xf0, 0x70, 0x82, 0xc9, 0x43, 0x31, 0xa7, 0x93, 0x09, 0xf2, 0x2e, 0x4b, 0x35, 0x62,
-	0x13, 0xed, 0xfd, 0x5e, 0x83, 0x5b, 0x03, 0x8e, 0x54, 0xe2, 0x4b, 0x2a, 0xce, 0x86, 0xf8, 0x53,
-	0x86, 0x42, 0x92, 0x13, 0xb0, 0x5e, 0xb1, 0x91, 0x1f, 0x85, 0x4e, 0xc5, 0xad, 0x1c, 0xdb, 0xbd,
-	0x3b, 0x1d, 0x73, 0x64, 0x67, 0x99, 0x53, 0xe7, 0x85, 0xe4, 0x51, 0x32, 0xf9, 0x8e, 0xc6, 0x19,
-	0x0e, 0xeb, 0xaf, 0xd8, 0xe8, 0x34, 0x24, 0x9f, 0xc2, 0x6e, 0xc2, 0x42, 0x54, 0xac, 0x6a, 0x09,
-	0x96, 0xa5, 0xc0, 0xa7, 0x21, 0x79, 0x04, 0x96, 0xa4, 0x7c, 0x82, 0xd2, 0xa9, 0x95, 0x61, 0x19,
-	0x2c, 0x79, 0x0a, 0xb6, 0x2a, 0xaf, 0x4f, 0x03, 0x75, 0x1b, 0x67, 0xa7, 0x04, 0x55, 0xf7, 0xa3,
-	0xaf, 0xf1, 0xe4, 0x31, 0x34, 0xc2, 0x88, 0x63, 0x20, 0xa3, 0x73, 0x74, 0xea, 0x25, 0xc8, 0x2b,
-	0x38, 0x19, 0xc0, 0xc1, 0x98, 0x46, 0x71, 0xc6, 0xd1, 0xa7, 0x71, 0xcc, 0xe6, 0x18, 0x3a, 0x96,
-	0xde, 0xa1, 0xb5, 0xb1, 0xc3, 0x33, 0xc6, 0x62, 0xc3, 0xdf, 0xcf, 0x29, 0x7d, 0xc3, 0x50, 0xb7,
-	0x16, 0x92, 0xca, 0x4c, 0x38, 0xbb, 0x65, 0x6e, 0x6d, 0xb0, 0xde, 0xcf, 0x15, 0x20, 0xc5, 0x6e,
-	0x89, 0x94, 0x25, 0x02, 0x55, 0xe5, 0x75, 0x31, 0x4a, 0xf6, 0xcb, 0x52, 0xe0, 0xd3, 0xb0, 0xd0,
-	0xe5, 0x6a, 0xe9, 0x2e, 0x7b, 0x6d, 0xb8, 0x35, 0x44, 0xc9, 0x17, 0x2a, 0x01, 0xb1, 0xd4, 0xcb,
-	0x7b, 0xc5, 0x04, 0x6a, 0xc7, 0x8d, 0xe5, 0x11, 0x5e, 0x1f, 0x48, 0x11, 0x9d, 0xe7, 0xfb, 0x00,
-	0xf6, 0x34, 0x5c, 0xa0, 0xd4, 0x78, 0xbb, 0x77, 0xb3, 0xb3, 0x1a, 0x90, 0x8e, 0xbe, 0x9b, 0xde,
-	0xf0, 0x05, 0x4a, 0xef, 0x47, 0x68, 0xa8, 0xc0, 0x57, 0x74, 0x81, 0x9c, 0x7c, 0x08, 0x75, 0x15,
-	0x17, 0x57, 0xd2, 0xcc, 0x32, 0x79, 0x00, 0xf5, 0x60, 0x1a, 0xc5, 0xcb, 0x9b, 0x1d, 0x5d, 0xc6,
-	0xe9, 0xdd, 0x86, 0x06, 0xe3, 0xfd, 0x69, 0xc1, 0x8e, 0x0a, 0xfe, 0x9f, 0x75, 0xbc, 0x2c, 0xe0,
-	0xda, 0x96, 0x02, 0x5e, 0xe9, 0x67, 0xa7, 0xbc, 0x7e, 0xc8, 0x13, 0x00, 0xe4, 0x9c, 0x71, 0x3f,
-	0x60, 0xe1, 0xd5, 0xba, 0xff, 0xf6, 0x34, 0x91, 0x27, 0xbd, 0x5c, 0xf7, 0x1a, 0x3f, 0x60, 0x21,
-	0xae, 0xcf, 0x8c, 0xb5, 0xdd, 0xcc, 0x7c, 0x0e, 0x7b, 0xf8, 0x1a, 0x83, 0x4c, 0x32, 0x5e, 0x4a,
-	0xf0, 0x17, 0x68, 0x95, 0x32, 0x9b, 0x27, 0xc8, 0xfd, 0x94, 0xca, 0xa9, 0xb3, 0x57, 0xe6, 0x58,
-	0x8d, 0xff, 0x86, 0xca, 0x69, 0xc1, 0x5b, 0x1a, 0x5b, 0x78, 0x4b, 0xc1, 0xc8, 0x60, 0x0b, 0x23,
-	0x7b, 0x02, 0x76, 0xa0, 0x67, 0xd3, 0x57, 0x86, 0xed, 0xd8, 0x57, 0x78, 0xc2, 0xcb, 0xa5, 0x9b,
-	0x0f, 0xc1, 0xc0, 0x55, 0x40, 0x91, 0x4d, 0x8f, 0x0c, 0xf9, 0xc6, 0xf5, 0x64, 0x03, 0xd7, 0xe4,
-	0xb7, 0x38, 0x52, 0x73, 0x6b, 0x47, 0xea, 0x41, 0x5d, 0x17, 0xce, 0xd9, 0x2f, 0x23, 0x62, 0x0d,
-	0xf5, 0xfe, 0xad, 0xc1, 0xe1, 0x17, 0x28, 0x02, 0x1e, 0x8d, 0x70, 0xcd, 0x10, 0x9e, 0x82, 0x2d,
-	0x90, 0xf2, 0x60, 0xea, 0xcf, 0x19, 0x2f, 0x37, 0x4d, 0x60, 0x08, 0xdf, 0x33, 0x1e, 0x92, 0xcf,
-	0x60, 0x4f, 0x30, 0x2e, 0xfd, 0x33, 0x5c, 0x94, 0x9a, 0xa9, 0x5d, 0x85, 0x7e, 0x8e, 0x0b, 0xf2,
-	0x08, 0x76, 0x39, 0x9e, 0x23, 0x17, 0x98, 0x4f, 0xd4, 0xbb, 0x2a, 0xb0, 0x84, 0x92, 0x43, 0xa8,
-	0xc7, 0xd1, 0x2c, 0x92, 0x7a, 0x96, 0x9a, 0x43, 0xf3, 0x41, 0x6e, 0x83, 0xc5, 0xc6, 0x63, 0xe5,
-	0x51, 0x75, 0x1d, 0xce, 0xbf, 0xc8, 0x47, 0x70, 0x10, 0x46, 0x22, 0x8d, 0xe9, 0xc2, 0x0f, 0x58,
-	0x9c, 0xcd, 0x12, 0xe1, 0x58, 0xda, 0xf4, 0xf6, 0xf3, 0xf0, 0xc0, 0x44, 0x8b, 0xae, 0x68, 0x17,
-	0x5d, 0x91, 0x1c, 0x5d, 0x18, 0xc6, 0x0d, 0x1d, 0xcf, 0x2d, 0xa1, 0x38, 0x24, 0xcd, 0xad, 0x86,
-	0x64, 0xa5, 0xf3, 0xfd, 0x2d, 0x74, 0x7e, 0xfb, 0xc2, 0x43, 0x0e, 0x4c, 0x7a, 0xb9, 0x4b, 0x1c,
-	0x2e, 0x95, 0x70, 0xd3, 0x64, 0x67, 0x7a, 0x8d, 0x70, 0x74, 0xa9, 0xd5, 0xb9, 0x9b, 0xdf, 0x03,
-	0x5b, 0x32, 0x49, 0x63, 0x3f, 0x60, 0x59, 0x22, 0x75, 0xaf, 0x9b, 0x43, 0xd0, 0xa1, 0x81, 0x8a,
-	0xac, 0xd9, 0x7d, 0xf5, 0x1a, 0xbb, 0xef, 0xfd, 0x51, 0x03, 0x5b, 0x45, 0xbe, 0xa6, 0x09, 0x9d,
-	0x20, 0x27, 0xcf, 0x01, 0x56, 0x2f, 0x1e, 0x79, 0xbf, 0x48, 0xdc, 0xf8, 0xdf, 0xd2, 0xba, 0x7b,
-	0xd5, 0x72, 0x9e, 0xea, 0xdf, 0x15, 0x68, 0xae, 0x5d, 0x82, 0xb8, 0x45, 0xc6, 0xdb, 0xa4, 0xdc,
-	0xfa, 0xe0, 0x1d, 0x08, 0xb3, 0xad, 0xf7, 0x4b, 0xe5, 0x4d, 0x7f, 0x46, 0xce, 0xbe, 0x44, 0xe9,
-	0xea, 0xe7, 0xa7, 0xed, 0x06, 0x34, 0x71, 0xc7, 0x51, 0x2c, 0x91, 0xbb, 0xf3, 0x48, 0x4e, 0x5d,
-	0x39, 0x45, 0x81, 0xee, 0x38, 0xc2, 0x38, 0x14, 0xc7, 0xa6, 0xf7, 0x6d, 0x37, 0x17, 0x47, 0xdb,
-	0x5d, 0xf6, 0xb1, 0xed, 0x9a, 0x0e, 0xb4, 0x5d, 0x5d, 0xf2, 0x8f, 0xdb, 0x6e, 0x88, 0x63, 0x9a,
-	0xc5, 0xd2, 0xe5, 0x28, 0x33, 0x9e, 0xb8, 0x34, 0x8e, 0xcd, 0x09, 0xbf, 0xfe, 0xf5, 0xcf, 0x6f,
-	0x55, 0x9b, 0x34, 0xba, 0xe7, 0x9f, 0x74, 0xcd, 0x8b, 0x37, 0x07, 0x58, 0xbd, 0xb4, 0xeb, 0x75,
-	0xda, 0x78, 0xaf, 0xd7, 0xeb, 0xb4, 0xf9, 0x40, 0x7b, 0xf7, 0xdf, 0xf4, 0x9b, 0xc4, 0xd6, 0x0b,
-	0x85, 0xf3, 0x0e, 0xbd, 0x83, 0x8b, 0xf3, 0xba, 0x5c, 0x2d, 0x3e, 0xae, 0xdc, 0x7f, 0xb6, 0xf3,
-	0x43, 0x35, 0x1d, 0x8d, 0x2c, 0xad, 0xb4, 0x93, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x80,
-	0x63, 0x5e, 0xf5, 0x0a, 0x00, 0x00,
+	// 1218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x8f, 0x1b, 0x45,
+	0x13, 0x96, 0x77, 0xd7, 0x5f, 0x35, 0xf1, 0x7e, 0xf4, 0x9b, 0x8f, 0x91, 0x95, 0x37, 0x19, 0x7c,
+	0x80, 0x90, 0x38, 0x6b, 0xd8, 0x4d, 0x44, 0x48, 0x94, 0x83, 0xd7, 0x41, 0x68, 0x15, 0x82, 0x22,
+	0x27, 0x80, 0xc4, 0x65, 0x68, 0xcf, 0x94, 0xed, 0x89, 0xc7, 0xd3, 0x43, 0x77, 0x3b, 0x5e, 0xdf,
+	0x80, 0x9f, 0x10, 0xfe, 0x03, 0x07, 0x38, 0xc3, 0x1f, 0xe1, 0xc2, 0x15, 0x09, 0xfe, 0x07, 0xea,
+	0xee, 0xb1, 0x3d, 0xde, 0x8d, 0xb3, 0x33, 0x11, 0xe2, 0x64, 0x4d, 0xf5, 0xf3, 0x74, 0x57, 0x57,
+	0x3d, 0x55, 0xd5, 0x06, 0x90, 0x54, 0x8c, 0xf6, 0x63, 0xce, 0x24, 0x23, 0xc0, 0x62, 0x8c, 0xe2,
+	0x40, 0xf2, 0xe0, 0xa4, 0x7e, 0x6d, 0xc0, 0xd8, 0x20, 0xc4, 0x96, 0x5e, 0xe9, 0x4d, 0xfa, 0xad,
+	0x29, 0xa7, 0x71, 0x8c, 0x5c, 0x18, 0x6c, 0xfd, 0xfa, 0xe9, 0x75, 0x19, 0x8c, 0x51, 0x48, 0x3a,
+	0x8e, 0x13, 0xc0, 0xd5, 0x04, 0x40, 0xe3, 0xa0, 0x45, 0xa3, 0x88, 0x49, 0x2a, 0x03, 0x16, 0xcd,
+	0xe9, 0x4d, 0xfd, 0xe3, 0xdd, 0x1e, 0x60, 0x74, 0x5b, 0x4c, 0xe9, 0x60, 0x80, 0xbc, 0xc5, 0x62,
+	0x8d, 0x38, 0x8b, 0x6e, 0xfc, 0xbd, 0x09, 0x7b, 0x1d, 0x8e, 0x54, 0xe2, 0x73, 0x2a, 0x46, 0x5d,
+	0xfc, 0x76, 0x82, 0x42, 0x92, 0x43, 0x28, 0xbd, 0x60, 0x3d, 0x37, 0xf0, 0xed, 0x82, 0x53, 0xb8,
+	0x61, 0x1d, 0x5c, 0xdd, 0x37, 0x47, 0xee, 0xcf, 0x7d, 0xda, 0x7f, 0x26, 0x79, 0x10, 0x0d, 0xbe,
+	0xa4, 0xe1, 0x04, 0xbb, 0xc5, 0x17, 0xac, 0x77, 0xec, 0x93, 0xbb, 0x50, 0x8e, 0x98, 0x8f, 0x8a,
+	0xb5, 0x91, 0x81, 0x55, 0x52, 0xe0, 0x63, 0x9f, 0xdc, 0x81, 0x92, 0xa4, 0x7c, 0x80, 0xd2, 0xde,
+	0xcc, 0xc2, 0x32, 0x58, 0xf2, 0x10, 0x2c, 0x15, 0x5e, 0x97, 0x7a, 0xea, 0x36, 0xf6, 0x56, 0x06,
+	0xaa, 0xce, 0x47, 0x5b, 0xe3, 0xc9, 0x7d, 0xa8, 0xfa, 0x01, 0x47, 0x4f, 0x06, 0x2f, 0xd1, 0x2e,
+	0x66, 0x20, 0x2f, 0xe1, 0xa4, 0x03, 0x3b, 0x7d, 0x1a, 0x84, 0x13, 0x8e, 0x2e, 0x0d, 0x43, 0x36,
+	0x45, 0xdf, 0x2e, 0xe9, 0x1d, 0xea, 0x67, 0x76, 0x38, 0x62, 0x2c, 0x34, 0xfc, 0xed, 0x84, 0xd2,
+	0x36, 0x0c, 0x75, 0x6b, 0x21, 0xa9, 0x9c, 0x08, 0xbb, 0x9c, 0xe5, 0xd6, 0x06, 0x4b, 0xee, 0x41,
+	0x25, 0xe6, 0x01, 0xe3, 0x81, 0x9c, 0xd9, 0x95, 0x35, 0xbc, 0x2f, 0x8e, 0x23, 0x79, 0x78, 0x60,
+	0x78, 0x0b, 0x74, 0xe3, 0xbb, 0x02, 0x90, 0x74, 0x9e, 0x45, 0xcc, 0x22, 0x81, 0x2a, 0x67, 0x3a,
+	0x8c, 0x19, 0x33, 0x5d, 0x52, 0xe0, 0x63, 0x3f, 0xa5, 0x8f, 0x8d, 0xcc, 0xfa, 0x68, 0x34, 0x61,
+	0xaf, 0x8b, 0x92, 0xcf, 0x94, 0x03, 0x62, 0xae, 0xb4, 0x2b, 0x69, 0x07, 0x36, 0x6f, 0x54, 0xe7,
+	0x47, 0x34, 0xda, 0x40, 0xd2, 0xe8, 0xc4, 0xdf, 0x5b, 0x50, 0xd1, 0x70, 0x81, 0x52, 0xe3, 0xad,
+	0x83, 0xdd, 0xfd, 0x65, 0x69, 0xed, 0xeb, 0xbb, 0xe9, 0x0d, 0x9f, 0xa1, 0x6c, 0xdc, 0x06, 0xd2,
+	0xa1, 0x91, 0x87, 0x61, 0xb6, 0x13, 0x8f, 0xe0, 0x7f, 0x2b, 0xf0, 0xb7, 0x39, 0xf2, 0x1b, 0xa8,
+	0x2a, 0xc3, 0x67, 0x74, 0x86, 0x9c, 0xbc, 0x0b, 0x45, 0x65, 0x17, 0x6b, 0x69, 0x66, 0x99, 0xdc,
+	0x82, 0xa2, 0x37, 0x0c, 0xc2, 0x79, 0x30, 0x2f, 0x9d, 0xc6, 0xe9, 0xdd, 0xba, 0x06, 0xd3, 0xf8,
+	0xb3, 0x0c, 0x5b, 0xca, 0xf8, 0x5f, 0xa6, 0xee, 0x74, 0xb5, 0x6d, 0xe6, 0xac, 0xb6, 0xa5, 0xd8,
+	0xb7, 0x72, 0x88, 0xfd, 0x01, 0x00, 0x72, 0xce, 0xb8, 0xeb, 0x31, 0x7f, 0x7d, 0x91, 0xa6, 0xe5,
+	0x5e, 0xd5, 0xf8, 0x0e, 0xf3, 0x71, 0xb5, 0xc0, 0x4b, 0xf9, 0x0a, 0xfc, 0x1e, 0x54, 0xf0, 0x04,
+	0xbd, 0x89, 0x64, 0x3c, 0x53, 0x75, 0x2e, 0xd0, 0xca, 0x65, 0x36, 0x8d, 0x90, 0xbb, 0x31, 0x95,
+	0x43, 0xbb, 0x92, 0x81, 0x5b, 0xd5, 0xf8, 0xa7, 0x54, 0x0e, 0x53, 0x8d, 0xb0, 0x9a, 0xa3, 0x11,
+	0xa6, 0xba, 0x2e, 0xe4, 0xe8, 0xba, 0x0f, 0xc0, 0xf2, 0x74, 0x3b, 0x70, 0xd5, 0x74, 0xb1, 0xad,
+	0x35, 0x0d, 0xec, 0xf9, 0x7c, 0xf4, 0x74, 0xc1, 0xc0, 0x95, 0x41, 0x91, 0x4d, 0x8e, 0x0c, 0xf9,
+	0xc2, 0xf9, 0x64, 0x03, 0xd7, 0xe4, 0xd7, 0xb4, 0xcf, 0x5a, 0xee, 0xf6, 0x79, 0x00, 0x45, 0x1d,
+	0x38, 0x7b, 0x3b, 0x8b, 0x88, 0x35, 0x74, 0xa5, 0x79, 0xee, 0xe4, 0x69, 0x9e, 0xe4, 0x10, 0x2a,
+	0x54, 0x4a, 0x1c, 0xc7, 0x52, 0xd8, 0xbb, 0xba, 0x96, 0xaf, 0x9c, 0xae, 0xd1, 0xb6, 0x59, 0xef,
+	0x2e, 0x80, 0xe4, 0x63, 0x00, 0xae, 0x1a, 0x98, 0x89, 0xd1, 0xde, 0xb9, 0x31, 0xaa, 0x6a, 0xb4,
+	0xfa, 0x6e, 0xfc, 0xbc, 0x09, 0x56, 0x6a, 0xd3, 0x15, 0x41, 0x16, 0x72, 0x09, 0x72, 0x59, 0x79,
+	0x1b, 0x6f, 0x5d, 0x79, 0x9b, 0xf9, 0x2a, 0xaf, 0x0d, 0x35, 0x43, 0x1e, 0xa3, 0x10, 0x74, 0x80,
+	0x99, 0x6a, 0xfe, 0x82, 0xa6, 0x3c, 0x31, 0x0c, 0x15, 0x3a, 0x21, 0x29, 0x97, 0x26, 0x74, 0xc5,
+	0xf3, 0x43, 0xa7, 0xd1, 0x5a, 0x5d, 0x77, 0xa1, 0x82, 0x91, 0x6f, 0x88, 0xa5, 0x73, 0x89, 0x65,
+	0x8c, 0x7c, 0x4d, 0x5b, 0x4d, 0x56, 0x39, 0x4f, 0xb2, 0x7e, 0xda, 0x82, 0x8b, 0x8f, 0x50, 0x78,
+	0x3c, 0xe8, 0xe1, 0xca, 0xa0, 0x79, 0x08, 0x96, 0x40, 0xca, 0xbd, 0xa1, 0x3b, 0x65, 0x3c, 0x5b,
+	0x93, 0x06, 0x43, 0xf8, 0x8a, 0x71, 0x9f, 0x7c, 0x04, 0x15, 0xc1, 0xb8, 0x74, 0x47, 0x38, 0xcb,
+	0x94, 0xbc, 0xb2, 0x42, 0x3f, 0xc6, 0x19, 0xb9, 0x03, 0x65, 0x8e, 0x2f, 0x91, 0x8b, 0x79, 0xea,
+	0xde, 0x54, 0x58, 0x73, 0x28, 0xb9, 0x08, 0xc5, 0x30, 0x18, 0x07, 0x52, 0xa7, 0xab, 0xd6, 0x35,
+	0x1f, 0xe4, 0x32, 0x94, 0x58, 0xbf, 0xaf, 0x46, 0x5f, 0x51, 0x9b, 0x93, 0x2f, 0xf2, 0x1e, 0xec,
+	0xf8, 0x81, 0x88, 0x43, 0x3a, 0x73, 0x3d, 0x16, 0x4e, 0xc6, 0x91, 0xb0, 0x4b, 0x7a, 0x98, 0x6e,
+	0x27, 0xe6, 0x8e, 0xb1, 0xa6, 0xa7, 0xad, 0x95, 0x9e, 0xb6, 0xe4, 0xd2, 0x62, 0x0e, 0x5d, 0xd0,
+	0xf6, 0x64, 0xd2, 0xa4, 0xa5, 0x5e, 0xcb, 0x2b, 0xf5, 0xa4, 0x7d, 0x6e, 0xe7, 0x68, 0x9f, 0x97,
+	0x17, 0x05, 0xb2, 0x63, 0xdc, 0x33, 0x5f, 0x2a, 0x1c, 0xa6, 0xc1, 0xec, 0x1a, 0xef, 0xf4, 0x87,
+	0x2a, 0x8c, 0x98, 0x0e, 0xd0, 0x95, 0x6c, 0x84, 0x91, 0xbd, 0x97, 0xe1, 0x9c, 0xaa, 0xc2, 0x3f,
+	0x57, 0xf0, 0xc6, 0x2f, 0x05, 0xb8, 0x74, 0x4a, 0x28, 0xc9, 0x13, 0xe3, 0x3a, 0x58, 0x92, 0x49,
+	0x1a, 0xba, 0x1e, 0x9b, 0x44, 0x52, 0x2b, 0xa5, 0xd6, 0x05, 0x6d, 0xea, 0x28, 0xcb, 0xca, 0x1b,
+	0x64, 0xe3, 0x9c, 0x37, 0x08, 0x79, 0x04, 0x3b, 0x11, 0x9e, 0x48, 0x37, 0xe5, 0x69, 0x96, 0x81,
+	0x5d, 0x53, 0xa4, 0xa7, 0x73, 0x6f, 0x0f, 0x7e, 0x2b, 0x9a, 0x1e, 0xf4, 0x84, 0x46, 0x74, 0x80,
+	0x9c, 0x3c, 0x06, 0x58, 0xbe, 0x1f, 0xc9, 0xff, 0xd3, 0xc7, 0x9f, 0xf9, 0xff, 0x50, 0xbf, 0xb6,
+	0x6e, 0x39, 0xb9, 0xf0, 0x1f, 0x05, 0xa8, 0xad, 0x84, 0x82, 0x38, 0x69, 0xc6, 0xeb, 0xca, 0xa9,
+	0xfe, 0xce, 0x1b, 0x10, 0x66, 0xdb, 0xc6, 0xf7, 0x85, 0x57, 0xed, 0x31, 0x19, 0x7d, 0x8a, 0xd2,
+	0xd1, 0x2f, 0xab, 0xa6, 0xe3, 0xd1, 0xc8, 0xe9, 0x07, 0xa1, 0x44, 0xee, 0x4c, 0x03, 0x39, 0x74,
+	0xe4, 0x10, 0x05, 0x3a, 0xfd, 0x00, 0x43, 0x5f, 0xdc, 0x30, 0xfa, 0x6b, 0x3a, 0x89, 0x40, 0x9b,
+	0xce, 0x5c, 0x4b, 0x4d, 0xc7, 0xa8, 0xa0, 0xe9, 0xe8, 0xb4, 0xbf, 0xdf, 0x74, 0x7c, 0xec, 0xd3,
+	0x49, 0x28, 0x1d, 0x8e, 0x72, 0xc2, 0x23, 0x87, 0x86, 0xa1, 0x39, 0xe1, 0x87, 0xdf, 0xff, 0xfa,
+	0x71, 0xc3, 0x22, 0xd5, 0xd6, 0xcb, 0x0f, 0x5b, 0xda, 0x40, 0x7e, 0x2d, 0x80, 0xf5, 0xc9, 0x49,
+	0xcc, 0xb8, 0xfc, 0x17, 0x2f, 0xe6, 0xbe, 0x6a, 0x3f, 0x22, 0x47, 0x66, 0xdb, 0xf9, 0xd5, 0x84,
+	0xe4, 0x48, 0xc7, 0xc6, 0x95, 0x21, 0x1a, 0xab, 0xa3, 0x72, 0xee, 0xf4, 0x66, 0xe6, 0xb7, 0xcf,
+	0xb8, 0xd3, 0x9b, 0x84, 0x23, 0x87, 0xa3, 0x22, 0x06, 0xd1, 0x40, 0xbb, 0x4b, 0xc8, 0xee, 0xc2,
+	0xdd, 0x16, 0xea, 0x3d, 0x3f, 0x28, 0x90, 0x29, 0xc0, 0xf2, 0xbd, 0xbd, 0x9a, 0xdf, 0x33, 0xaf,
+	0xf6, 0xfa, 0xb5, 0x75, 0xcb, 0x89, 0xbf, 0x37, 0x5f, 0xb5, 0x6b, 0xc4, 0xd2, 0x0b, 0xa9, 0x38,
+	0x5d, 0x6c, 0xec, 0x2c, 0x0f, 0xd6, 0x0d, 0xf4, 0x7e, 0xe1, 0x26, 0xf9, 0x1c, 0xac, 0xd4, 0xb3,
+	0x9b, 0xac, 0x4a, 0xe7, 0xcc, 0xf3, 0xbd, 0x7e, 0x7d, 0xed, 0xba, 0x39, 0xfb, 0x68, 0xeb, 0xeb,
+	0x8d, 0xb8, 0xd7, 0x2b, 0x69, 0x8d, 0x1f, 0xfe, 0x33, 0x00, 0xa2, 0x16, 0xf7, 0x1f, 0x85, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -738,8 +986,12 @@ type TaskManagerClient interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	// Get tasks, can filter with these fields(job_id, task_id, executor, status, owner), default return all tasks
 	DescribeTasks(ctx context.Context, in *DescribeTasksRequest, opts ...grpc.CallOption) (*DescribeTasksResponse, error)
+	// Export tasks, stream all the tasks page by page for bulk reporting
+	ExportTasks(ctx context.Context, in *DescribeTasksRequest, opts ...grpc.CallOption) (TaskManager_ExportTasksClient, error)
 	// Retry tasks
 	RetryTasks(ctx context.Context, in *RetryTasksRequest, opts ...grpc.CallOption) (*RetryTasksResponse, error)
+	// Cancel pending or working tasks, called by job manager when job cancelled
+	CancelTasks(ctx context.Context, in *CancelTasksRequest, opts ...grpc.CallOption) (*CancelTasksResponse, error)
 }
 
 type taskManagerClient struct {
@@ -768,6 +1020,38 @@ func (c *taskManagerClient) DescribeTasks(ctx context.Context, in *DescribeTasks
 	return out, nil
 }
 
+func (c *taskManagerClient) ExportTasks(ctx context.Context, in *DescribeTasksRequest, opts ...grpc.CallOption) (TaskManager_ExportTasksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TaskManager_serviceDesc.Streams[0], "/openpitrix.TaskManager/ExportTasks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskManagerExportTasksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskManager_ExportTasksClient interface {
+	Recv() (*DescribeTasksResponse, error)
+	grpc.ClientStream
+}
+
+type taskManagerExportTasksClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskManagerExportTasksClient) Recv() (*DescribeTasksResponse, error) {
+	m := new(DescribeTasksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *taskManagerClient) RetryTasks(ctx context.Context, in *RetryTasksRequest, opts ...grpc.CallOption) (*RetryTasksResponse, error) {
 	out := new(RetryTasksResponse)
 	err := c.cc.Invoke(ctx, "/openpitrix.TaskManager/RetryTasks", in, out, opts...)
@@ -777,13 +1061,26 @@ func (c *taskManagerClient) RetryTasks(ctx context.Context, in *RetryTasksReques
 	return out, nil
 }
 
+func (c *taskManagerClient) CancelTasks(ctx context.Context, in *CancelTasksRequest, opts ...grpc.CallOption) (*CancelTasksResponse, error) {
+	out := new(CancelTasksResponse)
+	err := c.cc.Invoke(ctx, "/openpitrix.TaskManager/CancelTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskManagerServer is the server API for TaskManager service.
 type TaskManagerServer interface {
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
 	// Get tasks, can filter with these fields(job_id, task_id, executor, status, owner), default return all tasks
 	DescribeTasks(context.Context, *DescribeTasksRequest) (*DescribeTasksResponse, error)
+	// Export tasks, stream all the tasks page by page for bulk reporting
+	ExportTasks(*DescribeTasksRequest, TaskManager_ExportTasksServer) error
 	// Retry tasks
 	RetryTasks(context.Context, *RetryTasksRequest) (*RetryTasksResponse, error)
+	// Cancel pending or working tasks, called by job manager when job cancelled
+	CancelTasks(context.Context, *CancelTasksRequest) (*CancelTasksResponse, error)
 }
 
 // UnimplementedTaskManagerServer can be embedded to have forward compatible implementations.
@@ -796,9 +1093,15 @@ func (*UnimplementedTaskManagerServer) CreateTask(ctx context.Context, req *Crea
 func (*UnimplementedTaskManagerServer) DescribeTasks(ctx context.Context, req *DescribeTasksRequest) (*DescribeTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTasks not implemented")
 }
+func (*UnimplementedTaskManagerServer) ExportTasks(req *DescribeTasksRequest, srv TaskManager_ExportTasksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTasks not implemented")
+}
 func (*UnimplementedTaskManagerServer) RetryTasks(ctx context.Context, req *RetryTasksRequest) (*RetryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryTasks not implemented")
 }
+func (*UnimplementedTaskManagerServer) CancelTasks(ctx context.Context, req *CancelTasksRequest) (*CancelTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTasks not implemented")
+}
 
 func RegisterTaskManagerServer(s *grpc.Server, srv TaskManagerServer) {
 	s.RegisterService(&_TaskManager_serviceDesc, srv)
@@ -840,6 +1143,27 @@ func _TaskManager_DescribeTasks_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskManager_ExportTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DescribeTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskManagerServer).ExportTasks(m, &taskManagerExportTasksServer{stream})
+}
+
+type TaskManager_ExportTasksServer interface {
+	Send(*DescribeTasksResponse) error
+	grpc.ServerStream
+}
+
+type taskManagerExportTasksServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagerExportTasksServer) Send(m *DescribeTasksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TaskManager_RetryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetryTasksRequest)
 	if err := dec(in); err != nil {
@@ -858,6 +1182,24 @@ func _TaskManager_RetryTasks_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskManager_CancelTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).CancelTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openpitrix.TaskManager/CancelTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).CancelTasks(ctx, req.(*CancelTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TaskManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openpitrix.TaskManager",
 	HandlerType: (*TaskManagerServer)(nil),
@@ -874,7 +1216,17 @@ var _TaskManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetryTasks",
 			Handler:    _TaskManager_RetryTasks_Handler,
 		},
+		{
+			MethodName: "CancelTasks",
+			Handler:    _TaskManager_CancelTasks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTasks",
+			Handler:       _TaskManager_ExportTasks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "task.proto",
 }
@@ -72,6 +72,7 @@ const (
 	TimeoutSshKeygen            = 120
 	TimeoutRemoveContainer      = 120
 	TimeoutKeyPair              = 60
+	TimeoutSwapImage            = 600
 )
 
 const (
@@ -31,6 +31,8 @@ type Frame struct {
 	ClusterWrapper        *models.ClusterWrapper
 	Runtime               *models.RuntimeDetails
 	RuntimeProviderConfig *config.RuntimeProviderConfig
+	// DryRun only builds the task layers, other services are never called
+	DryRun bool
 }
 
 func (f *Frame) startConfdServiceLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
@@ -182,7 +184,31 @@ func (f *Frame) deregisterCmdLayer(nodeIds []string, failureAllowed bool) *model
 	}
 }
 
-func (f *Frame) registerCmdLayer(nodeIds []string, serviceName string, failureAllowed bool) *models.TaskLayer {
+// quoteServiceParam quotes the value of service param for shell, which may be given by user
+func quoteServiceParam(value interface{}) string {
+	return "'" + strings.Replace(fmt.Sprint(value), "'", `'\''`, -1) + "'"
+}
+
+// getServiceCmd exports the service params as environment variables of the service cmd
+func getServiceCmd(cmd string, serviceParams map[string]interface{}) string {
+	if len(serviceParams) == 0 {
+		return cmd
+	}
+	var keys []string
+	for key := range serviceParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var envs []string
+	for _, key := range keys {
+		envs = append(envs, fmt.Sprintf("%s=%s", key, quoteServiceParam(serviceParams[key])))
+	}
+	return fmt.Sprintf("export %s;%s", strings.Join(envs, " "), cmd)
+}
+
+func (f *Frame) registerCmdLayer(nodeIds []string, serviceName string, serviceParams map[string]interface{},
+	failureAllowed bool) *models.TaskLayer {
 	metadata := &Metadata{
 		ClusterWrapper: f.ClusterWrapper,
 		RuntimeDetails: f.Runtime,
@@ -208,7 +234,7 @@ func (f *Frame) registerCmdLayer(nodeIds []string, serviceName string, failureAl
 			}
 			ip := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId].PrivateIp
 			cmd := &models.Cmd{
-				Cmd:     service.Cmd,
+				Cmd:     getServiceCmd(service.Cmd, serviceParams),
 				Timeout: timeout,
 			}
 			meta := &models.Meta{
@@ -388,7 +414,7 @@ func (f *Frame) constructServiceTasks(serviceName, cmdName string, nodeIds []str
 
 	for _, order := range orders {
 		nodeIds := orderNodeIds[order]
-		taskLayer := f.registerCmdLayer(nodeIds, serviceName, failureAllowed)
+		taskLayer := f.registerCmdLayer(nodeIds, serviceName, serviceParams, failureAllowed)
 		headTaskLayer.Leaf().Child = taskLayer
 	}
 	return headTaskLayer.Child
@@ -422,10 +448,31 @@ func (f *Frame) scaleInServiceLayer(nodeIds []string, failureAllowed bool) *mode
 	return f.constructServiceTasks("ScaleInService", constants.ServicePreCheckName, nodeIds, nil, failureAllowed)
 }
 
+func (f *Frame) upgradeServiceLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
+	return f.constructServiceTasks("UpgradeService", constants.ServiceCmdName, nodeIds, nil, failureAllowed)
+}
+
 func (f *Frame) destroyServiceLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
 	return f.constructServiceTasks("DestroyService", constants.ServiceCmdName, nodeIds, nil, failureAllowed)
 }
 
+func (f *Frame) backupServiceLayer(nodeIds []string, snapshot *models.ClusterSnapshot, failureAllowed bool) *models.TaskLayer {
+	return f.constructServiceTasks("BackupService", constants.ServiceCmdName, nodeIds, snapshot.GetServiceParams(), failureAllowed)
+}
+
+func (f *Frame) restoreServiceLayer(nodeIds []string, snapshot *models.ClusterSnapshot, failureAllowed bool) *models.TaskLayer {
+	return f.constructServiceTasks("RestoreService", constants.ServiceCmdName, nodeIds, snapshot.GetServiceParams(), failureAllowed)
+}
+
+func (f *Frame) deleteSnapshotServiceLayer(nodeIds []string, snapshot *models.ClusterSnapshot, failureAllowed bool) *models.TaskLayer {
+	return f.constructServiceTasks("DeleteSnapshotService", constants.ServiceCmdName, nodeIds, snapshot.GetServiceParams(), failureAllowed)
+}
+
+func (f *Frame) runServiceLayer(nodeIds []string, clusterServiceAudit *models.ClusterServiceAudit, failureAllowed bool) *models.TaskLayer {
+	return f.constructServiceTasks(clusterServiceAudit.GetServiceAttribute(), constants.ServiceCmdName, nodeIds,
+		clusterServiceAudit.GetServiceParams(), failureAllowed)
+}
+
 func (f *Frame) initAndStartServiceLayer(nodeIds []string, failureAllowed bool) *models.TaskLayer {
 	headTaskLayer := new(models.TaskLayer)
 
@@ -717,13 +764,77 @@ func (f *Frame) removeContainerLayer(nodeIds []string, failureAllowed bool) *mod
 	}
 }
 
-func (f *Frame) sshKeygenLayer(failureAllowed bool) *models.TaskLayer {
+func (f *Frame) swapImageLayer(nodeIds []string, fromClusterCommons map[string]*models.ClusterCommon, failureAllowed bool) *models.TaskLayer {
 	taskLayer := new(models.TaskLayer)
-	clusterClient, err := clusterclient.NewClient()
-	if err != nil {
-		logger.Error(f.Ctx, "New ssh key gen task layer failed: %+v", err)
+
+	for _, nodeId := range nodeIds {
+		clusterNode, exist := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId]
+		if !exist {
+			logger.Error(f.Ctx, "ClusterConf [%s] node [%s] not exist", f.ClusterWrapper.Cluster.ClusterId, nodeId)
+			continue
+		}
+		role := clusterNode.Role
+		if strings.HasSuffix(role, constants.ReplicaRoleSuffix) {
+			role = string([]byte(role)[:len(role)-len(constants.ReplicaRoleSuffix)])
+		}
+		clusterCommon, exist := f.ClusterWrapper.ClusterCommons[role]
+		if !exist {
+			logger.Error(f.Ctx, "No such role [%s] in cluster common [%s]. ",
+				role, f.ClusterWrapper.Cluster.ClusterId)
+			return nil
+		}
+
+		// only the roles with changed image need to recreate the default container
+		imageId := clusterCommon.ImageId
+		if imageId == "" {
+			continue
+		}
+		fromClusterCommon, exist := fromClusterCommons[role]
+		if exist && fromClusterCommon.ImageId == imageId {
+			continue
+		}
+
+		cmd := fmt.Sprintf("sed -i 's#^IMAGE=.*#IMAGE=\\\"%s\\\"#' %s && docker pull %s && docker rm -f default",
+			imageId, f.getConfFile(), imageId)
+		request := &pbtypes.RunCommandOnDroneRequest{
+			Endpoint: &pbtypes.DroneEndpoint{
+				FrontgateId: f.ClusterWrapper.Cluster.FrontgateId,
+				DroneIp:     clusterNode.PrivateIp,
+				DronePort:   constants.DroneServicePort,
+			},
+			Command:        fmt.Sprintf("%s \"%s\"", HostCmdPrefix, cmd),
+			TimeoutSeconds: TimeoutSwapImage,
+		}
+		directive := jsonutil.ToString(request)
+		swapImageTask := &models.Task{
+			JobId:          f.Job.JobId,
+			Owner:          f.Job.Owner,
+			TaskAction:     ActionRemoveContainerOnDrone,
+			Target:         constants.TargetPilot,
+			NodeId:         nodeId,
+			Directive:      directive,
+			FailureAllowed: failureAllowed,
+		}
+		taskLayer.Tasks = append(taskLayer.Tasks, swapImageTask)
+	}
+	if len(taskLayer.Tasks) > 0 {
+		return taskLayer
+	} else {
 		return nil
 	}
+}
+
+func (f *Frame) sshKeygenLayer(failureAllowed bool) *models.TaskLayer {
+	taskLayer := new(models.TaskLayer)
+	var clusterClient *clusterclient.Client
+	if !f.DryRun {
+		var err error
+		clusterClient, err = clusterclient.NewClient()
+		if err != nil {
+			logger.Error(f.Ctx, "New ssh key gen task layer failed: %+v", err)
+			return nil
+		}
+	}
 
 	for nodeId, clusterNode := range f.ClusterWrapper.ClusterNodesWithKeyPairs {
 		role := clusterNode.Role
@@ -736,12 +847,14 @@ func (f *Frame) sshKeygenLayer(failureAllowed bool) *models.TaskLayer {
 					clusterCommon.Passphraseless, nodeId)
 				return nil
 			}
-			_, err = clusterClient.ModifyClusterNode(f.Ctx, &pb.ModifyClusterNodeRequest{
-				ClusterNode: &pb.ClusterNode{
-					NodeId: pbutil.ToProtoString(nodeId),
-					PubKey: pbutil.ToProtoString(public),
-				},
-			})
+			if clusterClient != nil {
+				_, err = clusterClient.ModifyClusterNode(f.Ctx, &pb.ModifyClusterNodeRequest{
+					ClusterNode: &pb.ClusterNode{
+						NodeId: pbutil.ToProtoString(nodeId),
+						PubKey: pbutil.ToProtoString(public),
+					},
+				})
+			}
 			cmd := fmt.Sprintf("mkdir -p /root/.ssh/ && chmod 700 /root/.ssh/ && "+
 				"echo \"%s\" > /root/.ssh/id_%s && echo \"%s\" > /root/.ssh/id_%s.pub && "+
 				"chown 600 /root/.ssh/id_%s && chown 644 /root/.ssh/id_%s.pub",
@@ -833,7 +946,7 @@ func (f *Frame) umountVolumeLayer(nodeIds []string, failureAllowed bool) *models
 }
 
 func (f *Frame) getUserDataExec(filename, contents, imageUrl, certificateExec string) string {
-	if pi.Global() == nil {
+	if pi.Global() == nil && !f.DryRun {
 		logger.Error(f.Ctx, "Pi global should be init.")
 		return ""
 	}
@@ -1698,3 +1811,134 @@ func (f *Frame) DetachKeyPairsLayer(nodeKeyPairDetails models.NodeKeyPairDetails
 
 	return headTaskLayer.Child
 }
+
+// fromFrame returns the frame with the cluster commons of the version before upgrading,
+// the services of current version are used to stop the cluster
+func (f *Frame) fromFrame(fromClusterCommons map[string]*models.ClusterCommon) *Frame {
+	if len(fromClusterCommons) == 0 {
+		return f
+	}
+	clusterWrapper := *f.ClusterWrapper
+	clusterWrapper.ClusterCommons = fromClusterCommons
+	from := *f
+	from.ClusterWrapper = &clusterWrapper
+	return &from
+}
+
+func (f *Frame) UpgradeClusterLayer(fromClusterCommons map[string]*models.ClusterCommon) *models.TaskLayer {
+	var nodeIds []string
+	for nodeId := range f.ClusterWrapper.ClusterNodesWithKeyPairs {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	from := f.fromFrame(fromClusterCommons)
+	headTaskLayer := new(models.TaskLayer)
+
+	if f.ClusterWrapper.Cluster.Status == constants.StatusActive {
+		headTaskLayer.
+			Append(from.stopServiceLayer(nodeIds, true)).  // register stop cmd of current version to exec
+			Append(f.stopConfdServiceLayer(nodeIds, true)) // stop confd service
+	} else {
+		headTaskLayer.
+			Append(f.attachVolumesLayer(nodeIds, false)).  // attach volume to instance, will auto mount
+			Append(f.startInstancesLayer(nodeIds, false)). // start instance
+			Append(f.waitFrontgateLayer(false))            // wait frontgate cluster to be active
+	}
+
+	headTaskLayer.
+		Append(f.pingDroneLayer(nodeIds, false)).                     // ping drone
+		Append(f.swapImageLayer(nodeIds, fromClusterCommons, false)). // swap image and recreate default container
+		Append(f.pingDroneLayer(nodeIds, false)).                     // ping drone
+		Append(f.setDroneConfigLayer(nodeIds, false)).                // set drone config
+		Append(f.deregisterMetadataLayer(true)).                      // deregister cluster metadata
+		Append(f.deregisterMetadataMappingLayer(true)).               // deregister cluster metadata mapping
+		Append(f.registerMetadataLayer(false)).                       // register cluster metadata
+		Append(f.registerMetadataMappingLayer(false)).                // register cluster metadata mapping
+		Append(f.startConfdServiceLayer(nodeIds, false)).             // start confd service
+		Append(f.upgradeServiceLayer(nodeIds, false)).                // register upgrade cmd to exec
+		Append(f.startServiceLayer(nodeIds, false)).                  // register start cmd to exec
+		Append(f.deregisterCmdLayer(nodeIds, true))                   // deregister cmd
+
+	return headTaskLayer.Child
+}
+
+// getSnapshotNodeIds returns the nodes of the snapshot which are still in the cluster
+func (f *Frame) getSnapshotNodeIds(snapshot *models.ClusterSnapshot) []string {
+	var nodeIds []string
+	for _, nodeId := range snapshot.GetNodeIds() {
+		if _, exist := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId]; exist {
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+	return nodeIds
+}
+
+func (f *Frame) CreateClusterSnapshotLayer(snapshot *models.ClusterSnapshot) *models.TaskLayer {
+	nodeIds := f.getSnapshotNodeIds(snapshot)
+	headTaskLayer := new(models.TaskLayer)
+
+	headTaskLayer.
+		Append(f.backupServiceLayer(nodeIds, snapshot, false)). // register backup cmd to exec
+		Append(f.deregisterCmdLayer(nodeIds, true))             // deregister cmd
+
+	return headTaskLayer.Child
+}
+
+// RestoreClusterFromSnapshotLayer restores the snapshots one by one in the order of the snapshot chain,
+// the full snapshot first and then the incremental snapshots based on it
+func (f *Frame) RestoreClusterFromSnapshotLayer(snapshots []*models.ClusterSnapshot) *models.TaskLayer {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	nodeIds := f.getSnapshotNodeIds(snapshots[0])
+	headTaskLayer := new(models.TaskLayer)
+
+	headTaskLayer.
+		Append(f.stopServiceLayer(nodeIds, false)) // register stop cmd to exec
+	for _, snapshot := range snapshots {
+		headTaskLayer.
+			Append(f.restoreServiceLayer(nodeIds, snapshot, false)) // register restore cmd to exec
+	}
+	headTaskLayer.
+		Append(f.startServiceLayer(nodeIds, false)). // register start cmd to exec
+		Append(f.deregisterCmdLayer(nodeIds, true))  // deregister cmd
+
+	return headTaskLayer.Child
+}
+
+func (f *Frame) DeleteClusterSnapshotsLayer(snapshots []*models.ClusterSnapshot) *models.TaskLayer {
+	nodeIdSet := make(map[string]bool)
+	var nodeIds []string
+	headTaskLayer := new(models.TaskLayer)
+
+	for _, snapshot := range snapshots {
+		snapshotNodeIds := f.getSnapshotNodeIds(snapshot)
+		for _, nodeId := range snapshotNodeIds {
+			if !nodeIdSet[nodeId] {
+				nodeIdSet[nodeId] = true
+				nodeIds = append(nodeIds, nodeId)
+			}
+		}
+		headTaskLayer.
+			Append(f.deleteSnapshotServiceLayer(snapshotNodeIds, snapshot, true)) // register delete snapshot cmd to exec
+	}
+	headTaskLayer.
+		Append(f.deregisterCmdLayer(nodeIds, true)) // deregister cmd
+
+	return headTaskLayer.Child
+}
+
+func (f *Frame) RunClusterServiceLayer(clusterServiceAudit *models.ClusterServiceAudit) *models.TaskLayer {
+	var nodeIds []string
+	for _, nodeId := range clusterServiceAudit.GetNodeIds() {
+		if _, exist := f.ClusterWrapper.ClusterNodesWithKeyPairs[nodeId]; exist {
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+	headTaskLayer := new(models.TaskLayer)
+
+	headTaskLayer.
+		Append(f.runServiceLayer(nodeIds, clusterServiceAudit, false)). // register service cmd to exec
+		Append(f.deregisterCmdLayer(nodeIds, true))                     // deregister cmd
+
+	return headTaskLayer.Child
+}
@@ -34,6 +34,11 @@ type FrameInterface interface {
 	ResizeClusterLayer(roleResizeResources models.RoleResizeResources) *models.TaskLayer
 	AttachKeyPairsLayer(nodeKeyPairDetails models.NodeKeyPairDetails) *models.TaskLayer
 	DetachKeyPairsLayer(nodeKeyPairDetails models.NodeKeyPairDetails) *models.TaskLayer
+	UpgradeClusterLayer(fromClusterCommons map[string]*models.ClusterCommon) *models.TaskLayer
+	CreateClusterSnapshotLayer(snapshot *models.ClusterSnapshot) *models.TaskLayer
+	RestoreClusterFromSnapshotLayer(snapshots []*models.ClusterSnapshot) *models.TaskLayer
+	DeleteClusterSnapshotsLayer(snapshots []*models.ClusterSnapshot) *models.TaskLayer
+	RunClusterServiceLayer(clusterServiceAudit *models.ClusterServiceAudit) *models.TaskLayer
 }
 
 func SplitJobIntoTasks(ctx context.Context, job *models.Job, advancedParam ...string) (*models.TaskLayer, error) {
@@ -47,12 +52,13 @@ func SplitJobIntoTasks(ctx context.Context, job *models.Job, advancedParam ...st
 		// TODO: vpc, eip, subnet
 
 		return frameInterface.CreateClusterLayer(), nil
-	case constants.ActionUpgradeCluster:
-		// not supported yet
-		return nil, nil
-	case constants.ActionRollbackCluster:
-		// not supported yet
-		return nil, nil
+	case constants.ActionUpgradeCluster, constants.ActionRollbackCluster:
+		// rollback is the upgrade to the version before last upgrade
+		upgradeDirective, err := models.NewClusterUpgradeDirective(ctx, job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		return frameInterface.UpgradeClusterLayer(upgradeDirective.FromClusterCommons), nil
 	case constants.ActionAddClusterNodes:
 		return frameInterface.AddClusterNodesLayer(), nil
 	case constants.ActionDeleteClusterNodes:
@@ -89,6 +95,36 @@ func SplitJobIntoTasks(ctx context.Context, job *models.Job, advancedParam ...st
 			return nil, err
 		}
 		return frameInterface.DetachKeyPairsLayer(nodeKeyPairDetails), nil
+	case constants.ActionCreateClusterSnapshot:
+		snapshotDirective, err := models.NewClusterSnapshotDirective(ctx, job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		if len(snapshotDirective.Snapshots) == 0 {
+			return nil, fmt.Errorf("no snapshot in directive of job [%s]", job.JobId)
+		}
+		return frameInterface.CreateClusterSnapshotLayer(snapshotDirective.Snapshots[0]), nil
+	case constants.ActionRestoreClusterFromSnapshot:
+		snapshotDirective, err := models.NewClusterSnapshotDirective(ctx, job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		return frameInterface.RestoreClusterFromSnapshotLayer(snapshotDirective.Snapshots), nil
+	case constants.ActionDeleteClusterSnapshots:
+		snapshotDirective, err := models.NewClusterSnapshotDirective(ctx, job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		return frameInterface.DeleteClusterSnapshotsLayer(snapshotDirective.Snapshots), nil
+	case constants.ActionRunClusterService:
+		serviceDirective, err := models.NewClusterServiceDirective(ctx, job.Directive)
+		if err != nil {
+			return nil, err
+		}
+		if serviceDirective.ClusterServiceAudit == nil {
+			return nil, fmt.Errorf("no cluster service audit in directive of job [%s]", job.JobId)
+		}
+		return frameInterface.RunClusterServiceLayer(serviceDirective.ClusterServiceAudit), nil
 	default:
 		logger.Error(ctx, "Unknown job action [%s]", job.JobAction)
 		return nil, fmt.Errorf("unknown job action [%s]", job.JobAction)
@@ -116,6 +152,17 @@ func GetFrameInterface(ctx context.Context, job *models.Job, advancedParam ...st
 			return nil, err
 		}
 		clusterWrapper = pbClusterWrappers[0]
+		if job.JobAction == constants.ActionResizeCluster {
+			roleResizeResources, err := models.NewRoleResizeResources(job.Directive)
+			if err != nil {
+				return nil, err
+			}
+			for _, roleResizeResource := range roleResizeResources {
+				if roleResizeResource.ClusterRole != nil {
+					clusterWrapper.ClusterRoles[roleResizeResource.Role] = roleResizeResource.ClusterRole
+				}
+			}
+		}
 	default:
 		clusterWrapper, err = models.NewClusterWrapper(ctx, job.Directive)
 		if err != nil {
@@ -193,22 +240,10 @@ func ParseClusterConf(ctx context.Context, versionId, runtimeId, conf string, cl
 				logger.Error(ctx, "Parse conf [%s] failed: %+v", conf, err)
 				return clusterWrapper, err
 			}
-			appPackage.ConfigTemplate.FillInDefaultConfig(confJson)
-		}
-		confJson = appPackage.ConfigTemplate.GetDefaultConfig()
-		err = appPackage.Validate(confJson)
-		if err != nil {
-			logger.Error(ctx, "Validate conf [%s] failed: %+v", conf, err)
-			return clusterWrapper, err
-		}
-		clusterConf, err = appPackage.ClusterConfTemplate.Render(confJson)
-		if err != nil {
-			logger.Error(ctx, "Render app version [%s] cluster template failed: %+v", versionId, err)
-			return clusterWrapper, err
 		}
-		err = clusterConf.Validate()
+		clusterConf, err = appPackage.RenderClusterConf(confJson)
 		if err != nil {
-			logger.Error(ctx, "Validate app version [%s] conf [%s] failed: %+v", versionId, conf, err)
+			logger.Error(ctx, "Render app version [%s] conf [%s] failed: %+v", versionId, conf, err)
 			return clusterWrapper, err
 		}
 		clusterConf.AppId = resp.GetAppId().GetValue()
@@ -6,6 +6,7 @@ package vmbased
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"openpitrix.io/openpitrix/pkg/config"
@@ -89,3 +90,228 @@ func TestSplitJobIntoTasks(t *testing.T) {
 	}
 	testCreateCluster(t, frame)
 }
+
+func TestUpgradeClusterLayer(t *testing.T) {
+	clusterWrapper := getTestClusterWrapper(t)
+	clusterWrapper.Cluster.Status = constants.StatusActive
+
+	fromClusterCommons := make(map[string]*models.ClusterCommon)
+	for role, clusterCommon := range clusterWrapper.ClusterCommons {
+		fromClusterCommon := *clusterCommon
+		fromClusterCommons[role] = &fromClusterCommon
+	}
+	clusterWrapper.ClusterCommons["hbase-slave"].ImageId = "img-upgraded"
+	clusterWrapper.ClusterCommons["hbase-slave"].UpgradeService = `{"cmd":"USER=root /opt/hbase/bin/upgrade.sh"}`
+
+	mockJob := &models.Job{
+		JobId:     "j-1234",
+		Owner:     "usr-1234",
+		ClusterId: "cl-1234",
+		Directive: jsonutil.ToString(&models.ClusterUpgradeDirective{
+			ClusterWrapper:     clusterWrapper,
+			FromClusterCommons: fromClusterCommons,
+		}),
+		JobAction: constants.ActionUpgradeCluster,
+	}
+
+	runtime := new(models.RuntimeDetails)
+	runtime.RuntimeId = "rt-1234"
+	runtime.Runtime.Provider = constants.ProviderQingCloud
+	runtime.Zone = "testing"
+
+	frame := &Frame{
+		Job:            mockJob,
+		ClusterWrapper: clusterWrapper,
+		Runtime:        runtime,
+		Ctx:            context.Background(),
+		RuntimeProviderConfig: &config.RuntimeProviderConfig{
+			ImageId: "img:abcd",
+		},
+	}
+	rootTaskLayer := frame.UpgradeClusterLayer(fromClusterCommons)
+
+	expectResult := []ActionNum{
+		{ActionRegisterCmd, 2}, // hbase-hdfs-master and hbase-master stop
+		{ActionRegisterCmd, 3}, // hbase-slave stop
+		{ActionStopConfd, 5},
+		{ActionPingDrone, 5},
+		{ActionRemoveContainerOnDrone, 3}, // hbase-slave swap image
+		{ActionPingDrone, 5},
+		{ActionSetDroneConfig, 5},
+		{ActionDeregisterMetadata, 1},
+		{ActionDeregisterMetadataMapping, 1},
+		{ActionRegisterMetadata, 1},
+		{ActionRegisterMetadataMapping, 1},
+		{ActionStartConfd, 5},
+		{ActionRegisterCmd, 3}, // hbase-slave upgrade
+		{ActionRegisterCmd, 1}, // hbase-hdfs-master start
+		{ActionRegisterCmd, 1}, // hbase-master start
+		{ActionRegisterCmd, 3}, // hbase-slave start
+		{ActionDeregisterCmd, 5},
+	}
+
+	var result []ActionNum
+	for rootTaskLayer != nil {
+		result = append(result, ActionNum{rootTaskLayer.Tasks[0].TaskAction, len(rootTaskLayer.Tasks)})
+		rootTaskLayer = rootTaskLayer.Child
+	}
+
+	if len(result) != len(expectResult) {
+		t.Errorf("Expect [%d] task layer, while get [%d] task layer", len(expectResult), len(result))
+	}
+
+	for index := range result {
+		if index < len(expectResult) && result[index] != expectResult[index] {
+			t.Errorf("Index [%d] expect [%+v], while get [%+v]", index, expectResult[index], result[index])
+		}
+	}
+}
+
+func getTestSnapshotFrame(t *testing.T, jobAction string) (*Frame, []string) {
+	clusterWrapper := getTestClusterWrapper(t)
+	clusterWrapper.Cluster.Status = constants.StatusActive
+	clusterWrapper.ClusterCommons["hbase-slave"].BackupService = `{"cmd":"USER=root /opt/hbase/bin/backup.sh"}`
+	clusterWrapper.ClusterCommons["hbase-slave"].RestoreService = `{"cmd":"USER=root /opt/hbase/bin/restore.sh"}`
+
+	var nodeIds []string
+	for nodeId, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		if clusterNode.Role == "hbase-slave" {
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+
+	runtime := new(models.RuntimeDetails)
+	runtime.RuntimeId = "rt-1234"
+	runtime.Runtime.Provider = constants.ProviderQingCloud
+	runtime.Zone = "testing"
+
+	frame := &Frame{
+		Job: &models.Job{
+			JobId:     "j-1234",
+			Owner:     "usr-1234",
+			ClusterId: "cl-1234",
+			JobAction: jobAction,
+		},
+		ClusterWrapper: clusterWrapper,
+		Runtime:        runtime,
+		Ctx:            context.Background(),
+		RuntimeProviderConfig: &config.RuntimeProviderConfig{
+			ImageId: "img:abcd",
+		},
+	}
+	return frame, nodeIds
+}
+
+func checkTaskLayer(t *testing.T, rootTaskLayer *models.TaskLayer, expectResult []ActionNum) {
+	var result []ActionNum
+	for rootTaskLayer != nil {
+		result = append(result, ActionNum{rootTaskLayer.Tasks[0].TaskAction, len(rootTaskLayer.Tasks)})
+		rootTaskLayer = rootTaskLayer.Child
+	}
+
+	if len(result) != len(expectResult) {
+		t.Errorf("Expect [%d] task layer, while get [%d] task layer", len(expectResult), len(result))
+	}
+
+	for index := range result {
+		if index < len(expectResult) && result[index] != expectResult[index] {
+			t.Errorf("Index [%d] expect [%+v], while get [%+v]", index, expectResult[index], result[index])
+		}
+	}
+}
+
+func TestCreateClusterSnapshotLayer(t *testing.T) {
+	frame, nodeIds := getTestSnapshotFrame(t, constants.ActionCreateClusterSnapshot)
+	snapshot := &models.ClusterSnapshot{
+		SnapshotId:   "ss-1234",
+		Roles:        "hbase-slave",
+		NodeIds:      strings.Join(nodeIds, ","),
+		SnapshotType: constants.SnapshotTypeFull,
+	}
+
+	checkTaskLayer(t, frame.CreateClusterSnapshotLayer(snapshot), []ActionNum{
+		{ActionRegisterCmd, 3}, // hbase-slave backup
+		{ActionDeregisterCmd, 3},
+	})
+}
+
+func TestRestoreClusterFromSnapshotLayer(t *testing.T) {
+	frame, nodeIds := getTestSnapshotFrame(t, constants.ActionRestoreClusterFromSnapshot)
+	snapshots := []*models.ClusterSnapshot{
+		{
+			SnapshotId:       "ss-1234",
+			Roles:            "hbase-slave",
+			NodeIds:          strings.Join(nodeIds, ","),
+			SnapshotType:     constants.SnapshotTypeFull,
+			ChildSnapshotIds: "ss-5678",
+		},
+		{
+			SnapshotId:       "ss-5678",
+			Roles:            "hbase-slave",
+			NodeIds:          strings.Join(nodeIds, ","),
+			SnapshotType:     constants.SnapshotTypeIncremental,
+			ParentSnapshotId: "ss-1234",
+		},
+	}
+
+	checkTaskLayer(t, frame.RestoreClusterFromSnapshotLayer(snapshots), []ActionNum{
+		{ActionRegisterCmd, 3}, // hbase-slave stop
+		{ActionRegisterCmd, 3}, // hbase-slave restore full snapshot
+		{ActionRegisterCmd, 3}, // hbase-slave restore incremental snapshot
+		{ActionRegisterCmd, 3}, // hbase-slave start
+		{ActionDeregisterCmd, 3},
+	})
+}
+
+func TestGetServiceCmd(t *testing.T) {
+	cmd := "/opt/backup.sh"
+	if getServiceCmd(cmd, nil) != cmd {
+		t.Errorf("Cmd without params should not be changed")
+	}
+	serviceCmd := getServiceCmd(cmd, map[string]interface{}{
+		"SNAPSHOT_TYPE": "full",
+		"SNAPSHOT_ID":   "ss-1234",
+	})
+	expected := `export SNAPSHOT_ID='ss-1234' SNAPSHOT_TYPE='full';/opt/backup.sh`
+	if serviceCmd != expected {
+		t.Errorf("Expect cmd [%s], while get [%s]", expected, serviceCmd)
+	}
+
+	// params given by user must not be expanded by shell
+	serviceCmd = getServiceCmd(cmd, map[string]interface{}{
+		"MODE": `$(reboot)'; reboot`,
+	})
+	expected = `export MODE='$(reboot)'\''; reboot';/opt/backup.sh`
+	if serviceCmd != expected {
+		t.Errorf("Expect cmd [%s], while get [%s]", expected, serviceCmd)
+	}
+}
+
+func TestRunClusterServiceLayer(t *testing.T) {
+	frame, nodeIds := getTestSnapshotFrame(t, constants.ActionRunClusterService)
+	frame.ClusterWrapper.ClusterCommons["hbase-slave"].CustomService =
+		`{"rebalance":{"cmd":"/opt/hbase/bin/rebalance.sh","service_params":{"mode":{"type":"string"}}}}`
+	clusterServiceAudit := &models.ClusterServiceAudit{
+		ClusterServiceAuditId: "csa-1234",
+		ServiceName:           "rebalance",
+		Roles:                 "hbase-slave",
+		NodeIds:               strings.Join(nodeIds, ","),
+		ServiceParams:         `{"mode":"slow"}`,
+	}
+
+	taskLayer := frame.RunClusterServiceLayer(clusterServiceAudit)
+	checkTaskLayer(t, taskLayer, []ActionNum{
+		{ActionRegisterCmd, 3}, // hbase-slave rebalance
+		{ActionDeregisterCmd, 3},
+	})
+	for _, task := range taskLayer.Tasks {
+		meta := new(models.Meta)
+		err := jsonutil.Decode([]byte(task.Directive), meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(meta.Cnodes, "export mode='slow';/opt/hbase/bin/rebalance.sh") {
+			t.Errorf("Service params not exported to cmd of node [%s]: %s", task.NodeId, meta.Cnodes)
+		}
+	}
+}
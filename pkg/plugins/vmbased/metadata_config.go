@@ -6,6 +6,8 @@ package vmbased
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/models"
@@ -34,6 +36,13 @@ func (m *MetadataConfig) GetDroneConfig(nodeId string) string {
 		ConfdSelfHost:  clusterNode.PrivateIp,
 		LogLevel:       MetadataLogLevel,
 	}
+	role := strings.TrimSuffix(clusterNode.Role, constants.ReplicaRoleSuffix)
+	if clusterCommon, exist := m.ClusterWrapper.ClusterCommons[role]; exist {
+		if monitor := clusterCommon.GetMonitor(); monitor != nil {
+			droneConfig.MonitorCmd = monitor.Cmd
+			droneConfig.MonitorIntervalSeconds = int32(monitor.GetInterval() / time.Second)
+		}
+	}
 	config := &pbtypes.SetDroneConfigRequest{
 		Endpoint: droneEndpoint,
 		Config:   droneConfig,
@@ -98,6 +107,15 @@ func (m *MetadataConfig) GetFrontgateConfig(nodeId string) string {
 		LogLevel:    MetadataLogLevel,
 		AutoUpdate:  pi.Global().GlobalConfig().Cluster.FrontgateAutoUpdate,
 	}
+	if config.AutoUpdate {
+		updateConfig := pi.Global().GlobalConfig().Cluster.FrontgateUpdate
+		config.UpdateConfig = &pbtypes.FrontgateUpdateConfig{
+			ReleaseUrl:       updateConfig.ReleaseUrl,
+			ReleasePublicKey: updateConfig.ReleasePublicKey,
+			DroneCanaryCount: updateConfig.DroneCanaryCount,
+			DroneBatchSize:   updateConfig.DroneBatchSize,
+		}
+	}
 	if pi.Global().GlobalConfig().Pilot.Port > 0 {
 		config.PilotPort = pi.Global().GlobalConfig().Pilot.Port
 	} else {
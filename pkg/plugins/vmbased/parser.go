@@ -471,9 +471,11 @@ func (p *Parser) ParseClusterCommon(clusterConf opapp.ClusterConf, node opapp.No
 			clusterCommon.DeleteSnapshotService = serviceStr
 		case constants.ServiceUpgrade:
 			clusterCommon.UpgradeService = serviceStr
+		case constants.ServiceCustom:
+			// the custom services are kept by name, see ClusterCommon.GetCustomService
+			clusterCommon.CustomService = serviceStr
 		default:
-			customService := map[string]interface{}{constants.ServiceCustom: service}
-			clusterCommon.CustomService = jsonutil.ToString(customService)
+			logger.Warn(p.Ctx, "Unknown service [%s] ignored", serviceName)
 		}
 	}
 
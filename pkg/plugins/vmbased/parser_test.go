@@ -415,7 +415,7 @@ var hbaseClusterCommons = map[string]models.ClusterCommon{
 		RestoreService:             "",
 		DeleteSnapshotService:      "",
 		HealthCheck:                "{\"enable\":true,\"interval_sec\":60,\"timeout_sec\":45,\"action_timeout_sec\":45,\"healthy_threshold\":2,\"unhealthy_threshold\":2,\"check_cmd\":\"/opt/hbase/bin/health-check.sh\",\"action_cmd\":\"/opt/hbase/bin/health-action.sh\"}",
-		Monitor:                    "{\"enable\":true,\"cmd\":\"/usr/bin/python /opt/hbase/bin/hbase-monitor.py\",\"items\":{\"ritCount\":{\"unit\":\"region\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null}},\"groups\":null,\"display\":[\"ritCount\"],\"alarm\":null}",
+		Monitor:                    "{\"enable\":true,\"interval_sec\":0,\"cmd\":\"/usr/bin/python /opt/hbase/bin/hbase-monitor.py\",\"items\":{\"ritCount\":{\"unit\":\"region\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null}},\"groups\":null,\"display\":[\"ritCount\"],\"alarm\":null}",
 		Passphraseless:             "",
 		VerticalScalingPolicy:      "sequential",
 		AgentInstalled:             true,
@@ -443,7 +443,7 @@ var hbaseClusterCommons = map[string]models.ClusterCommon{
 		RestoreService:             "",
 		DeleteSnapshotService:      "",
 		HealthCheck:                "{\"enable\":true,\"interval_sec\":60,\"timeout_sec\":45,\"action_timeout_sec\":45,\"healthy_threshold\":2,\"unhealthy_threshold\":2,\"check_cmd\":\"sh /opt/hadoop/sbin/health-check.sh\",\"action_cmd\":\"sh /opt/hadoop/sbin/health-action.sh\"}",
-		Monitor:                    "{\"enable\":true,\"cmd\":\"/usr/bin/python /opt/hbase/bin/hbase-monitor.py\",\"items\":{\"DeadNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"DecomDeadNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"DecomLiveNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"DecommissioningNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesAppended\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesCreated\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesDeleted\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesRenamed\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesTotal\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"LiveNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"PercentRemaining\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"PercentUsed\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"RemainingGB\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"UsedGB\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null}},\"groups\":{\"DFS Capacity\":[\"UsedGB\",\"RemainingGB\"],\"DFS Files\":[\"FilesTotal\",\"FilesCreated\",\"FilesAppended\",\"FilesRenamed\",\"FilesDeleted\"],\"DFS Percentage\":[\"PercentUsed\",\"PercentRemaining\"],\"Data Nodes\":[\"LiveNodes\",\"DeadNodes\",\"DecomLiveNodes\",\"DecomDeadNodes\",\"DecommissioningNodes\"]},\"display\":[\"DFS Files\",\"DFS Percentage\",\"DFS Capacity\",\"Data Nodes\"],\"alarm\":null}",
+		Monitor:                    "{\"enable\":true,\"interval_sec\":0,\"cmd\":\"/usr/bin/python /opt/hbase/bin/hbase-monitor.py\",\"items\":{\"DeadNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"DecomDeadNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"DecomLiveNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"DecommissioningNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesAppended\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesCreated\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesDeleted\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesRenamed\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"FilesTotal\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"LiveNodes\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"PercentRemaining\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"PercentUsed\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"RemainingGB\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null},\"UsedGB\":{\"unit\":\"\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":0,\"enums\":null}},\"groups\":{\"DFS Capacity\":[\"UsedGB\",\"RemainingGB\"],\"DFS Files\":[\"FilesTotal\",\"FilesCreated\",\"FilesAppended\",\"FilesRenamed\",\"FilesDeleted\"],\"DFS Percentage\":[\"PercentUsed\",\"PercentRemaining\"],\"Data Nodes\":[\"LiveNodes\",\"DeadNodes\",\"DecomLiveNodes\",\"DecomDeadNodes\",\"DecommissioningNodes\"]},\"display\":[\"DFS Files\",\"DFS Percentage\",\"DFS Capacity\",\"Data Nodes\"],\"alarm\":null}",
 		Passphraseless:             "",
 		VerticalScalingPolicy:      "sequential",
 		AgentInstalled:             true,
@@ -471,7 +471,7 @@ var hbaseClusterCommons = map[string]models.ClusterCommon{
 		RestoreService:             "",
 		DeleteSnapshotService:      "",
 		HealthCheck:                "{\"enable\":true,\"interval_sec\":60,\"timeout_sec\":45,\"action_timeout_sec\":45,\"healthy_threshold\":2,\"unhealthy_threshold\":2,\"check_cmd\":\"sh /opt/hbase/bin/health-check.sh\",\"action_cmd\":\"sh /opt/hbase/bin/health-action.sh\"}",
-		Monitor:                    "{\"enable\":true,\"cmd\":\"/usr/bin/python /opt/hbase/bin/hbase-monitor.py\",\"items\":{\"GcTimeMillisConcurrentMarkSweep\":{\"unit\":\"ms\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"blockCacheCountHitPercent\":{\"unit\":\"%\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":100,\"enums\":null},\"blockCacheHitCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"readRequestCount\":{\"unit\":\"number per second\",\"value_type\":\"int\",\"statistics_type\":\"rate\",\"scale_factor_when_display\":0,\"enums\":null},\"slowAppendCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowDeleteCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowGetCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowIncrementCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowPutCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"writeRequestCount\":{\"unit\":\"number per second\",\"value_type\":\"int\",\"statistics_type\":\"rate\",\"scale_factor_when_display\":0,\"enums\":null}},\"groups\":{\"RequestCount\":[\"readRequestCount\",\"writeRequestCount\"],\"SlowCount\":[\"slowDeleteCount\",\"slowIncrementCount\",\"slowGetCount\",\"slowAppendCount\",\"slowPutCount\"]},\"display\":[\"blockCacheHitCount\",\"blockCacheCountHitPercent\",\"RequestCount\",\"SlowCount\",\"GcTimeMillisConcurrentMarkSweep\"],\"alarm\":null}",
+		Monitor:                    "{\"enable\":true,\"interval_sec\":0,\"cmd\":\"/usr/bin/python /opt/hbase/bin/hbase-monitor.py\",\"items\":{\"GcTimeMillisConcurrentMarkSweep\":{\"unit\":\"ms\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"blockCacheCountHitPercent\":{\"unit\":\"%\",\"value_type\":\"int\",\"statistics_type\":\"latest\",\"scale_factor_when_display\":100,\"enums\":null},\"blockCacheHitCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"readRequestCount\":{\"unit\":\"number per second\",\"value_type\":\"int\",\"statistics_type\":\"rate\",\"scale_factor_when_display\":0,\"enums\":null},\"slowAppendCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowDeleteCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowGetCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowIncrementCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"slowPutCount\":{\"unit\":\"number\",\"value_type\":\"int\",\"statistics_type\":\"delta\",\"scale_factor_when_display\":0,\"enums\":null},\"writeRequestCount\":{\"unit\":\"number per second\",\"value_type\":\"int\",\"statistics_type\":\"rate\",\"scale_factor_when_display\":0,\"enums\":null}},\"groups\":{\"RequestCount\":[\"readRequestCount\",\"writeRequestCount\"],\"SlowCount\":[\"slowDeleteCount\",\"slowIncrementCount\",\"slowGetCount\",\"slowAppendCount\",\"slowPutCount\"]},\"display\":[\"blockCacheHitCount\",\"blockCacheCountHitPercent\",\"RequestCount\",\"SlowCount\",\"GcTimeMillisConcurrentMarkSweep\"],\"alarm\":null}",
 		Passphraseless:             "",
 		VerticalScalingPolicy:      "sequential",
 		AgentInstalled:             true,
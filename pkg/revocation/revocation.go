@@ -0,0 +1,101 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package revocation keeps the deny-list of access tokens in etcd. Access tokens
+// are stateless JWTs, so a revoked token, session or user is recorded until the
+// access tokens issued before the revocation expired.
+package revocation
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/jwtutil"
+)
+
+// ErrTokenIdMissing is returned when revoking an access token without id (jti),
+// which would revoke all the access tokens without id
+var ErrTokenIdMissing = errors.New("revocation: access token id is missing")
+
+func put(ctx context.Context, e *etcd.Etcd, key, value string, ttl time.Duration) error {
+	seconds := int64(ttl / time.Second)
+	if seconds <= 0 {
+		// the tokens already expired, nothing to revoke
+		return nil
+	}
+	resp, err := e.Grant(ctx, seconds)
+	if err != nil {
+		logger.Error(ctx, "Grant ttl from etcd failed: %+v", err)
+		return err
+	}
+	_, err = e.Put(ctx, key, value, clientv3.WithLease(resp.ID))
+	if err != nil {
+		logger.Error(ctx, "Put revoked key [%s] to etcd failed: %+v", key, err)
+		return err
+	}
+	return nil
+}
+
+// RevokeToken revokes the access token until it expires
+func RevokeToken(ctx context.Context, e *etcd.Etcd, claims *jwtutil.Claims) error {
+	if claims.TokenId == "" {
+		return ErrTokenIdMissing
+	}
+	return put(ctx, e, constants.RevokedTokenPrefix+claims.TokenId, claims.UserId, time.Until(claims.Expiry))
+}
+
+// RevokeSession revokes all the access tokens issued by the session,
+// ttl should not be less than the expire time of access token.
+func RevokeSession(ctx context.Context, e *etcd.Etcd, sessionId string, ttl time.Duration) error {
+	return put(ctx, e, constants.RevokedSessionPrefix+sessionId, sessionId, ttl)
+}
+
+// RevokeUser revokes all the access tokens of user issued before now,
+// ttl should not be less than the expire time of access token.
+func RevokeUser(ctx context.Context, e *etcd.Etcd, userId string, ttl time.Duration) error {
+	revokedAt := strconv.FormatInt(time.Now().Unix(), 10)
+	return put(ctx, e, constants.RevokedUserPrefix+userId, revokedAt, ttl)
+}
+
+// IsRevoked returns true if the access token, the session issuing it or its user is revoked
+func IsRevoked(ctx context.Context, e *etcd.Etcd, claims *jwtutil.Claims) (bool, error) {
+	ops := []clientv3.Op{
+		clientv3.OpGet(constants.RevokedUserPrefix + claims.UserId),
+	}
+	if claims.TokenId != "" {
+		ops = append(ops, clientv3.OpGet(constants.RevokedTokenPrefix+claims.TokenId, clientv3.WithCountOnly()))
+	}
+	if claims.SessionId != "" {
+		ops = append(ops, clientv3.OpGet(constants.RevokedSessionPrefix+claims.SessionId, clientv3.WithCountOnly()))
+	}
+	resp, err := e.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		logger.Error(ctx, "Get revoked keys of token [%s] from etcd failed: %+v", claims.TokenId, err)
+		return false, err
+	}
+
+	for _, r := range resp.Responses[1:] {
+		if r.GetResponseRange().Count > 0 {
+			return true, nil
+		}
+	}
+	kvs := resp.Responses[0].GetResponseRange().Kvs
+	if len(kvs) == 0 {
+		return false, nil
+	}
+	revokedAt, err := strconv.ParseInt(string(kvs[0].Value), 10, 64)
+	if err != nil {
+		logger.Error(ctx, "Parse revoked time [%s] of user [%s] failed: %+v", string(kvs[0].Value), claims.UserId, err)
+		return false, err
+	}
+	// issued time of token is in seconds, tokens issued in the second of revocation are revoked too
+	return claims.IssuedAt.Unix() <= revokedAt, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build etcd
+
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"openpitrix.io/openpitrix/pkg/config/test_config"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/jwtutil"
+)
+
+var tc = test_config.NewEtcdTestConfig()
+
+func newClaims(userId, sessionId string, issuedAt time.Time) *jwtutil.Claims {
+	return &jwtutil.Claims{
+		TokenId:   jwtutil.NewTokenId(),
+		SessionId: sessionId,
+		UserId:    userId,
+		IssuedAt:  issuedAt,
+		Expiry:    issuedAt.Add(time.Hour),
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	userId := idutil.GetUuid("usr-")
+	sessionId := idutil.GetUuid("token-")
+	claims := newClaims(userId, sessionId, time.Now())
+
+	revoked, err := IsRevoked(ctx, e, claims)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	err = RevokeToken(ctx, e, claims)
+	require.NoError(t, err)
+	revoked, err = IsRevoked(ctx, e, claims)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// other tokens of the session are still valid until the session is revoked
+	another := newClaims(userId, sessionId, time.Now())
+	revoked, err = IsRevoked(ctx, e, another)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// token without id is not revocable, and not revoked by the other tokens
+	withoutId := newClaims(userId, "", time.Now())
+	withoutId.TokenId = ""
+	err = RevokeToken(ctx, e, withoutId)
+	require.Equal(t, ErrTokenIdMissing, err)
+	revoked, err = IsRevoked(ctx, e, withoutId)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	err = RevokeSession(ctx, e, sessionId, time.Hour)
+	require.NoError(t, err)
+	revoked, err = IsRevoked(ctx, e, another)
+	require.NoError(t, err)
+	require.True(t, revoked)
+}
+
+func TestRevokeUser(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	userId := idutil.GetUuid("usr-")
+	issued := newClaims(userId, "", time.Now().Add(-time.Minute))
+	err = RevokeUser(ctx, e, userId, time.Hour)
+	require.NoError(t, err)
+
+	revoked, err := IsRevoked(ctx, e, issued)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// tokens issued after the revocation are valid
+	reissued := newClaims(userId, "", time.Now().Add(time.Second))
+	revoked, err = IsRevoked(ctx, e, reissued)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
@@ -16,6 +16,8 @@ type Sender struct {
 	UserId     string    `json:"user_id,omitempty"`
 	OwnerPath  OwnerPath `json:"owner_path,omitempty"`
 	AccessPath OwnerPath `json:"access_path,omitempty"`
+	// id of session issuing the access token of sender
+	SessionId string `json:"session_id,omitempty"`
 }
 
 func GetSystemSender() *Sender {
@@ -13,6 +13,8 @@ import (
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/topic"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
 	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
@@ -37,6 +39,16 @@ func validateRoleUser(ctx context.Context, roleId, userId string) bool {
 	}
 }
 
+// notifyAccessChanged invalidates the CanDo decisions of users cached by api gateway,
+// or decisions of all users if userIds is empty. The cached decisions expire anyway,
+// so failing to notify does not fail the request.
+func notifyAccessChanged(ctx context.Context, userIds ...string) {
+	err := topic.PushAccessEvent(ctx, pi.Global().Etcd(ctx), userIds...)
+	if err != nil {
+		logger.Error(ctx, "Notify access of users [%v] changed failed: %+v", userIds, err)
+	}
+}
+
 func (p *Server) CanDo(ctx context.Context, req *pb.CanDoRequest) (*pb.CanDoResponse, error) {
 	v, err := amClient.CanDo(ctx, &pbam.CanDoRequest{
 		UserId:    req.UserId,
@@ -77,6 +89,7 @@ func (p *Server) ModifyRoleModule(ctx context.Context, req *pb.ModifyRoleModuleR
 	if err != nil {
 		return nil, err
 	}
+	notifyAccessChanged(ctx)
 
 	return &pb.ModifyRoleModuleResponse{
 		RoleId: req.RoleId,
@@ -108,6 +121,7 @@ func (p *Server) DeleteRoles(ctx context.Context, req *pb.DeleteRolesRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	notifyAccessChanged(ctx)
 
 	return &pb.DeleteRolesResponse{
 		RoleId: req.RoleId,
@@ -180,6 +194,7 @@ func (p *Server) BindUserRole(ctx context.Context, req *pb.BindUserRoleRequest)
 	if err != nil {
 		return nil, err
 	}
+	notifyAccessChanged(ctx, req.UserId...)
 
 	return &pb.BindUserRoleResponse{
 		UserId: req.UserId,
@@ -448,6 +448,11 @@ func (p *Server) ModifyUser(ctx context.Context, req *pb.ModifyUserRequest) (*pb
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 		}
+		// tokens issued with the old password are revoked
+		err = p.revokeUserTokens(ctx, userId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
 	}
 
 	_, err = imClient.ModifyUser(ctx, &pbim.ModifyUserRequest{
@@ -467,28 +472,35 @@ func (p *Server) ModifyUser(ctx context.Context, req *pb.ModifyUserRequest) (*pb
 }
 
 func (p *Server) DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*pb.DeleteUsersResponse, error) {
-	return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorPermissionDenied)
-	//userIds := req.GetUserId()
-	//_, err := CheckUsersPermission(ctx, userIds)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//_, err = amClient.UnbindUserRole(ctx, &pbam.UnbindUserRoleRequest{
-	//	UserId: userIds,
-	//})
-	//if err != nil {
-	//	return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorCannotDeleteUsers)
-	//}
-	//_, err = imClient.DeleteUsers(ctx, &pbim.DeleteUsersRequest{
-	//	UserId: userIds,
-	//})
-	//if err != nil {
-	//	return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorCannotDeleteUsers)
-	//}
-	//
-	//return &pb.DeleteUsersResponse{
-	//	UserId: userIds,
-	//}, nil
+	userIds := req.GetUserId()
+	_, err := CheckUsersPermission(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	_, err = amClient.UnbindUserRole(ctx, &pbam.UnbindUserRoleRequest{
+		UserId: userIds,
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorCannotDeleteUsers)
+	}
+	_, err = imClient.DeleteUsers(ctx, &pbim.DeleteUsersRequest{
+		UserId: userIds,
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorCannotDeleteUsers)
+	}
+	// tokens of deleted users are no longer valid
+	for _, userId := range userIds {
+		err = p.revokeUserTokens(ctx, userId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+	}
+	notifyAccessChanged(ctx, userIds...)
+
+	return &pb.DeleteUsersResponse{
+		UserId: userIds,
+	}, nil
 }
 
 func (p *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
@@ -785,6 +797,10 @@ func (p *Server) ChangePassword(ctx context.Context, req *pb.ChangePasswordReque
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 	}
+	err = p.revokeUserTokens(ctx, resetInfo.UserId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
 
 	_, err = pi.Global().DB(ctx).
 		Update(constants.TableUserPasswordReset).
@@ -978,6 +994,8 @@ func (p *Server) JoinGroup(ctx context.Context, req *pb.JoinGroupRequest) (*pb.J
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorCannotJoinGroup)
 	}
+	// access path of users changed with group
+	notifyAccessChanged(ctx, userIds...)
 
 	return &pb.JoinGroupResponse{
 		GroupId: groupIds,
@@ -9,12 +9,16 @@ import (
 	"time"
 
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/revocation"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
 	"openpitrix.io/openpitrix/pkg/util/jwtutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
 )
 
 var (
@@ -94,7 +98,7 @@ func (p *Server) Token(ctx context.Context, req *pb.TokenRequest) (*pb.TokenResp
 	}
 
 	userId = token.UserId
-	accessToken, err := jwtutil.Generate(p.IAMConfig.SecretKey, p.IAMConfig.ExpireTime, userId)
+	accessToken, err := jwtutil.GenerateWithSession(p.IAMConfig.SecretKey, p.IAMConfig.ExpireTime, userId, token.TokenId)
 	if err != nil {
 		return nil, gerr.New(ctx, gerr.Internal, gerr.ErrorInternalError)
 	}
@@ -111,3 +115,180 @@ func (p *Server) Token(ctx context.Context, req *pb.TokenRequest) (*pb.TokenResp
 		RefreshToken: token.RefreshToken,
 	}, nil
 }
+
+// checkTokenOwner checks whether sender could manage the tokens of user
+func checkTokenOwner(ctx context.Context, userId string) error {
+	if ctxutil.GetSender(ctx).UserId == userId {
+		return nil
+	}
+	_, err := CheckUsersPermission(ctx, []string{userId})
+	return err
+}
+
+// revokeSession deletes the refresh token of session and revokes the access tokens issued by it
+func (p *Server) revokeSession(ctx context.Context, sessionId string) error {
+	err := deleteTokens(ctx, db.Eq(constants.ColumnTokenId, sessionId))
+	if err != nil {
+		return err
+	}
+	return revocation.RevokeSession(ctx, pi.Global().Etcd(ctx), sessionId, p.ExpireTime)
+}
+
+// revokeUserTokens deletes the refresh tokens of user and revokes the access tokens issued before now,
+// it is called when the user is deleted, the password of user is reset or an admin revokes them.
+func (p *Server) revokeUserTokens(ctx context.Context, userId string) error {
+	err := deleteTokens(ctx, db.Eq(constants.ColumnUserId, userId))
+	if err != nil {
+		logger.Error(ctx, "Delete refresh tokens of user [%s] failed: %+v", userId, err)
+		return err
+	}
+	err = revocation.RevokeUser(ctx, pi.Global().Etcd(ctx), userId, p.ExpireTime)
+	if err != nil {
+		logger.Error(ctx, "Revoke access tokens of user [%s] failed: %+v", userId, err)
+		return err
+	}
+	return nil
+}
+
+func (p *Server) RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	token := req.GetToken()
+	sessionId := req.GetSessionId()
+	if token == "" && sessionId == "" {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorParameterShouldNotBeEmpty, "token")
+	}
+
+	var session *models.Token
+	var err error
+	if sessionId != "" {
+		session, err = getTokenById(ctx, sessionId)
+		if err != nil {
+			if err == db.ErrNotFound {
+				return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, sessionId)
+			}
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+	} else if req.GetTokenTypeHint() != constants.TokenTypeHintAccessToken {
+		// try refresh token first, the token is an access token if not found
+		session, err = getTokenByRefreshToken(ctx, token)
+		if err != nil && err != db.ErrNotFound {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+	}
+
+	if session != nil {
+		err = checkTokenOwner(ctx, session.UserId)
+		if err != nil {
+			return nil, err
+		}
+		err = p.revokeSession(ctx, session.TokenId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+		return &pb.RevokeTokenResponse{
+			UserId:    session.UserId,
+			SessionId: session.TokenId,
+		}, nil
+	}
+
+	claims, err := jwtutil.ValidateClaims(p.SecretKey, token)
+	if err != nil {
+		if err == jwtutil.ErrExpired {
+			return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorAccessTokenExpired)
+		}
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorAuthFailure)
+	}
+	err = checkTokenOwner(ctx, claims.UserId)
+	if err != nil {
+		return nil, err
+	}
+	err = revocation.RevokeToken(ctx, pi.Global().Etcd(ctx), claims)
+	if err == revocation.ErrTokenIdMissing {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorAccessTokenWithoutId)
+	}
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+
+	return &pb.RevokeTokenResponse{
+		UserId: claims.UserId,
+	}, nil
+}
+
+func (p *Server) DescribeSessions(ctx context.Context, req *pb.DescribeSessionsRequest) (*pb.DescribeSessionsResponse, error) {
+	s := ctxutil.GetSender(ctx)
+	userIds := req.GetUserId()
+	if len(userIds) == 0 {
+		userIds = []string{s.UserId}
+	}
+	if len(userIds) > 1 || userIds[0] != s.UserId {
+		_, err := CheckUsersPermission(ctx, userIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+	query := pi.Global().DB(ctx).
+		Select(models.TokenColumns...).
+		From(constants.TableToken).
+		Offset(offset).
+		Limit(limit).
+		Where(db.Eq(constants.ColumnUserId, userIds)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		Where(db.Gt(constants.ColumnCreateTime, time.Now().Add(-p.RefreshTokenExpireTime))).
+		OrderDir(constants.ColumnCreateTime, false)
+
+	var tokens []*models.Token
+	_, err := query.Load(&tokens)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	count, err := query.Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	return &pb.DescribeSessionsResponse{
+		TotalCount: count,
+		SessionSet: models.TokensToPbSessions(tokens, p.RefreshTokenExpireTime),
+	}, nil
+}
+
+func (p *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	s := ctxutil.GetSender(ctx)
+	if s.SessionId == "" {
+		// access token is not issued by token service, revoke it by RevokeToken instead
+		return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorSessionNotFound)
+	}
+	err := p.revokeSession(ctx, s.SessionId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+
+	return &pb.LogoutResponse{
+		UserId:    s.UserId,
+		SessionId: s.SessionId,
+	}, nil
+}
+
+func (p *Server) RevokeUserTokens(ctx context.Context, req *pb.RevokeUserTokensRequest) (*pb.RevokeUserTokensResponse, error) {
+	userIds := req.GetUserId()
+	if len(userIds) == 0 {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorParameterShouldNotBeEmpty, "user_id")
+	}
+	_, err := CheckUsersPermission(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, userId := range userIds {
+		err = p.revokeUserTokens(ctx, userId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+	}
+
+	return &pb.RevokeUserTokensResponse{
+		UserId: userIds,
+	}, nil
+}
@@ -6,7 +6,9 @@ package account
 
 import (
 	"context"
+	"time"
 
+	"github.com/gocraft/dbr"
 	pbim "kubesphere.io/im/pkg/pb"
 
 	"openpitrix.io/openpitrix/pkg/constants"
@@ -85,6 +87,19 @@ func getTokenByRefreshToken(ctx context.Context, refreshToken string) (*models.T
 	return &token, nil
 }
 
+func getTokenById(ctx context.Context, tokenId string) (*models.Token, error) {
+	var token = models.Token{}
+	err := pi.Global().DB(ctx).
+		Select(models.TokenColumns...).
+		From(constants.TableToken).
+		Where(db.Eq(constants.ColumnTokenId, tokenId)).
+		LoadOne(&token)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
 func getLastToken(ctx context.Context, clientId, userId, scope string) (*models.Token, error) {
 	var token = models.Token{}
 	err := pi.Global().DB(ctx).
@@ -122,3 +137,15 @@ func newToken(ctx context.Context, clientId, scope, userId string) (*models.Toke
 		return token, nil
 	}
 }
+
+// deleteTokens marks the refresh tokens as deleted, so they could not refresh access tokens anymore
+func deleteTokens(ctx context.Context, condition dbr.Builder) error {
+	_, err := pi.Global().DB(ctx).
+		Update(constants.TableToken).
+		Set(constants.ColumnStatus, constants.StatusDeleted).
+		Set(constants.ColumnStatusTime, time.Now()).
+		Where(condition).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		Exec()
+	return err
+}
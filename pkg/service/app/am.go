@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/repoiface"
@@ -85,6 +86,19 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required("version_id").
 			Exec()
+	case *pb.CreateReviewPolicyRequest:
+		return manager.NewChecker(ctx, r).
+			Required("scope_type", "scope_id").
+			StringChosen("scope_type", constants.ReviewPolicyScopeTypes).
+			Exec()
+	case *pb.ModifyReviewPolicyRequest:
+		return manager.NewChecker(ctx, r).
+			Required("policy_id").
+			Exec()
+	case *pb.DeleteReviewPoliciesRequest:
+		return manager.NewChecker(ctx, r).
+			Required("policy_id").
+			Exec()
 	case *pb.GetAppStatisticsRequest:
 		return manager.NewChecker(ctx, r).
 			Exec()
@@ -12,7 +12,6 @@ import (
 	"time"
 
 	clientutil "openpitrix.io/openpitrix/pkg/client"
-	accessclient "openpitrix.io/openpitrix/pkg/client/access"
 	accountclient "openpitrix.io/openpitrix/pkg/client/account"
 	attachmentclient "openpitrix.io/openpitrix/pkg/client/attachment"
 	"openpitrix.io/openpitrix/pkg/client/category"
@@ -125,7 +124,10 @@ func (p *Server) describeApps(ctx context.Context, req *pb.DescribeAppsRequest,
 			Where(db.Eq(constants.ColumnCategoryId, categoryIds))
 		query = query.Where(db.Eq(constants.ColumnAppId, []*db.SelectQuery{subqueryStmt}))
 	}
-	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+	query, cursor, err := manager.AddQueryCursor(query, req, constants.ColumnCreateTime, constants.ColumnAppId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalPageToken)
+	}
 	if len(displayColumns) > 0 {
 		_, err := query.Load(&apps)
 		if err != nil {
@@ -136,6 +138,10 @@ func (p *Server) describeApps(ctx context.Context, req *pb.DescribeAppsRequest,
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 	}
+	nextPageToken, err := cursor.NextPageToken(apps, limit)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
 
 	appSet, err := formatAppSet(ctx, apps, active)
 	if err != nil {
@@ -143,12 +149,29 @@ func (p *Server) describeApps(ctx context.Context, req *pb.DescribeAppsRequest,
 	}
 
 	res := &pb.DescribeAppsResponse{
-		AppSet:     appSet,
-		TotalCount: count,
+		AppSet:        appSet,
+		TotalCount:    count,
+		NextPageToken: pbutil.ToProtoString(nextPageToken),
 	}
 	return res, nil
 }
 
+// ExportApps streams all the apps by pages of the max size, following the page token of previous page
+func (p *Server) ExportApps(req *pb.DescribeAppsRequest, srv pb.AppManager_ExportAppsServer) error {
+	ctx := srv.Context()
+	req.Limit = db.DefaultSelectLimit
+	return db.ExportPages(req.GetPageToken().GetValue(),
+		func(pageToken string) (interface{}, string, error) {
+			req.PageToken = pbutil.ToProtoString(pageToken)
+			res, err := p.describeApps(ctx, req, false)
+			return res, res.GetNextPageToken().GetValue(), err
+		},
+		func(page interface{}) error {
+			return srv.Send(page.(*pb.DescribeAppsResponse))
+		},
+	)
+}
+
 func (p *Server) ValidatePackage(ctx context.Context, req *pb.ValidatePackageRequest) (*pb.ValidatePackageResponse, error) {
 	res := &pb.ValidatePackageResponse{}
 	v, err := repoiface.LoadPackage(ctx, req.GetVersionType(), req.GetVersionPackage())
@@ -890,34 +913,17 @@ func (p *Server) SubmitAppVersion(ctx context.Context, req *pb.SubmitAppVersionR
 		return nil, err
 	}
 
+	version, versionReview, err := autoPassAppVersionReview(ctx, versionId)
+	if err != nil {
+		return nil, err
+	}
+
 	if enabled := pi.Global().GlobalConfig().InstallModule.Iam; enabled {
 		accountClient, err := accountclient.NewClient()
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 		}
 
-		accessClient, err := accessclient.NewClient()
-		if err != nil {
-			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-		}
-		noNeedIsvReview := accessClient.CheckActionBundleUser(ctx, []string{constants.ActionBundleIsvReview}, s.UserId)
-		if noNeedIsvReview {
-			// When DescribeAppVersionReviews, need sort by create time
-			time.Sleep(1 * time.Second)
-			operatorType := constants.OperatorTypeIsv
-			version, _ = CheckAppVersionPermission(ctx, versionId)
-			err = startAppVersionReview(ctx, version, operatorType)
-			if err != nil {
-				return nil, err
-			}
-			time.Sleep(1 * time.Second)
-			version, _ = CheckAppVersionPermission(ctx, versionId)
-			err = passAppVersionReview(ctx, version, constants.OperatorTypeIsv)
-			if err != nil {
-				return nil, err
-			}
-		}
-
 		return func() (*pb.SubmitAppVersionResponse, error) {
 
 			if !stringutil.StringIn(s.UserId, constants.InternalUsers) {
@@ -942,40 +948,14 @@ func (p *Server) SubmitAppVersion(ctx context.Context, req *pb.SubmitAppVersionR
 					Addresses:   []string{versionOwner.GetEmail().GetValue()},
 				})
 
-				// notify isv reviewers
-				if noNeedIsvReview {
-					systemCtx := clientutil.SetSystemUserToContext(ctx)
-					isv, err := accountClient.GetIsvFromUser(systemCtx, s.UserId)
-					if err != nil {
-						logger.Error(ctx, "Failed to get isv from user [%s], %+v", s.UserId, err)
-						return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-					}
-					isvCtx, err := clientutil.SetUserToContext(ctx, isv.GetUserId().GetValue(), "DescribeUsers")
-					if err != nil {
-						logger.Error(ctx, "Failed to set [%s] as sender: %+v", isv.GetUserId().GetValue(), err)
-						return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-					}
-					isvUsers, err := accessClient.GetActionBundleUsers(isvCtx, []string{constants.ActionBundleIsvReview})
+				// notify reviewers of the next stage
+				nextStage := versionReview.GetStages().Next(versionReview.Phase)
+				if nextStage != nil && version.Status != constants.StatusPassed {
+					reviewers, err := getReviewStageUsers(ctx, nextStage, s.UserId)
 					if err != nil {
 						return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 					}
-					for _, user := range isvUsers {
-						emailNotifications = append(emailNotifications, &models.EmailNotification{
-							Title:       constants.SubmitAppVersionNotifyReviewerTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-							Content:     constants.SubmitAppVersionNotifyReviewerContent.GetDefaultMessage(platformName, user.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
-							Owner:       s.UserId,
-							ContentType: constants.NfContentTypeVerify,
-							Addresses:   []string{user.GetEmail().GetValue()},
-						})
-					}
-				} else {
-					// notify business reviewers
-					systemCtx := clientutil.SetSystemUserToContext(ctx)
-					businessUsers, err := accessClient.GetActionBundleUsers(systemCtx, []string{constants.ActionBundleBusinessReview})
-					if err != nil {
-						return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-					}
-					for _, user := range businessUsers {
+					for _, user := range reviewers {
 						emailNotifications = append(emailNotifications, &models.EmailNotification{
 							Title:       constants.SubmitAppVersionNotifyReviewerTitle.GetDefaultMessage(platformName, app.Name, version.Name),
 							Content:     constants.SubmitAppVersionNotifyReviewerContent.GetDefaultMessage(platformName, user.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
@@ -1153,7 +1133,7 @@ func reviewAppVersion(ctx context.Context, operatorType string, req *pb.ReviewAp
 		return nil, err
 	}
 
-	err = startAppVersionReview(ctx, version, operatorType)
+	_, err = startAppVersionReview(ctx, version, operatorType)
 	if err != nil {
 		return nil, err
 	}
@@ -1177,20 +1157,22 @@ func passAppVersion(ctx context.Context, operatorType string, req *pb.PassAppVer
 		return nil, err
 	}
 
-	err = passAppVersionReview(ctx, version, operatorType)
+	versionReview, err := passAppVersionReview(ctx, version, operatorType)
 	if err != nil {
 		return nil, err
 	}
+	if operatorType != constants.OperatorTypeAdmin {
+		version, versionReview, err = autoPassAppVersionReview(ctx, versionId)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if enabled := pi.Global().GlobalConfig().InstallModule.Iam; enabled {
 		accountClient, err := accountclient.NewClient()
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 		}
-		accessClient, err := accessclient.NewClient()
-		if err != nil {
-			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-		}
 		if !stringutil.StringIn(s.UserId, constants.InternalUsers) {
 			var emailNotifications []*models.EmailNotification
 			versionOwner, err := accountClient.GetUser(ctx, version.Owner)
@@ -1202,16 +1184,14 @@ func passAppVersion(ctx context.Context, operatorType string, req *pb.PassAppVer
 			platformName := pi.Global().GlobalConfig().BasicCfg.PlatformName
 			platformUrl := pi.Global().GlobalConfig().BasicCfg.PlatformUrl
 
-			switch operatorType {
-			case constants.OperatorTypeIsv:
-				// notify business reviewers
-				systemCtx := clientutil.SetSystemUserToContext(ctx)
-				businessUsers, err := accessClient.GetActionBundleUsers(systemCtx, []string{constants.ActionBundleBusinessReview})
+			// notify reviewers of the next stage
+			nextStage := versionReview.GetStages().Next(versionReview.Phase)
+			if operatorType != constants.OperatorTypeAdmin && nextStage != nil {
+				reviewers, err := getReviewStageUsers(ctx, nextStage, versionReview.Owner)
 				if err != nil {
 					return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 				}
-
-				for _, user := range businessUsers {
+				for _, user := range reviewers {
 					emailNotifications = append(emailNotifications, &models.EmailNotification{
 						Title:       constants.SubmitAppVersionNotifyReviewerTitle.GetDefaultMessage(platformName, app.Name, version.Name),
 						Content:     constants.SubmitAppVersionNotifyReviewerContent.GetDefaultMessage(platformName, user.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
@@ -1220,50 +1200,19 @@ func passAppVersion(ctx context.Context, operatorType string, req *pb.PassAppVer
 						Addresses:   []string{user.GetEmail().GetValue()},
 					})
 				}
+			}
 
-				// notify app version owner
+			// notify app version owner
+			if message, ok := passReviewStageNotifyMessages[operatorType]; ok {
 				emailNotifications = append(emailNotifications, &models.EmailNotification{
-					Title:       constants.PassAppVersionInfoNotifyTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-					Content:     constants.PassAppVersionInfoNotifyContent.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
+					Title:       message.title.GetDefaultMessage(platformName, app.Name, version.Name),
+					Content:     message.content.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
 					Owner:       s.UserId,
 					ContentType: constants.NfContentTypeVerify,
 					Addresses:   []string{versionOwner.GetEmail().GetValue()},
 				})
-			case constants.OperatorTypeBusiness:
-				// notify technical reviewers
-				systemCtx := clientutil.SetSystemUserToContext(ctx)
-				technicalUsers, err := accessClient.GetActionBundleUsers(systemCtx, []string{constants.ActionBundleTechnicalReview})
-				if err != nil {
-					return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-				}
-				for _, user := range technicalUsers {
-					emailNotifications = append(emailNotifications, &models.EmailNotification{
-						Title:       constants.SubmitAppVersionNotifyReviewerTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-						Content:     constants.SubmitAppVersionNotifyReviewerContent.GetDefaultMessage(platformName, user.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
-						Owner:       s.UserId,
-						ContentType: constants.NfContentTypeVerify,
-						Addresses:   []string{user.GetEmail().GetValue()},
-					})
-				}
-				// notify app version owner
-				emailNotifications = append(emailNotifications, &models.EmailNotification{
-					Title:       constants.PassAppVersionBusinessNotifyTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-					Content:     constants.PassAppVersionBusinessNotifyContent.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
-					Owner:       s.UserId,
-					ContentType: constants.NfContentTypeVerify,
-					Addresses:   []string{versionOwner.GetEmail().GetValue()},
-				})
-			case constants.OperatorTypeTechnical:
-				// notify app version owner
-				emailNotifications = append(emailNotifications, &models.EmailNotification{
-					Title:       constants.PassAppVersionTechnicalNotifyTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-					Content:     constants.PassAppVersionTechnicalNotifyContent.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
-					Owner:       s.UserId,
-					ContentType: constants.NfContentTypeVerify,
-					Addresses:   []string{versionOwner.GetEmail().GetValue()},
-				})
-			default:
-				logger.Debug(ctx, "No need to notify users with operator type [%s]", operatorType)
+			} else {
+				logger.Debug(ctx, "No need to notify owner with operator type [%s]", operatorType)
 			}
 
 			_ = nfclient.SendEmailNotification(ctx, emailNotifications)
@@ -1310,32 +1259,15 @@ func rejectAppVersion(ctx context.Context, operatorType string, req *pb.RejectAp
 				logger.Error(ctx, "Failed to get user [%s], %+v", version.Owner, err)
 				return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 			}
-			switch operatorType {
-			case constants.OperatorTypeIsv:
-				emailNotifications = append(emailNotifications, &models.EmailNotification{
-					Title:       constants.RejectAppVersionInfoNotifyTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-					Content:     constants.RejectAppVersionInfoNotifyContent.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
-					Owner:       s.UserId,
-					ContentType: constants.NfContentTypeVerify,
-					Addresses:   []string{versionOwner.GetEmail().GetValue()},
-				})
-			case constants.OperatorTypeBusiness:
+			if message, ok := rejectReviewStageNotifyMessages[operatorType]; ok {
 				emailNotifications = append(emailNotifications, &models.EmailNotification{
-					Title:       constants.RejectAppVersionBusinessNotifyTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-					Content:     constants.RejectAppVersionBusinessNotifyContent.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
+					Title:       message.title.GetDefaultMessage(platformName, app.Name, version.Name),
+					Content:     message.content.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
 					Owner:       s.UserId,
 					ContentType: constants.NfContentTypeVerify,
 					Addresses:   []string{versionOwner.GetEmail().GetValue()},
 				})
-			case constants.OperatorTypeTechnical:
-				emailNotifications = append(emailNotifications, &models.EmailNotification{
-					Title:       constants.RejectAppVersionTechnicalNotifyTitle.GetDefaultMessage(platformName, app.Name, version.Name),
-					Content:     constants.RejectAppVersionTechnicalNotifyContent.GetDefaultMessage(platformName, versionOwner.GetUsername().GetValue(), app.Name, version.Name, platformUrl, platformUrl, platformUrl),
-					Owner:       s.UserId,
-					ContentType: constants.NfContentTypeVerify,
-					Addresses:   []string{versionOwner.GetEmail().GetValue()},
-				})
-			default:
+			} else {
 				logger.Debug(ctx, "No need to notify users with operator type [%s]", operatorType)
 			}
 			_ = nfclient.SendEmailNotification(ctx, emailNotifications)
@@ -1384,6 +1316,54 @@ func (p *Server) TechnicalRejectAppVersion(ctx context.Context, req *pb.RejectAp
 	return rejectAppVersion(ctx, constants.OperatorTypeTechnical, req)
 }
 
+func (p *Server) ReviewAppVersion(ctx context.Context, req *pb.ReviewAppVersionRequest) (*pb.ReviewAppVersionResponse, error) {
+	stage := req.GetStage().GetValue()
+	if stage == "" {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, "stage")
+	}
+	version, err := CheckAppVersionPermission(ctx, req.GetVersionId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	err = checkReviewStagePermission(ctx, version, stage)
+	if err != nil {
+		return nil, err
+	}
+	return reviewAppVersion(ctx, stage, req)
+}
+
+func (p *Server) PassAppVersion(ctx context.Context, req *pb.PassAppVersionRequest) (*pb.PassAppVersionResponse, error) {
+	stage := req.GetStage().GetValue()
+	if stage == "" {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, "stage")
+	}
+	version, err := CheckAppVersionPermission(ctx, req.GetVersionId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	err = checkReviewStagePermission(ctx, version, stage)
+	if err != nil {
+		return nil, err
+	}
+	return passAppVersion(ctx, stage, req)
+}
+
+func (p *Server) RejectAppVersion(ctx context.Context, req *pb.RejectAppVersionRequest) (*pb.RejectAppVersionResponse, error) {
+	stage := req.GetStage().GetValue()
+	if stage == "" {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, "stage")
+	}
+	version, err := CheckAppVersionPermission(ctx, req.GetVersionId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	err = checkReviewStagePermission(ctx, version, stage)
+	if err != nil {
+		return nil, err
+	}
+	return rejectAppVersion(ctx, stage, req)
+}
+
 func (p *Server) AdminPassAppVersion(ctx context.Context, req *pb.PassAppVersionRequest) (*pb.PassAppVersionResponse, error) {
 	return passAppVersion(ctx, constants.OperatorTypeAdmin, req)
 }
@@ -8,10 +8,15 @@ import (
 	"context"
 	"time"
 
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	accessclient "openpitrix.io/openpitrix/pkg/client/access"
+	accountclient "openpitrix.io/openpitrix/pkg/client/account"
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
 )
@@ -36,7 +41,11 @@ func submitAppVersionReview(ctx context.Context, version *models.AppVersion) err
 	if err != nil {
 		return err
 	}
-	versionReview := models.NewAppVersionReview(version.VersionId, version.AppId, status, s.GetOwnerPath())
+	stages, err := getReviewStages(ctx, version, s.UserId)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	versionReview := models.NewAppVersionReview(version.VersionId, version.AppId, status, s.GetOwnerPath(), stages)
 	versionReview.UpdatePhase(operatorType, status, operator, message)
 
 	_, err = pi.Global().DB(ctx).
@@ -65,7 +74,7 @@ func getAppVersionReview(ctx context.Context, version *models.AppVersion) (*mode
 	var versionReview = &models.AppVersionReview{}
 	var reviewId = version.ReviewId
 	if reviewId == "" {
-		versionReview = models.NewAppVersionReview(version.VersionId, version.AppId, constants.StatusSubmitted, version.OwnerPath)
+		versionReview = models.NewAppVersionReview(version.VersionId, version.AppId, constants.StatusSubmitted, version.OwnerPath, nil)
 
 		_, err = pi.Global().DB(ctx).
 			InsertInto(constants.TableAppVersionReview).
@@ -93,7 +102,45 @@ var reviewActionStatusMap = map[Action]string{
 	Cancel: constants.StatusDraft,
 }
 
-func execAppVersionReview(ctx context.Context, version *models.AppVersion, action Action, operatorType, message string) error {
+// the status of review is prefixed by the operator type, eg. business-in-review,
+// the stages not listed here are prefixed by their names
+var reviewStatusPrefixMap = map[string]string{
+	constants.OperatorTypeDeveloper: "",
+	constants.OperatorTypeIsv:       "isv-",
+	constants.OperatorTypeBusiness:  "business-",
+	constants.OperatorTypeTechnical: "develop-",
+	constants.OperatorTypeAdmin:     "admin-",
+}
+
+type reviewNotifyMessage struct {
+	title   constants.NotifyTitle
+	content constants.NotifyContent
+}
+
+// the messages to notify the owner of app version when the stage is passed,
+// the stages not listed here are not notified
+var passReviewStageNotifyMessages = map[string]reviewNotifyMessage{
+	constants.OperatorTypeIsv:       {constants.PassAppVersionInfoNotifyTitle, constants.PassAppVersionInfoNotifyContent},
+	constants.OperatorTypeBusiness:  {constants.PassAppVersionBusinessNotifyTitle, constants.PassAppVersionBusinessNotifyContent},
+	constants.OperatorTypeTechnical: {constants.PassAppVersionTechnicalNotifyTitle, constants.PassAppVersionTechnicalNotifyContent},
+}
+
+// the messages to notify the owner of app version when the stage is rejected
+var rejectReviewStageNotifyMessages = map[string]reviewNotifyMessage{
+	constants.OperatorTypeIsv:       {constants.RejectAppVersionInfoNotifyTitle, constants.RejectAppVersionInfoNotifyContent},
+	constants.OperatorTypeBusiness:  {constants.RejectAppVersionBusinessNotifyTitle, constants.RejectAppVersionBusinessNotifyContent},
+	constants.OperatorTypeTechnical: {constants.RejectAppVersionTechnicalNotifyTitle, constants.RejectAppVersionTechnicalNotifyContent},
+}
+
+func getReviewStatus(operatorType, status string) string {
+	prefix, ok := reviewStatusPrefixMap[operatorType]
+	if !ok {
+		prefix = operatorType + "-"
+	}
+	return prefix + status
+}
+
+func execAppVersionReview(ctx context.Context, version *models.AppVersion, action Action, operatorType, message string) (*models.AppVersionReview, error) {
 	var (
 		s        = ctxutil.GetSender(ctx)
 		operator = s.UserId
@@ -102,38 +149,35 @@ func execAppVersionReview(ctx context.Context, version *models.AppVersion, actio
 	if operatorType != constants.OperatorTypeAdmin {
 		err := checkAppVersionHandlePermission(ctx, action, version)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	versionReview, err := getAppVersionReview(ctx, version)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	stages := versionReview.GetStages()
 	p, ok := versionReview.Phase[operatorType]
 	switch action {
 	case Review:
 		if ok {
-			return gerr.New(ctx,
+			return nil, gerr.New(ctx,
 				gerr.FailedPrecondition, gerr.ErrorAppVersionIncorrectStatus, version.VersionId, version.Status)
 		}
-		switch operatorType {
-		case constants.OperatorTypeBusiness, constants.OperatorTypeTechnical:
-			if _, ok = versionReview.Phase[constants.OperatorTypeIsv]; !ok {
-				return gerr.New(ctx,
-					gerr.FailedPrecondition, gerr.ErrorAppVersionIncorrectStatus, version.VersionId, version.Status)
-			}
+		if stages.Index(operatorType) < 0 {
+			return nil, gerr.New(ctx,
+				gerr.FailedPrecondition, gerr.ErrorReviewStageNotFound, operatorType, version.VersionId)
 		}
-		if operatorType == constants.OperatorTypeTechnical {
-			if _, ok = versionReview.Phase[constants.OperatorTypeBusiness]; !ok {
-				return gerr.New(ctx,
-					gerr.FailedPrecondition, gerr.ErrorAppVersionIncorrectStatus, version.VersionId, version.Status)
-			}
+		// the stages before should be passed
+		if next := stages.Next(versionReview.Phase); next == nil || next.Name != operatorType {
+			return nil, gerr.New(ctx,
+				gerr.FailedPrecondition, gerr.ErrorAppVersionIncorrectStatus, version.VersionId, version.Status)
 		}
 	case Pass, Reject:
 		if operatorType != constants.OperatorTypeAdmin {
 			if p.Status != constants.StatusInReview {
-				return gerr.New(ctx,
+				return nil, gerr.New(ctx,
 					gerr.FailedPrecondition, gerr.ErrorAppVersionIncorrectStatus, version.VersionId, version.Status)
 			}
 		}
@@ -143,21 +187,9 @@ func execAppVersionReview(ctx context.Context, version *models.AppVersion, actio
 
 	versionReview.UpdatePhase(operatorType, status, operator, message)
 
-	var reviewStatus = ""
-	switch operatorType {
-	case constants.OperatorTypeIsv:
-		reviewStatus = "isv-"
-	case constants.OperatorTypeBusiness:
-		reviewStatus = "business-"
-	case constants.OperatorTypeTechnical:
-		reviewStatus = "develop-"
-	case constants.OperatorTypeAdmin:
-		reviewStatus = "admin-"
-	}
-
 	updater := pi.Global().DB(ctx).
 		Update(constants.TableAppVersionReview).
-		Set(constants.ColumnStatus, reviewStatus+status).
+		Set(constants.ColumnStatus, getReviewStatus(operatorType, status)).
 		Set(constants.ColumnStatusTime, time.Now()).
 		Set(constants.ColumnPhase, versionReview.Phase).
 		Where(db.Eq(constants.ColumnReviewId, versionReview.ReviewId))
@@ -167,13 +199,13 @@ func execAppVersionReview(ctx context.Context, version *models.AppVersion, actio
 	}
 	_, err = updater.Exec()
 	if err != nil {
-		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, versionReview.ReviewId)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, versionReview.ReviewId)
 	}
-	return nil
+	return versionReview, nil
 }
 
 func cancelAppVersionReview(ctx context.Context, version *models.AppVersion, operatorType string) error {
-	err := execAppVersionReview(ctx, version, Cancel, operatorType, "")
+	_, err := execAppVersionReview(ctx, version, Cancel, operatorType, "")
 	if err != nil {
 		return err
 	}
@@ -190,44 +222,44 @@ func cancelAppVersionReview(ctx context.Context, version *models.AppVersion, ope
 	return nil
 }
 
-func startAppVersionReview(ctx context.Context, version *models.AppVersion, operatorType string) error {
-	err := execAppVersionReview(ctx, version, Review, operatorType, "")
+func startAppVersionReview(ctx context.Context, version *models.AppVersion, operatorType string) (*models.AppVersionReview, error) {
+	versionReview, err := execAppVersionReview(ctx, version, Review, operatorType, "")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if operatorType == constants.OperatorTypeIsv {
+	if versionReview.GetStages().Index(operatorType) == 0 {
 		err = updateVersionStatus(ctx, version, constants.StatusInReview)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	err = addAppVersionAudit(ctx, version, constants.StatusInReview, operatorType, "")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return versionReview, nil
 }
 
-func passAppVersionReview(ctx context.Context, version *models.AppVersion, operatorType string) error {
-	err := execAppVersionReview(ctx, version, Pass, operatorType, "")
+func passAppVersionReview(ctx context.Context, version *models.AppVersion, operatorType string) (*models.AppVersionReview, error) {
+	versionReview, err := execAppVersionReview(ctx, version, Pass, operatorType, "")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if operatorType == constants.OperatorTypeTechnical || operatorType == constants.OperatorTypeAdmin {
+	if operatorType == constants.OperatorTypeAdmin || versionReview.GetStages().IsLast(operatorType) {
 		err = updateVersionStatus(ctx, version, constants.StatusPassed)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		err = addAppVersionAudit(ctx, version, constants.StatusPassed, operatorType, "")
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return versionReview, nil
 }
 
 func rejectAppVersionReview(ctx context.Context, version *models.AppVersion, operatorType string, message string) error {
-	err := execAppVersionReview(ctx, version, Reject, operatorType, message)
+	_, err := execAppVersionReview(ctx, version, Reject, operatorType, message)
 	if err != nil {
 		return err
 	}
@@ -237,3 +269,110 @@ func rejectAppVersionReview(ctx context.Context, version *models.AppVersion, ope
 	}
 	return addAppVersionAudit(ctx, version, constants.StatusRejected, operatorType, message)
 }
+
+func isReviewStageAutoPassed(ctx context.Context, stage *models.ReviewStage, versionReview *models.AppVersionReview) bool {
+	if stage.AutoPass == nil {
+		return false
+	}
+	if stage.AutoPass.MatchOwnerPath(versionReview.OwnerPath) {
+		return true
+	}
+	if len(stage.AutoPass.SubmitterActionBundles) == 0 || !pi.Global().GlobalConfig().InstallModule.Iam {
+		return false
+	}
+	accessClient, err := accessclient.NewClient()
+	if err != nil {
+		logger.Error(ctx, "Failed to create access client: %+v", err)
+		return false
+	}
+	return accessClient.CheckActionBundleUser(ctx, stage.AutoPass.SubmitterActionBundles, versionReview.Owner)
+}
+
+// autoPassAppVersionReview passes the next stages of review until the auto pass rules are not matched,
+// returns the latest app version and review
+func autoPassAppVersionReview(ctx context.Context, versionId string) (*models.AppVersion, *models.AppVersionReview, error) {
+	for {
+		version, err := getAppVersion(ctx, versionId)
+		if err != nil {
+			return nil, nil, err
+		}
+		versionReview, err := getAppVersionReview(ctx, version)
+		if err != nil {
+			return nil, nil, err
+		}
+		stage := versionReview.GetStages().Next(versionReview.Phase)
+		if stage == nil || version.Status == constants.StatusPassed || !isReviewStageAutoPassed(ctx, stage, versionReview) {
+			return version, versionReview, nil
+		}
+
+		logger.Info(ctx, "Auto pass stage [%s] of app version [%s]", stage.Name, versionId)
+		// When DescribeAppVersionReviews, need sort by create time
+		time.Sleep(1 * time.Second)
+		_, err = startAppVersionReview(ctx, version, stage.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		time.Sleep(1 * time.Second)
+		version, err = getAppVersion(ctx, versionId)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, err = passAppVersionReview(ctx, version, stage.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// checkReviewStagePermission returns error if the sender is not the reviewer of the stage
+func checkReviewStagePermission(ctx context.Context, version *models.AppVersion, operatorType string) error {
+	versionReview, err := getAppVersionReview(ctx, version)
+	if err != nil {
+		return err
+	}
+	stages := versionReview.GetStages()
+	i := stages.Index(operatorType)
+	if i < 0 {
+		return gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorReviewStageNotFound, operatorType, version.VersionId)
+	}
+	if !pi.Global().GlobalConfig().InstallModule.Iam {
+		return nil
+	}
+	s := ctxutil.GetSender(ctx)
+	accessClient, err := accessclient.NewClient()
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	if !accessClient.CheckActionBundleUser(ctx, []string{stages[i].ActionBundle}, s.UserId) {
+		return gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorResourceAccessDenied, version.VersionId)
+	}
+	return nil
+}
+
+// getReviewStageUsers returns the reviewers of the stage to notify
+func getReviewStageUsers(ctx context.Context, stage *models.ReviewStage, submitter string) ([]*pb.User, error) {
+	accountClient, err := accountclient.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	accessClient, err := accessclient.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	systemCtx := clientutil.SetSystemUserToContext(ctx)
+	if !stage.IsvScoped {
+		return accessClient.GetActionBundleUsers(systemCtx, []string{stage.ActionBundle})
+	}
+
+	isv, err := accountClient.GetIsvFromUser(systemCtx, submitter)
+	if err != nil {
+		logger.Error(ctx, "Failed to get isv from user [%s], %+v", submitter, err)
+		return nil, err
+	}
+	isvCtx, err := clientutil.SetUserToContext(ctx, isv.GetUserId().GetValue(), "DescribeUsers")
+	if err != nil {
+		logger.Error(ctx, "Failed to set [%s] as sender: %+v", isv.GetUserId().GetValue(), err)
+		return nil, err
+	}
+	return accessClient.GetActionBundleUsers(isvCtx, []string{stage.ActionBundle})
+}
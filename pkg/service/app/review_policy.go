@@ -0,0 +1,284 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	clientutil "openpitrix.io/openpitrix/pkg/client"
+	marketclient "openpitrix.io/openpitrix/pkg/client/market"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/util/ctxutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// only the sender able to access the resources of all users can manage review policies
+func checkReviewPolicyAdminPermission(ctx context.Context) error {
+	s := ctxutil.GetSender(ctx)
+	if s != nil && s.GetAccessPath() != "" {
+		err := fmt.Errorf("user [%s] can not manage review policies", s.UserId)
+		return gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorPermissionDenied)
+	}
+	return nil
+}
+
+func getCategoryReviewPolicy(ctx context.Context, appId string) (*models.ReviewPolicy, error) {
+	categoryIdsQuery := pi.Global().DB(ctx).
+		Select(constants.ColumnCategoryId).
+		From(constants.TableCategoryResource).
+		Where(db.Eq(constants.ColumnResouceId, appId)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusEnabled))
+
+	var policies []*models.ReviewPolicy
+	_, err := pi.Global().DB(ctx).
+		Select(models.ReviewPolicyColumns...).
+		From(constants.TableReviewPolicy).
+		Where(db.Eq(constants.ColumnScopeType, constants.ReviewPolicyScopeTypeCategory)).
+		Where(db.Eq(constants.ColumnScopeId, []*db.SelectQuery{categoryIdsQuery})).
+		OrderDir(constants.ColumnCreateTime, true).
+		Load(&policies)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	return policies[0], nil
+}
+
+func getMarketReviewPolicy(ctx context.Context, userId string) (*models.ReviewPolicy, error) {
+	var policies []*models.ReviewPolicy
+	_, err := pi.Global().DB(ctx).
+		Select(models.ReviewPolicyColumns...).
+		From(constants.TableReviewPolicy).
+		Where(db.Eq(constants.ColumnScopeType, constants.ReviewPolicyScopeTypeMarket)).
+		OrderDir(constants.ColumnCreateTime, true).
+		Load(&policies)
+	if err != nil {
+		return nil, err
+	}
+	// no need to ask market manager if there is no policy of market
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	var marketIds []string
+	for _, policy := range policies {
+		marketIds = append(marketIds, policy.ScopeId)
+	}
+	marketManagerClient, err := marketclient.NewMarketManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	systemCtx := clientutil.SetSystemUserToContext(ctx)
+	response, err := marketManagerClient.DescribeMarketUsers(systemCtx, &pb.DescribeMarketUsersRequest{
+		MarketId: marketIds,
+		UserId:   []string{userId},
+		Limit:    uint32(len(marketIds)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var joinedMarketIds []string
+	for _, marketUser := range response.MarketUserSet {
+		joinedMarketIds = append(joinedMarketIds, marketUser.GetMarketId().GetValue())
+	}
+	for _, policy := range policies {
+		if stringutil.StringIn(policy.ScopeId, joinedMarketIds) {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+// getReviewStages returns the stages of the review policy of the app category,
+// then of the market which the submitter joined, and the default stages at last
+func getReviewStages(ctx context.Context, version *models.AppVersion, submitter string) (models.ReviewStages, error) {
+	policy, err := getCategoryReviewPolicy(ctx, version.AppId)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		policy, err = getMarketReviewPolicy(ctx, submitter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if policy == nil {
+		return models.DefaultReviewStages, nil
+	}
+	logger.Debug(ctx, "Review app version [%s] with stages %v of review policy [%s]",
+		version.VersionId, policy.Stages.Names(), policy.PolicyId)
+	return policy.Stages, nil
+}
+
+func (p *Server) CreateReviewPolicy(ctx context.Context, req *pb.CreateReviewPolicyRequest) (*pb.CreateReviewPolicyResponse, error) {
+	err := checkReviewPolicyAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s := ctxutil.GetSender(ctx)
+	scopeType := req.GetScopeType().GetValue()
+	scopeId := req.GetScopeId().GetValue()
+	stages := models.PbToReviewStages(req.GetStages())
+	err = stages.Validate()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalReviewStages, err.Error())
+	}
+
+	if scopeType == constants.ReviewPolicyScopeTypeCategory {
+		count, err := pi.Global().DB(ctx).
+			Select(constants.ColumnCategoryId).
+			From(constants.TableCategory).
+			Where(db.Eq(constants.ColumnCategoryId, scopeId)).
+			Count()
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+		}
+		if count == 0 {
+			return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, scopeId)
+		}
+	}
+
+	count, err := pi.Global().DB(ctx).
+		Select(constants.ColumnPolicyId).
+		From(constants.TableReviewPolicy).
+		Where(db.Eq(constants.ColumnScopeType, scopeType)).
+		Where(db.Eq(constants.ColumnScopeId, scopeId)).
+		Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+	if count > 0 {
+		return nil, gerr.New(ctx, gerr.AlreadyExists, gerr.ErrorReviewPolicyExists, scopeType, scopeId)
+	}
+
+	newPolicy := models.NewReviewPolicy(
+		req.GetName().GetValue(),
+		scopeType,
+		scopeId,
+		req.GetDescription().GetValue(),
+		stages,
+		s.GetOwnerPath(),
+	)
+	_, err = pi.Global().DB(ctx).
+		InsertInto(constants.TableReviewPolicy).
+		Record(newPolicy).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+
+	return &pb.CreateReviewPolicyResponse{
+		PolicyId: pbutil.ToProtoString(newPolicy.PolicyId),
+	}, nil
+}
+
+func (p *Server) ModifyReviewPolicy(ctx context.Context, req *pb.ModifyReviewPolicyRequest) (*pb.ModifyReviewPolicyResponse, error) {
+	err := checkReviewPolicyAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policyId := req.GetPolicyId().GetValue()
+	count, err := pi.Global().DB(ctx).
+		Select(constants.ColumnPolicyId).
+		From(constants.TableReviewPolicy).
+		Where(db.Eq(constants.ColumnPolicyId, policyId)).
+		Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, policyId)
+	}
+	if count == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, policyId)
+	}
+
+	attributes := manager.BuildUpdateAttributes(req, constants.ColumnName, constants.ColumnDescription)
+	// the reviews in progress keep the stages resolved when submitted
+	if len(req.GetStages()) > 0 {
+		stages := models.PbToReviewStages(req.GetStages())
+		err = stages.Validate()
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalReviewStages, err.Error())
+		}
+		attributes[constants.ColumnStages] = stages
+	}
+	attributes[constants.ColumnStatusTime] = time.Now()
+
+	_, err = pi.Global().DB(ctx).
+		Update(constants.TableReviewPolicy).
+		SetMap(attributes).
+		Where(db.Eq(constants.ColumnPolicyId, policyId)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, policyId)
+	}
+
+	return &pb.ModifyReviewPolicyResponse{
+		PolicyId: pbutil.ToProtoString(policyId),
+	}, nil
+}
+
+func (p *Server) DeleteReviewPolicies(ctx context.Context, req *pb.DeleteReviewPoliciesRequest) (*pb.DeleteReviewPoliciesResponse, error) {
+	err := checkReviewPolicyAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policyIds := req.GetPolicyId()
+	_, err = pi.Global().DB(ctx).
+		DeleteFrom(constants.TableReviewPolicy).
+		Where(db.Eq(constants.ColumnPolicyId, policyIds)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, strings.Join(policyIds, ","))
+	}
+
+	return &pb.DeleteReviewPoliciesResponse{
+		PolicyId: policyIds,
+	}, nil
+}
+
+func (p *Server) DescribeReviewPolicies(ctx context.Context, req *pb.DescribeReviewPoliciesRequest) (*pb.DescribeReviewPoliciesResponse, error) {
+	var policies []*models.ReviewPolicy
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+	displayColumns := manager.GetDisplayColumns(req.GetDisplayColumns(), models.ReviewPolicyColumns)
+	query := pi.Global().DB(ctx).
+		Select(displayColumns...).
+		From(constants.TableReviewPolicy).
+		Offset(offset).
+		Limit(limit).
+		Where(manager.BuildFilterConditions(req, constants.TableReviewPolicy))
+	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+
+	if len(displayColumns) > 0 {
+		_, err := query.Load(&policies)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+	}
+	count, err := query.Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	return &pb.DescribeReviewPoliciesResponse{
+		ReviewPolicySet: models.ReviewPoliciesToPbs(policies),
+		TotalCount:      count,
+	}, nil
+}
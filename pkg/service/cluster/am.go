@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	pilotclient "openpitrix.io/openpitrix/pkg/client/pilot"
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
@@ -112,6 +113,43 @@ func (p *Server) Checker(ctx context.Context, req interface{}) error {
 	case *pb.GetClusterStatisticsRequest:
 		return manager.NewChecker(ctx, r).
 			Exec()
+	case *pb.RunClusterNodeCommandRequest:
+		return manager.NewChecker(ctx, r).
+			Required("node_id", "command").
+			Exec()
+	case *pb.CreateClusterSnapshotRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id").
+			Exec()
+	case *pb.RestoreClusterFromSnapshotRequest:
+		return manager.NewChecker(ctx, r).
+			Required("snapshot_id").
+			Exec()
+	case *pb.DeleteClusterSnapshotsRequest:
+		return manager.NewChecker(ctx, r).
+			Required("snapshot_id").
+			Exec()
+	case *pb.RunClusterServiceRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id", "service_name").
+			Exec()
+	case *pb.DescribeClusterMonitorRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id").
+			Exec()
+	case *pb.CreateQuotaRequest:
+		return manager.NewChecker(ctx, r).
+			Required("subject_type", "subject").
+			StringChosen("subject_type", constants.QuotaSubjectTypes).
+			Exec()
+	case *pb.ModifyQuotaRequest:
+		return manager.NewChecker(ctx, r).
+			Required("quota_id").
+			Exec()
+	case *pb.DeleteQuotasRequest:
+		return manager.NewChecker(ctx, r).
+			Required("quota_id").
+			Exec()
 	}
 	return nil
 }
@@ -0,0 +1,289 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	jobclient "openpitrix.io/openpitrix/pkg/client/job"
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/plugins"
+	"openpitrix.io/openpitrix/pkg/util/ctxutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// getRoleService returns the service of role by the attribute name in cluster common,
+// nil if the role has no such service
+func getRoleService(clusterWrapper *models.ClusterWrapper, role, serviceAttribute string) (*opapp.Service, error) {
+	serviceStr, ok := clusterWrapper.GetCommonAttribute(role, serviceAttribute).(string)
+	if !ok || serviceStr == "" {
+		return nil, nil
+	}
+	service := new(opapp.Service)
+	err := jsonutil.Decode([]byte(serviceStr), service)
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// getServiceRolesAndNodes returns the roles and nodes to run the service on, which are the given nodes,
+// or the nodes of the given roles, or all the nodes of the roles having the service by default
+func getServiceRolesAndNodes(ctx context.Context, clusterWrapper *models.ClusterWrapper, serviceName, serviceAttribute string,
+	roles, nodeIds []string) ([]string, []string, error) {
+	clusterId := clusterWrapper.Cluster.ClusterId
+	for _, role := range roles {
+		if _, exist := clusterWrapper.ClusterRoles[role]; !exist {
+			return nil, nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceRoleNotFound, clusterId, role)
+		}
+	}
+	for _, nodeId := range nodeIds {
+		if _, exist := clusterWrapper.ClusterNodesWithKeyPairs[nodeId]; !exist {
+			return nil, nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, nodeId)
+		}
+	}
+
+	roleServices := make(map[string]bool)
+	var serviceRoles, serviceNodeIds []string
+	for nodeId, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		role := clusterNode.Role
+		if len(nodeIds) > 0 {
+			if !stringutil.StringIn(nodeId, nodeIds) {
+				continue
+			}
+		} else if len(roles) > 0 && !stringutil.StringIn(role, roles) {
+			continue
+		}
+
+		hasService, checked := roleServices[role]
+		if !checked {
+			service, err := getRoleService(clusterWrapper, role, serviceAttribute)
+			if err != nil {
+				return nil, nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+			}
+			hasService = service != nil
+			roleServices[role] = hasService
+			if hasService {
+				serviceRoles = append(serviceRoles, role)
+			}
+		}
+		if hasService {
+			serviceNodeIds = append(serviceNodeIds, nodeId)
+		} else if len(nodeIds) > 0 || len(roles) > 0 {
+			// the service is required to be run on the given roles or nodes
+			return nil, nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorResourceServiceNotFound, clusterId, serviceName)
+		}
+	}
+	if len(serviceNodeIds) == 0 {
+		return nil, nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorResourceServiceNotFound, clusterId, serviceName)
+	}
+	sort.Strings(serviceRoles)
+	sort.Strings(serviceNodeIds)
+	return serviceRoles, serviceNodeIds, nil
+}
+
+// getServiceParams validates the params given by user against the service params declared by
+// the service of every role, the declared params not given are filled in with their defaults
+func getServiceParams(ctx context.Context, clusterWrapper *models.ClusterWrapper, serviceName, serviceAttribute string,
+	roles []string, params string) (map[string]interface{}, error) {
+	serviceParams := make(map[string]interface{})
+	if params != "" {
+		err := jsonutil.Decode([]byte(params), &serviceParams)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalServiceParams, serviceName)
+		}
+	}
+
+	for _, role := range roles {
+		service, err := getRoleService(clusterWrapper, role, serviceAttribute)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunServiceFailed, clusterWrapper.Cluster.ClusterId, serviceName)
+		}
+		for key := range serviceParams {
+			if _, exist := service.ServiceParams[key]; !exist {
+				err = fmt.Errorf("param [%s] is not declared by service [%s] of role [%s]", key, serviceName, role)
+				return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalServiceParams, serviceName)
+			}
+		}
+
+		config, err := service.GetParamsConfig()
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunServiceFailed, clusterWrapper.Cluster.ClusterId, serviceName)
+		}
+		if config == nil {
+			continue
+		}
+		err = config.Validate(jsonutil.ToJson(serviceParams))
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalServiceParams, serviceName)
+		}
+		for _, property := range config.Properties {
+			if _, exist := serviceParams[property.Key]; !exist && property.Default != nil {
+				serviceParams[property.Key] = property.Default
+			}
+		}
+	}
+	return serviceParams, nil
+}
+
+func modifyClusterServiceAuditAttributes(ctx context.Context, clusterServiceAuditId string, attributes map[string]interface{}) error {
+	_, err := pi.Global().DB(ctx).
+		Update(constants.TableClusterServiceAudit).
+		SetMap(attributes).
+		Where(db.Eq(constants.ColumnClusterServiceAuditId, clusterServiceAuditId)).
+		Exec()
+	return err
+}
+
+func (p *Server) RunClusterService(ctx context.Context, req *pb.RunClusterServiceRequest) (*pb.RunClusterServiceResponse, error) {
+	s := ctxutil.GetSender(ctx)
+	clusterId := req.GetClusterId().GetValue()
+	serviceName := req.GetServiceName().GetValue()
+	cluster, err := CheckClusterPermission(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.ClusterType == constants.FrontgateClusterType {
+		return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+	}
+	err = checkPermissionAndTransition(ctx, cluster, []string{constants.StatusActive})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+	}
+
+	runtime, err := runtimeclient.NewRuntime(ctx, cluster.RuntimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+	}
+	if !plugins.IsVmbasedProviders(runtime.Runtime.Provider) {
+		return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+	}
+
+	clusterWrapper, err := getClusterWrapper(ctx, clusterId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+
+	clusterServiceAudit := &models.ClusterServiceAudit{ServiceName: serviceName}
+	serviceAttribute := clusterServiceAudit.GetServiceAttribute()
+	roles, nodeIds, err := getServiceRolesAndNodes(ctx, clusterWrapper, serviceName, serviceAttribute, req.GetRole(), req.GetNodeId())
+	if err != nil {
+		return nil, err
+	}
+	serviceParams, err := getServiceParams(ctx, clusterWrapper, serviceName, serviceAttribute, roles, req.GetServiceParams().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	clusterServiceAudit = models.NewClusterServiceAudit(clusterId, serviceName, roles, nodeIds, serviceParams, s.GetOwnerPath())
+	directive := jsonutil.ToString(&models.ClusterServiceDirective{
+		ClusterWrapper:      clusterWrapper,
+		ClusterServiceAudit: clusterServiceAudit,
+	})
+	newJob := models.NewJob(
+		constants.PlaceHolder,
+		clusterId,
+		cluster.AppId,
+		cluster.VersionId,
+		constants.ActionRunClusterService,
+		directive,
+		runtime.Runtime.Provider,
+		s.GetOwnerPath(),
+		cluster.RuntimeId,
+	)
+
+	_, err = pi.Global().DB(ctx).
+		InsertInto(constants.TableClusterServiceAudit).
+		Record(clusterServiceAudit).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+	}
+
+	jobId, err := jobclient.SendJob(ctx, newJob)
+	if err != nil {
+		modifyClusterServiceAuditAttributes(ctx, clusterServiceAudit.ClusterServiceAuditId, map[string]interface{}{
+			constants.ColumnStatus:     constants.StatusFailed,
+			constants.ColumnStatusTime: time.Now(),
+		})
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+	}
+	err = modifyClusterServiceAuditAttributes(ctx, clusterServiceAudit.ClusterServiceAuditId, map[string]interface{}{
+		constants.ColumnJobId: jobId,
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunServiceFailed, clusterId, serviceName)
+	}
+
+	return &pb.RunClusterServiceResponse{
+		ClusterId:             pbutil.ToProtoString(clusterId),
+		ClusterServiceAuditId: pbutil.ToProtoString(clusterServiceAudit.ClusterServiceAuditId),
+		JobId:                 pbutil.ToProtoString(jobId),
+	}, nil
+}
+
+func (p *Server) DescribeClusterServiceAudits(ctx context.Context, req *pb.DescribeClusterServiceAuditsRequest) (*pb.DescribeClusterServiceAuditsResponse, error) {
+	var clusterServiceAudits []*models.ClusterServiceAudit
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+	displayColumns := manager.GetDisplayColumns(req.GetDisplayColumns(), models.ClusterServiceAuditColumns)
+	query := pi.Global().DB(ctx).
+		Select(displayColumns...).
+		From(constants.TableClusterServiceAudit).
+		Offset(offset).
+		Limit(limit).
+		Where(manager.BuildPermissionFilter(ctx)).
+		Where(manager.BuildFilterConditions(req, constants.TableClusterServiceAudit))
+	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+
+	if len(displayColumns) > 0 {
+		_, err := query.Load(&clusterServiceAudits)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+	}
+	count, err := query.Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	res := &pb.DescribeClusterServiceAuditsResponse{
+		ClusterServiceAuditSet: models.ClusterServiceAuditsToPbs(clusterServiceAudits),
+		TotalCount:             count,
+	}
+	return res, nil
+}
+
+func (p *Server) ModifyClusterServiceAudit(ctx context.Context, req *pb.ModifyClusterServiceAuditRequest) (*pb.ModifyClusterServiceAuditResponse, error) {
+	clusterServiceAuditId := req.GetClusterServiceAudit().GetClusterServiceAuditId().GetValue()
+	_, err := CheckClusterServiceAuditPermission(ctx, clusterServiceAuditId)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := manager.BuildUpdateAttributes(req.ClusterServiceAudit, models.ClusterServiceAuditColumns...)
+	err = modifyClusterServiceAuditAttributes(ctx, clusterServiceAuditId, attributes)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, clusterServiceAuditId)
+	}
+
+	res := &pb.ModifyClusterServiceAuditResponse{
+		ClusterServiceAuditId: pbutil.ToProtoString(clusterServiceAuditId),
+	}
+	return res, nil
+}
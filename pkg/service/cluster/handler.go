@@ -7,6 +7,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 
 	appclient "openpitrix.io/openpitrix/pkg/client/app"
 	jobclient "openpitrix.io/openpitrix/pkg/client/job"
+	pilotclient "openpitrix.io/openpitrix/pkg/client/pilot"
 	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
 	providerclient "openpitrix.io/openpitrix/pkg/client/runtime_provider"
 	"openpitrix.io/openpitrix/pkg/constants"
@@ -25,6 +27,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/plugins"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
@@ -200,6 +203,24 @@ func updateTransitionStatus(ctx context.Context, cluster *models.Cluster) error
 	return nil
 }
 
+// splitJobIntoTasks returns the task layers the job would be split into by runtime provider,
+// it is used by dry run, which has no side effect and sends no job.
+func splitJobIntoTasks(ctx context.Context, job *models.Job) (*pb.TaskLayer, error) {
+	providerClient, err := providerclient.NewRuntimeProviderManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	response, err := providerClient.SplitJobIntoTasks(ctx, &pb.SplitJobIntoTasksRequest{
+		RuntimeId: pbutil.ToProtoString(job.RuntimeId),
+		Job:       models.JobToPb(job),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to split job [%s] of cluster [%s] into tasks: %+v", job.JobAction, job.ClusterId, err)
+		return nil, err
+	}
+	return response.TaskLayer, nil
+}
+
 func getClusterIdsByFrontgateId(ctx context.Context, frontgateId string, debug bool) ([]string, error) {
 	var clusterIds []string
 	_, err := pi.Global().DB(ctx).
@@ -691,6 +712,11 @@ func (p *Server) createCluster(ctx context.Context, req *pb.CreateClusterRequest
 	clusterWrapper.Cluster.ClusterType = constants.NormalClusterType
 	clusterWrapper.Cluster.Debug = debug
 
+	err = checkQuotas(ctx, clusterWrapper.Cluster.OwnerPath, runtimeId, models.GetClusterResourceUsage(clusterWrapper))
+	if err != nil {
+		return nil, err
+	}
+
 	if plugins.IsVmbasedProviders(runtime.Runtime.Provider) {
 		err = CheckVmBasedProvider(ctx, runtime, providerClient, clusterWrapper)
 		if err != nil {
@@ -709,9 +735,17 @@ func (p *Server) createCluster(ctx context.Context, req *pb.CreateClusterRequest
 		}
 	}
 
-	err = RegisterClusterWrapper(ctx, clusterWrapper)
+	// quotas are checked again with the cluster registered, the early check above
+	// fails fast before the resources are checked by runtime provider
+	err = registerWithinQuotas(ctx, clusterWrapper.Cluster.OwnerPath, runtimeId, models.GetClusterResourceUsage(clusterWrapper), func() error {
+		err := RegisterClusterWrapper(ctx, clusterWrapper)
+		if err != nil {
+			return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+		return nil, err
 	}
 
 	directive := jsonutil.ToString(clusterWrapper)
@@ -1047,7 +1081,10 @@ func (p *Server) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterReque
 			return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorUpgradeResourceFailed, clusterId)
 		}
 	} else {
-		err := checkPermissionAndTransition(ctx, cluster, []string{constants.StatusStopped})
+		if cluster.ClusterType == constants.FrontgateClusterType {
+			return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorUpgradeResourceFailed, clusterId)
+		}
+		err := checkPermissionAndTransition(ctx, cluster, []string{constants.StatusActive, constants.StatusStopped})
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorUpgradeResourceFailed, clusterId)
 		}
@@ -1059,6 +1096,13 @@ func (p *Server) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterReque
 	}
 
 	directive := jsonutil.ToString(clusterWrapper)
+	if plugins.IsVmbasedProviders(runtime.Runtime.Provider) {
+		clusterCommons, err := getVersionClusterCommons(ctx, clusterWrapper, versionId)
+		if err != nil {
+			return nil, err
+		}
+		directive = getUpgradeDirective(clusterWrapper, clusterCommons)
+	}
 
 	newJob := models.NewJob(
 		constants.PlaceHolder,
@@ -1072,8 +1116,33 @@ func (p *Server) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterReque
 		clusterWrapper.Cluster.RuntimeId,
 	)
 
+	if req.GetDryRun().GetValue() {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpgradeResourceFailed, clusterId)
+		}
+		return &pb.UpgradeClusterResponse{
+			ClusterId: pbutil.ToProtoString(clusterId),
+			TaskLayer: taskLayer,
+		}, nil
+	}
+
+	upgradeAudit := models.NewClusterUpgradeAudit(clusterId, clusterWrapper.Cluster.VersionId, versionId, "", s.GetOwnerPath())
+	_, err = pi.Global().DB(ctx).
+		InsertInto(constants.TableClusterUpgradeAudit).
+		Record(upgradeAudit).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpgradeResourceFailed, clusterId)
+	}
+
 	jobId, err := jobclient.SendJob(ctx, newJob)
 	if err != nil {
+		// cluster is not upgraded, the audit should not be rolled back to
+		if deleteErr := deleteUpgradeAudit(ctx, upgradeAudit.ClusterUpgradeAuditId); deleteErr != nil {
+			logger.Error(ctx, "Failed to delete upgrade audit [%s] of cluster [%s]: %+v",
+				upgradeAudit.ClusterUpgradeAuditId, clusterId, deleteErr)
+		}
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpgradeResourceFailed, clusterId)
 	}
 
@@ -1107,11 +1176,39 @@ func (p *Server) RollbackCluster(ctx context.Context, req *pb.RollbackClusterReq
 		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterWrapper.Cluster.RuntimeId)
 	}
 
+	versionId := clusterWrapper.Cluster.VersionId
+	var upgradeAudit *models.ClusterUpgradeAudit
+	if req.GetReleaseVersion() != nil {
+		releaseVersion := req.GetReleaseVersion().GetValue()
+		if runtime.Runtime.Provider != constants.ProviderKubernetes {
+			return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "release_version", fmt.Sprint(releaseVersion))
+		}
+		directive = jsonutil.ToString(&models.ClusterRollbackDirective{
+			ClusterWrapper: clusterWrapper,
+			ReleaseVersion: releaseVersion,
+		})
+	} else if plugins.IsVmbasedProviders(runtime.Runtime.Provider) {
+		if clusterWrapper.Cluster.ClusterType == constants.FrontgateClusterType {
+			return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorRollbackResourceFailed, clusterId)
+		}
+		// roll back to the version before the last upgrade
+		upgradeAudit, err = getLastUpgradeAudit(ctx, clusterId, versionId)
+		if err != nil {
+			return nil, err
+		}
+		versionId = upgradeAudit.FromVersionId
+		clusterCommons, err := getVersionClusterCommons(ctx, clusterWrapper, versionId)
+		if err != nil {
+			return nil, err
+		}
+		directive = getUpgradeDirective(clusterWrapper, clusterCommons)
+	}
+
 	newJob := models.NewJob(
 		constants.PlaceHolder,
 		clusterId,
 		clusterWrapper.Cluster.AppId,
-		clusterWrapper.Cluster.VersionId,
+		versionId,
 		constants.ActionRollbackCluster,
 		directive,
 		runtime.Runtime.Provider,
@@ -1119,8 +1216,34 @@ func (p *Server) RollbackCluster(ctx context.Context, req *pb.RollbackClusterReq
 		clusterWrapper.Cluster.RuntimeId,
 	)
 
+	if req.GetDryRun().GetValue() {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRollbackResourceFailed, clusterId)
+		}
+		return &pb.RollbackClusterResponse{
+			ClusterId: pbutil.ToProtoString(clusterId),
+			TaskLayer: taskLayer,
+		}, nil
+	}
+
+	if upgradeAudit != nil {
+		err = modifyUpgradeAuditStatus(ctx, upgradeAudit.ClusterUpgradeAuditId, constants.StatusRollbacked)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRollbackResourceFailed, clusterId)
+		}
+	}
+
 	jobId, err := jobclient.SendJob(ctx, newJob)
 	if err != nil {
+		// cluster is not rolled back, the audit can be rolled back to later
+		if upgradeAudit != nil {
+			modifyErr := modifyUpgradeAuditStatus(ctx, upgradeAudit.ClusterUpgradeAuditId, constants.StatusActive)
+			if modifyErr != nil {
+				logger.Error(ctx, "Failed to restore upgrade audit [%s] of cluster [%s]: %+v",
+					upgradeAudit.ClusterUpgradeAuditId, clusterId, modifyErr)
+			}
+		}
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRollbackResourceFailed, clusterId)
 	}
 
@@ -1156,7 +1279,10 @@ func (p *Server) ResizeCluster(ctx context.Context, req *pb.ResizeClusterRequest
 		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorAddResourceNodeFailed, clusterId)
 	}
 
+	dryRun := req.GetDryRun().GetValue()
 	var roleResizeResources models.RoleResizeResources
+	var resizedRoles []*models.ClusterRole
+	requested := new(models.ResourceUsage)
 	for _, pbRoleResource := range req.RoleResource {
 		roleResource := models.PbToRoleResource(pbRoleResource)
 		clusterRole, isExist := clusterWrapper.ClusterRoles[roleResource.Role]
@@ -1165,24 +1291,21 @@ func (p *Server) ResizeCluster(ctx context.Context, req *pb.ResizeClusterRequest
 			return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceRoleNotFound, clusterId, roleResource.Role)
 		}
 
+		previousRole := *clusterRole
 		if isSame, roleResizeResource := roleResource.IsSame(clusterRole); !isSame && roleResizeResource != nil {
-			roleResizeResources = append(roleResizeResources, roleResizeResource)
-			attributes := map[string]interface{}{
-				"cpu":           clusterRole.Cpu,
-				"memory":        clusterRole.Memory,
-				"gpu":           clusterRole.Gpu,
-				"instance_size": clusterRole.InstanceSize,
-				"storage_size":  clusterRole.StorageSize,
+			if dryRun {
+				roleResizeResource.ClusterRole = clusterRole
 			}
-			_, err = pi.Global().DB(ctx).
-				Update(constants.TableClusterRole).
-				SetMap(attributes).
-				Where(db.Eq(constants.ColumnClusterId, clusterId)).
-				Where(db.Eq(constants.ColumnRole, roleResource.Role)).
-				Exec()
-			if err != nil {
-				return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, clusterId)
+			roleResizeResources = append(roleResizeResources, roleResizeResource)
+			resizedRoles = append(resizedRoles, clusterRole)
+
+			var nodeCount uint32
+			for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+				if clusterNode.Role == roleResource.Role {
+					nodeCount++
+				}
 			}
+			requested.Add(models.GetRoleResizeUsage(&previousRole, clusterRole, nodeCount))
 		}
 	}
 
@@ -1191,6 +1314,37 @@ func (p *Server) ResizeCluster(ctx context.Context, req *pb.ResizeClusterRequest
 		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorResizeResourceFailed, clusterId)
 	}
 
+	// resources of role are rendered into the tasks from db by runtime provider,
+	// the resized roles of dry run are carried by the directive instead
+	if dryRun {
+		err = checkQuotas(ctx, clusterWrapper.Cluster.OwnerPath, clusterWrapper.Cluster.RuntimeId, requested)
+	} else {
+		err = registerWithinQuotas(ctx, clusterWrapper.Cluster.OwnerPath, clusterWrapper.Cluster.RuntimeId, requested, func() error {
+			for _, clusterRole := range resizedRoles {
+				attributes := map[string]interface{}{
+					"cpu":           clusterRole.Cpu,
+					"memory":        clusterRole.Memory,
+					"gpu":           clusterRole.Gpu,
+					"instance_size": clusterRole.InstanceSize,
+					"storage_size":  clusterRole.StorageSize,
+				}
+				_, err := pi.Global().DB(ctx).
+					Update(constants.TableClusterRole).
+					SetMap(attributes).
+					Where(db.Eq(constants.ColumnClusterId, clusterId)).
+					Where(db.Eq(constants.ColumnRole, clusterRole.Role)).
+					Exec()
+				if err != nil {
+					return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, clusterId)
+				}
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	directive := jsonutil.ToString(roleResizeResources)
 
 	newJob := models.NewJob(
@@ -1205,6 +1359,17 @@ func (p *Server) ResizeCluster(ctx context.Context, req *pb.ResizeClusterRequest
 		clusterWrapper.Cluster.RuntimeId,
 	)
 
+	if dryRun {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorResizeResourceFailed, clusterId)
+		}
+		return &pb.ResizeClusterResponse{
+			ClusterId: pbutil.ToProtoString(clusterId),
+			TaskLayer: taskLayer,
+		}, nil
+	}
+
 	jobId, err := jobclient.SendJob(ctx, newJob)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorResizeResourceFailed, clusterId)
@@ -1287,36 +1452,74 @@ func (p *Server) AddClusterNodes(ctx context.Context, req *pb.AddClusterNodesReq
 		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorValidateFailed)
 	}
 	clusterWrapper = models.PbToClusterWrapper(response.Cluster)
-	// register new role
-	if len(roleNodes) == 0 {
-		if len(req.AdvancedParam) == 0 {
-			err = fmt.Errorf("conf parameter is needed when role [%s] node does not exist", role)
-			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorAddResourceNodeFailed, clusterId)
+	if len(roleNodes) == 0 && len(req.AdvancedParam) == 0 {
+		err = fmt.Errorf("conf parameter is needed when role [%s] node does not exist", role)
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorAddResourceNodeFailed, clusterId)
+	}
+
+	var newNodes []*models.ClusterNode
+	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		if clusterNode.Status == constants.StatusPending {
+			newNodes = append(newNodes, clusterNode.ClusterNode)
 		}
+	}
+	requested := models.GetNodesResourceUsage(clusterWrapper.ClusterRoles, newNodes)
 
-		clusterWrapper.ClusterRoles[role].ClusterId = clusterId
-		err = RegisterClusterRole(ctx, clusterWrapper.ClusterRoles[role])
+	dryRun := req.GetDryRun().GetValue()
+	if dryRun {
+		err = checkQuotas(ctx, ownerPath, runtime.RuntimeId, requested)
 		if err != nil {
-			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorAddResourceNodeFailed)
+			return nil, err
 		}
-	}
 
-	// register new nodes
-	for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
-		if clusterNode.Status == constants.StatusPending {
-			clusterNode.ClusterNode.Owner = owner
-			clusterNode.ClusterNode.OwnerPath = ownerPath
-			err = RegisterClusterNode(ctx, clusterNode.ClusterNode)
-			if err != nil {
-				return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorAddResourceNodeFailed)
+		// new nodes are not registered in dry run, only give them ids to render the tasks
+		if len(roleNodes) == 0 {
+			clusterWrapper.ClusterRoles[role].ClusterId = clusterId
+		}
+		clusterNodes := make(map[string]*models.ClusterNodeWithKeyPairs)
+		for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+			if clusterNode.Status == constants.StatusPending {
+				clusterNode.ClusterNode.NodeId = models.NewClusterNodeId()
+				clusterNode.ClusterNode.ClusterId = clusterId
+				clusterNode.ClusterNode.Owner = owner
+				clusterNode.ClusterNode.OwnerPath = ownerPath
 			}
+			clusterNodes[clusterNode.NodeId] = clusterNode
 		}
-	}
+		clusterWrapper.ClusterNodesWithKeyPairs = clusterNodes
+	} else {
+		err = registerWithinQuotas(ctx, ownerPath, runtime.RuntimeId, requested, func() error {
+			// register new role
+			if len(roleNodes) == 0 {
+				clusterWrapper.ClusterRoles[role].ClusterId = clusterId
+				err := RegisterClusterRole(ctx, clusterWrapper.ClusterRoles[role])
+				if err != nil {
+					return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorAddResourceNodeFailed)
+				}
+			}
 
-	// reload clusterWrapper from db
-	clusterWrapper, err = getClusterWrapper(ctx, clusterId)
-	if err != nil {
-		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+			// register new nodes
+			for _, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+				if clusterNode.Status == constants.StatusPending {
+					clusterNode.ClusterNode.Owner = owner
+					clusterNode.ClusterNode.OwnerPath = ownerPath
+					err := RegisterClusterNode(ctx, clusterNode.ClusterNode)
+					if err != nil {
+						return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorAddResourceNodeFailed)
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// reload clusterWrapper from db
+		clusterWrapper, err = getClusterWrapper(ctx, clusterId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+		}
 	}
 	directive := jsonutil.ToString(clusterWrapper)
 	newJob := models.NewJob(
@@ -1331,6 +1534,17 @@ func (p *Server) AddClusterNodes(ctx context.Context, req *pb.AddClusterNodesReq
 		clusterWrapper.Cluster.RuntimeId,
 	)
 
+	if dryRun {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorAddResourceNodeFailed, clusterId)
+		}
+		return &pb.AddClusterNodesResponse{
+			ClusterId: pbutil.ToProtoString(clusterId),
+			TaskLayer: taskLayer,
+		}, nil
+	}
+
 	jobId, err := jobclient.SendJob(ctx, newJob)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorAddResourceNodeFailed, clusterId)
@@ -1392,6 +1606,17 @@ func (p *Server) DeleteClusterNodes(ctx context.Context, req *pb.DeleteClusterNo
 		clusterWrapper.Cluster.RuntimeId,
 	)
 
+	if req.GetDryRun().GetValue() {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceNodeFailed, clusterId)
+		}
+		return &pb.DeleteClusterNodesResponse{
+			ClusterId: pbutil.ToProtoString(clusterId),
+			TaskLayer: taskLayer,
+		}, nil
+	}
+
 	jobId, err := jobclient.SendJob(ctx, newJob)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceNodeFailed, clusterId)
@@ -1452,8 +1677,9 @@ func (p *Server) UpdateClusterEnv(ctx context.Context, req *pb.UpdateClusterEnvR
 	clusterWrapper.Cluster.ClusterId = clusterId
 	clusterWrapper.Cluster.Name = clusterName
 
+	dryRun := req.GetDryRun().GetValue()
 	// Update env
-	if len(clusterWrapper.Cluster.Env) > 0 {
+	if len(clusterWrapper.Cluster.Env) > 0 && !dryRun {
 		_, err = pi.Global().DB(ctx).
 			Update(constants.TableCluster).
 			Set(constants.ColumnEnv, clusterWrapper.Cluster.Env).
@@ -1465,7 +1691,7 @@ func (p *Server) UpdateClusterEnv(ctx context.Context, req *pb.UpdateClusterEnvR
 	}
 
 	for role, clusterRole := range clusterWrapper.ClusterRoles {
-		if len(clusterRole.Env) > 0 {
+		if len(clusterRole.Env) > 0 && !dryRun {
 			_, err = pi.Global().DB(ctx).
 				Update(constants.TableClusterRole).
 				Set(constants.ColumnEnv, clusterRole.Env).
@@ -1491,6 +1717,17 @@ func (p *Server) UpdateClusterEnv(ctx context.Context, req *pb.UpdateClusterEnvR
 		clusterWrapper.Cluster.RuntimeId,
 	)
 
+	if dryRun {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceEnvFailed, clusterId)
+		}
+		return &pb.UpdateClusterEnvResponse{
+			ClusterId: pbutil.ToProtoString(clusterId),
+			TaskLayer: taskLayer,
+		}, nil
+	}
+
 	jobId, err := jobclient.SendJob(ctx, newJob)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceEnvFailed, clusterId)
@@ -1565,7 +1802,10 @@ func (p *Server) describeClusters(ctx context.Context, req *pb.DescribeClustersR
 		Where(manager.BuildPermissionFilter(ctx)).
 		Where(manager.BuildFilterConditions(req, constants.TableCluster))
 	query = query.Where(db.Eq(constants.ColumnDebug, debug))
-	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+	query, cursor, err := manager.AddQueryCursor(query, req, constants.ColumnCreateTime, constants.ColumnClusterId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalPageToken)
+	}
 	createdHour := int(req.GetCreatedDate().GetValue()) * 24
 	if createdHour > 0 {
 		createdTime, err := time.ParseDuration(strconv.Itoa(createdHour) + "h")
@@ -1592,6 +1832,10 @@ func (p *Server) describeClusters(ctx context.Context, req *pb.DescribeClustersR
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 	}
+	nextPageToken, err := cursor.NextPageToken(clusters, limit)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
 
 	var pbClusters []*pb.Cluster
 	for _, cluster := range clusters {
@@ -1632,12 +1876,29 @@ func (p *Server) describeClusters(ctx context.Context, req *pb.DescribeClustersR
 	}
 
 	res := &pb.DescribeClustersResponse{
-		ClusterSet: pbClusters,
-		TotalCount: count,
+		ClusterSet:    pbClusters,
+		TotalCount:    count,
+		NextPageToken: pbutil.ToProtoString(nextPageToken),
 	}
 	return res, nil
 }
 
+// ExportClusters streams all the clusters by pages of the max size, following the page token of previous page
+func (p *Server) ExportClusters(req *pb.DescribeClustersRequest, srv pb.ClusterManager_ExportClustersServer) error {
+	ctx := srv.Context()
+	req.Limit = db.DefaultSelectLimit
+	return db.ExportPages(req.GetPageToken().GetValue(),
+		func(pageToken string) (interface{}, string, error) {
+			req.PageToken = pbutil.ToProtoString(pageToken)
+			res, err := p.describeClusters(ctx, req, false)
+			return res, res.GetNextPageToken().GetValue(), err
+		},
+		func(page interface{}) error {
+			return srv.Send(page.(*pb.DescribeClustersResponse))
+		},
+	)
+}
+
 func (p *Server) DescribeAppClusters(ctx context.Context, req *pb.DescribeAppClustersRequest) (*pb.DescribeAppClustersResponse, error) {
 	return p.describeAppClusters(ctx, req, false)
 }
@@ -1775,13 +2036,20 @@ func (p *Server) DescribeClusterNodes(ctx context.Context, req *pb.DescribeClust
 		Limit(limit).
 		Where(manager.BuildPermissionFilter(ctx)).
 		Where(manager.BuildFilterConditions(req, constants.TableClusterNode))
-	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+	query, cursor, err := manager.AddQueryCursor(query, req, constants.ColumnCreateTime, constants.ColumnNodeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalPageToken)
+	}
 	if len(displayColumns) > 0 {
 		_, err := query.Load(&clusterNodes)
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 		}
 	}
+	nextPageToken, err := cursor.NextPageToken(clusterNodes, limit)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
 
 	var pbClusterNodes []*pb.ClusterNode
 	for _, clusterNode := range clusterNodes {
@@ -1851,10 +2119,27 @@ func (p *Server) DescribeClusterNodes(ctx context.Context, req *pb.DescribeClust
 	res := &pb.DescribeClusterNodesResponse{
 		ClusterNodeSet: pbClusterNodes,
 		TotalCount:     count,
+		NextPageToken:  pbutil.ToProtoString(nextPageToken),
 	}
 	return res, nil
 }
 
+// ExportClusterNodes streams all the cluster nodes by pages of the max size, following the page token of previous page
+func (p *Server) ExportClusterNodes(req *pb.DescribeClusterNodesRequest, srv pb.ClusterManager_ExportClusterNodesServer) error {
+	ctx := srv.Context()
+	req.Limit = db.DefaultSelectLimit
+	return db.ExportPages(req.GetPageToken().GetValue(),
+		func(pageToken string) (interface{}, string, error) {
+			req.PageToken = pbutil.ToProtoString(pageToken)
+			res, err := p.DescribeClusterNodes(ctx, req)
+			return res, res.GetNextPageToken().GetValue(), err
+		},
+		func(page interface{}) error {
+			return srv.Send(page.(*pb.DescribeClusterNodesResponse))
+		},
+	)
+}
+
 func (p *Server) StopClusters(ctx context.Context, req *pb.StopClustersRequest) (*pb.StopClustersResponse, error) {
 	s := ctxutil.GetSender(ctx)
 
@@ -2183,3 +2468,80 @@ func (p *Server) GetClusterStatistics(ctx context.Context, req *pb.GetClusterSta
 
 	return res, nil
 }
+
+func (p *Server) RunClusterNodeCommand(req *pb.RunClusterNodeCommandRequest, srv pb.ClusterManager_RunClusterNodeCommandServer) error {
+	ctx := srv.Context()
+	s := ctxutil.GetSender(ctx)
+	nodeId := req.GetNodeId().GetValue()
+	command := req.GetCommand().GetValue()
+
+	clusterNode, err := CheckClusterNodePermission(ctx, nodeId)
+	if err != nil {
+		return err
+	}
+	cluster, err := CheckClusterPermission(ctx, clusterNode.ClusterId)
+	if err != nil {
+		return err
+	}
+	runtime, err := runtimeclient.NewRuntime(ctx, cluster.RuntimeId)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+	}
+	if !plugins.IsVmbasedProviders(runtime.Runtime.Provider) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedRuntimeProvider, runtime.Runtime.Provider)
+	}
+	if clusterNode.PrivateIp == "" {
+		return gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorRunClusterNodeCommandFailed, nodeId)
+	}
+
+	cmdReq := &pbtypes.RunCommandStreamRequest{
+		Command:        command,
+		TimeoutSeconds: int32(req.GetTimeoutSeconds().GetValue()),
+	}
+	if cluster.ClusterType == constants.FrontgateClusterType {
+		cmdReq.FrontgateEndpoint = &pbtypes.FrontgateEndpoint{
+			FrontgateId:     cluster.ClusterId,
+			FrontgateNodeId: nodeId,
+		}
+	} else {
+		cmdReq.DroneEndpoint = &pbtypes.DroneEndpoint{
+			FrontgateId: cluster.FrontgateId,
+			DroneIp:     clusterNode.PrivateIp,
+			DronePort:   constants.DroneServicePort,
+		}
+	}
+
+	pilotClient, err := pilotclient.NewClient()
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunClusterNodeCommandFailed, nodeId)
+	}
+	logger.Info(ctx, "User [%s] runs command [%s] on cluster node [%s]", s.GetOwnerPath(), command, nodeId)
+	// the command is canceled by pilot when ctx is done
+	stream, err := pilotClient.RunCommandStream(ctx, cmdReq)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunClusterNodeCommandFailed, nodeId)
+	}
+	for {
+		out, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunClusterNodeCommandFailed, nodeId)
+		}
+		if out.GetError() != "" {
+			err = fmt.Errorf("%s", out.GetError())
+			return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRunClusterNodeCommandFailed, nodeId)
+		}
+		err = srv.Send(&pb.RunClusterNodeCommandResponse{
+			NodeId:   pbutil.ToProtoString(nodeId),
+			Stdout:   pbutil.ToProtoString(string(out.GetStdout())),
+			Stderr:   pbutil.ToProtoString(string(out.GetStderr())),
+			Exited:   pbutil.ToProtoBool(out.GetExited()),
+			ExitCode: pbutil.ToProtoInt32(out.GetExitCode()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
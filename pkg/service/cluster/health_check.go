@@ -0,0 +1,250 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	pilotclient "openpitrix.io/openpitrix/pkg/client/pilot"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/semaphoreutil"
+)
+
+// getHealthChecks returns the enabled health checks of the roles of clusters, key=clusterId, role
+func getHealthChecks(ctx context.Context) (map[string]map[string]*opapp.HealthCheck, error) {
+	var clusterCommons []*models.ClusterCommon
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterCommonColumns...).
+		From(constants.TableClusterCommon).
+		Where(db.Neq(constants.ColumnHealthCheck, "")).
+		Load(&clusterCommons)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecks := make(map[string]map[string]*opapp.HealthCheck)
+	for _, clusterCommon := range clusterCommons {
+		healthCheck := new(opapp.HealthCheck)
+		err = jsonutil.Decode([]byte(clusterCommon.HealthCheck), healthCheck)
+		if err != nil {
+			logger.Error(ctx, "Decode health check [%s] of cluster [%s] role [%s] failed: %+v",
+				clusterCommon.HealthCheck, clusterCommon.ClusterId, clusterCommon.Role, err)
+			continue
+		}
+		if !healthCheck.IsEnabled() {
+			continue
+		}
+		if healthChecks[clusterCommon.ClusterId] == nil {
+			healthChecks[clusterCommon.ClusterId] = make(map[string]*opapp.HealthCheck)
+		}
+		healthChecks[clusterCommon.ClusterId][clusterCommon.Role] = healthCheck
+	}
+	return healthChecks, nil
+}
+
+func runCommandOnClusterNode(ctx context.Context, pilotClient *pilotclient.Client, cluster *models.Cluster,
+	clusterNode *models.ClusterNode, command string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout+constants.GrpcToPilotTimeout)
+	defer cancel()
+	_, err := pilotClient.RunCommandOnDrone(ctx, &pbtypes.RunCommandOnDroneRequest{
+		Endpoint: &pbtypes.DroneEndpoint{
+			FrontgateId: cluster.FrontgateId,
+			DroneIp:     clusterNode.PrivateIp,
+			DronePort:   constants.DroneServicePort,
+		},
+		Command:        command,
+		TimeoutSeconds: int32(timeout / time.Second),
+	})
+	return err
+}
+
+// runCheckCmdOnClusterNode runs the check cmd on the node and returns whether it succeeds,
+// err is returned if the result of check cmd is unknown, e.g. pilot, frontgate or drone is unreachable
+func runCheckCmdOnClusterNode(ctx context.Context, pilotClient *pilotclient.Client, cluster *models.Cluster,
+	clusterNode *models.ClusterNode, command string, timeout time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout+constants.GrpcToPilotTimeout)
+	defer cancel()
+	droneEndpoint := &pbtypes.DroneEndpoint{
+		FrontgateId: cluster.FrontgateId,
+		DroneIp:     clusterNode.PrivateIp,
+		DronePort:   constants.DroneServicePort,
+	}
+	// the errors relayed by frontgate are not told from the failures of check cmd,
+	// so the drone is pinged first, then the error of cmd means the check fails
+	_, err := pilotClient.PingDrone(ctx, droneEndpoint)
+	if err != nil {
+		return false, err
+	}
+	stream, err := pilotClient.RunCommandStream(ctx, &pbtypes.RunCommandStreamRequest{
+		DroneEndpoint:  droneEndpoint,
+		Command:        command,
+		TimeoutSeconds: int32(timeout / time.Second),
+	})
+	if err != nil {
+		return false, err
+	}
+	for {
+		out, err := stream.Recv()
+		if err != nil {
+			return false, err
+		}
+		if out.GetExited() {
+			if out.GetError() != "" {
+				logger.Warn(ctx, "Check cmd on cluster node [%s] failed: %s", clusterNode.NodeId, out.GetError())
+			}
+			return out.GetError() == "" && out.GetExitCode() == 0, nil
+		}
+	}
+}
+
+// checkNodeHealth runs the check cmd on the node and records the result,
+// the action cmd is run when the node needs to be healed
+func checkNodeHealth(ctx context.Context, pilotClient *pilotclient.Client, cluster *models.Cluster,
+	clusterNode *models.ClusterNode, healthCheck *opapp.HealthCheck) {
+	attributes := map[string]interface{}{
+		constants.ColumnHealthCheckTime: time.Now(),
+	}
+	var heal bool
+	healthy, err := runCheckCmdOnClusterNode(ctx, pilotClient, cluster, clusterNode, healthCheck.CheckCmd, healthCheck.GetTimeout())
+	if err != nil {
+		// the node is not counted as unhealthy when it can not be reached,
+		// it is checked again in the next interval
+		logger.Warn(ctx, "Health check of cluster node [%s] skipped: %+v", clusterNode.NodeId, err)
+	} else {
+		if !healthy {
+			logger.Warn(ctx, "Health check of cluster node [%s] failed", clusterNode.NodeId)
+		}
+		heal = clusterNode.RecordHealthCheck(healthy, healthCheck.HealthyThreshold, healthCheck.UnhealthyThreshold)
+		attributes[constants.ColumnHealthStatus] = clusterNode.HealthStatus
+		attributes[constants.ColumnHealthyCount] = clusterNode.HealthyCount
+		attributes[constants.ColumnUnhealthyCount] = clusterNode.UnhealthyCount
+	}
+
+	_, err = pi.Global().DB(ctx).
+		Update(constants.TableClusterNode).
+		SetMap(attributes).
+		Where(db.Eq(constants.ColumnNodeId, clusterNode.NodeId)).
+		Exec()
+	if err != nil {
+		logger.Error(ctx, "Update health of cluster node [%s] failed: %+v", clusterNode.NodeId, err)
+		return
+	}
+
+	if heal && healthCheck.ActionCmd != "" {
+		logger.Info(ctx, "Heal unhealthy cluster node [%s] of cluster [%s]", clusterNode.NodeId, cluster.ClusterId)
+		err = runCommandOnClusterNode(ctx, pilotClient, cluster, clusterNode, healthCheck.ActionCmd, healthCheck.GetActionTimeout())
+		if err != nil {
+			logger.Error(ctx, "Heal unhealthy cluster node [%s] failed: %+v", clusterNode.NodeId, err)
+		}
+	}
+}
+
+// scheduleHealthChecks runs the health checks on the active nodes of the active clusters
+// whose last health check is older than the interval of health check
+func scheduleHealthChecks(ctx context.Context) error {
+	healthChecks, err := getHealthChecks(ctx)
+	if err != nil {
+		return err
+	}
+	if len(healthChecks) == 0 {
+		return nil
+	}
+	var clusterIds []string
+	for clusterId := range healthChecks {
+		clusterIds = append(clusterIds, clusterId)
+	}
+
+	// nodes are not checked when clusters are changing, they may be restarted or removed
+	var clusters []*models.Cluster
+	_, err = pi.Global().DB(ctx).
+		Select(models.ClusterColumns...).
+		From(constants.TableCluster).
+		Where(db.Eq(constants.ColumnClusterId, clusterIds)).
+		Where(db.Eq(constants.ColumnClusterType, constants.NormalClusterType)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		Where(db.Eq(constants.ColumnTransitionStatus, "")).
+		Load(&clusters)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		return nil
+	}
+	clusterMap := make(map[string]*models.Cluster)
+	clusterIds = nil
+	for _, cluster := range clusters {
+		clusterMap[cluster.ClusterId] = cluster
+		clusterIds = append(clusterIds, cluster.ClusterId)
+	}
+
+	var clusterNodes []*models.ClusterNode
+	_, err = pi.Global().DB(ctx).
+		Select(models.ClusterNodeColumns...).
+		From(constants.TableClusterNode).
+		Where(db.Eq(constants.ColumnClusterId, clusterIds)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		Where(db.Eq(constants.ColumnTransitionStatus, "")).
+		Load(&clusterNodes)
+	if err != nil {
+		return err
+	}
+
+	pilotClient, err := pilotclient.NewClient()
+	if err != nil {
+		return err
+	}
+	workers := semaphoreutil.NewWeighted(constants.MaxRunningHealthChecks)
+	var wg sync.WaitGroup
+	for _, clusterNode := range clusterNodes {
+		role := strings.TrimSuffix(clusterNode.Role, constants.ReplicaRoleSuffix)
+		healthCheck := healthChecks[clusterNode.ClusterId][role]
+		if healthCheck == nil || clusterNode.PrivateIp == "" {
+			continue
+		}
+		if clusterNode.HealthCheckTime != nil && time.Since(*clusterNode.HealthCheckTime) < healthCheck.GetInterval() {
+			continue
+		}
+
+		err = workers.Acquire(ctx, 1)
+		if err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(clusterNode *models.ClusterNode) {
+			defer wg.Done()
+			defer workers.Release(1)
+			checkNodeHealth(ctx, pilotClient, clusterMap[clusterNode.ClusterId], clusterNode, healthCheck)
+		}(clusterNode)
+	}
+	wg.Wait()
+	return err
+}
+
+// ServeHealthChecks runs the health checks of roles on the nodes of clusters by interval,
+// the nodes are checked by one cluster manager at the same time
+func ServeHealthChecks() {
+	ticker := time.NewTicker(constants.ScheduleHealthCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx := context.Background()
+		err := pi.Global().Etcd(ctx).DlockWithTimeout(constants.ScheduleHealthCheckLock, constants.ScheduleHealthCheckTimeout, func() error {
+			return scheduleHealthChecks(ctx)
+		})
+		if err != nil {
+			logger.Error(ctx, "Schedule health checks failed: %+v", err)
+		}
+	}
+}
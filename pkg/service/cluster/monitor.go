@@ -0,0 +1,141 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	pilotclient "openpitrix.io/openpitrix/pkg/client/pilot"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/devkit/opapp"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// getMonitorSeries returns the time series of items of monitor from the metrics of node in time order
+func getMonitorSeries(monitor *opapp.Monitor, metricsList []*pbtypes.MonitorMetrics, step time.Duration) []*pb.MonitorSeries {
+	var items []string
+	for item := range monitor.Items {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+
+	var series []*pb.MonitorSeries
+	for _, item := range items {
+		var points []models.MonitorPoint
+		for _, metrics := range metricsList {
+			value, ok := metrics.Values[item]
+			if !ok {
+				continue
+			}
+			points = append(points, models.MonitorPoint{
+				Time:  pbutil.FromProtoTimestamp(metrics.CollectTime),
+				Value: value,
+			})
+		}
+		series = append(series, &pb.MonitorSeries{
+			Item:     pbutil.ToProtoString(item),
+			PointSet: models.MonitorPointsToPbs(models.CountMonitorPoints(monitor.Items[item], points, step)),
+		})
+	}
+	return series
+}
+
+func (p *Server) DescribeClusterMonitor(ctx context.Context, req *pb.DescribeClusterMonitorRequest) (*pb.DescribeClusterMonitorResponse, error) {
+	clusterId := req.GetClusterId().GetValue()
+	cluster, err := CheckClusterPermission(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := time.Now()
+	if req.GetEndTime() != nil {
+		endTime = pbutil.FromProtoTimestamp(req.EndTime)
+	}
+	startTime := endTime.Add(-constants.DefaultMonitorDuration)
+	if req.GetStartTime() != nil {
+		startTime = pbutil.FromProtoTimestamp(req.StartTime)
+	}
+	if !startTime.Before(endTime) {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorIllegalTimeRange,
+			startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+	step := time.Duration(req.GetStep().GetValue()) * time.Second
+
+	clusterWrapper, err := getClusterWrapper(ctx, clusterId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+
+	res := &pb.DescribeClusterMonitorResponse{
+		ClusterId: pbutil.ToProtoString(clusterId),
+	}
+	monitors := make(map[string]*opapp.Monitor)
+	var roles []string
+	for role, clusterCommon := range clusterWrapper.ClusterCommons {
+		if len(req.GetRole()) > 0 && !stringutil.StringIn(role, req.GetRole()) {
+			continue
+		}
+		if monitor := clusterCommon.GetMonitor(); monitor != nil {
+			monitors[role] = monitor
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		res.RoleMonitorSet = append(res.RoleMonitorSet, models.MonitorToPb(role, monitors[role]))
+	}
+
+	var nodeIds []string
+	for nodeId, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+		role := strings.TrimSuffix(clusterNode.Role, constants.ReplicaRoleSuffix)
+		if monitors[role] == nil {
+			continue
+		}
+		if len(req.GetNodeId()) > 0 && !stringutil.StringIn(nodeId, req.GetNodeId()) {
+			continue
+		}
+		nodeIds = append(nodeIds, nodeId)
+	}
+	if len(nodeIds) == 0 {
+		return res, nil
+	}
+	sort.Strings(nodeIds)
+
+	pilotClient, err := pilotclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeClusterMonitorFailed, clusterId)
+	}
+	metricsList, err := pilotClient.DescribeMonitorMetricsWithTimeout(ctx, &pbtypes.DescribeMonitorMetricsRequest{
+		FrontgateId: cluster.FrontgateId,
+		DroneIdList: nodeIds,
+		StartTime:   pbutil.ToProtoTimestamp(startTime),
+		EndTime:     pbutil.ToProtoTimestamp(endTime),
+	})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeClusterMonitorFailed, clusterId)
+	}
+	nodeMetrics := make(map[string][]*pbtypes.MonitorMetrics)
+	for _, metrics := range metricsList.GetMetricsList() {
+		nodeMetrics[metrics.DroneId] = append(nodeMetrics[metrics.DroneId], metrics)
+	}
+
+	for _, nodeId := range nodeIds {
+		role := clusterWrapper.ClusterNodesWithKeyPairs[nodeId].Role
+		res.NodeMonitorSet = append(res.NodeMonitorSet, &pb.NodeMonitor{
+			NodeId:    pbutil.ToProtoString(nodeId),
+			Role:      pbutil.ToProtoString(role),
+			SeriesSet: getMonitorSeries(monitors[strings.TrimSuffix(role, constants.ReplicaRoleSuffix)], nodeMetrics[nodeId], step),
+		})
+	}
+	return res, nil
+}
@@ -172,3 +172,107 @@ func CheckKeyPairPermission(ctx context.Context, resourceId string) (*models.Key
 	}
 	return keypairs[0], nil
 }
+
+func CheckClusterSnapshotsPermission(ctx context.Context, resourceIds []string) ([]*models.ClusterSnapshot, error) {
+	if len(resourceIds) == 0 {
+		return nil, nil
+	}
+	var sender = ctxutil.GetSender(ctx)
+	var clustersnapshots []*models.ClusterSnapshot
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterSnapshotColumns...).
+		From(constants.TableClusterSnapshot).
+		Where(db.Eq(constants.ColumnSnapshotId, resourceIds)).Load(&clustersnapshots)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	if sender != nil {
+		for _, clustersnapshot := range clustersnapshots {
+			if !clustersnapshot.OwnerPath.CheckPermission(sender) && clustersnapshot.Owner != sender.UserId {
+				return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorResourceAccessDenied, clustersnapshot.SnapshotId)
+			}
+		}
+	}
+	if len(clustersnapshots) == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, resourceIds)
+	}
+	return clustersnapshots, nil
+}
+
+func CheckClusterSnapshotPermission(ctx context.Context, resourceId string) (*models.ClusterSnapshot, error) {
+	if len(resourceId) == 0 {
+		return nil, nil
+	}
+	var sender = ctxutil.GetSender(ctx)
+	var clustersnapshots []*models.ClusterSnapshot
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterSnapshotColumns...).
+		From(constants.TableClusterSnapshot).
+		Where(db.Eq(constants.ColumnSnapshotId, resourceId)).Load(&clustersnapshots)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	if sender != nil {
+		for _, clustersnapshot := range clustersnapshots {
+			if !clustersnapshot.OwnerPath.CheckPermission(sender) {
+				return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorResourceAccessDenied, clustersnapshot.SnapshotId)
+			}
+		}
+	}
+	if len(clustersnapshots) == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, resourceId)
+	}
+	return clustersnapshots[0], nil
+}
+
+func CheckClusterServiceAuditsPermission(ctx context.Context, resourceIds []string) ([]*models.ClusterServiceAudit, error) {
+	if len(resourceIds) == 0 {
+		return nil, nil
+	}
+	var sender = ctxutil.GetSender(ctx)
+	var clusterserviceaudits []*models.ClusterServiceAudit
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterServiceAuditColumns...).
+		From(constants.TableClusterServiceAudit).
+		Where(db.Eq(constants.ColumnClusterServiceAuditId, resourceIds)).Load(&clusterserviceaudits)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	if sender != nil {
+		for _, clusterserviceaudit := range clusterserviceaudits {
+			if !clusterserviceaudit.OwnerPath.CheckPermission(sender) && clusterserviceaudit.Owner != sender.UserId {
+				return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorResourceAccessDenied, clusterserviceaudit.ClusterServiceAuditId)
+			}
+		}
+	}
+	if len(clusterserviceaudits) == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, resourceIds)
+	}
+	return clusterserviceaudits, nil
+}
+
+func CheckClusterServiceAuditPermission(ctx context.Context, resourceId string) (*models.ClusterServiceAudit, error) {
+	if len(resourceId) == 0 {
+		return nil, nil
+	}
+	var sender = ctxutil.GetSender(ctx)
+	var clusterserviceaudits []*models.ClusterServiceAudit
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterServiceAuditColumns...).
+		From(constants.TableClusterServiceAudit).
+		Where(db.Eq(constants.ColumnClusterServiceAuditId, resourceId)).Load(&clusterserviceaudits)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	if sender != nil {
+		for _, clusterserviceaudit := range clusterserviceaudits {
+			if !clusterserviceaudit.OwnerPath.CheckPermission(sender) {
+				return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorResourceAccessDenied, clusterserviceaudit.ClusterServiceAuditId)
+			}
+		}
+	}
+	if len(clusterserviceaudits) == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, resourceId)
+	}
+	return clusterserviceaudits[0], nil
+}
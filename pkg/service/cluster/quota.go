@@ -0,0 +1,400 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gocraft/dbr"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/ctxutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// only the sender able to access the clusters of all users can manage quotas
+func checkQuotaAdminPermission(ctx context.Context) error {
+	s := ctxutil.GetSender(ctx)
+	if s != nil && s.GetAccessPath() != "" {
+		err := fmt.Errorf("user [%s] can not manage quotas", s.UserId)
+		return gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorPermissionDenied)
+	}
+	return nil
+}
+
+// buildAppliedQuotaFilter matches the quotas limiting the clusters of owner path
+func buildAppliedQuotaFilter(ownerPath sender.OwnerPath) dbr.Builder {
+	groupPath := strings.Split(string(ownerPath), ":")[0]
+	var groupPaths []string
+	if groupPath != "" {
+		groups := strings.Split(groupPath, ".")
+		for i := range groups {
+			groupPaths = append(groupPaths, strings.Join(groups[:i+1], "."))
+		}
+	}
+	var ownerPathPrefixes []string
+	for i := range ownerPath {
+		ownerPathPrefixes = append(ownerPathPrefixes, string(ownerPath[:i+1]))
+	}
+
+	conditions := []dbr.Builder{
+		db.And(
+			db.Eq(constants.ColumnSubjectType, constants.QuotaSubjectTypeUser),
+			db.Eq(constants.ColumnSubject, ownerPath.Owner()),
+		),
+	}
+	if len(groupPaths) > 0 {
+		conditions = append(conditions, db.And(
+			db.Eq(constants.ColumnSubjectType, constants.QuotaSubjectTypeGroup),
+			db.Eq(constants.ColumnSubject, groupPaths),
+		))
+	}
+	if len(ownerPathPrefixes) > 0 {
+		conditions = append(conditions, db.And(
+			db.Eq(constants.ColumnSubjectType, constants.QuotaSubjectTypeOwnerPath),
+			db.Eq(constants.ColumnSubject, ownerPathPrefixes),
+		))
+	}
+	return db.Or(conditions...)
+}
+
+// buildQuotaUsageFilter matches the clusters limited by the quota
+func buildQuotaUsageFilter(quota *models.ResourceQuota) dbr.Builder {
+	conditions := []dbr.Builder{
+		db.Eq(constants.ColumnClusterType, constants.NormalClusterType),
+		db.Neq(constants.ColumnStatus, constants.DeletedStatuses),
+	}
+	if quota.RuntimeId != "" {
+		conditions = append(conditions, db.Eq(constants.ColumnRuntimeId, quota.RuntimeId))
+	}
+	switch quota.SubjectType {
+	case constants.QuotaSubjectTypeUser:
+		conditions = append(conditions, db.Eq(constants.ColumnOwner, quota.Subject))
+	case constants.QuotaSubjectTypeGroup:
+		conditions = append(conditions, db.Or(
+			db.Prefix(constants.ColumnOwnerPath, quota.Subject+":"),
+			db.Prefix(constants.ColumnOwnerPath, quota.Subject+"."),
+		))
+	default:
+		conditions = append(conditions, db.Prefix(constants.ColumnOwnerPath, quota.Subject))
+	}
+	return db.And(conditions...)
+}
+
+// getAppliedQuotas returns the quotas limiting the clusters of owner path
+// in the runtime, or the quotas in all runtimes if runtimeId is empty
+func getAppliedQuotas(ctx context.Context, ownerPath sender.OwnerPath, runtimeId string) ([]*models.ResourceQuota, error) {
+	var quotas []*models.ResourceQuota
+	query := pi.Global().DB(ctx).
+		Select(models.ResourceQuotaColumns...).
+		From(constants.TableQuota).
+		Where(buildAppliedQuotaFilter(ownerPath))
+	if runtimeId != "" {
+		query = query.Where(db.Eq(constants.ColumnRuntimeId, []string{"", runtimeId}))
+	}
+	_, err := query.OrderDir(constants.ColumnCreateTime, true).Load(&quotas)
+	if err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// getQuotaUsage returns the resources used by the clusters limited by the quota
+func getQuotaUsage(ctx context.Context, quota *models.ResourceQuota) (*models.ResourceUsage, error) {
+	var clusters []*models.Cluster
+	_, err := pi.Global().DB(ctx).
+		Select(constants.ColumnClusterId, constants.ColumnOwnerPath, constants.ColumnRuntimeId).
+		From(constants.TableCluster).
+		Where(buildQuotaUsageFilter(quota)).
+		Load(&clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	// the prefix of owner path is matched by LIKE, which is not exact
+	var clusterIds []string
+	for _, cluster := range clusters {
+		if quota.IsApplied(cluster.OwnerPath, cluster.RuntimeId) {
+			clusterIds = append(clusterIds, cluster.ClusterId)
+		}
+	}
+
+	usage := &models.ResourceUsage{
+		Clusters: uint32(len(clusterIds)),
+	}
+	if len(clusterIds) == 0 {
+		return usage, nil
+	}
+
+	var clusterRoles []*models.ClusterRole
+	_, err = pi.Global().DB(ctx).
+		Select(models.ClusterRoleColumns...).
+		From(constants.TableClusterRole).
+		Where(db.Eq(constants.ColumnClusterId, clusterIds)).
+		Load(&clusterRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterNodes []*models.ClusterNode
+	_, err = pi.Global().DB(ctx).
+		Select(constants.ColumnClusterId, constants.ColumnRole).
+		From(constants.TableClusterNode).
+		Where(db.Eq(constants.ColumnClusterId, clusterIds)).
+		Where(db.Neq(constants.ColumnStatus, constants.DeletedStatuses)).
+		Load(&clusterNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	rolesOfClusters := make(map[string]map[string]*models.ClusterRole)
+	for _, clusterRole := range clusterRoles {
+		if _, isExist := rolesOfClusters[clusterRole.ClusterId]; !isExist {
+			rolesOfClusters[clusterRole.ClusterId] = make(map[string]*models.ClusterRole)
+		}
+		rolesOfClusters[clusterRole.ClusterId][clusterRole.Role] = clusterRole
+	}
+	nodesOfClusters := make(map[string][]*models.ClusterNode)
+	for _, clusterNode := range clusterNodes {
+		nodesOfClusters[clusterNode.ClusterId] = append(nodesOfClusters[clusterNode.ClusterId], clusterNode)
+	}
+	for clusterId, nodes := range nodesOfClusters {
+		nodesUsage := models.GetNodesResourceUsage(rolesOfClusters[clusterId], nodes)
+		nodesUsage.Clusters = 0
+		usage.Add(nodesUsage)
+	}
+	return usage, nil
+}
+
+// checkQuotas returns error if the resources requested by the clusters of owner path
+// in the runtime exceed any quota limiting them
+func checkQuotas(ctx context.Context, ownerPath sender.OwnerPath, runtimeId string, requested *models.ResourceUsage) error {
+	quotas, err := getAppliedQuotas(ctx, ownerPath, runtimeId)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	for _, quota := range quotas {
+		used, err := getQuotaUsage(ctx, quota)
+		if err != nil {
+			return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+		err = quota.Check(used, requested)
+		if err != nil {
+			logger.Warn(ctx, "Owner [%s] exceeds quota [%s] in runtime [%s]: %+v", ownerPath, quota.QuotaId, runtimeId, err)
+			return gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorQuotaExceeded, quota.QuotaId, err.Error())
+		}
+	}
+	return nil
+}
+
+// registerWithinQuotas checks the quotas and registers the requested resources by register
+// in the quota lock, so that the resources registered concurrently are counted by each other.
+// Quotas of groups and owner paths are shared by owners, so the lock is not taken per owner
+func registerWithinQuotas(ctx context.Context, ownerPath sender.OwnerPath, runtimeId string, requested *models.ResourceUsage, register func() error) error {
+	var registerErr error
+	err := pi.Global().Etcd(ctx).DlockWithTimeout(constants.QuotaLock, 60*time.Second, func() error {
+		registerErr = checkQuotas(ctx, ownerPath, runtimeId, requested)
+		if registerErr == nil {
+			registerErr = register()
+		}
+		return registerErr
+	})
+	if registerErr != nil {
+		return registerErr
+	}
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	return nil
+}
+
+func (p *Server) CreateQuota(ctx context.Context, req *pb.CreateQuotaRequest) (*pb.CreateQuotaResponse, error) {
+	err := checkQuotaAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s := ctxutil.GetSender(ctx)
+	ownerPath := s.GetOwnerPath()
+	subjectType := req.GetSubjectType().GetValue()
+	subject := req.GetSubject().GetValue()
+	runtimeId := req.GetRuntimeId().GetValue()
+
+	count, err := pi.Global().DB(ctx).
+		Select(constants.ColumnQuotaId).
+		From(constants.TableQuota).
+		Where(db.Eq(constants.ColumnSubjectType, subjectType)).
+		Where(db.Eq(constants.ColumnSubject, subject)).
+		Where(db.Eq(constants.ColumnRuntimeId, runtimeId)).
+		Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+	if count > 0 {
+		return nil, gerr.New(ctx, gerr.AlreadyExists, gerr.ErrorQuotaExists, subjectType, subject, runtimeId)
+	}
+
+	now := time.Now()
+	newQuota := &models.ResourceQuota{
+		QuotaId:       models.NewQuotaId(),
+		SubjectType:   subjectType,
+		Subject:       subject,
+		RuntimeId:     runtimeId,
+		MaxClusters:   req.GetMaxClusters().GetValue(),
+		MaxNodes:      req.GetMaxNodes().GetValue(),
+		MaxCpu:        req.GetMaxCpu().GetValue(),
+		MaxMemory:     req.GetMaxMemory().GetValue(),
+		MaxVolumeSize: req.GetMaxVolumeSize().GetValue(),
+		Description:   req.GetDescription().GetValue(),
+		Owner:         ownerPath.Owner(),
+		OwnerPath:     ownerPath,
+		CreateTime:    now,
+		StatusTime:    now,
+	}
+
+	_, err = pi.Global().DB(ctx).
+		InsertInto(constants.TableQuota).
+		Record(newQuota).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+
+	return &pb.CreateQuotaResponse{
+		QuotaId: pbutil.ToProtoString(newQuota.QuotaId),
+	}, nil
+}
+
+func (p *Server) ModifyQuota(ctx context.Context, req *pb.ModifyQuotaRequest) (*pb.ModifyQuotaResponse, error) {
+	err := checkQuotaAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quotaId := req.GetQuotaId().GetValue()
+	count, err := pi.Global().DB(ctx).
+		Select(constants.ColumnQuotaId).
+		From(constants.TableQuota).
+		Where(db.Eq(constants.ColumnQuotaId, quotaId)).
+		Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, quotaId)
+	}
+	if count == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, quotaId)
+	}
+
+	attributes := manager.BuildUpdateAttributes(req,
+		"max_clusters", "max_nodes", "max_cpu", "max_memory", "max_volume_size", constants.ColumnDescription)
+	attributes[constants.ColumnStatusTime] = time.Now()
+
+	_, err = pi.Global().DB(ctx).
+		Update(constants.TableQuota).
+		SetMap(attributes).
+		Where(db.Eq(constants.ColumnQuotaId, quotaId)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, quotaId)
+	}
+
+	return &pb.ModifyQuotaResponse{
+		QuotaId: pbutil.ToProtoString(quotaId),
+	}, nil
+}
+
+func (p *Server) DeleteQuotas(ctx context.Context, req *pb.DeleteQuotasRequest) (*pb.DeleteQuotasResponse, error) {
+	err := checkQuotaAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quotaIds := req.GetQuotaId()
+	_, err = pi.Global().DB(ctx).
+		DeleteFrom(constants.TableQuota).
+		Where(db.Eq(constants.ColumnQuotaId, quotaIds)).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, strings.Join(quotaIds, ","))
+	}
+
+	return &pb.DeleteQuotasResponse{
+		QuotaId: quotaIds,
+	}, nil
+}
+
+// DescribeQuotas returns all quotas to admins, and only the quotas limiting themselves to others
+func (p *Server) DescribeQuotas(ctx context.Context, req *pb.DescribeQuotasRequest) (*pb.DescribeQuotasResponse, error) {
+	var quotas []*models.ResourceQuota
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+	displayColumns := manager.GetDisplayColumns(req.GetDisplayColumns(), models.ResourceQuotaColumns)
+	query := pi.Global().DB(ctx).
+		Select(displayColumns...).
+		From(constants.TableQuota).
+		Offset(offset).
+		Limit(limit).
+		Where(manager.BuildFilterConditions(req, constants.TableQuota))
+	if checkQuotaAdminPermission(ctx) != nil {
+		query = query.Where(buildAppliedQuotaFilter(ctxutil.GetSender(ctx).GetOwnerPath()))
+	}
+	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+
+	if len(displayColumns) > 0 {
+		_, err := query.Load(&quotas)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+	}
+	count, err := query.Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	return &pb.DescribeQuotasResponse{
+		QuotaSet:   models.ResourceQuotasToPbs(quotas),
+		TotalCount: count,
+	}, nil
+}
+
+func (p *Server) DescribeQuotaUsage(ctx context.Context, req *pb.DescribeQuotaUsageRequest) (*pb.DescribeQuotaUsageResponse, error) {
+	s := ctxutil.GetSender(ctx)
+	ownerPath := s.GetOwnerPath()
+	if req.GetOwnerPath() != nil {
+		ownerPath = sender.OwnerPath(req.GetOwnerPath().GetValue())
+		if !ownerPath.CheckPermission(s) {
+			return nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorResourceAccessDenied, ownerPath)
+		}
+	}
+	runtimeId := req.GetRuntimeId().GetValue()
+
+	quotas, err := getAppliedQuotas(ctx, ownerPath, runtimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	var quotaUsageSet []*pb.QuotaUsage
+	for _, quota := range quotas {
+		used, err := getQuotaUsage(ctx, quota)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+		quotaUsageSet = append(quotaUsageSet, models.ResourceUsageToPb(quota, used))
+	}
+
+	return &pb.DescribeQuotaUsageResponse{
+		QuotaUsageSet: quotaUsageSet,
+	}, nil
+}
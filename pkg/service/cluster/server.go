@@ -20,6 +20,8 @@ type Server struct {
 func Serve(cfg *config.Config) {
 	pi.SetGlobal(cfg)
 	s := Server{}
+	go ServeScheduledBackups()
+	go ServeHealthChecks()
 	manager.NewGrpcServer("cluster-manager", constants.ClusterManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
 		WithChecker(s.Checker).
@@ -0,0 +1,712 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jobclient "openpitrix.io/openpitrix/pkg/client/job"
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/plugins"
+	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/ctxutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
+)
+
+// getBackupRolesAndNodes returns the roles of cluster having backup service and nodes,
+// only the given roles are returned if any
+func getBackupRolesAndNodes(clusterWrapper *models.ClusterWrapper, roles []string) ([]string, []string) {
+	var commonRoles []string
+	for role := range clusterWrapper.ClusterCommons {
+		commonRoles = append(commonRoles, role)
+	}
+	sort.Strings(commonRoles)
+
+	var backupRoles, nodeIds []string
+	for _, role := range commonRoles {
+		if clusterWrapper.ClusterCommons[role].BackupService == "" {
+			continue
+		}
+		if len(roles) > 0 && !stringutil.StringIn(role, roles) {
+			continue
+		}
+		var roleNodeIds []string
+		for nodeId, clusterNode := range clusterWrapper.ClusterNodesWithKeyPairs {
+			if clusterNode.Role == role {
+				roleNodeIds = append(roleNodeIds, nodeId)
+			}
+		}
+		if len(roleNodeIds) == 0 {
+			continue
+		}
+		sort.Strings(roleNodeIds)
+		backupRoles = append(backupRoles, role)
+		nodeIds = append(nodeIds, roleNodeIds...)
+	}
+	return backupRoles, nodeIds
+}
+
+func isIncrementalBackupSupported(clusterWrapper *models.ClusterWrapper, roles []string) bool {
+	for _, role := range roles {
+		if !clusterWrapper.ClusterCommons[role].IncrementalBackupSupported {
+			return false
+		}
+	}
+	return true
+}
+
+func getClusterSnapshots(ctx context.Context, snapshotIds []string) ([]*models.ClusterSnapshot, error) {
+	var snapshots []*models.ClusterSnapshot
+	if len(snapshotIds) == 0 {
+		return snapshots, nil
+	}
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterSnapshotColumns...).
+		From(constants.TableClusterSnapshot).
+		Where(db.Eq(constants.ColumnSnapshotId, snapshotIds)).
+		Load(&snapshots)
+	return snapshots, err
+}
+
+// getLastFullSnapshot returns the last active full snapshot of the roles of cluster,
+// which the incremental snapshots are based on
+func getLastFullSnapshot(ctx context.Context, clusterId, roles string) (*models.ClusterSnapshot, error) {
+	var snapshots []*models.ClusterSnapshot
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterSnapshotColumns...).
+		From(constants.TableClusterSnapshot).
+		Where(db.Eq(constants.ColumnClusterId, clusterId)).
+		Where(db.Eq(constants.ColumnSnapshotType, constants.SnapshotTypeFull)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		OrderDir(constants.ColumnCreateTime, false).
+		Load(&snapshots)
+	if err != nil {
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Roles == roles {
+			return snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+// getSnapshotChain returns the snapshots to restore from in order, the full snapshot
+// and the incremental snapshots based on it up to the given snapshot
+func getSnapshotChain(ctx context.Context, snapshot *models.ClusterSnapshot) ([]*models.ClusterSnapshot, error) {
+	if snapshot.SnapshotType != constants.SnapshotTypeIncremental {
+		return []*models.ClusterSnapshot{snapshot}, nil
+	}
+	parents, err := getClusterSnapshots(ctx, []string{snapshot.ParentSnapshotId})
+	if err != nil {
+		return nil, err
+	}
+	if len(parents) == 0 || parents[0].Status != constants.StatusActive {
+		return nil, fmt.Errorf("full snapshot [%s] of snapshot [%s] is not active", snapshot.ParentSnapshotId, snapshot.SnapshotId)
+	}
+	parent := parents[0]
+
+	var chainIds []string
+	for _, childId := range parent.GetChildSnapshotIds() {
+		chainIds = append(chainIds, childId)
+		if childId == snapshot.SnapshotId {
+			break
+		}
+	}
+	children, err := getClusterSnapshots(ctx, chainIds)
+	if err != nil {
+		return nil, err
+	}
+	childMap := make(map[string]*models.ClusterSnapshot)
+	for _, child := range children {
+		childMap[child.SnapshotId] = child
+	}
+
+	chain := []*models.ClusterSnapshot{parent}
+	for _, childId := range chainIds {
+		child, exist := childMap[childId]
+		if !exist || child.Status != constants.StatusActive {
+			return nil, fmt.Errorf("snapshot [%s] in the chain of snapshot [%s] is not active", childId, snapshot.SnapshotId)
+		}
+		chain = append(chain, child)
+	}
+	if chain[len(chain)-1].SnapshotId != snapshot.SnapshotId {
+		return nil, fmt.Errorf("snapshot [%s] is not in the chain of full snapshot [%s]", snapshot.SnapshotId, parent.SnapshotId)
+	}
+	return chain, nil
+}
+
+// withChildSnapshots returns the snapshots and the incremental snapshots based on the full ones,
+// the snapshots already deleted are skipped
+func withChildSnapshots(ctx context.Context, snapshots []*models.ClusterSnapshot) ([]*models.ClusterSnapshot, error) {
+	var childIds []string
+	for _, snapshot := range snapshots {
+		childIds = append(childIds, snapshot.GetChildSnapshotIds()...)
+	}
+	children, err := getClusterSnapshots(ctx, childIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.ClusterSnapshot
+	added := make(map[string]bool)
+	for _, snapshot := range append(snapshots, children...) {
+		if added[snapshot.SnapshotId] || snapshot.Status == constants.StatusDeleted {
+			continue
+		}
+		added[snapshot.SnapshotId] = true
+		result = append(result, snapshot)
+	}
+	return result, nil
+}
+
+// updateSnapshotChain keeps the ChildSnapshotIds of the full snapshot in step with
+// the status of the incremental snapshot based on it
+func updateSnapshotChain(ctx context.Context, snapshotId, status string) error {
+	snapshots, err := getClusterSnapshots(ctx, []string{snapshotId})
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 || snapshots[0].ParentSnapshotId == "" {
+		return nil
+	}
+	parentSnapshotId := snapshots[0].ParentSnapshotId
+
+	return pi.Global().Etcd(ctx).DlockWithTimeout(constants.ClusterSnapshotPrefix+parentSnapshotId, 60*time.Second, func() error {
+		parents, err := getClusterSnapshots(ctx, []string{parentSnapshotId})
+		if err != nil {
+			return err
+		}
+		if len(parents) == 0 {
+			return nil
+		}
+
+		// the incremental snapshots are kept in the order they become active
+		var childIds []string
+		for _, childId := range parents[0].GetChildSnapshotIds() {
+			if childId != snapshotId {
+				childIds = append(childIds, childId)
+			} else if status == constants.StatusActive {
+				return nil
+			}
+		}
+		if status == constants.StatusActive {
+			childIds = append(childIds, snapshotId)
+		}
+		_, err = pi.Global().DB(ctx).
+			Update(constants.TableClusterSnapshot).
+			Set(constants.ColumnChildSnapshotIds, strings.Join(childIds, ",")).
+			Where(db.Eq(constants.ColumnSnapshotId, parentSnapshotId)).
+			Exec()
+		return err
+	})
+}
+
+func modifyClusterSnapshotsAttributes(ctx context.Context, snapshotIds []string, attributes map[string]interface{}) error {
+	_, err := pi.Global().DB(ctx).
+		Update(constants.TableClusterSnapshot).
+		SetMap(attributes).
+		Where(db.Eq(constants.ColumnSnapshotId, snapshotIds)).
+		Exec()
+	return err
+}
+
+// newClusterSnapshotJob checks the cluster to back up, and returns the snapshot of the roles
+// of cluster and the job creating it
+func newClusterSnapshotJob(ctx context.Context, clusterId, name, description string, roles []string,
+	incremental, autoBackup bool) (*models.ClusterSnapshot, *models.Job, error) {
+	s := ctxutil.GetSender(ctx)
+	cluster, err := CheckClusterPermission(ctx, clusterId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cluster.ClusterType == constants.FrontgateClusterType {
+		return nil, nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorBackupResourceFailed, clusterId)
+	}
+	err = checkPermissionAndTransition(ctx, cluster, []string{constants.StatusActive})
+	if err != nil {
+		return nil, nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorBackupResourceFailed, clusterId)
+	}
+
+	runtime, err := runtimeclient.NewRuntime(ctx, cluster.RuntimeId)
+	if err != nil {
+		return nil, nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+	}
+	if !plugins.IsVmbasedProviders(runtime.Runtime.Provider) {
+		return nil, nil, gerr.New(ctx, gerr.PermissionDenied, gerr.ErrorBackupResourceFailed, clusterId)
+	}
+
+	clusterWrapper, err := getClusterWrapper(ctx, clusterId)
+	if err != nil {
+		return nil, nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+	backupRoles, nodeIds := getBackupRolesAndNodes(clusterWrapper, roles)
+	if len(backupRoles) == 0 {
+		return nil, nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorResourceBackupNotSupported, clusterId)
+	}
+
+	snapshot := &models.ClusterSnapshot{
+		SnapshotId:       models.NewClusterSnapshotId(),
+		ClusterId:        clusterId,
+		Name:             name,
+		Description:      description,
+		Roles:            strings.Join(backupRoles, ","),
+		NodeIds:          strings.Join(nodeIds, ","),
+		AppId:            cluster.AppId,
+		VersionId:        cluster.VersionId,
+		SnapshotType:     constants.SnapshotTypeFull,
+		AutoBackup:       autoBackup,
+		Status:           constants.StatusPending,
+		TransitionStatus: constants.StatusCreating,
+		Owner:            s.GetOwnerPath().Owner(),
+		OwnerPath:        s.GetOwnerPath(),
+		CreateTime:       time.Now(),
+		StatusTime:       time.Now(),
+	}
+	// incremental snapshot is based on the last full snapshot of the same roles,
+	// the full snapshot is created if there is none
+	if incremental && isIncrementalBackupSupported(clusterWrapper, backupRoles) {
+		parent, err := getLastFullSnapshot(ctx, clusterId, snapshot.Roles)
+		if err != nil {
+			return nil, nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorBackupResourceFailed, clusterId)
+		}
+		if parent != nil {
+			snapshot.SnapshotType = constants.SnapshotTypeIncremental
+			snapshot.ParentSnapshotId = parent.SnapshotId
+		}
+	}
+
+	directive := jsonutil.ToString(&models.ClusterSnapshotDirective{
+		ClusterWrapper: clusterWrapper,
+		Snapshots:      []*models.ClusterSnapshot{snapshot},
+	})
+	newJob := models.NewJob(
+		constants.PlaceHolder,
+		clusterId,
+		cluster.AppId,
+		cluster.VersionId,
+		constants.ActionCreateClusterSnapshot,
+		directive,
+		runtime.Runtime.Provider,
+		s.GetOwnerPath(),
+		cluster.RuntimeId,
+	)
+	return snapshot, newJob, nil
+}
+
+func sendClusterSnapshotJob(ctx context.Context, snapshot *models.ClusterSnapshot, newJob *models.Job) (string, error) {
+	_, err := pi.Global().DB(ctx).
+		InsertInto(constants.TableClusterSnapshot).
+		Record(snapshot).
+		Exec()
+	if err != nil {
+		return "", gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorBackupResourceFailed, snapshot.ClusterId)
+	}
+
+	jobId, err := jobclient.SendJob(ctx, newJob)
+	if err != nil {
+		modifyClusterSnapshotsAttributes(ctx, []string{snapshot.SnapshotId}, map[string]interface{}{
+			constants.ColumnStatus:           constants.StatusFailed,
+			constants.ColumnTransitionStatus: "",
+			constants.ColumnStatusTime:       time.Now(),
+		})
+		return "", gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorBackupResourceFailed, snapshot.ClusterId)
+	}
+	return jobId, nil
+}
+
+func (p *Server) CreateClusterSnapshot(ctx context.Context, req *pb.CreateClusterSnapshotRequest) (*pb.CreateClusterSnapshotResponse, error) {
+	clusterId := req.GetClusterId().GetValue()
+	snapshot, newJob, err := newClusterSnapshotJob(ctx, clusterId, req.GetName().GetValue(), req.GetDescription().GetValue(),
+		req.GetRole(), req.GetIncremental().GetValue(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetDryRun().GetValue() {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorBackupResourceFailed, clusterId)
+		}
+		return &pb.CreateClusterSnapshotResponse{
+			ClusterId:  pbutil.ToProtoString(clusterId),
+			SnapshotId: pbutil.ToProtoString(snapshot.SnapshotId),
+			TaskLayer:  taskLayer,
+		}, nil
+	}
+
+	jobId, err := sendClusterSnapshotJob(ctx, snapshot, newJob)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateClusterSnapshotResponse{
+		ClusterId:  pbutil.ToProtoString(clusterId),
+		SnapshotId: pbutil.ToProtoString(snapshot.SnapshotId),
+		JobId:      pbutil.ToProtoString(jobId),
+	}, nil
+}
+
+func (p *Server) DescribeClusterSnapshots(ctx context.Context, req *pb.DescribeClusterSnapshotsRequest) (*pb.DescribeClusterSnapshotsResponse, error) {
+	var snapshots []*models.ClusterSnapshot
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+	displayColumns := manager.GetDisplayColumns(req.GetDisplayColumns(), models.ClusterSnapshotColumns)
+	query := pi.Global().DB(ctx).
+		Select(displayColumns...).
+		From(constants.TableClusterSnapshot).
+		Offset(offset).
+		Limit(limit).
+		Where(manager.BuildPermissionFilter(ctx)).
+		Where(manager.BuildFilterConditions(req, constants.TableClusterSnapshot))
+	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+
+	if len(displayColumns) > 0 {
+		_, err := query.Load(&snapshots)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+	}
+	count, err := query.Count()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	res := &pb.DescribeClusterSnapshotsResponse{
+		ClusterSnapshotSet: models.ClusterSnapshotsToPbs(snapshots),
+		TotalCount:         count,
+	}
+	return res, nil
+}
+
+func (p *Server) RestoreClusterFromSnapshot(ctx context.Context, req *pb.RestoreClusterFromSnapshotRequest) (*pb.RestoreClusterFromSnapshotResponse, error) {
+	s := ctxutil.GetSender(ctx)
+	snapshotId := req.GetSnapshotId().GetValue()
+	snapshot, err := CheckClusterSnapshotPermission(ctx, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+	clusterId := snapshot.ClusterId
+	if snapshot.Status != constants.StatusActive || snapshot.TransitionStatus != "" {
+		err = fmt.Errorf("snapshot [%s] status is [%s] and transition status is [%s]", snapshotId, snapshot.Status, snapshot.TransitionStatus)
+		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorRestoreResourceFailed, clusterId, snapshotId)
+	}
+
+	cluster, err := CheckClusterPermission(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkPermissionAndTransition(ctx, cluster, []string{constants.StatusActive})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorRestoreResourceFailed, clusterId, snapshotId)
+	}
+
+	snapshots, err := getSnapshotChain(ctx, snapshot)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.FailedPrecondition, err, gerr.ErrorRestoreResourceFailed, clusterId, snapshotId)
+	}
+
+	clusterWrapper, err := getClusterWrapper(ctx, clusterId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+	runtime, err := runtimeclient.NewRuntime(ctx, cluster.RuntimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+	}
+
+	directive := jsonutil.ToString(&models.ClusterSnapshotDirective{
+		ClusterWrapper: clusterWrapper,
+		Snapshots:      snapshots,
+	})
+	newJob := models.NewJob(
+		constants.PlaceHolder,
+		clusterId,
+		cluster.AppId,
+		cluster.VersionId,
+		constants.ActionRestoreClusterFromSnapshot,
+		directive,
+		runtime.Runtime.Provider,
+		s.GetOwnerPath(),
+		cluster.RuntimeId,
+	)
+
+	if req.GetDryRun().GetValue() {
+		taskLayer, err := splitJobIntoTasks(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRestoreResourceFailed, clusterId, snapshotId)
+		}
+		return &pb.RestoreClusterFromSnapshotResponse{
+			ClusterId:  pbutil.ToProtoString(clusterId),
+			SnapshotId: pbutil.ToProtoString(snapshotId),
+			TaskLayer:  taskLayer,
+		}, nil
+	}
+
+	jobId, err := jobclient.SendJob(ctx, newJob)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRestoreResourceFailed, clusterId, snapshotId)
+	}
+
+	return &pb.RestoreClusterFromSnapshotResponse{
+		ClusterId:  pbutil.ToProtoString(clusterId),
+		SnapshotId: pbutil.ToProtoString(snapshotId),
+		JobId:      pbutil.ToProtoString(jobId),
+	}, nil
+}
+
+func (p *Server) DeleteClusterSnapshots(ctx context.Context, req *pb.DeleteClusterSnapshotsRequest) (*pb.DeleteClusterSnapshotsResponse, error) {
+	s := ctxutil.GetSender(ctx)
+	snapshots, err := CheckClusterSnapshotsPermission(ctx, req.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+	// the incremental snapshots are useless without the full snapshot they are based on
+	snapshots, err = withChildSnapshots(ctx, snapshots)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourcesFailed)
+	}
+
+	var clusterIds []string
+	clusterSnapshots := make(map[string][]*models.ClusterSnapshot)
+	for _, snapshot := range snapshots {
+		if snapshot.TransitionStatus != "" {
+			err = fmt.Errorf("snapshot [%s] is [%s], please try later", snapshot.SnapshotId, snapshot.TransitionStatus)
+			return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorDeleteResourceFailed, snapshot.SnapshotId)
+		}
+		if _, exist := clusterSnapshots[snapshot.ClusterId]; !exist {
+			clusterIds = append(clusterIds, snapshot.ClusterId)
+		}
+		clusterSnapshots[snapshot.ClusterId] = append(clusterSnapshots[snapshot.ClusterId], snapshot)
+	}
+
+	var snapshotIds, jobIds []string
+	for _, clusterId := range clusterIds {
+		var deleteSnapshotIds []string
+		for _, snapshot := range clusterSnapshots[clusterId] {
+			deleteSnapshotIds = append(deleteSnapshotIds, snapshot.SnapshotId)
+		}
+
+		clusterWrapper, err := getClusterWrapper(ctx, clusterId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+		}
+		cluster := clusterWrapper.Cluster
+
+		// the data of snapshots is gone with the nodes of cluster deleted
+		if stringutil.StringIn(cluster.Status, constants.DeletedStatuses) {
+			for _, snapshotId := range deleteSnapshotIds {
+				err = modifyClusterSnapshotsAttributes(ctx, []string{snapshotId}, map[string]interface{}{
+					constants.ColumnStatus:     constants.StatusDeleted,
+					constants.ColumnStatusTime: time.Now(),
+				})
+				if err != nil {
+					return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, snapshotId)
+				}
+				err = updateSnapshotChain(ctx, snapshotId, constants.StatusDeleted)
+				if err != nil {
+					return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, snapshotId)
+				}
+			}
+			snapshotIds = append(snapshotIds, deleteSnapshotIds...)
+			continue
+		}
+
+		err = checkPermissionAndTransition(ctx, cluster, []string{constants.StatusActive})
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorDeleteResourceFailed, clusterId)
+		}
+		runtime, err := runtimeclient.NewRuntime(ctx, cluster.RuntimeId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+		}
+
+		err = modifyClusterSnapshotsAttributes(ctx, deleteSnapshotIds, map[string]interface{}{
+			constants.ColumnTransitionStatus: constants.StatusDeleting,
+			constants.ColumnStatusTime:       time.Now(),
+		})
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, clusterId)
+		}
+
+		directive := jsonutil.ToString(&models.ClusterSnapshotDirective{
+			ClusterWrapper: clusterWrapper,
+			Snapshots:      clusterSnapshots[clusterId],
+		})
+		newJob := models.NewJob(
+			constants.PlaceHolder,
+			clusterId,
+			cluster.AppId,
+			cluster.VersionId,
+			constants.ActionDeleteClusterSnapshots,
+			directive,
+			runtime.Runtime.Provider,
+			s.GetOwnerPath(),
+			cluster.RuntimeId,
+		)
+
+		jobId, err := jobclient.SendJob(ctx, newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, clusterId)
+		}
+		snapshotIds = append(snapshotIds, deleteSnapshotIds...)
+		jobIds = append(jobIds, jobId)
+	}
+
+	return &pb.DeleteClusterSnapshotsResponse{
+		SnapshotId: snapshotIds,
+		JobId:      jobIds,
+	}, nil
+}
+
+func (p *Server) ModifyClusterSnapshot(ctx context.Context, req *pb.ModifyClusterSnapshotRequest) (*pb.ModifyClusterSnapshotResponse, error) {
+	snapshotId := req.GetClusterSnapshot().GetSnapshotId().GetValue()
+	_, err := CheckClusterSnapshotPermission(ctx, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := manager.BuildUpdateAttributes(req.ClusterSnapshot, models.ClusterSnapshotColumns...)
+	err = modifyClusterSnapshotsAttributes(ctx, []string{snapshotId}, attributes)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, snapshotId)
+	}
+
+	if status, exist := attributes[constants.ColumnStatus]; exist {
+		err = updateSnapshotChain(ctx, snapshotId, status.(string))
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, snapshotId)
+		}
+	}
+
+	res := &pb.ModifyClusterSnapshotResponse{
+		SnapshotId: pbutil.ToProtoString(snapshotId),
+	}
+	return res, nil
+}
+
+// getScheduledBackups returns the roles of clusters to back up by the backup policy,
+// and the shortest interval between the backups of the roles
+func getScheduledBackups(ctx context.Context) (map[string][]string, map[string]time.Duration, error) {
+	var clusterCommons []*models.ClusterCommon
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterCommonColumns...).
+		From(constants.TableClusterCommon).
+		Where(db.Neq(constants.ColumnBackupPolicy, "")).
+		Where(db.Neq(constants.ColumnBackupService, "")).
+		Load(&clusterCommons)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clusterRoles := make(map[string][]string)
+	clusterIntervals := make(map[string]time.Duration)
+	for _, clusterCommon := range clusterCommons {
+		interval := models.GetBackupInterval(clusterCommon.BackupPolicy)
+		if interval == 0 {
+			continue
+		}
+		clusterId := clusterCommon.ClusterId
+		clusterRoles[clusterId] = append(clusterRoles[clusterId], clusterCommon.Role)
+		if clusterIntervals[clusterId] == 0 || interval < clusterIntervals[clusterId] {
+			clusterIntervals[clusterId] = interval
+		}
+	}
+	return clusterRoles, clusterIntervals, nil
+}
+
+// scheduleBackups creates the snapshots of the active clusters whose last scheduled backup
+// is older than the interval of backup policy
+func scheduleBackups(ctx context.Context) error {
+	clusterRoles, clusterIntervals, err := getScheduledBackups(ctx)
+	if err != nil {
+		return err
+	}
+	if len(clusterRoles) == 0 {
+		return nil
+	}
+	var clusterIds []string
+	for clusterId := range clusterRoles {
+		clusterIds = append(clusterIds, clusterId)
+	}
+
+	var clusters []*models.Cluster
+	_, err = pi.Global().DB(ctx).
+		Select(models.ClusterColumns...).
+		From(constants.TableCluster).
+		Where(db.Eq(constants.ColumnClusterId, clusterIds)).
+		Where(db.Eq(constants.ColumnClusterType, constants.NormalClusterType)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		Where(db.Eq(constants.ColumnTransitionStatus, "")).
+		Load(&clusters)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		var lastSnapshots []*models.ClusterSnapshot
+		_, err = pi.Global().DB(ctx).
+			Select(models.ClusterSnapshotColumns...).
+			From(constants.TableClusterSnapshot).
+			Where(db.Eq(constants.ColumnClusterId, cluster.ClusterId)).
+			Where(db.Eq(constants.ColumnAutoBackup, true)).
+			OrderDir(constants.ColumnCreateTime, false).
+			Limit(1).
+			Load(&lastSnapshots)
+		if err != nil {
+			return err
+		}
+		if len(lastSnapshots) > 0 && time.Since(lastSnapshots[0].CreateTime) < clusterIntervals[cluster.ClusterId] {
+			continue
+		}
+
+		// the snapshot is created on behalf of the owner of cluster
+		ownerCtx := ctxutil.ContextWithSender(ctx, sender.New(cluster.Owner, cluster.OwnerPath, ""))
+		name := fmt.Sprintf("auto-backup-%s", time.Now().Format("20060102150405"))
+		snapshot, newJob, err := newClusterSnapshotJob(ownerCtx, cluster.ClusterId, name, "", clusterRoles[cluster.ClusterId], true, true)
+		if err != nil {
+			logger.Error(ctx, "Schedule backup of cluster [%s] failed: %+v", cluster.ClusterId, err)
+			continue
+		}
+		jobId, err := sendClusterSnapshotJob(ownerCtx, snapshot, newJob)
+		if err != nil {
+			logger.Error(ctx, "Schedule backup of cluster [%s] failed: %+v", cluster.ClusterId, err)
+			continue
+		}
+		logger.Info(ctx, "Scheduled backup [%s] of cluster [%s] by job [%s]", snapshot.SnapshotId, cluster.ClusterId, jobId)
+	}
+	return nil
+}
+
+// ServeScheduledBackups checks the clusters by interval and backs them up by the backup policy,
+// the clusters are checked by one cluster manager at the same time
+func ServeScheduledBackups() {
+	ticker := time.NewTicker(constants.ScheduleBackupInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx := context.Background()
+		err := pi.Global().Etcd(ctx).DlockWithTimeout(constants.ScheduleBackupLock, constants.ScheduleBackupTimeout, func() error {
+			return scheduleBackups(ctx)
+		})
+		if err != nil {
+			logger.Error(ctx, "Schedule backups failed: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	providerclient "openpitrix.io/openpitrix/pkg/client/runtime_provider"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// getVersionClusterCommons parses the cluster commons of roles of the cluster from the package of
+// the app version, the default conf of the version is used since only the commons are needed
+func getVersionClusterCommons(ctx context.Context, clusterWrapper *models.ClusterWrapper, versionId string) (map[string]*models.ClusterCommon, error) {
+	clusterId := clusterWrapper.Cluster.ClusterId
+	runtimeId := clusterWrapper.Cluster.RuntimeId
+
+	providerClient, err := providerclient.NewRuntimeProviderManagerClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	response, err := providerClient.ParseClusterConf(ctx, &pb.ParseClusterConfRequest{
+		RuntimeId: pbutil.ToProtoString(runtimeId),
+		VersionId: pbutil.ToProtoString(versionId),
+		Conf:      pbutil.ToProtoString(""),
+		Cluster:   models.ClusterWrapperToPb(new(models.ClusterWrapper)),
+	})
+	if err != nil {
+		logger.Error(ctx, "Parse cluster conf with versionId [%s] runtime [%s] failed: %+v",
+			versionId, runtimeId, err)
+		if gerr.IsGRPCError(err) {
+			return nil, err
+		}
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorValidateFailed)
+	}
+	versionClusterWrapper := models.PbToClusterWrapper(response.Cluster)
+
+	clusterCommons := make(map[string]*models.ClusterCommon)
+	for role := range clusterWrapper.ClusterCommons {
+		clusterCommon, exist := versionClusterWrapper.ClusterCommons[role]
+		if !exist {
+			return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorResourceRoleNotFound, clusterId, role)
+		}
+		clusterCommon.ClusterId = clusterId
+		clusterCommons[role] = clusterCommon
+	}
+	return clusterCommons, nil
+}
+
+// getUpgradeDirective returns the directive of job upgrading the vm-based cluster to the cluster commons
+func getUpgradeDirective(clusterWrapper *models.ClusterWrapper, clusterCommons map[string]*models.ClusterCommon) string {
+	upgradeClusterWrapper := *clusterWrapper
+	upgradeClusterWrapper.ClusterCommons = clusterCommons
+	return jsonutil.ToString(&models.ClusterUpgradeDirective{
+		ClusterWrapper:     &upgradeClusterWrapper,
+		FromClusterCommons: clusterWrapper.ClusterCommons,
+	})
+}
+
+// getLastUpgradeAudit returns the active audit of the last upgrade to the current version of cluster
+func getLastUpgradeAudit(ctx context.Context, clusterId, versionId string) (*models.ClusterUpgradeAudit, error) {
+	var upgradeAudits []*models.ClusterUpgradeAudit
+	_, err := pi.Global().DB(ctx).
+		Select(models.ClusterUpgradeAuditColumns...).
+		From(constants.TableClusterUpgradeAudit).
+		Where(db.Eq(constants.ColumnClusterId, clusterId)).
+		Where(db.Eq(constants.ColumnToVersionId, versionId)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusActive)).
+		OrderDir(constants.ColumnCreateTime, false).
+		Limit(1).
+		Load(&upgradeAudits)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRollbackResourceFailed, clusterId)
+	}
+	if len(upgradeAudits) == 0 {
+		return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorRollbackResourceFailed, clusterId)
+	}
+	return upgradeAudits[0], nil
+}
+
+func modifyUpgradeAuditStatus(ctx context.Context, upgradeAuditId, status string) error {
+	_, err := pi.Global().DB(ctx).
+		Update(constants.TableClusterUpgradeAudit).
+		Set(constants.ColumnStatus, status).
+		Set(constants.ColumnStatusTime, time.Now()).
+		Where(db.Eq(constants.ColumnClusterUpgradeAuditId, upgradeAuditId)).
+		Exec()
+	return err
+}
+
+func deleteUpgradeAudit(ctx context.Context, upgradeAuditId string) error {
+	_, err := pi.Global().DB(ctx).
+		DeleteFrom(constants.TableClusterUpgradeAudit).
+		Where(db.Eq(constants.ColumnClusterUpgradeAuditId, upgradeAuditId)).
+		Exec()
+	return err
+}
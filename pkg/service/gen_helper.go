@@ -32,6 +32,9 @@ var syncTemplate = template.Must(template.New("").Funcs(template.FuncMap{
 		if table == "cluster_node" {
 			return "node"
 		}
+		if table == "cluster_snapshot" {
+			return "snapshot"
+		}
 		return table
 	},
 }).Parse(`
@@ -97,6 +100,7 @@ func main() {
 	var models = []string{
 		"repo", "app", "app_version", "cluster", "cluster_node",
 		"job", "task", "repo_event", "runtime", "runtime_credential", "key_pair",
+		"cluster_snapshot", "cluster_service_audit",
 	}
 
 	permissions := make(map[string]*os.File)
@@ -111,6 +115,10 @@ func main() {
 			packageName = "cluster"
 		case "key_pair":
 			packageName = "cluster"
+		case "cluster_snapshot":
+			packageName = "cluster"
+		case "cluster_service_audit":
+			packageName = "cluster"
 		case "repo_event":
 			packageName = "repo_indexer"
 		case "runtime_credential":
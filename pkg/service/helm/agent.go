@@ -1,6 +1,8 @@
 package helm
 
 import (
+	"regexp"
+
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
@@ -15,26 +17,32 @@ func GetRelease(cfg *action.Configuration, releaseName string) (*release.Release
 	return releaseInfo, nil
 }
 
+// ListReleases lists releases in namespace of ns, releases of all namespaces are listed
+// if ns is empty, status could be name of release status or "all"
 func ListReleases(cfg *action.Configuration, releaseName, ns, status string) ([]*release.Release, error) {
 	cmd := action.NewList(cfg)
-	allNamespaces := false
-	if ns == "" {
-		allNamespaces = true
+	if releaseName != "" {
+		cmd.Filter = "^" + regexp.QuoteMeta(releaseName) + "$"
 	}
-	if status == "all" {
+	switch status {
+	case "":
+	case "all":
 		cmd.StateMask = action.ListAll
+	default:
+		cmd.StateMask = cmd.StateMask.FromName(status)
 	}
 	releases, err := cmd.Run()
 	if err != nil {
 		return nil, err
 	}
 
+	var result []*release.Release
 	for _, r := range releases {
-		if allNamespaces || r.Namespace == ns {
-
+		if ns == "" || r.Namespace == ns {
+			result = append(result, r)
 		}
 	}
-	return releases, nil
+	return result, nil
 }
 
 func CreateRelease(cfg *action.Configuration, releaseName, ns string, ch *chart.Chart, vals map[string]interface{}) (*release.Release, error) {
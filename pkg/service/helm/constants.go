@@ -21,4 +21,6 @@ const (
 	StatefulSetFlag = "-StatefulSet"
 	DaemonSetFlag   = "-DaemonSet"
 	Driver          = "configmap"
+
+	MaxReleaseHistory = 256
 )
@@ -28,13 +28,8 @@ func decodeJobDirective(ctx context.Context, data string) (*JobDirective, error)
 		return nil, err
 	}
 
-	namespace := runtime.Zone
-	if clusterWrapper.Cluster.Zone != "" {
-		namespace = clusterWrapper.Cluster.Zone
-	}
-
 	j := &JobDirective{
-		Namespace:   namespace,
+		Namespace:   getNamespace(runtime, clusterWrapper.Cluster),
 		RuntimeId:   runtimeId,
 		Values:      clusterWrapper.Cluster.Env,
 		ClusterName: clusterWrapper.Cluster.Name,
@@ -43,6 +38,15 @@ func decodeJobDirective(ctx context.Context, data string) (*JobDirective, error)
 	return j, nil
 }
 
+// getNamespace returns namespace of the release of cluster, it is the zone of cluster
+// or the zone of runtime by default
+func getNamespace(runtime *models.RuntimeDetails, cluster *models.Cluster) string {
+	if cluster.Zone != "" {
+		return cluster.Zone
+	}
+	return runtime.Zone
+}
+
 type TaskDirective struct {
 	VersionId         string
 	Namespace         string
@@ -50,6 +54,8 @@ type TaskDirective struct {
 	Values            string
 	ClusterName       string
 	RawClusterWrapper string
+	// version of release revision to roll back to, 0 means the previous revision
+	ReleaseVersion int32 `json:",omitempty"`
 }
 
 func encodeTaskDirective(v interface{}) string {
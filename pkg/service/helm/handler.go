@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/sender"
@@ -146,11 +147,16 @@ func (p *Server) SplitJobIntoTasks(ctx context.Context, req *pb.SplitJobIntoTask
 			Child: nil,
 		}
 	case constants.ActionRollbackCluster:
+		rollbackDirective, err := models.NewClusterRollbackDirective(ctx, job.Directive)
+		if err != nil {
+			return nil, err
+		}
 		td := TaskDirective{
 			Namespace:         jobDirective.Namespace,
 			RuntimeId:         jobDirective.RuntimeId,
 			ClusterName:       jobDirective.ClusterName,
 			RawClusterWrapper: job.Directive,
+			ReleaseVersion:    rollbackDirective.ReleaseVersion,
 		}
 		tdj := encodeTaskDirective(td)
 
@@ -255,7 +261,7 @@ func (s *Server) HandleSubtask(ctx context.Context, req *pb.HandleSubtaskRequest
 		}
 
 	case constants.ActionRollbackCluster:
-		err = proxy.RollbackRelease(cfg, directive.ClusterName)
+		err = proxy.RollbackRelease(cfg, directive.ClusterName, directive.ReleaseVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -293,6 +299,10 @@ func (s *Server) WaitSubtask(ctx context.Context, req *pb.WaitSubtaskRequest) (*
 	}
 
 	err = funcutil.WaitForSpecificOrError(func() (bool, error) {
+		// task manager stops waiting when the task is cancelled
+		if ctx.Err() != nil {
+			return true, ctx.Err()
+		}
 		switch task.TaskAction {
 		case constants.ActionCreateCluster, constants.ActionUpgradeCluster, constants.ActionRollbackCluster:
 			status, err := proxy.ReleaseStatus(cfg, taskDirective.ClusterName)
@@ -351,6 +361,13 @@ func (s *Server) WaitSubtask(ctx context.Context, req *pb.WaitSubtaskRequest) (*
 	}
 }
 
+func (p *Server) AbortSubtask(ctx context.Context, req *pb.AbortSubtaskRequest) (*pb.AbortSubtaskResponse, error) {
+	// helm release operations have been submitted in HandleSubtask and could not be interrupted,
+	// the release is left as it is and the cluster is rolled back by job manager
+	return nil, gerr.New(ctx, gerr.Unimplemented, gerr.ErrorAbortTaskNotSupported,
+		req.GetTask().GetTaskId().GetValue(), constants.ProviderKubernetes)
+}
+
 func (p *Server) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
 	return nil, fmt.Errorf("the action DescribeSubnets is not supported")
 }
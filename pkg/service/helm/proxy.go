@@ -219,16 +219,41 @@ func (proxy *Proxy) UpdateReleaseFromChart(cfg *action.Configuration, releaseNam
 	return nil
 }
 
-func (proxy *Proxy) RollbackRelease(cfg *action.Configuration, releaseName string) error {
+// RollbackRelease rolls back the release to the revision of version, 0 means the previous revision
+func (proxy *Proxy) RollbackRelease(cfg *action.Configuration, releaseName string, version int32) error {
 	rollbackCli := action.NewRollback(cfg)
+	rollbackCli.Version = int(version)
 	err := rollbackCli.Run(releaseName)
 	if err != nil {
-		logger.Debug(proxy.ctx, "rollback release [%s] error [%s]", releaseName, err.Error())
+		logger.Debug(proxy.ctx, "rollback release [%s] to version [%d] error [%s]", releaseName, version, err.Error())
 		return err
 	}
 	return nil
 }
 
+func (proxy *Proxy) ReleaseHistory(cfg *action.Configuration, releaseName string) ([]*release.Release, error) {
+	historyCli := action.NewHistory(cfg)
+	historyCli.Max = MaxReleaseHistory
+	rlss, err := historyCli.Run(releaseName)
+	if err != nil {
+		logger.Debug(proxy.ctx, "get release [%s] history error [%s]", releaseName, err.Error())
+		return nil, err
+	}
+	return rlss, nil
+}
+
+// ReleaseRevision returns the revision of version, 0 means the current revision
+func (proxy *Proxy) ReleaseRevision(cfg *action.Configuration, releaseName string, version int32) (*release.Release, error) {
+	getCli := action.NewGet(cfg)
+	getCli.Version = int(version)
+	rls, err := getCli.Run(releaseName)
+	if err != nil {
+		logger.Debug(proxy.ctx, "get release [%s] version [%d] error [%s]", releaseName, version, err.Error())
+		return nil, err
+	}
+	return rls, nil
+}
+
 func (proxy *Proxy) DeleteRelease(cfg *action.Configuration, releaseName string, purge bool, namespace string) error {
 	deleteCli := action.NewUninstall(cfg)
 
@@ -0,0 +1,88 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package helm
+
+import (
+	"fmt"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/release"
+
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/yamlutil"
+)
+
+const diffContextLines = 3
+
+func ReleaseToPb(rls *release.Release) *pb.Release {
+	pbRelease := pb.Release{}
+	pbRelease.ReleaseName = pbutil.ToProtoString(rls.Name)
+	pbRelease.Version = pbutil.ToProtoInt32(int32(rls.Version))
+	pbRelease.Namespace = pbutil.ToProtoString(rls.Namespace)
+	if rls.Info != nil {
+		pbRelease.Status = pbutil.ToProtoString(rls.Info.Status.String())
+		pbRelease.Description = pbutil.ToProtoString(rls.Info.Description)
+		pbRelease.FirstDeployedTime = pbutil.ToProtoTimestamp(rls.Info.FirstDeployed.Time)
+		pbRelease.LastDeployedTime = pbutil.ToProtoTimestamp(rls.Info.LastDeployed.Time)
+		pbRelease.DeletedTime = pbutil.ToProtoTimestamp(rls.Info.Deleted.Time)
+	}
+	if rls.Chart != nil && rls.Chart.Metadata != nil {
+		pbRelease.Chart = pbutil.ToProtoString(fmt.Sprintf("%s-%s", rls.Chart.Metadata.Name, rls.Chart.Metadata.Version))
+		pbRelease.AppVersion = pbutil.ToProtoString(rls.Chart.Metadata.AppVersion)
+	}
+	return &pbRelease
+}
+
+func ReleasesToPbs(rlss []*release.Release) (pbReleases []*pb.Release) {
+	for _, rls := range rlss {
+		pbReleases = append(pbReleases, ReleaseToPb(rls))
+	}
+	return
+}
+
+func revisionName(rls *release.Release) string {
+	return fmt.Sprintf("%s revision %d", rls.Name, rls.Version)
+}
+
+func releaseValues(rls *release.Release) (string, error) {
+	if len(rls.Config) == 0 {
+		return "", nil
+	}
+	values, err := yamlutil.Encode(rls.Config)
+	if err != nil {
+		return "", err
+	}
+	return string(values), nil
+}
+
+func unifiedDiff(from, to, fromName, toName string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  diffContextLines,
+	})
+}
+
+// diffReleases returns the unified diffs of user supplied values and rendered manifest
+// between two revisions of release, the diffs are empty if nothing changed
+func diffReleases(from, to *release.Release) (valuesDiff, manifestDiff string, err error) {
+	fromValues, err := releaseValues(from)
+	if err != nil {
+		return
+	}
+	toValues, err := releaseValues(to)
+	if err != nil {
+		return
+	}
+	valuesDiff, err = unifiedDiff(fromValues, toValues, revisionName(from), revisionName(to))
+	if err != nil {
+		return
+	}
+	manifestDiff, err = unifiedDiff(from.Manifest, to.Manifest, revisionName(from), revisionName(to))
+	return
+}
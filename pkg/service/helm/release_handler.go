@@ -0,0 +1,377 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package helm
+
+import (
+	"context"
+	"sort"
+
+	clusterclient "openpitrix.io/openpitrix/pkg/client/cluster"
+	runtimeclient "openpitrix.io/openpitrix/pkg/client/runtime"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/pb"
+	"openpitrix.io/openpitrix/pkg/util/ctxutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// ReleaseServer serves helm releases, revisions are read from the runtime directly,
+// while installing, upgrading, rolling back and deleting are sent to cluster manager
+// as jobs of the cluster of release.
+type ReleaseServer struct {
+}
+
+type clusterRelease struct {
+	ClusterId   string
+	RuntimeId   string
+	Namespace   string
+	ReleaseName string
+}
+
+func (s *ReleaseServer) Checker(ctx context.Context, req interface{}) error {
+	switch r := req.(type) {
+	case *pb.ListReleasesRequest:
+		return manager.NewChecker(ctx, r).
+			Required("runtime_id").
+			Exec()
+	case *pb.DescribeReleaseHistoryRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id").
+			Exec()
+	case *pb.DiffReleaseRevisionsRequest:
+		return manager.NewChecker(ctx, r).
+			Required("cluster_id", "from_version").
+			Exec()
+	case *pb.CreateReleaseRequest:
+		return manager.NewChecker(ctx, r).
+			Required("app_id", "version_id", "runtime_id", "release_name").
+			Exec()
+	case *pb.UpgradeReleaseRequest:
+		return manager.NewChecker(ctx, r).
+			Required("version_id").
+			Exec()
+	}
+	return nil
+}
+
+// getClusterRelease returns the release of cluster, permission of sender is checked by cluster manager
+func getClusterRelease(ctx context.Context, clusterId string) (*clusterRelease, error) {
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	pbClusters, err := clusterClient.GetClusters(ctx, []string{clusterId})
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, clusterId)
+	}
+	cluster := models.PbToCluster(pbClusters[0])
+
+	runtime, err := runtimeclient.NewRuntime(ctx, cluster.RuntimeId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorResourceNotFound, cluster.RuntimeId)
+	}
+	if runtime.Runtime.Provider != constants.ProviderKubernetes {
+		return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedRuntimeProvider, runtime.Runtime.Provider)
+	}
+
+	return &clusterRelease{
+		ClusterId:   clusterId,
+		RuntimeId:   cluster.RuntimeId,
+		Namespace:   getNamespace(runtime, cluster),
+		ReleaseName: cluster.Name,
+	}, nil
+}
+
+// getReleaseClusterId returns the cluster id of release, the release is found by
+// its name in runtime when cluster id is not set
+func getReleaseClusterId(ctx context.Context, clusterId, runtimeId, releaseName string) (string, error) {
+	if clusterId != "" {
+		return clusterId, nil
+	}
+	if runtimeId == "" || releaseName == "" {
+		return "", gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, "cluster_id")
+	}
+
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return "", gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	response, err := clusterClient.DescribeClusters(ctx, &pb.DescribeClustersRequest{
+		RuntimeId:  []string{runtimeId},
+		SearchWord: pbutil.ToProtoString(releaseName),
+	})
+	if err != nil {
+		return "", err
+	}
+	// release name is the name of cluster, search word matches the other columns as well
+	var clusterIds []string
+	for _, cluster := range response.ClusterSet {
+		if cluster.GetName().GetValue() == releaseName && cluster.GetStatus().GetValue() != constants.StatusCeased {
+			clusterIds = append(clusterIds, cluster.GetClusterId().GetValue())
+		}
+	}
+	switch len(clusterIds) {
+	case 0:
+		return "", gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, releaseName)
+	case 1:
+		return clusterIds[0], nil
+	default:
+		return "", gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorHelmReleaseAmbiguous, releaseName, runtimeId)
+	}
+}
+
+func (s *ReleaseServer) ListReleases(ctx context.Context, req *pb.ListReleasesRequest) (*pb.ListReleaseResponse, error) {
+	sender := ctxutil.GetSender(ctx)
+	ctx = ctxutil.ContextWithSender(ctx, sender)
+	runtimeId := req.GetRuntimeId().GetValue()
+	namespace := req.GetNamespace().GetValue()
+
+	runtime, err := runtimeclient.NewRuntime(ctx, runtimeId)
+	if err != nil || !runtime.Runtime.OwnerPath.CheckPermission(sender) {
+		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorResourceAccessDenied, runtimeId)
+	}
+
+	proxy := NewProxy(ctx, runtimeId)
+	cfg, err := proxy.GetHelmConfig(namespace)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	releases, err := ListReleases(cfg, req.GetReleaseName().GetValue(), namespace, req.GetStatus().GetValue())
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+
+	return &pb.ListReleaseResponse{
+		ReleaseSet: ReleasesToPbs(releases),
+	}, nil
+}
+
+func (s *ReleaseServer) DescribeReleaseHistory(ctx context.Context, req *pb.DescribeReleaseHistoryRequest) (*pb.DescribeReleaseHistoryResponse, error) {
+	ctx = ctxutil.ContextWithSender(ctx, ctxutil.GetSender(ctx))
+	clusterId := req.GetClusterId().GetValue()
+	rls, err := getClusterRelease(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := NewProxy(ctx, rls.RuntimeId)
+	cfg, err := proxy.GetHelmConfig(rls.Namespace)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourceFailed, clusterId)
+	}
+	revisions, err := proxy.ReleaseHistory(cfg, rls.ReleaseName)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourceFailed, clusterId)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Version < revisions[j].Version
+	})
+
+	return &pb.DescribeReleaseHistoryResponse{
+		ClusterId:  pbutil.ToProtoString(clusterId),
+		ReleaseSet: ReleasesToPbs(revisions),
+	}, nil
+}
+
+func (s *ReleaseServer) DiffReleaseRevisions(ctx context.Context, req *pb.DiffReleaseRevisionsRequest) (*pb.DiffReleaseRevisionsResponse, error) {
+	ctx = ctxutil.ContextWithSender(ctx, ctxutil.GetSender(ctx))
+	clusterId := req.GetClusterId().GetValue()
+	rls, err := getClusterRelease(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := NewProxy(ctx, rls.RuntimeId)
+	cfg, err := proxy.GetHelmConfig(rls.Namespace)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourceFailed, clusterId)
+	}
+
+	fromVersion := req.GetFromVersion().GetValue()
+	from, err := proxy.ReleaseRevision(cfg, rls.ReleaseName, fromVersion)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorHelmReleaseRevisionNotFound, rls.ReleaseName, fromVersion)
+	}
+	// diff to the current revision by default
+	toVersion := req.GetToVersion().GetValue()
+	to, err := proxy.ReleaseRevision(cfg, rls.ReleaseName, toVersion)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorHelmReleaseRevisionNotFound, rls.ReleaseName, toVersion)
+	}
+
+	valuesDiff, manifestDiff, err := diffReleases(from, to)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourceFailed, clusterId)
+	}
+
+	return &pb.DiffReleaseRevisionsResponse{
+		ClusterId:    pbutil.ToProtoString(clusterId),
+		FromVersion:  pbutil.ToProtoInt32(int32(from.Version)),
+		ToVersion:    pbutil.ToProtoInt32(int32(to.Version)),
+		ValuesDiff:   pbutil.ToProtoString(valuesDiff),
+		ManifestDiff: pbutil.ToProtoString(manifestDiff),
+	}, nil
+}
+
+func (s *ReleaseServer) CreateRelease(ctx context.Context, req *pb.CreateReleaseRequest) (*pb.CreateReleaseResponse, error) {
+	ctx = ctxutil.ContextWithSender(ctx, ctxutil.GetSender(ctx))
+
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	// release is named by the config [Name] of cluster
+	conf := jsonutil.ToString(map[string]string{"Name": req.GetReleaseName().GetValue()})
+	response, err := clusterClient.CreateCluster(ctx, &pb.CreateClusterRequest{
+		AppId:     req.GetAppId(),
+		VersionId: req.GetVersionId(),
+		RuntimeId: req.GetRuntimeId(),
+		Conf:      pbutil.ToProtoString(conf),
+		Zone:      req.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateReleaseResponse{
+		ReleaseName: req.GetReleaseName(),
+		ClusterId:   response.GetClusterId(),
+		JobId:       response.GetJobId(),
+	}, nil
+}
+
+func (s *ReleaseServer) UpgradeRelease(ctx context.Context, req *pb.UpgradeReleaseRequest) (*pb.UpgradeReleaseResponse, error) {
+	ctx = ctxutil.ContextWithSender(ctx, ctxutil.GetSender(ctx))
+	clusterId, err := getReleaseClusterId(ctx, req.GetClusterId().GetValue(),
+		req.GetRuntimeId().GetValue(), req.GetReleaseName().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	rls, err := getClusterRelease(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	response, err := clusterClient.UpgradeCluster(ctx, &pb.UpgradeClusterRequest{
+		ClusterId: pbutil.ToProtoString(clusterId),
+		VersionId: req.GetVersionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpgradeReleaseResponse{
+		ReleaseName: pbutil.ToProtoString(rls.ReleaseName),
+		ClusterId:   pbutil.ToProtoString(clusterId),
+		JobId:       response.GetJobId(),
+	}, nil
+}
+
+func (s *ReleaseServer) DeleteRelease(ctx context.Context, req *pb.DeleteReleaseRequest) (*pb.DeleteReleaseResponse, error) {
+	ctx = ctxutil.ContextWithSender(ctx, ctxutil.GetSender(ctx))
+	clusterId, err := getReleaseClusterId(ctx, req.GetClusterId().GetValue(),
+		req.GetRuntimeId().GetValue(), req.GetReleaseName().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	rls, err := getClusterRelease(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	// deleted cluster keeps the revision history of release, while ceased cluster purges it
+	var jobIds []string
+	if req.GetPurge().GetValue() {
+		response, err := clusterClient.CeaseClusters(ctx, &pb.CeaseClustersRequest{
+			ClusterId: []string{clusterId},
+			Force:     pbutil.ToProtoBool(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+		jobIds = response.GetJobId()
+	} else {
+		response, err := clusterClient.DeleteClusters(ctx, &pb.DeleteClustersRequest{
+			ClusterId: []string{clusterId},
+		})
+		if err != nil {
+			return nil, err
+		}
+		jobIds = response.GetJobId()
+	}
+
+	res := &pb.DeleteReleaseResponse{
+		ReleaseName: pbutil.ToProtoString(rls.ReleaseName),
+		ClusterId:   pbutil.ToProtoString(clusterId),
+	}
+	if len(jobIds) > 0 {
+		res.JobId = pbutil.ToProtoString(jobIds[0])
+	}
+	return res, nil
+}
+
+func (s *ReleaseServer) RollbackRelease(ctx context.Context, req *pb.RollbackReleaseRequest) (*pb.RollbackReleaseResponse, error) {
+	ctx = ctxutil.ContextWithSender(ctx, ctxutil.GetSender(ctx))
+	clusterId, err := getReleaseClusterId(ctx, req.GetClusterId().GetValue(),
+		req.GetRuntimeId().GetValue(), req.GetReleaseName().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	rls, err := getClusterRelease(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := NewProxy(ctx, rls.RuntimeId)
+	cfg, err := proxy.GetHelmConfig(rls.Namespace)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRollbackResourceFailed, clusterId)
+	}
+
+	// resolve the revision when the job is sent, so that the job rolls back to the same
+	// revision even if the release is changed before the job is executed
+	version := req.GetVersion().GetValue()
+	if version == 0 {
+		current, err := proxy.ReleaseRevision(cfg, rls.ReleaseName, 0)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorHelmReleaseRevisionNotFound, rls.ReleaseName, version)
+		}
+		version = int32(current.Version) - 1
+	}
+	_, err = proxy.ReleaseRevision(cfg, rls.ReleaseName, version)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.NotFound, err, gerr.ErrorHelmReleaseRevisionNotFound, rls.ReleaseName, version)
+	}
+
+	clusterClient, err := clusterclient.NewClient()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+	response, err := clusterClient.RollbackCluster(ctx, &pb.RollbackClusterRequest{
+		ClusterId:      pbutil.ToProtoString(clusterId),
+		ReleaseVersion: pbutil.ToProtoInt32(version),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RollbackReleaseResponse{
+		ReleaseName: pbutil.ToProtoString(rls.ReleaseName),
+		Version:     pbutil.ToProtoInt32(version),
+		ClusterId:   pbutil.ToProtoString(clusterId),
+		JobId:       response.GetJobId(),
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package helm
+
+import (
+	"strings"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestDiffReleases(t *testing.T) {
+	from := &release.Release{
+		Name:     "wordpress",
+		Version:  1,
+		Config:   map[string]interface{}{"replicas": 1, "image": "wordpress:5.2"},
+		Manifest: "kind: Deployment\nreplicas: 1\n",
+	}
+	to := &release.Release{
+		Name:     "wordpress",
+		Version:  3,
+		Config:   map[string]interface{}{"replicas": 2, "image": "wordpress:5.2"},
+		Manifest: "kind: Deployment\nreplicas: 2\n",
+	}
+
+	valuesDiff, manifestDiff, err := diffReleases(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(valuesDiff, "--- wordpress revision 1\n+++ wordpress revision 3\n") {
+		t.Errorf("Wrong header of values diff [%s]", valuesDiff)
+	}
+	if !strings.Contains(valuesDiff, "-replicas: 1\n") || !strings.Contains(valuesDiff, "+replicas: 2\n") {
+		t.Errorf("Wrong values diff [%s]", valuesDiff)
+	}
+	if strings.Contains(valuesDiff, "-image") {
+		t.Errorf("Unchanged values should not be diffed [%s]", valuesDiff)
+	}
+	if !strings.Contains(manifestDiff, "-replicas: 1\n") || !strings.Contains(manifestDiff, "+replicas: 2\n") {
+		t.Errorf("Wrong manifest diff [%s]", manifestDiff)
+	}
+
+	valuesDiff, manifestDiff, err = diffReleases(from, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valuesDiff != "" || manifestDiff != "" {
+		t.Errorf("Diffs of same revision should be empty, got [%s] [%s]", valuesDiff, manifestDiff)
+	}
+}
@@ -23,6 +23,14 @@ func Serve(cfg *config.Config) {
 	if err != nil {
 		logger.Critical(nil, "failed to register provider config: %+v", err)
 	}
+	rs := ReleaseServer{}
+	go manager.NewGrpcServer("release-manager", constants.ReleaseManagerPort).
+		ShowErrorCause(cfg.Grpc.ShowErrorCause).
+		WithChecker(rs.Checker).
+		Serve(func(server *grpc.Server) {
+			pb.RegisterReleaseManagerServer(server, &rs)
+		})
+
 	s := Server{}
 	manager.NewGrpcServer("openpitrix-rp-kubernetes", constants.KubernetesProviderPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
@@ -0,0 +1,26 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package job
+
+import (
+	"context"
+
+	"openpitrix.io/openpitrix/pkg/manager"
+	"openpitrix.io/openpitrix/pkg/pb"
+)
+
+func (p *Server) Checker(ctx context.Context, req interface{}) error {
+	switch r := req.(type) {
+	case *pb.CancelJobRequest:
+		return manager.NewChecker(ctx, r).
+			Required("job_id").
+			Exec()
+	case *pb.WatchJobRequest:
+		return manager.NewChecker(ctx, r).
+			Required("job_id").
+			Exec()
+	}
+	return nil
+}
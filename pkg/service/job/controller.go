@@ -6,6 +6,7 @@ package job
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -20,23 +21,34 @@ import (
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/topic"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/semaphoreutil"
+	"openpitrix.io/openpitrix/pkg/util/stringutil"
 )
 
 type Controller struct {
-	runningJobs  chan string
-	runningCount int32
-	hostname     string
-	queue        *etcd.Queue
+	runningJobs chan string
+	// slots of working jobs in this job manager, resized with MaxWorkingJobs
+	workers    *semaphoreutil.Weighted
+	hostname   string
+	queue      *etcd.Queue
+	lease      *etcd.Lease
+	leaseMutex sync.RWMutex
 }
 
+var errLeaseExpired = fmt.Errorf("executor lease expired, job will be resumed by others")
+var errJobCancelled = fmt.Errorf("job cancelled")
+var errJobTasksFailed = fmt.Errorf("tasks of job failed")
+
 func NewController(hostname string) *Controller {
 	return &Controller{
-		runningJobs:  make(chan string),
-		runningCount: 0,
-		hostname:     hostname,
-		queue:        pi.Global().Etcd(nil).NewQueue("job"),
+		runningJobs: make(chan string),
+		workers:     semaphoreutil.NewWeighted(constants.DefaultMaxWorkingJobs),
+		hostname:    hostname,
+		queue:       pi.Global().Etcd(nil).NewQueue("job"),
 	}
 }
 
@@ -49,7 +61,68 @@ func (c *Controller) updateJobAttributes(ctx context.Context, jobId string, attr
 	return err
 }
 
-var mutex sync.Mutex
+func (c *Controller) saveCheckpoint(ctx context.Context, lease *etcd.Lease, jobId string, checkpoint *models.JobCheckpoint) error {
+	if lease.IsExpired() {
+		return errLeaseExpired
+	}
+	err := c.updateJobAttributes(ctx, jobId, map[string]interface{}{
+		constants.ColumnCheckpoint: jsonutil.ToString(checkpoint),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to save checkpoint of job [%s]: %+v", jobId, err)
+	}
+	return err
+}
+
+// pushJobEvent notifies the watchers of job, failure only affects the watchers
+func (c *Controller) pushJobEvent(ctx context.Context, event *models.JobEvent) {
+	topic.PushJobEvent(ctx, pi.Global().Etcd(ctx), event)
+}
+
+func (c *Controller) isJobCancelled(ctx context.Context, jobId string) bool {
+	count, err := pi.Global().DB(ctx).
+		Select(constants.ColumnJobId).
+		From(constants.TableJob).
+		Where(db.Eq(constants.ColumnJobId, jobId)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusCancelled)).
+		Count()
+	if err != nil {
+		logger.Error(ctx, "Failed to get status of job [%s]: %+v", jobId, err)
+		return false
+	}
+	return count > 0
+}
+
+func (c *Controller) getLease() *etcd.Lease {
+	c.leaseMutex.RLock()
+	defer c.leaseMutex.RUnlock()
+	return c.lease
+}
+
+func (c *Controller) newLease(ctx context.Context) *etcd.Lease {
+	for {
+		lease, err := pi.Global().Etcd(ctx).NewLease(constants.ExecutorLeaseTTL)
+		if err != nil {
+			logger.Error(ctx, "Failed to create executor lease: %+v", err)
+			time.Sleep(constants.ExecutorLeaseRetryInterval)
+			continue
+		}
+		c.leaseMutex.Lock()
+		c.lease = lease
+		c.leaseMutex.Unlock()
+		return lease
+	}
+}
+
+// KeepLease renews the executor lease when it expired, jobs held by the expired
+// lease will stop at next checkpoint and be taken over by RecoverWorkingJobs
+func (c *Controller) KeepLease(ctx context.Context, lease *etcd.Lease) {
+	for {
+		<-lease.Done()
+		logger.Critical(ctx, "Executor lease [%d] expired, renew it", lease.Lease())
+		lease = c.newLease(ctx)
+	}
+}
 
 func (c *Controller) GetJobLength() int32 {
 	if pi.Global().GlobalConfig().Job.MaxWorkingJobs > 0 {
@@ -59,32 +132,155 @@ func (c *Controller) GetJobLength() int32 {
 	}
 }
 
-func (c *Controller) IsRunningExceed() bool {
-	mutex.Lock()
-	defer mutex.Unlock()
-	count := c.runningCount
-	return count > c.GetJobLength()
+// acquireWorker blocks until there is a free slot for working job
+func (c *Controller) acquireWorker(ctx context.Context) error {
+	c.workers.Resize(int64(c.GetJobLength()))
+	return c.workers.Acquire(ctx, 1)
 }
 
-func (c *Controller) UpdateWorkingJobs(ctx context.Context) error {
-	//TODO: retry the job
-	_, err := pi.Global().DB(ctx).
-		Update(constants.TableJob).
-		SetMap(map[string]interface{}{constants.ColumnStatus: constants.StatusFailed}).
-		Where(db.Eq(constants.ColumnStatus, constants.StatusWorking)).
-		Exec()
-	return err
+func (c *Controller) releaseWorker() {
+	c.workers.Release(1)
+}
+
+// getSemaphores returns the semaphores limiting working jobs of the runtime and the owner
+func (c *Controller) getSemaphores(job *models.Job) []*etcd.Semaphore {
+	jobConfig := pi.Global().GlobalConfig().Job
+	var semaphores []*etcd.Semaphore
+	if jobConfig.MaxWorkingJobsPerRuntime > 0 && job.RuntimeId != "" {
+		semaphores = append(semaphores, pi.Global().Etcd(nil).
+			NewSemaphore(constants.JobRuntimeSemaphorePrefix+job.RuntimeId, jobConfig.MaxWorkingJobsPerRuntime))
+	}
+	if jobConfig.MaxWorkingJobsPerOwner > 0 && job.Owner != "" {
+		semaphores = append(semaphores, pi.Global().Etcd(nil).
+			NewSemaphore(constants.JobOwnerSemaphorePrefix+job.Owner, jobConfig.MaxWorkingJobsPerOwner))
+	}
+	return semaphores
+}
+
+func (c *Controller) enqueueJob(job *models.Job) error {
+	return c.queue.EnqueueWithPriority(job.JobId, uint16(job.Priority))
+}
+
+// requeueJob puts the job back to the queue, the worker is held for a while,
+// so that jobs exceeding the limits do not keep the workers busy
+func (c *Controller) requeueJob(ctx context.Context, job *models.Job) {
+	err := c.enqueueJob(job)
+	if err != nil {
+		logger.Critical(ctx, "Failed to requeue job [%s]: %+v", job.JobId, err)
+		return
+	}
+	time.Sleep(constants.ConcurrencyLimitRetryInterval)
+}
+
+// DispatchScheduledJobs enqueues scheduled jobs when their scheduled time is reached
+func (c *Controller) DispatchScheduledJobs(ctx context.Context) {
+	for {
+		var jobs []*models.Job
+		_, err := pi.Global().DB(ctx).
+			Select(models.JobColumns...).
+			From(constants.TableJob).
+			Where(db.Eq(constants.ColumnStatus, constants.StatusScheduled)).
+			Where(db.Lte(constants.ColumnScheduledTime, time.Now())).
+			Load(&jobs)
+		if err != nil {
+			logger.Error(ctx, "Failed to get scheduled jobs: %+v", err)
+		}
+		for _, job := range jobs {
+			c.dispatchScheduledJob(ctx, job)
+		}
+		time.Sleep(constants.DispatchScheduledJobsInterval)
+	}
+}
+
+// dispatchScheduledJob claims the job by changing its status to pending,
+// so that the job is enqueued by only one job manager
+func (c *Controller) dispatchScheduledJob(ctx context.Context, job *models.Job) {
+	setStatus := func(from, to string) (bool, error) {
+		result, err := pi.Global().DB(ctx).
+			Update(constants.TableJob).
+			SetMap(map[string]interface{}{
+				constants.ColumnStatus:     to,
+				constants.ColumnStatusTime: time.Now(),
+			}).
+			Where(db.Eq(constants.ColumnJobId, job.JobId)).
+			Where(db.Eq(constants.ColumnStatus, from)).
+			Exec()
+		if err != nil {
+			return false, err
+		}
+		count, err := result.RowsAffected()
+		return count > 0, err
+	}
+
+	claimed, err := setStatus(constants.StatusScheduled, constants.StatusPending)
+	if err != nil {
+		logger.Error(ctx, "Failed to claim scheduled job [%s]: %+v", job.JobId, err)
+		return
+	}
+	// dispatched by others or cancelled
+	if !claimed {
+		return
+	}
+	err = c.enqueueJob(job)
+	if err != nil {
+		logger.Error(ctx, "Failed to enqueue scheduled job [%s]: %+v", job.JobId, err)
+		// try again in the next round
+		_, err = setStatus(constants.StatusPending, constants.StatusScheduled)
+		if err != nil {
+			logger.Critical(ctx, "Failed to reset status of scheduled job [%s]: %+v", job.JobId, err)
+		}
+		return
+	}
+	logger.Info(ctx, "Scheduled job [%s] dispatched", job.JobId)
+}
+
+// RecoverWorkingJobs resumes working jobs whose executor lease is gone,
+// the executor may exit or lose connection with etcd
+func (c *Controller) RecoverWorkingJobs(ctx context.Context) {
+	for {
+		var jobIds []string
+		_, err := pi.Global().DB(ctx).
+			Select(constants.ColumnJobId).
+			From(constants.TableJob).
+			Where(db.Eq(constants.ColumnStatus, constants.StatusWorking)).
+			Load(&jobIds)
+		if err != nil {
+			logger.Error(ctx, "Failed to get working jobs: %+v", err)
+		}
+		for _, jobId := range jobIds {
+			leased, err := pi.Global().Etcd(ctx).IsLeased(ctx, constants.JobExecutorPrefix+jobId)
+			if err != nil {
+				logger.Error(ctx, "Failed to get lease of job [%s]: %+v", jobId, err)
+				continue
+			}
+			if leased {
+				continue
+			}
+			err = c.acquireWorker(ctx)
+			if err != nil {
+				logger.Error(ctx, "Failed to acquire worker: %+v", err)
+				return
+			}
+			logger.Info(ctx, "Job [%s] has no executor, resume it", jobId)
+			c.runningJobs <- jobId
+		}
+		time.Sleep(constants.RecoverWorkingInterval)
+	}
 }
 
 func (c *Controller) ExtractJobs(ctx context.Context) {
 	for {
-		if c.IsRunningExceed() {
-			logger.Error(ctx, "Sleep 10s, running job count exceed [%d/%d]", c.runningCount, c.GetJobLength())
-			time.Sleep(10 * time.Second)
-			continue
+		err := c.acquireWorker(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to acquire worker: %+v", err)
+			return
 		}
-		jobId, err := c.queue.Dequeue()
+		jobId, err := c.queue.DequeueWithContext(ctx)
 		if err != nil {
+			c.releaseWorker()
+			if ctx.Err() != nil {
+				return
+			}
 			logger.Error(ctx, "Failed to dequeue job from etcd queue: %+v", err)
 			time.Sleep(3 * time.Second)
 			continue
@@ -94,37 +290,80 @@ func (c *Controller) ExtractJobs(ctx context.Context) {
 	}
 }
 
+// adoptSentTask finds the task created in task manager but not recorded by the
+// checkpoint, the job manager may exit between sending the task and saving the checkpoint
+func adoptSentTask(task *models.Task, unrecordedTasks []*models.Task) (string, []*models.Task) {
+	for i, unrecordedTask := range unrecordedTasks {
+		if unrecordedTask.TaskAction == task.TaskAction &&
+			unrecordedTask.NodeId == task.NodeId &&
+			unrecordedTask.Target == task.Target {
+			return unrecordedTask.TaskId, append(unrecordedTasks[:i], unrecordedTasks[i+1:]...)
+		}
+	}
+	return "", unrecordedTasks
+}
+
 func (c *Controller) HandleJob(ctx context.Context, jobId string, cb func()) error {
 	ctx = ctxutil.AddMessageId(ctx, jobId)
 
 	defer cb()
 
-	job := &models.Job{
-		JobId:  jobId,
-		Status: constants.StatusWorking,
+	lease := c.getLease()
+	leaseKey := constants.JobExecutorPrefix + jobId
+	acquired, err := lease.Acquire(ctx, leaseKey, c.hostname)
+	if err != nil {
+		logger.Error(ctx, "Failed to acquire lease of job: %+v", err)
+		return err
+	}
+	if !acquired {
+		logger.Warn(ctx, "Job [%s] is held by another executor", jobId)
+		return nil
+	}
+	defer lease.Release(ctx, leaseKey)
+
+	job := &models.Job{}
+	err = pi.Global().DB(ctx).
+		Select(models.JobColumns...).
+		From(constants.TableJob).
+		Where(db.Eq(constants.ColumnJobId, jobId)).
+		LoadOne(&job)
+	if err != nil {
+		logger.Error(ctx, "Failed to get job: %+v", err)
+		return err
+	}
+	if job.Status != constants.StatusPending && job.Status != constants.StatusWorking {
+		logger.Warn(ctx, "Job [%s] is already [%s]", jobId, job.Status)
+		return nil
+	}
+
+	semaphores := c.getSemaphores(job)
+	acquired, err = etcd.AcquireSemaphores(ctx, lease, jobId, semaphores...)
+	if err != nil {
+		logger.Error(ctx, "Failed to acquire semaphores of job: %+v", err)
+		c.requeueJob(ctx, job)
+		return err
+	}
+	if !acquired {
+		logger.Warn(ctx, "Working jobs of runtime [%s] or owner [%s] exceed the limit, requeue job [%s]",
+			job.RuntimeId, job.Owner, jobId)
+		c.requeueJob(ctx, job)
+		return nil
 	}
+	defer etcd.ReleaseSemaphores(ctx, jobId, semaphores...)
 
-	err := c.updateJobAttributes(ctx, job.JobId, map[string]interface{}{
+	job.Status = constants.StatusWorking
+	job.Executor = c.hostname
+	err = c.updateJobAttributes(ctx, job.JobId, map[string]interface{}{
 		constants.ColumnStatus:   job.Status,
-		constants.ColumnExecutor: c.hostname,
+		constants.ColumnExecutor: job.Executor,
 	})
 	if err != nil {
 		logger.Error(ctx, "Failed to update job: %+v", err)
 		return err
 	}
+	c.pushJobEvent(ctx, models.NewJobStatusEvent(job))
 
-	err = func() error {
-		query := pi.Global().DB(ctx).
-			Select(models.JobColumns...).
-			From(constants.TableJob).
-			Where(db.Eq(constants.ColumnJobId, jobId))
-
-		err := query.LoadOne(&job)
-		if err != nil {
-			logger.Error(ctx, "Failed to get job: %+v", err)
-			return err
-		}
-
+	err = func() (err error) {
 		ctx = ctxutil.ContextWithSender(ctx, sender.New(job.Owner, job.OwnerPath, ""))
 
 		processor := NewProcessor(job)
@@ -132,21 +371,12 @@ func (c *Controller) HandleJob(ctx context.Context, jobId string, cb func()) err
 		if err != nil {
 			return err
 		}
-		defer processor.Final(ctx)
-
-		providerClient, err := providerclient.NewRuntimeProviderManagerClient()
-		if err != nil {
-			return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
-		}
-		response, err := providerClient.SplitJobIntoTasks(ctx, &pb.SplitJobIntoTasksRequest{
-			RuntimeId: pbutil.ToProtoString(job.RuntimeId),
-			Job:       models.JobToPb(job),
-		})
-		if err != nil {
-			logger.Error(ctx, "Failed to split job into tasks with provider [%s]: %+v", job.Provider, err)
-			return err
-		}
-		module := models.PbToTaskLayer(response.TaskLayer)
+		defer func() {
+			// the job will be resumed by another executor, keep the transition status
+			if err != errLeaseExpired {
+				processor.Final(ctx)
+			}
+		}()
 
 		taskClient, err := taskclient.NewClient()
 		if err != nil {
@@ -154,45 +384,138 @@ func (c *Controller) HandleJob(ctx context.Context, jobId string, cb func()) err
 			return err
 		}
 
+		var checkpoint *models.JobCheckpoint
+		var unrecordedTasks []*models.Task
+		if job.Checkpoint != "" {
+			checkpoint, err = models.NewJobCheckpoint(job.Checkpoint)
+			if err != nil {
+				return err
+			}
+			logger.Info(ctx, "Resume job [%s] from task layer [%d]", jobId, checkpoint.FinishedLayers)
+
+			tasks, err := taskClient.GetJobTasks(ctx, jobId)
+			if err != nil {
+				return err
+			}
+			sentTaskIds := checkpoint.SentTaskIds()
+			for _, task := range tasks {
+				if !stringutil.StringIn(task.TaskId, sentTaskIds) {
+					unrecordedTasks = append(unrecordedTasks, task)
+				}
+			}
+		} else {
+			providerClient, err := providerclient.NewRuntimeProviderManagerClient()
+			if err != nil {
+				return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+			}
+			response, err := providerClient.SplitJobIntoTasks(ctx, &pb.SplitJobIntoTasksRequest{
+				RuntimeId: pbutil.ToProtoString(job.RuntimeId),
+				Job:       models.JobToPb(job),
+			})
+			if err != nil {
+				logger.Error(ctx, "Failed to split job into tasks with provider [%s]: %+v", job.Provider, err)
+				return err
+			}
+			checkpoint = &models.JobCheckpoint{
+				TaskLayer: models.PbToTaskLayer(response.TaskLayer),
+			}
+			err = c.saveCheckpoint(ctx, lease, jobId, checkpoint)
+			if err != nil {
+				return err
+			}
+		}
+
+		waitTasks := func(tasks []*models.Task) bool {
+			successful := true
+			for _, task := range tasks {
+				err := taskClient.WaitTask(ctx, task.TaskId, task.GetTimeout(constants.MaxTaskTimeout), constants.WaitTaskInterval)
+				if err != nil {
+					logger.Error(ctx, "Failed to wait task [%s]: %+v", task.TaskId, err)
+					if !task.FailureAllowed {
+						successful = false
+					}
+				}
+			}
+			return successful
+		}
+
 		successful := true
-		module.WalkTree(func(parent *models.TaskLayer, current *models.TaskLayer) {
-			if parent != nil {
-				for _, parentTask := range parent.Tasks {
-					err = taskClient.WaitTask(ctx, parentTask.TaskId, parentTask.GetTimeout(constants.MaxTaskTimeout), constants.WaitTaskInterval)
+		layerIndex := -1
+		checkpoint.TaskLayer.WalkTree(func(parent *models.TaskLayer, current *models.TaskLayer) {
+			layerIndex++
+			if err == errLeaseExpired || err == errJobCancelled {
+				return
+			}
+
+			if parent != nil && !checkpoint.IsLayerFinished(layerIndex-1) {
+				if !waitTasks(parent.Tasks) {
+					successful = false
+				} else if successful {
+					checkpoint.FinishLayer(layerIndex - 1)
+					err = c.saveCheckpoint(ctx, lease, jobId, checkpoint)
 					if err != nil {
-						logger.Error(ctx, "Failed to wait task [%s]: %+v", parentTask.TaskId, err)
-						if !parentTask.FailureAllowed {
-							successful = false
-						}
+						successful = false
 					}
 				}
 			}
 
-			if current != nil {
+			if current != nil && !checkpoint.IsLayerFinished(layerIndex) {
+				// stop dispatching the remaining task layers
+				if c.isJobCancelled(ctx, jobId) {
+					err = errJobCancelled
+					return
+				}
+				c.pushJobEvent(ctx, models.NewTaskLayerEvent(jobId, layerIndex))
 				for _, currentTask := range current.Tasks {
-					if !successful {
-						currentTask.Status = constants.StatusFailed
+					if err == errLeaseExpired {
+						return
 					}
-					currentTask.TaskId, err = taskClient.SendTask(ctx, currentTask)
+					// task has been sent before job manager exited, wait for it again
+					if checkpoint.IsTaskSent(currentTask) {
+						continue
+					}
+					currentTask.TaskId, unrecordedTasks = adoptSentTask(currentTask, unrecordedTasks)
+					if currentTask.TaskId == "" {
+						if !successful {
+							currentTask.Status = constants.StatusFailed
+						}
+						currentTask.Priority = job.Priority
+						currentTask.TaskId, err = taskClient.SendTask(ctx, currentTask)
+						if err != nil {
+							logger.Error(ctx, "Failed to send task [%s]: %+v", currentTask.TaskId, err)
+							successful = false
+							continue
+						}
+					}
+					err = c.saveCheckpoint(ctx, lease, jobId, checkpoint)
 					if err != nil {
-						logger.Error(ctx, "Failed to send task [%s]: %+v", currentTask.TaskId, err)
 						successful = false
 					}
 				}
 				if current.IsLeaf() {
-					for _, currentTask := range current.Tasks {
-						err = taskClient.WaitTask(ctx, currentTask.TaskId, currentTask.GetTimeout(constants.MaxTaskTimeout), constants.WaitTaskInterval)
+					if !waitTasks(current.Tasks) {
+						successful = false
+					} else if successful {
+						checkpoint.FinishLayer(layerIndex)
+						err = c.saveCheckpoint(ctx, lease, jobId, checkpoint)
 						if err != nil {
-							logger.Error(ctx, "Failed to wait task [%s]: %+v", currentTask.TaskId, err)
-							if !currentTask.FailureAllowed {
-								successful = false
-							}
+							successful = false
 						}
 					}
 				}
 			}
 		})
+		if err == errLeaseExpired || err == errJobCancelled {
+			return err
+		}
 		if !successful {
+			if c.isJobCancelled(ctx, jobId) {
+				return errJobCancelled
+			}
+			// err may be reset by the checkpoints saved after the failure
+			if err == nil {
+				err = errJobTasksFailed
+			}
 			return err
 		}
 
@@ -200,41 +523,46 @@ func (c *Controller) HandleJob(ctx context.Context, jobId string, cb func()) err
 		return processor.Post(ctx)
 	}()
 
+	if err == errLeaseExpired {
+		logger.Critical(ctx, "Job [%s] stopped: %+v", jobId, err)
+		return err
+	}
+
 	var status = constants.StatusSuccessful
-	if err != nil {
+	if err == errJobCancelled {
+		logger.Warn(ctx, "Job [%s] cancelled", jobId)
+		status = constants.StatusCancelled
+	} else if err != nil {
 		logger.Error(ctx, "Job [%s] failed: %+v", jobId, err)
 		status = constants.StatusFailed
+		c.pushJobEvent(ctx, models.NewJobErrorEvent(jobId, err))
 	}
 
+	job.Status = status
+	job.StatusTime = time.Now()
 	err = c.updateJobAttributes(ctx, jobId, map[string]interface{}{
-		constants.ColumnStatus:     status,
-		constants.ColumnStatusTime: time.Now(),
+		constants.ColumnStatus:     job.Status,
+		constants.ColumnStatusTime: job.StatusTime,
 	})
 	if err != nil {
 		logger.Error(ctx, "Failed to update job: %+v", err)
 	}
+	c.pushJobEvent(ctx, models.NewJobStatusEvent(job))
 
 	return err
 }
 
 func (c *Controller) HandleJobs(ctx context.Context) {
 	for jobId := range c.runningJobs {
-		mutex.Lock()
-		c.runningCount++
-		mutex.Unlock()
-		go c.HandleJob(ctx, jobId, func() {
-			mutex.Lock()
-			c.runningCount--
-			mutex.Unlock()
-		})
+		go c.HandleJob(ctx, jobId, c.releaseWorker)
 	}
 }
 
 func (c *Controller) Serve(ctx context.Context) {
-	err := c.UpdateWorkingJobs(ctx)
-	if err != nil {
-		logger.Error(ctx, "Failed to update working jobs: %+v", err)
-	}
+	lease := c.newLease(ctx)
+	go c.KeepLease(ctx, lease)
 	go c.ExtractJobs(ctx)
 	go c.HandleJobs(ctx)
+	go c.RecoverWorkingJobs(ctx)
+	go c.DispatchScheduledJobs(ctx)
 }
@@ -6,13 +6,19 @@ package job
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	taskclient "openpitrix.io/openpitrix/pkg/client/task"
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
+	"openpitrix.io/openpitrix/pkg/topic"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
 )
@@ -30,6 +36,21 @@ func (p *Server) CreateJob(ctx context.Context, req *pb.CreateJobRequest) (*pb.C
 		s.GetOwnerPath(),
 		req.GetRuntimeId().GetValue(),
 	)
+	if req.GetPriority() != nil {
+		priority := req.GetPriority().GetValue()
+		if priority > constants.MaxJobPriority {
+			return nil, gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "priority", fmt.Sprint(priority))
+		}
+		newJob.Priority = priority
+	}
+	if req.GetScheduledTime() != nil {
+		scheduledTime := pbutil.GetTime(req.GetScheduledTime())
+		newJob.ScheduledTime = &scheduledTime
+		// job will be dispatched by controller at the scheduled time
+		if scheduledTime.After(time.Now()) {
+			newJob.Status = constants.StatusScheduled
+		}
+	}
 
 	_, err := pi.Global().DB(ctx).
 		InsertInto(constants.TableJob).
@@ -39,9 +60,11 @@ func (p *Server) CreateJob(ctx context.Context, req *pb.CreateJobRequest) (*pb.C
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
 	}
 
-	err = p.controller.queue.Enqueue(newJob.JobId)
-	if err != nil {
-		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	if newJob.Status == constants.StatusPending {
+		err = p.controller.enqueueJob(newJob)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+		}
 	}
 
 	res := &pb.CreateJobResponse{
@@ -66,7 +89,10 @@ func (p *Server) DescribeJobs(ctx context.Context, req *pb.DescribeJobsRequest)
 		Limit(limit).
 		Where(manager.BuildPermissionFilter(ctx)).
 		Where(manager.BuildFilterConditions(req, constants.TableJob))
-	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
+	query, cursor, err := manager.AddQueryCursor(query, req, constants.ColumnCreateTime, constants.ColumnJobId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalPageToken)
+	}
 	if len(displayColumns) > 0 {
 		_, err := query.Load(&jobs)
 		if err != nil {
@@ -77,10 +103,213 @@ func (p *Server) DescribeJobs(ctx context.Context, req *pb.DescribeJobsRequest)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 	}
+	nextPageToken, err := cursor.NextPageToken(jobs, limit)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
 
 	res := &pb.DescribeJobsResponse{
-		JobSet:     models.JobsToPbs(jobs),
-		TotalCount: uint32(count),
+		JobSet:        models.JobsToPbs(jobs),
+		TotalCount:    uint32(count),
+		NextPageToken: pbutil.ToProtoString(nextPageToken),
 	}
 	return res, nil
 }
+
+// ExportJobs streams all the jobs by pages of the max size, following the page token of previous page
+func (p *Server) ExportJobs(req *pb.DescribeJobsRequest, srv pb.JobManager_ExportJobsServer) error {
+	ctx := srv.Context()
+	req.Limit = db.DefaultSelectLimit
+	return db.ExportPages(req.GetPageToken().GetValue(),
+		func(pageToken string) (interface{}, string, error) {
+			req.PageToken = pbutil.ToProtoString(pageToken)
+			res, err := p.DescribeJobs(ctx, req)
+			return res, res.GetNextPageToken().GetValue(), err
+		},
+		func(page interface{}) error {
+			return srv.Send(page.(*pb.DescribeJobsResponse))
+		},
+	)
+}
+
+func (p *Server) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
+	jobId := req.GetJobId().GetValue()
+	job, err := CheckJobPermission(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != constants.StatusPending && job.Status != constants.StatusWorking &&
+		job.Status != constants.StatusScheduled {
+		return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorJobIncorrectStatus, jobId, job.Status)
+	}
+
+	// pending and scheduled jobs will be skipped by controller, roll back the cluster here
+	cancelled, err := cancelJobInStatus(ctx, jobId, constants.StatusPending, constants.StatusScheduled)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCancelJobFailed, jobId)
+	}
+	if cancelled {
+		NewProcessor(job).Final(ctx)
+		job.Status = constants.StatusCancelled
+		job.StatusTime = time.Now()
+		p.controller.pushJobEvent(ctx, models.NewJobStatusEvent(job))
+	} else {
+		// controller stops dispatching task layers once it finds the job cancelled
+		cancelled, err = cancelJobInStatus(ctx, jobId, constants.StatusWorking)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCancelJobFailed, jobId)
+		}
+		if !cancelled {
+			// the job has been finished or cancelled since it was checked
+			job, err = CheckJobPermission(ctx, jobId)
+			if err != nil {
+				return nil, err
+			}
+			return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorJobIncorrectStatus, jobId, job.Status)
+		}
+		err = cancelJobTasks(ctx, jobId)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCancelJobFailed, jobId)
+		}
+	}
+
+	res := &pb.CancelJobResponse{
+		JobId: pbutil.ToProtoString(jobId),
+	}
+	return res, nil
+}
+
+// cancelJobInStatus cancels the job only when it is in one of the statuses,
+// it returns false when the status of job has been changed by others
+func cancelJobInStatus(ctx context.Context, jobId string, statuses ...string) (bool, error) {
+	result, err := pi.Global().DB(ctx).
+		Update(constants.TableJob).
+		SetMap(map[string]interface{}{
+			constants.ColumnStatus:     constants.StatusCancelled,
+			constants.ColumnStatusTime: time.Now(),
+		}).
+		Where(db.Eq(constants.ColumnJobId, jobId)).
+		Where(db.Eq(constants.ColumnStatus, statuses)).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func cancelJobTasks(ctx context.Context, jobId string) error {
+	taskClient, err := taskclient.NewClient()
+	if err != nil {
+		return err
+	}
+	tasks, err := taskClient.GetJobTasks(ctx, jobId)
+	if err != nil {
+		return err
+	}
+	var taskIds []string
+	for _, task := range tasks {
+		if task.Status == constants.StatusPending || task.Status == constants.StatusWorking {
+			taskIds = append(taskIds, task.TaskId)
+		}
+	}
+	if len(taskIds) == 0 {
+		return nil
+	}
+	_, err = taskClient.CancelTasks(ctx, &pb.CancelTasksRequest{
+		TaskId: taskIds,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to cancel tasks %v of job [%s]: %+v", taskIds, jobId, err)
+	}
+	return err
+}
+
+func (p *Server) WatchJob(req *pb.WatchJobRequest, srv pb.JobManager_WatchJobServer) error {
+	ctx := srv.Context()
+	jobId := req.GetJobId().GetValue()
+	e := pi.Global().Etcd(ctx)
+
+	// take the snapshot after getting the revision, so that no event is missed
+	revision, err := topic.GetJobEventRevision(ctx, e, jobId)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorWatchJobFailed, jobId)
+	}
+	job, err := CheckJobPermission(ctx, jobId)
+	if err != nil {
+		return err
+	}
+	snapshot, err := getJobSnapshot(ctx, job)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorWatchJobFailed, jobId)
+	}
+	err = srv.Send(models.JobEventToPb(snapshot))
+	if err != nil {
+		return err
+	}
+	if snapshot.IsFinished() {
+		return nil
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for event := range topic.WatchJobEvents(watchCtx, e, jobId, revision) {
+		err = srv.Send(models.JobEventToPb(event))
+		if err != nil {
+			return err
+		}
+		if event.IsFinished() {
+			return nil
+		}
+	}
+	if ctx.Err() != nil {
+		// watcher is gone
+		return nil
+	}
+	return gerr.New(ctx, gerr.Internal, gerr.ErrorWatchJobFailed, jobId)
+}
+
+// getJobSnapshot returns the job with its task layers, tasks in the task layers
+// are replaced with the sent tasks to show the latest status
+func getJobSnapshot(ctx context.Context, job *models.Job) (*models.JobEvent, error) {
+	snapshot := &models.JobEvent{
+		EventType: constants.JobEventSnapshot,
+		JobId:     job.JobId,
+		Job:       job,
+		EventTime: time.Now(),
+	}
+	if job.Checkpoint == "" {
+		return snapshot, nil
+	}
+	checkpoint, err := models.NewJobCheckpoint(job.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	taskClient, err := taskclient.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := taskClient.GetJobTasks(ctx, job.JobId)
+	if err != nil {
+		return nil, err
+	}
+	taskMap := make(map[string]*models.Task)
+	for _, task := range tasks {
+		taskMap[task.TaskId] = task
+	}
+	checkpoint.TaskLayer.WalkTree(func(parent *models.TaskLayer, current *models.TaskLayer) {
+		if current == nil {
+			return
+		}
+		for i, task := range current.Tasks {
+			if sentTask, ok := taskMap[task.TaskId]; ok {
+				current.Tasks[i] = sentTask
+			}
+		}
+	})
+	snapshot.TaskLayer = checkpoint.TaskLayer
+	return snapshot, nil
+}
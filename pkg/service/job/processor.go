@@ -64,6 +64,12 @@ func (p *Processor) Pre(ctx context.Context) error {
 		err = clusterClient.ModifyClusterTransitionStatus(ctx, p.Job.ClusterId, constants.StatusCeasing)
 	case constants.ActionUpdateClusterEnv, constants.ActionAttachKeyPairs, constants.ActionDetachKeyPairs:
 		err = clusterClient.ModifyClusterTransitionStatus(ctx, p.Job.ClusterId, constants.StatusUpdating)
+	case constants.ActionCreateClusterSnapshot:
+		err = clusterClient.ModifyClusterTransitionStatus(ctx, p.Job.ClusterId, constants.StatusBackingUp)
+	case constants.ActionRestoreClusterFromSnapshot:
+		err = clusterClient.ModifyClusterTransitionStatus(ctx, p.Job.ClusterId, constants.StatusRestoring)
+	case constants.ActionDeleteClusterSnapshots, constants.ActionRunClusterService:
+		err = clusterClient.ModifyClusterTransitionStatus(ctx, p.Job.ClusterId, constants.StatusUpdating)
 	default:
 		logger.Error(ctx, "Unknown job action [%s]", p.Job.JobAction)
 	}
@@ -290,6 +296,24 @@ func (p *Processor) Post(ctx context.Context) error {
 		_, err = clusterClient.DeleteNodeKeyPairs(ctx, &pb.DeleteNodeKeyPairsRequest{
 			NodeKeyPair: pbNodeKeyPairs,
 		})
+	case constants.ActionCreateClusterSnapshot:
+		err = clusterClient.ModifyClusterSnapshotStatus(ctx, p.getSnapshotIds(ctx), constants.StatusActive)
+		if err != nil {
+			return err
+		}
+
+		err = clusterClient.ModifyClusterStatus(ctx, p.Job.ClusterId, constants.StatusActive)
+	case constants.ActionRestoreClusterFromSnapshot:
+		err = clusterClient.ModifyClusterStatus(ctx, p.Job.ClusterId, constants.StatusActive)
+	case constants.ActionDeleteClusterSnapshots:
+		err = clusterClient.ModifyClusterSnapshotStatus(ctx, p.getSnapshotIds(ctx), constants.StatusDeleted)
+		if err != nil {
+			return err
+		}
+
+		err = clusterClient.ModifyClusterStatus(ctx, p.Job.ClusterId, constants.StatusActive)
+	case constants.ActionRunClusterService:
+		err = clusterClient.ModifyClusterStatus(ctx, p.Job.ClusterId, constants.StatusActive)
 	default:
 		logger.Error(ctx, "Unknown job action [%s]", p.Job.JobAction)
 	}
@@ -310,6 +334,48 @@ func (p *Processor) Final(ctx context.Context) {
 	if err != nil {
 		logger.Error(ctx, "Executing job final processor failed: %+v", err)
 	}
+
+	switch p.Job.JobAction {
+	case constants.ActionCreateClusterSnapshot, constants.ActionDeleteClusterSnapshots:
+		snapshotIds := p.getSnapshotIds(ctx)
+		err = clusterClient.ModifyClusterSnapshotTransitionStatus(ctx, snapshotIds, "")
+		if err != nil {
+			logger.Error(ctx, "Executing job final processor failed: %+v", err)
+		}
+		// the snapshot failed to be created is useless to restore from
+		if p.Job.JobAction == constants.ActionCreateClusterSnapshot && p.Job.Status != constants.StatusSuccessful {
+			err = clusterClient.ModifyClusterSnapshotStatus(ctx, snapshotIds, constants.StatusFailed)
+			if err != nil {
+				logger.Error(ctx, "Executing job final processor failed: %+v", err)
+			}
+		}
+	case constants.ActionRunClusterService:
+		serviceDirective, err := models.NewClusterServiceDirective(ctx, p.Job.Directive)
+		if err != nil || serviceDirective.ClusterServiceAudit == nil {
+			logger.Error(ctx, "Executing job final processor failed: %+v", err)
+			return
+		}
+		status := constants.StatusSuccessful
+		if p.Job.Status != constants.StatusSuccessful {
+			status = constants.StatusFailed
+		}
+		err = clusterClient.ModifyClusterServiceAuditStatus(ctx, serviceDirective.ClusterServiceAudit.ClusterServiceAuditId, status)
+		if err != nil {
+			logger.Error(ctx, "Executing job final processor failed: %+v", err)
+		}
+	}
+}
+
+func (p *Processor) getSnapshotIds(ctx context.Context) []string {
+	snapshotDirective, err := models.NewClusterSnapshotDirective(ctx, p.Job.Directive)
+	if err != nil {
+		return nil
+	}
+	var snapshotIds []string
+	for _, snapshot := range snapshotDirective.Snapshots {
+		snapshotIds = append(snapshotIds, snapshot.SnapshotId)
+	}
+	return snapshotIds
 }
 
 func (p *Processor) UpdateClusterDetails(ctx context.Context) error {
@@ -344,6 +410,11 @@ func (p *Processor) UpdateClusterDetails(ctx context.Context) error {
 			clusterNodes = append(clusterNodes, clusterNode)
 		}
 
+		var clusterCommons []*models.ClusterCommon
+		for _, clusterCommon := range clusterWrapper.ClusterCommons {
+			clusterCommons = append(clusterCommons, clusterCommon)
+		}
+
 		clusterClient, err := clusterclient.NewClient()
 		if err != nil {
 			return err
@@ -354,8 +425,9 @@ func (p *Processor) UpdateClusterDetails(ctx context.Context) error {
 				ClusterId:   pbutil.ToProtoString(clusterWrapper.Cluster.ClusterId),
 				Description: pbutil.ToProtoString(clusterWrapper.Cluster.Description),
 			},
-			ClusterRoleSet: models.ClusterRolesToPbs(clusterRoles),
-			ClusterNodeSet: models.ClusterNodesWithKeyPairsToPbs(clusterNodes),
+			ClusterRoleSet:   models.ClusterRolesToPbs(clusterRoles),
+			ClusterNodeSet:   models.ClusterNodesWithKeyPairsToPbs(clusterNodes),
+			ClusterCommonSet: models.ClusterCommonsToPbs(clusterCommons),
 		}
 		if p.Job.VersionId != "" {
 			modifyClusterRequest.Cluster.VersionId = pbutil.ToProtoString(p.Job.VersionId)
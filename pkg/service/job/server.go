@@ -38,6 +38,7 @@ func Serve(cfg *config.Config) {
 
 	manager.NewGrpcServer("job-controller", constants.JobManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
+		WithChecker(s.Checker).
 		WithMysqlConfig(cfg.Mysql).
 		Serve(func(server *grpc.Server) {
 			pb.RegisterJobManagerServer(server, &s)
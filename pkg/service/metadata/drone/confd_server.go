@@ -113,6 +113,8 @@ func (p *ConfdServer) Start(opts ...libconfd.Options) error {
 		// etcd: OK
 	case backends.MetadBackendType:
 		// metad: OK
+	case backends.FileBackendType, backends.ConsulBackendType:
+		// local files or consul, without pilot: OK
 	default:
 		s := p.backendConfig.Type
 		logger.Error(nil, "ConfdServer: unsupport confd backend: "+s)
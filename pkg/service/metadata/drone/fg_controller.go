@@ -76,6 +76,25 @@ func (p *FrontgateController) ReportSubTaskStatus(in *pbtypes.SubTaskStatus) err
 	return nil
 }
 
+func (p *FrontgateController) ReportMonitorMetrics(in *pbtypes.MonitorMetrics) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.getClient()
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+
+	_, err = client.ReportMonitorMetrics(in)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (p *FrontgateController) getClient() (
 	*pbfrontgate.FrontgateServiceClient,
 	error,
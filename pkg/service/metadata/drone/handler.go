@@ -23,8 +23,10 @@ import (
 	pbdrone "openpitrix.io/openpitrix/pkg/pb/metadata/drone"
 	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/service/metadata/drone/yunify_confdfunc"
+	"openpitrix.io/openpitrix/pkg/util/cmdutil"
 	"openpitrix.io/openpitrix/pkg/util/funcutil"
 	"openpitrix.io/openpitrix/pkg/util/httputil"
+	"openpitrix.io/openpitrix/pkg/util/releaseutil"
 	"openpitrix.io/openpitrix/pkg/util/retryutil"
 	"openpitrix.io/openpitrix/pkg/version"
 )
@@ -38,7 +40,7 @@ var (
 	PilotVersionFilePath                              = "/opt/openpitrix/conf/pilot-version"
 )
 
-func (p *Server) getDroneFromFrontgate(pilotVersion, frontgateAddress string) error {
+func (p *Server) getDroneFromFrontgate(pilotVersion, frontgateAddress, droneSha256 string) error {
 	var droneBinary []byte
 
 	url := fmt.Sprintf("http://%s:%d/%s/drone", frontgateAddress, constants.FrontgateFileServerPort, pilotVersion)
@@ -49,6 +51,7 @@ func (p *Server) getDroneFromFrontgate(pilotVersion, frontgateAddress string) er
 		if err != nil {
 			return err
 		}
+		defer resp.Body.Close()
 
 		if resp.StatusCode != 200 {
 			return fmt.Errorf("download drone from url [%s] failed, status %s", url, resp.Status)
@@ -65,31 +68,25 @@ func (p *Server) getDroneFromFrontgate(pilotVersion, frontgateAddress string) er
 		return err
 	}
 
-	err = os.MkdirAll(fmt.Sprintf(DowloadPathPattern, pilotVersion), os.ModeDir|os.ModePerm)
-	if err != nil {
-		return err
-	}
-
-	filePath := fmt.Sprintf(DowloadFilePathPattern, pilotVersion, "drone")
-
-	logger.Info(nil, "Write drone to [%s]", filePath)
-	f, err := os.Create(filePath)
-	if err != nil {
-		return err
+	// frontgate of old version does not send the sha256
+	if droneSha256 != "" {
+		err = releaseutil.VerifySha256(droneBinary, droneSha256)
+		if err != nil {
+			return fmt.Errorf("verify drone downloaded from url [%s] failed: %+v", url, err)
+		}
+	} else {
+		logger.Warn(nil, "Drone downloaded from url [%s] is not verified, sha256 is not provided", url)
 	}
-	defer f.Close()
 
-	_, err = f.Write(droneBinary)
+	err = os.MkdirAll(fmt.Sprintf(DowloadPathPattern, pilotVersion), 0755)
 	if err != nil {
 		return err
 	}
 
-	err = os.Chmod(filePath, os.ModePerm)
-	if err != nil {
-		return err
-	}
+	filePath := fmt.Sprintf(DowloadFilePathPattern, pilotVersion, "drone")
 
-	return nil
+	logger.Info(nil, "Write drone to [%s]", filePath)
+	return releaseutil.WriteFile(filePath, droneBinary, 0755)
 }
 
 func (p *Server) createPilotVersionFile(pilotVersion string) error {
@@ -110,19 +107,24 @@ func (p *Server) createPilotVersionFile(pilotVersion string) error {
 func (p *Server) DistributeDrone(ctx context.Context, req *pbtypes.DistributeDroneRequest) (*pbtypes.Empty, error) {
 	pilotVersion := req.GetPilotVersion().GetValue()
 	frontgateAddress := req.GetFrontgateAddress().GetValue()
+	droneSha256 := req.GetDroneSha256().GetValue()
 
-	err := p.getDroneFromFrontgate(pilotVersion, frontgateAddress)
+	err := p.getDroneFromFrontgate(pilotVersion, frontgateAddress, droneSha256)
 	if err != nil {
 		return &pbtypes.Empty{}, err
 	}
 
-	err = p.createPilotVersionFile(pilotVersion)
+	err = p.prepareUpdate(pilotVersion)
 	if err != nil {
 		return &pbtypes.Empty{}, err
 	}
 
-	logger.Info(nil, "Drone exit")
-	os.Exit(0)
+	// exit after replied, supervisord restarts the drone with new version
+	go func() {
+		time.Sleep(time.Second)
+		logger.Info(nil, "Drone exit")
+		os.Exit(0)
+	}()
 
 	return &pbtypes.Empty{}, nil
 }
@@ -436,6 +438,8 @@ func (p *Server) PingFrontgate(ctx context.Context, arg *pbtypes.FrontgateEndpoi
 
 func (p *Server) PingDrone(ctx context.Context, arg *pbtypes.Empty) (*pbtypes.Empty, error) {
 	logger.Info(nil, funcutil.CallerName(1))
+	// reachable from frontgate, the pending update works
+	p.confirmUpdate()
 	return &pbtypes.Empty{}, nil
 }
 
@@ -477,3 +481,68 @@ func (p *Server) RunCommand(ctx context.Context, arg *pbtypes.RunCommandOnDroneR
 
 	return &pbtypes.String{Value: string(b.Bytes())}, nil
 }
+
+// commandOutputWriter sends the output of command as chunks of stdout or stderr
+type commandOutputWriter struct {
+	stderr bool
+	send   func(*pbtypes.CommandOutput) error
+}
+
+func (w *commandOutputWriter) Write(p []byte) (int, error) {
+	out := &pbtypes.CommandOutput{}
+	if w.stderr {
+		out.Stderr = append([]byte(nil), p...)
+	} else {
+		out.Stdout = append([]byte(nil), p...)
+	}
+	if err := w.send(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (p *Server) RunCommandStream(arg *pbtypes.RunCommandOnDroneRequest, srv pbdrone.DroneService_RunCommandStreamServer) error {
+	logger.Info(nil, funcutil.CallerName(1))
+
+	exitCode, err := cmdutil.Run(srv.Context(), arg.GetCommand(),
+		time.Duration(arg.GetTimeoutSeconds())*time.Second,
+		&commandOutputWriter{send: srv.Send},
+		&commandOutputWriter{stderr: true, send: srv.Send},
+	)
+	out := &pbtypes.CommandOutput{Exited: true, ExitCode: int32(exitCode)}
+	if err != nil {
+		logger.Warn(nil, "Run command [%s] failed: %+v", arg.GetCommand(), err)
+		out.Error = err.Error()
+	}
+	return srv.Send(out)
+}
+
+func (p *Server) AbortCmd(ctx context.Context, arg *pbtypes.SubTaskId) (*pbtypes.Empty, error) {
+	logger.Info(nil, "%s taskId: %s", funcutil.CallerName(1), arg.TaskId)
+
+	cfg := p.cfg.Get()
+	status, isEmpty, err := LoadLastCmdStatus(cfg.CmdInfoLogPath)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+	// the cmd of task is done or not started yet
+	if isEmpty || status.SubtaskId != arg.TaskId || status.Status != "executing" {
+		return &pbtypes.Empty{}, nil
+	}
+
+	killed, err := libconfd.KillReloadCmd("/etc/confd/conf.d/cmd.info.toml")
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+	if killed {
+		logger.Info(nil, "Cmd of task [%s] aborted", arg.TaskId)
+		go p.fg.ReportSubTaskStatus(&pbtypes.SubTaskStatus{
+			TaskId: arg.TaskId,
+			Status: constants.StatusFailed,
+		})
+	}
+
+	return &pbtypes.Empty{}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/cmdutil"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+// how often the drone config is checked when the node is not monitored
+var MonitorIdleInterval = 10 * time.Second
+
+// parseMonitorMetrics parses the values of monitor items printed by monitor cmd in json,
+// bool values are 1 or 0, the values which are not numbers are ignored
+func parseMonitorMetrics(output []byte) (map[string]float64, error) {
+	d := json.NewDecoder(bytes.NewReader(output))
+	d.UseNumber()
+	var items map[string]interface{}
+	if err := d.Decode(&items); err != nil {
+		return nil, fmt.Errorf("invalid output of monitor cmd [%s]: %v", string(output), err)
+	}
+
+	values := make(map[string]float64)
+	for item, v := range items {
+		var err error
+		switch x := v.(type) {
+		case json.Number:
+			values[item], err = x.Float64()
+		case string:
+			values[item], err = strconv.ParseFloat(x, 64)
+		case bool:
+			values[item] = 0
+			if x {
+				values[item] = 1
+			}
+		default:
+			err = fmt.Errorf("unsupported type %T", v)
+		}
+		if err != nil {
+			delete(values, item)
+			logger.Warn(nil, "Ignore value [%v] of monitor item [%s]: %v", v, item, err)
+		}
+	}
+	return values, nil
+}
+
+func collectMonitorMetrics(cfg *pbtypes.DroneConfig, timeout time.Duration) (*pbtypes.MonitorMetrics, error) {
+	var stdout, stderr bytes.Buffer
+	exitCode, err := cmdutil.Run(context.Background(), cfg.MonitorCmd, timeout, &stdout, &stderr)
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("monitor cmd exited with code %d: %s", exitCode, stderr.String())
+	}
+
+	values, err := parseMonitorMetrics(stdout.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	// frontgate_id is filled by the frontgate forwarding the metrics
+	return &pbtypes.MonitorMetrics{
+		DroneId:     cfg.Id,
+		CollectTime: pbutil.ToProtoTimestamp(time.Now()),
+		Values:      values,
+	}, nil
+}
+
+// serveMonitor runs the monitor cmd in drone config by interval,
+// and reports the metrics to pilot through frontgate
+func (p *Server) serveMonitor() {
+	for {
+		cfg := p.cfg.Get()
+		if cfg.MonitorCmd == "" || cfg.MonitorIntervalSeconds <= 0 {
+			time.Sleep(MonitorIdleInterval)
+			continue
+		}
+
+		interval := time.Duration(cfg.MonitorIntervalSeconds) * time.Second
+		start := time.Now()
+		metrics, err := collectMonitorMetrics(cfg, interval)
+		if err != nil {
+			logger.Warn(nil, "Collect monitor metrics by cmd [%s] failed: %+v", cfg.MonitorCmd, err)
+		} else if err = p.fg.ReportMonitorMetrics(metrics); err != nil {
+			logger.Warn(nil, "Report monitor metrics failed: %+v", err)
+		}
+		time.Sleep(interval - time.Since(start))
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMonitorMetrics(t *testing.T) {
+	values, err := parseMonitorMetrics([]byte(`{"conn_count": 10, "hit_percent": "95.5", "leader": true, "version": "v1.0", "slaves": [1]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]float64{
+		"conn_count":  10,
+		"hit_percent": 95.5,
+		"leader":      1,
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("Unexpected values [%+v], expected [%+v]", values, expected)
+	}
+
+	_, err = parseMonitorMetrics([]byte("connection refused"))
+	if err == nil {
+		t.Fatal("Invalid output of monitor cmd should fail")
+	}
+}
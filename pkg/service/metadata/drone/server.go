@@ -5,6 +5,8 @@
 package drone
 
 import (
+	"sync"
+
 	"google.golang.org/grpc"
 
 	"openpitrix.io/openpitrix/pkg/manager"
@@ -15,6 +17,9 @@ type Server struct {
 	cfg   *ConfigManager
 	confd *ConfdServer
 	fg    *FrontgateController
+
+	updateConfirmed   chan struct{}
+	confirmUpdateOnce sync.Once
 }
 
 func NewServer(cfg *ConfigManager, confd *ConfdServer) *Server {
@@ -22,6 +27,8 @@ func NewServer(cfg *ConfigManager, confd *ConfdServer) *Server {
 		cfg:   cfg,
 		confd: confd,
 		fg:    NewFrontgateController(),
+
+		updateConfirmed: make(chan struct{}),
 	}
 
 	return p
@@ -29,6 +36,8 @@ func NewServer(cfg *ConfigManager, confd *ConfdServer) *Server {
 
 func Serve(cfg *ConfigManager, confd *ConfdServer) {
 	s := NewServer(cfg, confd)
+	go s.watchPendingUpdate()
+	go s.serveMonitor()
 
 	manager.NewGrpcServer("drone-service", int(s.cfg.Get().ListenPort)).Serve(func(server *grpc.Server) {
 		pbdrone.RegisterDroneServiceServer(server, s)
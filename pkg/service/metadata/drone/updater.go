@@ -0,0 +1,94 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package drone
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+)
+
+// The drone updated by frontgate is pending until it is pinged by the frontgate,
+// otherwise it rolls back to the previous version. start-drone.sh also rolls back
+// if the updated drone fails to start several times.
+var (
+	PreviousPilotVersionFilePath = "/opt/openpitrix/conf/pilot-version.previous"
+	PendingPilotVersionFilePath  = "/opt/openpitrix/conf/pilot-version.pending"
+	// must be longer than the time frontgate waits for the updated drone
+	UpdateConfirmTimeout = 3 * time.Minute
+)
+
+func readPilotVersionFile(path string) string {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
+// prepareUpdate switches to the new version which takes effect after restarted,
+// the version running now (empty for the built-in drone) is kept for rollback
+func (p *Server) prepareUpdate(pilotVersion string) error {
+	previousVersion := readPilotVersionFile(PilotVersionFilePath)
+	err := ioutil.WriteFile(PreviousPilotVersionFilePath, []byte(previousVersion), 0644)
+	if err != nil {
+		return err
+	}
+
+	// start-drone.sh counts the starts of the pending version
+	err = ioutil.WriteFile(PendingPilotVersionFilePath, []byte("0"), 0644)
+	if err != nil {
+		return err
+	}
+
+	return p.createPilotVersionFile(pilotVersion)
+}
+
+func (p *Server) confirmUpdate() {
+	p.confirmUpdateOnce.Do(func() {
+		close(p.updateConfirmed)
+	})
+}
+
+func (p *Server) rollbackUpdate() {
+	previousVersion := readPilotVersionFile(PreviousPilotVersionFilePath)
+
+	var err error
+	if previousVersion == "" {
+		err = os.Remove(PilotVersionFilePath)
+	} else {
+		err = p.createPilotVersionFile(previousVersion)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		logger.Error(nil, "Rollback to previous version [%s] failed: %+v", previousVersion, err)
+		return
+	}
+	os.Remove(PendingPilotVersionFilePath)
+
+	logger.Error(nil, "Update is not confirmed by frontgate in %s, rollback to previous version [%s]",
+		UpdateConfirmTimeout, previousVersion)
+	os.Exit(0)
+}
+
+// watchPendingUpdate waits for the confirm of the pending update if any
+func (p *Server) watchPendingUpdate() {
+	if _, err := os.Stat(PendingPilotVersionFilePath); err != nil {
+		return
+	}
+
+	pilotVersion := readPilotVersionFile(PilotVersionFilePath)
+	logger.Info(nil, "Drone is updated to version [%s], waiting for frontgate to confirm", pilotVersion)
+
+	select {
+	case <-p.updateConfirmed:
+		os.Remove(PendingPilotVersionFilePath)
+		logger.Info(nil, "Update to version [%s] is confirmed", pilotVersion)
+	case <-time.After(UpdateConfirmTimeout):
+		p.rollbackUpdate()
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package frontgate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+)
+
+const (
+	// max time ReadCommandOutput waits for new output
+	commandOutputWait = time.Second
+	// the command is canceled if the output is not read for a while,
+	// e.g. the pilot reading it is gone
+	commandSessionIdleTimeout = time.Minute
+)
+
+// runCommandFunc runs the command until it exited or ctx is done
+type runCommandFunc func(ctx context.Context, stdout, stderr io.Writer) (exitCode int, err error)
+
+// commandSession buffers the output of a running command until it is read
+type commandSession struct {
+	id      string
+	command string
+	cancel  context.CancelFunc
+
+	stdout   bytes.Buffer
+	stderr   bytes.Buffer
+	exited   bool
+	exitCode int
+	err      error
+	// closed and renewed when the output changed
+	changed chan struct{}
+	readAt  time.Time
+	sync.Mutex
+}
+
+type sessionWriter struct {
+	s      *commandSession
+	stderr bool
+}
+
+func (w *sessionWriter) Write(p []byte) (int, error) {
+	w.s.Lock()
+	defer w.s.Unlock()
+
+	if w.stderr {
+		w.s.stderr.Write(p)
+	} else {
+		w.s.stdout.Write(p)
+	}
+	w.s.notify()
+	return len(p), nil
+}
+
+// notify must be called with lock held
+func (s *commandSession) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *commandSession) exit(exitCode int, err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.exited = true
+	s.exitCode = exitCode
+	s.err = err
+	s.notify()
+}
+
+// read drains the buffered output, waits for at most wait if nothing is buffered
+func (s *commandSession) read(wait time.Duration) *pbtypes.CommandOutput {
+	s.Lock()
+	if s.stdout.Len() == 0 && s.stderr.Len() == 0 && !s.exited {
+		changed := s.changed
+		s.Unlock()
+		select {
+		case <-changed:
+		case <-time.After(wait):
+		}
+		s.Lock()
+	}
+	defer s.Unlock()
+
+	out := &pbtypes.CommandOutput{
+		Stdout: append([]byte(nil), s.stdout.Bytes()...),
+		Stderr: append([]byte(nil), s.stderr.Bytes()...),
+	}
+	s.stdout.Reset()
+	s.stderr.Reset()
+	s.readAt = time.Now()
+	if s.exited {
+		out.Exited = true
+		out.ExitCode = int32(s.exitCode)
+		if s.err != nil {
+			out.Error = s.err.Error()
+		}
+	}
+	return out
+}
+
+func (s *commandSession) isIdle(now time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+	return now.Sub(s.readAt) > commandSessionIdleTimeout
+}
+
+type CommandSessionManager struct {
+	sessions map[string]*commandSession
+	sync.Mutex
+}
+
+func NewCommandSessionManager() *CommandSessionManager {
+	return &CommandSessionManager{
+		sessions: make(map[string]*commandSession),
+	}
+}
+
+// Start runs the command in background, the output is read by the returned session id
+func (p *CommandSessionManager) Start(command string, run runCommandFunc) string {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &commandSession{
+		id:       idutil.GetUuid("cmd-"),
+		command:  command,
+		cancel:   cancel,
+		exitCode: -1,
+		changed:  make(chan struct{}),
+		readAt:   time.Now(),
+	}
+
+	p.Lock()
+	p.cleanup()
+	p.sessions[s.id] = s
+	p.Unlock()
+
+	go func() {
+		defer cancel()
+		exitCode, err := run(ctx, &sessionWriter{s: s}, &sessionWriter{s: s, stderr: true})
+		if err != nil {
+			logger.Warn(nil, "Command [%s] of session [%s] failed: %+v", command, s.id, err)
+		}
+		s.exit(exitCode, err)
+	}()
+	return s.id
+}
+
+// Read returns the output since last read, the session is removed
+// after the last output with exited is read
+func (p *CommandSessionManager) Read(id string) (*pbtypes.CommandOutput, error) {
+	p.Lock()
+	s, ok := p.sessions[id]
+	p.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("command session [%s] not found", id)
+	}
+
+	out := s.read(commandOutputWait)
+	if out.Exited {
+		p.Lock()
+		delete(p.sessions, id)
+		p.Unlock()
+	}
+	return out, nil
+}
+
+// Cancel kills the command, the output until killed is still readable
+func (p *CommandSessionManager) Cancel(id string) error {
+	p.Lock()
+	s, ok := p.sessions[id]
+	p.Unlock()
+	if !ok {
+		return fmt.Errorf("command session [%s] not found", id)
+	}
+
+	logger.Info(nil, "Cancel command [%s] of session [%s]", s.command, id)
+	s.cancel()
+	return nil
+}
+
+// cleanup must be called with lock held
+func (p *CommandSessionManager) cleanup() {
+	now := time.Now()
+	for id, s := range p.sessions {
+		if s.isIdle(now) {
+			logger.Warn(nil, "Command [%s] of session [%s] is not read for a while, canceled", s.command, id)
+			s.cancel()
+			delete(p.sessions, id)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package frontgate
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/util/cmdutil"
+)
+
+func readAllCommandOutput(tb testing.TB, mgr *CommandSessionManager, id string) (stdout, stderr string, exitCode int32) {
+	for {
+		out, err := mgr.Read(id)
+		Assert(tb, err == nil, err)
+		stdout += string(out.Stdout)
+		stderr += string(out.Stderr)
+		if out.Exited {
+			return stdout, stderr, out.ExitCode
+		}
+	}
+}
+
+func runLocalCommand(command string) runCommandFunc {
+	return func(ctx context.Context, stdout, stderr io.Writer) (int, error) {
+		return cmdutil.Run(ctx, command, 0, stdout, stderr)
+	}
+}
+
+func TestCommandSessionManager(t *testing.T) {
+	mgr := NewCommandSessionManager()
+
+	command := "echo 1; sleep 1; echo 2 >&2; sleep 1; echo 3; exit 2"
+	id := mgr.Start(command, runLocalCommand(command))
+	stdout, stderr, exitCode := readAllCommandOutput(t, mgr, id)
+	Assert(t, stdout == "1\n3\n", stdout)
+	Assert(t, stderr == "2\n", stderr)
+	Assert(t, exitCode == 2, exitCode)
+
+	// session is removed after exited
+	_, err := mgr.Read(id)
+	Assert(t, err != nil)
+}
+
+func TestCommandSessionManager_Cancel(t *testing.T) {
+	mgr := NewCommandSessionManager()
+
+	command := "echo begin; sleep 10; echo end"
+	id := mgr.Start(command, runLocalCommand(command))
+	out, err := mgr.Read(id)
+	Assert(t, err == nil, err)
+	Assert(t, string(out.Stdout) == "begin\n", string(out.Stdout))
+
+	start := time.Now()
+	Assert(t, mgr.Cancel(id) == nil)
+	stdout, _, _ := readAllCommandOutput(t, mgr, id)
+	Assert(t, stdout == "", stdout)
+	Assert(t, time.Since(start) < 5*time.Second, "command should be killed")
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os/exec"
 	"reflect"
 	"runtime"
@@ -16,13 +17,16 @@ import (
 
 	"github.com/chai2010/jsonmap"
 
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/logger"
 	pbfrontgate "openpitrix.io/openpitrix/pkg/pb/metadata/frontgate"
 	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
 	"openpitrix.io/openpitrix/pkg/service/metadata/drone/droneutil"
 	"openpitrix.io/openpitrix/pkg/service/metadata/frontgate/frontgateutil"
 	"openpitrix.io/openpitrix/pkg/service/metadata/pilot/pilotutil"
+	"openpitrix.io/openpitrix/pkg/util/cmdutil"
 	"openpitrix.io/openpitrix/pkg/util/funcutil"
+	"openpitrix.io/openpitrix/pkg/util/retryutil"
 )
 
 var (
@@ -617,6 +621,27 @@ func (p *EtcdClient) DeregisterCmd(in *pbtypes.SubTask_DeregisterCmd) error {
 	return nil
 }
 
+func (p *Server) AbortCmd(in *pbtypes.SubTask_AbortCmd, out *pbtypes.Empty) error {
+	logger.Info(nil, "%s taskId: %s", funcutil.CallerName(1), in.TaskId)
+
+	ctx := context.Background()
+
+	client, conn, err := droneutil.DialDroneService(ctx, in.DroneIp, constants.DroneServicePort)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.AbortCmd(ctx, &pbtypes.SubTaskId{TaskId: in.TaskId})
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (p *Server) ReportSubTaskStatus(in *pbtypes.SubTaskStatus, out *pbtypes.Empty) error {
 	logger.Info(nil, "%s taskId: %s", funcutil.CallerName(1), in.TaskId)
 
@@ -633,7 +658,38 @@ func (p *Server) ReportSubTaskStatus(in *pbtypes.SubTaskStatus, out *pbtypes.Emp
 	}
 	defer conn.Close()
 
-	_, err = client.ReportSubTaskStatus(ctx, in)
+	// the task would wait for the status until timeout if it is lost
+	err = retryutil.Retry(RetryCount, RetryInterval, func() error {
+		_, err := client.ReportSubTaskStatus(ctx, in)
+		return err
+	})
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *Server) ReportMonitorMetrics(in *pbtypes.MonitorMetrics, out *pbtypes.Empty) error {
+	logger.Debug(nil, "%s droneId: %s", funcutil.CallerName(1), in.DroneId)
+
+	ctx := context.Background()
+
+	cfg := p.cfg.Get()
+	client, conn, err := pilotutil.DialPilotServiceForFrontgate_TLS(
+		ctx, cfg.PilotHost, int(cfg.PilotPort),
+		p.tlsPilotConfig,
+	)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+	defer conn.Close()
+
+	// pilot keeps the metrics by frontgate and drone
+	in.FrontgateId = cfg.Id
+	_, err = client.ReportMonitorMetrics(ctx, in)
 	if err != nil {
 		logger.Warn(nil, "%+v", err)
 		return err
@@ -887,6 +943,81 @@ func (p *Server) RunCommandOnDrone(in *pbtypes.RunCommandOnDroneRequest, out *pb
 	return nil
 }
 
+func (p *Server) StartCommand(in *pbtypes.RunCommandStreamRequest, out *pbtypes.CommandSession) error {
+	logger.Info(nil, funcutil.CallerName(1))
+
+	var run runCommandFunc
+	if drone := in.GetDroneEndpoint(); drone != nil {
+		run = func(ctx context.Context, stdout, stderr io.Writer) (int, error) {
+			return runCommandOnDrone(ctx, drone, in, stdout, stderr)
+		}
+	} else {
+		run = func(ctx context.Context, stdout, stderr io.Writer) (int, error) {
+			timeout := time.Duration(in.GetTimeoutSeconds()) * time.Second
+			return cmdutil.Run(ctx, in.GetCommand(), timeout, stdout, stderr)
+		}
+	}
+
+	out.SessionId = p.cmdSessions.Start(in.GetCommand(), run)
+	return nil
+}
+
+// runCommandOnDrone relays the output streamed by drone
+func runCommandOnDrone(ctx context.Context, drone *pbtypes.DroneEndpoint, in *pbtypes.RunCommandStreamRequest, stdout, stderr io.Writer) (int, error) {
+	client, conn, err := droneutil.DialDroneService(ctx,
+		drone.GetDroneIp(),
+		int(drone.GetDronePort()),
+	)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Close()
+
+	stream, err := client.RunCommandStream(ctx, &pbtypes.RunCommandOnDroneRequest{
+		Endpoint:       drone,
+		Command:        in.GetCommand(),
+		TimeoutSeconds: in.GetTimeoutSeconds(),
+	})
+	if err != nil {
+		return -1, err
+	}
+	for {
+		output, err := stream.Recv()
+		if err != nil {
+			return -1, err
+		}
+		stdout.Write(output.GetStdout())
+		stderr.Write(output.GetStderr())
+		if output.GetExited() {
+			if output.GetError() != "" {
+				return int(output.GetExitCode()), fmt.Errorf("%s", output.GetError())
+			}
+			return int(output.GetExitCode()), nil
+		}
+	}
+}
+
+func (p *Server) ReadCommandOutput(in *pbtypes.CommandSession, out *pbtypes.CommandOutput) error {
+	output, err := p.cmdSessions.Read(in.GetSessionId())
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+	*out = *output
+	return nil
+}
+
+func (p *Server) CancelCommand(in *pbtypes.CommandSession, out *pbtypes.Empty) error {
+	logger.Info(nil, funcutil.CallerName(1))
+
+	err := p.cmdSessions.Cancel(in.GetSessionId())
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+	return nil
+}
+
 func (p *Server) HeartBeat(in *pbtypes.Empty, out *pbtypes.Empty) error {
 	return nil // OK
 }
@@ -28,6 +28,7 @@ type Server struct {
 	cfg            *ConfigManager
 	tlsPilotConfig *tls.Config
 	etcd           *EtcdClientManager
+	cmdSessions    *CommandSessionManager
 
 	ch   *pilotutil.FrameChannel
 	conn *grpc.ClientConn
@@ -39,6 +40,7 @@ func Serve(cfg *ConfigManager, tlsPilotConfig *tls.Config) {
 		cfg:            cfg,
 		tlsPilotConfig: tlsPilotConfig,
 		etcd:           NewEtcdClientManager(),
+		cmdSessions:    NewCommandSessionManager(),
 	}
 
 	go func() {
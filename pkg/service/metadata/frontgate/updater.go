@@ -1,8 +1,10 @@
 package frontgate
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"regexp"
 	"strings"
@@ -19,23 +21,34 @@ import (
 	"openpitrix.io/openpitrix/pkg/util/gziputil"
 	"openpitrix.io/openpitrix/pkg/util/httputil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
+	"openpitrix.io/openpitrix/pkg/util/releaseutil"
 	"openpitrix.io/openpitrix/pkg/util/retryutil"
 	"openpitrix.io/openpitrix/pkg/version"
 )
 
 var (
-	FrontgateVersion            = getShortVersion(version.ShortVersion)
-	CheckInterval               = 10 * time.Second
-	RetryInterval               = 3 * time.Second
-	RetryCount                  = 5
-	OpenPitrixReleaseUrlPattern = "https://github.com/openpitrix/openpitrix/releases/download/%s/openpitrix-%s-bin.tar.gz"
-	HttpServePath               = "/opt/openpitrix/bin"
-	DowloadPathPattern          = "/opt/openpitrix/bin/%s"
-	DowloadFilePathPattern      = "/opt/openpitrix/bin/%s/%s"
-	PilotVersionFilePath        = "/opt/openpitrix/conf/pilot-version"
-	KeyPrefix                   = "/"
-	KeyRegexp                   = regexp.MustCompile(`^\/\_metad\/mapping\/default\/(\d+\.\d+\.\d+\.\d+)\/host$`)
-	EtcdEndpoints               = []string{"127.0.0.1:2379"}
+	FrontgateVersion       = getShortVersion(version.ShortVersion)
+	CheckInterval          = 10 * time.Second
+	RetryInterval          = 3 * time.Second
+	RetryCount             = 5
+	DefaultReleaseUrl      = "https://github.com/openpitrix/openpitrix/releases/download/%s"
+	ReleaseFilePattern     = "openpitrix-%s-bin.tar.gz"
+	ManifestFilePattern    = "openpitrix-%s-manifest.json"
+	SignatureFilePattern   = "openpitrix-%s-manifest.json.sig"
+	HttpServePath          = "/opt/openpitrix/bin"
+	DowloadPathPattern     = "/opt/openpitrix/bin/%s"
+	DowloadFilePathPattern = "/opt/openpitrix/bin/%s/%s"
+	PilotVersionFilePath   = "/opt/openpitrix/conf/pilot-version"
+	KeyPrefix              = "/"
+	KeyRegexp              = regexp.MustCompile(`^\/\_metad\/mapping\/default\/(\d+\.\d+\.\d+\.\d+)\/host$`)
+	EtcdEndpoints          = []string{"127.0.0.1:2379"}
+
+	DefaultDroneCanaryCount = 1
+	DefaultDroneBatchSize   = 5
+	// the updated drone must be reachable with the new version in time,
+	// shorter than the time drone waits for the confirm before rollback
+	DroneUpdateTimeout = 2 * time.Minute
+	DroneRpcTimeout    = 10 * time.Second
 )
 
 func getShortVersion(v string) string {
@@ -54,6 +67,9 @@ type Updater struct {
 
 	etcd *EtcdClientManager
 	cfg  *pbtypes.FrontgateConfig
+
+	// rollout of the version is halted since some drone failed to update
+	haltedVersion string
 }
 
 func NewUpdater(conn *grpc.ClientConn, cfg *pbtypes.FrontgateConfig) *Updater {
@@ -99,57 +115,107 @@ func (u *Updater) createPilotVersionFile(pilotVersion string) error {
 	return nil
 }
 
-func (u *Updater) downloadNewRelease(pilotVersion string) error {
-	err := os.MkdirAll(fmt.Sprintf(DowloadPathPattern, pilotVersion), os.ModeDir|os.ModePerm)
-	if err != nil {
-		return err
+func (u *Updater) getReleaseFileUrl(pilotVersion, filePattern string) string {
+	releaseUrl := u.cfg.GetUpdateConfig().GetReleaseUrl()
+	if releaseUrl == "" {
+		releaseUrl = DefaultReleaseUrl
 	}
+	releaseUrl = strings.Replace(releaseUrl, "%s", pilotVersion, -1)
+	return strings.TrimSuffix(releaseUrl, "/") + "/" + fmt.Sprintf(filePattern, pilotVersion)
+}
 
-	url := fmt.Sprintf(OpenPitrixReleaseUrlPattern, pilotVersion, pilotVersion)
-	logger.Info(nil, "Trying to download new release from url [%s]", url)
-
-	err = retryutil.Retry(RetryCount, RetryInterval, func() error {
+func (u *Updater) download(url string) ([]byte, error) {
+	var content []byte
+	err := retryutil.Retry(RetryCount, RetryInterval, func() error {
 		resp, err := httputil.HttpGet(url)
 		if err != nil {
 			return err
 		}
+		defer resp.Body.Close()
 
 		if resp.StatusCode != 200 {
-			return fmt.Errorf("download new release from url [%s] failed, status %s", url, resp.Status)
+			return fmt.Errorf("download from url [%s] failed, status %s", url, resp.Status)
 		}
 
-		archiveFiles, err := gziputil.LoadArchive(resp.Body)
-		if err != nil {
-			return err
-		}
+		content, err = ioutil.ReadAll(resp.Body)
+		return err
+	})
+	return content, err
+}
 
-		for fileName, fileBytes := range archiveFiles {
-			filePath := fmt.Sprintf(DowloadFilePathPattern, pilotVersion, fileName)
+// loadReleaseManifest downloads the manifest of release and verifies its signature
+func (u *Updater) loadReleaseManifest(pilotVersion string) (*releaseutil.Manifest, error) {
+	// an unsigned release is never installed
+	key := u.cfg.GetUpdateConfig().GetReleasePublicKey()
+	if key == "" {
+		return nil, fmt.Errorf("release public key is not configured, release manifest can not be verified")
+	}
+	publicKey, err := releaseutil.ParsePublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release public key: %+v", err)
+	}
+	signature, err := u.download(u.getReleaseFileUrl(pilotVersion, SignatureFilePattern))
+	if err != nil {
+		return nil, err
+	}
 
-			logger.Info(nil, "Write downloaded file [%s] to [%s]", fileName, filePath)
-			f, err := os.Create(filePath)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
+	url := u.getReleaseFileUrl(pilotVersion, ManifestFilePattern)
+	logger.Info(nil, "Trying to download release manifest from url [%s]", url)
+	content, err := u.download(url)
+	if err != nil {
+		return nil, err
+	}
 
-			_, err = f.Write(fileBytes)
-			if err != nil {
-				return err
-			}
+	manifest, err := releaseutil.LoadManifest(content, signature, publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("verify release manifest from url [%s] failed: %+v", url, err)
+	}
+	// an old signed manifest must not be used for the new version
+	if manifest.Version != pilotVersion {
+		return nil, fmt.Errorf("release manifest from url [%s] is for version [%s]", url, manifest.Version)
+	}
+	return manifest, nil
+}
 
-			err = os.Chmod(filePath, os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
+func (u *Updater) downloadNewRelease(pilotVersion string) error {
+	manifest, err := u.loadReleaseManifest(pilotVersion)
+	if err != nil {
+		return err
+	}
 
-		return nil
-	})
+	url := u.getReleaseFileUrl(pilotVersion, ReleaseFilePattern)
+	logger.Info(nil, "Trying to download new release from url [%s]", url)
+
+	release, err := u.download(url)
+	if err != nil {
+		return err
+	}
+
+	err = manifest.VerifyFile(fmt.Sprintf(ReleaseFilePattern, pilotVersion), release)
+	if err != nil {
+		return fmt.Errorf("verify release from url [%s] failed: %+v", url, err)
+	}
+
+	archiveFiles, err := gziputil.LoadArchive(bytes.NewReader(release))
 	if err != nil {
 		return err
 	}
 
+	err = os.MkdirAll(fmt.Sprintf(DowloadPathPattern, pilotVersion), 0755)
+	if err != nil {
+		return err
+	}
+
+	for fileName, fileBytes := range archiveFiles {
+		filePath := fmt.Sprintf(DowloadFilePathPattern, pilotVersion, fileName)
+
+		logger.Info(nil, "Write downloaded file [%s] to [%s]", fileName, filePath)
+		err = releaseutil.WriteFile(filePath, fileBytes, 0755)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = u.createPilotVersionFile(pilotVersion)
 	if err != nil {
 		return err
@@ -195,8 +261,9 @@ func (u *Updater) getDroneVersion(ctx context.Context, client pbdrone.DroneServi
 	return getShortVersion(droneVersion.ShortVersion), nil
 }
 
-func (u *Updater) distributeDrone(drone string, pilotVersion string) error {
-	ctx := context.Background()
+func (u *Updater) callDrone(drone string, fn func(ctx context.Context, client pbdrone.DroneServiceClient) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), DroneRpcTimeout)
+	defer cancel()
 
 	client, conn, err := droneutil.DialDroneService(ctx, drone, constants.DroneServicePort)
 	if err != nil {
@@ -204,31 +271,84 @@ func (u *Updater) distributeDrone(drone string, pilotVersion string) error {
 	}
 	defer conn.Close()
 
-	droneVersion, err := u.getDroneVersion(ctx, client)
-	if err != nil {
-		return err
-	}
+	return fn(ctx, client)
+}
 
-	logger.Debug(ctx, "Pilot version [%s]", pilotVersion)
-	logger.Debug(ctx, "Drone version [%s]", droneVersion)
+func (u *Updater) distributeDrone(drone, pilotVersion, droneSha256 string) error {
+	logger.Info(nil, "Trying to distribute drone with version [%s] from frontgate[%s] to drone[%s]", pilotVersion, u.cfg.Host, drone)
 
-	if pilotVersion != droneVersion {
-		logger.Info(nil, "Trying to distribute drone with version [%s] from frontgate[%s] to drone[%s]", pilotVersion, u.cfg.Host, drone)
+	return u.callDrone(drone, func(ctx context.Context, client pbdrone.DroneServiceClient) error {
 		req := &pbtypes.DistributeDroneRequest{
 			PilotVersion:     pbutil.ToProtoString(pilotVersion),
 			FrontgateAddress: pbutil.ToProtoString(u.cfg.Host),
+			DroneSha256:      pbutil.ToProtoString(droneSha256),
 		}
 
-		_, err = client.DistributeDrone(ctx, req)
-		if err != nil {
-			return err
+		_, err := client.DistributeDrone(ctx, req)
+		return err
+	})
+}
+
+// waitDroneUpdated waits for the drone restarted with the new version,
+// the drone confirms the update when pinged and rolls back if not pinged in time
+func (u *Updater) waitDroneUpdated(drone, pilotVersion string, deadline time.Time) error {
+	for {
+		time.Sleep(RetryInterval)
+
+		err := u.callDrone(drone, func(ctx context.Context, client pbdrone.DroneServiceClient) error {
+			_, err := client.PingDrone(ctx, &pbtypes.Empty{})
+			if err != nil {
+				return err
+			}
+
+			droneVersion, err := u.getDroneVersion(ctx, client)
+			if err != nil {
+				return err
+			}
+			if droneVersion != pilotVersion {
+				return fmt.Errorf("drone is running version [%s]", droneVersion)
+			}
+			return nil
+		})
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("drone [%s] is not healthy after updated to version [%s]: %+v", drone, pilotVersion, err)
 		}
 	}
+}
 
-	return nil
+// planDroneRollout splits the drones into the batches to update in turn, the canary drones first
+func planDroneRollout(drones []string, canaryCount, batchSize int) [][]string {
+	if canaryCount <= 0 {
+		canaryCount = DefaultDroneCanaryCount
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultDroneBatchSize
+	}
+
+	var batches [][]string
+	for len(drones) > 0 {
+		n := batchSize
+		if len(batches) == 0 {
+			n = canaryCount
+		}
+		if n > len(drones) {
+			n = len(drones)
+		}
+		batches = append(batches, drones[:n])
+		drones = drones[n:]
+	}
+	return batches
 }
 
 func (u *Updater) distributeDrones(pilotVersion string) error {
+	if pilotVersion == u.haltedVersion {
+		logger.Debug(nil, "Rollout of drone version [%s] is halted", pilotVersion)
+		return nil
+	}
+
 	drones, err := u.getDroneList()
 	if err != nil {
 		return err
@@ -236,13 +356,75 @@ func (u *Updater) distributeDrones(pilotVersion string) error {
 
 	logger.Debug(nil, "Get drone list %+v", drones)
 
+	var outdatedDrones []string
 	for _, drone := range drones {
-		err := u.distributeDrone(drone, pilotVersion)
+		var droneVersion string
+		err := u.callDrone(drone, func(ctx context.Context, client pbdrone.DroneServiceClient) (err error) {
+			droneVersion, err = u.getDroneVersion(ctx, client)
+			return
+		})
 		if err != nil {
-			return err
+			logger.Warn(nil, "Get version of drone [%s] failed, %+v", drone, err)
+			continue
+		}
+
+		logger.Debug(nil, "Pilot version [%s], drone [%s] version [%s]", pilotVersion, drone, droneVersion)
+		if droneVersion != pilotVersion {
+			outdatedDrones = append(outdatedDrones, drone)
+		}
+	}
+	if len(outdatedDrones) == 0 {
+		return nil
+	}
+
+	droneBinary, err := ioutil.ReadFile(fmt.Sprintf(DowloadFilePathPattern, pilotVersion, "drone"))
+	if err != nil {
+		return err
+	}
+	droneSha256 := releaseutil.Sha256(droneBinary)
+
+	updateConfig := u.cfg.GetUpdateConfig()
+	batches := planDroneRollout(outdatedDrones, int(updateConfig.GetDroneCanaryCount()), int(updateConfig.GetDroneBatchSize()))
+	for i, batch := range batches {
+		logger.Info(nil, "Updating drones %+v to version [%s], batch [%d/%d]", batch, pilotVersion, i+1, len(batches))
+
+		err = u.updateDrones(batch, pilotVersion, droneSha256)
+		if err != nil {
+			// the failed drones roll back by themselves, the rest are kept
+			u.haltedVersion = pilotVersion
+			return fmt.Errorf("rollout of drone version [%s] halted: %+v", pilotVersion, err)
 		}
 	}
 
+	logger.Info(nil, "Drones %+v are updated to version [%s]", outdatedDrones, pilotVersion)
+	return nil
+}
+
+// updateDrones updates the drones of a batch, all the updated drones are
+// checked even if some of them failed, so that the healthy ones are confirmed
+func (u *Updater) updateDrones(drones []string, pilotVersion, droneSha256 string) error {
+	var errs []string
+	var distributedDrones []string
+	for _, drone := range drones {
+		err := u.distributeDrone(drone, pilotVersion, droneSha256)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("distribute drone [%s] failed: %+v", drone, err))
+			continue
+		}
+		distributedDrones = append(distributedDrones, drone)
+	}
+
+	deadline := time.Now().Add(DroneUpdateTimeout)
+	for _, drone := range distributedDrones {
+		err := u.waitDroneUpdated(drone, pilotVersion, deadline)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
 	return nil
 }
 
@@ -0,0 +1,31 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package frontgate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanDroneRollout(t *testing.T) {
+	drones := []string{"a", "b", "c", "d", "e", "f"}
+
+	tests := []struct {
+		canaryCount int
+		batchSize   int
+		batches     [][]string
+	}{
+		{1, 2, [][]string{{"a"}, {"b", "c"}, {"d", "e"}, {"f"}}},
+		{2, 10, [][]string{{"a", "b"}, {"c", "d", "e", "f"}}},
+		{10, 1, [][]string{{"a", "b", "c", "d", "e", "f"}}},
+		{0, 0, [][]string{{"a"}, {"b", "c", "d", "e", "f"}}},
+	}
+	for _, tt := range tests {
+		batches := planDroneRollout(drones, tt.canaryCount, tt.batchSize)
+		Assertf(t, reflect.DeepEqual(batches, tt.batches), "canary %d, batch %d: %v", tt.canaryCount, tt.batchSize, batches)
+	}
+
+	Assert(t, len(planDroneRollout(nil, 1, 1)) == 0)
+}
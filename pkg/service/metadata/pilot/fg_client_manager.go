@@ -99,6 +99,35 @@ func (p *FrontgateClientManager) GetNodeClient(id, nodeId string) (*FrontgateCli
 	return nil, fmt.Errorf("frontgate [%s] node [%s] not found", id, nodeId)
 }
 
+// HasClient returns true if the frontgate (node) is connected to this pilot,
+// any node of the frontgate is accepted when nodeId is empty
+func (p *FrontgateClientManager) HasClient(id, nodeId string) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, cs := range p.clientMap[id] {
+		if nodeId == "" || cs.info.GetNodeId() == nodeId {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClientIds returns the id of frontgates connected to this pilot
+func (p *FrontgateClientManager) GetClientIds() []string {
+	p.Lock()
+	defer p.Unlock()
+
+	var ids []string
+	for id, cs := range p.clientMap {
+		if len(cs) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 func (p *FrontgateClientManager) PutClient(c *pbfrontgate.FrontgateServiceClient, info *pbtypes.FrontgateConfig) (closed chan bool) {
 	p.Lock()
 	defer p.Unlock()
@@ -0,0 +1,154 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+)
+
+const (
+	frontgateRegistryPrefix  = "pilot/frontgates/"
+	frontgateRegistryTTL     = 15 // seconds
+	frontgateRegistryTimeout = 5 * time.Second
+)
+
+// FrontgateRegistry records which pilot replica holds the channel of a frontgate node,
+// so the replica receives a request of the frontgate can forward it to the holder.
+// The records are put with the lease of the replica, and removed by etcd when
+// the replica is gone.
+type FrontgateRegistry struct {
+	etcd *etcd.Etcd
+	// address of the pilot service of this replica
+	addr string
+
+	lease *etcd.Lease
+	// reference count of the frontgate node channels held by this replica
+	nodes map[string]int
+	sync.Mutex
+}
+
+func NewFrontgateRegistry(e *etcd.Etcd, addr string) (*FrontgateRegistry, error) {
+	lease, err := e.NewLease(frontgateRegistryTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &FrontgateRegistry{
+		etcd:  e,
+		addr:  addr,
+		lease: lease,
+		nodes: make(map[string]int),
+	}, nil
+}
+
+func frontgateRegistryKey(id, nodeId string) string {
+	return fmt.Sprintf("%s%s/%s", frontgateRegistryPrefix, id, nodeId)
+}
+
+func (p *FrontgateRegistry) Addr() string {
+	return p.addr
+}
+
+func (p *FrontgateRegistry) put(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), frontgateRegistryTimeout)
+	defer cancel()
+
+	_, err := p.lease.Client().Put(ctx, key, p.addr, clientv3.WithLease(p.lease.Lease()))
+	return err
+}
+
+func (p *FrontgateRegistry) Register(id, nodeId string) {
+	p.Lock()
+	defer p.Unlock()
+
+	key := frontgateRegistryKey(id, nodeId)
+	p.nodes[key]++
+	if err := p.put(key); err != nil {
+		logger.Error(nil, "Register frontgate (%s/%s) failed: %+v", id, nodeId, err)
+	}
+}
+
+func (p *FrontgateRegistry) Deregister(id, nodeId string) {
+	p.Lock()
+	defer p.Unlock()
+
+	key := frontgateRegistryKey(id, nodeId)
+	if p.nodes[key]--; p.nodes[key] > 0 {
+		return
+	}
+	delete(p.nodes, key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), frontgateRegistryTimeout)
+	defer cancel()
+	if err := p.lease.Release(ctx, key); err != nil {
+		logger.Error(nil, "Deregister frontgate (%s/%s) failed: %+v", id, nodeId, err)
+	}
+}
+
+// Lookup returns the address of the pilot replica holding the frontgate node,
+// any node of the frontgate is accepted when nodeId is empty
+func (p *FrontgateRegistry) Lookup(ctx context.Context, id, nodeId string) (string, bool, error) {
+	key := frontgateRegistryKey(id, nodeId)
+	var opts []clientv3.OpOption
+	if len(nodeId) == 0 {
+		opts = append(opts, clientv3.WithPrefix(), clientv3.WithLimit(1))
+	}
+	resp, err := p.etcd.Get(ctx, key, opts...)
+	if err != nil {
+		return "", false, err
+	}
+	if len(resp.Kvs) == 0 {
+		return "", false, nil
+	}
+	return string(resp.Kvs[0].Value), true, nil
+}
+
+// List returns the id of frontgates held by all pilot replicas
+func (p *FrontgateRegistry) List(ctx context.Context) ([]string, error) {
+	resp, err := p.etcd.Get(ctx, frontgateRegistryPrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	for _, kv := range resp.Kvs {
+		id := strings.SplitN(strings.TrimPrefix(string(kv.Key), frontgateRegistryPrefix), "/", 2)[0]
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// KeepAlive renews the lease when it is expired (e.g. etcd was unreachable
+// for a while) and registers the frontgate nodes held by this replica again
+func (p *FrontgateRegistry) KeepAlive() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.lease.IsExpired() {
+		return
+	}
+	lease, err := p.etcd.NewLease(frontgateRegistryTTL)
+	if err != nil {
+		logger.Error(nil, "Renew frontgate registry lease failed: %+v", err)
+		return
+	}
+	p.lease = lease
+	for key := range p.nodes {
+		if err = p.put(key); err != nil {
+			logger.Error(nil, "Register frontgate [%s] failed: %+v", key, err)
+		}
+	}
+}
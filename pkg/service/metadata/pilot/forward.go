@@ -0,0 +1,166 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/manager"
+	pbpilot "openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+// forwardedByKey is set in the metadata of forwarded requests,
+// to avoid forwarding the requests again
+const forwardedByKey = "x-pilot-forwarded-by"
+
+var pilotServiceType = reflect.TypeOf((*pbpilot.PilotServiceServer)(nil)).Elem()
+
+// frontgateOf returns the frontgate (and node) the request is sent to
+func frontgateOf(req interface{}) (id, nodeId string) {
+	switch r := req.(type) {
+	case *pbtypes.FrontgateId:
+		return r.GetId(), ""
+	case *pbtypes.FrontgateNodeId:
+		return r.GetId(), r.GetNodeId()
+	case *pbtypes.FrontgateConfig:
+		return r.GetId(), ""
+	case *pbtypes.RunCommandOnFrontgateRequest:
+		return r.GetEndpoint().GetFrontgateId(), r.GetEndpoint().GetFrontgateNodeId()
+	case *pbtypes.RunCommandStreamRequest:
+		if r.GetDroneEndpoint() != nil {
+			return r.GetDroneEndpoint().GetFrontgateId(), ""
+		}
+		return r.GetFrontgateEndpoint().GetFrontgateId(), r.GetFrontgateEndpoint().GetFrontgateNodeId()
+	case *pbtypes.SubTaskMessage:
+		// directives of all sub tasks sent to frontgate have frontgate_id
+		var x struct {
+			FrontgateId string `json:"frontgate_id"`
+		}
+		json.Unmarshal([]byte(r.GetDirective()), &x)
+		return x.FrontgateId, ""
+	case interface{ GetFrontgateId() string }:
+		return r.GetFrontgateId(), ""
+	case interface {
+		GetEndpoint() *pbtypes.DroneEndpoint
+	}:
+		return r.GetEndpoint().GetFrontgateId(), ""
+	case interface {
+		GetEndpoint() *pbtypes.ConfdEndpoint
+	}:
+		return r.GetEndpoint().GetFrontgateId(), ""
+	}
+	return "", ""
+}
+
+func isForwarded(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(forwardedByKey)) > 0
+}
+
+// holderOf returns the address of the other pilot replica holding the frontgate
+// channel, ok is false if the request should be handled by this replica
+func (p *Server) holderOf(ctx context.Context, req interface{}) (addr string, ok bool) {
+	if p.fgRegistry == nil || isForwarded(ctx) {
+		return "", false
+	}
+	id, nodeId := frontgateOf(req)
+	if len(id) == 0 || p.fgClientMgr.HasClient(id, nodeId) {
+		return "", false
+	}
+
+	addr, ok, err := p.fgRegistry.Lookup(ctx, id, nodeId)
+	if err != nil {
+		logger.Error(nil, "Lookup pilot of frontgate [%s] failed: %+v", id, err)
+		return "", false
+	}
+	if !ok || addr == p.fgRegistry.Addr() {
+		return "", false
+	}
+	return addr, true
+}
+
+// forwardInterceptor forwards the requests of frontgates not connected to this
+// replica, to the replica holding the frontgate channel
+func (p *Server) forwardInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	addr, ok := p.holderOf(ctx, req)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	logger.Debug(nil, "Forward [%s] to pilot [%s]", info.FullMethod, addr)
+	return p.forward(ctx, addr, info.FullMethod, req)
+}
+
+func dialReplica(addr string) (*grpc.ClientConn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, err
+	}
+	return manager.NewClient(host, portNum)
+}
+
+func (p *Server) forward(ctx context.Context, addr, fullMethod string, req interface{}) (interface{}, error) {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	m, ok := pilotServiceType.MethodByName(method)
+	if !ok {
+		return nil, fmt.Errorf("unknown pilot method [%s]", fullMethod)
+	}
+	reply := reflect.New(m.Type.Out(0).Elem()).Interface()
+
+	conn, err := dialReplica(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, forwardedByKey, p.cfg.Id)
+	if err = conn.Invoke(ctx, fullMethod, req, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// forwardRunCommandStream relays the command output from the replica holding the
+// frontgate channel, streaming requests are not intercepted by forwardInterceptor
+func (p *Server) forwardRunCommandStream(addr string, arg *pbtypes.RunCommandStreamRequest, srv pbpilot.PilotService_RunCommandStreamServer) error {
+	conn, err := dialReplica(addr)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug(nil, "Forward RunCommandStream to pilot [%s]", addr)
+	ctx := metadata.AppendToOutgoingContext(srv.Context(), forwardedByKey, p.cfg.Id)
+	stream, err := pbpilot.NewPilotServiceClient(conn).RunCommandStream(ctx, arg)
+	if err != nil {
+		return err
+	}
+	for {
+		out, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = srv.Send(out); err != nil {
+			return err
+		}
+	}
+}
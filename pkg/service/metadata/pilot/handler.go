@@ -87,10 +87,19 @@ func (p *Server) GetPilotClientTLSConfig(context.Context, *pbtypes.Empty) (*pbty
 	return cfg, nil
 }
 
-func (p *Server) GetFrontgateList(context.Context, *pbtypes.Empty) (*pbtypes.FrontgateIdList, error) {
+func (p *Server) GetFrontgateList(ctx context.Context, arg *pbtypes.Empty) (*pbtypes.FrontgateIdList, error) {
 	logger.Info(nil, funcutil.CallerName(1))
 
-	return nil, fmt.Errorf("TODO")
+	if p.fgRegistry == nil {
+		return &pbtypes.FrontgateIdList{IdList: p.fgClientMgr.GetClientIds()}, nil
+	}
+
+	ids, err := p.fgRegistry.List(ctx)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+	return &pbtypes.FrontgateIdList{IdList: ids}, nil
 }
 
 func (p *Server) GetFrontgateConfig(ctx context.Context, arg *pbtypes.FrontgateId) (*pbtypes.FrontgateConfig, error) {
@@ -201,8 +210,14 @@ func (p *Server) FrontgateChannel(ch pbpilot.PilotServiceForFrontgate_FrontgateC
 		return err
 	}
 
+	closed := p.fgClientMgr.PutClient(c, info)
+	if p.fgRegistry != nil {
+		p.fgRegistry.Register(info.Id, info.NodeId)
+		defer p.fgRegistry.Deregister(info.Id, info.NodeId)
+	}
+
 	// if return, the channel will be closed
-	<-p.fgClientMgr.PutClient(c, info)
+	<-closed
 	return nil
 }
 
@@ -458,13 +473,75 @@ func (p *Server) DeregisterCmd(ctx context.Context, arg *pbtypes.SubTask_Deregis
 	return &pbtypes.Empty{}, nil
 }
 
+func (p *Server) AbortCmd(ctx context.Context, arg *pbtypes.SubTask_AbortCmd) (*pbtypes.Empty, error) {
+	logger.Info(nil, "%s taskId: %s", funcutil.CallerName(1), arg.TaskId)
+
+	client, err := p.fgClientMgr.GetClient(arg.FrontgateId)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil && p.fgClientMgr.IsFrontgateShutdownError(err) {
+			p.fgClientMgr.CloseClient(client.info.Id, client.info.NodeId)
+		}
+	}()
+
+	_, err = client.AbortCmd(arg)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+
+	return &pbtypes.Empty{}, nil
+}
+
 func (p *Server) ReportSubTaskStatus(ctx context.Context, arg *pbtypes.SubTaskStatus) (*pbtypes.Empty, error) {
 	logger.Info(nil, "%s taskId: %s", funcutil.CallerName(1), arg.TaskId)
 
-	p.taskStatusMgr.PutStatus(*arg)
+	// frontgate retries the report when the status is not kept
+	err := p.taskStatusMgr.PutStatus(*arg)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+	return &pbtypes.Empty{}, nil
+}
+
+func (p *Server) ReportMonitorMetrics(ctx context.Context, arg *pbtypes.MonitorMetrics) (*pbtypes.Empty, error) {
+	logger.Debug(nil, "%s droneId: %s", funcutil.CallerName(1), arg.DroneId)
+
+	// frontgates may report to any replica, the metrics are kept by the replica
+	// holding the frontgate channel, where the queries of frontgate are forwarded to
+	if addr, ok := p.holderOf(ctx, arg); ok {
+		_, err := p.forward(ctx, addr, "/metadata.pilot.PilotService/ReportMonitorMetrics", arg)
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
+		return &pbtypes.Empty{}, nil
+	}
+
+	err := p.metricsStore.PutMetrics(arg)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
 	return &pbtypes.Empty{}, nil
 }
 
+func (p *Server) DescribeMonitorMetrics(ctx context.Context, arg *pbtypes.DescribeMonitorMetricsRequest) (*pbtypes.MonitorMetricsList, error) {
+	logger.Info(nil, funcutil.CallerName(1))
+
+	metricsList, err := p.metricsStore.QueryMetrics(arg)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return nil, err
+	}
+	return &pbtypes.MonitorMetricsList{MetricsList: metricsList}, nil
+}
+
 func (p *Server) GetSubtaskStatus(ctx context.Context, arg *pbtypes.SubTaskId) (*pbtypes.SubTaskStatus, error) {
 	logger.Info(nil, funcutil.CallerName(1))
 
@@ -534,10 +611,14 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
 	case pbtypes.SubTaskAction_RegisterMetadata.String():
@@ -562,10 +643,14 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
 	case pbtypes.SubTaskAction_DeregisterMetadata.String():
@@ -590,10 +675,14 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
 	case pbtypes.SubTaskAction_RegisterMetadataMapping.String():
@@ -618,10 +707,14 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
 	case pbtypes.SubTaskAction_DeregisterMetadataMapping.String():
@@ -646,10 +739,14 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
 	case pbtypes.SubTaskAction_RegisterCmd.String():
@@ -674,10 +771,14 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
 	case pbtypes.SubTaskAction_DeregisterCmd.String():
@@ -701,12 +802,31 @@ func (p *Server) HandleSubtask(ctx context.Context, msg *pbtypes.SubTaskMessage)
 			return nil, err
 		}
 
-		p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
+		err = p.taskStatusMgr.PutStatus(pbtypes.SubTaskStatus{
 			TaskId: x.TaskId,
 			Status: constants.StatusSuccessful,
 		})
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
 		return reply, nil
 
+	case pbtypes.SubTaskAction_AbortCmd.String():
+
+		var x pbtypes.SubTask_AbortCmd
+		err := json.Unmarshal([]byte(msg.Directive), &x)
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return nil, err
+		}
+
+		x.Action = msg.Action
+		x.TaskId = msg.TaskId
+
+		// the status of aborted task is reported by drone
+		return p.AbortCmd(ctx, &x)
+
 	case pbtypes.SubTaskAction_GetTaskStatus.String():
 
 		var x pbtypes.SubTask_GetTaskStatus
@@ -866,3 +986,67 @@ func (p *Server) RunCommandOnDrone(ctx context.Context, arg *pbtypes.RunCommandO
 
 	return reply, nil
 }
+
+func (p *Server) RunCommandStream(arg *pbtypes.RunCommandStreamRequest, srv pbpilot.PilotService_RunCommandStreamServer) error {
+	logger.Info(nil, funcutil.CallerName(1))
+
+	if addr, ok := p.holderOf(srv.Context(), arg); ok {
+		return p.forwardRunCommandStream(addr, arg, srv)
+	}
+
+	var client *FrontgateClient
+	var err error
+	if arg.GetDroneEndpoint() != nil {
+		client, err = p.fgClientMgr.GetClient(arg.GetDroneEndpoint().GetFrontgateId())
+	} else {
+		client, err = p.fgClientMgr.GetNodeClient(
+			arg.GetFrontgateEndpoint().GetFrontgateId(),
+			arg.GetFrontgateEndpoint().GetFrontgateNodeId(),
+		)
+	}
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+
+	defer func() {
+		if err != nil && p.fgClientMgr.IsFrontgateShutdownError(err) {
+			p.fgClientMgr.CloseClient(client.info.Id, client.info.NodeId)
+		}
+	}()
+
+	session, err := client.StartCommand(arg)
+	if err != nil {
+		logger.Warn(nil, "%+v", err)
+		return err
+	}
+
+	var out *pbtypes.CommandOutput
+	for {
+		select {
+		case <-srv.Context().Done():
+			// the client canceled or disconnected, kill the command
+			if _, err := client.CancelCommand(session); err != nil {
+				logger.Warn(nil, "Cancel command session [%s] failed: %+v", session.GetSessionId(), err)
+			}
+			return srv.Context().Err()
+		default:
+		}
+
+		out, err = client.ReadCommandOutput(session)
+		if err != nil {
+			logger.Warn(nil, "%+v", err)
+			return err
+		}
+		if len(out.GetStdout()) == 0 && len(out.GetStderr()) == 0 && !out.GetExited() {
+			continue
+		}
+		if err = srv.Send(out); err != nil {
+			logger.Warn(nil, "%+v", err)
+			return err
+		}
+		if out.GetExited() {
+			return nil
+		}
+	}
+}
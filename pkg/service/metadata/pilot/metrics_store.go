@@ -0,0 +1,168 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+const (
+	LocalMetricsStore       = "local"
+	DefaultMetricsRetention = 24 * time.Hour
+
+	// a day of the metrics collected every 10 seconds
+	maxLocalMetricsPoints = 8640
+)
+
+// MetricsStore keeps the monitor metrics of nodes reported by drones,
+// the metrics are cleaned up after retention since they were collected
+type MetricsStore interface {
+	PutMetrics(v *pbtypes.MonitorMetrics) error
+	// QueryMetrics returns the metrics of drones collected in the time range, in time order of each drone
+	QueryMetrics(req *pbtypes.DescribeMonitorMetricsRequest) ([]*pbtypes.MonitorMetrics, error)
+}
+
+type MetricsStoreFactory func(retention time.Duration) (MetricsStore, error)
+
+var metricsStoreFactories = map[string]MetricsStoreFactory{
+	LocalMetricsStore: func(retention time.Duration) (MetricsStore, error) {
+		return NewLocalMetricsStore(retention), nil
+	},
+}
+
+// RegisterMetricsStore makes the metrics store available by name for pilot,
+// it should be called in init of the package implementing the store
+func RegisterMetricsStore(name string, factory MetricsStoreFactory) {
+	metricsStoreFactories[name] = factory
+}
+
+func NewMetricsStore(name string, retention time.Duration) (MetricsStore, error) {
+	factory, ok := metricsStoreFactories[name]
+	if !ok {
+		return nil, fmt.Errorf("pilot: unknown metrics store [%s]", name)
+	}
+	return factory(retention)
+}
+
+func metricsKey(frontgateId, droneId string) string {
+	return frontgateId + "/" + droneId
+}
+
+func collectTimeOf(v *pbtypes.MonitorMetrics) time.Time {
+	return pbutil.FromProtoTimestamp(v.CollectTime)
+}
+
+// localMetricsStore keeps the time series of drones in memory, which are lost
+// when pilot restarts and only hold the metrics reported to this pilot replica
+type localMetricsStore struct {
+	series    map[string][]*pbtypes.MonitorMetrics // key=frontgateId/droneId
+	retention time.Duration
+	cleanupAt time.Time
+	sync.RWMutex
+}
+
+func NewLocalMetricsStore(retention time.Duration) MetricsStore {
+	return &localMetricsStore{
+		series:    make(map[string][]*pbtypes.MonitorMetrics),
+		retention: retention,
+		cleanupAt: time.Now().Add(retention),
+	}
+}
+
+// expire drops the points collected before t, the points are in time order
+func expire(points []*pbtypes.MonitorMetrics, t time.Time) []*pbtypes.MonitorMetrics {
+	i := sort.Search(len(points), func(i int) bool {
+		return !collectTimeOf(points[i]).Before(t)
+	})
+	return points[i:]
+}
+
+func (p *localMetricsStore) PutMetrics(v *pbtypes.MonitorMetrics) error {
+	if v.GetFrontgateId() == "" || v.GetDroneId() == "" || v.GetCollectTime() == nil {
+		return fmt.Errorf("pilot: invalid metrics, frontgate id, drone id and collect time are required")
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	now := time.Now()
+	key := metricsKey(v.FrontgateId, v.DroneId)
+	points := p.series[key]
+
+	// the metrics may arrive out of order when retried
+	t := collectTimeOf(v)
+	i := sort.Search(len(points), func(i int) bool {
+		return collectTimeOf(points[i]).After(t)
+	})
+	points = append(points, nil)
+	copy(points[i+1:], points[i:])
+	points[i] = v
+
+	points = expire(points, now.Add(-p.retention))
+	if len(points) > maxLocalMetricsPoints {
+		points = points[len(points)-maxLocalMetricsPoints:]
+	}
+	p.series[key] = points
+
+	if now.After(p.cleanupAt) {
+		for k, points := range p.series {
+			points = expire(points, now.Add(-p.retention))
+			if len(points) == 0 {
+				delete(p.series, k)
+			} else {
+				p.series[k] = points
+			}
+		}
+		p.cleanupAt = now.Add(p.retention)
+	}
+	return nil
+}
+
+func (p *localMetricsStore) QueryMetrics(req *pbtypes.DescribeMonitorMetricsRequest) ([]*pbtypes.MonitorMetrics, error) {
+	start := time.Now().Add(-p.retention)
+	if req.GetStartTime() != nil && pbutil.FromProtoTimestamp(req.StartTime).After(start) {
+		start = pbutil.FromProtoTimestamp(req.StartTime)
+	}
+	end := time.Now()
+	if req.GetEndTime() != nil {
+		end = pbutil.FromProtoTimestamp(req.EndTime)
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+
+	var keys []string
+	if len(req.GetDroneIdList()) == 0 {
+		prefix := metricsKey(req.GetFrontgateId(), "")
+		for key := range p.series {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+	} else {
+		for _, droneId := range req.DroneIdList {
+			keys = append(keys, metricsKey(req.GetFrontgateId(), droneId))
+		}
+	}
+
+	var result []*pbtypes.MonitorMetrics
+	for _, key := range keys {
+		for _, v := range expire(p.series[key], start) {
+			if collectTimeOf(v).After(end) {
+				break
+			}
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package pilot
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+	"openpitrix.io/openpitrix/pkg/util/pbutil"
+)
+
+func newTestMetrics(droneId string, t time.Time, value float64) *pbtypes.MonitorMetrics {
+	return &pbtypes.MonitorMetrics{
+		FrontgateId: "cl-frontgate",
+		DroneId:     droneId,
+		CollectTime: pbutil.ToProtoTimestamp(t),
+		Values:      map[string]float64{"conn_count": value},
+	}
+}
+
+func TestLocalMetricsStore(t *testing.T) {
+	store, err := NewMetricsStore(LocalMetricsStore, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	// expired, out of order and other drone
+	for _, v := range []*pbtypes.MonitorMetrics{
+		newTestMetrics("cln-1", now.Add(-2*time.Hour), 1),
+		newTestMetrics("cln-1", now.Add(-time.Minute), 2),
+		newTestMetrics("cln-1", now.Add(-3*time.Minute), 3),
+		newTestMetrics("cln-1", now.Add(-2*time.Minute), 4),
+		newTestMetrics("cln-2", now.Add(-time.Minute), 5),
+	} {
+		if err = store.PutMetrics(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = store.PutMetrics(&pbtypes.MonitorMetrics{DroneId: "cln-1"}); err == nil {
+		t.Fatal("Metrics without frontgate id and collect time should fail")
+	}
+
+	result, err := store.QueryMetrics(&pbtypes.DescribeMonitorMetricsRequest{
+		FrontgateId: "cl-frontgate",
+		DroneIdList: []string{"cln-1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []float64
+	for _, v := range result {
+		values = append(values, v.Values["conn_count"])
+	}
+	if len(values) != 3 || values[0] != 3 || values[1] != 4 || values[2] != 2 {
+		t.Fatalf("Unexpected values [%v] of drone", values)
+	}
+
+	result, err = store.QueryMetrics(&pbtypes.DescribeMonitorMetricsRequest{
+		FrontgateId: "cl-frontgate",
+		StartTime:   pbutil.ToProtoTimestamp(now.Add(-90 * time.Second)),
+		EndTime:     pbutil.ToProtoTimestamp(now),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || result[0].DroneId != "cln-1" || result[1].DroneId != "cln-2" {
+		t.Fatalf("Unexpected metrics [%+v] of frontgate", result)
+	}
+
+	if _, err = NewMetricsStore("unknown", time.Hour); err == nil {
+		t.Fatal("Unknown metrics store should fail")
+	}
+}
@@ -5,13 +5,16 @@
 package pilot
 
 import (
+	"net"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"openpitrix.io/openpitrix/pkg/etcd"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	pbpilot "openpitrix.io/openpitrix/pkg/pb/metadata/pilot"
@@ -23,10 +26,24 @@ type Server struct {
 	cfg           *pbtypes.PilotConfig
 	pbTlsCfg      *pbtypes.PilotTLSConfig
 	fgClientMgr   *FrontgateClientManager
-	taskStatusMgr *TaskStatusManager
+	fgRegistry    *FrontgateRegistry
+	taskStatusMgr TaskStatusManager
+	metricsStore  MetricsStore
 }
 
-func Serve(cfg *pbtypes.PilotConfig, pbTlsCfg *pbtypes.PilotTLSConfig, opts ...Options) {
+// Serve runs a single pilot, the sub task status is kept in memory,
+// the monitor metrics are kept in the local metrics store if metricsStore is nil
+func Serve(cfg *pbtypes.PilotConfig, pbTlsCfg *pbtypes.PilotTLSConfig, metricsStore MetricsStore, opts ...Options) {
+	serve(cfg, pbTlsCfg, nil, DefaultTaskStatusTTL, metricsStore, opts...)
+}
+
+// ServeWithEtcd runs a pilot replica sharing the sub task status and frontgate
+// channels with other replicas by etcd, so the replicas can serve behind one endpoint
+func ServeWithEtcd(cfg *pbtypes.PilotConfig, pbTlsCfg *pbtypes.PilotTLSConfig, e *etcd.Etcd, taskStatusTTL time.Duration, metricsStore MetricsStore, opts ...Options) {
+	serve(cfg, pbTlsCfg, e, taskStatusTTL, metricsStore, opts...)
+}
+
+func serve(cfg *pbtypes.PilotConfig, pbTlsCfg *pbtypes.PilotTLSConfig, e *etcd.Etcd, taskStatusTTL time.Duration, metricsStore MetricsStore, opts ...Options) {
 	if cfg != nil {
 		cfg = proto.Clone(cfg).(*pbtypes.PilotConfig)
 	} else {
@@ -37,26 +54,45 @@ func Serve(cfg *pbtypes.PilotConfig, pbTlsCfg *pbtypes.PilotTLSConfig, opts ...O
 		fn(cfg)
 	}
 
+	if metricsStore == nil {
+		metricsStore = NewLocalMetricsStore(DefaultMetricsRetention)
+	}
+
 	p := &Server{
 		cfg:           cfg,
 		pbTlsCfg:      proto.Clone(pbTlsCfg).(*pbtypes.PilotTLSConfig),
 		fgClientMgr:   NewFrontgateClientManager(),
-		taskStatusMgr: NewTaskStatusManager(),
+		taskStatusMgr: NewTaskStatusManager(taskStatusTTL),
+		metricsStore:  metricsStore,
+	}
+
+	if e != nil {
+		addr := net.JoinHostPort(p.cfg.Host, strconv.Itoa(int(p.cfg.ListenPort)))
+		fgRegistry, err := NewFrontgateRegistry(e, addr)
+		if err != nil {
+			logger.Critical(nil, "%+v", err)
+			os.Exit(1)
+		}
+		p.fgRegistry = fgRegistry
+		p.taskStatusMgr = NewEtcdTaskStatusManager(e, taskStatusTTL)
 	}
 
 	go func() {
 		for {
 			p.fgClientMgr.CheckAllClient()
+			if p.fgRegistry != nil {
+				p.fgRegistry.KeepAlive()
+			}
 			time.Sleep(time.Second * 10)
 		}
 	}()
 
 	// internal service
-	go manager.NewGrpcServer("pilot-service", int(p.cfg.ListenPort)).Serve(
-		func(server *grpc.Server) {
+	go manager.NewGrpcServer("pilot-service", int(p.cfg.ListenPort)).
+		WithUnaryInterceptor(p.forwardInterceptor).
+		Serve(func(server *grpc.Server) {
 			pbpilot.RegisterPilotServiceServer(server, p)
-		},
-	)
+		})
 
 	// tls for public service
 	if pbTlsCfg != nil {
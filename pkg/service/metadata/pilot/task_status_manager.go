@@ -5,33 +5,177 @@
 package pilot
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"sync"
+	"time"
 
+	"go.etcd.io/etcd/clientv3"
+
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
 	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
 )
 
-type TaskStatusManager struct {
-	db map[string]pbtypes.SubTaskStatus
+const (
+	DefaultTaskStatusTTL = 24 * time.Hour
+
+	taskStatusPrefix  = "pilot/subtask-status/"
+	taskStatusTimeout = 5 * time.Second
+	// the status put in 1/24 of ttl shares a lease
+	taskStatusLeaseBuckets = 24
+)
+
+// TaskStatusManager keeps the status of sub tasks reported by drones,
+// task manager polls the status with GetSubtaskStatus.
+// The status is cleaned up after ttl since it was put, PutStatus returns error
+// when the status is not kept, so the reporter is able to retry.
+type TaskStatusManager interface {
+	GetStatus(id string) (v pbtypes.SubTaskStatus, ok bool)
+	PutStatus(v pbtypes.SubTaskStatus) error
+}
+
+type taskStatusEntry struct {
+	status   pbtypes.SubTaskStatus
+	expireAt time.Time
+}
+
+// memTaskStatusManager keeps the status in memory, which is lost when pilot
+// restarts and can not be shared by pilot replicas
+type memTaskStatusManager struct {
+	db        map[string]taskStatusEntry
+	ttl       time.Duration
+	cleanupAt time.Time
+	sync.Mutex
+}
+
+func NewTaskStatusManager(ttl time.Duration) TaskStatusManager {
+	return &memTaskStatusManager{
+		db:        make(map[string]taskStatusEntry),
+		ttl:       ttl,
+		cleanupAt: time.Now().Add(ttl),
+	}
+}
+
+func (p *memTaskStatusManager) GetStatus(id string) (v pbtypes.SubTaskStatus, ok bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	e, ok := p.db[id]
+	if !ok || time.Now().After(e.expireAt) {
+		return v, false
+	}
+	return e.status, true
+}
+
+func (p *memTaskStatusManager) PutStatus(v pbtypes.SubTaskStatus) error {
+	p.Lock()
+	defer p.Unlock()
+
+	now := time.Now()
+	p.db[v.TaskId] = taskStatusEntry{status: v, expireAt: now.Add(p.ttl)}
+
+	if now.After(p.cleanupAt) {
+		for id, e := range p.db {
+			if now.After(e.expireAt) {
+				delete(p.db, id)
+			}
+		}
+		p.cleanupAt = now.Add(p.ttl)
+	}
+	return nil
+}
+
+// etcdTaskStatusManager keeps the status in etcd with a lease of ttl,
+// so the status survives pilot restarts and is visible to all pilot replicas.
+// The status put in the same bucket shares the lease, which expires after
+// ttl+bucket, so a lease is not granted for every report.
+type etcdTaskStatusManager struct {
+	etcd   *etcd.Etcd
+	ttl    time.Duration
+	bucket time.Duration
+
+	lease         clientv3.LeaseID
+	leaseBucketAt time.Time
 	sync.Mutex
 }
 
-func NewTaskStatusManager() *TaskStatusManager {
-	return &TaskStatusManager{
-		db: make(map[string]pbtypes.SubTaskStatus),
+func NewEtcdTaskStatusManager(e *etcd.Etcd, ttl time.Duration) TaskStatusManager {
+	bucket := ttl / taskStatusLeaseBuckets
+	if bucket < time.Second {
+		bucket = time.Second
+	}
+	return &etcdTaskStatusManager{
+		etcd:   e,
+		ttl:    ttl,
+		bucket: bucket,
+	}
+}
+
+func (p *etcdTaskStatusManager) GetStatus(id string) (v pbtypes.SubTaskStatus, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), taskStatusTimeout)
+	defer cancel()
+
+	resp, err := p.etcd.Get(ctx, taskStatusPrefix+id)
+	if err != nil {
+		logger.Error(nil, "Get sub task [%s] status from etcd failed: %+v", id, err)
+		return v, false
 	}
+	if len(resp.Kvs) == 0 {
+		return v, false
+	}
+	if err = json.Unmarshal(resp.Kvs[0].Value, &v); err != nil {
+		logger.Error(nil, "Decode sub task [%s] status [%s] failed: %+v", id, string(resp.Kvs[0].Value), err)
+		return v, false
+	}
+	return v, true
 }
 
-func (p *TaskStatusManager) GetStatus(id string) (v pbtypes.SubTaskStatus, ok bool) {
+// getLease returns the lease of current bucket, it is granted at the first put of the bucket
+func (p *etcdTaskStatusManager) getLease(ctx context.Context) (clientv3.LeaseID, error) {
 	p.Lock()
 	defer p.Unlock()
 
-	v, ok = p.db[id]
-	return
+	now := time.Now()
+	if p.lease != clientv3.NoLease && now.Before(p.leaseBucketAt.Add(p.bucket)) {
+		return p.lease, nil
+	}
+	lease, err := p.etcd.Grant(ctx, int64((p.ttl+p.bucket)/time.Second))
+	if err != nil {
+		return clientv3.NoLease, err
+	}
+	p.lease = lease.ID
+	p.leaseBucketAt = now
+	return p.lease, nil
 }
 
-func (p *TaskStatusManager) PutStatus(v pbtypes.SubTaskStatus) {
+// resetLease drops the lease, e.g. it is revoked, so the next put grants a new one
+func (p *etcdTaskStatusManager) resetLease(lease clientv3.LeaseID) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.db[v.TaskId] = v
+	if p.lease == lease {
+		p.lease = clientv3.NoLease
+	}
+}
+
+func (p *etcdTaskStatusManager) PutStatus(v pbtypes.SubTaskStatus) error {
+	ctx, cancel := context.WithTimeout(context.Background(), taskStatusTimeout)
+	defer cancel()
+
+	data, err := json.Marshal(&v)
+	if err != nil {
+		return fmt.Errorf("pilot: encode sub task [%s] status failed: %+v", v.TaskId, err)
+	}
+	lease, err := p.getLease(ctx)
+	if err != nil {
+		return fmt.Errorf("pilot: grant lease for sub task [%s] status failed: %+v", v.TaskId, err)
+	}
+	_, err = p.etcd.Put(ctx, taskStatusPrefix+v.TaskId, string(data), clientv3.WithLease(lease))
+	if err != nil {
+		p.resetLease(lease)
+		return fmt.Errorf("pilot: put sub task [%s] status to etcd failed: %+v", v.TaskId, err)
+	}
+	return nil
 }
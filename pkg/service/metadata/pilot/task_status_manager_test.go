@@ -0,0 +1,162 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// +build etcd
+
+package pilot
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"openpitrix.io/openpitrix/pkg/config/test_config"
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	pbtypes "openpitrix.io/openpitrix/pkg/pb/metadata/types"
+)
+
+var tc = test_config.NewEtcdTestConfig()
+
+func testTaskStatusManager(t *testing.T, newMgr func(ttl time.Duration) TaskStatusManager) {
+	taskId := fmt.Sprintf("t-%d", rand.Intn(100000))
+
+	mgr := newMgr(time.Minute)
+	if _, ok := mgr.GetStatus(taskId); ok {
+		t.Fatalf("status of task [%s] should not exist", taskId)
+	}
+	for _, status := range []string{constants.StatusWorking, constants.StatusSuccessful} {
+		if err := mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: taskId, Status: status}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, ok := mgr.GetStatus(taskId)
+	if !ok || s.Status != constants.StatusSuccessful {
+		t.Fatalf("unexpected status [%+v] of task [%s]", s, taskId)
+	}
+
+	// expired status
+	mgr = newMgr(time.Second)
+	if err := mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: taskId, Status: constants.StatusFailed}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Second)
+	if s, ok = mgr.GetStatus(taskId); ok {
+		t.Fatalf("status [%+v] of task [%s] should be expired", s, taskId)
+	}
+}
+
+func TestMemTaskStatusManager(t *testing.T) {
+	testTaskStatusManager(t, NewTaskStatusManager)
+}
+
+func TestEtcdTaskStatusManager(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testTaskStatusManager(t, func(ttl time.Duration) TaskStatusManager {
+		return NewEtcdTaskStatusManager(e, ttl)
+	})
+
+	// status is shared by pilot replicas
+	taskId := fmt.Sprintf("t-%d", rand.Intn(100000))
+	mgr := NewEtcdTaskStatusManager(e, time.Minute)
+	if err = mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: taskId, Status: constants.StatusSuccessful}); err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := NewEtcdTaskStatusManager(e, time.Minute).GetStatus(taskId); !ok || s.Status != constants.StatusSuccessful {
+		t.Fatalf("unexpected status [%+v] of task [%s]", s, taskId)
+	}
+
+	// status put in the same bucket shares the lease
+	otherTaskId := taskId + "-1"
+	if err = mgr.PutStatus(pbtypes.SubTaskStatus{TaskId: otherTaskId, Status: constants.StatusSuccessful}); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	r1, err := e.Get(ctx, taskStatusPrefix+taskId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := e.Get(ctx, taskStatusPrefix+otherTaskId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r1.Kvs) == 0 || len(r2.Kvs) == 0 || r1.Kvs[0].Lease != r2.Kvs[0].Lease {
+		t.Fatalf("status of task [%s] and [%s] should share the lease", taskId, otherTaskId)
+	}
+}
+
+func TestFrontgateRegistry(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	fgId := fmt.Sprintf("cl-fg-%d", rand.Intn(100000))
+
+	r1, err := NewFrontgateRegistry(e, "pilot-1:9110")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := NewFrontgateRegistry(e, "pilot-2:9110")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r1.Register(fgId, "cln-1")
+	r2.Register(fgId, "cln-2")
+
+	tests := []struct {
+		nodeId string
+		addr   string
+	}{
+		{"cln-1", "pilot-1:9110"},
+		{"cln-2", "pilot-2:9110"},
+		{"", "pilot-1:9110"},
+	}
+	for _, tt := range tests {
+		addr, ok, err := r2.Lookup(ctx, fgId, tt.nodeId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok || addr != tt.addr {
+			t.Errorf("expect frontgate (%s/%s) held by [%s], got [%s]", fgId, tt.nodeId, tt.addr, addr)
+		}
+	}
+
+	ids, err := r1.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, id := range ids {
+		found = found || id == fgId
+	}
+	if !found {
+		t.Errorf("frontgate [%s] not in list %v", fgId, ids)
+	}
+
+	// channel reconnected to the same replica is still registered
+	r1.Register(fgId, "cln-1")
+	r1.Deregister(fgId, "cln-1")
+	if _, ok, _ := r2.Lookup(ctx, fgId, "cln-1"); !ok {
+		t.Errorf("frontgate (%s/cln-1) should be registered", fgId)
+	}
+	r1.Deregister(fgId, "cln-1")
+	if _, ok, _ := r2.Lookup(ctx, fgId, "cln-1"); ok {
+		t.Errorf("frontgate (%s/cln-1) should be deregistered", fgId)
+	}
+
+	// records of a gone replica are removed
+	r2.lease.Close()
+	if _, ok, _ := r1.Lookup(ctx, fgId, "cln-2"); ok {
+		t.Errorf("frontgate (%s/cln-2) should be removed with the replica", fgId)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
@@ -43,8 +44,11 @@ func (p *Server) DescribeRepoEvents(ctx context.Context, req *pb.DescribeRepoEve
 		Limit(limit).
 		Where(manager.BuildPermissionFilter(ctx)).
 		Where(manager.BuildFilterConditions(req, constants.TableRepoEvent))
-	query = manager.AddQueryOrderDir(query, req, constants.ColumnCreateTime)
-	_, err := query.Load(&repoEvents)
+	query, cursor, err := manager.AddQueryCursor(query, req, constants.ColumnCreateTime, constants.ColumnRepoEventId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalPageToken)
+	}
+	_, err = query.Load(&repoEvents)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 	}
@@ -52,9 +56,30 @@ func (p *Server) DescribeRepoEvents(ctx context.Context, req *pb.DescribeRepoEve
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 	}
+	nextPageToken, err := cursor.NextPageToken(repoEvents, limit)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
 	res := &pb.DescribeRepoEventsResponse{
-		RepoEventSet: models.RepoEventsToPbs(repoEvents),
-		TotalCount:   count,
+		RepoEventSet:  models.RepoEventsToPbs(repoEvents),
+		TotalCount:    count,
+		NextPageToken: pbutil.ToProtoString(nextPageToken),
 	}
 	return res, nil
 }
+
+// ExportRepoEvents streams all the repository events by pages of the max size, following the page token of previous page
+func (p *Server) ExportRepoEvents(req *pb.DescribeRepoEventsRequest, srv pb.RepoIndexer_ExportRepoEventsServer) error {
+	ctx := srv.Context()
+	req.Limit = db.DefaultSelectLimit
+	return db.ExportPages(req.GetPageToken().GetValue(),
+		func(pageToken string) (interface{}, string, error) {
+			req.PageToken = pbutil.ToProtoString(pageToken)
+			res, err := p.DescribeRepoEvents(ctx, req)
+			return res, res.GetNextPageToken().GetValue(), err
+		},
+		func(page interface{}) error {
+			return srv.Send(page.(*pb.DescribeRepoEventsResponse))
+		},
+	)
+}
@@ -14,6 +14,7 @@ import (
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/gerr"
+	"openpitrix.io/openpitrix/pkg/kms"
 	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
@@ -348,6 +349,10 @@ func (p *Server) createRuntimeCredential(ctx context.Context, req *pb.CreateRunt
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorCreateResourcesFailed)
 		}
+		content, err = sealRuntimeCredentialContent(ctx, content)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+		}
 
 		_, err = pi.Global().DB(ctx).InsertBySql(
 			`insert into runtime_credential (runtime_credential_id, name, description, runtime_url, runtime_credential_content, provider, owner, owner_path, status, debug)
@@ -385,22 +390,34 @@ on duplicate key update runtime_credential_id = ?, name = ?, description = ?, ru
 		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorCreateResourcesFailed)
 	}
 
+	// the content is encrypted with a random data key, so it can not be compared in db
+	var existRuntimeCredentials []*models.RuntimeCredential
 	query := pi.Global().DB(ctx).
 		Select(models.RuntimeCredentialColumns...).
 		From(constants.TableRuntimeCredential).
 		Where(db.Eq(constants.ColumnRuntimeUrl, req.GetRuntimeUrl().GetValue())).
-		Where(db.Eq(constants.ColumnRuntimeCredentialContent, content)).
 		Where(db.Eq(constants.ColumnProvider, req.GetProvider().GetValue())).
 		Where(db.Eq(constants.ColumnDebug, debug)).
 		Where(db.Eq(constants.ColumnOwner, s.GetOwnerPath().Owner())).
 		Where(db.Eq(constants.ColumnOwnerPath, s.GetOwnerPath()))
 
-	count, err := query.Count()
+	_, err = query.Load(&existRuntimeCredentials)
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
 	}
-	if count > 0 {
-		return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorRuntimeCredentialExists)
+	err = openRuntimeCredentials(ctx, existRuntimeCredentials...)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+	for _, existRuntimeCredential := range existRuntimeCredentials {
+		if sameRuntimeCredentialContent(existRuntimeCredential.RuntimeCredentialContent, content) {
+			return nil, gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorRuntimeCredentialExists)
+		}
+	}
+
+	sealedContent, err := sealRuntimeCredentialContent(ctx, content)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
 	}
 
 	newRuntimeCredential := models.NewRuntimeCredential(
@@ -408,7 +425,7 @@ on duplicate key update runtime_credential_id = ?, name = ?, description = ?, ru
 		req.GetName().GetValue(),
 		req.GetDescription().GetValue(),
 		req.GetRuntimeUrl().GetValue(),
-		content,
+		sealedContent,
 		req.GetProvider().GetValue(),
 		s.GetOwnerPath(),
 		debug,
@@ -562,6 +579,10 @@ func (p *Server) ModifyRuntimeCredential(ctx context.Context, req *pb.ModifyRunt
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorModifyResourceFailed, runtimeCredentialId)
 		}
+		newContent, err = sealRuntimeCredentialContent(ctx, newContent)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourceFailed, runtimeCredentialId)
+		}
 		attributes[constants.ColumnRuntimeCredentialContent] = newContent
 	}
 
@@ -693,3 +714,83 @@ func (p *Server) GetRuntimeStatistics(ctx context.Context, req *pb.GetRuntimeSta
 
 	return res, nil
 }
+
+// checkCredentialKeyAdminPermission allows only the global admin to rotate credential key,
+// since credentials of all users are re-encrypted
+func checkCredentialKeyAdminPermission(ctx context.Context) error {
+	s := ctxutil.GetSender(ctx)
+	if s != nil && s.GetAccessPath() != "" {
+		err := fmt.Errorf("user [%s] can not rotate credential key", s.UserId)
+		return gerr.NewWithDetail(ctx, gerr.PermissionDenied, err, gerr.ErrorPermissionDenied)
+	}
+	return nil
+}
+
+// RotateCredentialKey re-encrypts the runtime credentials which were not encrypted
+// by the primary master key, then the old master keys can be removed.
+func (p *Server) RotateCredentialKey(ctx context.Context, req *pb.RotateCredentialKeyRequest) (*pb.RotateCredentialKeyResponse, error) {
+	err := checkCredentialKeyAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if credentialKeyManager == nil {
+		return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorCredentialKeyNotConfigured)
+	}
+	primaryKeyId := credentialKeyManager.PrimaryKeyId()
+
+	var runtimeCredentials []*models.RuntimeCredential
+	query := pi.Global().DB(ctx).
+		Select(models.RuntimeCredentialColumns...).
+		From(constants.TableRuntimeCredential)
+	if runtimeCredentialIds := req.GetRuntimeCredentialId(); len(runtimeCredentialIds) > 0 {
+		query = query.Where(db.Eq(constants.ColumnRuntimeCredentialId, runtimeCredentialIds))
+	}
+	_, err = query.Load(&runtimeCredentials)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
+
+	var rotatedIds []string
+	for _, runtimeCredential := range runtimeCredentials {
+		if keyId, ok := kms.KeyIdOf(runtimeCredential.RuntimeCredentialContent); ok && keyId == primaryKeyId {
+			continue
+		}
+		sealedContent := runtimeCredential.RuntimeCredentialContent
+		err = openRuntimeCredentials(ctx, runtimeCredential)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+		content, err := sealRuntimeCredentialContent(ctx, runtimeCredential.RuntimeCredentialContent)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+		// the content modified after it was read must not be overwritten
+		result, err := pi.Global().DB(ctx).
+			Update(constants.TableRuntimeCredential).
+			Set(constants.ColumnRuntimeCredentialContent, content).
+			Where(db.Eq(constants.ColumnRuntimeCredentialId, runtimeCredential.RuntimeCredentialId)).
+			Where(db.Eq(constants.ColumnRuntimeCredentialContent, sealedContent)).
+			Exec()
+		if err != nil {
+			logger.Error(ctx, "Failed to update runtime credential [%s]: %+v", runtimeCredential.RuntimeCredentialId, err)
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+		}
+		if rowsAffected == 0 {
+			// the modified content is sealed with the primary key already
+			logger.Warn(ctx, "Runtime credential [%s] is modified while rotating, skipped", runtimeCredential.RuntimeCredentialId)
+			continue
+		}
+		rotatedIds = append(rotatedIds, runtimeCredential.RuntimeCredentialId)
+	}
+	logger.Info(ctx, "Rotated [%d] runtime credentials to master key [%s]", len(rotatedIds), primaryKeyId)
+
+	res := &pb.RotateCredentialKeyResponse{
+		KeyId:               pbutil.ToProtoString(primaryKeyId),
+		RuntimeCredentialId: rotatedIds,
+	}
+	return res, nil
+}
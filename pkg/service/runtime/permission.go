@@ -92,6 +92,10 @@ func CheckRuntimeCredentialsPermission(ctx context.Context, resourceIds []string
 	if len(runtimecredentials) == 0 {
 		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, resourceIds)
 	}
+	err = openRuntimeCredentials(ctx, runtimecredentials...)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
 	return runtimecredentials, nil
 }
 
@@ -118,5 +122,9 @@ func CheckRuntimeCredentialPermission(ctx context.Context, resourceId string) (*
 	if len(runtimecredentials) == 0 {
 		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, resourceId)
 	}
+	err = openRuntimeCredentials(ctx, runtimecredentials[0])
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
+	}
 	return runtimecredentials[0], nil
 }
@@ -6,19 +6,53 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/ghodss/yaml"
 
 	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/db"
+	"openpitrix.io/openpitrix/pkg/kms"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/models"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/plugins"
 )
 
+// credentialKeyManager seals the runtime credential content stored in db,
+// the content is stored in plaintext when it is nil
+var credentialKeyManager kms.KeyManager
+
+func sealRuntimeCredentialContent(ctx context.Context, content string) (string, error) {
+	return kms.Seal(ctx, credentialKeyManager, content)
+}
+
+// openRuntimeCredentials decrypts the contents loaded from db in place
+func openRuntimeCredentials(ctx context.Context, runtimeCredentials ...*models.RuntimeCredential) error {
+	for _, runtimeCredential := range runtimeCredentials {
+		content, err := kms.Open(ctx, credentialKeyManager, runtimeCredential.RuntimeCredentialContent)
+		if err != nil {
+			logger.Error(ctx, "Failed to decrypt runtime credential [%s]: %+v", runtimeCredential.RuntimeCredentialId, err)
+			return err
+		}
+		runtimeCredential.RuntimeCredentialContent = content
+	}
+	return nil
+}
+
+// sameRuntimeCredentialContent compares json contents, mysql normalizes the json it stores
+func sameRuntimeCredentialContent(a, b string) bool {
+	var x, y interface{}
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(x, y)
+}
+
 func getRuntimeCredentials(ctx context.Context, credentialIds ...string) ([]*models.RuntimeCredential, error) {
 	if len(credentialIds) == 0 {
 		return nil, nil
@@ -33,6 +67,10 @@ func getRuntimeCredentials(ctx context.Context, credentialIds ...string) ([]*mod
 	if err != nil {
 		return nil, err
 	}
+	err = openRuntimeCredentials(ctx, runtimeCredentials...)
+	if err != nil {
+		return nil, err
+	}
 	return runtimeCredentials, nil
 }
 
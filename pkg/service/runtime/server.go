@@ -9,6 +9,8 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/config"
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/kms"
+	"openpitrix.io/openpitrix/pkg/logger"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/pb"
 	"openpitrix.io/openpitrix/pkg/pi"
@@ -19,6 +21,16 @@ type Server struct {
 
 func Serve(cfg *config.Config) {
 	pi.SetGlobal(cfg)
+	km, err := kms.NewKeyManager(cfg.Credential)
+	if err != nil {
+		logger.Critical(nil, "Failed to load credential key manager: %+v", err)
+		panic(err)
+	}
+	if km == nil {
+		logger.Warn(nil, "No credential master key configured, runtime credentials will be stored in plaintext")
+	}
+	credentialKeyManager = km
+
 	s := Server{}
 	manager.NewGrpcServer("runtime-manager", constants.RuntimeManagerPort).
 		ShowErrorCause(cfg.Grpc.ShowErrorCause).
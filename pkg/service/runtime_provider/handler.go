@@ -103,6 +103,16 @@ func (p *Server) WaitSubtask(ctx context.Context, req *pb.WaitSubtaskRequest) (*
 	return providerClient.WaitSubtask(ctx, req)
 }
 
+func (p *Server) AbortSubtask(ctx context.Context, req *pb.AbortSubtaskRequest) (*pb.AbortSubtaskResponse, error) {
+	runtimeId := req.GetRuntimeId().GetValue()
+	providerClient, err := getProviderClient(ctx, runtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return providerClient.AbortSubtask(ctx, req)
+}
+
 func (p *Server) DescribeSubnets(ctx context.Context, req *pb.DescribeSubnetsRequest) (*pb.DescribeSubnetsResponse, error) {
 	runtimeId := req.GetRuntimeId().GetValue()
 	providerClient, err := getProviderClient(ctx, runtimeId)
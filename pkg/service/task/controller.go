@@ -6,10 +6,13 @@ package task
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/status"
+
 	pilotclient "openpitrix.io/openpitrix/pkg/client/pilot"
 	providerclient "openpitrix.io/openpitrix/pkg/client/runtime_provider"
 	"openpitrix.io/openpitrix/pkg/constants"
@@ -23,24 +26,31 @@ import (
 	"openpitrix.io/openpitrix/pkg/pi"
 	"openpitrix.io/openpitrix/pkg/plugins/vmbased"
 	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/topic"
 	"openpitrix.io/openpitrix/pkg/util/ctxutil"
 	"openpitrix.io/openpitrix/pkg/util/funcutil"
 	"openpitrix.io/openpitrix/pkg/util/jsonutil"
 	"openpitrix.io/openpitrix/pkg/util/pbutil"
 	"openpitrix.io/openpitrix/pkg/util/retryutil"
+	"openpitrix.io/openpitrix/pkg/util/semaphoreutil"
 )
 
 type Controller struct {
 	runningTasks chan string
-	runningCount int32
-	hostname     string
-	queue        *etcd.Queue
+	// slots of working tasks in this task manager, resized with MaxWorkingTasks
+	workers    *semaphoreutil.Weighted
+	hostname   string
+	queue      *etcd.Queue
+	lease      *etcd.Lease
+	leaseMutex sync.RWMutex
 }
 
+var errLeaseExpired = fmt.Errorf("executor lease expired, task will be resumed by others")
+
 func NewController(hostname string) *Controller {
 	return &Controller{
 		runningTasks: make(chan string),
-		runningCount: 0,
+		workers:      semaphoreutil.NewWeighted(constants.DefaultMaxWorkingTasks),
 		hostname:     hostname,
 		queue:        pi.Global().Etcd(context.Background()).NewQueue("task"),
 	}
@@ -56,7 +66,36 @@ func (c *Controller) updateTaskAttributes(ctx context.Context, taskId string, at
 	return err
 }
 
-var mutex sync.Mutex
+func (c *Controller) getLease() *etcd.Lease {
+	c.leaseMutex.RLock()
+	defer c.leaseMutex.RUnlock()
+	return c.lease
+}
+
+func (c *Controller) newLease(ctx context.Context) *etcd.Lease {
+	for {
+		lease, err := pi.Global().Etcd(ctx).NewLease(constants.ExecutorLeaseTTL)
+		if err != nil {
+			logger.Error(ctx, "Failed to create executor lease: %+v", err)
+			time.Sleep(constants.ExecutorLeaseRetryInterval)
+			continue
+		}
+		c.leaseMutex.Lock()
+		c.lease = lease
+		c.leaseMutex.Unlock()
+		return lease
+	}
+}
+
+// KeepLease renews the executor lease when it expired, tasks held by the expired
+// lease will be taken over by RecoverWorkingTasks
+func (c *Controller) KeepLease(ctx context.Context, lease *etcd.Lease) {
+	for {
+		<-lease.Done()
+		logger.Critical(ctx, "Executor lease [%d] expired, renew it", lease.Lease())
+		lease = c.newLease(ctx)
+	}
+}
 
 func (c *Controller) GetTaskLength() int32 {
 	if pi.Global().GlobalConfig().Task.MaxWorkingTasks > 0 {
@@ -66,32 +105,306 @@ func (c *Controller) GetTaskLength() int32 {
 	}
 }
 
-func (c *Controller) IsRunningExceed() bool {
-	mutex.Lock()
-	defer mutex.Unlock()
-	count := c.runningCount
-	return count > c.GetTaskLength()
+// acquireWorker blocks until there is a free slot for working task
+func (c *Controller) acquireWorker(ctx context.Context) error {
+	c.workers.Resize(int64(c.GetTaskLength()))
+	return c.workers.Acquire(ctx, 1)
 }
 
-func (c *Controller) UpdateWorkingTasks(ctx context.Context) error {
-	//TODO: retry the tasks
-	_, err := pi.Global().DB(ctx).
+func (c *Controller) releaseWorker() {
+	c.workers.Release(1)
+}
+
+// getSemaphores returns the semaphores limiting working tasks of the runtime and the owner,
+// tasks running in pilot are not limited by runtime
+func (c *Controller) getSemaphores(task *models.Task) []*etcd.Semaphore {
+	taskConfig := pi.Global().GlobalConfig().Task
+	var semaphores []*etcd.Semaphore
+	if taskConfig.MaxWorkingTasksPerRuntime > 0 && task.Target != "" && task.Target != constants.TargetPilot {
+		semaphores = append(semaphores, pi.Global().Etcd(nil).
+			NewSemaphore(constants.TaskRuntimeSemaphorePrefix+task.Target, taskConfig.MaxWorkingTasksPerRuntime))
+	}
+	if taskConfig.MaxWorkingTasksPerOwner > 0 && task.Owner != "" {
+		semaphores = append(semaphores, pi.Global().Etcd(nil).
+			NewSemaphore(constants.TaskOwnerSemaphorePrefix+task.Owner, taskConfig.MaxWorkingTasksPerOwner))
+	}
+	return semaphores
+}
+
+func (c *Controller) enqueueTask(task *models.Task) error {
+	return c.queue.EnqueueWithPriority(task.TaskId, uint16(task.Priority))
+}
+
+// requeueTask puts the task back to the queue, the worker is held for a while,
+// so that tasks exceeding the limits do not keep the workers busy
+func (c *Controller) requeueTask(ctx context.Context, task *models.Task) {
+	err := c.enqueueTask(task)
+	if err != nil {
+		logger.Critical(ctx, "Failed to requeue task [%s]: %+v", task.TaskId, err)
+		return
+	}
+	time.Sleep(constants.ConcurrencyLimitRetryInterval)
+}
+
+// DispatchRetryTasks enqueues the failed tasks retried by policy when their retry time is reached
+func (c *Controller) DispatchRetryTasks(ctx context.Context) {
+	for {
+		var tasks []*models.Task
+		_, err := pi.Global().DB(ctx).
+			Select(models.TaskColumns...).
+			From(constants.TableTask).
+			Where(db.Eq(constants.ColumnStatus, constants.StatusPending)).
+			Where(db.Lte(constants.ColumnRetryTime, time.Now())).
+			Load(&tasks)
+		if err != nil {
+			logger.Error(ctx, "Failed to get tasks to retry: %+v", err)
+		}
+		for _, task := range tasks {
+			c.dispatchRetryTask(ctx, task)
+		}
+		time.Sleep(constants.DispatchRetryTasksInterval)
+	}
+}
+
+// dispatchRetryTask claims the task by clearing its retry time,
+// so that the task is enqueued by only one task manager
+func (c *Controller) dispatchRetryTask(ctx context.Context, task *models.Task) {
+	result, err := pi.Global().DB(ctx).
 		Update(constants.TableTask).
-		SetMap(map[string]interface{}{constants.ColumnStatus: constants.StatusFailed}).
-		Where(db.Eq(constants.ColumnStatus, constants.StatusWorking)).
+		SetMap(map[string]interface{}{
+			constants.ColumnRetryTime: nil,
+		}).
+		Where(db.Eq(constants.ColumnTaskId, task.TaskId)).
+		Where(db.Eq(constants.ColumnStatus, constants.StatusPending)).
+		Where(db.Lte(constants.ColumnRetryTime, time.Now())).
 		Exec()
-	return err
+	if err != nil {
+		logger.Error(ctx, "Failed to claim task [%s] to retry: %+v", task.TaskId, err)
+		return
+	}
+	count, err := result.RowsAffected()
+	// dispatched by others, retried by hand or cancelled
+	if err != nil || count == 0 {
+		return
+	}
+	err = c.enqueueTask(task)
+	if err != nil {
+		logger.Error(ctx, "Failed to enqueue task [%s] to retry: %+v", task.TaskId, err)
+		// try again in the next round
+		err = c.updateTaskAttributes(ctx, task.TaskId, map[string]interface{}{
+			constants.ColumnRetryTime: task.RetryTime,
+		})
+		if err != nil {
+			logger.Critical(ctx, "Failed to reset retry time of task [%s]: %+v", task.TaskId, err)
+		}
+		return
+	}
+	logger.Info(ctx, "Task [%s] dispatched to retry", task.TaskId)
+}
+
+// finishAttempt returns the attributes to update when the attempt of handling task finished,
+// the failed task is set back to pending to retry later if its retry policy allows
+func (c *Controller) finishAttempt(ctx context.Context, task *models.Task, taskStatus string, startTime time.Time, taskErr error) map[string]interface{} {
+	attempt := &models.TaskAttempt{
+		Executor:  c.hostname,
+		Status:    taskStatus,
+		StartTime: startTime,
+		EndTime:   time.Now(),
+	}
+	if taskStatus == constants.StatusFailed && taskErr != nil {
+		attempt.ErrorCode = uint32(status.Code(taskErr))
+		attempt.ErrorMessage = taskErr.Error()
+	}
+	attributes := map[string]interface{}{
+		constants.ColumnStatus:     taskStatus,
+		constants.ColumnStatusTime: time.Now(),
+		constants.ColumnErrorCode:  attempt.ErrorCode,
+		// retried task should be handled from the beginning
+		constants.ColumnCheckpoint: "",
+	}
+
+	attempts, _ := models.NewTaskAttempts(task.Attempts)
+	policy := pi.Global().GlobalConfig().Task.GetRetryPolicy(task.TaskAction)
+	if taskStatus == constants.StatusFailed && policy != nil {
+		retries := models.CountRetries(attempts)
+		code := status.Code(taskErr).String()
+		if retries+1 < int(policy.MaxAttempts) && policy.IsRetryable(code) {
+			retryTime := time.Now().Add(policy.GetBackoff(retries))
+			attempt.RetryTime = &retryTime
+			attributes[constants.ColumnStatus] = constants.StatusPending
+			attributes[constants.ColumnRetryTime] = retryTime
+			logger.Warn(ctx, "Task [%s] failed with [%s], retry [%d] at [%s]",
+				task.TaskId, code, retries+1, retryTime)
+		}
+	}
+	attributes[constants.ColumnAttempts] = jsonutil.ToString(append(attempts, attempt))
+	return attributes
+}
+
+// pushTaskEvent notifies the watchers of job of the task, failure only affects the watchers
+func (c *Controller) pushTaskEvent(ctx context.Context, task *models.Task) {
+	topic.PushJobEvent(ctx, pi.Global().Etcd(ctx), models.NewTaskStatusEvent(task))
+}
+
+func (c *Controller) isTaskInStatus(ctx context.Context, taskId, status string) (bool, error) {
+	count, err := pi.Global().DB(ctx).
+		Select(constants.ColumnTaskId).
+		From(constants.TableTask).
+		Where(db.Eq(constants.ColumnTaskId, taskId)).
+		Where(db.Eq(constants.ColumnStatus, status)).
+		Count()
+	return count > 0, err
+}
+
+// WatchTaskCancelled cancels the context of a working task after the task is cancelled,
+// the subtask handled by runtime provider is aborted as well
+func (c *Controller) WatchTaskCancelled(ctx context.Context, taskId string, cancel context.CancelFunc) {
+	ticker := time.NewTicker(constants.WaitTaskInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cancelled, err := c.isTaskInStatus(ctx, taskId, constants.StatusCancelled)
+			if err != nil {
+				logger.Error(ctx, "Failed to get status of task [%s]: %+v", taskId, err)
+				continue
+			}
+			if !cancelled {
+				continue
+			}
+			logger.Warn(ctx, "Task [%s] has been cancelled, abort it", taskId)
+			c.abortSubtask(ctx, taskId)
+			cancel()
+			return
+		}
+	}
+}
+
+func (c *Controller) abortSubtask(ctx context.Context, taskId string) {
+	task := new(models.Task)
+	err := pi.Global().DB(ctx).
+		Select(models.TaskColumns...).
+		From(constants.TableTask).
+		Where(db.Eq(constants.ColumnTaskId, taskId)).
+		LoadOne(&task)
+	if err != nil {
+		logger.Error(ctx, "Failed to get task [%s]: %+v", taskId, err)
+		return
+	}
+	if task.Checkpoint != constants.TaskCheckpointHandled {
+		return
+	}
+	if task.Target == constants.TargetPilot {
+		c.abortPilotSubtask(ctx, task)
+		return
+	}
+	providerClient, err := providerclient.NewRuntimeProviderManagerClient()
+	if err != nil {
+		logger.Error(ctx, "Failed to connect runtime provider manager: %+v", err)
+		return
+	}
+	_, err = providerClient.AbortSubtask(ctx, &pb.AbortSubtaskRequest{
+		RuntimeId: pbutil.ToProtoString(task.Target),
+		Task:      models.TaskToPb(task),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to abort subtask in runtime [%s]: %+v", task.Target, err)
+	}
+}
+
+// abortPilotSubtask kills the cmd of the task on drone, the other subtasks
+// of pilot are stopped by no longer waiting for them
+func (c *Controller) abortPilotSubtask(ctx context.Context, task *models.Task) {
+	if task.TaskAction != vmbased.ActionRegisterCmd {
+		return
+	}
+	meta, err := models.NewMeta(task.Directive)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode directive of task [%s]: %+v", task.TaskId, err)
+		return
+	}
+	pilotClient, err := pilotclient.NewClient()
+	if err != nil {
+		logger.Error(ctx, "Failed to connect pilot: %+v", err)
+		return
+	}
+	_, err = pilotClient.HandleSubtaskWithTimeout(ctx,
+		&pbtypes.SubTaskMessage{
+			TaskId: task.TaskId,
+			Action: pbtypes.SubTaskAction_AbortCmd.String(),
+			Directive: jsonutil.ToString(&pbtypes.SubTask_AbortCmd{
+				TaskId:      task.TaskId,
+				FrontgateId: meta.FrontgateId,
+				DroneIp:     meta.DroneIp,
+			}),
+		})
+	if err != nil {
+		logger.Error(ctx, "Failed to abort cmd of task [%s] on drone [%s]: %+v", task.TaskId, meta.DroneIp, err)
+	}
+}
+
+// RecoverWorkingTasks resumes working tasks whose executor lease is gone,
+// the executor may exit or lose connection with etcd
+func (c *Controller) RecoverWorkingTasks(ctx context.Context) {
+	for {
+		var taskIds []string
+		_, err := pi.Global().DB(ctx).
+			Select(constants.ColumnTaskId).
+			From(constants.TableTask).
+			Where(db.Eq(constants.ColumnStatus, constants.StatusWorking)).
+			Load(&taskIds)
+		if err != nil {
+			logger.Error(ctx, "Failed to get working tasks: %+v", err)
+		}
+		for _, taskId := range taskIds {
+			// tasks could be retried in any status, so hold the task before
+			// checking its status again, the lease is handed over to resumeTask
+			lease := c.getLease()
+			leaseKey := constants.TaskExecutorPrefix + taskId
+			acquired, err := lease.Acquire(ctx, leaseKey, c.hostname)
+			if err != nil {
+				logger.Error(ctx, "Failed to acquire lease of task [%s]: %+v", taskId, err)
+				continue
+			}
+			if !acquired {
+				continue
+			}
+			working, err := c.isTaskInStatus(ctx, taskId, constants.StatusWorking)
+			if err != nil {
+				logger.Error(ctx, "Failed to get status of task [%s]: %+v", taskId, err)
+			}
+			if !working {
+				lease.Release(ctx, leaseKey)
+				continue
+			}
+			err = c.acquireWorker(ctx)
+			if err != nil {
+				logger.Error(ctx, "Failed to acquire worker: %+v", err)
+				lease.Release(ctx, leaseKey)
+				return
+			}
+			logger.Info(ctx, "Task [%s] has no executor, resume it", taskId)
+			go c.resumeTask(ctx, lease, leaseKey, taskId)
+		}
+		time.Sleep(constants.RecoverWorkingInterval)
+	}
 }
 
 func (c *Controller) ExtractTasks(ctx context.Context) {
 	for {
-		if c.IsRunningExceed() {
-			logger.Error(ctx, "Sleep 10s, running task count exceed [%d/%d]", c.runningCount, c.GetTaskLength())
-			time.Sleep(10 * time.Second)
-			continue
+		err := c.acquireWorker(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to acquire worker: %+v", err)
+			return
 		}
-		taskId, err := c.queue.Dequeue()
+		taskId, err := c.queue.DequeueWithContext(ctx)
 		if err != nil {
+			c.releaseWorker()
+			if ctx.Err() != nil {
+				return
+			}
 			logger.Error(ctx, "Failed to dequeue task from etcd queue: %+v", err)
 			time.Sleep(3 * time.Second)
 			continue
@@ -104,6 +417,59 @@ func (c *Controller) ExtractTasks(ctx context.Context) {
 func (c *Controller) HandleTask(ctx context.Context, taskId string, cb func()) error {
 	ctx = ctxutil.AddMessageId(ctx, taskId)
 	defer cb()
+
+	lease := c.getLease()
+	leaseKey := constants.TaskExecutorPrefix + taskId
+	acquired, err := lease.Acquire(ctx, leaseKey, c.hostname)
+	if err != nil {
+		logger.Error(ctx, "Failed to acquire lease of task: %+v", err)
+		return err
+	}
+	if !acquired {
+		logger.Warn(ctx, "Task [%s] is held by another executor", taskId)
+		return nil
+	}
+	defer lease.Release(ctx, leaseKey)
+
+	return c.handleTask(ctx, lease, taskId, false)
+}
+
+// resumeTask handles the working task held by the lease in RecoverWorkingTasks
+func (c *Controller) resumeTask(ctx context.Context, lease *etcd.Lease, leaseKey, taskId string) error {
+	ctx = ctxutil.AddMessageId(ctx, taskId)
+	defer c.releaseWorker()
+	defer lease.Release(ctx, leaseKey)
+
+	return c.handleTask(ctx, lease, taskId, true)
+}
+
+// claimTask sets the task working if it is still in one of the statuses,
+// it returns false when the task has been claimed by others or cancelled
+func (c *Controller) claimTask(ctx context.Context, task *models.Task, statuses []string) (bool, error) {
+	result, err := pi.Global().DB(ctx).
+		Update(constants.TableTask).
+		SetMap(map[string]interface{}{
+			constants.ColumnStatus:   constants.StatusWorking,
+			constants.ColumnExecutor: c.hostname,
+			// task retried by hand should not be dispatched again
+			constants.ColumnRetryTime: nil,
+		}).
+		Where(db.Eq(constants.ColumnTaskId, task.TaskId)).
+		Where(db.Eq(constants.ColumnStatus, statuses)).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// handleTask runs the task held by the lease, the resumed task is kept working
+// by the former executor and the others should be pending or failed
+func (c *Controller) handleTask(ctx context.Context, lease *etcd.Lease, taskId string, resumed bool) error {
 	task := new(models.Task)
 	query := pi.Global().DB(ctx).
 		Select(models.TaskColumns...).
@@ -115,25 +481,71 @@ func (c *Controller) HandleTask(ctx context.Context, taskId string, cb func()) e
 		logger.Error(ctx, "Failed to get task [%s]: %+v", task.TaskId, err)
 		return err
 	}
+	if task.Status == constants.StatusCancelled {
+		logger.Warn(ctx, "Task [%s] has been cancelled", taskId)
+		return nil
+	}
+
+	semaphores := c.getSemaphores(task)
+	acquired, err := etcd.AcquireSemaphores(ctx, lease, taskId, semaphores...)
+	if err != nil {
+		logger.Error(ctx, "Failed to acquire semaphores of task: %+v", err)
+		// resumed task is still working and will be resumed again
+		if !resumed {
+			c.requeueTask(ctx, task)
+		}
+		return err
+	}
+	if !acquired {
+		logger.Warn(ctx, "Working tasks of runtime [%s] or owner [%s] exceed the limit, requeue task [%s]",
+			task.Target, task.Owner, taskId)
+		if !resumed {
+			c.requeueTask(ctx, task)
+		}
+		return nil
+	}
+	defer etcd.ReleaseSemaphores(ctx, taskId, semaphores...)
 	ctx = ctxutil.AddMessageId(ctx, task.JobId)
 
 	ctx = ctxutil.ContextWithSender(ctx, sender.New(task.Owner, task.OwnerPath, ""))
 
-	err = c.updateTaskAttributes(ctx, task.TaskId, map[string]interface{}{
-		constants.ColumnStatus:   constants.StatusWorking,
-		constants.ColumnExecutor: c.hostname,
-	})
+	startTime := time.Now()
+	statuses := []string{constants.StatusPending, constants.StatusFailed}
+	if resumed {
+		statuses = []string{constants.StatusWorking}
+	}
+	claimed, err := c.claimTask(ctx, task, statuses)
 	if err != nil {
 		logger.Error(ctx, "Failed to update task: %+v", err)
 		return err
 	}
+	if !claimed {
+		// the task in queue more than once has been handled, or cancelled
+		logger.Warn(ctx, "Task [%s] is not in status %v, skip it", taskId, statuses)
+		return nil
+	}
+	task.Status = constants.StatusWorking
+	task.Executor = c.hostname
+	c.pushTaskEvent(ctx, task)
+
+	// subtask has been handled by the former executor, only need to wait for it
+	handled := task.Checkpoint == constants.TaskCheckpointHandled
+	if handled {
+		logger.Info(ctx, "Task [%s] has been handled, resume waiting for it", task.TaskId)
+	}
 
-	err = func() error {
+	taskCtx, cancelTask := context.WithCancel(ctx)
+	defer cancelTask()
+	go c.WatchTaskCancelled(taskCtx, task.TaskId, cancelTask)
+
+	err = func(ctx context.Context) error {
 		processor := NewProcessor(task)
-		err = processor.Pre(ctx)
-		if err != nil {
-			logger.Error(ctx, "Executing task pre processor failed: %+v", err)
-			return err
+		if !handled {
+			err = processor.Pre(ctx)
+			if err != nil {
+				logger.Error(ctx, "Executing task pre processor failed: %+v", err)
+				return err
+			}
 		}
 
 		if task.Target == constants.TargetPilot {
@@ -376,19 +788,28 @@ func (c *Controller) HandleTask(ctx context.Context, taskId string, cb func()) e
 				}
 
 			case vmbased.ActionRegisterCmd:
-				pbTask := models.TaskToPb(task)
-				err = retryutil.RetryWithContext(ctx, constants.PilotTasksRetry, constants.PilotTasksSleep, func() error {
-					_, err := pilotClient.HandleSubtaskWithTimeout(ctx,
-						&pbtypes.SubTaskMessage{
-							TaskId:    pbTask.TaskId.GetValue(),
-							Action:    pbTask.TaskAction.GetValue(),
-							Directive: pbTask.Directive.GetValue(),
-						})
-					return err
-				})
-				if err != nil {
-					logger.Error(ctx, "Failed to handle task to pilot: %+v", err)
-					return err
+				if !handled {
+					pbTask := models.TaskToPb(task)
+					err = retryutil.RetryWithContext(ctx, constants.PilotTasksRetry, constants.PilotTasksSleep, func() error {
+						_, err := pilotClient.HandleSubtaskWithTimeout(ctx,
+							&pbtypes.SubTaskMessage{
+								TaskId:    pbTask.TaskId.GetValue(),
+								Action:    pbTask.TaskAction.GetValue(),
+								Directive: pbTask.Directive.GetValue(),
+							})
+						return err
+					})
+					if err != nil {
+						logger.Error(ctx, "Failed to handle task to pilot: %+v", err)
+						return err
+					}
+					err = c.updateTaskAttributes(ctx, task.TaskId, map[string]interface{}{
+						constants.ColumnCheckpoint: constants.TaskCheckpointHandled,
+					})
+					if err != nil {
+						logger.Error(ctx, "Failed to save checkpoint of task: %+v", err)
+						return err
+					}
 				}
 				err = pilotClient.WaitSubtask(
 					ctx, task.TaskId, task.GetTimeout(constants.WaitTaskTimeout), constants.WaitTaskInterval)
@@ -405,19 +826,32 @@ func (c *Controller) HandleTask(ctx context.Context, taskId string, cb func()) e
 			if err != nil {
 				return gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorInternalError)
 			}
-			handleResponse, err := providerClient.HandleSubtask(ctx, &pb.HandleSubtaskRequest{
-				RuntimeId: pbutil.ToProtoString(task.Target),
-				Task:      models.TaskToPb(task),
-			})
-			if err != nil {
-				logger.Error(ctx, "Failed to handle subtask in runtime [%s]: %+v", task.Target, err)
-				return err
+			pbTask := models.TaskToPb(task)
+			if !handled {
+				handleResponse, err := providerClient.HandleSubtask(ctx, &pb.HandleSubtaskRequest{
+					RuntimeId: pbutil.ToProtoString(task.Target),
+					Task:      pbTask,
+				})
+				if err != nil {
+					logger.Error(ctx, "Failed to handle subtask in runtime [%s]: %+v", task.Target, err)
+					return err
+				}
+				pbTask = handleResponse.Task
+				// directive returned by runtime provider is needed to wait for the subtask
+				err = c.updateTaskAttributes(ctx, task.TaskId, map[string]interface{}{
+					constants.ColumnDirective:  pbTask.GetDirective().GetValue(),
+					constants.ColumnCheckpoint: constants.TaskCheckpointHandled,
+				})
+				if err != nil {
+					logger.Error(ctx, "Failed to save checkpoint of task: %+v", err)
+					return err
+				}
 			}
 			withTimeoutCtx, cancel := context.WithTimeout(ctx, constants.MaxTaskTimeout)
 			defer cancel()
 			waitResponse, err := providerClient.WaitSubtask(withTimeoutCtx, &pb.WaitSubtaskRequest{
-				RuntimeId: handleResponse.Task.Target,
-				Task:      handleResponse.Task,
+				RuntimeId: pbTask.Target,
+				Task:      pbTask,
 			})
 			if err != nil {
 				logger.Error(ctx, "Failed to wait subtask in runtime [%s]: %+v", task.Target, err)
@@ -437,42 +871,44 @@ func (c *Controller) HandleTask(ctx context.Context, taskId string, cb func()) e
 			logger.Error(ctx, "Executing task post processor failed: %+v", err)
 		}
 		return err
-	}()
-	var status = constants.StatusSuccessful
-	if err != nil {
-		status = constants.StatusFailed
+	}(taskCtx)
+	if lease.IsExpired() {
+		// the task will be resumed by another executor, keep it working
+		logger.Critical(ctx, "Task [%s] stopped: %+v", taskId, errLeaseExpired)
+		return errLeaseExpired
+	}
+
+	var taskStatus = constants.StatusSuccessful
+	if taskCtx.Err() != nil {
+		// context is only cancelled by WatchTaskCancelled before the deferred cancelTask
+		taskStatus = constants.StatusCancelled
+	} else if err != nil {
+		taskStatus = constants.StatusFailed
 
 	}
-	err = c.updateTaskAttributes(ctx, task.TaskId, map[string]interface{}{
-		constants.ColumnStatus:     status,
-		constants.ColumnStatusTime: time.Now(),
-	})
+	attributes := c.finishAttempt(ctx, task, taskStatus, startTime, err)
+	err = c.updateTaskAttributes(ctx, task.TaskId, attributes)
 	if err != nil {
 		logger.Error(ctx, "Failed to update task: %+v", err)
 	}
+	task.Status = attributes[constants.ColumnStatus].(string)
+	task.StatusTime = attributes[constants.ColumnStatusTime].(time.Time)
+	c.pushTaskEvent(ctx, task)
 
 	return err
 }
 
 func (c *Controller) HandleTasks(ctx context.Context) {
 	for taskId := range c.runningTasks {
-		mutex.Lock()
-		c.runningCount++
-		mutex.Unlock()
-
-		go c.HandleTask(ctx, taskId, func() {
-			mutex.Lock()
-			c.runningCount--
-			mutex.Unlock()
-		})
+		go c.HandleTask(ctx, taskId, c.releaseWorker)
 	}
 }
 
 func (c *Controller) Serve(ctx context.Context) {
-	err := c.UpdateWorkingTasks(ctx)
-	if err != nil {
-		logger.Error(ctx, "Failed to update working tasks: %+v", err)
-	}
+	lease := c.newLease(ctx)
+	go c.KeepLease(ctx, lease)
 	go c.ExtractTasks(ctx)
 	go c.HandleTasks(ctx)
+	go c.RecoverWorkingTasks(ctx)
+	go c.DispatchRetryTasks(ctx)
 }
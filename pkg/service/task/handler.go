@@ -7,8 +7,10 @@ package task
 import (
 	"context"
 	"strings"
+	"time"
 
 	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/db"
 	"openpitrix.io/openpitrix/pkg/gerr"
 	"openpitrix.io/openpitrix/pkg/manager"
 	"openpitrix.io/openpitrix/pkg/models"
@@ -34,6 +36,9 @@ func (p *Server) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb
 	if req.GetStatus().GetValue() == constants.StatusFailed {
 		newTask.Status = req.GetStatus().GetValue()
 	}
+	if req.GetPriority() != nil {
+		newTask.Priority = req.GetPriority().GetValue()
+	}
 
 	_, err := pi.Global().DB(ctx).
 		InsertInto(constants.TableTask).
@@ -44,7 +49,7 @@ func (p *Server) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb
 	}
 
 	if newTask.Status != constants.StatusFailed {
-		err = p.controller.queue.Enqueue(newTask.TaskId)
+		err = p.controller.enqueueTask(newTask)
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
 		}
@@ -62,6 +67,12 @@ func (p *Server) DescribeTasks(ctx context.Context, req *pb.DescribeTasksRequest
 	offset := pbutil.GetOffsetFromRequest(req)
 	limit := pbutil.GetLimitFromRequest(req)
 
+	// tasks are always ordered by the create time, so that they are in the order of running
+	cursor, err := manager.GetCursor(req, db.NewCursor(constants.ColumnCreateTime, constants.ColumnTaskId, true))
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalPageToken)
+	}
+
 	displayColumns := manager.GetDisplayColumns(req.GetDisplayColumns(), models.TaskColumns)
 	query := pi.Global().DB(ctx).
 		Select(displayColumns...).
@@ -70,7 +81,7 @@ func (p *Server) DescribeTasks(ctx context.Context, req *pb.DescribeTasksRequest
 		Limit(limit).
 		Where(manager.BuildPermissionFilter(ctx)).
 		Where(manager.BuildFilterConditions(req, constants.TableTask)).
-		OrderDir(constants.ColumnCreateTime, true)
+		OrderByCursor(cursor)
 
 	if len(displayColumns) > 0 {
 		_, err := query.Load(&tasks)
@@ -82,23 +93,50 @@ func (p *Server) DescribeTasks(ctx context.Context, req *pb.DescribeTasksRequest
 	if err != nil {
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
 	}
+	nextPageToken, err := cursor.NextPageToken(tasks, limit)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
 
 	res := &pb.DescribeTasksResponse{
-		TaskSet:    models.TasksToPbs(tasks),
-		TotalCount: uint32(count),
+		TaskSet:       models.TasksToPbs(tasks),
+		TotalCount:    uint32(count),
+		NextPageToken: pbutil.ToProtoString(nextPageToken),
 	}
 	return res, nil
 }
 
+// ExportTasks streams all the tasks by pages of the max size, following the page token of previous page
+func (p *Server) ExportTasks(req *pb.DescribeTasksRequest, srv pb.TaskManager_ExportTasksServer) error {
+	ctx := srv.Context()
+	req.Limit = db.DefaultSelectLimit
+	return db.ExportPages(req.GetPageToken().GetValue(),
+		func(pageToken string) (interface{}, string, error) {
+			req.PageToken = pbutil.ToProtoString(pageToken)
+			res, err := p.DescribeTasks(ctx, req)
+			return res, res.GetNextPageToken().GetValue(), err
+		},
+		func(page interface{}) error {
+			return srv.Send(page.(*pb.DescribeTasksResponse))
+		},
+	)
+}
+
 func (p *Server) RetryTasks(ctx context.Context, req *pb.RetryTasksRequest) (*pb.RetryTasksResponse, error) {
 	taskIds := req.GetTaskId()
 	tasks, err := CheckTasksPermission(ctx, taskIds)
 	if err != nil {
 		return nil, err
 	}
+	// working task is held by an executor, it would be handled twice
+	for _, task := range tasks {
+		if task.Status == constants.StatusWorking {
+			return nil, gerr.New(ctx, gerr.FailedPrecondition, gerr.ErrorResourceTransitionStatus, task.TaskId, task.Status)
+		}
+	}
 
-	for _, taskId := range taskIds {
-		err = p.controller.queue.Enqueue(taskId)
+	for _, task := range tasks {
+		err = p.controller.enqueueTask(task)
 		if err != nil {
 			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorRetryTaskFailed, strings.Join(taskIds, ","))
 		}
@@ -109,3 +147,35 @@ func (p *Server) RetryTasks(ctx context.Context, req *pb.RetryTasksRequest) (*pb
 	}
 	return res, nil
 }
+
+func (p *Server) CancelTasks(ctx context.Context, req *pb.CancelTasksRequest) (*pb.CancelTasksResponse, error) {
+	taskIds := req.GetTaskId()
+	tasks, err := CheckTasksPermission(ctx, taskIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// working tasks are stopped by the controller which is handling them
+	_, err = pi.Global().DB(ctx).
+		Update(constants.TableTask).
+		SetMap(map[string]interface{}{
+			constants.ColumnStatus:     constants.StatusCancelled,
+			constants.ColumnStatusTime: time.Now(),
+		}).
+		Where(db.Eq(constants.ColumnTaskId, taskIds)).
+		Where(db.Eq(constants.ColumnStatus, []string{constants.StatusPending, constants.StatusWorking})).
+		Exec()
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorModifyResourcesFailed)
+	}
+	for _, task := range tasks {
+		if task.Status == constants.StatusPending || task.Status == constants.StatusWorking {
+			task.Status = constants.StatusCancelled
+		}
+	}
+
+	res := &pb.CancelTasksResponse{
+		TaskSet: models.TasksToPbs(tasks),
+	}
+	return res, nil
+}
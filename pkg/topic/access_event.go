@@ -0,0 +1,86 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+// AccessEvent is pushed when the permissions of users changed,
+// the permissions of all users changed if UserIds is empty.
+type AccessEvent struct {
+	UserIds []string `json:"user_ids,omitempty"`
+}
+
+func formatAccessEventKey(eventId uint64) string {
+	return fmt.Sprintf("%s%d", constants.AccessEventPrefix, eventId)
+}
+
+// PushAccessEvent notifies the watchers that the permissions of users changed
+func PushAccessEvent(ctx context.Context, e *etcd.Etcd, userIds ...string) error {
+	var eventId = idutil.GetIntId()
+	var key = formatAccessEventKey(eventId)
+	value, err := jsonutil.Encode(&AccessEvent{UserIds: userIds})
+	if err != nil {
+		logger.Error(ctx, "Encode access event of users [%v] to json failed", userIds)
+		return err
+	}
+
+	resp, err := e.Grant(ctx, expireTime)
+	if err != nil {
+		logger.Error(ctx, "Grant ttl from etcd failed: %+v", err)
+		return err
+	}
+
+	_, err = e.Put(ctx, key, string(value), clientv3.WithLease(resp.ID))
+	if err != nil {
+		logger.Error(ctx, "Push access event [%d] [%s] to etcd failed: %+v", eventId, string(value), err)
+		return err
+	}
+	return nil
+}
+
+// WatchAccessEvents watches the access events pushed after now, the returned channel
+// is closed when ctx is done or the watch failed, events may be lost in that case.
+func WatchAccessEvents(ctx context.Context, e *etcd.Etcd) <-chan *AccessEvent {
+	var c = make(chan *AccessEvent, 255)
+	go func() {
+		defer close(c)
+		watchRes := e.Watch(ctx, constants.AccessEventPrefix, clientv3.WithPrefix(), clientv3.WithFilterDelete())
+		for res := range watchRes {
+			if err := res.Err(); err != nil {
+				logger.Error(ctx, "Watch access events failed: %+v", err)
+				return
+			}
+			for _, ev := range res.Events {
+				if ev.Type != mvccpb.PUT {
+					continue
+				}
+				var event AccessEvent
+				err := jsonutil.Decode(ev.Kv.Value, &event)
+				if err != nil {
+					logger.Error(ctx, "Decode access event [%s] failed: %+v", string(ev.Kv.Value), err)
+					continue
+				}
+				select {
+				case c <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return c
+}
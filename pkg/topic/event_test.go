@@ -14,7 +14,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"openpitrix.io/openpitrix/pkg/config/test_config"
+	"openpitrix.io/openpitrix/pkg/constants"
 	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/models"
 )
 
 const (
@@ -51,3 +53,62 @@ func TestWatchEvents(t *testing.T) {
 	require.Equal(t, testUid, event.UserId)
 	t.Log(event)
 }
+
+func TestWatchJobEvents(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	jobId := models.NewJobId()
+	err = PushJobEvent(ctx, e, models.NewTaskLayerEvent(jobId, 0))
+	require.NoError(t, err)
+
+	revision, err := GetJobEventRevision(ctx, e, jobId)
+	require.NoError(t, err)
+
+	c := WatchJobEvents(ctx, e, jobId, revision)
+
+	job := &models.Job{JobId: jobId, Status: constants.StatusSuccessful}
+	err = PushJobEvent(ctx, e, models.NewJobStatusEvent(job))
+	require.NoError(t, err)
+
+	// events pushed before the revision are skipped
+	event := <-c
+	require.Equal(t, constants.JobEventJobStatus, event.EventType)
+	require.Equal(t, jobId, event.JobId)
+	require.True(t, event.IsFinished())
+
+	cancel()
+	_, ok := <-c
+	require.False(t, ok)
+}
+
+func TestWatchAccessEvents(t *testing.T) {
+	tc.CheckEtcdUnitTest(t)
+	e, err := etcd.Connect(tc.GetTestEtcdEndpoints(), "test")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := WatchAccessEvents(ctx, e)
+	time.Sleep(time.Second)
+
+	err = PushAccessEvent(ctx, e, testUid)
+	require.NoError(t, err)
+	event := <-c
+	require.Equal(t, []string{testUid}, event.UserIds)
+
+	// event without users changes the permissions of all users
+	err = PushAccessEvent(ctx, e)
+	require.NoError(t, err)
+	event = <-c
+	require.Empty(t, event.UserIds)
+
+	cancel()
+	_, ok := <-c
+	require.False(t, ok)
+}
@@ -0,0 +1,98 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+
+	"openpitrix.io/openpitrix/pkg/constants"
+	"openpitrix.io/openpitrix/pkg/etcd"
+	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/models"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
+	"openpitrix.io/openpitrix/pkg/util/jsonutil"
+)
+
+func formatJobEventPrefix(jobId string) string {
+	return fmt.Sprintf("%s%s/", constants.JobEventPrefix, jobId)
+}
+
+func formatJobEventKey(jobId string, eventId uint64) string {
+	return fmt.Sprintf("%s%d", formatJobEventPrefix(jobId), eventId)
+}
+
+// PushJobEvent pushes the event to the watchers of job, events expire after a while
+// since watchers only care about the events happened after they started watching.
+func PushJobEvent(ctx context.Context, e *etcd.Etcd, event *models.JobEvent) error {
+	var eventId = idutil.GetIntId()
+	var key = formatJobEventKey(event.JobId, eventId)
+	value, err := jsonutil.Encode(event)
+	if err != nil {
+		logger.Error(ctx, "Encode job event [%+v] to json failed", event)
+		return err
+	}
+
+	resp, err := e.Grant(ctx, expireTime)
+	if err != nil {
+		logger.Error(ctx, "Grant ttl from etcd failed: %+v", err)
+		return err
+	}
+
+	_, err = e.Put(ctx, key, string(value), clientv3.WithLease(resp.ID))
+	if err != nil {
+		logger.Error(ctx, "Push job [%s] event [%d] [%s] to etcd failed: %+v", event.JobId, eventId, string(value), err)
+		return err
+	}
+	return nil
+}
+
+// GetJobEventRevision returns the current revision of etcd, events pushed after
+// the revision can be watched by WatchJobEvents.
+func GetJobEventRevision(ctx context.Context, e *etcd.Etcd, jobId string) (int64, error) {
+	resp, err := e.Get(ctx, formatJobEventPrefix(jobId), clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		logger.Error(ctx, "Get revision of job [%s] events from etcd failed: %+v", jobId, err)
+		return 0, err
+	}
+	return resp.Header.Revision, nil
+}
+
+// WatchJobEvents watches events of job pushed after the revision,
+// the returned channel is closed when ctx is done.
+func WatchJobEvents(ctx context.Context, e *etcd.Etcd, jobId string, revision int64) <-chan *models.JobEvent {
+	var c = make(chan *models.JobEvent, 255)
+	go func() {
+		defer close(c)
+		watchRes := e.Watch(ctx, formatJobEventPrefix(jobId),
+			clientv3.WithPrefix(), clientv3.WithRev(revision+1), clientv3.WithFilterDelete())
+		for res := range watchRes {
+			if err := res.Err(); err != nil {
+				logger.Error(ctx, "Watch job [%s] events failed: %+v", jobId, err)
+				return
+			}
+			for _, ev := range res.Events {
+				if ev.Type != mvccpb.PUT {
+					continue
+				}
+				var event models.JobEvent
+				err := jsonutil.Decode(ev.Kv.Value, &event)
+				if err != nil {
+					logger.Error(ctx, "Decode job [%s] event [%s] failed: %+v", jobId, string(ev.Kv.Value), err)
+					continue
+				}
+				select {
+				case c <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return c
+}
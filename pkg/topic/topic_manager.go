@@ -12,6 +12,7 @@ import (
 
 	"openpitrix.io/openpitrix/pkg/etcd"
 	"openpitrix.io/openpitrix/pkg/logger"
+	"openpitrix.io/openpitrix/pkg/revocation"
 	"openpitrix.io/openpitrix/pkg/util/jwtutil"
 )
 
@@ -107,7 +108,7 @@ func (tm *topicManager) HandleEvent(key string) func(w http.ResponseWriter, r *h
 			http.Error(w, "Unauthorized: [sid] is required.", http.StatusUnauthorized)
 			return
 		}
-		sender, err := jwtutil.Validate(key, sid)
+		claims, err := jwtutil.ValidateClaims(key, sid)
 		if err != nil {
 			if err == jwtutil.ErrExpired {
 				http.Error(w, "Unauthorized: access token expired.", http.StatusUnauthorized)
@@ -116,12 +117,21 @@ func (tm *topicManager) HandleEvent(key string) func(w http.ResponseWriter, r *h
 			}
 			return
 		}
+		revoked, err := revocation.IsRevoked(r.Context(), tm.Etcd, claims)
+		if err != nil {
+			http.Error(w, "Internal Server Error: check access token failed.", http.StatusInternalServerError)
+			return
+		}
+		if revoked {
+			http.Error(w, "Unauthorized: access token revoked.", http.StatusUnauthorized)
+			return
+		}
 		c, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
 			logger.Info(nil, "Upgrade websocket request failed: %+v", err)
 			return
 		}
-		receiver := receiver{UserId: sender.UserId, Conn: c}
+		receiver := receiver{UserId: claims.UserId, Conn: c}
 		tm.addReceiver <- receiver
 		for {
 			_, _, err := receiver.Conn.ReadMessage()
@@ -0,0 +1,69 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cmdutil
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// Run runs the command by shell and writes the output to stdout and stderr
+// while it is running. The command is killed when ctx is done or timeout
+// (no timeout if timeout <= 0). exitCode is -1 if the command is not exited
+// by itself, err is only returned when the command fails to start or is killed.
+func Run(ctx context.Context, command string, timeout time.Duration, stdout, stderr io.Writer) (exitCode int, err error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	c := shellCommand(command)
+	// the writers are called by different goroutines of exec
+	var mu sync.Mutex
+	c.Stdout = &lockedWriter{w: stdout, mu: &mu}
+	c.Stderr = &lockedWriter{w: stderr, mu: &mu}
+
+	if err = c.Start(); err != nil {
+		return -1, err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// children of shell may hold the output pipes, kill them all,
+			// otherwise Wait blocks until they exited
+			kill(c)
+		case <-done:
+		}
+	}()
+
+	err = c.Wait()
+	if ctx.Err() != nil {
+		return -1, ctx.Err()
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return -1, err
+	}
+	return 0, nil
+}
+
+type lockedWriter struct {
+	w  io.Writer
+	mu *sync.Mutex
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
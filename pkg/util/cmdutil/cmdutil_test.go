@@ -0,0 +1,55 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		command  string
+		timeout  time.Duration
+		exitCode int
+		stdout   string
+		stderr   string
+		hasError bool
+	}{
+		{"echo hello", 0, 0, "hello\n", "", false},
+		{"echo oops >&2; exit 3", 0, 3, "", "oops\n", false},
+		{"echo begin; sleep 10", time.Second, -1, "begin\n", "", true},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		exitCode, err := Run(context.Background(), tt.command, tt.timeout, &stdout, &stderr)
+		if (err != nil) != tt.hasError {
+			t.Errorf("command [%s] error: %+v", tt.command, err)
+		}
+		if exitCode != tt.exitCode {
+			t.Errorf("command [%s] expect exit code [%d], got [%d]", tt.command, tt.exitCode, exitCode)
+		}
+		if stdout.String() != tt.stdout || stderr.String() != tt.stderr {
+			t.Errorf("command [%s] got unexpected output [%s] [%s]", tt.command, stdout.String(), stderr.String())
+		}
+	}
+}
+
+func TestRunCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(time.Second, cancel)
+
+	start := time.Now()
+	var out bytes.Buffer
+	_, err := Run(ctx, "sleep 10", 0, &out, &out)
+	if err != context.Canceled {
+		t.Errorf("expect canceled, got %+v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("command should be killed when canceled")
+	}
+}
@@ -13,21 +13,41 @@ import (
 	"gopkg.in/square/go-jose.v2/jwt"
 
 	"openpitrix.io/openpitrix/pkg/sender"
+	"openpitrix.io/openpitrix/pkg/util/idutil"
 )
 
 var ErrExpired = fmt.Errorf("access token expired")
 
+// Claims is the registered claims of access token needed to revoke it,
+// SessionId is the id of refresh token which the access token is issued by.
+type Claims struct {
+	TokenId   string
+	SessionId string
+	UserId    string
+	IssuedAt  time.Time
+	Expiry    time.Time
+}
+
+type sessionClaims struct {
+	SessionId string `json:"sid,omitempty"`
+}
+
 func trimKey(k string) []byte {
 	return []byte(strings.TrimSpace(k))
 }
 
-func Validate(k, str string) (*sender.Sender, error) {
+func NewTokenId() string {
+	return idutil.GetUuid("jti-")
+}
+
+// ValidateClaims returns the claims of access token if it is signed by the key and not expired
+func ValidateClaims(k, str string) (*Claims, error) {
 	tok, err := jwt.ParseSigned(str)
 	if err != nil {
 		return nil, err
 	}
 	c := &jwt.Claims{}
-	s := &sender.Sender{}
+	s := &sessionClaims{}
 	err = tok.Claims(trimKey(k), c, s)
 	if err != nil {
 		return nil, err
@@ -35,11 +55,30 @@ func Validate(k, str string) (*sender.Sender, error) {
 	if c.Expiry.Time().Unix() < time.Now().Unix() {
 		return nil, ErrExpired
 	}
-	s.UserId = c.Subject
-	return s, nil
+	return &Claims{
+		TokenId:   c.ID,
+		SessionId: s.SessionId,
+		UserId:    c.Subject,
+		IssuedAt:  c.IssuedAt.Time(),
+		Expiry:    c.Expiry.Time(),
+	}, nil
+}
+
+func Validate(k, str string) (*sender.Sender, error) {
+	c, err := ValidateClaims(k, str)
+	if err != nil {
+		return nil, err
+	}
+	return &sender.Sender{UserId: c.UserId, SessionId: c.SessionId}, nil
 }
 
 func Generate(k string, expire time.Duration, userId string) (string, error) {
+	return GenerateWithSession(k, expire, userId, "")
+}
+
+// GenerateWithSession generates access token issued by the session,
+// the token is revoked when the session is revoked.
+func GenerateWithSession(k string, expire time.Duration, userId, sessionId string) (string, error) {
 	// TODO: use RS512 or ES512 to encrypt token
 	// https://auth0.com/blog/brute-forcing-hs256-is-possible-the-importance-of-using-strong-keys-to-sign-jwts/
 
@@ -49,10 +88,13 @@ func Generate(k string, expire time.Duration, userId string) (string, error) {
 	}
 	now := time.Now()
 	c := &jwt.Claims{
+		ID:       NewTokenId(),
 		IssuedAt: jwt.NewNumericDate(now),
 		Expiry:   jwt.NewNumericDate(now.Add(expire)),
-		// TODO: add jti
-		Subject: userId,
+		Subject:  userId,
+	}
+	s := &sessionClaims{
+		SessionId: sessionId,
 	}
-	return jwt.Signed(signer).Claims(c).CompactSerialize()
+	return jwt.Signed(signer).Claims(c).Claims(s).CompactSerialize()
 }
@@ -0,0 +1,66 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package jwtutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateWithSession(t *testing.T) {
+	token, err := GenerateWithSession("secret", time.Hour, "usr-1", "token-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ValidateClaims("secret", token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.UserId != "usr-1" || c.SessionId != "token-1" {
+		t.Errorf("Wrong claims [%+v]", c)
+	}
+	if c.TokenId == "" {
+		t.Errorf("Token id should not be empty")
+	}
+	if c.Expiry.Sub(c.IssuedAt) != time.Hour {
+		t.Errorf("Wrong expiry [%s] of token issued at [%s]", c.Expiry, c.IssuedAt)
+	}
+
+	another, err := GenerateWithSession("secret", time.Hour, "usr-1", "token-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	anotherClaims, err := ValidateClaims("secret", another)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anotherClaims.TokenId == c.TokenId {
+		t.Errorf("Token id [%s] should be unique", c.TokenId)
+	}
+
+	s, err := Validate("secret", token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.UserId != "usr-1" {
+		t.Errorf("Wrong sender [%+v]", s)
+	}
+
+	_, err = Validate("another secret", token)
+	if err == nil {
+		t.Errorf("Token signed by another key should be invalid")
+	}
+}
+
+func TestValidateExpired(t *testing.T) {
+	token, err := Generate("secret", -time.Minute, "usr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Validate("secret", token)
+	if err != ErrExpired {
+		t.Errorf("Expired token should be invalid, got [%+v]", err)
+	}
+}
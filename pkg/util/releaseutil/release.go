@@ -0,0 +1,134 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package releaseutil verifies the downloaded releases.
+//
+// A release is published with a manifest listing the sha256 of its files,
+// and a detached signature of the manifest signed by the release key.
+// RSA (PKCS#1 v1.5), ECDSA (ASN.1) and Ed25519 signatures are supported,
+// the first two are signed over the sha256 of the manifest, e.g.
+//
+//	openssl dgst -sha256 -sign release.key -out manifest.json.sig manifest.json
+package releaseutil
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+)
+
+type Manifest struct {
+	Version string `json:"version"`
+	// file name => hex encoded sha256
+	Files map[string]string `json:"files"`
+}
+
+// ParsePublicKey parses the PEM encoded PKIX public key
+func ParsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM encoded public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// VerifySignature verifies the detached signature of data
+func VerifySignature(publicKey crypto.PublicKey, data, signature []byte) error {
+	digest := sha256.Sum256(data)
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	case *ecdsa.PublicKey:
+		var sig struct {
+			R, S *big.Int
+		}
+		rest, err := asn1.Unmarshal(signature, &sig)
+		if err != nil || len(rest) != 0 {
+			return fmt.Errorf("invalid ecdsa signature")
+		}
+		if !ecdsa.Verify(key, digest[:], sig.R, sig.S) {
+			return fmt.Errorf("ecdsa signature verification failed")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return fmt.Errorf("ed25519 signature verification failed")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// LoadManifest verifies the signature of the manifest and parses it,
+// the signature is not verified if publicKey is nil
+func LoadManifest(data, signature []byte, publicKey crypto.PublicKey) (*Manifest, error) {
+	if publicKey != nil {
+		if err := VerifySignature(publicKey, data, signature); err != nil {
+			return nil, err
+		}
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid release manifest: %+v", err)
+	}
+	return &m, nil
+}
+
+// VerifyFile checks the sha256 of the file which must be listed in manifest
+func (m *Manifest) VerifyFile(name string, data []byte) error {
+	expected, ok := m.Files[name]
+	if !ok {
+		return fmt.Errorf("file [%s] is not listed in release manifest of [%s]", name, m.Version)
+	}
+	return VerifySha256(data, expected)
+}
+
+func Sha256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifySha256 checks the data against the hex encoded sha256
+func VerifySha256(data []byte, expected string) error {
+	if actual := Sha256(data); actual != expected {
+		return fmt.Errorf("sha256 mismatch, expected [%s], got [%s]", expected, actual)
+	}
+	return nil
+}
+
+// WriteFile writes the file by renaming a temp file in the same dir,
+// so that a binary being executed is never seen half written
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Chmod(f.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
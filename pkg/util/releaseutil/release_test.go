@@ -0,0 +1,135 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package releaseutil
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testManifest = []byte(`{"version":"v0.4.0","files":{"openpitrix-v0.4.0-bin.tar.gz":"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}}`)
+
+func encodePublicKey(t *testing.T, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestLoadManifest(t *testing.T) {
+	digest := sha256.Sum256(testManifest)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaSig, err := ecdsaKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ed25519Pub, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed25519Sig := ed25519.Sign(ed25519Key, testManifest)
+
+	tests := []struct {
+		publicKey crypto.PublicKey
+		signature []byte
+	}{
+		{&rsaKey.PublicKey, rsaSig},
+		{&ecdsaKey.PublicKey, ecdsaSig},
+		{ed25519Pub, ed25519Sig},
+	}
+	for _, tt := range tests {
+		publicKey, err := ParsePublicKey(encodePublicKey(t, tt.publicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, err := LoadManifest(testManifest, tt.signature, publicKey)
+		if err != nil {
+			t.Fatalf("%T: %+v", publicKey, err)
+		}
+		if m.Version != "v0.4.0" {
+			t.Errorf("%T: unexpected version [%s]", publicKey, m.Version)
+		}
+
+		tampered := append([]byte(nil), testManifest...)
+		tampered[len(tampered)-4] = '0'
+		if _, err = LoadManifest(tampered, tt.signature, publicKey); err == nil {
+			t.Errorf("%T: tampered manifest should not be verified", publicKey)
+		}
+	}
+}
+
+func TestManifestVerifyFile(t *testing.T) {
+	m, err := LoadManifest(testManifest, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.VerifyFile("openpitrix-v0.4.0-bin.tar.gz", []byte("hello")); err != nil {
+		t.Error(err)
+	}
+	if err = m.VerifyFile("openpitrix-v0.4.0-bin.tar.gz", []byte("hello!")); err == nil {
+		t.Error("file with wrong sha256 should not be verified")
+	}
+	if err = m.VerifyFile("drone", []byte("hello")); err == nil {
+		t.Error("file not in manifest should not be verified")
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "releaseutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "drone")
+	for _, data := range []string{"v1", "v2"} {
+		if err = WriteFile(path, []byte(data), 0755); err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil || string(b) != data {
+			t.Errorf("expect [%s], got [%s] %+v", data, b, err)
+		}
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0755 {
+		t.Errorf("unexpected file mode %v", fi.Mode())
+	}
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("temp file should be removed, got %d files", len(files))
+	}
+}
@@ -36,3 +36,16 @@ func RetryWithContext(ctx context.Context, attempts int, sleep time.Duration, ca
 	}
 	return fmt.Errorf("failed after %d attempts, error: %+v", attempts, err)
 }
+
+// Backoff returns the duration to wait before the retry, it doubles from initial
+// for each retry and is capped by max, retries starts from 0.
+func Backoff(initial, max time.Duration, retries int) time.Duration {
+	backoff := initial
+	for i := 0; i < retries && (max <= 0 || backoff < max); i++ {
+		backoff *= 2
+	}
+	if max > 0 && backoff > max {
+		backoff = max
+	}
+	return backoff
+}
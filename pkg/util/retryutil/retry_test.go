@@ -0,0 +1,29 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package retryutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	for _, tt := range []struct {
+		initial  time.Duration
+		max      time.Duration
+		retries  int
+		expected time.Duration
+	}{
+		{time.Second, time.Minute, 0, time.Second},
+		{time.Second, time.Minute, 3, 8 * time.Second},
+		{time.Second, time.Minute, 10, time.Minute},
+		{time.Second, 0, 2, 4 * time.Second},
+	} {
+		backoff := Backoff(tt.initial, tt.max, tt.retries)
+		if backoff != tt.expected {
+			t.Errorf("Backoff(%s, %s, %d) = %s, expected %s", tt.initial, tt.max, tt.retries, backoff, tt.expected)
+		}
+	}
+}
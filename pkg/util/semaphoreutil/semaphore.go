@@ -0,0 +1,120 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package semaphoreutil
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+type waiter struct {
+	n     int64
+	ready chan struct{}
+}
+
+// Weighted is a semaphore whose size can be changed at runtime, waiters are served
+// in FIFO order so that a large request is not starved by small ones.
+type Weighted struct {
+	mutex   sync.Mutex
+	size    int64
+	cur     int64
+	waiters list.List
+}
+
+func NewWeighted(n int64) *Weighted {
+	return &Weighted{size: n}
+}
+
+// Acquire blocks until n is acquired or the context is done,
+// it returns ctx.Err() and leaves the semaphore unchanged on failure.
+func (s *Weighted) Acquire(ctx context.Context, n int64) error {
+	s.mutex.Lock()
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		s.mutex.Unlock()
+		return nil
+	}
+
+	ready := make(chan struct{})
+	elem := s.waiters.PushBack(waiter{n: n, ready: ready})
+	s.mutex.Unlock()
+
+	select {
+	case <-ctx.Done():
+		err := ctx.Err()
+		s.mutex.Lock()
+		select {
+		case <-ready:
+			// acquired right after the context is done, keep it
+			err = nil
+		default:
+			isFront := s.waiters.Front() == elem
+			s.waiters.Remove(elem)
+			// waiters behind may be able to acquire now
+			if isFront {
+				s.notifyWaiters()
+			}
+		}
+		s.mutex.Unlock()
+		return err
+
+	case <-ready:
+		return nil
+	}
+}
+
+// TryAcquire acquires n without blocking, it returns false if n is not available
+func (s *Weighted) TryAcquire(n int64) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		return true
+	}
+	return false
+}
+
+func (s *Weighted) Release(n int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cur -= n
+	if s.cur < 0 {
+		panic("semaphore: released more than held")
+	}
+	s.notifyWaiters()
+}
+
+// Resize changes the size of semaphore, holders beyond the new size are not
+// interrupted, new waiters are blocked until enough holders released.
+func (s *Weighted) Resize(n int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.size = n
+	s.notifyWaiters()
+}
+
+// Current returns the count held by all holders
+func (s *Weighted) Current() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cur
+}
+
+func (s *Weighted) notifyWaiters() {
+	for {
+		next := s.waiters.Front()
+		if next == nil {
+			return
+		}
+		w := next.Value.(waiter)
+		if s.size-s.cur < w.n {
+			return
+		}
+		s.cur += w.n
+		s.waiters.Remove(next)
+		close(w.ready)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2018 The OpenPitrix Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package semaphoreutil
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWeighted(t *testing.T) {
+	s := NewWeighted(2)
+	ctx := context.Background()
+	if err := s.Acquire(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	if s.TryAcquire(1) {
+		t.Fatal("semaphore should be full")
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := s.Acquire(ctx, 1); err != nil {
+			t.Error(err)
+		}
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("semaphore should block until released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	s.Release(1)
+	<-acquired
+	if s.Current() != 2 {
+		t.Fatalf("current should be 2, got %d", s.Current())
+	}
+	s.Release(2)
+}
+
+func TestWeightedCancel(t *testing.T) {
+	s := NewWeighted(1)
+	s.TryAcquire(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(ctx, 1); err != context.DeadlineExceeded {
+		t.Fatalf("acquire should fail with deadline exceeded, got %+v", err)
+	}
+	s.Release(1)
+	if !s.TryAcquire(1) {
+		t.Fatal("canceled waiter should not hold the semaphore")
+	}
+}
+
+func TestWeightedResize(t *testing.T) {
+	s := NewWeighted(1)
+	s.TryAcquire(1)
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := s.Acquire(context.Background(), 1); err != nil {
+			t.Error(err)
+		}
+		close(acquired)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	s.Resize(2)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("waiter should acquire after resized")
+	}
+}
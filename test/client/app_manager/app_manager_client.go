@@ -256,6 +256,35 @@ func (a *Client) CreateAppVersion(params *CreateAppVersionParams, authInfo runti
 
 }
 
+/*
+CreateReviewPolicy creates review policy which replaces the default review stages of the apps in category or market
+*/
+func (a *Client) CreateReviewPolicy(params *CreateReviewPolicyParams, authInfo runtime.ClientAuthInfoWriter) (*CreateReviewPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateReviewPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateReviewPolicy",
+		Method:             "POST",
+		PathPattern:        "/v1/review_policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateReviewPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateReviewPolicyOK), nil
+
+}
+
 /*
 DeleteAppVersion deletes version of the app
 */
@@ -314,6 +343,35 @@ func (a *Client) DeleteApps(params *DeleteAppsParams, authInfo runtime.ClientAut
 
 }
 
+/*
+DeleteReviewPolicies batches delete review policies
+*/
+func (a *Client) DeleteReviewPolicies(params *DeleteReviewPoliciesParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteReviewPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteReviewPoliciesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteReviewPolicies",
+		Method:             "DELETE",
+		PathPattern:        "/v1/review_policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteReviewPoliciesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteReviewPoliciesOK), nil
+
+}
+
 /*
 DescribeActiveAppVersions gets active versions of app can filter with these fields version id app id name owner description package name status type default return all active app versions
 */
@@ -488,6 +546,64 @@ func (a *Client) DescribeApps(params *DescribeAppsParams, authInfo runtime.Clien
 
 }
 
+/*
+DescribeReviewPolicies gets review policies can filter with these fields policy id scope type scope id
+*/
+func (a *Client) DescribeReviewPolicies(params *DescribeReviewPoliciesParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeReviewPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeReviewPoliciesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeReviewPolicies",
+		Method:             "GET",
+		PathPattern:        "/v1/review_policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeReviewPoliciesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeReviewPoliciesOK), nil
+
+}
+
+/*
+ExportApps exports apps stream all the apps page by page for bulk reporting
+*/
+func (a *Client) ExportApps(params *ExportAppsParams, authInfo runtime.ClientAuthInfoWriter) (*ExportAppsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportAppsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportApps",
+		Method:             "GET",
+		PathPattern:        "/v1/apps/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportAppsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportAppsOK), nil
+
+}
+
 /*
 GetAppStatistics gets statistics info of apps
 */
@@ -720,6 +836,64 @@ func (a *Client) ModifyAppVersion(params *ModifyAppVersionParams, authInfo runti
 
 }
 
+/*
+ModifyReviewPolicy modifies review policy
+*/
+func (a *Client) ModifyReviewPolicy(params *ModifyReviewPolicyParams, authInfo runtime.ClientAuthInfoWriter) (*ModifyReviewPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewModifyReviewPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ModifyReviewPolicy",
+		Method:             "PATCH",
+		PathPattern:        "/v1/review_policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ModifyReviewPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ModifyReviewPolicyOK), nil
+
+}
+
+/*
+PassAppVersion reviewers of the stage pass version of the app
+*/
+func (a *Client) PassAppVersion(params *PassAppVersionParams, authInfo runtime.ClientAuthInfoWriter) (*PassAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPassAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PassAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_version/action/pass",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PassAppVersionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PassAppVersionOK), nil
+
+}
+
 /*
 RecoverAppVersion recovers version of app
 */
@@ -749,6 +923,35 @@ func (a *Client) RecoverAppVersion(params *RecoverAppVersionParams, authInfo run
 
 }
 
+/*
+RejectAppVersion reviewers of the stage reject version of the app
+*/
+func (a *Client) RejectAppVersion(params *RejectAppVersionParams, authInfo runtime.ClientAuthInfoWriter) (*RejectAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRejectAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RejectAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_version/action/reject",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RejectAppVersionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RejectAppVersionOK), nil
+
+}
+
 /*
 ReleaseAppVersion releases version of the app
 */
@@ -778,6 +981,35 @@ func (a *Client) ReleaseAppVersion(params *ReleaseAppVersionParams, authInfo run
 
 }
 
+/*
+ReviewAppVersion reviewers of the stage review version of the app
+*/
+func (a *Client) ReviewAppVersion(params *ReviewAppVersionParams, authInfo runtime.ClientAuthInfoWriter) (*ReviewAppVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReviewAppVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ReviewAppVersion",
+		Method:             "POST",
+		PathPattern:        "/v1/app_version/action/review",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ReviewAppVersionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ReviewAppVersionOK), nil
+
+}
+
 /*
 SubmitAppVersion submits version of the app
 */
@@ -108,6 +108,11 @@ type DescribeActiveAppsParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*RepoID
 	  app repository ids.
 
@@ -271,6 +276,17 @@ func (o *DescribeActiveAppsParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe active apps params
+func (o *DescribeActiveAppsParams) WithPageToken(pageToken *string) *DescribeActiveAppsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe active apps params
+func (o *DescribeActiveAppsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithRepoID adds the repoID to the describe active apps params
 func (o *DescribeActiveAppsParams) WithRepoID(repoID []string) *DescribeActiveAppsParams {
 	o.SetRepoID(repoID)
@@ -422,6 +438,22 @@ func (o *DescribeActiveAppsParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	valuesRepoID := o.RepoID
 
 	joinedRepoID := swag.JoinByFormat(valuesRepoID, "multi")
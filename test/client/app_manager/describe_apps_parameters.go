@@ -108,6 +108,11 @@ type DescribeAppsParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*RepoID
 	  app repository ids.
 
@@ -271,6 +276,17 @@ func (o *DescribeAppsParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe apps params
+func (o *DescribeAppsParams) WithPageToken(pageToken *string) *DescribeAppsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe apps params
+func (o *DescribeAppsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithRepoID adds the repoID to the describe apps params
 func (o *DescribeAppsParams) WithRepoID(repoID []string) *DescribeAppsParams {
 	o.SetRepoID(repoID)
@@ -422,6 +438,22 @@ func (o *DescribeAppsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	valuesRepoID := o.RepoID
 
 	joinedRepoID := swag.JoinByFormat(valuesRepoID, "multi")
@@ -140,6 +140,35 @@ func (a *Client) CreateCluster(params *CreateClusterParams, authInfo runtime.Cli
 
 }
 
+/*
+CreateClusterSnapshot backs up cluster by the backup service of app
+*/
+func (a *Client) CreateClusterSnapshot(params *CreateClusterSnapshotParams, authInfo runtime.ClientAuthInfoWriter) (*CreateClusterSnapshotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateClusterSnapshotParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateClusterSnapshot",
+		Method:             "POST",
+		PathPattern:        "/v1/clusters/snapshots",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateClusterSnapshotReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateClusterSnapshotOK), nil
+
+}
+
 /*
 CreateDebugCluster creates debug cluster
 */
@@ -198,6 +227,35 @@ func (a *Client) CreateKeyPair(params *CreateKeyPairParams, authInfo runtime.Cli
 
 }
 
+/*
+CreateQuota creates quota of user group or owner path
+*/
+func (a *Client) CreateQuota(params *CreateQuotaParams, authInfo runtime.ClientAuthInfoWriter) (*CreateQuotaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateQuotaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateQuota",
+		Method:             "POST",
+		PathPattern:        "/v1/clusters/quotas",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateQuotaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateQuotaOK), nil
+
+}
+
 /*
 DeleteClusterNodes batches delete nodes from cluster
 */
@@ -227,6 +285,35 @@ func (a *Client) DeleteClusterNodes(params *DeleteClusterNodesParams, authInfo r
 
 }
 
+/*
+DeleteClusterSnapshots batches delete snapshots of clusters
+*/
+func (a *Client) DeleteClusterSnapshots(params *DeleteClusterSnapshotsParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteClusterSnapshotsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteClusterSnapshotsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterSnapshots",
+		Method:             "DELETE",
+		PathPattern:        "/v1/clusters/snapshots",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteClusterSnapshotsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteClusterSnapshotsOK), nil
+
+}
+
 /*
 DeleteClusters batches delete clusters
 */
@@ -285,6 +372,35 @@ func (a *Client) DeleteKeyPairs(params *DeleteKeyPairsParams, authInfo runtime.C
 
 }
 
+/*
+DeleteQuotas batches delete quotas
+*/
+func (a *Client) DeleteQuotas(params *DeleteQuotasParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteQuotasOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteQuotasParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteQuotas",
+		Method:             "DELETE",
+		PathPattern:        "/v1/clusters/quotas",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteQuotasReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteQuotasOK), nil
+
+}
+
 /*
 DescribeAppClusters gets app clusters can filter with these fields cluster id app id version id status runtime id frontgate id owner cluster type default return all app clusters
 */
@@ -314,6 +430,35 @@ func (a *Client) DescribeAppClusters(params *DescribeAppClustersParams, authInfo
 
 }
 
+/*
+DescribeClusterMonitor gets time series of the monitor items defined by app on nodes of cluster
+*/
+func (a *Client) DescribeClusterMonitor(params *DescribeClusterMonitorParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeClusterMonitorOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeClusterMonitorParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeClusterMonitor",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/monitor",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeClusterMonitorReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeClusterMonitorOK), nil
+
+}
+
 /*
 DescribeClusterNodes gets nodes in cluster can filter with these fields cluster id node id status owner
 */
@@ -343,6 +488,64 @@ func (a *Client) DescribeClusterNodes(params *DescribeClusterNodesParams, authIn
 
 }
 
+/*
+DescribeClusterServiceAudits gets history of services run on clusters can filter with these fields cluster service audit id cluster id service name status owner default return all cluster service audits
+*/
+func (a *Client) DescribeClusterServiceAudits(params *DescribeClusterServiceAuditsParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeClusterServiceAuditsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeClusterServiceAuditsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeClusterServiceAudits",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/service_audits",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeClusterServiceAuditsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeClusterServiceAuditsOK), nil
+
+}
+
+/*
+DescribeClusterSnapshots gets snapshots of clusters can filter with these fields snapshot id cluster id snapshot type parent snapshot id status owner default return all snapshots
+*/
+func (a *Client) DescribeClusterSnapshots(params *DescribeClusterSnapshotsParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeClusterSnapshotsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeClusterSnapshotsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeClusterSnapshots",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/snapshots",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeClusterSnapshotsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeClusterSnapshotsOK), nil
+
+}
+
 /*
 DescribeClusters gets clusters can filter with these fields cluster id app id version id status runtime id frontgate id owner cluster type default return all clusters
 */
@@ -459,6 +662,64 @@ func (a *Client) DescribeKeyPairs(params *DescribeKeyPairsParams, authInfo runti
 
 }
 
+/*
+DescribeQuotaUsage gets usage of quotas limiting the clusters of owner path
+*/
+func (a *Client) DescribeQuotaUsage(params *DescribeQuotaUsageParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeQuotaUsageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeQuotaUsageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeQuotaUsage",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/quotas/usage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeQuotaUsageReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeQuotaUsageOK), nil
+
+}
+
+/*
+DescribeQuotas gets quotas can filter with these fields quota id subject type subject runtime id default return all quotas
+*/
+func (a *Client) DescribeQuotas(params *DescribeQuotasParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeQuotasOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeQuotasParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeQuotas",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/quotas",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeQuotasReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeQuotasOK), nil
+
+}
+
 /*
 DescribeSubnets gets subnets
 */
@@ -517,6 +778,64 @@ func (a *Client) DetachKeyPairs(params *DetachKeyPairsParams, authInfo runtime.C
 
 }
 
+/*
+ExportClusterNodes exports cluster nodes stream all the cluster nodes page by page for bulk reporting
+*/
+func (a *Client) ExportClusterNodes(params *ExportClusterNodesParams, authInfo runtime.ClientAuthInfoWriter) (*ExportClusterNodesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportClusterNodesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportClusterNodes",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/nodes/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportClusterNodesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportClusterNodesOK), nil
+
+}
+
+/*
+ExportClusters exports clusters stream all the clusters page by page for bulk reporting
+*/
+func (a *Client) ExportClusters(params *ExportClustersParams, authInfo runtime.ClientAuthInfoWriter) (*ExportClustersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportClustersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportClusters",
+		Method:             "GET",
+		PathPattern:        "/v1/clusters/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportClustersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportClustersOK), nil
+
+}
+
 /*
 GetClusterStatistics gets statistics of cluster
 */
@@ -604,6 +923,35 @@ func (a *Client) ModifyClusterNodeAttributes(params *ModifyClusterNodeAttributes
 
 }
 
+/*
+ModifyQuota modifies quota
+*/
+func (a *Client) ModifyQuota(params *ModifyQuotaParams, authInfo runtime.ClientAuthInfoWriter) (*ModifyQuotaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewModifyQuotaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ModifyQuota",
+		Method:             "PATCH",
+		PathPattern:        "/v1/clusters/quotas",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ModifyQuotaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ModifyQuotaOK), nil
+
+}
+
 /*
 RecoverClusters batches recover clusters
 */
@@ -662,6 +1010,35 @@ func (a *Client) ResizeCluster(params *ResizeClusterParams, authInfo runtime.Cli
 
 }
 
+/*
+RestoreClusterFromSnapshot restores cluster from snapshot by the restore service of app
+*/
+func (a *Client) RestoreClusterFromSnapshot(params *RestoreClusterFromSnapshotParams, authInfo runtime.ClientAuthInfoWriter) (*RestoreClusterFromSnapshotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreClusterFromSnapshotParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RestoreClusterFromSnapshot",
+		Method:             "POST",
+		PathPattern:        "/v1/clusters/snapshots/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RestoreClusterFromSnapshotReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RestoreClusterFromSnapshotOK), nil
+
+}
+
 /*
 RollbackCluster rollbacks cluster
 */
@@ -691,6 +1068,64 @@ func (a *Client) RollbackCluster(params *RollbackClusterParams, authInfo runtime
 
 }
 
+/*
+RunClusterNodeCommand runs command on cluster node stream output of command until exited
+*/
+func (a *Client) RunClusterNodeCommand(params *RunClusterNodeCommandParams, authInfo runtime.ClientAuthInfoWriter) (*RunClusterNodeCommandOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunClusterNodeCommandParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RunClusterNodeCommand",
+		Method:             "POST",
+		PathPattern:        "/v1/clusters/nodes/run_command",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunClusterNodeCommandReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RunClusterNodeCommandOK), nil
+
+}
+
+/*
+RunClusterService runs restart service or the custom service defined by app on cluster
+*/
+func (a *Client) RunClusterService(params *RunClusterServiceParams, authInfo runtime.ClientAuthInfoWriter) (*RunClusterServiceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunClusterServiceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RunClusterService",
+		Method:             "POST",
+		PathPattern:        "/v1/clusters/run_service",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunClusterServiceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RunClusterServiceOK), nil
+
+}
+
 /*
 StartClusters batches start clusters
 */
@@ -73,6 +73,11 @@ type DescribeClusterNodesParams struct {
 
 	*/
 	DisplayColumns []string
+	/*HealthStatus
+	  health status eg.[healthy|unhealthy].
+
+	*/
+	HealthStatus []string
 	/*Limit
 	  data limit per page, default value 20, max value 200.
 
@@ -93,6 +98,11 @@ type DescribeClusterNodesParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*Reverse
 	  value = 0 sort ASC, value = 1 sort DESC.
 
@@ -174,6 +184,17 @@ func (o *DescribeClusterNodesParams) SetDisplayColumns(displayColumns []string)
 	o.DisplayColumns = displayColumns
 }
 
+// WithHealthStatus adds the healthStatus to the describe cluster nodes params
+func (o *DescribeClusterNodesParams) WithHealthStatus(healthStatus []string) *DescribeClusterNodesParams {
+	o.SetHealthStatus(healthStatus)
+	return o
+}
+
+// SetHealthStatus adds the healthStatus to the describe cluster nodes params
+func (o *DescribeClusterNodesParams) SetHealthStatus(healthStatus []string) {
+	o.HealthStatus = healthStatus
+}
+
 // WithLimit adds the limit to the describe cluster nodes params
 func (o *DescribeClusterNodesParams) WithLimit(limit *int64) *DescribeClusterNodesParams {
 	o.SetLimit(limit)
@@ -218,6 +239,17 @@ func (o *DescribeClusterNodesParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe cluster nodes params
+func (o *DescribeClusterNodesParams) WithPageToken(pageToken *string) *DescribeClusterNodesParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe cluster nodes params
+func (o *DescribeClusterNodesParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithReverse adds the reverse to the describe cluster nodes params
 func (o *DescribeClusterNodesParams) WithReverse(reverse *bool) *DescribeClusterNodesParams {
 	o.SetReverse(reverse)
@@ -294,6 +326,14 @@ func (o *DescribeClusterNodesParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 
+	valuesHealthStatus := o.HealthStatus
+
+	joinedHealthStatus := swag.JoinByFormat(valuesHealthStatus, "multi")
+	// query array param health_status
+	if err := r.SetQueryParam("health_status", joinedHealthStatus...); err != nil {
+		return err
+	}
+
 	if o.Limit != nil {
 
 		// query param limit
@@ -342,6 +382,22 @@ func (o *DescribeClusterNodesParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Reverse != nil {
 
 		// query param reverse
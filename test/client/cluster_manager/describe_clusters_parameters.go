@@ -115,6 +115,11 @@ type DescribeClustersParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*Reverse
 	  value = 0 sort ASC, value = 1 sort DESC.
 
@@ -315,6 +320,17 @@ func (o *DescribeClustersParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe clusters params
+func (o *DescribeClustersParams) WithPageToken(pageToken *string) *DescribeClustersParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe clusters params
+func (o *DescribeClustersParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithReverse adds the reverse to the describe clusters params
 func (o *DescribeClustersParams) WithReverse(reverse *bool) *DescribeClustersParams {
 	o.SetReverse(reverse)
@@ -547,6 +563,22 @@ func (o *DescribeClustersParams) WriteToRequest(r runtime.ClientRequest, reg str
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Reverse != nil {
 
 		// query param reverse
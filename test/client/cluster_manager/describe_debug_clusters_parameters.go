@@ -115,6 +115,11 @@ type DescribeDebugClustersParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*Reverse
 	  value = 0 sort ASC, value = 1 sort DESC.
 
@@ -315,6 +320,17 @@ func (o *DescribeDebugClustersParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe debug clusters params
+func (o *DescribeDebugClustersParams) WithPageToken(pageToken *string) *DescribeDebugClustersParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe debug clusters params
+func (o *DescribeDebugClustersParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithReverse adds the reverse to the describe debug clusters params
 func (o *DescribeDebugClustersParams) WithReverse(reverse *bool) *DescribeDebugClustersParams {
 	o.SetReverse(reverse)
@@ -547,6 +563,22 @@ func (o *DescribeDebugClustersParams) WriteToRequest(r runtime.ClientRequest, re
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Reverse != nil {
 
 		// query param reverse
@@ -103,6 +103,11 @@ type DescribeJobsParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*Provider
 	  runtime provider eg.[qingcloud|aliyun|aws|kubernetes].
 
@@ -129,7 +134,7 @@ type DescribeJobsParams struct {
 	*/
 	SortKey *string
 	/*Status
-	  status eg.[successful|failed|running|pending].
+	  status eg.[successful|failed|running|pending|scheduled|cancelled].
 
 	*/
 	Status []string
@@ -265,6 +270,17 @@ func (o *DescribeJobsParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe jobs params
+func (o *DescribeJobsParams) WithPageToken(pageToken *string) *DescribeJobsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe jobs params
+func (o *DescribeJobsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithProvider adds the provider to the describe jobs params
 func (o *DescribeJobsParams) WithProvider(provider *string) *DescribeJobsParams {
 	o.SetProvider(provider)
@@ -454,6 +470,22 @@ func (o *DescribeJobsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Provider != nil {
 
 		// query param provider
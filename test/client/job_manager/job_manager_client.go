@@ -24,6 +24,35 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+CancelJob cancels pending or working job the remaining tasks of the job will not be executed
+*/
+func (a *Client) CancelJob(params *CancelJobParams, authInfo runtime.ClientAuthInfoWriter) (*CancelJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CancelJob",
+		Method:             "POST",
+		PathPattern:        "/v1/jobs/cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CancelJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CancelJobOK), nil
+
+}
+
 /*
 DescribeJobs gets job filter with these fields job id cluster id app id version id executor provider status owner default return all jobs
 */
@@ -53,6 +82,64 @@ func (a *Client) DescribeJobs(params *DescribeJobsParams, authInfo runtime.Clien
 
 }
 
+/*
+ExportJobs exports jobs stream all the jobs page by page for bulk reporting
+*/
+func (a *Client) ExportJobs(params *ExportJobsParams, authInfo runtime.ClientAuthInfoWriter) (*ExportJobsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportJobsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportJobs",
+		Method:             "GET",
+		PathPattern:        "/v1/jobs/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportJobsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportJobsOK), nil
+
+}
+
+/*
+WatchJob watches job stream the changes of job and its tasks until the job finished
+*/
+func (a *Client) WatchJob(params *WatchJobParams, authInfo runtime.ClientAuthInfoWriter) (*WatchJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWatchJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "WatchJob",
+		Method:             "GET",
+		PathPattern:        "/v1/jobs/watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &WatchJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WatchJobOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
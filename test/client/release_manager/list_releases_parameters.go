@@ -62,13 +62,22 @@ for the list releases operation typically these are written to a http.Request
 */
 type ListReleasesParams struct {
 
-	/*Namespace*/
+	/*Namespace
+	  namespace, default to all namespaces.
+
+	*/
 	Namespace *string
 	/*ReleaseName*/
 	ReleaseName *string
-	/*RuntimeID*/
+	/*RuntimeID
+	  required, id of runtime.
+
+	*/
 	RuntimeID *string
-	/*Status*/
+	/*Status
+	  release status eg.[deployed|failed|uninstalled|all], default to deployed and failed.
+
+	*/
 	Status *string
 
 	timeout    time.Duration
@@ -82,6 +82,64 @@ func (a *Client) DeleteRelease(params *DeleteReleaseParams, authInfo runtime.Cli
 
 }
 
+/*
+DescribeReleaseHistory describes revision history of the release of cluster
+*/
+func (a *Client) DescribeReleaseHistory(params *DescribeReleaseHistoryParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeReleaseHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeReleaseHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeReleaseHistory",
+		Method:             "GET",
+		PathPattern:        "/v1/releases/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeReleaseHistoryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeReleaseHistoryOK), nil
+
+}
+
+/*
+DiffReleaseRevisions diffs values and manifest between two revisions of the release of cluster
+*/
+func (a *Client) DiffReleaseRevisions(params *DiffReleaseRevisionsParams, authInfo runtime.ClientAuthInfoWriter) (*DiffReleaseRevisionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDiffReleaseRevisionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DiffReleaseRevisions",
+		Method:             "GET",
+		PathPattern:        "/v1/releases/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DiffReleaseRevisionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DiffReleaseRevisionsOK), nil
+
+}
+
 /*
 ListReleases lists release
 */
@@ -78,6 +78,11 @@ type DescribeRepoEventsParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*RepoEventID
 	  repository event ids.
 
@@ -165,6 +170,17 @@ func (o *DescribeRepoEventsParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe repo events params
+func (o *DescribeRepoEventsParams) WithPageToken(pageToken *string) *DescribeRepoEventsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe repo events params
+func (o *DescribeRepoEventsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithRepoEventID adds the repoEventID to the describe repo events params
 func (o *DescribeRepoEventsParams) WithRepoEventID(repoEventID []string) *DescribeRepoEventsParams {
 	o.SetRepoEventID(repoEventID)
@@ -246,6 +262,22 @@ func (o *DescribeRepoEventsParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	valuesRepoEventID := o.RepoEventID
 
 	joinedRepoEventID := swag.JoinByFormat(valuesRepoEventID, "multi")
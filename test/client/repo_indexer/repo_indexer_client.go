@@ -53,6 +53,35 @@ func (a *Client) DescribeRepoEvents(params *DescribeRepoEventsParams, authInfo r
 
 }
 
+/*
+ExportRepoEvents exports repository events stream all the repository events page by page for bulk reporting
+*/
+func (a *Client) ExportRepoEvents(params *ExportRepoEventsParams, authInfo runtime.ClientAuthInfoWriter) (*ExportRepoEventsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportRepoEventsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportRepoEvents",
+		Method:             "GET",
+		PathPattern:        "/v1/repo_events/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportRepoEventsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportRepoEventsOK), nil
+
+}
+
 /*
 IndexRepo starts index repository event
 */
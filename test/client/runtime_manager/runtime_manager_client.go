@@ -430,6 +430,35 @@ func (a *Client) ModifyRuntimeCredential(params *ModifyRuntimeCredentialParams,
 
 }
 
+/*
+RotateCredentialKey res encrypt runtime credentials with the primary master key only for global admin
+*/
+func (a *Client) RotateCredentialKey(params *RotateCredentialKeyParams, authInfo runtime.ClientAuthInfoWriter) (*RotateCredentialKeyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateCredentialKeyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RotateCredentialKey",
+		Method:             "POST",
+		PathPattern:        "/v1/runtimes/credentials:rotate_key",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RotateCredentialKeyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RotateCredentialKeyOK), nil
+
+}
+
 /*
 ValidateRuntimeCredential validates runtime credential
 */
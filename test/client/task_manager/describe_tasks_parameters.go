@@ -93,6 +93,11 @@ type DescribeTasksParams struct {
 
 	*/
 	Owner []string
+	/*PageToken
+	  page token of the next page returned by previous page, the offset is ignored if it is set.
+
+	*/
+	PageToken *string
 	/*Reverse
 	  value = 0 sort ASC, value = 1 sort DESC.
 
@@ -228,6 +233,17 @@ func (o *DescribeTasksParams) SetOwner(owner []string) {
 	o.Owner = owner
 }
 
+// WithPageToken adds the pageToken to the describe tasks params
+func (o *DescribeTasksParams) WithPageToken(pageToken *string) *DescribeTasksParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the describe tasks params
+func (o *DescribeTasksParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithReverse adds the reverse to the describe tasks params
 func (o *DescribeTasksParams) WithReverse(reverse *bool) *DescribeTasksParams {
 	o.SetReverse(reverse)
@@ -374,6 +390,22 @@ func (o *DescribeTasksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		return err
 	}
 
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Reverse != nil {
 
 		// query param reverse
@@ -53,6 +53,35 @@ func (a *Client) DescribeTasks(params *DescribeTasksParams, authInfo runtime.Cli
 
 }
 
+/*
+ExportTasks exports tasks stream all the tasks page by page for bulk reporting
+*/
+func (a *Client) ExportTasks(params *ExportTasksParams, authInfo runtime.ClientAuthInfoWriter) (*ExportTasksOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportTasksParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportTasks",
+		Method:             "GET",
+		PathPattern:        "/v1/tasks/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportTasksReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportTasksOK), nil
+
+}
+
 /*
 RetryTasks retries tasks
 */
@@ -53,6 +53,122 @@ func (a *Client) CreateClient(params *CreateClientParams, authInfo runtime.Clien
 
 }
 
+/*
+DescribeSessions gets sessions
+*/
+func (a *Client) DescribeSessions(params *DescribeSessionsParams, authInfo runtime.ClientAuthInfoWriter) (*DescribeSessionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDescribeSessionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DescribeSessions",
+		Method:             "GET",
+		PathPattern:        "/v1/oauth2/sessions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DescribeSessionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DescribeSessionsOK), nil
+
+}
+
+/*
+Logout logouts
+*/
+func (a *Client) Logout(params *LogoutParams, authInfo runtime.ClientAuthInfoWriter) (*LogoutOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewLogoutParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Logout",
+		Method:             "POST",
+		PathPattern:        "/v1/oauth2/logout",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &LogoutReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*LogoutOK), nil
+
+}
+
+/*
+RevokeToken revokes token
+*/
+func (a *Client) RevokeToken(params *RevokeTokenParams, authInfo runtime.ClientAuthInfoWriter) (*RevokeTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeTokenParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RevokeToken",
+		Method:             "POST",
+		PathPattern:        "/v1/oauth2/revoke",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RevokeTokenReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RevokeTokenOK), nil
+
+}
+
+/*
+RevokeUserTokens revokes all tokens of users
+*/
+func (a *Client) RevokeUserTokens(params *RevokeUserTokensParams, authInfo runtime.ClientAuthInfoWriter) (*RevokeUserTokensOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeUserTokensParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RevokeUserTokens",
+		Method:             "POST",
+		PathPattern:        "/v1/oauth2/revoke_user_tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RevokeUserTokensReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RevokeUserTokensOK), nil
+
+}
+
 /*
 Token gets users include user info of role and group filter with fields user id email phone number status default return all users
 */
@@ -22,6 +22,9 @@ type OpenpitrixAddClusterNodesRequest struct {
 	// required, id of cluster to add node
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// number of node added to cluster
 	NodeCount int64 `json:"node_count,omitempty"`
 
@@ -31,6 +31,9 @@ type OpenpitrixAppVersionReview struct {
 	// user who review the app version
 	Reviewer string `json:"reviewer,omitempty"`
 
+	// ordered stages of the review, eg.[isv, business, technical]
+	Stage []string `json:"stage"`
+
 	// review status eg.[isv-in-review|isv-passed|isv-rejected|isv-draft|business-in-review|business-passed|business-rejected|develop-draft|develop-in-review|develop-passed|develop-rejected|develop-draft]
 	Status string `json:"status,omitempty"`
 
@@ -51,12 +54,26 @@ type OpenpitrixAppVersionReview struct {
 func (m *OpenpitrixAppVersionReview) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateStage(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *OpenpitrixAppVersionReview) validateStage(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Stage) { // not required
+		return nil
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *OpenpitrixAppVersionReview) MarshalBinary() ([]byte, error) {
 	if m == nil {
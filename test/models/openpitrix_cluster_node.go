@@ -46,6 +46,9 @@ type OpenpitrixClusterNode struct {
 	// group id
 	GroupID int64 `json:"group_id,omitempty"`
 
+	// the time when the health check of role last ran on cluster node
+	HealthCheckTime strfmt.DateTime `json:"health_check_time,omitempty"`
+
 	// health status default empty eg.[healthy|unhealthy|""]
 	HealthStatus string `json:"health_status,omitempty"`
 
@@ -19,10 +19,10 @@ type OpenpitrixCreateReleaseRequest struct {
 	// required, id of app to run in cluster
 	AppID string `json:"app_id,omitempty"`
 
-	// namespace
+	// namespace, default to the zone of runtime
 	Namespace string `json:"namespace,omitempty"`
 
-	// release name
+	// required, release name, which is the name of cluster as well
 	ReleaseName string `json:"release_name,omitempty"`
 
 	// required, id of runtime
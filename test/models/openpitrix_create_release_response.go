@@ -16,6 +16,12 @@ import (
 // swagger:model openpitrixCreateReleaseResponse
 type OpenpitrixCreateReleaseResponse struct {
 
+	// id of cluster created for the release
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// id of the job installing the release
+	JobID string `json:"job_id,omitempty"`
+
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 }
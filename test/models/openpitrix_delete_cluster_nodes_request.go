@@ -22,6 +22,9 @@ type OpenpitrixDeleteClusterNodesRequest struct {
 	// required, id of cluster to delete node
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// required, node ids
 	NodeID []string `json:"node_id"`
 }
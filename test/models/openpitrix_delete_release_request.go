@@ -16,13 +16,16 @@ import (
 // swagger:model openpitrixDeleteReleaseRequest
 type OpenpitrixDeleteReleaseRequest struct {
 
-	// purge
+	// id of kubernetes cluster
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// whether to delete the revision history and the cluster as well
 	Purge bool `json:"purge,omitempty"`
 
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 
-	// runtime id
+	// id of runtime, required with release_name if cluster_id is not set
 	RuntimeID string `json:"runtime_id,omitempty"`
 }
 
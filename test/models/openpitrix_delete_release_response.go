@@ -16,6 +16,12 @@ import (
 // swagger:model openpitrixDeleteReleaseResponse
 type OpenpitrixDeleteReleaseResponse struct {
 
+	// id of cluster
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// id of the job deleting the release
+	JobID string `json:"job_id,omitempty"`
+
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 }
@@ -19,6 +19,9 @@ type OpenpitrixDescribeAppsResponse struct {
 	// app set
 	AppSet OpenpitrixDescribeAppsResponseAppSet `json:"app_set"`
 
+	// page token of the next page, empty if there are no more apps
+	NextPageToken string `json:"next_page_token,omitempty"`
+
 	// total count of qualified app
 	TotalCount int64 `json:"total_count,omitempty"`
 }
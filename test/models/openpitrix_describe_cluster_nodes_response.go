@@ -19,6 +19,9 @@ type OpenpitrixDescribeClusterNodesResponse struct {
 	// cluster node set
 	ClusterNodeSet OpenpitrixDescribeClusterNodesResponseClusterNodeSet `json:"cluster_node_set"`
 
+	// page token of the next page, empty if there are no more cluster nodes
+	NextPageToken string `json:"next_page_token,omitempty"`
+
 	// total count of node in the cluster
 	TotalCount int64 `json:"total_count,omitempty"`
 }
@@ -19,6 +19,9 @@ type OpenpitrixDescribeClustersResponse struct {
 	// cluster set
 	ClusterSet OpenpitrixDescribeClustersResponseClusterSet `json:"cluster_set"`
 
+	// page token of the next page, empty if there are no more clusters
+	NextPageToken string `json:"next_page_token,omitempty"`
+
 	// total count of qualified cluster
 	TotalCount int64 `json:"total_count,omitempty"`
 }
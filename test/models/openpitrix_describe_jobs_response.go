@@ -19,6 +19,9 @@ type OpenpitrixDescribeJobsResponse struct {
 	// job set
 	JobSet OpenpitrixDescribeJobsResponseJobSet `json:"job_set"`
 
+	// page token of the next page, empty if there are no more jobs
+	NextPageToken string `json:"next_page_token,omitempty"`
+
 	// total count of job
 	TotalCount int64 `json:"total_count,omitempty"`
 }
@@ -16,6 +16,9 @@ import (
 // swagger:model openpitrixDescribeRepoEventsResponse
 type OpenpitrixDescribeRepoEventsResponse struct {
 
+	// page token of the next page, empty if there are no more repository events
+	NextPageToken string `json:"next_page_token,omitempty"`
+
 	// repo event set
 	RepoEventSet OpenpitrixDescribeRepoEventsResponseRepoEventSet `json:"repo_event_set"`
 
@@ -16,6 +16,9 @@ import (
 // swagger:model openpitrixDescribeTasksResponse
 type OpenpitrixDescribeTasksResponse struct {
 
+	// page token of the next page, empty if there are no more tasks
+	NextPageToken string `json:"next_page_token,omitempty"`
+
 	// task set
 	TaskSet OpenpitrixDescribeTasksResponseTaskSet `json:"task_set"`
 
@@ -46,13 +46,19 @@ type OpenpitrixJob struct {
 	// own path, concat string group_path:user_id
 	OwnerPath string `json:"owner_path,omitempty"`
 
+	// priority of job, job with higher priority is dispatched first
+	Priority int64 `json:"priority,omitempty"`
+
 	// runtime provider eg:[qingcloud|aliyun|aws|kubernetes]
 	Provider string `json:"provider,omitempty"`
 
 	// id of runtime of cluster
 	RuntimeID string `json:"runtime_id,omitempty"`
 
-	// status eg.[successful|failed|running|pending]
+	// the time to dispatch the job
+	ScheduledTime strfmt.DateTime `json:"scheduled_time,omitempty"`
+
+	// status eg.[successful|failed|running|pending|scheduled|cancelled]
 	Status string `json:"status,omitempty"`
 
 	// record the status changed time
@@ -16,6 +16,9 @@ import (
 // swagger:model openpitrixPassAppVersionRequest
 type OpenpitrixPassAppVersionRequest struct {
 
+	// stage of the review to pass, required by PassAppVersion
+	Stage string `json:"stage,omitempty"`
+
 	// required, id of version to pass
 	VersionID string `json:"version_id,omitempty"`
 }
@@ -19,6 +19,9 @@ type OpenpitrixRejectAppVersionRequest struct {
 	// reject message
 	Message string `json:"message,omitempty"`
 
+	// stage of the review to reject, required by RejectAppVersion
+	Stage string `json:"stage,omitempty"`
+
 	// required, id of version to reject
 	VersionID string `json:"version_id,omitempty"`
 }
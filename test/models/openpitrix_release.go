@@ -16,6 +16,12 @@ import (
 // swagger:model openpitrixRelease
 type OpenpitrixRelease struct {
 
+	// app version of chart deployed in the revision
+	AppVersion string `json:"app_version,omitempty"`
+
+	// name and version of chart deployed in the revision, eg. [wordpress-0.3.0]
+	Chart string `json:"chart,omitempty"`
+
 	// deleted time
 	DeletedTime strfmt.DateTime `json:"deleted_time,omitempty"`
 
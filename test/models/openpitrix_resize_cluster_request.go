@@ -22,6 +22,9 @@ type OpenpitrixResizeClusterRequest struct {
 	// required, id of cluster to resize
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// role resource
 	RoleResource OpenpitrixResizeClusterRequestRoleResource `json:"role_resource"`
 }
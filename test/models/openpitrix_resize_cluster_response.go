@@ -21,18 +21,45 @@ type OpenpitrixResizeClusterResponse struct {
 
 	// job id
 	JobID string `json:"job_id,omitempty"`
+
+	// task layers the job would be split into, set in dry run
+	TaskLayer *OpenpitrixTaskLayer `json:"task_layer,omitempty"`
 }
 
 // Validate validates this openpitrix resize cluster response
 func (m *OpenpitrixResizeClusterResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateTaskLayer(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *OpenpitrixResizeClusterResponse) validateTaskLayer(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TaskLayer) { // not required
+		return nil
+	}
+
+	if m.TaskLayer != nil {
+
+		if err := m.TaskLayer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("task_layer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *OpenpitrixResizeClusterResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
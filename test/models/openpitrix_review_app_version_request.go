@@ -16,6 +16,9 @@ import (
 // swagger:model openpitrixReviewAppVersionRequest
 type OpenpitrixReviewAppVersionRequest struct {
 
+	// stage of the review to start, required by ReviewAppVersion
+	Stage string `json:"stage,omitempty"`
+
 	// required, id of version to review
 	VersionID string `json:"version_id,omitempty"`
 }
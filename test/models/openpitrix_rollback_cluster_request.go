@@ -21,6 +21,12 @@ type OpenpitrixRollbackClusterRequest struct {
 
 	// required, id of cluster to rollback
 	ClusterID string `json:"cluster_id,omitempty"`
+
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// version of helm release revision to roll back a kubernetes cluster to, default to the previous revision
+	ReleaseVersion int32 `json:"release_version,omitempty"`
 }
 
 // Validate validates this openpitrix rollback cluster request
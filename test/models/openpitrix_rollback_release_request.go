@@ -16,13 +16,16 @@ import (
 // swagger:model openpitrixRollbackReleaseRequest
 type OpenpitrixRollbackReleaseRequest struct {
 
+	// id of kubernetes cluster
+	ClusterID string `json:"cluster_id,omitempty"`
+
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 
-	// runtime id
+	// id of runtime, required with release_name if cluster_id is not set
 	RuntimeID string `json:"runtime_id,omitempty"`
 
-	// version
+	// version of revision to roll back to, default to the previous revision
 	Version int32 `json:"version,omitempty"`
 }
 
@@ -16,10 +16,16 @@ import (
 // swagger:model openpitrixRollbackReleaseResponse
 type OpenpitrixRollbackReleaseResponse struct {
 
+	// id of cluster
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// id of the job rolling back the cluster
+	JobID string `json:"job_id,omitempty"`
+
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 
-	// version
+	// version of revision rolled back to
 	Version int32 `json:"version,omitempty"`
 }
 
@@ -16,6 +16,9 @@ import (
 // swagger:model openpitrixTask
 type OpenpitrixTask struct {
 
+	// attempts
+	Attempts OpenpitrixTaskAttempts `json:"attempts"`
+
 	// the time when task create
 	CreateTime strfmt.DateTime `json:"create_time,omitempty"`
 
@@ -43,6 +46,12 @@ type OpenpitrixTask struct {
 	// owner path, concat string group_path:user_id
 	OwnerPath string `json:"owner_path,omitempty"`
 
+	// priority of task, task with higher priority is dispatched first
+	Priority int64 `json:"priority,omitempty"`
+
+	// the time to retry the failed task automatically
+	RetryTime strfmt.DateTime `json:"retry_time,omitempty"`
+
 	// task status eg.[running|successful|failed|pending]
 	Status string `json:"status,omitempty"`
 
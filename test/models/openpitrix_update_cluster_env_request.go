@@ -22,6 +22,9 @@ type OpenpitrixUpdateClusterEnvRequest struct {
 	// id of cluster to update env
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// env
 	Env string `json:"env,omitempty"`
 }
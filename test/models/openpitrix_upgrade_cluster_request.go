@@ -22,6 +22,9 @@ type OpenpitrixUpgradeClusterRequest struct {
 	// required, id of cluster to upgrade
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// dry run, return the task layers the job would be split into without executing it
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// app version id
 	VersionID string `json:"version_id,omitempty"`
 }
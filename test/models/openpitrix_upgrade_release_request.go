@@ -16,13 +16,16 @@ import (
 // swagger:model openpitrixUpgradeReleaseRequest
 type OpenpitrixUpgradeReleaseRequest struct {
 
+	// id of kubernetes cluster
+	ClusterID string `json:"cluster_id,omitempty"`
+
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 
-	// runtime id
+	// id of runtime, required with release_name if cluster_id is not set
 	RuntimeID string `json:"runtime_id,omitempty"`
 
-	// version id
+	// required, id of app version to upgrade to
 	VersionID string `json:"version_id,omitempty"`
 }
 
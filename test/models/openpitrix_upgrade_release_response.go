@@ -16,6 +16,12 @@ import (
 // swagger:model openpitrixUpgradeReleaseResponse
 type OpenpitrixUpgradeReleaseResponse struct {
 
+	// id of cluster
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// id of the job upgrading the cluster
+	JobID string `json:"job_id,omitempty"`
+
 	// release name
 	ReleaseName string `json:"release_name,omitempty"`
 }